@@ -48,6 +48,10 @@ func newMsgFactory(localMsg bool) msgstream.Factory {
 	if localMsg {
 		return msgstream.NewRmsFactory()
 	}
+	// paramtable.Params is initialized by Run before any role starts
+	if paramtable.Params.MqType == "kafka" {
+		return msgstream.NewKmsFactory(paramtable.Params.KafkaBrokerList)
+	}
 	return msgstream.NewPmsFactory()
 }
 
@@ -373,6 +377,9 @@ func (mr *MilvusRoles) Run(localMsg bool, alias string) {
 		}
 	}
 
+	// after the deploy mode is set, which decides whether embedded etcd is allowed
+	paramtable.Params.Init()
+
 	var rc *components.RootCoord
 	if mr.EnableRootCoord {
 		rc = mr.runRootCoord(ctx, localMsg)
@@ -26,3 +26,18 @@ const (
 	TimeStampFieldName = "Timestamp"
 	DefaultShardsNum   = int32(2)
 )
+
+// DefaultDatabaseName is the database which collections belong to when no database is specified,
+// it always exists and can't be dropped
+const DefaultDatabaseName = "default"
+
+// DefaultRootUser is the super user created by root coord at the first startup,
+// it bypasses all the privilege checks
+const DefaultRootUser = "root"
+
+// DefaultRootPassword is the initial password of DefaultRootUser
+const DefaultRootPassword = "Milvus"
+
+// MaxLengthKey is the key of type param which declares the max length in bytes of a string field,
+// a string value takes a fixed size of 4 bytes length and max length bytes in row based insert data
+const MaxLengthKey = "max_length"
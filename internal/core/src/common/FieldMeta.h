@@ -70,6 +70,8 @@ datatype_name(DataType data_type) {
         case DataType::VECTOR_BINARY: {
             return "vector_binary";
         }
+        case DataType::STRING:
+            return "string";
         default: {
             auto err_msg = "Unsupported DataType(" + std::to_string((int)data_type) + ")";
             PanicInfo(err_msg);
@@ -82,6 +84,11 @@ datatype_is_vector(DataType datatype) {
     return datatype == DataType::VECTOR_BINARY || datatype == DataType::VECTOR_FLOAT;
 }
 
+inline bool
+datatype_is_string(DataType datatype) {
+    return datatype == DataType::STRING;
+}
+
 inline bool
 datatype_is_integer(DataType datatype) {
     switch (datatype) {
@@ -118,6 +125,12 @@ class FieldMeta {
 
     FieldMeta(const FieldName& name, FieldId id, DataType type) : name_(name), id_(id), type_(type) {
         Assert(!is_vector());
+        Assert(!is_string());
+    }
+
+    FieldMeta(const FieldName& name, FieldId id, DataType type, int64_t max_length)
+        : name_(name), id_(id), type_(type), string_info_(StringInfo{max_length}) {
+        Assert(is_string());
     }
 
     FieldMeta(const FieldName& name, FieldId id, DataType type, int64_t dim, std::optional<MetricType> metric_type)
@@ -131,6 +144,19 @@ class FieldMeta {
         return type_ == DataType::VECTOR_BINARY || type_ == DataType::VECTOR_FLOAT;
     }
 
+    bool
+    is_string() const {
+        Assert(type_ != DataType::NONE);
+        return type_ == DataType::STRING;
+    }
+
+    int64_t
+    get_max_length() const {
+        Assert(is_string());
+        Assert(string_info_.has_value());
+        return string_info_->max_length_;
+    }
+
     int64_t
     get_dim() const {
         Assert(is_vector());
@@ -160,10 +186,13 @@ class FieldMeta {
         return type_;
     }
 
+    // a string takes a fixed size slot of a uint32 length followed by max length bytes
     int
     get_sizeof() const {
         if (is_vector()) {
             return datatype_sizeof(type_, get_dim());
+        } else if (is_string()) {
+            return sizeof(uint32_t) + get_max_length();
         } else {
             return datatype_sizeof(type_);
         }
@@ -174,10 +203,14 @@ class FieldMeta {
         int64_t dim_;
         std::optional<MetricType> metric_type_;
     };
+    struct StringInfo {
+        int64_t max_length_;
+    };
     FieldName name_;
     FieldId id_;
     DataType type_ = DataType::NONE;
     std::optional<VectorInfo> vector_info_;
+    std::optional<StringInfo> string_info_;
 };
 
 }  // namespace milvus
@@ -63,6 +63,11 @@ Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
                 auto metric_type = GetMetricType(index_map.at("metric_type"));
                 schema->AddField(name, field_id, data_type, dim, metric_type);
             }
+        } else if (datatype_is_string(data_type)) {
+            auto type_map = RepeatedKeyValToMap(child.type_params());
+            AssertInfo(type_map.count("max_length"), "max_length not found");
+            auto max_length = boost::lexical_cast<int64_t>(type_map.at("max_length"));
+            schema->AddField(name, field_id, data_type, max_length);
         } else {
             schema->AddField(name, field_id, data_type);
         }
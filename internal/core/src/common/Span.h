@@ -13,6 +13,8 @@
 #include <type_traits>
 #include "common/Types.h"
 #include <cassert>
+#include <cstring>
+#include <string_view>
 #include "VectorTrait.h"
 
 namespace milvus {
@@ -127,4 +129,68 @@ class Span<VectorType, typename std::enable_if_t<std::is_base_of_v<VectorTrait,
     const int64_t row_count_;
     const int64_t element_sizeof_;
 };
+
+// view the string held by a slot of VarChar
+inline std::string_view
+VarCharView(const void* slot) {
+    uint32_t length;
+    memcpy(&length, slot, sizeof(length));
+    return std::string_view(reinterpret_cast<const char*>(slot) + sizeof(length), length);
+}
+
+// fill a slot of VarChar with str, the bytes after str are zeroed
+inline void
+FillVarChar(std::string_view str, void* slot, int64_t slot_sizeof) {
+    assert(sizeof(uint32_t) + str.size() <= slot_sizeof);
+    auto length = static_cast<uint32_t>(str.size());
+    auto dst = reinterpret_cast<char*>(slot);
+    memcpy(dst, &length, sizeof(length));
+    memcpy(dst + sizeof(length), str.data(), str.size());
+    memset(dst + sizeof(length) + str.size(), 0, slot_sizeof - sizeof(length) - str.size());
+}
+
+template <>
+class Span<VarChar> {
+ public:
+    using embedded_type = typename VarChar::embedded_type;
+
+    Span(const embedded_type* data, int64_t row_count, int64_t element_sizeof)
+        : data_(data), row_count_(row_count), element_sizeof_(element_sizeof) {
+    }
+
+    explicit Span(const SpanBase& base)
+        : data_(reinterpret_cast<const embedded_type*>(base.data())),
+          row_count_(base.row_count()),
+          element_sizeof_(base.element_sizeof()) {
+    }
+
+    operator SpanBase() const {
+        return SpanBase(data_, row_count_, element_sizeof_);
+    }
+
+    int64_t
+    element_sizeof() const {
+        return element_sizeof_;
+    }
+
+    const embedded_type*
+    data() const {
+        return data_;
+    }
+
+    std::string_view
+    operator[](int64_t offset) const {
+        return VarCharView(data_ + offset * element_sizeof_);
+    }
+
+    int64_t
+    row_count() const {
+        return row_count_;
+    }
+
+ private:
+    const embedded_type* data_;
+    const int64_t row_count_;
+    const int64_t element_sizeof_;
+};
 }  // namespace milvus
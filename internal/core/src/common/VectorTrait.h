@@ -28,6 +28,13 @@ class BinaryVector : public VectorTrait {
     static constexpr auto metric_type = DataType::VECTOR_BINARY;
 };
 
+// a string field is stored in fixed size slots, each holds a uint32 length followed by the bytes padded to max length
+class VarChar {
+ public:
+    using embedded_type = uint8_t;
+    static constexpr auto data_type = DataType::STRING;
+};
+
 template <typename VectorType>
 inline constexpr int64_t
 element_sizeof(int64_t dim) {
@@ -169,7 +169,7 @@ static void InitDefaultsscc_info_Status_common_2eproto() {
     {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsscc_info_Status_common_2eproto}, {}};
 
 static ::PROTOBUF_NAMESPACE_ID::Metadata file_level_metadata_common_2eproto[8];
-static const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* file_level_enum_descriptors_common_2eproto[5];
+static const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* file_level_enum_descriptors_common_2eproto[8];
 static constexpr ::PROTOBUF_NAMESPACE_ID::ServiceDescriptor const** file_level_service_descriptors_common_2eproto = nullptr;
 
 const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_common_2eproto::offsets[] PROTOBUF_SECTION_VARIABLE(protodesc_cold) = {
@@ -284,37 +284,51 @@ const char descriptor_table_protodef_common_2eproto[] PROTOBUF_SECTION_VARIABLE(
   "ssued\020\001\022\016\n\nInProgress\020\002\022\014\n\010Finished\020\003\022\n\n"
   "\006Failed\020\004*f\n\014SegmentState\022\024\n\020SegmentStat"
   "eNone\020\000\022\014\n\010NotExist\020\001\022\013\n\007Growing\020\002\022\n\n\006Se"
-  "aled\020\003\022\013\n\007Flushed\020\004\022\014\n\010Flushing\020\005*\264\010\n\007Ms"
+  "aled\020\003\022\013\n\007Flushed\020\004\022\014\n\010Flushing\020\005*\370\n\n\007Ms"
   "gType\022\r\n\tUndefined\020\000\022\024\n\020CreateCollection"
   "\020d\022\022\n\016DropCollection\020e\022\021\n\rHasCollection\020"
   "f\022\026\n\022DescribeCollection\020g\022\023\n\017ShowCollect"
   "ions\020h\022\024\n\020GetSystemConfigs\020i\022\022\n\016LoadColl"
   "ection\020j\022\025\n\021ReleaseCollection\020k\022\017\n\013Creat"
   "eAlias\020l\022\r\n\tDropAlias\020m\022\016\n\nAlterAlias\020n\022"
-  "\024\n\017CreatePartition\020\310\001\022\022\n\rDropPartition\020\311"
-  "\001\022\021\n\014HasPartition\020\312\001\022\026\n\021DescribePartitio"
-  "n\020\313\001\022\023\n\016ShowPartitions\020\314\001\022\023\n\016LoadPartiti"
-  "ons\020\315\001\022\026\n\021ReleasePartitions\020\316\001\022\021\n\014ShowSe"
-  "gments\020\372\001\022\024\n\017DescribeSegment\020\373\001\022\021\n\014LoadS"
-  "egments\020\374\001\022\024\n\017ReleaseSegments\020\375\001\022\024\n\017Hand"
-  "offSegments\020\376\001\022\030\n\023LoadBalanceSegments\020\377\001"
-  "\022\020\n\013CreateIndex\020\254\002\022\022\n\rDescribeIndex\020\255\002\022\016"
-  "\n\tDropIndex\020\256\002\022\013\n\006Insert\020\220\003\022\013\n\006Delete\020\221\003"
-  "\022\n\n\005Flush\020\222\003\022\013\n\006Search\020\364\003\022\021\n\014SearchResul"
-  "t\020\365\003\022\022\n\rGetIndexState\020\366\003\022\032\n\025GetIndexBuil"
-  "dProgress\020\367\003\022\034\n\027GetCollectionStatistics\020"
-  "\370\003\022\033\n\026GetPartitionStatistics\020\371\003\022\r\n\010Retri"
-  "eve\020\372\003\022\023\n\016RetrieveResult\020\373\003\022\024\n\017WatchDmCh"
-  "annels\020\374\003\022\025\n\020RemoveDmChannels\020\375\003\022\027\n\022Watc"
-  "hQueryChannels\020\376\003\022\030\n\023RemoveQueryChannels"
-  "\020\377\003\022\020\n\013SegmentInfo\020\330\004\022\r\n\010TimeTick\020\260\t\022\023\n\016"
-  "QueryNodeStats\020\261\t\022\016\n\tLoadIndex\020\262\t\022\016\n\tReq"
-  "uestID\020\263\t\022\017\n\nRequestTSO\020\264\t\022\024\n\017AllocateSe"
-  "gment\020\265\t\022\026\n\021SegmentStatistics\020\266\t\022\025\n\020Segm"
-  "entFlushDone\020\267\t\022\017\n\nDataNodeTt\020\270\t*\"\n\007DslT"
-  "ype\022\007\n\003Dsl\020\000\022\016\n\nBoolExprV1\020\001B5Z3github.c"
-  "om/milvus-io/milvus/internal/proto/commo"
-  "npbb\006proto3"
+  "\014\n\010AddField\020o\022\023\n\016CreateDatabase\020\226\001\022\021\n\014Dr"
+  "opDatabase\020\227\001\022\022\n\rListDatabases\020\230\001\022\024\n\017Cre"
+  "atePartition\020\310\001\022\022\n\rDropPartition\020\311\001\022\021\n\014H"
+  "asPartition\020\312\001\022\026\n\021DescribePartition\020\313\001\022\023"
+  "\n\016ShowPartitions\020\314\001\022\023\n\016LoadPartitions\020\315\001"
+  "\022\026\n\021ReleasePartitions\020\316\001\022\021\n\014ShowSegments"
+  "\020\372\001\022\024\n\017DescribeSegment\020\373\001\022\021\n\014LoadSegment"
+  "s\020\374\001\022\024\n\017ReleaseSegments\020\375\001\022\024\n\017HandoffSeg"
+  "ments\020\376\001\022\030\n\023LoadBalanceSegments\020\377\001\022\020\n\013Cr"
+  "eateIndex\020\254\002\022\022\n\rDescribeIndex\020\255\002\022\016\n\tDrop"
+  "Index\020\256\002\022\013\n\006Insert\020\220\003\022\013\n\006Delete\020\221\003\022\n\n\005Fl"
+  "ush\020\222\003\022\013\n\006Search\020\364\003\022\021\n\014SearchResult\020\365\003\022\022"
+  "\n\rGetIndexState\020\366\003\022\032\n\025GetIndexBuildProgr"
+  "ess\020\367\003\022\034\n\027GetCollectionStatistics\020\370\003\022\033\n\026"
+  "GetPartitionStatistics\020\371\003\022\r\n\010Retrieve\020\372\003"
+  "\022\023\n\016RetrieveResult\020\373\003\022\024\n\017WatchDmChannels"
+  "\020\374\003\022\025\n\020RemoveDmChannels\020\375\003\022\027\n\022WatchQuery"
+  "Channels\020\376\003\022\030\n\023RemoveQueryChannels\020\377\003\022\020\n"
+  "\013SegmentInfo\020\330\004\022\r\n\010TimeTick\020\260\t\022\023\n\016QueryN"
+  "odeStats\020\261\t\022\016\n\tLoadIndex\020\262\t\022\016\n\tRequestID"
+  "\020\263\t\022\017\n\nRequestTSO\020\264\t\022\024\n\017AllocateSegment\020"
+  "\265\t\022\026\n\021SegmentStatistics\020\266\t\022\025\n\020SegmentFlu"
+  "shDone\020\267\t\022\017\n\nDataNodeTt\020\270\t\022\025\n\020CreateCred"
+  "ential\020\334\013\022\022\n\rGetCredential\020\335\013\022\025\n\020DeleteC"
+  "redential\020\336\013\022\025\n\020UpdateCredential\020\337\013\022\026\n\021L"
+  "istCredUsernames\020\340\013\022\017\n\nCreateRole\020\300\014\022\r\n\010"
+  "DropRole\020\301\014\022\022\n\rAddUserToRole\020\302\014\022\027\n\022Remov"
+  "eUserFromRole\020\303\014\022\023\n\016GrantPrivilege\020\304\014\022\024\n"
+  "\017RevokePrivilege\020\305\014\022\017\n\nListGrants\020\306\014*\"\n\007"
+  "DslType\022\007\n\003Dsl\020\000\022\016\n\nBoolExprV1\020\001*B\n\017Comp"
+  "actionState\022\021\n\rUndefiedState\020\000\022\r\n\tExecut"
+  "ing\020\001\022\r\n\tCompleted\020\002*Z\n\013ImportState\022\021\n\rI"
+  "mportPending\020\000\022\020\n\014ImportFailed\020\001\022\021\n\rImpo"
+  "rtStarted\020\002\022\023\n\017ImportCompleted\020\003*H\n\020Cons"
+  "istencyLevel\022\n\n\006Strong\020\000\022\013\n\007Session\020\001\022\013\n"
+  "\007Bounded\020\002\022\016\n\nEventually\020\003B5Z3github.com"
+  "/milvus-io/milvus/internal/proto/commonp"
+  "bb\006proto3"
   ;
 static const ::PROTOBUF_NAMESPACE_ID::internal::DescriptorTable*const descriptor_table_common_2eproto_deps[1] = {
 };
@@ -331,7 +345,7 @@ static ::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase*const descriptor_table_com
 static ::PROTOBUF_NAMESPACE_ID::internal::once_flag descriptor_table_common_2eproto_once;
 static bool descriptor_table_common_2eproto_initialized = false;
 const ::PROTOBUF_NAMESPACE_ID::internal::DescriptorTable descriptor_table_common_2eproto = {
-  &descriptor_table_common_2eproto_initialized, descriptor_table_protodef_common_2eproto, "common.proto", 2451,
+  &descriptor_table_common_2eproto_initialized, descriptor_table_protodef_common_2eproto, "common.proto", 3009,
   &descriptor_table_common_2eproto_once, descriptor_table_common_2eproto_sccs, descriptor_table_common_2eproto_deps, 8, 0,
   schemas, file_default_instances, TableStruct_common_2eproto::offsets,
   file_level_metadata_common_2eproto, 8, file_level_enum_descriptors_common_2eproto, file_level_service_descriptors_common_2eproto,
@@ -434,6 +448,10 @@ bool MsgType_IsValid(int value) {
     case 108:
     case 109:
     case 110:
+    case 111:
+    case 150:
+    case 151:
+    case 152:
     case 200:
     case 201:
     case 202:
@@ -475,6 +493,18 @@ bool MsgType_IsValid(int value) {
     case 1206:
     case 1207:
     case 1208:
+    case 1500:
+    case 1501:
+    case 1502:
+    case 1503:
+    case 1504:
+    case 1600:
+    case 1601:
+    case 1602:
+    case 1603:
+    case 1604:
+    case 1605:
+    case 1606:
       return true;
     default:
       return false;
@@ -495,6 +525,53 @@ bool DslType_IsValid(int value) {
   }
 }
 
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* CompactionState_descriptor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_common_2eproto);
+  return file_level_enum_descriptors_common_2eproto[5];
+}
+bool CompactionState_IsValid(int value) {
+  switch (value) {
+    case 0:
+    case 1:
+    case 2:
+      return true;
+    default:
+      return false;
+  }
+}
+
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* ImportState_descriptor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_common_2eproto);
+  return file_level_enum_descriptors_common_2eproto[6];
+}
+bool ImportState_IsValid(int value) {
+  switch (value) {
+    case 0:
+    case 1:
+    case 2:
+    case 3:
+      return true;
+    default:
+      return false;
+  }
+}
+
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* ConsistencyLevel_descriptor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_common_2eproto);
+  return file_level_enum_descriptors_common_2eproto[7];
+}
+bool ConsistencyLevel_IsValid(int value) {
+  switch (value) {
+    case 0:
+    case 1:
+    case 2:
+    case 3:
+      return true;
+    default:
+      return false;
+  }
+}
+
 
 // ===================================================================
 
@@ -219,6 +219,10 @@ enum MsgType : int {
   CreateAlias = 108,
   DropAlias = 109,
   AlterAlias = 110,
+  AddField = 111,
+  CreateDatabase = 150,
+  DropDatabase = 151,
+  ListDatabases = 152,
   CreatePartition = 200,
   DropPartition = 201,
   HasPartition = 202,
@@ -260,12 +264,24 @@ enum MsgType : int {
   SegmentStatistics = 1206,
   SegmentFlushDone = 1207,
   DataNodeTt = 1208,
+  CreateCredential = 1500,
+  GetCredential = 1501,
+  DeleteCredential = 1502,
+  UpdateCredential = 1503,
+  ListCredUsernames = 1504,
+  CreateRole = 1600,
+  DropRole = 1601,
+  AddUserToRole = 1602,
+  RemoveUserFromRole = 1603,
+  GrantPrivilege = 1604,
+  RevokePrivilege = 1605,
+  ListGrants = 1606,
   MsgType_INT_MIN_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::min(),
   MsgType_INT_MAX_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::max()
 };
 bool MsgType_IsValid(int value);
 constexpr MsgType MsgType_MIN = Undefined;
-constexpr MsgType MsgType_MAX = DataNodeTt;
+constexpr MsgType MsgType_MAX = ListGrants;
 constexpr int MsgType_ARRAYSIZE = MsgType_MAX + 1;
 
 const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* MsgType_descriptor();
@@ -307,6 +323,86 @@ inline bool DslType_Parse(
   return ::PROTOBUF_NAMESPACE_ID::internal::ParseNamedEnum<DslType>(
     DslType_descriptor(), name, value);
 }
+enum CompactionState : int {
+  UndefiedState = 0,
+  Executing = 1,
+  Completed = 2,
+  CompactionState_INT_MIN_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::min(),
+  CompactionState_INT_MAX_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::max()
+};
+bool CompactionState_IsValid(int value);
+constexpr CompactionState CompactionState_MIN = UndefiedState;
+constexpr CompactionState CompactionState_MAX = Completed;
+constexpr int CompactionState_ARRAYSIZE = CompactionState_MAX + 1;
+
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* CompactionState_descriptor();
+template<typename T>
+inline const std::string& CompactionState_Name(T enum_t_value) {
+  static_assert(::std::is_same<T, CompactionState>::value ||
+    ::std::is_integral<T>::value,
+    "Incorrect type passed to function CompactionState_Name.");
+  return ::PROTOBUF_NAMESPACE_ID::internal::NameOfEnum(
+    CompactionState_descriptor(), enum_t_value);
+}
+inline bool CompactionState_Parse(
+    const std::string& name, CompactionState* value) {
+  return ::PROTOBUF_NAMESPACE_ID::internal::ParseNamedEnum<CompactionState>(
+    CompactionState_descriptor(), name, value);
+}
+enum ImportState : int {
+  ImportPending = 0,
+  ImportFailed = 1,
+  ImportStarted = 2,
+  ImportCompleted = 3,
+  ImportState_INT_MIN_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::min(),
+  ImportState_INT_MAX_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::max()
+};
+bool ImportState_IsValid(int value);
+constexpr ImportState ImportState_MIN = ImportPending;
+constexpr ImportState ImportState_MAX = ImportCompleted;
+constexpr int ImportState_ARRAYSIZE = ImportState_MAX + 1;
+
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* ImportState_descriptor();
+template<typename T>
+inline const std::string& ImportState_Name(T enum_t_value) {
+  static_assert(::std::is_same<T, ImportState>::value ||
+    ::std::is_integral<T>::value,
+    "Incorrect type passed to function ImportState_Name.");
+  return ::PROTOBUF_NAMESPACE_ID::internal::NameOfEnum(
+    ImportState_descriptor(), enum_t_value);
+}
+inline bool ImportState_Parse(
+    const std::string& name, ImportState* value) {
+  return ::PROTOBUF_NAMESPACE_ID::internal::ParseNamedEnum<ImportState>(
+    ImportState_descriptor(), name, value);
+}
+enum ConsistencyLevel : int {
+  Strong = 0,
+  Session = 1,
+  Bounded = 2,
+  Eventually = 3,
+  ConsistencyLevel_INT_MIN_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::min(),
+  ConsistencyLevel_INT_MAX_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::max()
+};
+bool ConsistencyLevel_IsValid(int value);
+constexpr ConsistencyLevel ConsistencyLevel_MIN = Strong;
+constexpr ConsistencyLevel ConsistencyLevel_MAX = Eventually;
+constexpr int ConsistencyLevel_ARRAYSIZE = ConsistencyLevel_MAX + 1;
+
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* ConsistencyLevel_descriptor();
+template<typename T>
+inline const std::string& ConsistencyLevel_Name(T enum_t_value) {
+  static_assert(::std::is_same<T, ConsistencyLevel>::value ||
+    ::std::is_integral<T>::value,
+    "Incorrect type passed to function ConsistencyLevel_Name.");
+  return ::PROTOBUF_NAMESPACE_ID::internal::NameOfEnum(
+    ConsistencyLevel_descriptor(), enum_t_value);
+}
+inline bool ConsistencyLevel_Parse(
+    const std::string& name, ConsistencyLevel* value) {
+  return ::PROTOBUF_NAMESPACE_ID::internal::ParseNamedEnum<ConsistencyLevel>(
+    ConsistencyLevel_descriptor(), name, value);
+}
 // ===================================================================
 
 class Status :
@@ -2149,6 +2245,21 @@ template <>
 inline const EnumDescriptor* GetEnumDescriptor< ::milvus::proto::common::DslType>() {
   return ::milvus::proto::common::DslType_descriptor();
 }
+template <> struct is_proto_enum< ::milvus::proto::common::CompactionState> : ::std::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::milvus::proto::common::CompactionState>() {
+  return ::milvus::proto::common::CompactionState_descriptor();
+}
+template <> struct is_proto_enum< ::milvus::proto::common::ImportState> : ::std::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::milvus::proto::common::ImportState>() {
+  return ::milvus::proto::common::ImportState_descriptor();
+}
+template <> struct is_proto_enum< ::milvus::proto::common::ConsistencyLevel> : ::std::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::milvus::proto::common::ConsistencyLevel>() {
+  return ::milvus::proto::common::ConsistencyLevel_descriptor();
+}
 
 PROTOBUF_NAMESPACE_CLOSE
 
@@ -49,7 +49,7 @@ struct TableStruct_etcd_5fmeta_2eproto {
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
   static const ::PROTOBUF_NAMESPACE_ID::internal::AuxillaryParseTableField aux[]
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
-  static const ::PROTOBUF_NAMESPACE_ID::internal::ParseTable schema[7]
+  static const ::PROTOBUF_NAMESPACE_ID::internal::ParseTable schema[11]
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
   static const ::PROTOBUF_NAMESPACE_ID::internal::FieldMetadata field_metadata[];
   static const ::PROTOBUF_NAMESPACE_ID::internal::SerializationTable serialization_table[];
@@ -65,15 +65,27 @@ extern CollectionInfoDefaultTypeInternal _CollectionInfo_default_instance_;
 class CollectionMeta;
 class CollectionMetaDefaultTypeInternal;
 extern CollectionMetaDefaultTypeInternal _CollectionMeta_default_instance_;
+class CredentialInfo;
+class CredentialInfoDefaultTypeInternal;
+extern CredentialInfoDefaultTypeInternal _CredentialInfo_default_instance_;
+class DatabaseInfo;
+class DatabaseInfoDefaultTypeInternal;
+extern DatabaseInfoDefaultTypeInternal _DatabaseInfo_default_instance_;
 class FieldIndexInfo;
 class FieldIndexInfoDefaultTypeInternal;
 extern FieldIndexInfoDefaultTypeInternal _FieldIndexInfo_default_instance_;
+class GrantInfo;
+class GrantInfoDefaultTypeInternal;
+extern GrantInfoDefaultTypeInternal _GrantInfo_default_instance_;
 class IndexInfo;
 class IndexInfoDefaultTypeInternal;
 extern IndexInfoDefaultTypeInternal _IndexInfo_default_instance_;
 class ProxyMeta;
 class ProxyMetaDefaultTypeInternal;
 extern ProxyMetaDefaultTypeInternal _ProxyMeta_default_instance_;
+class RoleInfo;
+class RoleInfoDefaultTypeInternal;
+extern RoleInfoDefaultTypeInternal _RoleInfo_default_instance_;
 class SegmentIndexInfo;
 class SegmentIndexInfoDefaultTypeInternal;
 extern SegmentIndexInfoDefaultTypeInternal _SegmentIndexInfo_default_instance_;
@@ -86,9 +98,13 @@ extern TenantMetaDefaultTypeInternal _TenantMeta_default_instance_;
 PROTOBUF_NAMESPACE_OPEN
 template<> ::milvus::proto::etcd::CollectionInfo* Arena::CreateMaybeMessage<::milvus::proto::etcd::CollectionInfo>(Arena*);
 template<> ::milvus::proto::etcd::CollectionMeta* Arena::CreateMaybeMessage<::milvus::proto::etcd::CollectionMeta>(Arena*);
+template<> ::milvus::proto::etcd::CredentialInfo* Arena::CreateMaybeMessage<::milvus::proto::etcd::CredentialInfo>(Arena*);
+template<> ::milvus::proto::etcd::DatabaseInfo* Arena::CreateMaybeMessage<::milvus::proto::etcd::DatabaseInfo>(Arena*);
 template<> ::milvus::proto::etcd::FieldIndexInfo* Arena::CreateMaybeMessage<::milvus::proto::etcd::FieldIndexInfo>(Arena*);
+template<> ::milvus::proto::etcd::GrantInfo* Arena::CreateMaybeMessage<::milvus::proto::etcd::GrantInfo>(Arena*);
 template<> ::milvus::proto::etcd::IndexInfo* Arena::CreateMaybeMessage<::milvus::proto::etcd::IndexInfo>(Arena*);
 template<> ::milvus::proto::etcd::ProxyMeta* Arena::CreateMaybeMessage<::milvus::proto::etcd::ProxyMeta>(Arena*);
+template<> ::milvus::proto::etcd::RoleInfo* Arena::CreateMaybeMessage<::milvus::proto::etcd::RoleInfo>(Arena*);
 template<> ::milvus::proto::etcd::SegmentIndexInfo* Arena::CreateMaybeMessage<::milvus::proto::etcd::SegmentIndexInfo>(Arena*);
 template<> ::milvus::proto::etcd::TenantMeta* Arena::CreateMaybeMessage<::milvus::proto::etcd::TenantMeta>(Arena*);
 PROTOBUF_NAMESPACE_CLOSE
@@ -843,10 +859,13 @@ class CollectionInfo :
     kPhysicalChannelNamesFieldNumber = 8,
     kPartitionCreatedTimestampsFieldNumber = 9,
     kStartPositionsFieldNumber = 11,
+    kDbNameFieldNumber = 12,
     kSchemaFieldNumber = 2,
     kIDFieldNumber = 1,
     kCreateTimeFieldNumber = 3,
     kShardsNumFieldNumber = 10,
+    kConsistencyLevelFieldNumber = 13,
+    kSchemaVersionFieldNumber = 14,
   };
   // repeated int64 partitionIDs = 4;
   int partitionids_size() const;
@@ -943,6 +962,17 @@ class CollectionInfo :
   const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::common::KeyDataPair >&
       start_positions() const;
 
+  // string db_name = 12;
+  void clear_db_name();
+  const std::string& db_name() const;
+  void set_db_name(const std::string& value);
+  void set_db_name(std::string&& value);
+  void set_db_name(const char* value);
+  void set_db_name(const char* value, size_t size);
+  std::string* mutable_db_name();
+  std::string* release_db_name();
+  void set_allocated_db_name(std::string* db_name);
+
   // .milvus.proto.schema.CollectionSchema schema = 2;
   bool has_schema() const;
   void clear_schema();
@@ -966,6 +996,16 @@ class CollectionInfo :
   ::PROTOBUF_NAMESPACE_ID::int32 shards_num() const;
   void set_shards_num(::PROTOBUF_NAMESPACE_ID::int32 value);
 
+  // .milvus.proto.common.ConsistencyLevel consistency_level = 13;
+  void clear_consistency_level();
+  ::milvus::proto::common::ConsistencyLevel consistency_level() const;
+  void set_consistency_level(::milvus::proto::common::ConsistencyLevel value);
+
+  // int64 schema_version = 14;
+  void clear_schema_version();
+  ::PROTOBUF_NAMESPACE_ID::int64 schema_version() const;
+  void set_schema_version(::PROTOBUF_NAMESPACE_ID::int64 value);
+
   // @@protoc_insertion_point(class_scope:milvus.proto.etcd.CollectionInfo)
  private:
   class _Internal;
@@ -980,32 +1020,35 @@ class CollectionInfo :
   ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::uint64 > partition_created_timestamps_;
   mutable std::atomic<int> _partition_created_timestamps_cached_byte_size_;
   ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::common::KeyDataPair > start_positions_;
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr db_name_;
   ::milvus::proto::schema::CollectionSchema* schema_;
   ::PROTOBUF_NAMESPACE_ID::int64 id_;
   ::PROTOBUF_NAMESPACE_ID::uint64 create_time_;
   ::PROTOBUF_NAMESPACE_ID::int32 shards_num_;
+  int consistency_level_;
+  ::PROTOBUF_NAMESPACE_ID::int64 schema_version_;
   mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_etcd_5fmeta_2eproto;
 };
 // -------------------------------------------------------------------
 
-class SegmentIndexInfo :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.etcd.SegmentIndexInfo) */ {
+class DatabaseInfo :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.etcd.DatabaseInfo) */ {
  public:
-  SegmentIndexInfo();
-  virtual ~SegmentIndexInfo();
+  DatabaseInfo();
+  virtual ~DatabaseInfo();
 
-  SegmentIndexInfo(const SegmentIndexInfo& from);
-  SegmentIndexInfo(SegmentIndexInfo&& from) noexcept
-    : SegmentIndexInfo() {
+  DatabaseInfo(const DatabaseInfo& from);
+  DatabaseInfo(DatabaseInfo&& from) noexcept
+    : DatabaseInfo() {
     *this = ::std::move(from);
   }
 
-  inline SegmentIndexInfo& operator=(const SegmentIndexInfo& from) {
+  inline DatabaseInfo& operator=(const DatabaseInfo& from) {
     CopyFrom(from);
     return *this;
   }
-  inline SegmentIndexInfo& operator=(SegmentIndexInfo&& from) noexcept {
+  inline DatabaseInfo& operator=(DatabaseInfo&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -1023,37 +1066,37 @@ class SegmentIndexInfo :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return GetMetadataStatic().reflection;
   }
-  static const SegmentIndexInfo& default_instance();
+  static const DatabaseInfo& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const SegmentIndexInfo* internal_default_instance() {
-    return reinterpret_cast<const SegmentIndexInfo*>(
-               &_SegmentIndexInfo_default_instance_);
+  static inline const DatabaseInfo* internal_default_instance() {
+    return reinterpret_cast<const DatabaseInfo*>(
+               &_DatabaseInfo_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
     5;
 
-  friend void swap(SegmentIndexInfo& a, SegmentIndexInfo& b) {
+  friend void swap(DatabaseInfo& a, DatabaseInfo& b) {
     a.Swap(&b);
   }
-  inline void Swap(SegmentIndexInfo* other) {
+  inline void Swap(DatabaseInfo* other) {
     if (other == this) return;
     InternalSwap(other);
   }
 
   // implements Message ----------------------------------------------
 
-  inline SegmentIndexInfo* New() const final {
-    return CreateMaybeMessage<SegmentIndexInfo>(nullptr);
+  inline DatabaseInfo* New() const final {
+    return CreateMaybeMessage<DatabaseInfo>(nullptr);
   }
 
-  SegmentIndexInfo* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
-    return CreateMaybeMessage<SegmentIndexInfo>(arena);
+  DatabaseInfo* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<DatabaseInfo>(arena);
   }
   void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
   void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
-  void CopyFrom(const SegmentIndexInfo& from);
-  void MergeFrom(const SegmentIndexInfo& from);
+  void CopyFrom(const DatabaseInfo& from);
+  void MergeFrom(const DatabaseInfo& from);
   PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
   bool IsInitialized() const final;
 
@@ -1074,10 +1117,10 @@ class SegmentIndexInfo :
   inline void SharedCtor();
   inline void SharedDtor();
   void SetCachedSize(int size) const final;
-  void InternalSwap(SegmentIndexInfo* other);
+  void InternalSwap(DatabaseInfo* other);
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "milvus.proto.etcd.SegmentIndexInfo";
+    return "milvus.proto.etcd.DatabaseInfo";
   }
   private:
   inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
@@ -1102,83 +1145,61 @@ class SegmentIndexInfo :
   // accessors -------------------------------------------------------
 
   enum : int {
-    kCollectionIDFieldNumber = 1,
-    kPartitionIDFieldNumber = 2,
-    kSegmentIDFieldNumber = 3,
-    kFieldIDFieldNumber = 4,
-    kIndexIDFieldNumber = 5,
-    kBuildIDFieldNumber = 6,
-    kEnableIndexFieldNumber = 7,
+    kNameFieldNumber = 2,
+    kIDFieldNumber = 1,
+    kCreateTimeFieldNumber = 3,
   };
-  // int64 collectionID = 1;
-  void clear_collectionid();
-  ::PROTOBUF_NAMESPACE_ID::int64 collectionid() const;
-  void set_collectionid(::PROTOBUF_NAMESPACE_ID::int64 value);
-
-  // int64 partitionID = 2;
-  void clear_partitionid();
-  ::PROTOBUF_NAMESPACE_ID::int64 partitionid() const;
-  void set_partitionid(::PROTOBUF_NAMESPACE_ID::int64 value);
-
-  // int64 segmentID = 3;
-  void clear_segmentid();
-  ::PROTOBUF_NAMESPACE_ID::int64 segmentid() const;
-  void set_segmentid(::PROTOBUF_NAMESPACE_ID::int64 value);
-
-  // int64 fieldID = 4;
-  void clear_fieldid();
-  ::PROTOBUF_NAMESPACE_ID::int64 fieldid() const;
-  void set_fieldid(::PROTOBUF_NAMESPACE_ID::int64 value);
-
-  // int64 indexID = 5;
-  void clear_indexid();
-  ::PROTOBUF_NAMESPACE_ID::int64 indexid() const;
-  void set_indexid(::PROTOBUF_NAMESPACE_ID::int64 value);
+  // string name = 2;
+  void clear_name();
+  const std::string& name() const;
+  void set_name(const std::string& value);
+  void set_name(std::string&& value);
+  void set_name(const char* value);
+  void set_name(const char* value, size_t size);
+  std::string* mutable_name();
+  std::string* release_name();
+  void set_allocated_name(std::string* name);
 
-  // int64 buildID = 6;
-  void clear_buildid();
-  ::PROTOBUF_NAMESPACE_ID::int64 buildid() const;
-  void set_buildid(::PROTOBUF_NAMESPACE_ID::int64 value);
+  // int64 ID = 1;
+  void clear_id();
+  ::PROTOBUF_NAMESPACE_ID::int64 id() const;
+  void set_id(::PROTOBUF_NAMESPACE_ID::int64 value);
 
-  // bool enable_index = 7;
-  void clear_enable_index();
-  bool enable_index() const;
-  void set_enable_index(bool value);
+  // uint64 create_time = 3;
+  void clear_create_time();
+  ::PROTOBUF_NAMESPACE_ID::uint64 create_time() const;
+  void set_create_time(::PROTOBUF_NAMESPACE_ID::uint64 value);
 
-  // @@protoc_insertion_point(class_scope:milvus.proto.etcd.SegmentIndexInfo)
+  // @@protoc_insertion_point(class_scope:milvus.proto.etcd.DatabaseInfo)
  private:
   class _Internal;
 
   ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
-  ::PROTOBUF_NAMESPACE_ID::int64 collectionid_;
-  ::PROTOBUF_NAMESPACE_ID::int64 partitionid_;
-  ::PROTOBUF_NAMESPACE_ID::int64 segmentid_;
-  ::PROTOBUF_NAMESPACE_ID::int64 fieldid_;
-  ::PROTOBUF_NAMESPACE_ID::int64 indexid_;
-  ::PROTOBUF_NAMESPACE_ID::int64 buildid_;
-  bool enable_index_;
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr name_;
+  ::PROTOBUF_NAMESPACE_ID::int64 id_;
+  ::PROTOBUF_NAMESPACE_ID::uint64 create_time_;
   mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_etcd_5fmeta_2eproto;
 };
 // -------------------------------------------------------------------
 
-class CollectionMeta :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.etcd.CollectionMeta) */ {
+class CredentialInfo :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.etcd.CredentialInfo) */ {
  public:
-  CollectionMeta();
-  virtual ~CollectionMeta();
+  CredentialInfo();
+  virtual ~CredentialInfo();
 
-  CollectionMeta(const CollectionMeta& from);
-  CollectionMeta(CollectionMeta&& from) noexcept
-    : CollectionMeta() {
+  CredentialInfo(const CredentialInfo& from);
+  CredentialInfo(CredentialInfo&& from) noexcept
+    : CredentialInfo() {
     *this = ::std::move(from);
   }
 
-  inline CollectionMeta& operator=(const CollectionMeta& from) {
+  inline CredentialInfo& operator=(const CredentialInfo& from) {
     CopyFrom(from);
     return *this;
   }
-  inline CollectionMeta& operator=(CollectionMeta&& from) noexcept {
+  inline CredentialInfo& operator=(CredentialInfo&& from) noexcept {
     if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
       if (this != &from) InternalSwap(&from);
     } else {
@@ -1196,37 +1217,37 @@ class CollectionMeta :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return GetMetadataStatic().reflection;
   }
-  static const CollectionMeta& default_instance();
+  static const CredentialInfo& default_instance();
 
   static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
-  static inline const CollectionMeta* internal_default_instance() {
-    return reinterpret_cast<const CollectionMeta*>(
-               &_CollectionMeta_default_instance_);
+  static inline const CredentialInfo* internal_default_instance() {
+    return reinterpret_cast<const CredentialInfo*>(
+               &_CredentialInfo_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
     6;
 
-  friend void swap(CollectionMeta& a, CollectionMeta& b) {
+  friend void swap(CredentialInfo& a, CredentialInfo& b) {
     a.Swap(&b);
   }
-  inline void Swap(CollectionMeta* other) {
+  inline void Swap(CredentialInfo* other) {
     if (other == this) return;
     InternalSwap(other);
   }
 
   // implements Message ----------------------------------------------
 
-  inline CollectionMeta* New() const final {
-    return CreateMaybeMessage<CollectionMeta>(nullptr);
+  inline CredentialInfo* New() const final {
+    return CreateMaybeMessage<CredentialInfo>(nullptr);
   }
 
-  CollectionMeta* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
-    return CreateMaybeMessage<CollectionMeta>(arena);
+  CredentialInfo* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<CredentialInfo>(arena);
   }
   void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
   void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
-  void CopyFrom(const CollectionMeta& from);
-  void MergeFrom(const CollectionMeta& from);
+  void CopyFrom(const CredentialInfo& from);
+  void MergeFrom(const CredentialInfo& from);
   PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
   bool IsInitialized() const final;
 
@@ -1247,10 +1268,10 @@ class CollectionMeta :
   inline void SharedCtor();
   inline void SharedDtor();
   void SetCachedSize(int size) const final;
-  void InternalSwap(CollectionMeta* other);
+  void InternalSwap(CredentialInfo* other);
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "milvus.proto.etcd.CollectionMeta";
+    return "milvus.proto.etcd.CredentialInfo";
   }
   private:
   inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
@@ -1275,898 +1296,2212 @@ class CollectionMeta :
   // accessors -------------------------------------------------------
 
   enum : int {
-    kSegmentIDsFieldNumber = 4,
-    kPartitionTagsFieldNumber = 5,
-    kPartitionIDsFieldNumber = 6,
-    kSchemaFieldNumber = 2,
+    kRolesFieldNumber = 4,
+    kUsernameFieldNumber = 2,
+    kEncryptedPasswordFieldNumber = 3,
     kIDFieldNumber = 1,
-    kCreateTimeFieldNumber = 3,
+    kCreateTimeFieldNumber = 5,
   };
-  // repeated int64 segmentIDs = 4;
-  int segmentids_size() const;
-  void clear_segmentids();
-  ::PROTOBUF_NAMESPACE_ID::int64 segmentids(int index) const;
-  void set_segmentids(int index, ::PROTOBUF_NAMESPACE_ID::int64 value);
-  void add_segmentids(::PROTOBUF_NAMESPACE_ID::int64 value);
-  const ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >&
-      segmentids() const;
-  ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >*
-      mutable_segmentids();
-
-  // repeated string partition_tags = 5;
-  int partition_tags_size() const;
-  void clear_partition_tags();
-  const std::string& partition_tags(int index) const;
-  std::string* mutable_partition_tags(int index);
-  void set_partition_tags(int index, const std::string& value);
-  void set_partition_tags(int index, std::string&& value);
-  void set_partition_tags(int index, const char* value);
-  void set_partition_tags(int index, const char* value, size_t size);
-  std::string* add_partition_tags();
-  void add_partition_tags(const std::string& value);
-  void add_partition_tags(std::string&& value);
-  void add_partition_tags(const char* value);
-  void add_partition_tags(const char* value, size_t size);
-  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>& partition_tags() const;
-  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>* mutable_partition_tags();
-
-  // repeated int64 partitionIDs = 6;
-  int partitionids_size() const;
-  void clear_partitionids();
-  ::PROTOBUF_NAMESPACE_ID::int64 partitionids(int index) const;
-  void set_partitionids(int index, ::PROTOBUF_NAMESPACE_ID::int64 value);
-  void add_partitionids(::PROTOBUF_NAMESPACE_ID::int64 value);
-  const ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >&
-      partitionids() const;
-  ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >*
-      mutable_partitionids();
-
-  // .milvus.proto.schema.CollectionSchema schema = 2;
-  bool has_schema() const;
-  void clear_schema();
-  const ::milvus::proto::schema::CollectionSchema& schema() const;
-  ::milvus::proto::schema::CollectionSchema* release_schema();
-  ::milvus::proto::schema::CollectionSchema* mutable_schema();
-  void set_allocated_schema(::milvus::proto::schema::CollectionSchema* schema);
+  // repeated string roles = 4;
+  int roles_size() const;
+  void clear_roles();
+  const std::string& roles(int index) const;
+  std::string* mutable_roles(int index);
+  void set_roles(int index, const std::string& value);
+  void set_roles(int index, std::string&& value);
+  void set_roles(int index, const char* value);
+  void set_roles(int index, const char* value, size_t size);
+  std::string* add_roles();
+  void add_roles(const std::string& value);
+  void add_roles(std::string&& value);
+  void add_roles(const char* value);
+  void add_roles(const char* value, size_t size);
+  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>& roles() const;
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>* mutable_roles();
+
+  // string username = 2;
+  void clear_username();
+  const std::string& username() const;
+  void set_username(const std::string& value);
+  void set_username(std::string&& value);
+  void set_username(const char* value);
+  void set_username(const char* value, size_t size);
+  std::string* mutable_username();
+  std::string* release_username();
+  void set_allocated_username(std::string* username);
+
+  // string encrypted_password = 3;
+  void clear_encrypted_password();
+  const std::string& encrypted_password() const;
+  void set_encrypted_password(const std::string& value);
+  void set_encrypted_password(std::string&& value);
+  void set_encrypted_password(const char* value);
+  void set_encrypted_password(const char* value, size_t size);
+  std::string* mutable_encrypted_password();
+  std::string* release_encrypted_password();
+  void set_allocated_encrypted_password(std::string* encrypted_password);
 
   // int64 ID = 1;
   void clear_id();
   ::PROTOBUF_NAMESPACE_ID::int64 id() const;
   void set_id(::PROTOBUF_NAMESPACE_ID::int64 value);
 
-  // uint64 create_time = 3;
+  // uint64 create_time = 5;
   void clear_create_time();
   ::PROTOBUF_NAMESPACE_ID::uint64 create_time() const;
   void set_create_time(::PROTOBUF_NAMESPACE_ID::uint64 value);
 
-  // @@protoc_insertion_point(class_scope:milvus.proto.etcd.CollectionMeta)
+  // @@protoc_insertion_point(class_scope:milvus.proto.etcd.CredentialInfo)
  private:
   class _Internal;
 
   ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
-  ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 > segmentids_;
-  mutable std::atomic<int> _segmentids_cached_byte_size_;
-  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string> partition_tags_;
-  ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 > partitionids_;
-  mutable std::atomic<int> _partitionids_cached_byte_size_;
-  ::milvus::proto::schema::CollectionSchema* schema_;
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string> roles_;
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr username_;
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr encrypted_password_;
   ::PROTOBUF_NAMESPACE_ID::int64 id_;
   ::PROTOBUF_NAMESPACE_ID::uint64 create_time_;
   mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_etcd_5fmeta_2eproto;
 };
-// ===================================================================
+// -------------------------------------------------------------------
 
+class RoleInfo :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.etcd.RoleInfo) */ {
+ public:
+  RoleInfo();
+  virtual ~RoleInfo();
 
-// ===================================================================
+  RoleInfo(const RoleInfo& from);
+  RoleInfo(RoleInfo&& from) noexcept
+    : RoleInfo() {
+    *this = ::std::move(from);
+  }
 
-#ifdef __GNUC__
-  #pragma GCC diagnostic push
-  #pragma GCC diagnostic ignored "-Wstrict-aliasing"
-#endif  // __GNUC__
-// TenantMeta
+  inline RoleInfo& operator=(const RoleInfo& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline RoleInfo& operator=(RoleInfo&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
 
-// int64 ID = 1;
-inline void TenantMeta::clear_id() {
-  id_ = PROTOBUF_LONGLONG(0);
-}
-inline ::PROTOBUF_NAMESPACE_ID::int64 TenantMeta::id() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.TenantMeta.ID)
-  return id_;
-}
-inline void TenantMeta::set_id(::PROTOBUF_NAMESPACE_ID::int64 value) {
-  
-  id_ = value;
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.TenantMeta.ID)
-}
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return GetMetadataStatic().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return GetMetadataStatic().reflection;
+  }
+  static const RoleInfo& default_instance();
 
-// int64 num_query_nodes = 2;
-inline void TenantMeta::clear_num_query_nodes() {
-  num_query_nodes_ = PROTOBUF_LONGLONG(0);
-}
-inline ::PROTOBUF_NAMESPACE_ID::int64 TenantMeta::num_query_nodes() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.TenantMeta.num_query_nodes)
-  return num_query_nodes_;
-}
-inline void TenantMeta::set_num_query_nodes(::PROTOBUF_NAMESPACE_ID::int64 value) {
-  
-  num_query_nodes_ = value;
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.TenantMeta.num_query_nodes)
-}
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const RoleInfo* internal_default_instance() {
+    return reinterpret_cast<const RoleInfo*>(
+               &_RoleInfo_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    7;
 
-// repeated string insert_channelIDs = 3;
-inline int TenantMeta::insert_channelids_size() const {
-  return insert_channelids_.size();
-}
-inline void TenantMeta::clear_insert_channelids() {
-  insert_channelids_.Clear();
-}
-inline const std::string& TenantMeta::insert_channelids(int index) const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.TenantMeta.insert_channelIDs)
-  return insert_channelids_.Get(index);
-}
-inline std::string* TenantMeta::mutable_insert_channelids(int index) {
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.TenantMeta.insert_channelIDs)
-  return insert_channelids_.Mutable(index);
-}
-inline void TenantMeta::set_insert_channelids(int index, const std::string& value) {
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.TenantMeta.insert_channelIDs)
-  insert_channelids_.Mutable(index)->assign(value);
-}
-inline void TenantMeta::set_insert_channelids(int index, std::string&& value) {
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.TenantMeta.insert_channelIDs)
-  insert_channelids_.Mutable(index)->assign(std::move(value));
-}
-inline void TenantMeta::set_insert_channelids(int index, const char* value) {
-  GOOGLE_DCHECK(value != nullptr);
-  insert_channelids_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.TenantMeta.insert_channelIDs)
-}
-inline void TenantMeta::set_insert_channelids(int index, const char* value, size_t size) {
-  insert_channelids_.Mutable(index)->assign(
+  friend void swap(RoleInfo& a, RoleInfo& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(RoleInfo* other) {
+    if (other == this) return;
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline RoleInfo* New() const final {
+    return CreateMaybeMessage<RoleInfo>(nullptr);
+  }
+
+  RoleInfo* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<RoleInfo>(arena);
+  }
+  void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void CopyFrom(const RoleInfo& from);
+  void MergeFrom(const RoleInfo& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  #else
+  bool MergePartialFromCodedStream(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const final;
+  ::PROTOBUF_NAMESPACE_ID::uint8* InternalSerializeWithCachedSizesToArray(
+      ::PROTOBUF_NAMESPACE_ID::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  inline void SharedCtor();
+  inline void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(RoleInfo* other);
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "milvus.proto.etcd.RoleInfo";
+  }
+  private:
+  inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+  private:
+  static ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadataStatic() {
+    ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&::descriptor_table_etcd_5fmeta_2eproto);
+    return ::descriptor_table_etcd_5fmeta_2eproto.file_level_metadata[kIndexInFileMessages];
+  }
+
+  public:
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kGrantsFieldNumber = 3,
+    kNameFieldNumber = 2,
+    kIDFieldNumber = 1,
+    kCreateTimeFieldNumber = 4,
+  };
+  // repeated .milvus.proto.etcd.GrantInfo grants = 3;
+  int grants_size() const;
+  void clear_grants();
+  ::milvus::proto::etcd::GrantInfo* mutable_grants(int index);
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::etcd::GrantInfo >*
+      mutable_grants();
+  const ::milvus::proto::etcd::GrantInfo& grants(int index) const;
+  ::milvus::proto::etcd::GrantInfo* add_grants();
+  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::etcd::GrantInfo >&
+      grants() const;
+
+  // string name = 2;
+  void clear_name();
+  const std::string& name() const;
+  void set_name(const std::string& value);
+  void set_name(std::string&& value);
+  void set_name(const char* value);
+  void set_name(const char* value, size_t size);
+  std::string* mutable_name();
+  std::string* release_name();
+  void set_allocated_name(std::string* name);
+
+  // int64 ID = 1;
+  void clear_id();
+  ::PROTOBUF_NAMESPACE_ID::int64 id() const;
+  void set_id(::PROTOBUF_NAMESPACE_ID::int64 value);
+
+  // uint64 create_time = 4;
+  void clear_create_time();
+  ::PROTOBUF_NAMESPACE_ID::uint64 create_time() const;
+  void set_create_time(::PROTOBUF_NAMESPACE_ID::uint64 value);
+
+  // @@protoc_insertion_point(class_scope:milvus.proto.etcd.RoleInfo)
+ private:
+  class _Internal;
+
+  ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::etcd::GrantInfo > grants_;
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr name_;
+  ::PROTOBUF_NAMESPACE_ID::int64 id_;
+  ::PROTOBUF_NAMESPACE_ID::uint64 create_time_;
+  mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_etcd_5fmeta_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GrantInfo :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.etcd.GrantInfo) */ {
+ public:
+  GrantInfo();
+  virtual ~GrantInfo();
+
+  GrantInfo(const GrantInfo& from);
+  GrantInfo(GrantInfo&& from) noexcept
+    : GrantInfo() {
+    *this = ::std::move(from);
+  }
+
+  inline GrantInfo& operator=(const GrantInfo& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GrantInfo& operator=(GrantInfo&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return GetMetadataStatic().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return GetMetadataStatic().reflection;
+  }
+  static const GrantInfo& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const GrantInfo* internal_default_instance() {
+    return reinterpret_cast<const GrantInfo*>(
+               &_GrantInfo_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    8;
+
+  friend void swap(GrantInfo& a, GrantInfo& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GrantInfo* other) {
+    if (other == this) return;
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline GrantInfo* New() const final {
+    return CreateMaybeMessage<GrantInfo>(nullptr);
+  }
+
+  GrantInfo* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<GrantInfo>(arena);
+  }
+  void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void CopyFrom(const GrantInfo& from);
+  void MergeFrom(const GrantInfo& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  #else
+  bool MergePartialFromCodedStream(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const final;
+  ::PROTOBUF_NAMESPACE_ID::uint8* InternalSerializeWithCachedSizesToArray(
+      ::PROTOBUF_NAMESPACE_ID::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  inline void SharedCtor();
+  inline void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GrantInfo* other);
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "milvus.proto.etcd.GrantInfo";
+  }
+  private:
+  inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+  private:
+  static ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadataStatic() {
+    ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&::descriptor_table_etcd_5fmeta_2eproto);
+    return ::descriptor_table_etcd_5fmeta_2eproto.file_level_metadata[kIndexInFileMessages];
+  }
+
+  public:
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kDbNameFieldNumber = 1,
+    kObjectNameFieldNumber = 2,
+    kPrivilegeFieldNumber = 3,
+  };
+  // string db_name = 1;
+  void clear_db_name();
+  const std::string& db_name() const;
+  void set_db_name(const std::string& value);
+  void set_db_name(std::string&& value);
+  void set_db_name(const char* value);
+  void set_db_name(const char* value, size_t size);
+  std::string* mutable_db_name();
+  std::string* release_db_name();
+  void set_allocated_db_name(std::string* db_name);
+
+  // string object_name = 2;
+  void clear_object_name();
+  const std::string& object_name() const;
+  void set_object_name(const std::string& value);
+  void set_object_name(std::string&& value);
+  void set_object_name(const char* value);
+  void set_object_name(const char* value, size_t size);
+  std::string* mutable_object_name();
+  std::string* release_object_name();
+  void set_allocated_object_name(std::string* object_name);
+
+  // string privilege = 3;
+  void clear_privilege();
+  const std::string& privilege() const;
+  void set_privilege(const std::string& value);
+  void set_privilege(std::string&& value);
+  void set_privilege(const char* value);
+  void set_privilege(const char* value, size_t size);
+  std::string* mutable_privilege();
+  std::string* release_privilege();
+  void set_allocated_privilege(std::string* privilege);
+
+  // @@protoc_insertion_point(class_scope:milvus.proto.etcd.GrantInfo)
+ private:
+  class _Internal;
+
+  ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr db_name_;
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr object_name_;
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr privilege_;
+  mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_etcd_5fmeta_2eproto;
+};
+// -------------------------------------------------------------------
+
+class SegmentIndexInfo :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.etcd.SegmentIndexInfo) */ {
+ public:
+  SegmentIndexInfo();
+  virtual ~SegmentIndexInfo();
+
+  SegmentIndexInfo(const SegmentIndexInfo& from);
+  SegmentIndexInfo(SegmentIndexInfo&& from) noexcept
+    : SegmentIndexInfo() {
+    *this = ::std::move(from);
+  }
+
+  inline SegmentIndexInfo& operator=(const SegmentIndexInfo& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline SegmentIndexInfo& operator=(SegmentIndexInfo&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return GetMetadataStatic().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return GetMetadataStatic().reflection;
+  }
+  static const SegmentIndexInfo& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const SegmentIndexInfo* internal_default_instance() {
+    return reinterpret_cast<const SegmentIndexInfo*>(
+               &_SegmentIndexInfo_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    9;
+
+  friend void swap(SegmentIndexInfo& a, SegmentIndexInfo& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(SegmentIndexInfo* other) {
+    if (other == this) return;
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline SegmentIndexInfo* New() const final {
+    return CreateMaybeMessage<SegmentIndexInfo>(nullptr);
+  }
+
+  SegmentIndexInfo* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<SegmentIndexInfo>(arena);
+  }
+  void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void CopyFrom(const SegmentIndexInfo& from);
+  void MergeFrom(const SegmentIndexInfo& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  #else
+  bool MergePartialFromCodedStream(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const final;
+  ::PROTOBUF_NAMESPACE_ID::uint8* InternalSerializeWithCachedSizesToArray(
+      ::PROTOBUF_NAMESPACE_ID::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  inline void SharedCtor();
+  inline void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(SegmentIndexInfo* other);
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "milvus.proto.etcd.SegmentIndexInfo";
+  }
+  private:
+  inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+  private:
+  static ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadataStatic() {
+    ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&::descriptor_table_etcd_5fmeta_2eproto);
+    return ::descriptor_table_etcd_5fmeta_2eproto.file_level_metadata[kIndexInFileMessages];
+  }
+
+  public:
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kCollectionIDFieldNumber = 1,
+    kPartitionIDFieldNumber = 2,
+    kSegmentIDFieldNumber = 3,
+    kFieldIDFieldNumber = 4,
+    kIndexIDFieldNumber = 5,
+    kBuildIDFieldNumber = 6,
+    kEnableIndexFieldNumber = 7,
+  };
+  // int64 collectionID = 1;
+  void clear_collectionid();
+  ::PROTOBUF_NAMESPACE_ID::int64 collectionid() const;
+  void set_collectionid(::PROTOBUF_NAMESPACE_ID::int64 value);
+
+  // int64 partitionID = 2;
+  void clear_partitionid();
+  ::PROTOBUF_NAMESPACE_ID::int64 partitionid() const;
+  void set_partitionid(::PROTOBUF_NAMESPACE_ID::int64 value);
+
+  // int64 segmentID = 3;
+  void clear_segmentid();
+  ::PROTOBUF_NAMESPACE_ID::int64 segmentid() const;
+  void set_segmentid(::PROTOBUF_NAMESPACE_ID::int64 value);
+
+  // int64 fieldID = 4;
+  void clear_fieldid();
+  ::PROTOBUF_NAMESPACE_ID::int64 fieldid() const;
+  void set_fieldid(::PROTOBUF_NAMESPACE_ID::int64 value);
+
+  // int64 indexID = 5;
+  void clear_indexid();
+  ::PROTOBUF_NAMESPACE_ID::int64 indexid() const;
+  void set_indexid(::PROTOBUF_NAMESPACE_ID::int64 value);
+
+  // int64 buildID = 6;
+  void clear_buildid();
+  ::PROTOBUF_NAMESPACE_ID::int64 buildid() const;
+  void set_buildid(::PROTOBUF_NAMESPACE_ID::int64 value);
+
+  // bool enable_index = 7;
+  void clear_enable_index();
+  bool enable_index() const;
+  void set_enable_index(bool value);
+
+  // @@protoc_insertion_point(class_scope:milvus.proto.etcd.SegmentIndexInfo)
+ private:
+  class _Internal;
+
+  ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
+  ::PROTOBUF_NAMESPACE_ID::int64 collectionid_;
+  ::PROTOBUF_NAMESPACE_ID::int64 partitionid_;
+  ::PROTOBUF_NAMESPACE_ID::int64 segmentid_;
+  ::PROTOBUF_NAMESPACE_ID::int64 fieldid_;
+  ::PROTOBUF_NAMESPACE_ID::int64 indexid_;
+  ::PROTOBUF_NAMESPACE_ID::int64 buildid_;
+  bool enable_index_;
+  mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_etcd_5fmeta_2eproto;
+};
+// -------------------------------------------------------------------
+
+class CollectionMeta :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.etcd.CollectionMeta) */ {
+ public:
+  CollectionMeta();
+  virtual ~CollectionMeta();
+
+  CollectionMeta(const CollectionMeta& from);
+  CollectionMeta(CollectionMeta&& from) noexcept
+    : CollectionMeta() {
+    *this = ::std::move(from);
+  }
+
+  inline CollectionMeta& operator=(const CollectionMeta& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline CollectionMeta& operator=(CollectionMeta&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return GetMetadataStatic().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return GetMetadataStatic().reflection;
+  }
+  static const CollectionMeta& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const CollectionMeta* internal_default_instance() {
+    return reinterpret_cast<const CollectionMeta*>(
+               &_CollectionMeta_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    10;
+
+  friend void swap(CollectionMeta& a, CollectionMeta& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(CollectionMeta* other) {
+    if (other == this) return;
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline CollectionMeta* New() const final {
+    return CreateMaybeMessage<CollectionMeta>(nullptr);
+  }
+
+  CollectionMeta* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<CollectionMeta>(arena);
+  }
+  void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void CopyFrom(const CollectionMeta& from);
+  void MergeFrom(const CollectionMeta& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  #else
+  bool MergePartialFromCodedStream(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const final;
+  ::PROTOBUF_NAMESPACE_ID::uint8* InternalSerializeWithCachedSizesToArray(
+      ::PROTOBUF_NAMESPACE_ID::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  inline void SharedCtor();
+  inline void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(CollectionMeta* other);
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "milvus.proto.etcd.CollectionMeta";
+  }
+  private:
+  inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+  private:
+  static ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadataStatic() {
+    ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&::descriptor_table_etcd_5fmeta_2eproto);
+    return ::descriptor_table_etcd_5fmeta_2eproto.file_level_metadata[kIndexInFileMessages];
+  }
+
+  public:
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kSegmentIDsFieldNumber = 4,
+    kPartitionTagsFieldNumber = 5,
+    kPartitionIDsFieldNumber = 6,
+    kSchemaFieldNumber = 2,
+    kIDFieldNumber = 1,
+    kCreateTimeFieldNumber = 3,
+  };
+  // repeated int64 segmentIDs = 4;
+  int segmentids_size() const;
+  void clear_segmentids();
+  ::PROTOBUF_NAMESPACE_ID::int64 segmentids(int index) const;
+  void set_segmentids(int index, ::PROTOBUF_NAMESPACE_ID::int64 value);
+  void add_segmentids(::PROTOBUF_NAMESPACE_ID::int64 value);
+  const ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >&
+      segmentids() const;
+  ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >*
+      mutable_segmentids();
+
+  // repeated string partition_tags = 5;
+  int partition_tags_size() const;
+  void clear_partition_tags();
+  const std::string& partition_tags(int index) const;
+  std::string* mutable_partition_tags(int index);
+  void set_partition_tags(int index, const std::string& value);
+  void set_partition_tags(int index, std::string&& value);
+  void set_partition_tags(int index, const char* value);
+  void set_partition_tags(int index, const char* value, size_t size);
+  std::string* add_partition_tags();
+  void add_partition_tags(const std::string& value);
+  void add_partition_tags(std::string&& value);
+  void add_partition_tags(const char* value);
+  void add_partition_tags(const char* value, size_t size);
+  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>& partition_tags() const;
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>* mutable_partition_tags();
+
+  // repeated int64 partitionIDs = 6;
+  int partitionids_size() const;
+  void clear_partitionids();
+  ::PROTOBUF_NAMESPACE_ID::int64 partitionids(int index) const;
+  void set_partitionids(int index, ::PROTOBUF_NAMESPACE_ID::int64 value);
+  void add_partitionids(::PROTOBUF_NAMESPACE_ID::int64 value);
+  const ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >&
+      partitionids() const;
+  ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >*
+      mutable_partitionids();
+
+  // .milvus.proto.schema.CollectionSchema schema = 2;
+  bool has_schema() const;
+  void clear_schema();
+  const ::milvus::proto::schema::CollectionSchema& schema() const;
+  ::milvus::proto::schema::CollectionSchema* release_schema();
+  ::milvus::proto::schema::CollectionSchema* mutable_schema();
+  void set_allocated_schema(::milvus::proto::schema::CollectionSchema* schema);
+
+  // int64 ID = 1;
+  void clear_id();
+  ::PROTOBUF_NAMESPACE_ID::int64 id() const;
+  void set_id(::PROTOBUF_NAMESPACE_ID::int64 value);
+
+  // uint64 create_time = 3;
+  void clear_create_time();
+  ::PROTOBUF_NAMESPACE_ID::uint64 create_time() const;
+  void set_create_time(::PROTOBUF_NAMESPACE_ID::uint64 value);
+
+  // @@protoc_insertion_point(class_scope:milvus.proto.etcd.CollectionMeta)
+ private:
+  class _Internal;
+
+  ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
+  ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 > segmentids_;
+  mutable std::atomic<int> _segmentids_cached_byte_size_;
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string> partition_tags_;
+  ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 > partitionids_;
+  mutable std::atomic<int> _partitionids_cached_byte_size_;
+  ::milvus::proto::schema::CollectionSchema* schema_;
+  ::PROTOBUF_NAMESPACE_ID::int64 id_;
+  ::PROTOBUF_NAMESPACE_ID::uint64 create_time_;
+  mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_etcd_5fmeta_2eproto;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+#ifdef __GNUC__
+  #pragma GCC diagnostic push
+  #pragma GCC diagnostic ignored "-Wstrict-aliasing"
+#endif  // __GNUC__
+// TenantMeta
+
+// int64 ID = 1;
+inline void TenantMeta::clear_id() {
+  id_ = PROTOBUF_LONGLONG(0);
+}
+inline ::PROTOBUF_NAMESPACE_ID::int64 TenantMeta::id() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.TenantMeta.ID)
+  return id_;
+}
+inline void TenantMeta::set_id(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  id_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.TenantMeta.ID)
+}
+
+// int64 num_query_nodes = 2;
+inline void TenantMeta::clear_num_query_nodes() {
+  num_query_nodes_ = PROTOBUF_LONGLONG(0);
+}
+inline ::PROTOBUF_NAMESPACE_ID::int64 TenantMeta::num_query_nodes() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.TenantMeta.num_query_nodes)
+  return num_query_nodes_;
+}
+inline void TenantMeta::set_num_query_nodes(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  num_query_nodes_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.TenantMeta.num_query_nodes)
+}
+
+// repeated string insert_channelIDs = 3;
+inline int TenantMeta::insert_channelids_size() const {
+  return insert_channelids_.size();
+}
+inline void TenantMeta::clear_insert_channelids() {
+  insert_channelids_.Clear();
+}
+inline const std::string& TenantMeta::insert_channelids(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+  return insert_channelids_.Get(index);
+}
+inline std::string* TenantMeta::mutable_insert_channelids(int index) {
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+  return insert_channelids_.Mutable(index);
+}
+inline void TenantMeta::set_insert_channelids(int index, const std::string& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+  insert_channelids_.Mutable(index)->assign(value);
+}
+inline void TenantMeta::set_insert_channelids(int index, std::string&& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+  insert_channelids_.Mutable(index)->assign(std::move(value));
+}
+inline void TenantMeta::set_insert_channelids(int index, const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  insert_channelids_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+}
+inline void TenantMeta::set_insert_channelids(int index, const char* value, size_t size) {
+  insert_channelids_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+}
+inline std::string* TenantMeta::add_insert_channelids() {
+  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+  return insert_channelids_.Add();
+}
+inline void TenantMeta::add_insert_channelids(const std::string& value) {
+  insert_channelids_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+}
+inline void TenantMeta::add_insert_channelids(std::string&& value) {
+  insert_channelids_.Add(std::move(value));
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+}
+inline void TenantMeta::add_insert_channelids(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  insert_channelids_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+}
+inline void TenantMeta::add_insert_channelids(const char* value, size_t size) {
+  insert_channelids_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>&
+TenantMeta::insert_channelids() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+  return insert_channelids_;
+}
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>*
+TenantMeta::mutable_insert_channelids() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+  return &insert_channelids_;
+}
+
+// string query_channelID = 4;
+inline void TenantMeta::clear_query_channelid() {
+  query_channelid_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline const std::string& TenantMeta::query_channelid() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.TenantMeta.query_channelID)
+  return query_channelid_.GetNoArena();
+}
+inline void TenantMeta::set_query_channelid(const std::string& value) {
+  
+  query_channelid_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.TenantMeta.query_channelID)
+}
+inline void TenantMeta::set_query_channelid(std::string&& value) {
+  
+  query_channelid_.SetNoArena(
+    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.TenantMeta.query_channelID)
+}
+inline void TenantMeta::set_query_channelid(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  query_channelid_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.TenantMeta.query_channelID)
+}
+inline void TenantMeta::set_query_channelid(const char* value, size_t size) {
+  
+  query_channelid_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.TenantMeta.query_channelID)
+}
+inline std::string* TenantMeta::mutable_query_channelid() {
+  
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.TenantMeta.query_channelID)
+  return query_channelid_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline std::string* TenantMeta::release_query_channelid() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.TenantMeta.query_channelID)
+  
+  return query_channelid_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline void TenantMeta::set_allocated_query_channelid(std::string* query_channelid) {
+  if (query_channelid != nullptr) {
+    
+  } else {
+    
+  }
+  query_channelid_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), query_channelid);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.TenantMeta.query_channelID)
+}
+
+// -------------------------------------------------------------------
+
+// ProxyMeta
+
+// int64 ID = 1;
+inline void ProxyMeta::clear_id() {
+  id_ = PROTOBUF_LONGLONG(0);
+}
+inline ::PROTOBUF_NAMESPACE_ID::int64 ProxyMeta::id() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.ProxyMeta.ID)
+  return id_;
+}
+inline void ProxyMeta::set_id(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  id_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.ProxyMeta.ID)
+}
+
+// .milvus.proto.common.Address address = 2;
+inline bool ProxyMeta::has_address() const {
+  return this != internal_default_instance() && address_ != nullptr;
+}
+inline const ::milvus::proto::common::Address& ProxyMeta::address() const {
+  const ::milvus::proto::common::Address* p = address_;
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.ProxyMeta.address)
+  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::common::Address*>(
+      &::milvus::proto::common::_Address_default_instance_);
+}
+inline ::milvus::proto::common::Address* ProxyMeta::release_address() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.ProxyMeta.address)
+  
+  ::milvus::proto::common::Address* temp = address_;
+  address_ = nullptr;
+  return temp;
+}
+inline ::milvus::proto::common::Address* ProxyMeta::mutable_address() {
+  
+  if (address_ == nullptr) {
+    auto* p = CreateMaybeMessage<::milvus::proto::common::Address>(GetArenaNoVirtual());
+    address_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.ProxyMeta.address)
+  return address_;
+}
+inline void ProxyMeta::set_allocated_address(::milvus::proto::common::Address* address) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete reinterpret_cast< ::PROTOBUF_NAMESPACE_ID::MessageLite*>(address_);
+  }
+  if (address) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      address = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, address, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  address_ = address;
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.ProxyMeta.address)
+}
+
+// repeated string result_channelIDs = 3;
+inline int ProxyMeta::result_channelids_size() const {
+  return result_channelids_.size();
+}
+inline void ProxyMeta::clear_result_channelids() {
+  result_channelids_.Clear();
+}
+inline const std::string& ProxyMeta::result_channelids(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+  return result_channelids_.Get(index);
+}
+inline std::string* ProxyMeta::mutable_result_channelids(int index) {
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+  return result_channelids_.Mutable(index);
+}
+inline void ProxyMeta::set_result_channelids(int index, const std::string& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+  result_channelids_.Mutable(index)->assign(value);
+}
+inline void ProxyMeta::set_result_channelids(int index, std::string&& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+  result_channelids_.Mutable(index)->assign(std::move(value));
+}
+inline void ProxyMeta::set_result_channelids(int index, const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  result_channelids_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+}
+inline void ProxyMeta::set_result_channelids(int index, const char* value, size_t size) {
+  result_channelids_.Mutable(index)->assign(
     reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.ProxyMeta.result_channelIDs)
 }
-inline std::string* TenantMeta::add_insert_channelids() {
-  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.TenantMeta.insert_channelIDs)
-  return insert_channelids_.Add();
+inline std::string* ProxyMeta::add_result_channelids() {
+  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+  return result_channelids_.Add();
 }
-inline void TenantMeta::add_insert_channelids(const std::string& value) {
-  insert_channelids_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+inline void ProxyMeta::add_result_channelids(const std::string& value) {
+  result_channelids_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.ProxyMeta.result_channelIDs)
 }
-inline void TenantMeta::add_insert_channelids(std::string&& value) {
-  insert_channelids_.Add(std::move(value));
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+inline void ProxyMeta::add_result_channelids(std::string&& value) {
+  result_channelids_.Add(std::move(value));
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.ProxyMeta.result_channelIDs)
 }
-inline void TenantMeta::add_insert_channelids(const char* value) {
+inline void ProxyMeta::add_result_channelids(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
-  insert_channelids_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+  result_channelids_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.ProxyMeta.result_channelIDs)
 }
-inline void TenantMeta::add_insert_channelids(const char* value, size_t size) {
-  insert_channelids_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.TenantMeta.insert_channelIDs)
+inline void ProxyMeta::add_result_channelids(const char* value, size_t size) {
+  result_channelids_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.ProxyMeta.result_channelIDs)
 }
 inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>&
-TenantMeta::insert_channelids() const {
-  // @@protoc_insertion_point(field_list:milvus.proto.etcd.TenantMeta.insert_channelIDs)
-  return insert_channelids_;
+ProxyMeta::result_channelids() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+  return result_channelids_;
 }
 inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>*
-TenantMeta::mutable_insert_channelids() {
-  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.TenantMeta.insert_channelIDs)
-  return &insert_channelids_;
+ProxyMeta::mutable_result_channelids() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+  return &result_channelids_;
+}
+
+// -------------------------------------------------------------------
+
+// IndexInfo
+
+// string index_name = 1;
+inline void IndexInfo::clear_index_name() {
+  index_name_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline const std::string& IndexInfo::index_name() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.IndexInfo.index_name)
+  return index_name_.GetNoArena();
+}
+inline void IndexInfo::set_index_name(const std::string& value) {
+  
+  index_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.IndexInfo.index_name)
+}
+inline void IndexInfo::set_index_name(std::string&& value) {
+  
+  index_name_.SetNoArena(
+    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.IndexInfo.index_name)
+}
+inline void IndexInfo::set_index_name(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  index_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.IndexInfo.index_name)
+}
+inline void IndexInfo::set_index_name(const char* value, size_t size) {
+  
+  index_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.IndexInfo.index_name)
+}
+inline std::string* IndexInfo::mutable_index_name() {
+  
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.IndexInfo.index_name)
+  return index_name_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline std::string* IndexInfo::release_index_name() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.IndexInfo.index_name)
+  
+  return index_name_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline void IndexInfo::set_allocated_index_name(std::string* index_name) {
+  if (index_name != nullptr) {
+    
+  } else {
+    
+  }
+  index_name_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), index_name);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.IndexInfo.index_name)
+}
+
+// int64 indexID = 2;
+inline void IndexInfo::clear_indexid() {
+  indexid_ = PROTOBUF_LONGLONG(0);
+}
+inline ::PROTOBUF_NAMESPACE_ID::int64 IndexInfo::indexid() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.IndexInfo.indexID)
+  return indexid_;
+}
+inline void IndexInfo::set_indexid(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  indexid_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.IndexInfo.indexID)
+}
+
+// repeated .milvus.proto.common.KeyValuePair index_params = 3;
+inline int IndexInfo::index_params_size() const {
+  return index_params_.size();
+}
+inline ::milvus::proto::common::KeyValuePair* IndexInfo::mutable_index_params(int index) {
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.IndexInfo.index_params)
+  return index_params_.Mutable(index);
+}
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::common::KeyValuePair >*
+IndexInfo::mutable_index_params() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.IndexInfo.index_params)
+  return &index_params_;
+}
+inline const ::milvus::proto::common::KeyValuePair& IndexInfo::index_params(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.IndexInfo.index_params)
+  return index_params_.Get(index);
+}
+inline ::milvus::proto::common::KeyValuePair* IndexInfo::add_index_params() {
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.IndexInfo.index_params)
+  return index_params_.Add();
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::common::KeyValuePair >&
+IndexInfo::index_params() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.IndexInfo.index_params)
+  return index_params_;
+}
+
+// -------------------------------------------------------------------
+
+// FieldIndexInfo
+
+// int64 filedID = 1;
+inline void FieldIndexInfo::clear_filedid() {
+  filedid_ = PROTOBUF_LONGLONG(0);
+}
+inline ::PROTOBUF_NAMESPACE_ID::int64 FieldIndexInfo::filedid() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.FieldIndexInfo.filedID)
+  return filedid_;
+}
+inline void FieldIndexInfo::set_filedid(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  filedid_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.FieldIndexInfo.filedID)
 }
 
-// string query_channelID = 4;
-inline void TenantMeta::clear_query_channelid() {
-  query_channelid_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+// int64 indexID = 2;
+inline void FieldIndexInfo::clear_indexid() {
+  indexid_ = PROTOBUF_LONGLONG(0);
 }
-inline const std::string& TenantMeta::query_channelid() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.TenantMeta.query_channelID)
-  return query_channelid_.GetNoArena();
+inline ::PROTOBUF_NAMESPACE_ID::int64 FieldIndexInfo::indexid() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.FieldIndexInfo.indexID)
+  return indexid_;
 }
-inline void TenantMeta::set_query_channelid(const std::string& value) {
+inline void FieldIndexInfo::set_indexid(::PROTOBUF_NAMESPACE_ID::int64 value) {
   
-  query_channelid_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.TenantMeta.query_channelID)
+  indexid_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.FieldIndexInfo.indexID)
 }
-inline void TenantMeta::set_query_channelid(std::string&& value) {
-  
-  query_channelid_.SetNoArena(
-    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.TenantMeta.query_channelID)
+
+// -------------------------------------------------------------------
+
+// CollectionInfo
+
+// int64 ID = 1;
+inline void CollectionInfo::clear_id() {
+  id_ = PROTOBUF_LONGLONG(0);
 }
-inline void TenantMeta::set_query_channelid(const char* value) {
-  GOOGLE_DCHECK(value != nullptr);
-  
-  query_channelid_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.TenantMeta.query_channelID)
+inline ::PROTOBUF_NAMESPACE_ID::int64 CollectionInfo::id() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.ID)
+  return id_;
 }
-inline void TenantMeta::set_query_channelid(const char* value, size_t size) {
+inline void CollectionInfo::set_id(::PROTOBUF_NAMESPACE_ID::int64 value) {
   
-  query_channelid_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.TenantMeta.query_channelID)
+  id_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.ID)
 }
-inline std::string* TenantMeta::mutable_query_channelid() {
+
+// .milvus.proto.schema.CollectionSchema schema = 2;
+inline bool CollectionInfo::has_schema() const {
+  return this != internal_default_instance() && schema_ != nullptr;
+}
+inline const ::milvus::proto::schema::CollectionSchema& CollectionInfo::schema() const {
+  const ::milvus::proto::schema::CollectionSchema* p = schema_;
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.schema)
+  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::schema::CollectionSchema*>(
+      &::milvus::proto::schema::_CollectionSchema_default_instance_);
+}
+inline ::milvus::proto::schema::CollectionSchema* CollectionInfo::release_schema() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.CollectionInfo.schema)
   
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.TenantMeta.query_channelID)
-  return query_channelid_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  ::milvus::proto::schema::CollectionSchema* temp = schema_;
+  schema_ = nullptr;
+  return temp;
 }
-inline std::string* TenantMeta::release_query_channelid() {
-  // @@protoc_insertion_point(field_release:milvus.proto.etcd.TenantMeta.query_channelID)
+inline ::milvus::proto::schema::CollectionSchema* CollectionInfo::mutable_schema() {
   
-  return query_channelid_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  if (schema_ == nullptr) {
+    auto* p = CreateMaybeMessage<::milvus::proto::schema::CollectionSchema>(GetArenaNoVirtual());
+    schema_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.schema)
+  return schema_;
 }
-inline void TenantMeta::set_allocated_query_channelid(std::string* query_channelid) {
-  if (query_channelid != nullptr) {
+inline void CollectionInfo::set_allocated_schema(::milvus::proto::schema::CollectionSchema* schema) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete reinterpret_cast< ::PROTOBUF_NAMESPACE_ID::MessageLite*>(schema_);
+  }
+  if (schema) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      schema = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, schema, submessage_arena);
+    }
     
   } else {
     
   }
-  query_channelid_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), query_channelid);
-  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.TenantMeta.query_channelID)
+  schema_ = schema;
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.CollectionInfo.schema)
 }
 
-// -------------------------------------------------------------------
+// uint64 create_time = 3;
+inline void CollectionInfo::clear_create_time() {
+  create_time_ = PROTOBUF_ULONGLONG(0);
+}
+inline ::PROTOBUF_NAMESPACE_ID::uint64 CollectionInfo::create_time() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.create_time)
+  return create_time_;
+}
+inline void CollectionInfo::set_create_time(::PROTOBUF_NAMESPACE_ID::uint64 value) {
+  
+  create_time_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.create_time)
+}
 
-// ProxyMeta
+// repeated int64 partitionIDs = 4;
+inline int CollectionInfo::partitionids_size() const {
+  return partitionids_.size();
+}
+inline void CollectionInfo::clear_partitionids() {
+  partitionids_.Clear();
+}
+inline ::PROTOBUF_NAMESPACE_ID::int64 CollectionInfo::partitionids(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.partitionIDs)
+  return partitionids_.Get(index);
+}
+inline void CollectionInfo::set_partitionids(int index, ::PROTOBUF_NAMESPACE_ID::int64 value) {
+  partitionids_.Set(index, value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.partitionIDs)
+}
+inline void CollectionInfo::add_partitionids(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  partitionids_.Add(value);
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.partitionIDs)
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >&
+CollectionInfo::partitionids() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.partitionIDs)
+  return partitionids_;
+}
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >*
+CollectionInfo::mutable_partitionids() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.partitionIDs)
+  return &partitionids_;
+}
 
-// int64 ID = 1;
-inline void ProxyMeta::clear_id() {
-  id_ = PROTOBUF_LONGLONG(0);
+// repeated string partitionNames = 5;
+inline int CollectionInfo::partitionnames_size() const {
+  return partitionnames_.size();
+}
+inline void CollectionInfo::clear_partitionnames() {
+  partitionnames_.Clear();
+}
+inline const std::string& CollectionInfo::partitionnames(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.partitionNames)
+  return partitionnames_.Get(index);
+}
+inline std::string* CollectionInfo::mutable_partitionnames(int index) {
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.partitionNames)
+  return partitionnames_.Mutable(index);
+}
+inline void CollectionInfo::set_partitionnames(int index, const std::string& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.partitionNames)
+  partitionnames_.Mutable(index)->assign(value);
+}
+inline void CollectionInfo::set_partitionnames(int index, std::string&& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.partitionNames)
+  partitionnames_.Mutable(index)->assign(std::move(value));
+}
+inline void CollectionInfo::set_partitionnames(int index, const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  partitionnames_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.CollectionInfo.partitionNames)
+}
+inline void CollectionInfo::set_partitionnames(int index, const char* value, size_t size) {
+  partitionnames_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.CollectionInfo.partitionNames)
+}
+inline std::string* CollectionInfo::add_partitionnames() {
+  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.CollectionInfo.partitionNames)
+  return partitionnames_.Add();
+}
+inline void CollectionInfo::add_partitionnames(const std::string& value) {
+  partitionnames_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.partitionNames)
+}
+inline void CollectionInfo::add_partitionnames(std::string&& value) {
+  partitionnames_.Add(std::move(value));
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.partitionNames)
+}
+inline void CollectionInfo::add_partitionnames(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  partitionnames_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.CollectionInfo.partitionNames)
+}
+inline void CollectionInfo::add_partitionnames(const char* value, size_t size) {
+  partitionnames_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.CollectionInfo.partitionNames)
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>&
+CollectionInfo::partitionnames() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.partitionNames)
+  return partitionnames_;
+}
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>*
+CollectionInfo::mutable_partitionnames() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.partitionNames)
+  return &partitionnames_;
+}
+
+// repeated .milvus.proto.etcd.FieldIndexInfo field_indexes = 6;
+inline int CollectionInfo::field_indexes_size() const {
+  return field_indexes_.size();
+}
+inline void CollectionInfo::clear_field_indexes() {
+  field_indexes_.Clear();
+}
+inline ::milvus::proto::etcd::FieldIndexInfo* CollectionInfo::mutable_field_indexes(int index) {
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.field_indexes)
+  return field_indexes_.Mutable(index);
+}
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::etcd::FieldIndexInfo >*
+CollectionInfo::mutable_field_indexes() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.field_indexes)
+  return &field_indexes_;
+}
+inline const ::milvus::proto::etcd::FieldIndexInfo& CollectionInfo::field_indexes(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.field_indexes)
+  return field_indexes_.Get(index);
+}
+inline ::milvus::proto::etcd::FieldIndexInfo* CollectionInfo::add_field_indexes() {
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.field_indexes)
+  return field_indexes_.Add();
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::etcd::FieldIndexInfo >&
+CollectionInfo::field_indexes() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.field_indexes)
+  return field_indexes_;
+}
+
+// repeated string virtual_channel_names = 7;
+inline int CollectionInfo::virtual_channel_names_size() const {
+  return virtual_channel_names_.size();
+}
+inline void CollectionInfo::clear_virtual_channel_names() {
+  virtual_channel_names_.Clear();
+}
+inline const std::string& CollectionInfo::virtual_channel_names(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+  return virtual_channel_names_.Get(index);
+}
+inline std::string* CollectionInfo::mutable_virtual_channel_names(int index) {
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+  return virtual_channel_names_.Mutable(index);
+}
+inline void CollectionInfo::set_virtual_channel_names(int index, const std::string& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+  virtual_channel_names_.Mutable(index)->assign(value);
+}
+inline void CollectionInfo::set_virtual_channel_names(int index, std::string&& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+  virtual_channel_names_.Mutable(index)->assign(std::move(value));
 }
-inline ::PROTOBUF_NAMESPACE_ID::int64 ProxyMeta::id() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.ProxyMeta.ID)
-  return id_;
+inline void CollectionInfo::set_virtual_channel_names(int index, const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  virtual_channel_names_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
 }
-inline void ProxyMeta::set_id(::PROTOBUF_NAMESPACE_ID::int64 value) {
-  
-  id_ = value;
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.ProxyMeta.ID)
+inline void CollectionInfo::set_virtual_channel_names(int index, const char* value, size_t size) {
+  virtual_channel_names_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
 }
-
-// .milvus.proto.common.Address address = 2;
-inline bool ProxyMeta::has_address() const {
-  return this != internal_default_instance() && address_ != nullptr;
+inline std::string* CollectionInfo::add_virtual_channel_names() {
+  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+  return virtual_channel_names_.Add();
 }
-inline const ::milvus::proto::common::Address& ProxyMeta::address() const {
-  const ::milvus::proto::common::Address* p = address_;
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.ProxyMeta.address)
-  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::common::Address*>(
-      &::milvus::proto::common::_Address_default_instance_);
+inline void CollectionInfo::add_virtual_channel_names(const std::string& value) {
+  virtual_channel_names_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
 }
-inline ::milvus::proto::common::Address* ProxyMeta::release_address() {
-  // @@protoc_insertion_point(field_release:milvus.proto.etcd.ProxyMeta.address)
-  
-  ::milvus::proto::common::Address* temp = address_;
-  address_ = nullptr;
-  return temp;
+inline void CollectionInfo::add_virtual_channel_names(std::string&& value) {
+  virtual_channel_names_.Add(std::move(value));
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
 }
-inline ::milvus::proto::common::Address* ProxyMeta::mutable_address() {
-  
-  if (address_ == nullptr) {
-    auto* p = CreateMaybeMessage<::milvus::proto::common::Address>(GetArenaNoVirtual());
-    address_ = p;
-  }
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.ProxyMeta.address)
-  return address_;
+inline void CollectionInfo::add_virtual_channel_names(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  virtual_channel_names_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
 }
-inline void ProxyMeta::set_allocated_address(::milvus::proto::common::Address* address) {
-  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
-  if (message_arena == nullptr) {
-    delete reinterpret_cast< ::PROTOBUF_NAMESPACE_ID::MessageLite*>(address_);
-  }
-  if (address) {
-    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
-    if (message_arena != submessage_arena) {
-      address = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
-          message_arena, address, submessage_arena);
-    }
-    
-  } else {
-    
-  }
-  address_ = address;
-  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.ProxyMeta.address)
+inline void CollectionInfo::add_virtual_channel_names(const char* value, size_t size) {
+  virtual_channel_names_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>&
+CollectionInfo::virtual_channel_names() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+  return virtual_channel_names_;
+}
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>*
+CollectionInfo::mutable_virtual_channel_names() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+  return &virtual_channel_names_;
 }
 
-// repeated string result_channelIDs = 3;
-inline int ProxyMeta::result_channelids_size() const {
-  return result_channelids_.size();
+// repeated string physical_channel_names = 8;
+inline int CollectionInfo::physical_channel_names_size() const {
+  return physical_channel_names_.size();
 }
-inline void ProxyMeta::clear_result_channelids() {
-  result_channelids_.Clear();
+inline void CollectionInfo::clear_physical_channel_names() {
+  physical_channel_names_.Clear();
 }
-inline const std::string& ProxyMeta::result_channelids(int index) const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.ProxyMeta.result_channelIDs)
-  return result_channelids_.Get(index);
+inline const std::string& CollectionInfo::physical_channel_names(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+  return physical_channel_names_.Get(index);
 }
-inline std::string* ProxyMeta::mutable_result_channelids(int index) {
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.ProxyMeta.result_channelIDs)
-  return result_channelids_.Mutable(index);
+inline std::string* CollectionInfo::mutable_physical_channel_names(int index) {
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+  return physical_channel_names_.Mutable(index);
 }
-inline void ProxyMeta::set_result_channelids(int index, const std::string& value) {
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.ProxyMeta.result_channelIDs)
-  result_channelids_.Mutable(index)->assign(value);
+inline void CollectionInfo::set_physical_channel_names(int index, const std::string& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+  physical_channel_names_.Mutable(index)->assign(value);
 }
-inline void ProxyMeta::set_result_channelids(int index, std::string&& value) {
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.ProxyMeta.result_channelIDs)
-  result_channelids_.Mutable(index)->assign(std::move(value));
+inline void CollectionInfo::set_physical_channel_names(int index, std::string&& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+  physical_channel_names_.Mutable(index)->assign(std::move(value));
 }
-inline void ProxyMeta::set_result_channelids(int index, const char* value) {
+inline void CollectionInfo::set_physical_channel_names(int index, const char* value) {
   GOOGLE_DCHECK(value != nullptr);
-  result_channelids_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+  physical_channel_names_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.CollectionInfo.physical_channel_names)
 }
-inline void ProxyMeta::set_result_channelids(int index, const char* value, size_t size) {
-  result_channelids_.Mutable(index)->assign(
+inline void CollectionInfo::set_physical_channel_names(int index, const char* value, size_t size) {
+  physical_channel_names_.Mutable(index)->assign(
     reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.CollectionInfo.physical_channel_names)
 }
-inline std::string* ProxyMeta::add_result_channelids() {
-  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.ProxyMeta.result_channelIDs)
-  return result_channelids_.Add();
+inline std::string* CollectionInfo::add_physical_channel_names() {
+  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+  return physical_channel_names_.Add();
 }
-inline void ProxyMeta::add_result_channelids(const std::string& value) {
-  result_channelids_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+inline void CollectionInfo::add_physical_channel_names(const std::string& value) {
+  physical_channel_names_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.physical_channel_names)
 }
-inline void ProxyMeta::add_result_channelids(std::string&& value) {
-  result_channelids_.Add(std::move(value));
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+inline void CollectionInfo::add_physical_channel_names(std::string&& value) {
+  physical_channel_names_.Add(std::move(value));
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.physical_channel_names)
 }
-inline void ProxyMeta::add_result_channelids(const char* value) {
+inline void CollectionInfo::add_physical_channel_names(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
-  result_channelids_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+  physical_channel_names_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.CollectionInfo.physical_channel_names)
 }
-inline void ProxyMeta::add_result_channelids(const char* value, size_t size) {
-  result_channelids_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.ProxyMeta.result_channelIDs)
+inline void CollectionInfo::add_physical_channel_names(const char* value, size_t size) {
+  physical_channel_names_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.CollectionInfo.physical_channel_names)
 }
 inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>&
-ProxyMeta::result_channelids() const {
-  // @@protoc_insertion_point(field_list:milvus.proto.etcd.ProxyMeta.result_channelIDs)
-  return result_channelids_;
+CollectionInfo::physical_channel_names() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+  return physical_channel_names_;
 }
 inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>*
-ProxyMeta::mutable_result_channelids() {
-  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.ProxyMeta.result_channelIDs)
-  return &result_channelids_;
+CollectionInfo::mutable_physical_channel_names() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+  return &physical_channel_names_;
 }
 
-// -------------------------------------------------------------------
+// repeated uint64 partition_created_timestamps = 9;
+inline int CollectionInfo::partition_created_timestamps_size() const {
+  return partition_created_timestamps_.size();
+}
+inline void CollectionInfo::clear_partition_created_timestamps() {
+  partition_created_timestamps_.Clear();
+}
+inline ::PROTOBUF_NAMESPACE_ID::uint64 CollectionInfo::partition_created_timestamps(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.partition_created_timestamps)
+  return partition_created_timestamps_.Get(index);
+}
+inline void CollectionInfo::set_partition_created_timestamps(int index, ::PROTOBUF_NAMESPACE_ID::uint64 value) {
+  partition_created_timestamps_.Set(index, value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.partition_created_timestamps)
+}
+inline void CollectionInfo::add_partition_created_timestamps(::PROTOBUF_NAMESPACE_ID::uint64 value) {
+  partition_created_timestamps_.Add(value);
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.partition_created_timestamps)
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::uint64 >&
+CollectionInfo::partition_created_timestamps() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.partition_created_timestamps)
+  return partition_created_timestamps_;
+}
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::uint64 >*
+CollectionInfo::mutable_partition_created_timestamps() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.partition_created_timestamps)
+  return &partition_created_timestamps_;
+}
 
-// IndexInfo
+// int32 shards_num = 10;
+inline void CollectionInfo::clear_shards_num() {
+  shards_num_ = 0;
+}
+inline ::PROTOBUF_NAMESPACE_ID::int32 CollectionInfo::shards_num() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.shards_num)
+  return shards_num_;
+}
+inline void CollectionInfo::set_shards_num(::PROTOBUF_NAMESPACE_ID::int32 value) {
+  
+  shards_num_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.shards_num)
+}
 
-// string index_name = 1;
-inline void IndexInfo::clear_index_name() {
-  index_name_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+// repeated .milvus.proto.common.KeyDataPair start_positions = 11;
+inline int CollectionInfo::start_positions_size() const {
+  return start_positions_.size();
 }
-inline const std::string& IndexInfo::index_name() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.IndexInfo.index_name)
-  return index_name_.GetNoArena();
+inline ::milvus::proto::common::KeyDataPair* CollectionInfo::mutable_start_positions(int index) {
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.start_positions)
+  return start_positions_.Mutable(index);
 }
-inline void IndexInfo::set_index_name(const std::string& value) {
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::common::KeyDataPair >*
+CollectionInfo::mutable_start_positions() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.start_positions)
+  return &start_positions_;
+}
+inline const ::milvus::proto::common::KeyDataPair& CollectionInfo::start_positions(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.start_positions)
+  return start_positions_.Get(index);
+}
+inline ::milvus::proto::common::KeyDataPair* CollectionInfo::add_start_positions() {
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.start_positions)
+  return start_positions_.Add();
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::common::KeyDataPair >&
+CollectionInfo::start_positions() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.start_positions)
+  return start_positions_;
+}
+
+// string db_name = 12;
+inline void CollectionInfo::clear_db_name() {
+  db_name_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline const std::string& CollectionInfo::db_name() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.db_name)
+  return db_name_.GetNoArena();
+}
+inline void CollectionInfo::set_db_name(const std::string& value) {
   
-  index_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.IndexInfo.index_name)
+  db_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.db_name)
 }
-inline void IndexInfo::set_index_name(std::string&& value) {
+inline void CollectionInfo::set_db_name(std::string&& value) {
   
-  index_name_.SetNoArena(
+  db_name_.SetNoArena(
     &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
-  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.IndexInfo.index_name)
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.CollectionInfo.db_name)
 }
-inline void IndexInfo::set_index_name(const char* value) {
+inline void CollectionInfo::set_db_name(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
-  
-  index_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.IndexInfo.index_name)
+  
+  db_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.CollectionInfo.db_name)
 }
-inline void IndexInfo::set_index_name(const char* value, size_t size) {
+inline void CollectionInfo::set_db_name(const char* value, size_t size) {
   
-  index_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+  db_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
       ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.IndexInfo.index_name)
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.CollectionInfo.db_name)
 }
-inline std::string* IndexInfo::mutable_index_name() {
+inline std::string* CollectionInfo::mutable_db_name() {
   
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.IndexInfo.index_name)
-  return index_name_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.db_name)
+  return db_name_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline std::string* IndexInfo::release_index_name() {
-  // @@protoc_insertion_point(field_release:milvus.proto.etcd.IndexInfo.index_name)
+inline std::string* CollectionInfo::release_db_name() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.CollectionInfo.db_name)
   
-  return index_name_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  return db_name_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline void IndexInfo::set_allocated_index_name(std::string* index_name) {
-  if (index_name != nullptr) {
+inline void CollectionInfo::set_allocated_db_name(std::string* db_name) {
+  if (db_name != nullptr) {
     
   } else {
     
   }
-  index_name_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), index_name);
-  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.IndexInfo.index_name)
+  db_name_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), db_name);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.CollectionInfo.db_name)
 }
 
-// int64 indexID = 2;
-inline void IndexInfo::clear_indexid() {
-  indexid_ = PROTOBUF_LONGLONG(0);
+// .milvus.proto.common.ConsistencyLevel consistency_level = 13;
+inline void CollectionInfo::clear_consistency_level() {
+  consistency_level_ = 0;
 }
-inline ::PROTOBUF_NAMESPACE_ID::int64 IndexInfo::indexid() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.IndexInfo.indexID)
-  return indexid_;
+inline ::milvus::proto::common::ConsistencyLevel CollectionInfo::consistency_level() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.consistency_level)
+  return static_cast< ::milvus::proto::common::ConsistencyLevel >(consistency_level_);
 }
-inline void IndexInfo::set_indexid(::PROTOBUF_NAMESPACE_ID::int64 value) {
+inline void CollectionInfo::set_consistency_level(::milvus::proto::common::ConsistencyLevel value) {
   
-  indexid_ = value;
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.IndexInfo.indexID)
+  consistency_level_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.consistency_level)
 }
 
-// repeated .milvus.proto.common.KeyValuePair index_params = 3;
-inline int IndexInfo::index_params_size() const {
-  return index_params_.size();
-}
-inline ::milvus::proto::common::KeyValuePair* IndexInfo::mutable_index_params(int index) {
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.IndexInfo.index_params)
-  return index_params_.Mutable(index);
-}
-inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::common::KeyValuePair >*
-IndexInfo::mutable_index_params() {
-  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.IndexInfo.index_params)
-  return &index_params_;
-}
-inline const ::milvus::proto::common::KeyValuePair& IndexInfo::index_params(int index) const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.IndexInfo.index_params)
-  return index_params_.Get(index);
+// int64 schema_version = 14;
+inline void CollectionInfo::clear_schema_version() {
+  schema_version_ = PROTOBUF_LONGLONG(0);
 }
-inline ::milvus::proto::common::KeyValuePair* IndexInfo::add_index_params() {
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.IndexInfo.index_params)
-  return index_params_.Add();
+inline ::PROTOBUF_NAMESPACE_ID::int64 CollectionInfo::schema_version() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.schema_version)
+  return schema_version_;
 }
-inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::common::KeyValuePair >&
-IndexInfo::index_params() const {
-  // @@protoc_insertion_point(field_list:milvus.proto.etcd.IndexInfo.index_params)
-  return index_params_;
+inline void CollectionInfo::set_schema_version(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  schema_version_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.schema_version)
 }
 
 // -------------------------------------------------------------------
 
-// FieldIndexInfo
+// DatabaseInfo
 
-// int64 filedID = 1;
-inline void FieldIndexInfo::clear_filedid() {
-  filedid_ = PROTOBUF_LONGLONG(0);
+// int64 ID = 1;
+inline void DatabaseInfo::clear_id() {
+  id_ = PROTOBUF_LONGLONG(0);
 }
-inline ::PROTOBUF_NAMESPACE_ID::int64 FieldIndexInfo::filedid() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.FieldIndexInfo.filedID)
-  return filedid_;
+inline ::PROTOBUF_NAMESPACE_ID::int64 DatabaseInfo::id() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.DatabaseInfo.ID)
+  return id_;
 }
-inline void FieldIndexInfo::set_filedid(::PROTOBUF_NAMESPACE_ID::int64 value) {
+inline void DatabaseInfo::set_id(::PROTOBUF_NAMESPACE_ID::int64 value) {
   
-  filedid_ = value;
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.FieldIndexInfo.filedID)
+  id_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.DatabaseInfo.ID)
 }
 
-// int64 indexID = 2;
-inline void FieldIndexInfo::clear_indexid() {
-  indexid_ = PROTOBUF_LONGLONG(0);
+// string name = 2;
+inline void DatabaseInfo::clear_name() {
+  name_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline ::PROTOBUF_NAMESPACE_ID::int64 FieldIndexInfo::indexid() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.FieldIndexInfo.indexID)
-  return indexid_;
+inline const std::string& DatabaseInfo::name() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.DatabaseInfo.name)
+  return name_.GetNoArena();
 }
-inline void FieldIndexInfo::set_indexid(::PROTOBUF_NAMESPACE_ID::int64 value) {
+inline void DatabaseInfo::set_name(const std::string& value) {
   
-  indexid_ = value;
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.FieldIndexInfo.indexID)
-}
-
-// -------------------------------------------------------------------
-
-// CollectionInfo
-
-// int64 ID = 1;
-inline void CollectionInfo::clear_id() {
-  id_ = PROTOBUF_LONGLONG(0);
-}
-inline ::PROTOBUF_NAMESPACE_ID::int64 CollectionInfo::id() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.ID)
-  return id_;
+  name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.DatabaseInfo.name)
 }
-inline void CollectionInfo::set_id(::PROTOBUF_NAMESPACE_ID::int64 value) {
+inline void DatabaseInfo::set_name(std::string&& value) {
   
-  id_ = value;
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.ID)
+  name_.SetNoArena(
+    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.DatabaseInfo.name)
 }
-
-// .milvus.proto.schema.CollectionSchema schema = 2;
-inline bool CollectionInfo::has_schema() const {
-  return this != internal_default_instance() && schema_ != nullptr;
+inline void DatabaseInfo::set_name(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.DatabaseInfo.name)
 }
-inline const ::milvus::proto::schema::CollectionSchema& CollectionInfo::schema() const {
-  const ::milvus::proto::schema::CollectionSchema* p = schema_;
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.schema)
-  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::schema::CollectionSchema*>(
-      &::milvus::proto::schema::_CollectionSchema_default_instance_);
+inline void DatabaseInfo::set_name(const char* value, size_t size) {
+  
+  name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.DatabaseInfo.name)
 }
-inline ::milvus::proto::schema::CollectionSchema* CollectionInfo::release_schema() {
-  // @@protoc_insertion_point(field_release:milvus.proto.etcd.CollectionInfo.schema)
+inline std::string* DatabaseInfo::mutable_name() {
   
-  ::milvus::proto::schema::CollectionSchema* temp = schema_;
-  schema_ = nullptr;
-  return temp;
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.DatabaseInfo.name)
+  return name_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline ::milvus::proto::schema::CollectionSchema* CollectionInfo::mutable_schema() {
+inline std::string* DatabaseInfo::release_name() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.DatabaseInfo.name)
   
-  if (schema_ == nullptr) {
-    auto* p = CreateMaybeMessage<::milvus::proto::schema::CollectionSchema>(GetArenaNoVirtual());
-    schema_ = p;
-  }
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.schema)
-  return schema_;
+  return name_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline void CollectionInfo::set_allocated_schema(::milvus::proto::schema::CollectionSchema* schema) {
-  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
-  if (message_arena == nullptr) {
-    delete reinterpret_cast< ::PROTOBUF_NAMESPACE_ID::MessageLite*>(schema_);
-  }
-  if (schema) {
-    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
-    if (message_arena != submessage_arena) {
-      schema = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
-          message_arena, schema, submessage_arena);
-    }
+inline void DatabaseInfo::set_allocated_name(std::string* name) {
+  if (name != nullptr) {
     
   } else {
     
   }
-  schema_ = schema;
-  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.CollectionInfo.schema)
+  name_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), name);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.DatabaseInfo.name)
 }
 
 // uint64 create_time = 3;
-inline void CollectionInfo::clear_create_time() {
+inline void DatabaseInfo::clear_create_time() {
   create_time_ = PROTOBUF_ULONGLONG(0);
 }
-inline ::PROTOBUF_NAMESPACE_ID::uint64 CollectionInfo::create_time() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.create_time)
+inline ::PROTOBUF_NAMESPACE_ID::uint64 DatabaseInfo::create_time() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.DatabaseInfo.create_time)
   return create_time_;
 }
-inline void CollectionInfo::set_create_time(::PROTOBUF_NAMESPACE_ID::uint64 value) {
+inline void DatabaseInfo::set_create_time(::PROTOBUF_NAMESPACE_ID::uint64 value) {
   
   create_time_ = value;
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.create_time)
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.DatabaseInfo.create_time)
 }
 
-// repeated int64 partitionIDs = 4;
-inline int CollectionInfo::partitionids_size() const {
-  return partitionids_.size();
-}
-inline void CollectionInfo::clear_partitionids() {
-  partitionids_.Clear();
-}
-inline ::PROTOBUF_NAMESPACE_ID::int64 CollectionInfo::partitionids(int index) const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.partitionIDs)
-  return partitionids_.Get(index);
-}
-inline void CollectionInfo::set_partitionids(int index, ::PROTOBUF_NAMESPACE_ID::int64 value) {
-  partitionids_.Set(index, value);
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.partitionIDs)
-}
-inline void CollectionInfo::add_partitionids(::PROTOBUF_NAMESPACE_ID::int64 value) {
-  partitionids_.Add(value);
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.partitionIDs)
+// -------------------------------------------------------------------
+
+// CredentialInfo
+
+// int64 ID = 1;
+inline void CredentialInfo::clear_id() {
+  id_ = PROTOBUF_LONGLONG(0);
 }
-inline const ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >&
-CollectionInfo::partitionids() const {
-  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.partitionIDs)
-  return partitionids_;
+inline ::PROTOBUF_NAMESPACE_ID::int64 CredentialInfo::id() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CredentialInfo.ID)
+  return id_;
 }
-inline ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >*
-CollectionInfo::mutable_partitionids() {
-  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.partitionIDs)
-  return &partitionids_;
+inline void CredentialInfo::set_id(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  id_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CredentialInfo.ID)
 }
 
-// repeated string partitionNames = 5;
-inline int CollectionInfo::partitionnames_size() const {
-  return partitionnames_.size();
-}
-inline void CollectionInfo::clear_partitionnames() {
-  partitionnames_.Clear();
-}
-inline const std::string& CollectionInfo::partitionnames(int index) const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.partitionNames)
-  return partitionnames_.Get(index);
+// string username = 2;
+inline void CredentialInfo::clear_username() {
+  username_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline std::string* CollectionInfo::mutable_partitionnames(int index) {
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.partitionNames)
-  return partitionnames_.Mutable(index);
+inline const std::string& CredentialInfo::username() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CredentialInfo.username)
+  return username_.GetNoArena();
 }
-inline void CollectionInfo::set_partitionnames(int index, const std::string& value) {
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.partitionNames)
-  partitionnames_.Mutable(index)->assign(value);
+inline void CredentialInfo::set_username(const std::string& value) {
+  
+  username_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CredentialInfo.username)
 }
-inline void CollectionInfo::set_partitionnames(int index, std::string&& value) {
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.partitionNames)
-  partitionnames_.Mutable(index)->assign(std::move(value));
+inline void CredentialInfo::set_username(std::string&& value) {
+  
+  username_.SetNoArena(
+    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.CredentialInfo.username)
 }
-inline void CollectionInfo::set_partitionnames(int index, const char* value) {
+inline void CredentialInfo::set_username(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
-  partitionnames_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.CollectionInfo.partitionNames)
-}
-inline void CollectionInfo::set_partitionnames(int index, const char* value, size_t size) {
-  partitionnames_.Mutable(index)->assign(
-    reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.CollectionInfo.partitionNames)
-}
-inline std::string* CollectionInfo::add_partitionnames() {
-  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.CollectionInfo.partitionNames)
-  return partitionnames_.Add();
-}
-inline void CollectionInfo::add_partitionnames(const std::string& value) {
-  partitionnames_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.partitionNames)
-}
-inline void CollectionInfo::add_partitionnames(std::string&& value) {
-  partitionnames_.Add(std::move(value));
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.partitionNames)
+  
+  username_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.CredentialInfo.username)
 }
-inline void CollectionInfo::add_partitionnames(const char* value) {
-  GOOGLE_DCHECK(value != nullptr);
-  partitionnames_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.CollectionInfo.partitionNames)
+inline void CredentialInfo::set_username(const char* value, size_t size) {
+  
+  username_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.CredentialInfo.username)
 }
-inline void CollectionInfo::add_partitionnames(const char* value, size_t size) {
-  partitionnames_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.CollectionInfo.partitionNames)
+inline std::string* CredentialInfo::mutable_username() {
+  
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CredentialInfo.username)
+  return username_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>&
-CollectionInfo::partitionnames() const {
-  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.partitionNames)
-  return partitionnames_;
+inline std::string* CredentialInfo::release_username() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.CredentialInfo.username)
+  
+  return username_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>*
-CollectionInfo::mutable_partitionnames() {
-  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.partitionNames)
-  return &partitionnames_;
+inline void CredentialInfo::set_allocated_username(std::string* username) {
+  if (username != nullptr) {
+    
+  } else {
+    
+  }
+  username_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), username);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.CredentialInfo.username)
 }
 
-// repeated .milvus.proto.etcd.FieldIndexInfo field_indexes = 6;
-inline int CollectionInfo::field_indexes_size() const {
-  return field_indexes_.size();
+// string encrypted_password = 3;
+inline void CredentialInfo::clear_encrypted_password() {
+  encrypted_password_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline void CollectionInfo::clear_field_indexes() {
-  field_indexes_.Clear();
+inline const std::string& CredentialInfo::encrypted_password() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CredentialInfo.encrypted_password)
+  return encrypted_password_.GetNoArena();
 }
-inline ::milvus::proto::etcd::FieldIndexInfo* CollectionInfo::mutable_field_indexes(int index) {
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.field_indexes)
-  return field_indexes_.Mutable(index);
+inline void CredentialInfo::set_encrypted_password(const std::string& value) {
+  
+  encrypted_password_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CredentialInfo.encrypted_password)
 }
-inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::etcd::FieldIndexInfo >*
-CollectionInfo::mutable_field_indexes() {
-  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.field_indexes)
-  return &field_indexes_;
+inline void CredentialInfo::set_encrypted_password(std::string&& value) {
+  
+  encrypted_password_.SetNoArena(
+    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.CredentialInfo.encrypted_password)
 }
-inline const ::milvus::proto::etcd::FieldIndexInfo& CollectionInfo::field_indexes(int index) const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.field_indexes)
-  return field_indexes_.Get(index);
+inline void CredentialInfo::set_encrypted_password(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  encrypted_password_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.CredentialInfo.encrypted_password)
 }
-inline ::milvus::proto::etcd::FieldIndexInfo* CollectionInfo::add_field_indexes() {
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.field_indexes)
-  return field_indexes_.Add();
+inline void CredentialInfo::set_encrypted_password(const char* value, size_t size) {
+  
+  encrypted_password_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.CredentialInfo.encrypted_password)
+}
+inline std::string* CredentialInfo::mutable_encrypted_password() {
+  
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CredentialInfo.encrypted_password)
+  return encrypted_password_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::etcd::FieldIndexInfo >&
-CollectionInfo::field_indexes() const {
-  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.field_indexes)
-  return field_indexes_;
+inline std::string* CredentialInfo::release_encrypted_password() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.CredentialInfo.encrypted_password)
+  
+  return encrypted_password_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline void CredentialInfo::set_allocated_encrypted_password(std::string* encrypted_password) {
+  if (encrypted_password != nullptr) {
+    
+  } else {
+    
+  }
+  encrypted_password_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), encrypted_password);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.CredentialInfo.encrypted_password)
 }
 
-// repeated string virtual_channel_names = 7;
-inline int CollectionInfo::virtual_channel_names_size() const {
-  return virtual_channel_names_.size();
+// repeated string roles = 4;
+inline int CredentialInfo::roles_size() const {
+  return roles_.size();
 }
-inline void CollectionInfo::clear_virtual_channel_names() {
-  virtual_channel_names_.Clear();
+inline void CredentialInfo::clear_roles() {
+  roles_.Clear();
 }
-inline const std::string& CollectionInfo::virtual_channel_names(int index) const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
-  return virtual_channel_names_.Get(index);
+inline const std::string& CredentialInfo::roles(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CredentialInfo.roles)
+  return roles_.Get(index);
 }
-inline std::string* CollectionInfo::mutable_virtual_channel_names(int index) {
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
-  return virtual_channel_names_.Mutable(index);
+inline std::string* CredentialInfo::mutable_roles(int index) {
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CredentialInfo.roles)
+  return roles_.Mutable(index);
 }
-inline void CollectionInfo::set_virtual_channel_names(int index, const std::string& value) {
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
-  virtual_channel_names_.Mutable(index)->assign(value);
+inline void CredentialInfo::set_roles(int index, const std::string& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CredentialInfo.roles)
+  roles_.Mutable(index)->assign(value);
 }
-inline void CollectionInfo::set_virtual_channel_names(int index, std::string&& value) {
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
-  virtual_channel_names_.Mutable(index)->assign(std::move(value));
+inline void CredentialInfo::set_roles(int index, std::string&& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CredentialInfo.roles)
+  roles_.Mutable(index)->assign(std::move(value));
 }
-inline void CollectionInfo::set_virtual_channel_names(int index, const char* value) {
+inline void CredentialInfo::set_roles(int index, const char* value) {
   GOOGLE_DCHECK(value != nullptr);
-  virtual_channel_names_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+  roles_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.CredentialInfo.roles)
 }
-inline void CollectionInfo::set_virtual_channel_names(int index, const char* value, size_t size) {
-  virtual_channel_names_.Mutable(index)->assign(
+inline void CredentialInfo::set_roles(int index, const char* value, size_t size) {
+  roles_.Mutable(index)->assign(
     reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.CredentialInfo.roles)
 }
-inline std::string* CollectionInfo::add_virtual_channel_names() {
-  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
-  return virtual_channel_names_.Add();
+inline std::string* CredentialInfo::add_roles() {
+  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.CredentialInfo.roles)
+  return roles_.Add();
 }
-inline void CollectionInfo::add_virtual_channel_names(const std::string& value) {
-  virtual_channel_names_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+inline void CredentialInfo::add_roles(const std::string& value) {
+  roles_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CredentialInfo.roles)
 }
-inline void CollectionInfo::add_virtual_channel_names(std::string&& value) {
-  virtual_channel_names_.Add(std::move(value));
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+inline void CredentialInfo::add_roles(std::string&& value) {
+  roles_.Add(std::move(value));
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CredentialInfo.roles)
 }
-inline void CollectionInfo::add_virtual_channel_names(const char* value) {
+inline void CredentialInfo::add_roles(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
-  virtual_channel_names_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+  roles_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.CredentialInfo.roles)
 }
-inline void CollectionInfo::add_virtual_channel_names(const char* value, size_t size) {
-  virtual_channel_names_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
+inline void CredentialInfo::add_roles(const char* value, size_t size) {
+  roles_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.CredentialInfo.roles)
 }
 inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>&
-CollectionInfo::virtual_channel_names() const {
-  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
-  return virtual_channel_names_;
+CredentialInfo::roles() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CredentialInfo.roles)
+  return roles_;
 }
 inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>*
-CollectionInfo::mutable_virtual_channel_names() {
-  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.virtual_channel_names)
-  return &virtual_channel_names_;
+CredentialInfo::mutable_roles() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CredentialInfo.roles)
+  return &roles_;
 }
 
-// repeated string physical_channel_names = 8;
-inline int CollectionInfo::physical_channel_names_size() const {
-  return physical_channel_names_.size();
+// uint64 create_time = 5;
+inline void CredentialInfo::clear_create_time() {
+  create_time_ = PROTOBUF_ULONGLONG(0);
 }
-inline void CollectionInfo::clear_physical_channel_names() {
-  physical_channel_names_.Clear();
+inline ::PROTOBUF_NAMESPACE_ID::uint64 CredentialInfo::create_time() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CredentialInfo.create_time)
+  return create_time_;
 }
-inline const std::string& CollectionInfo::physical_channel_names(int index) const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.physical_channel_names)
-  return physical_channel_names_.Get(index);
+inline void CredentialInfo::set_create_time(::PROTOBUF_NAMESPACE_ID::uint64 value) {
+  
+  create_time_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CredentialInfo.create_time)
 }
-inline std::string* CollectionInfo::mutable_physical_channel_names(int index) {
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.physical_channel_names)
-  return physical_channel_names_.Mutable(index);
+
+// -------------------------------------------------------------------
+
+// RoleInfo
+
+// int64 ID = 1;
+inline void RoleInfo::clear_id() {
+  id_ = PROTOBUF_LONGLONG(0);
 }
-inline void CollectionInfo::set_physical_channel_names(int index, const std::string& value) {
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.physical_channel_names)
-  physical_channel_names_.Mutable(index)->assign(value);
+inline ::PROTOBUF_NAMESPACE_ID::int64 RoleInfo::id() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.RoleInfo.ID)
+  return id_;
 }
-inline void CollectionInfo::set_physical_channel_names(int index, std::string&& value) {
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.physical_channel_names)
-  physical_channel_names_.Mutable(index)->assign(std::move(value));
+inline void RoleInfo::set_id(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  id_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.RoleInfo.ID)
 }
-inline void CollectionInfo::set_physical_channel_names(int index, const char* value) {
+
+// string name = 2;
+inline void RoleInfo::clear_name() {
+  name_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline const std::string& RoleInfo::name() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.RoleInfo.name)
+  return name_.GetNoArena();
+}
+inline void RoleInfo::set_name(const std::string& value) {
+  
+  name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.RoleInfo.name)
+}
+inline void RoleInfo::set_name(std::string&& value) {
+  
+  name_.SetNoArena(
+    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.RoleInfo.name)
+}
+inline void RoleInfo::set_name(const char* value) {
   GOOGLE_DCHECK(value != nullptr);
-  physical_channel_names_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+  
+  name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.RoleInfo.name)
 }
-inline void CollectionInfo::set_physical_channel_names(int index, const char* value, size_t size) {
-  physical_channel_names_.Mutable(index)->assign(
-    reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+inline void RoleInfo::set_name(const char* value, size_t size) {
+  
+  name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.RoleInfo.name)
 }
-inline std::string* CollectionInfo::add_physical_channel_names() {
-  // @@protoc_insertion_point(field_add_mutable:milvus.proto.etcd.CollectionInfo.physical_channel_names)
-  return physical_channel_names_.Add();
+inline std::string* RoleInfo::mutable_name() {
+  
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.RoleInfo.name)
+  return name_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline void CollectionInfo::add_physical_channel_names(const std::string& value) {
-  physical_channel_names_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+inline std::string* RoleInfo::release_name() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.RoleInfo.name)
+  
+  return name_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline void CollectionInfo::add_physical_channel_names(std::string&& value) {
-  physical_channel_names_.Add(std::move(value));
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+inline void RoleInfo::set_allocated_name(std::string* name) {
+  if (name != nullptr) {
+    
+  } else {
+    
+  }
+  name_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), name);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.RoleInfo.name)
 }
-inline void CollectionInfo::add_physical_channel_names(const char* value) {
-  GOOGLE_DCHECK(value != nullptr);
-  physical_channel_names_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+
+// repeated .milvus.proto.etcd.GrantInfo grants = 3;
+inline int RoleInfo::grants_size() const {
+  return grants_.size();
 }
-inline void CollectionInfo::add_physical_channel_names(const char* value, size_t size) {
-  physical_channel_names_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:milvus.proto.etcd.CollectionInfo.physical_channel_names)
+inline void RoleInfo::clear_grants() {
+  grants_.Clear();
 }
-inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>&
-CollectionInfo::physical_channel_names() const {
-  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.physical_channel_names)
-  return physical_channel_names_;
+inline ::milvus::proto::etcd::GrantInfo* RoleInfo::mutable_grants(int index) {
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.RoleInfo.grants)
+  return grants_.Mutable(index);
 }
-inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField<std::string>*
-CollectionInfo::mutable_physical_channel_names() {
-  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.physical_channel_names)
-  return &physical_channel_names_;
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::etcd::GrantInfo >*
+RoleInfo::mutable_grants() {
+  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.RoleInfo.grants)
+  return &grants_;
+}
+inline const ::milvus::proto::etcd::GrantInfo& RoleInfo::grants(int index) const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.RoleInfo.grants)
+  return grants_.Get(index);
+}
+inline ::milvus::proto::etcd::GrantInfo* RoleInfo::add_grants() {
+  // @@protoc_insertion_point(field_add:milvus.proto.etcd.RoleInfo.grants)
+  return grants_.Add();
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::etcd::GrantInfo >&
+RoleInfo::grants() const {
+  // @@protoc_insertion_point(field_list:milvus.proto.etcd.RoleInfo.grants)
+  return grants_;
 }
 
-// repeated uint64 partition_created_timestamps = 9;
-inline int CollectionInfo::partition_created_timestamps_size() const {
-  return partition_created_timestamps_.size();
+// uint64 create_time = 4;
+inline void RoleInfo::clear_create_time() {
+  create_time_ = PROTOBUF_ULONGLONG(0);
 }
-inline void CollectionInfo::clear_partition_created_timestamps() {
-  partition_created_timestamps_.Clear();
+inline ::PROTOBUF_NAMESPACE_ID::uint64 RoleInfo::create_time() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.RoleInfo.create_time)
+  return create_time_;
 }
-inline ::PROTOBUF_NAMESPACE_ID::uint64 CollectionInfo::partition_created_timestamps(int index) const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.partition_created_timestamps)
-  return partition_created_timestamps_.Get(index);
+inline void RoleInfo::set_create_time(::PROTOBUF_NAMESPACE_ID::uint64 value) {
+  
+  create_time_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.RoleInfo.create_time)
 }
-inline void CollectionInfo::set_partition_created_timestamps(int index, ::PROTOBUF_NAMESPACE_ID::uint64 value) {
-  partition_created_timestamps_.Set(index, value);
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.partition_created_timestamps)
+
+// -------------------------------------------------------------------
+
+// GrantInfo
+
+// string db_name = 1;
+inline void GrantInfo::clear_db_name() {
+  db_name_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline void CollectionInfo::add_partition_created_timestamps(::PROTOBUF_NAMESPACE_ID::uint64 value) {
-  partition_created_timestamps_.Add(value);
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.partition_created_timestamps)
+inline const std::string& GrantInfo::db_name() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.GrantInfo.db_name)
+  return db_name_.GetNoArena();
 }
-inline const ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::uint64 >&
-CollectionInfo::partition_created_timestamps() const {
-  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.partition_created_timestamps)
-  return partition_created_timestamps_;
+inline void GrantInfo::set_db_name(const std::string& value) {
+  
+  db_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.GrantInfo.db_name)
 }
-inline ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::uint64 >*
-CollectionInfo::mutable_partition_created_timestamps() {
-  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.partition_created_timestamps)
-  return &partition_created_timestamps_;
+inline void GrantInfo::set_db_name(std::string&& value) {
+  
+  db_name_.SetNoArena(
+    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.GrantInfo.db_name)
+}
+inline void GrantInfo::set_db_name(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  db_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.GrantInfo.db_name)
+}
+inline void GrantInfo::set_db_name(const char* value, size_t size) {
+  
+  db_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.GrantInfo.db_name)
+}
+inline std::string* GrantInfo::mutable_db_name() {
+  
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.GrantInfo.db_name)
+  return db_name_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline std::string* GrantInfo::release_db_name() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.GrantInfo.db_name)
+  
+  return db_name_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline void GrantInfo::set_allocated_db_name(std::string* db_name) {
+  if (db_name != nullptr) {
+    
+  } else {
+    
+  }
+  db_name_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), db_name);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.GrantInfo.db_name)
 }
 
-// int32 shards_num = 10;
-inline void CollectionInfo::clear_shards_num() {
-  shards_num_ = 0;
+// string object_name = 2;
+inline void GrantInfo::clear_object_name() {
+  object_name_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline ::PROTOBUF_NAMESPACE_ID::int32 CollectionInfo::shards_num() const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.shards_num)
-  return shards_num_;
+inline const std::string& GrantInfo::object_name() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.GrantInfo.object_name)
+  return object_name_.GetNoArena();
 }
-inline void CollectionInfo::set_shards_num(::PROTOBUF_NAMESPACE_ID::int32 value) {
+inline void GrantInfo::set_object_name(const std::string& value) {
   
-  shards_num_ = value;
-  // @@protoc_insertion_point(field_set:milvus.proto.etcd.CollectionInfo.shards_num)
+  object_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.GrantInfo.object_name)
+}
+inline void GrantInfo::set_object_name(std::string&& value) {
+  
+  object_name_.SetNoArena(
+    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.GrantInfo.object_name)
+}
+inline void GrantInfo::set_object_name(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  object_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.GrantInfo.object_name)
+}
+inline void GrantInfo::set_object_name(const char* value, size_t size) {
+  
+  object_name_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.GrantInfo.object_name)
+}
+inline std::string* GrantInfo::mutable_object_name() {
+  
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.GrantInfo.object_name)
+  return object_name_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline std::string* GrantInfo::release_object_name() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.GrantInfo.object_name)
+  
+  return object_name_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline void GrantInfo::set_allocated_object_name(std::string* object_name) {
+  if (object_name != nullptr) {
+    
+  } else {
+    
+  }
+  object_name_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), object_name);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.GrantInfo.object_name)
 }
 
-// repeated .milvus.proto.common.KeyDataPair start_positions = 11;
-inline int CollectionInfo::start_positions_size() const {
-  return start_positions_.size();
+// string privilege = 3;
+inline void GrantInfo::clear_privilege() {
+  privilege_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline ::milvus::proto::common::KeyDataPair* CollectionInfo::mutable_start_positions(int index) {
-  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.CollectionInfo.start_positions)
-  return start_positions_.Mutable(index);
+inline const std::string& GrantInfo::privilege() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.etcd.GrantInfo.privilege)
+  return privilege_.GetNoArena();
 }
-inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::common::KeyDataPair >*
-CollectionInfo::mutable_start_positions() {
-  // @@protoc_insertion_point(field_mutable_list:milvus.proto.etcd.CollectionInfo.start_positions)
-  return &start_positions_;
+inline void GrantInfo::set_privilege(const std::string& value) {
+  
+  privilege_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.etcd.GrantInfo.privilege)
 }
-inline const ::milvus::proto::common::KeyDataPair& CollectionInfo::start_positions(int index) const {
-  // @@protoc_insertion_point(field_get:milvus.proto.etcd.CollectionInfo.start_positions)
-  return start_positions_.Get(index);
+inline void GrantInfo::set_privilege(std::string&& value) {
+  
+  privilege_.SetNoArena(
+    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.etcd.GrantInfo.privilege)
 }
-inline ::milvus::proto::common::KeyDataPair* CollectionInfo::add_start_positions() {
-  // @@protoc_insertion_point(field_add:milvus.proto.etcd.CollectionInfo.start_positions)
-  return start_positions_.Add();
+inline void GrantInfo::set_privilege(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  privilege_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.etcd.GrantInfo.privilege)
 }
-inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::milvus::proto::common::KeyDataPair >&
-CollectionInfo::start_positions() const {
-  // @@protoc_insertion_point(field_list:milvus.proto.etcd.CollectionInfo.start_positions)
-  return start_positions_;
+inline void GrantInfo::set_privilege(const char* value, size_t size) {
+  
+  privilege_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.etcd.GrantInfo.privilege)
+}
+inline std::string* GrantInfo::mutable_privilege() {
+  
+  // @@protoc_insertion_point(field_mutable:milvus.proto.etcd.GrantInfo.privilege)
+  return privilege_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline std::string* GrantInfo::release_privilege() {
+  // @@protoc_insertion_point(field_release:milvus.proto.etcd.GrantInfo.privilege)
+  
+  return privilege_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline void GrantInfo::set_allocated_privilege(std::string* privilege) {
+  if (privilege != nullptr) {
+    
+  } else {
+    
+  }
+  privilege_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), privilege);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.etcd.GrantInfo.privilege)
 }
 
 // -------------------------------------------------------------------
@@ -2488,6 +3823,14 @@ CollectionMeta::mutable_partitionids() {
 
 // -------------------------------------------------------------------
 
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
 
 // @@protoc_insertion_point(namespace_scope)
 
@@ -15,12 +15,15 @@
 #include <google/protobuf/wire_format.h>
 // @@protoc_insertion_point(includes)
 #include <google/protobuf/port_def.inc>
-extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<4> scc_info_BinaryExpr_plan_2eproto;
+extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_BinaryArithOpEvalRangeExpr_plan_2eproto;
+extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<6> scc_info_BinaryExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_BinaryRangeExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<0> scc_info_ColumnInfo_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<1> scc_info_CompareExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<0> scc_info_GenericValue_plan_2eproto;
-extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<0> scc_info_QueryInfo_plan_2eproto;
+extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<1> scc_info_QueryInfo_plan_2eproto;
+extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<0> scc_info_RangeSearchInfo_plan_2eproto;
+extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<1> scc_info_StringMatchExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_TermExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_UnaryRangeExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_VectorANNS_plan_2eproto;
@@ -33,7 +36,12 @@ class GenericValueDefaultTypeInternal {
   bool bool_val_;
   ::PROTOBUF_NAMESPACE_ID::int64 int64_val_;
   double float_val_;
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr string_val_;
 } _GenericValue_default_instance_;
+class RangeSearchInfoDefaultTypeInternal {
+ public:
+  ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<RangeSearchInfo> _instance;
+} _RangeSearchInfo_default_instance_;
 class QueryInfoDefaultTypeInternal {
  public:
   ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<QueryInfo> _instance;
@@ -58,6 +66,14 @@ class TermExprDefaultTypeInternal {
  public:
   ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<TermExpr> _instance;
 } _TermExpr_default_instance_;
+class StringMatchExprDefaultTypeInternal {
+ public:
+  ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<StringMatchExpr> _instance;
+} _StringMatchExpr_default_instance_;
+class BinaryArithOpEvalRangeExprDefaultTypeInternal {
+ public:
+  ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<BinaryArithOpEvalRangeExpr> _instance;
+} _BinaryArithOpEvalRangeExpr_default_instance_;
 class UnaryExprDefaultTypeInternal {
  public:
   ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<UnaryExpr> _instance;
@@ -75,6 +91,8 @@ class ExprDefaultTypeInternal {
   const ::milvus::proto::plan::CompareExpr* compare_expr_;
   const ::milvus::proto::plan::UnaryRangeExpr* unary_range_expr_;
   const ::milvus::proto::plan::BinaryRangeExpr* binary_range_expr_;
+  const ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* binary_arith_op_eval_range_expr_;
+  const ::milvus::proto::plan::StringMatchExpr* string_match_expr_;
 } _Expr_default_instance_;
 class VectorANNSDefaultTypeInternal {
  public:
@@ -89,6 +107,22 @@ class PlanNodeDefaultTypeInternal {
 }  // namespace plan
 }  // namespace proto
 }  // namespace milvus
+static void InitDefaultsscc_info_BinaryArithOpEvalRangeExpr_plan_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::milvus::proto::plan::_BinaryArithOpEvalRangeExpr_default_instance_;
+    new (ptr) ::milvus::proto::plan::BinaryArithOpEvalRangeExpr();
+    ::PROTOBUF_NAMESPACE_ID::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::milvus::proto::plan::BinaryArithOpEvalRangeExpr::InitAsDefaultInstance();
+}
+
+::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_BinaryArithOpEvalRangeExpr_plan_2eproto =
+    {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 2, InitDefaultsscc_info_BinaryArithOpEvalRangeExpr_plan_2eproto}, {
+      &scc_info_ColumnInfo_plan_2eproto.base,
+      &scc_info_GenericValue_plan_2eproto.base,}};
+
 static void InitDefaultsscc_info_BinaryExpr_plan_2eproto() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
 
@@ -112,12 +146,14 @@ static void InitDefaultsscc_info_BinaryExpr_plan_2eproto() {
   ::milvus::proto::plan::Expr::InitAsDefaultInstance();
 }
 
-::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<4> scc_info_BinaryExpr_plan_2eproto =
-    {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 4, InitDefaultsscc_info_BinaryExpr_plan_2eproto}, {
+::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<6> scc_info_BinaryExpr_plan_2eproto =
+    {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 6, InitDefaultsscc_info_BinaryExpr_plan_2eproto}, {
       &scc_info_TermExpr_plan_2eproto.base,
       &scc_info_CompareExpr_plan_2eproto.base,
       &scc_info_UnaryRangeExpr_plan_2eproto.base,
-      &scc_info_BinaryRangeExpr_plan_2eproto.base,}};
+      &scc_info_BinaryRangeExpr_plan_2eproto.base,
+      &scc_info_BinaryArithOpEvalRangeExpr_plan_2eproto.base,
+      &scc_info_StringMatchExpr_plan_2eproto.base,}};
 
 static void InitDefaultsscc_info_BinaryRangeExpr_plan_2eproto() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
@@ -205,8 +241,38 @@ static void InitDefaultsscc_info_QueryInfo_plan_2eproto() {
   ::milvus::proto::plan::QueryInfo::InitAsDefaultInstance();
 }
 
-::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<0> scc_info_QueryInfo_plan_2eproto =
-    {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsscc_info_QueryInfo_plan_2eproto}, {}};
+::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<1> scc_info_QueryInfo_plan_2eproto =
+    {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsscc_info_QueryInfo_plan_2eproto}, {
+      &scc_info_RangeSearchInfo_plan_2eproto.base,}};
+
+static void InitDefaultsscc_info_RangeSearchInfo_plan_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::milvus::proto::plan::_RangeSearchInfo_default_instance_;
+    new (ptr) ::milvus::proto::plan::RangeSearchInfo();
+    ::PROTOBUF_NAMESPACE_ID::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::milvus::proto::plan::RangeSearchInfo::InitAsDefaultInstance();
+}
+
+::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<0> scc_info_RangeSearchInfo_plan_2eproto =
+    {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsscc_info_RangeSearchInfo_plan_2eproto}, {}};
+
+static void InitDefaultsscc_info_StringMatchExpr_plan_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::milvus::proto::plan::_StringMatchExpr_default_instance_;
+    new (ptr) ::milvus::proto::plan::StringMatchExpr();
+    ::PROTOBUF_NAMESPACE_ID::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::milvus::proto::plan::StringMatchExpr::InitAsDefaultInstance();
+}
+
+::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<1> scc_info_StringMatchExpr_plan_2eproto =
+    {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsscc_info_StringMatchExpr_plan_2eproto}, {
+      &scc_info_ColumnInfo_plan_2eproto.base,}};
 
 static void InitDefaultsscc_info_TermExpr_plan_2eproto() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
@@ -256,8 +322,8 @@ static void InitDefaultsscc_info_VectorANNS_plan_2eproto() {
       &scc_info_BinaryExpr_plan_2eproto.base,
       &scc_info_QueryInfo_plan_2eproto.base,}};
 
-static ::PROTOBUF_NAMESPACE_ID::Metadata file_level_metadata_plan_2eproto[12];
-static const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* file_level_enum_descriptors_plan_2eproto[3];
+static ::PROTOBUF_NAMESPACE_ID::Metadata file_level_metadata_plan_2eproto[15];
+static const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* file_level_enum_descriptors_plan_2eproto[5];
 static constexpr ::PROTOBUF_NAMESPACE_ID::ServiceDescriptor const** file_level_service_descriptors_plan_2eproto = nullptr;
 
 const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_plan_2eproto::offsets[] PROTOBUF_SECTION_VARIABLE(protodesc_cold) = {
@@ -269,8 +335,16 @@ const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_plan_2eproto::offsets[] PROTOB
   offsetof(::milvus::proto::plan::GenericValueDefaultTypeInternal, bool_val_),
   offsetof(::milvus::proto::plan::GenericValueDefaultTypeInternal, int64_val_),
   offsetof(::milvus::proto::plan::GenericValueDefaultTypeInternal, float_val_),
+  offsetof(::milvus::proto::plan::GenericValueDefaultTypeInternal, string_val_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::GenericValue, val_),
   ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::RangeSearchInfo, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::RangeSearchInfo, radius_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::RangeSearchInfo, range_filter_),
+  ~0u,  // no _has_bits_
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::QueryInfo, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
@@ -278,6 +352,9 @@ const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_plan_2eproto::offsets[] PROTOB
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::QueryInfo, topk_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::QueryInfo, metric_type_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::QueryInfo, search_params_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::QueryInfo, range_search_info_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::QueryInfo, group_by_field_id_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::QueryInfo, group_size_),
   ~0u,  // no _has_bits_
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::ColumnInfo, _internal_metadata_),
   ~0u,  // no _extensions_
@@ -321,6 +398,24 @@ const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_plan_2eproto::offsets[] PROTOB
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::TermExpr, column_info_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::TermExpr, values_),
   ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::StringMatchExpr, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::StringMatchExpr, column_info_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::StringMatchExpr, op_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::StringMatchExpr, pattern_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithOpEvalRangeExpr, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithOpEvalRangeExpr, column_info_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithOpEvalRangeExpr, arith_op_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithOpEvalRangeExpr, right_operand_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithOpEvalRangeExpr, op_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithOpEvalRangeExpr, value_),
+  ~0u,  // no _has_bits_
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::UnaryExpr, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
@@ -346,6 +441,8 @@ const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_plan_2eproto::offsets[] PROTOB
   offsetof(::milvus::proto::plan::ExprDefaultTypeInternal, compare_expr_),
   offsetof(::milvus::proto::plan::ExprDefaultTypeInternal, unary_range_expr_),
   offsetof(::milvus::proto::plan::ExprDefaultTypeInternal, binary_range_expr_),
+  offsetof(::milvus::proto::plan::ExprDefaultTypeInternal, binary_arith_op_eval_range_expr_),
+  offsetof(::milvus::proto::plan::ExprDefaultTypeInternal, string_match_expr_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::Expr, expr_),
   ~0u,  // no _has_bits_
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::VectorANNS, _internal_metadata_),
@@ -365,31 +462,38 @@ const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_plan_2eproto::offsets[] PROTOB
   offsetof(::milvus::proto::plan::PlanNodeDefaultTypeInternal, vector_anns_),
   offsetof(::milvus::proto::plan::PlanNodeDefaultTypeInternal, predicates_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::PlanNode, output_field_ids_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::PlanNode, limit_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::PlanNode, node_),
 };
 static const ::PROTOBUF_NAMESPACE_ID::internal::MigrationSchema schemas[] PROTOBUF_SECTION_VARIABLE(protodesc_cold) = {
   { 0, -1, sizeof(::milvus::proto::plan::GenericValue)},
-  { 9, -1, sizeof(::milvus::proto::plan::QueryInfo)},
-  { 17, -1, sizeof(::milvus::proto::plan::ColumnInfo)},
-  { 26, -1, sizeof(::milvus::proto::plan::UnaryRangeExpr)},
-  { 34, -1, sizeof(::milvus::proto::plan::BinaryRangeExpr)},
-  { 44, -1, sizeof(::milvus::proto::plan::CompareExpr)},
-  { 52, -1, sizeof(::milvus::proto::plan::TermExpr)},
-  { 59, -1, sizeof(::milvus::proto::plan::UnaryExpr)},
-  { 66, -1, sizeof(::milvus::proto::plan::BinaryExpr)},
-  { 74, -1, sizeof(::milvus::proto::plan::Expr)},
-  { 86, -1, sizeof(::milvus::proto::plan::VectorANNS)},
-  { 96, -1, sizeof(::milvus::proto::plan::PlanNode)},
+  { 10, -1, sizeof(::milvus::proto::plan::RangeSearchInfo)},
+  { 17, -1, sizeof(::milvus::proto::plan::QueryInfo)},
+  { 28, -1, sizeof(::milvus::proto::plan::ColumnInfo)},
+  { 37, -1, sizeof(::milvus::proto::plan::UnaryRangeExpr)},
+  { 45, -1, sizeof(::milvus::proto::plan::BinaryRangeExpr)},
+  { 55, -1, sizeof(::milvus::proto::plan::CompareExpr)},
+  { 63, -1, sizeof(::milvus::proto::plan::TermExpr)},
+  { 70, -1, sizeof(::milvus::proto::plan::StringMatchExpr)},
+  { 78, -1, sizeof(::milvus::proto::plan::BinaryArithOpEvalRangeExpr)},
+  { 88, -1, sizeof(::milvus::proto::plan::UnaryExpr)},
+  { 95, -1, sizeof(::milvus::proto::plan::BinaryExpr)},
+  { 103, -1, sizeof(::milvus::proto::plan::Expr)},
+  { 117, -1, sizeof(::milvus::proto::plan::VectorANNS)},
+  { 127, -1, sizeof(::milvus::proto::plan::PlanNode)},
 };
 
 static ::PROTOBUF_NAMESPACE_ID::Message const * const file_default_instances[] = {
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_GenericValue_default_instance_),
+  reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_RangeSearchInfo_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_QueryInfo_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_ColumnInfo_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_UnaryRangeExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_BinaryRangeExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_CompareExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_TermExpr_default_instance_),
+  reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_StringMatchExpr_default_instance_),
+  reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_BinaryArithOpEvalRangeExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_UnaryExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_BinaryExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_Expr_default_instance_),
@@ -399,65 +503,89 @@ static ::PROTOBUF_NAMESPACE_ID::Message const * const file_default_instances[] =
 
 const char descriptor_table_protodef_plan_2eproto[] PROTOBUF_SECTION_VARIABLE(protodesc_cold) =
   "\n\nplan.proto\022\021milvus.proto.plan\032\014schema."
-  "proto\"S\n\014GenericValue\022\022\n\010bool_val\030\001 \001(\010H"
+  "proto\"i\n\014GenericValue\022\022\n\010bool_val\030\001 \001(\010H"
   "\000\022\023\n\tint64_val\030\002 \001(\003H\000\022\023\n\tfloat_val\030\003 \001("
-  "\001H\000B\005\n\003val\"E\n\tQueryInfo\022\014\n\004topk\030\001 \001(\003\022\023\n"
+  "\001H\000\022\024\n\nstring_val\030\004 \001(\tH\000B\005\n\003val\"7\n\017Rang"
+  "eSearchInfo\022\016\n\006radius\030\001 \001(\002\022\024\n\014range_fil"
+  "ter\030\002 \001(\002\"\263\001\n\tQueryInfo\022\014\n\004topk\030\001 \001(\003\022\023\n"
   "\013metric_type\030\003 \001(\t\022\025\n\rsearch_params\030\004 \001("
-  "\t\"{\n\nColumnInfo\022\020\n\010field_id\030\001 \001(\003\0220\n\tdat"
-  "a_type\030\002 \001(\0162\035.milvus.proto.schema.DataT"
-  "ype\022\026\n\016is_primary_key\030\003 \001(\010\022\021\n\tis_autoID"
-  "\030\004 \001(\010\"\233\001\n\016UnaryRangeExpr\0222\n\013column_info"
-  "\030\001 \001(\0132\035.milvus.proto.plan.ColumnInfo\022%\n"
-  "\002op\030\002 \001(\0162\031.milvus.proto.plan.OpType\022.\n\005"
-  "value\030\003 \001(\0132\037.milvus.proto.plan.GenericV"
-  "alue\"\343\001\n\017BinaryRangeExpr\0222\n\013column_info\030"
-  "\001 \001(\0132\035.milvus.proto.plan.ColumnInfo\022\027\n\017"
-  "lower_inclusive\030\002 \001(\010\022\027\n\017upper_inclusive"
-  "\030\003 \001(\010\0224\n\013lower_value\030\004 \001(\0132\037.milvus.pro"
-  "to.plan.GenericValue\0224\n\013upper_value\030\005 \001("
-  "\0132\037.milvus.proto.plan.GenericValue\"\247\001\n\013C"
-  "ompareExpr\0227\n\020left_column_info\030\001 \001(\0132\035.m"
-  "ilvus.proto.plan.ColumnInfo\0228\n\021right_col"
-  "umn_info\030\002 \001(\0132\035.milvus.proto.plan.Colum"
-  "nInfo\022%\n\002op\030\003 \001(\0162\031.milvus.proto.plan.Op"
-  "Type\"o\n\010TermExpr\0222\n\013column_info\030\001 \001(\0132\035."
-  "milvus.proto.plan.ColumnInfo\022/\n\006values\030\002"
-  " \003(\0132\037.milvus.proto.plan.GenericValue\"\206\001"
-  "\n\tUnaryExpr\0220\n\002op\030\001 \001(\0162$.milvus.proto.p"
-  "lan.UnaryExpr.UnaryOp\022&\n\005child\030\002 \001(\0132\027.m"
-  "ilvus.proto.plan.Expr\"\037\n\007UnaryOp\022\013\n\007Inva"
-  "lid\020\000\022\007\n\003Not\020\001\"\307\001\n\nBinaryExpr\0222\n\002op\030\001 \001("
-  "\0162&.milvus.proto.plan.BinaryExpr.BinaryO"
-  "p\022%\n\004left\030\002 \001(\0132\027.milvus.proto.plan.Expr"
-  "\022&\n\005right\030\003 \001(\0132\027.milvus.proto.plan.Expr"
-  "\"6\n\010BinaryOp\022\013\n\007Invalid\020\000\022\016\n\nLogicalAnd\020"
-  "\001\022\r\n\tLogicalOr\020\002\"\342\002\n\004Expr\0220\n\tterm_expr\030\001"
-  " \001(\0132\033.milvus.proto.plan.TermExprH\000\0222\n\nu"
-  "nary_expr\030\002 \001(\0132\034.milvus.proto.plan.Unar"
-  "yExprH\000\0224\n\013binary_expr\030\003 \001(\0132\035.milvus.pr"
-  "oto.plan.BinaryExprH\000\0226\n\014compare_expr\030\004 "
-  "\001(\0132\036.milvus.proto.plan.CompareExprH\000\022=\n"
-  "\020unary_range_expr\030\005 \001(\0132!.milvus.proto.p"
-  "lan.UnaryRangeExprH\000\022\?\n\021binary_range_exp"
-  "r\030\006 \001(\0132\".milvus.proto.plan.BinaryRangeE"
-  "xprH\000B\006\n\004expr\"\251\001\n\nVectorANNS\022\021\n\tis_binar"
-  "y\030\001 \001(\010\022\020\n\010field_id\030\002 \001(\003\022+\n\npredicates\030"
-  "\003 \001(\0132\027.milvus.proto.plan.Expr\0220\n\nquery_"
-  "info\030\004 \001(\0132\034.milvus.proto.plan.QueryInfo"
-  "\022\027\n\017placeholder_tag\030\005 \001(\t\"\221\001\n\010PlanNode\0224"
-  "\n\013vector_anns\030\001 \001(\0132\035.milvus.proto.plan."
-  "VectorANNSH\000\022-\n\npredicates\030\002 \001(\0132\027.milvu"
-  "s.proto.plan.ExprH\000\022\030\n\020output_field_ids\030"
-  "\003 \003(\003B\006\n\004node*n\n\006OpType\022\013\n\007Invalid\020\000\022\017\n\013"
-  "GreaterThan\020\001\022\020\n\014GreaterEqual\020\002\022\014\n\010LessT"
-  "han\020\003\022\r\n\tLessEqual\020\004\022\t\n\005Equal\020\005\022\014\n\010NotEq"
-  "ual\020\006B3Z1github.com/milvus-io/milvus/int"
-  "ernal/proto/planpbb\006proto3"
+  "\t\022=\n\021range_search_info\030\005 \001(\0132\".milvus.pr"
+  "oto.plan.RangeSearchInfo\022\031\n\021group_by_fie"
+  "ld_id\030\006 \001(\003\022\022\n\ngroup_size\030\007 \001(\003\"{\n\nColum"
+  "nInfo\022\020\n\010field_id\030\001 \001(\003\0220\n\tdata_type\030\002 \001"
+  "(\0162\035.milvus.proto.schema.DataType\022\026\n\016is_"
+  "primary_key\030\003 \001(\010\022\021\n\tis_autoID\030\004 \001(\010\"\233\001\n"
+  "\016UnaryRangeExpr\0222\n\013column_info\030\001 \001(\0132\035.m"
+  "ilvus.proto.plan.ColumnInfo\022%\n\002op\030\002 \001(\0162"
+  "\031.milvus.proto.plan.OpType\022.\n\005value\030\003 \001("
+  "\0132\037.milvus.proto.plan.GenericValue\"\343\001\n\017B"
+  "inaryRangeExpr\0222\n\013column_info\030\001 \001(\0132\035.mi"
+  "lvus.proto.plan.ColumnInfo\022\027\n\017lower_incl"
+  "usive\030\002 \001(\010\022\027\n\017upper_inclusive\030\003 \001(\010\0224\n\013"
+  "lower_value\030\004 \001(\0132\037.milvus.proto.plan.Ge"
+  "nericValue\0224\n\013upper_value\030\005 \001(\0132\037.milvus"
+  ".proto.plan.GenericValue\"\247\001\n\013CompareExpr"
+  "\0227\n\020left_column_info\030\001 \001(\0132\035.milvus.prot"
+  "o.plan.ColumnInfo\0228\n\021right_column_info\030\002"
+  " \001(\0132\035.milvus.proto.plan.ColumnInfo\022%\n\002o"
+  "p\030\003 \001(\0162\031.milvus.proto.plan.OpType\"o\n\010Te"
+  "rmExpr\0222\n\013column_info\030\001 \001(\0132\035.milvus.pro"
+  "to.plan.ColumnInfo\022/\n\006values\030\002 \003(\0132\037.mil"
+  "vus.proto.plan.GenericValue\"\314\001\n\017StringMa"
+  "tchExpr\0222\n\013column_info\030\001 \001(\0132\035.milvus.pr"
+  "oto.plan.ColumnInfo\0226\n\002op\030\002 \001(\0162*.milvus"
+  ".proto.plan.StringMatchExpr.MatchOp\022\017\n\007p"
+  "attern\030\003 \001(\t\"<\n\007MatchOp\022\013\n\007Invalid\020\000\022\n\n\006"
+  "Prefix\020\001\022\n\n\006Suffix\020\002\022\014\n\010Contains\020\003\"\221\002\n\032B"
+  "inaryArithOpEvalRangeExpr\0222\n\013column_info"
+  "\030\001 \001(\0132\035.milvus.proto.plan.ColumnInfo\0220\n"
+  "\010arith_op\030\002 \001(\0162\036.milvus.proto.plan.Arit"
+  "hOpType\0226\n\rright_operand\030\003 \001(\0132\037.milvus."
+  "proto.plan.GenericValue\022%\n\002op\030\004 \001(\0162\031.mi"
+  "lvus.proto.plan.OpType\022.\n\005value\030\005 \001(\0132\037."
+  "milvus.proto.plan.GenericValue\"\206\001\n\tUnary"
+  "Expr\0220\n\002op\030\001 \001(\0162$.milvus.proto.plan.Una"
+  "ryExpr.UnaryOp\022&\n\005child\030\002 \001(\0132\027.milvus.p"
+  "roto.plan.Expr\"\037\n\007UnaryOp\022\013\n\007Invalid\020\000\022\007"
+  "\n\003Not\020\001\"\307\001\n\nBinaryExpr\0222\n\002op\030\001 \001(\0162&.mil"
+  "vus.proto.plan.BinaryExpr.BinaryOp\022%\n\004le"
+  "ft\030\002 \001(\0132\027.milvus.proto.plan.Expr\022&\n\005rig"
+  "ht\030\003 \001(\0132\027.milvus.proto.plan.Expr\"6\n\010Bin"
+  "aryOp\022\013\n\007Invalid\020\000\022\016\n\nLogicalAnd\020\001\022\r\n\tLo"
+  "gicalOr\020\002\"\375\003\n\004Expr\0220\n\tterm_expr\030\001 \001(\0132\033."
+  "milvus.proto.plan.TermExprH\000\0222\n\nunary_ex"
+  "pr\030\002 \001(\0132\034.milvus.proto.plan.UnaryExprH\000"
+  "\0224\n\013binary_expr\030\003 \001(\0132\035.milvus.proto.pla"
+  "n.BinaryExprH\000\0226\n\014compare_expr\030\004 \001(\0132\036.m"
+  "ilvus.proto.plan.CompareExprH\000\022=\n\020unary_"
+  "range_expr\030\005 \001(\0132!.milvus.proto.plan.Una"
+  "ryRangeExprH\000\022\?\n\021binary_range_expr\030\006 \001(\013"
+  "2\".milvus.proto.plan.BinaryRangeExprH\000\022X"
+  "\n\037binary_arith_op_eval_range_expr\030\007 \001(\0132"
+  "-.milvus.proto.plan.BinaryArithOpEvalRan"
+  "geExprH\000\022\?\n\021string_match_expr\030\010 \001(\0132\".mi"
+  "lvus.proto.plan.StringMatchExprH\000B\006\n\004exp"
+  "r\"\251\001\n\nVectorANNS\022\021\n\tis_binary\030\001 \001(\010\022\020\n\010f"
+  "ield_id\030\002 \001(\003\022+\n\npredicates\030\003 \001(\0132\027.milv"
+  "us.proto.plan.Expr\0220\n\nquery_info\030\004 \001(\0132\034"
+  ".milvus.proto.plan.QueryInfo\022\027\n\017placehol"
+  "der_tag\030\005 \001(\t\"\240\001\n\010PlanNode\0224\n\013vector_ann"
+  "s\030\001 \001(\0132\035.milvus.proto.plan.VectorANNSH\000"
+  "\022-\n\npredicates\030\002 \001(\0132\027.milvus.proto.plan"
+  ".ExprH\000\022\030\n\020output_field_ids\030\003 \003(\003\022\r\n\005lim"
+  "it\030\004 \001(\003B\006\n\004node*n\n\006OpType\022\013\n\007Invalid\020\000\022"
+  "\017\n\013GreaterThan\020\001\022\020\n\014GreaterEqual\020\002\022\014\n\010Le"
+  "ssThan\020\003\022\r\n\tLessEqual\020\004\022\t\n\005Equal\020\005\022\014\n\010No"
+  "tEqual\020\006*G\n\013ArithOpType\022\013\n\007Unknown\020\000\022\007\n\003"
+  "Add\020\001\022\007\n\003Sub\020\002\022\007\n\003Mul\020\003\022\007\n\003Div\020\004\022\007\n\003Mod\020"
+  "\005B3Z1github.com/milvus-io/milvus/interna"
+  "l/proto/planpbb\006proto3"
   ;
 static const ::PROTOBUF_NAMESPACE_ID::internal::DescriptorTable*const descriptor_table_plan_2eproto_deps[1] = {
   &::descriptor_table_schema_2eproto,
 };
-static ::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase*const descriptor_table_plan_2eproto_sccs[10] = {
+static ::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase*const descriptor_table_plan_2eproto_sccs[13] = {
+  &scc_info_BinaryArithOpEvalRangeExpr_plan_2eproto.base,
   &scc_info_BinaryExpr_plan_2eproto.base,
   &scc_info_BinaryRangeExpr_plan_2eproto.base,
   &scc_info_ColumnInfo_plan_2eproto.base,
@@ -465,6 +593,8 @@ static ::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase*const descriptor_table_pla
   &scc_info_GenericValue_plan_2eproto.base,
   &scc_info_PlanNode_plan_2eproto.base,
   &scc_info_QueryInfo_plan_2eproto.base,
+  &scc_info_RangeSearchInfo_plan_2eproto.base,
+  &scc_info_StringMatchExpr_plan_2eproto.base,
   &scc_info_TermExpr_plan_2eproto.base,
   &scc_info_UnaryRangeExpr_plan_2eproto.base,
   &scc_info_VectorANNS_plan_2eproto.base,
@@ -472,10 +602,10 @@ static ::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase*const descriptor_table_pla
 static ::PROTOBUF_NAMESPACE_ID::internal::once_flag descriptor_table_plan_2eproto_once;
 static bool descriptor_table_plan_2eproto_initialized = false;
 const ::PROTOBUF_NAMESPACE_ID::internal::DescriptorTable descriptor_table_plan_2eproto = {
-  &descriptor_table_plan_2eproto_initialized, descriptor_table_protodef_plan_2eproto, "plan.proto", 2186,
-  &descriptor_table_plan_2eproto_once, descriptor_table_plan_2eproto_sccs, descriptor_table_plan_2eproto_deps, 10, 1,
+  &descriptor_table_plan_2eproto_initialized, descriptor_table_protodef_plan_2eproto, "plan.proto", 3102,
+  &descriptor_table_plan_2eproto_once, descriptor_table_plan_2eproto_sccs, descriptor_table_plan_2eproto_deps, 13, 1,
   schemas, file_default_instances, TableStruct_plan_2eproto::offsets,
-  file_level_metadata_plan_2eproto, 12, file_level_enum_descriptors_plan_2eproto, file_level_service_descriptors_plan_2eproto,
+  file_level_metadata_plan_2eproto, 15, file_level_enum_descriptors_plan_2eproto, file_level_service_descriptors_plan_2eproto,
 };
 
 // Force running AddDescriptors() at dynamic initialization time.
@@ -483,10 +613,35 @@ static bool dynamic_init_dummy_plan_2eproto = (  ::PROTOBUF_NAMESPACE_ID::intern
 namespace milvus {
 namespace proto {
 namespace plan {
-const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* UnaryExpr_UnaryOp_descriptor() {
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* StringMatchExpr_MatchOp_descriptor() {
   ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_plan_2eproto);
   return file_level_enum_descriptors_plan_2eproto[0];
 }
+bool StringMatchExpr_MatchOp_IsValid(int value) {
+  switch (value) {
+    case 0:
+    case 1:
+    case 2:
+    case 3:
+      return true;
+    default:
+      return false;
+  }
+}
+
+#if (__cplusplus < 201703) && (!defined(_MSC_VER) || _MSC_VER >= 1900)
+constexpr StringMatchExpr_MatchOp StringMatchExpr::Invalid;
+constexpr StringMatchExpr_MatchOp StringMatchExpr::Prefix;
+constexpr StringMatchExpr_MatchOp StringMatchExpr::Suffix;
+constexpr StringMatchExpr_MatchOp StringMatchExpr::Contains;
+constexpr StringMatchExpr_MatchOp StringMatchExpr::MatchOp_MIN;
+constexpr StringMatchExpr_MatchOp StringMatchExpr::MatchOp_MAX;
+constexpr int StringMatchExpr::MatchOp_ARRAYSIZE;
+#endif  // (__cplusplus < 201703) && (!defined(_MSC_VER) || _MSC_VER >= 1900)
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* UnaryExpr_UnaryOp_descriptor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_plan_2eproto);
+  return file_level_enum_descriptors_plan_2eproto[1];
+}
 bool UnaryExpr_UnaryOp_IsValid(int value) {
   switch (value) {
     case 0:
@@ -506,7 +661,7 @@ constexpr int UnaryExpr::UnaryOp_ARRAYSIZE;
 #endif  // (__cplusplus < 201703) && (!defined(_MSC_VER) || _MSC_VER >= 1900)
 const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* BinaryExpr_BinaryOp_descriptor() {
   ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_plan_2eproto);
-  return file_level_enum_descriptors_plan_2eproto[1];
+  return file_level_enum_descriptors_plan_2eproto[2];
 }
 bool BinaryExpr_BinaryOp_IsValid(int value) {
   switch (value) {
@@ -529,7 +684,7 @@ constexpr int BinaryExpr::BinaryOp_ARRAYSIZE;
 #endif  // (__cplusplus < 201703) && (!defined(_MSC_VER) || _MSC_VER >= 1900)
 const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* OpType_descriptor() {
   ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_plan_2eproto);
-  return file_level_enum_descriptors_plan_2eproto[2];
+  return file_level_enum_descriptors_plan_2eproto[3];
 }
 bool OpType_IsValid(int value) {
   switch (value) {
@@ -546,6 +701,24 @@ bool OpType_IsValid(int value) {
   }
 }
 
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* ArithOpType_descriptor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_plan_2eproto);
+  return file_level_enum_descriptors_plan_2eproto[4];
+}
+bool ArithOpType_IsValid(int value) {
+  switch (value) {
+    case 0:
+    case 1:
+    case 2:
+    case 3:
+    case 4:
+    case 5:
+      return true;
+    default:
+      return false;
+  }
+}
+
 
 // ===================================================================
 
@@ -553,6 +726,8 @@ void GenericValue::InitAsDefaultInstance() {
   ::milvus::proto::plan::_GenericValue_default_instance_.bool_val_ = false;
   ::milvus::proto::plan::_GenericValue_default_instance_.int64_val_ = PROTOBUF_LONGLONG(0);
   ::milvus::proto::plan::_GenericValue_default_instance_.float_val_ = 0;
+  ::milvus::proto::plan::_GenericValue_default_instance_.string_val_.UnsafeSetDefault(
+      &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
 class GenericValue::_Internal {
  public:
@@ -581,6 +756,10 @@ GenericValue::GenericValue(const GenericValue& from)
       set_float_val(from.float_val());
       break;
     }
+    case kStringVal: {
+      set_string_val(from.string_val());
+      break;
+    }
     case VAL_NOT_SET: {
       break;
     }
@@ -589,6 +768,7 @@ GenericValue::GenericValue(const GenericValue& from)
 }
 
 void GenericValue::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_GenericValue_plan_2eproto.base);
   clear_has_val();
 }
 
@@ -627,6 +807,10 @@ void GenericValue::clear_val() {
       // No need to clear
       break;
     }
+    case kStringVal: {
+      val_.string_val_.DestroyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+      break;
+    }
     case VAL_NOT_SET: {
       break;
     }
@@ -674,6 +858,13 @@ const char* GenericValue::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_I
           ptr += sizeof(double);
         } else goto handle_unusual;
         continue;
+      // string string_val = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 34)) {
+          ptr = ::PROTOBUF_NAMESPACE_ID::internal::InlineGreedyStringParserUTF8(mutable_string_val(), ptr, ctx, "milvus.proto.plan.GenericValue.string_val");
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
       default: {
       handle_unusual:
         if ((tag & 7) == 4 || tag == 0) {
@@ -746,6 +937,21 @@ bool GenericValue::MergePartialFromCodedStream(
         break;
       }
 
+      // string string_val = 4;
+      case 4: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (34 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadString(
+                input, this->mutable_string_val()));
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+            this->string_val().data(), static_cast<int>(this->string_val().length()),
+            ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::PARSE,
+            "milvus.proto.plan.GenericValue.string_val"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
       default: {
       handle_unusual:
         if (tag == 0) {
@@ -788,6 +994,16 @@ void GenericValue::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteDouble(3, this->float_val(), output);
   }
 
+  // string string_val = 4;
+  if (has_string_val()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->string_val().data(), static_cast<int>(this->string_val().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "milvus.proto.plan.GenericValue.string_val");
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringMaybeAliased(
+      4, this->string_val(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
@@ -816,6 +1032,17 @@ void GenericValue::SerializeWithCachedSizes(
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteDoubleToArray(3, this->float_val(), target);
   }
 
+  // string string_val = 4;
+  if (has_string_val()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->string_val().data(), static_cast<int>(this->string_val().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "milvus.proto.plan.GenericValue.string_val");
+    target =
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringToArray(
+        4, this->string_val(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
@@ -855,6 +1082,13 @@ size_t GenericValue::ByteSizeLong() const {
       total_size += 1 + 8;
       break;
     }
+    // string string_val = 4;
+    case kStringVal: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+          this->string_val());
+      break;
+    }
     case VAL_NOT_SET: {
       break;
     }
@@ -899,6 +1133,10 @@ void GenericValue::MergeFrom(const GenericValue& from) {
       set_float_val(from.float_val());
       break;
     }
+    case kStringVal: {
+      set_string_val(from.string_val());
+      break;
+    }
     case VAL_NOT_SET: {
       break;
     }
@@ -937,98 +1175,82 @@ void GenericValue::InternalSwap(GenericValue* other) {
 
 // ===================================================================
 
-void QueryInfo::InitAsDefaultInstance() {
+void RangeSearchInfo::InitAsDefaultInstance() {
 }
-class QueryInfo::_Internal {
+class RangeSearchInfo::_Internal {
  public:
 };
 
-QueryInfo::QueryInfo()
+RangeSearchInfo::RangeSearchInfo()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:milvus.proto.plan.QueryInfo)
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.RangeSearchInfo)
 }
-QueryInfo::QueryInfo(const QueryInfo& from)
+RangeSearchInfo::RangeSearchInfo(const RangeSearchInfo& from)
   : ::PROTOBUF_NAMESPACE_ID::Message(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  metric_type_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
-  if (!from.metric_type().empty()) {
-    metric_type_.AssignWithDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), from.metric_type_);
-  }
-  search_params_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
-  if (!from.search_params().empty()) {
-    search_params_.AssignWithDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), from.search_params_);
-  }
-  topk_ = from.topk_;
-  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.QueryInfo)
+  ::memcpy(&radius_, &from.radius_,
+    static_cast<size_t>(reinterpret_cast<char*>(&range_filter_) -
+    reinterpret_cast<char*>(&radius_)) + sizeof(range_filter_));
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.RangeSearchInfo)
 }
 
-void QueryInfo::SharedCtor() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_QueryInfo_plan_2eproto.base);
-  metric_type_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
-  search_params_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
-  topk_ = PROTOBUF_LONGLONG(0);
+void RangeSearchInfo::SharedCtor() {
+  ::memset(&radius_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&range_filter_) -
+      reinterpret_cast<char*>(&radius_)) + sizeof(range_filter_));
 }
 
-QueryInfo::~QueryInfo() {
-  // @@protoc_insertion_point(destructor:milvus.proto.plan.QueryInfo)
+RangeSearchInfo::~RangeSearchInfo() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.RangeSearchInfo)
   SharedDtor();
 }
 
-void QueryInfo::SharedDtor() {
-  metric_type_.DestroyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
-  search_params_.DestroyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+void RangeSearchInfo::SharedDtor() {
 }
 
-void QueryInfo::SetCachedSize(int size) const {
+void RangeSearchInfo::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const QueryInfo& QueryInfo::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_QueryInfo_plan_2eproto.base);
+const RangeSearchInfo& RangeSearchInfo::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_RangeSearchInfo_plan_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void QueryInfo::Clear() {
-// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.QueryInfo)
+void RangeSearchInfo::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.RangeSearchInfo)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  metric_type_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
-  search_params_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
-  topk_ = PROTOBUF_LONGLONG(0);
+  ::memset(&radius_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&range_filter_) -
+      reinterpret_cast<char*>(&radius_)) + sizeof(range_filter_));
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* QueryInfo::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+const char* RangeSearchInfo::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     ::PROTOBUF_NAMESPACE_ID::uint32 tag;
     ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
     CHK_(ptr);
     switch (tag >> 3) {
-      // int64 topk = 1;
+      // float radius = 1;
       case 1:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 8)) {
-          topk_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
-          CHK_(ptr);
-        } else goto handle_unusual;
-        continue;
-      // string metric_type = 3;
-      case 3:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 26)) {
-          ptr = ::PROTOBUF_NAMESPACE_ID::internal::InlineGreedyStringParserUTF8(mutable_metric_type(), ptr, ctx, "milvus.proto.plan.QueryInfo.metric_type");
-          CHK_(ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 13)) {
+          radius_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<float>(ptr);
+          ptr += sizeof(float);
         } else goto handle_unusual;
         continue;
-      // string search_params = 4;
-      case 4:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 34)) {
-          ptr = ::PROTOBUF_NAMESPACE_ID::internal::InlineGreedyStringParserUTF8(mutable_search_params(), ptr, ctx, "milvus.proto.plan.QueryInfo.search_params");
-          CHK_(ptr);
+      // float range_filter = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 21)) {
+          range_filter_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<float>(ptr);
+          ptr += sizeof(float);
         } else goto handle_unusual;
         continue;
       default: {
@@ -1051,53 +1273,36 @@ failure:
 #undef CHK_
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool QueryInfo::MergePartialFromCodedStream(
+bool RangeSearchInfo::MergePartialFromCodedStream(
     ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::PROTOBUF_NAMESPACE_ID::uint32 tag;
-  // @@protoc_insertion_point(parse_start:milvus.proto.plan.QueryInfo)
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.RangeSearchInfo)
   for (;;) {
     ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // int64 topk = 1;
+      // float radius = 1;
       case 1: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (8 & 0xFF)) {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (13 & 0xFF)) {
 
           DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
-                   ::PROTOBUF_NAMESPACE_ID::int64, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_INT64>(
-                 input, &topk_)));
+                   float, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_FLOAT>(
+                 input, &radius_)));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // string metric_type = 3;
-      case 3: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (26 & 0xFF)) {
-          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadString(
-                input, this->mutable_metric_type()));
-          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
-            this->metric_type().data(), static_cast<int>(this->metric_type().length()),
-            ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::PARSE,
-            "milvus.proto.plan.QueryInfo.metric_type"));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
+      // float range_filter = 2;
+      case 2: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (21 & 0xFF)) {
 
-      // string search_params = 4;
-      case 4: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (34 & 0xFF)) {
-          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadString(
-                input, this->mutable_search_params()));
-          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
-            this->search_params().data(), static_cast<int>(this->search_params().length()),
-            ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::PARSE,
-            "milvus.proto.plan.QueryInfo.search_params"));
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   float, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_FLOAT>(
+                 input, &range_filter_)));
         } else {
           goto handle_unusual;
         }
@@ -1116,96 +1321,64 @@ bool QueryInfo::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:milvus.proto.plan.QueryInfo)
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.RangeSearchInfo)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.QueryInfo)
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.RangeSearchInfo)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void QueryInfo::SerializeWithCachedSizes(
+void RangeSearchInfo::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.QueryInfo)
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.RangeSearchInfo)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 topk = 1;
-  if (this->topk() != 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64(1, this->topk(), output);
-  }
-
-  // string metric_type = 3;
-  if (this->metric_type().size() > 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
-      this->metric_type().data(), static_cast<int>(this->metric_type().length()),
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
-      "milvus.proto.plan.QueryInfo.metric_type");
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringMaybeAliased(
-      3, this->metric_type(), output);
+  // float radius = 1;
+  if (!(this->radius() <= 0 && this->radius() >= 0)) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteFloat(1, this->radius(), output);
   }
 
-  // string search_params = 4;
-  if (this->search_params().size() > 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
-      this->search_params().data(), static_cast<int>(this->search_params().length()),
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
-      "milvus.proto.plan.QueryInfo.search_params");
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringMaybeAliased(
-      4, this->search_params(), output);
+  // float range_filter = 2;
+  if (!(this->range_filter() <= 0 && this->range_filter() >= 0)) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteFloat(2, this->range_filter(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.QueryInfo)
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.RangeSearchInfo)
 }
 
-::PROTOBUF_NAMESPACE_ID::uint8* QueryInfo::InternalSerializeWithCachedSizesToArray(
+::PROTOBUF_NAMESPACE_ID::uint8* RangeSearchInfo::InternalSerializeWithCachedSizesToArray(
     ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.QueryInfo)
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.RangeSearchInfo)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 topk = 1;
-  if (this->topk() != 0) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64ToArray(1, this->topk(), target);
-  }
-
-  // string metric_type = 3;
-  if (this->metric_type().size() > 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
-      this->metric_type().data(), static_cast<int>(this->metric_type().length()),
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
-      "milvus.proto.plan.QueryInfo.metric_type");
-    target =
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringToArray(
-        3, this->metric_type(), target);
+  // float radius = 1;
+  if (!(this->radius() <= 0 && this->radius() >= 0)) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteFloatToArray(1, this->radius(), target);
   }
 
-  // string search_params = 4;
-  if (this->search_params().size() > 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
-      this->search_params().data(), static_cast<int>(this->search_params().length()),
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
-      "milvus.proto.plan.QueryInfo.search_params");
-    target =
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringToArray(
-        4, this->search_params(), target);
+  // float range_filter = 2;
+  if (!(this->range_filter() <= 0 && this->range_filter() >= 0)) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteFloatToArray(2, this->range_filter(), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.QueryInfo)
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.RangeSearchInfo)
   return target;
 }
 
-size_t QueryInfo::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.QueryInfo)
+size_t RangeSearchInfo::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.RangeSearchInfo)
   size_t total_size = 0;
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -1217,25 +1390,14 @@ size_t QueryInfo::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // string metric_type = 3;
-  if (this->metric_type().size() > 0) {
-    total_size += 1 +
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
-        this->metric_type());
+  // float radius = 1;
+  if (!(this->radius() <= 0 && this->radius() >= 0)) {
+    total_size += 1 + 4;
   }
 
-  // string search_params = 4;
-  if (this->search_params().size() > 0) {
-    total_size += 1 +
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
-        this->search_params());
-  }
-
-  // int64 topk = 1;
-  if (this->topk() != 0) {
-    total_size += 1 +
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::Int64Size(
-        this->topk());
+  // float range_filter = 2;
+  if (!(this->range_filter() <= 0 && this->range_filter() >= 0)) {
+    total_size += 1 + 4;
   }
 
   int cached_size = ::PROTOBUF_NAMESPACE_ID::internal::ToCachedSize(total_size);
@@ -1243,166 +1405,203 @@ size_t QueryInfo::ByteSizeLong() const {
   return total_size;
 }
 
-void QueryInfo::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.QueryInfo)
+void RangeSearchInfo::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.RangeSearchInfo)
   GOOGLE_DCHECK_NE(&from, this);
-  const QueryInfo* source =
-      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<QueryInfo>(
+  const RangeSearchInfo* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<RangeSearchInfo>(
           &from);
   if (source == nullptr) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.QueryInfo)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.RangeSearchInfo)
     ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.QueryInfo)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.RangeSearchInfo)
     MergeFrom(*source);
   }
 }
 
-void QueryInfo::MergeFrom(const QueryInfo& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.QueryInfo)
+void RangeSearchInfo::MergeFrom(const RangeSearchInfo& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.RangeSearchInfo)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.metric_type().size() > 0) {
-
-    metric_type_.AssignWithDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), from.metric_type_);
-  }
-  if (from.search_params().size() > 0) {
-
-    search_params_.AssignWithDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), from.search_params_);
+  if (!(from.radius() <= 0 && from.radius() >= 0)) {
+    set_radius(from.radius());
   }
-  if (from.topk() != 0) {
-    set_topk(from.topk());
+  if (!(from.range_filter() <= 0 && from.range_filter() >= 0)) {
+    set_range_filter(from.range_filter());
   }
 }
 
-void QueryInfo::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.QueryInfo)
+void RangeSearchInfo::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.RangeSearchInfo)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void QueryInfo::CopyFrom(const QueryInfo& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.QueryInfo)
+void RangeSearchInfo::CopyFrom(const RangeSearchInfo& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.RangeSearchInfo)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool QueryInfo::IsInitialized() const {
+bool RangeSearchInfo::IsInitialized() const {
   return true;
 }
 
-void QueryInfo::InternalSwap(QueryInfo* other) {
+void RangeSearchInfo::InternalSwap(RangeSearchInfo* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  metric_type_.Swap(&other->metric_type_, &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
-    GetArenaNoVirtual());
-  search_params_.Swap(&other->search_params_, &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
-    GetArenaNoVirtual());
-  swap(topk_, other->topk_);
+  swap(radius_, other->radius_);
+  swap(range_filter_, other->range_filter_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata QueryInfo::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata RangeSearchInfo::GetMetadata() const {
   return GetMetadataStatic();
 }
 
 
 // ===================================================================
 
-void ColumnInfo::InitAsDefaultInstance() {
+void QueryInfo::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_QueryInfo_default_instance_._instance.get_mutable()->range_search_info_ = const_cast< ::milvus::proto::plan::RangeSearchInfo*>(
+      ::milvus::proto::plan::RangeSearchInfo::internal_default_instance());
 }
-class ColumnInfo::_Internal {
+class QueryInfo::_Internal {
  public:
+  static const ::milvus::proto::plan::RangeSearchInfo& range_search_info(const QueryInfo* msg);
 };
 
-ColumnInfo::ColumnInfo()
+const ::milvus::proto::plan::RangeSearchInfo&
+QueryInfo::_Internal::range_search_info(const QueryInfo* msg) {
+  return *msg->range_search_info_;
+}
+QueryInfo::QueryInfo()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:milvus.proto.plan.ColumnInfo)
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.QueryInfo)
 }
-ColumnInfo::ColumnInfo(const ColumnInfo& from)
+QueryInfo::QueryInfo(const QueryInfo& from)
   : ::PROTOBUF_NAMESPACE_ID::Message(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  ::memcpy(&field_id_, &from.field_id_,
-    static_cast<size_t>(reinterpret_cast<char*>(&is_autoid_) -
-    reinterpret_cast<char*>(&field_id_)) + sizeof(is_autoid_));
-  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.ColumnInfo)
+  metric_type_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  if (!from.metric_type().empty()) {
+    metric_type_.AssignWithDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), from.metric_type_);
+  }
+  search_params_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  if (!from.search_params().empty()) {
+    search_params_.AssignWithDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), from.search_params_);
+  }
+  if (from.has_range_search_info()) {
+    range_search_info_ = new ::milvus::proto::plan::RangeSearchInfo(*from.range_search_info_);
+  } else {
+    range_search_info_ = nullptr;
+  }
+  ::memcpy(&topk_, &from.topk_,
+    static_cast<size_t>(reinterpret_cast<char*>(&group_size_) -
+    reinterpret_cast<char*>(&topk_)) + sizeof(group_size_));
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.QueryInfo)
 }
 
-void ColumnInfo::SharedCtor() {
-  ::memset(&field_id_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&is_autoid_) -
-      reinterpret_cast<char*>(&field_id_)) + sizeof(is_autoid_));
+void QueryInfo::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_QueryInfo_plan_2eproto.base);
+  metric_type_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  search_params_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  ::memset(&range_search_info_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&group_size_) -
+      reinterpret_cast<char*>(&range_search_info_)) + sizeof(group_size_));
 }
 
-ColumnInfo::~ColumnInfo() {
-  // @@protoc_insertion_point(destructor:milvus.proto.plan.ColumnInfo)
+QueryInfo::~QueryInfo() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.QueryInfo)
   SharedDtor();
 }
 
-void ColumnInfo::SharedDtor() {
+void QueryInfo::SharedDtor() {
+  metric_type_.DestroyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  search_params_.DestroyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  if (this != internal_default_instance()) delete range_search_info_;
 }
 
-void ColumnInfo::SetCachedSize(int size) const {
+void QueryInfo::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const ColumnInfo& ColumnInfo::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_ColumnInfo_plan_2eproto.base);
+const QueryInfo& QueryInfo::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_QueryInfo_plan_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void ColumnInfo::Clear() {
-// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.ColumnInfo)
+void QueryInfo::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.QueryInfo)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  ::memset(&field_id_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&is_autoid_) -
-      reinterpret_cast<char*>(&field_id_)) + sizeof(is_autoid_));
+  metric_type_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  search_params_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  if (GetArenaNoVirtual() == nullptr && range_search_info_ != nullptr) {
+    delete range_search_info_;
+  }
+  range_search_info_ = nullptr;
+  ::memset(&topk_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&group_size_) -
+      reinterpret_cast<char*>(&topk_)) + sizeof(group_size_));
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* ColumnInfo::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+const char* QueryInfo::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     ::PROTOBUF_NAMESPACE_ID::uint32 tag;
     ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
     CHK_(ptr);
     switch (tag >> 3) {
-      // int64 field_id = 1;
+      // int64 topk = 1;
       case 1:
         if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 8)) {
-          field_id_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          topk_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
-      // .milvus.proto.schema.DataType data_type = 2;
-      case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
-          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+      // string metric_type = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 26)) {
+          ptr = ::PROTOBUF_NAMESPACE_ID::internal::InlineGreedyStringParserUTF8(mutable_metric_type(), ptr, ctx, "milvus.proto.plan.QueryInfo.metric_type");
           CHK_(ptr);
-          set_data_type(static_cast<::milvus::proto::schema::DataType>(val));
         } else goto handle_unusual;
         continue;
-      // bool is_primary_key = 3;
-      case 3:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 24)) {
-          is_primary_key_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+      // string search_params = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 34)) {
+          ptr = ::PROTOBUF_NAMESPACE_ID::internal::InlineGreedyStringParserUTF8(mutable_search_params(), ptr, ctx, "milvus.proto.plan.QueryInfo.search_params");
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
-      // bool is_autoID = 4;
-      case 4:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 32)) {
-          is_autoid_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+      // .milvus.proto.plan.RangeSearchInfo range_search_info = 5;
+      case 5:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 42)) {
+          ptr = ctx->ParseMessage(mutable_range_search_info(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // int64 group_by_field_id = 6;
+      case 6:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 48)) {
+          group_by_field_id_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // int64 group_size = 7;
+      case 7:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 56)) {
+          group_size_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
@@ -1426,63 +1625,90 @@ failure:
 #undef CHK_
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool ColumnInfo::MergePartialFromCodedStream(
+bool QueryInfo::MergePartialFromCodedStream(
     ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::PROTOBUF_NAMESPACE_ID::uint32 tag;
-  // @@protoc_insertion_point(parse_start:milvus.proto.plan.ColumnInfo)
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.QueryInfo)
   for (;;) {
     ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // int64 field_id = 1;
+      // int64 topk = 1;
       case 1: {
         if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (8 & 0xFF)) {
 
           DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
                    ::PROTOBUF_NAMESPACE_ID::int64, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_INT64>(
-                 input, &field_id_)));
+                 input, &topk_)));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // .milvus.proto.schema.DataType data_type = 2;
-      case 2: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
-          int value = 0;
-          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
-                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
-                 input, &value)));
-          set_data_type(static_cast< ::milvus::proto::schema::DataType >(value));
+      // string metric_type = 3;
+      case 3: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadString(
+                input, this->mutable_metric_type()));
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+            this->metric_type().data(), static_cast<int>(this->metric_type().length()),
+            ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::PARSE,
+            "milvus.proto.plan.QueryInfo.metric_type"));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // bool is_primary_key = 3;
-      case 3: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (24 & 0xFF)) {
+      // string search_params = 4;
+      case 4: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (34 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadString(
+                input, this->mutable_search_params()));
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+            this->search_params().data(), static_cast<int>(this->search_params().length()),
+            ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::PARSE,
+            "milvus.proto.plan.QueryInfo.search_params"));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.RangeSearchInfo range_search_info = 5;
+      case 5: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (42 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_range_search_info()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // int64 group_by_field_id = 6;
+      case 6: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (48 & 0xFF)) {
 
           DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &is_primary_key_)));
+                   ::PROTOBUF_NAMESPACE_ID::int64, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_INT64>(
+                 input, &group_by_field_id_)));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // bool is_autoID = 4;
-      case 4: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (32 & 0xFF)) {
+      // int64 group_size = 7;
+      case 7: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (56 & 0xFF)) {
 
           DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &is_autoid_)));
+                   ::PROTOBUF_NAMESPACE_ID::int64, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_INT64>(
+                 input, &group_size_)));
         } else {
           goto handle_unusual;
         }
@@ -1501,64 +1727,515 @@ bool ColumnInfo::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:milvus.proto.plan.ColumnInfo)
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.QueryInfo)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.ColumnInfo)
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.QueryInfo)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void ColumnInfo::SerializeWithCachedSizes(
+void QueryInfo::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.ColumnInfo)
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.QueryInfo)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 field_id = 1;
-  if (this->field_id() != 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64(1, this->field_id(), output);
+  // int64 topk = 1;
+  if (this->topk() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64(1, this->topk(), output);
   }
 
-  // .milvus.proto.schema.DataType data_type = 2;
-  if (this->data_type() != 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
-      2, this->data_type(), output);
+  // string metric_type = 3;
+  if (this->metric_type().size() > 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->metric_type().data(), static_cast<int>(this->metric_type().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "milvus.proto.plan.QueryInfo.metric_type");
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringMaybeAliased(
+      3, this->metric_type(), output);
   }
 
-  // bool is_primary_key = 3;
-  if (this->is_primary_key() != 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBool(3, this->is_primary_key(), output);
+  // string search_params = 4;
+  if (this->search_params().size() > 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->search_params().data(), static_cast<int>(this->search_params().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "milvus.proto.plan.QueryInfo.search_params");
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringMaybeAliased(
+      4, this->search_params(), output);
   }
 
-  // bool is_autoID = 4;
-  if (this->is_autoid() != 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBool(4, this->is_autoid(), output);
+  // .milvus.proto.plan.RangeSearchInfo range_search_info = 5;
+  if (this->has_range_search_info()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, _Internal::range_search_info(this), output);
+  }
+
+  // int64 group_by_field_id = 6;
+  if (this->group_by_field_id() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64(6, this->group_by_field_id(), output);
+  }
+
+  // int64 group_size = 7;
+  if (this->group_size() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64(7, this->group_size(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.ColumnInfo)
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.QueryInfo)
 }
 
-::PROTOBUF_NAMESPACE_ID::uint8* ColumnInfo::InternalSerializeWithCachedSizesToArray(
+::PROTOBUF_NAMESPACE_ID::uint8* QueryInfo::InternalSerializeWithCachedSizesToArray(
     ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.ColumnInfo)
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.QueryInfo)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 field_id = 1;
-  if (this->field_id() != 0) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64ToArray(1, this->field_id(), target);
-  }
-
-  // .milvus.proto.schema.DataType data_type = 2;
-  if (this->data_type() != 0) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
-      2, this->data_type(), target);
+  // int64 topk = 1;
+  if (this->topk() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64ToArray(1, this->topk(), target);
+  }
+
+  // string metric_type = 3;
+  if (this->metric_type().size() > 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->metric_type().data(), static_cast<int>(this->metric_type().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "milvus.proto.plan.QueryInfo.metric_type");
+    target =
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringToArray(
+        3, this->metric_type(), target);
+  }
+
+  // string search_params = 4;
+  if (this->search_params().size() > 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->search_params().data(), static_cast<int>(this->search_params().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "milvus.proto.plan.QueryInfo.search_params");
+    target =
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringToArray(
+        4, this->search_params(), target);
+  }
+
+  // .milvus.proto.plan.RangeSearchInfo range_search_info = 5;
+  if (this->has_range_search_info()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        5, _Internal::range_search_info(this), target);
+  }
+
+  // int64 group_by_field_id = 6;
+  if (this->group_by_field_id() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64ToArray(6, this->group_by_field_id(), target);
+  }
+
+  // int64 group_size = 7;
+  if (this->group_size() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64ToArray(7, this->group_size(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.QueryInfo)
+  return target;
+}
+
+size_t QueryInfo::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.QueryInfo)
+  size_t total_size = 0;
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::ComputeUnknownFieldsSize(
+        _internal_metadata_.unknown_fields());
+  }
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // string metric_type = 3;
+  if (this->metric_type().size() > 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->metric_type());
+  }
+
+  // string search_params = 4;
+  if (this->search_params().size() > 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->search_params());
+  }
+
+  // .milvus.proto.plan.RangeSearchInfo range_search_info = 5;
+  if (this->has_range_search_info()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *range_search_info_);
+  }
+
+  // int64 topk = 1;
+  if (this->topk() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::Int64Size(
+        this->topk());
+  }
+
+  // int64 group_by_field_id = 6;
+  if (this->group_by_field_id() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::Int64Size(
+        this->group_by_field_id());
+  }
+
+  // int64 group_size = 7;
+  if (this->group_size() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::Int64Size(
+        this->group_size());
+  }
+
+  int cached_size = ::PROTOBUF_NAMESPACE_ID::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void QueryInfo::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.QueryInfo)
+  GOOGLE_DCHECK_NE(&from, this);
+  const QueryInfo* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<QueryInfo>(
+          &from);
+  if (source == nullptr) {
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.QueryInfo)
+    ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
+  } else {
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.QueryInfo)
+    MergeFrom(*source);
+  }
+}
+
+void QueryInfo::MergeFrom(const QueryInfo& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.QueryInfo)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.metric_type().size() > 0) {
+
+    metric_type_.AssignWithDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), from.metric_type_);
+  }
+  if (from.search_params().size() > 0) {
+
+    search_params_.AssignWithDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), from.search_params_);
+  }
+  if (from.has_range_search_info()) {
+    mutable_range_search_info()->::milvus::proto::plan::RangeSearchInfo::MergeFrom(from.range_search_info());
+  }
+  if (from.topk() != 0) {
+    set_topk(from.topk());
+  }
+  if (from.group_by_field_id() != 0) {
+    set_group_by_field_id(from.group_by_field_id());
+  }
+  if (from.group_size() != 0) {
+    set_group_size(from.group_size());
+  }
+}
+
+void QueryInfo::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.QueryInfo)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void QueryInfo::CopyFrom(const QueryInfo& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.QueryInfo)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool QueryInfo::IsInitialized() const {
+  return true;
+}
+
+void QueryInfo::InternalSwap(QueryInfo* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  metric_type_.Swap(&other->metric_type_, &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  search_params_.Swap(&other->search_params_, &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(range_search_info_, other->range_search_info_);
+  swap(topk_, other->topk_);
+  swap(group_by_field_id_, other->group_by_field_id_);
+  swap(group_size_, other->group_size_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata QueryInfo::GetMetadata() const {
+  return GetMetadataStatic();
+}
+
+
+// ===================================================================
+
+void ColumnInfo::InitAsDefaultInstance() {
+}
+class ColumnInfo::_Internal {
+ public:
+};
+
+ColumnInfo::ColumnInfo()
+  : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.ColumnInfo)
+}
+ColumnInfo::ColumnInfo(const ColumnInfo& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::memcpy(&field_id_, &from.field_id_,
+    static_cast<size_t>(reinterpret_cast<char*>(&is_autoid_) -
+    reinterpret_cast<char*>(&field_id_)) + sizeof(is_autoid_));
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.ColumnInfo)
+}
+
+void ColumnInfo::SharedCtor() {
+  ::memset(&field_id_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&is_autoid_) -
+      reinterpret_cast<char*>(&field_id_)) + sizeof(is_autoid_));
+}
+
+ColumnInfo::~ColumnInfo() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.ColumnInfo)
+  SharedDtor();
+}
+
+void ColumnInfo::SharedDtor() {
+}
+
+void ColumnInfo::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const ColumnInfo& ColumnInfo::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_ColumnInfo_plan_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void ColumnInfo::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.ColumnInfo)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  ::memset(&field_id_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&is_autoid_) -
+      reinterpret_cast<char*>(&field_id_)) + sizeof(is_autoid_));
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* ColumnInfo::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    ::PROTOBUF_NAMESPACE_ID::uint32 tag;
+    ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
+    CHK_(ptr);
+    switch (tag >> 3) {
+      // int64 field_id = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 8)) {
+          field_id_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.schema.DataType data_type = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+          set_data_type(static_cast<::milvus::proto::schema::DataType>(val));
+        } else goto handle_unusual;
+        continue;
+      // bool is_primary_key = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 24)) {
+          is_primary_key_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // bool is_autoID = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 32)) {
+          is_autoid_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->SetLastTag(tag);
+          goto success;
+        }
+        ptr = UnknownFieldParse(tag, &_internal_metadata_, ptr, ctx);
+        CHK_(ptr != nullptr);
+        continue;
+      }
+    }  // switch
+  }  // while
+success:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto success;
+#undef CHK_
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool ColumnInfo::MergePartialFromCodedStream(
+    ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::PROTOBUF_NAMESPACE_ID::uint32 tag;
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.ColumnInfo)
+  for (;;) {
+    ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // int64 field_id = 1;
+      case 1: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (8 & 0xFF)) {
+
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   ::PROTOBUF_NAMESPACE_ID::int64, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_INT64>(
+                 input, &field_id_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.schema.DataType data_type = 2;
+      case 2: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_data_type(static_cast< ::milvus::proto::schema::DataType >(value));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bool is_primary_key = 3;
+      case 3: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (24 & 0xFF)) {
+
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &is_primary_key_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bool is_autoID = 4;
+      case 4: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (32 & 0xFF)) {
+
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &is_autoid_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SkipField(
+              input, tag, _internal_metadata_.mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.ColumnInfo)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.ColumnInfo)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void ColumnInfo::SerializeWithCachedSizes(
+    ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.ColumnInfo)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int64 field_id = 1;
+  if (this->field_id() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64(1, this->field_id(), output);
+  }
+
+  // .milvus.proto.schema.DataType data_type = 2;
+  if (this->data_type() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
+      2, this->data_type(), output);
+  }
+
+  // bool is_primary_key = 3;
+  if (this->is_primary_key() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBool(3, this->is_primary_key(), output);
+  }
+
+  // bool is_autoID = 4;
+  if (this->is_autoid() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBool(4, this->is_autoid(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
+        _internal_metadata_.unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.ColumnInfo)
+}
+
+::PROTOBUF_NAMESPACE_ID::uint8* ColumnInfo::InternalSerializeWithCachedSizesToArray(
+    ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.ColumnInfo)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int64 field_id = 1;
+  if (this->field_id() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64ToArray(1, this->field_id(), target);
+  }
+
+  // .milvus.proto.schema.DataType data_type = 2;
+  if (this->data_type() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
+      2, this->data_type(), target);
   }
 
   // bool is_primary_key = 3;
@@ -1566,21 +2243,852 @@ void ColumnInfo::SerializeWithCachedSizes(
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBoolToArray(3, this->is_primary_key(), target);
   }
 
-  // bool is_autoID = 4;
-  if (this->is_autoid() != 0) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBoolToArray(4, this->is_autoid(), target);
+  // bool is_autoID = 4;
+  if (this->is_autoid() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBoolToArray(4, this->is_autoid(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.ColumnInfo)
+  return target;
+}
+
+size_t ColumnInfo::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.ColumnInfo)
+  size_t total_size = 0;
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::ComputeUnknownFieldsSize(
+        _internal_metadata_.unknown_fields());
+  }
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // int64 field_id = 1;
+  if (this->field_id() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::Int64Size(
+        this->field_id());
+  }
+
+  // .milvus.proto.schema.DataType data_type = 2;
+  if (this->data_type() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->data_type());
+  }
+
+  // bool is_primary_key = 3;
+  if (this->is_primary_key() != 0) {
+    total_size += 1 + 1;
+  }
+
+  // bool is_autoID = 4;
+  if (this->is_autoid() != 0) {
+    total_size += 1 + 1;
+  }
+
+  int cached_size = ::PROTOBUF_NAMESPACE_ID::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void ColumnInfo::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.ColumnInfo)
+  GOOGLE_DCHECK_NE(&from, this);
+  const ColumnInfo* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<ColumnInfo>(
+          &from);
+  if (source == nullptr) {
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.ColumnInfo)
+    ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
+  } else {
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.ColumnInfo)
+    MergeFrom(*source);
+  }
+}
+
+void ColumnInfo::MergeFrom(const ColumnInfo& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.ColumnInfo)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.field_id() != 0) {
+    set_field_id(from.field_id());
+  }
+  if (from.data_type() != 0) {
+    set_data_type(from.data_type());
+  }
+  if (from.is_primary_key() != 0) {
+    set_is_primary_key(from.is_primary_key());
+  }
+  if (from.is_autoid() != 0) {
+    set_is_autoid(from.is_autoid());
+  }
+}
+
+void ColumnInfo::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.ColumnInfo)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ColumnInfo::CopyFrom(const ColumnInfo& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.ColumnInfo)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ColumnInfo::IsInitialized() const {
+  return true;
+}
+
+void ColumnInfo::InternalSwap(ColumnInfo* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  swap(field_id_, other->field_id_);
+  swap(data_type_, other->data_type_);
+  swap(is_primary_key_, other->is_primary_key_);
+  swap(is_autoid_, other->is_autoid_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata ColumnInfo::GetMetadata() const {
+  return GetMetadataStatic();
+}
+
+
+// ===================================================================
+
+void UnaryRangeExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_UnaryRangeExpr_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
+      ::milvus::proto::plan::ColumnInfo::internal_default_instance());
+  ::milvus::proto::plan::_UnaryRangeExpr_default_instance_._instance.get_mutable()->value_ = const_cast< ::milvus::proto::plan::GenericValue*>(
+      ::milvus::proto::plan::GenericValue::internal_default_instance());
+}
+class UnaryRangeExpr::_Internal {
+ public:
+  static const ::milvus::proto::plan::ColumnInfo& column_info(const UnaryRangeExpr* msg);
+  static const ::milvus::proto::plan::GenericValue& value(const UnaryRangeExpr* msg);
+};
+
+const ::milvus::proto::plan::ColumnInfo&
+UnaryRangeExpr::_Internal::column_info(const UnaryRangeExpr* msg) {
+  return *msg->column_info_;
+}
+const ::milvus::proto::plan::GenericValue&
+UnaryRangeExpr::_Internal::value(const UnaryRangeExpr* msg) {
+  return *msg->value_;
+}
+UnaryRangeExpr::UnaryRangeExpr()
+  : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.UnaryRangeExpr)
+}
+UnaryRangeExpr::UnaryRangeExpr(const UnaryRangeExpr& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  if (from.has_column_info()) {
+    column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.column_info_);
+  } else {
+    column_info_ = nullptr;
+  }
+  if (from.has_value()) {
+    value_ = new ::milvus::proto::plan::GenericValue(*from.value_);
+  } else {
+    value_ = nullptr;
+  }
+  op_ = from.op_;
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.UnaryRangeExpr)
+}
+
+void UnaryRangeExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_UnaryRangeExpr_plan_2eproto.base);
+  ::memset(&column_info_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&op_) -
+      reinterpret_cast<char*>(&column_info_)) + sizeof(op_));
+}
+
+UnaryRangeExpr::~UnaryRangeExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.UnaryRangeExpr)
+  SharedDtor();
+}
+
+void UnaryRangeExpr::SharedDtor() {
+  if (this != internal_default_instance()) delete column_info_;
+  if (this != internal_default_instance()) delete value_;
+}
+
+void UnaryRangeExpr::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const UnaryRangeExpr& UnaryRangeExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_UnaryRangeExpr_plan_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void UnaryRangeExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.UnaryRangeExpr)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
+    delete column_info_;
+  }
+  column_info_ = nullptr;
+  if (GetArenaNoVirtual() == nullptr && value_ != nullptr) {
+    delete value_;
+  }
+  value_ = nullptr;
+  op_ = 0;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* UnaryRangeExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    ::PROTOBUF_NAMESPACE_ID::uint32 tag;
+    ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
+    CHK_(ptr);
+    switch (tag >> 3) {
+      // .milvus.proto.plan.ColumnInfo column_info = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 10)) {
+          ptr = ctx->ParseMessage(mutable_column_info(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.OpType op = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+          set_op(static_cast<::milvus::proto::plan::OpType>(val));
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.GenericValue value = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 26)) {
+          ptr = ctx->ParseMessage(mutable_value(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->SetLastTag(tag);
+          goto success;
+        }
+        ptr = UnknownFieldParse(tag, &_internal_metadata_, ptr, ctx);
+        CHK_(ptr != nullptr);
+        continue;
+      }
+    }  // switch
+  }  // while
+success:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto success;
+#undef CHK_
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool UnaryRangeExpr::MergePartialFromCodedStream(
+    ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::PROTOBUF_NAMESPACE_ID::uint32 tag;
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.UnaryRangeExpr)
+  for (;;) {
+    ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .milvus.proto.plan.ColumnInfo column_info = 1;
+      case 1: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_column_info()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.OpType op = 2;
+      case 2: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_op(static_cast< ::milvus::proto::plan::OpType >(value));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.GenericValue value = 3;
+      case 3: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SkipField(
+              input, tag, _internal_metadata_.mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.UnaryRangeExpr)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.UnaryRangeExpr)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void UnaryRangeExpr::SerializeWithCachedSizes(
+    ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.UnaryRangeExpr)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, _Internal::column_info(this), output);
+  }
+
+  // .milvus.proto.plan.OpType op = 2;
+  if (this->op() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
+      2, this->op(), output);
+  }
+
+  // .milvus.proto.plan.GenericValue value = 3;
+  if (this->has_value()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, _Internal::value(this), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
+        _internal_metadata_.unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.UnaryRangeExpr)
+}
+
+::PROTOBUF_NAMESPACE_ID::uint8* UnaryRangeExpr::InternalSerializeWithCachedSizesToArray(
+    ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.UnaryRangeExpr)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        1, _Internal::column_info(this), target);
+  }
+
+  // .milvus.proto.plan.OpType op = 2;
+  if (this->op() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
+      2, this->op(), target);
+  }
+
+  // .milvus.proto.plan.GenericValue value = 3;
+  if (this->has_value()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        3, _Internal::value(this), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.UnaryRangeExpr)
+  return target;
+}
+
+size_t UnaryRangeExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.UnaryRangeExpr)
+  size_t total_size = 0;
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::ComputeUnknownFieldsSize(
+        _internal_metadata_.unknown_fields());
+  }
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *column_info_);
+  }
+
+  // .milvus.proto.plan.GenericValue value = 3;
+  if (this->has_value()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *value_);
+  }
+
+  // .milvus.proto.plan.OpType op = 2;
+  if (this->op() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->op());
+  }
+
+  int cached_size = ::PROTOBUF_NAMESPACE_ID::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void UnaryRangeExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.UnaryRangeExpr)
+  GOOGLE_DCHECK_NE(&from, this);
+  const UnaryRangeExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<UnaryRangeExpr>(
+          &from);
+  if (source == nullptr) {
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.UnaryRangeExpr)
+    ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
+  } else {
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.UnaryRangeExpr)
+    MergeFrom(*source);
+  }
+}
+
+void UnaryRangeExpr::MergeFrom(const UnaryRangeExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.UnaryRangeExpr)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.has_column_info()) {
+    mutable_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.column_info());
+  }
+  if (from.has_value()) {
+    mutable_value()->::milvus::proto::plan::GenericValue::MergeFrom(from.value());
+  }
+  if (from.op() != 0) {
+    set_op(from.op());
+  }
+}
+
+void UnaryRangeExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.UnaryRangeExpr)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void UnaryRangeExpr::CopyFrom(const UnaryRangeExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.UnaryRangeExpr)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool UnaryRangeExpr::IsInitialized() const {
+  return true;
+}
+
+void UnaryRangeExpr::InternalSwap(UnaryRangeExpr* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  swap(column_info_, other->column_info_);
+  swap(value_, other->value_);
+  swap(op_, other->op_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata UnaryRangeExpr::GetMetadata() const {
+  return GetMetadataStatic();
+}
+
+
+// ===================================================================
+
+void BinaryRangeExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_BinaryRangeExpr_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
+      ::milvus::proto::plan::ColumnInfo::internal_default_instance());
+  ::milvus::proto::plan::_BinaryRangeExpr_default_instance_._instance.get_mutable()->lower_value_ = const_cast< ::milvus::proto::plan::GenericValue*>(
+      ::milvus::proto::plan::GenericValue::internal_default_instance());
+  ::milvus::proto::plan::_BinaryRangeExpr_default_instance_._instance.get_mutable()->upper_value_ = const_cast< ::milvus::proto::plan::GenericValue*>(
+      ::milvus::proto::plan::GenericValue::internal_default_instance());
+}
+class BinaryRangeExpr::_Internal {
+ public:
+  static const ::milvus::proto::plan::ColumnInfo& column_info(const BinaryRangeExpr* msg);
+  static const ::milvus::proto::plan::GenericValue& lower_value(const BinaryRangeExpr* msg);
+  static const ::milvus::proto::plan::GenericValue& upper_value(const BinaryRangeExpr* msg);
+};
+
+const ::milvus::proto::plan::ColumnInfo&
+BinaryRangeExpr::_Internal::column_info(const BinaryRangeExpr* msg) {
+  return *msg->column_info_;
+}
+const ::milvus::proto::plan::GenericValue&
+BinaryRangeExpr::_Internal::lower_value(const BinaryRangeExpr* msg) {
+  return *msg->lower_value_;
+}
+const ::milvus::proto::plan::GenericValue&
+BinaryRangeExpr::_Internal::upper_value(const BinaryRangeExpr* msg) {
+  return *msg->upper_value_;
+}
+BinaryRangeExpr::BinaryRangeExpr()
+  : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.BinaryRangeExpr)
+}
+BinaryRangeExpr::BinaryRangeExpr(const BinaryRangeExpr& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  if (from.has_column_info()) {
+    column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.column_info_);
+  } else {
+    column_info_ = nullptr;
+  }
+  if (from.has_lower_value()) {
+    lower_value_ = new ::milvus::proto::plan::GenericValue(*from.lower_value_);
+  } else {
+    lower_value_ = nullptr;
+  }
+  if (from.has_upper_value()) {
+    upper_value_ = new ::milvus::proto::plan::GenericValue(*from.upper_value_);
+  } else {
+    upper_value_ = nullptr;
+  }
+  ::memcpy(&lower_inclusive_, &from.lower_inclusive_,
+    static_cast<size_t>(reinterpret_cast<char*>(&upper_inclusive_) -
+    reinterpret_cast<char*>(&lower_inclusive_)) + sizeof(upper_inclusive_));
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.BinaryRangeExpr)
+}
+
+void BinaryRangeExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_BinaryRangeExpr_plan_2eproto.base);
+  ::memset(&column_info_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&upper_inclusive_) -
+      reinterpret_cast<char*>(&column_info_)) + sizeof(upper_inclusive_));
+}
+
+BinaryRangeExpr::~BinaryRangeExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.BinaryRangeExpr)
+  SharedDtor();
+}
+
+void BinaryRangeExpr::SharedDtor() {
+  if (this != internal_default_instance()) delete column_info_;
+  if (this != internal_default_instance()) delete lower_value_;
+  if (this != internal_default_instance()) delete upper_value_;
+}
+
+void BinaryRangeExpr::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const BinaryRangeExpr& BinaryRangeExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_BinaryRangeExpr_plan_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void BinaryRangeExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.BinaryRangeExpr)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
+    delete column_info_;
+  }
+  column_info_ = nullptr;
+  if (GetArenaNoVirtual() == nullptr && lower_value_ != nullptr) {
+    delete lower_value_;
+  }
+  lower_value_ = nullptr;
+  if (GetArenaNoVirtual() == nullptr && upper_value_ != nullptr) {
+    delete upper_value_;
+  }
+  upper_value_ = nullptr;
+  ::memset(&lower_inclusive_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&upper_inclusive_) -
+      reinterpret_cast<char*>(&lower_inclusive_)) + sizeof(upper_inclusive_));
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* BinaryRangeExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    ::PROTOBUF_NAMESPACE_ID::uint32 tag;
+    ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
+    CHK_(ptr);
+    switch (tag >> 3) {
+      // .milvus.proto.plan.ColumnInfo column_info = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 10)) {
+          ptr = ctx->ParseMessage(mutable_column_info(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // bool lower_inclusive = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
+          lower_inclusive_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // bool upper_inclusive = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 24)) {
+          upper_inclusive_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.GenericValue lower_value = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 34)) {
+          ptr = ctx->ParseMessage(mutable_lower_value(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.GenericValue upper_value = 5;
+      case 5:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 42)) {
+          ptr = ctx->ParseMessage(mutable_upper_value(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->SetLastTag(tag);
+          goto success;
+        }
+        ptr = UnknownFieldParse(tag, &_internal_metadata_, ptr, ctx);
+        CHK_(ptr != nullptr);
+        continue;
+      }
+    }  // switch
+  }  // while
+success:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto success;
+#undef CHK_
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool BinaryRangeExpr::MergePartialFromCodedStream(
+    ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::PROTOBUF_NAMESPACE_ID::uint32 tag;
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.BinaryRangeExpr)
+  for (;;) {
+    ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .milvus.proto.plan.ColumnInfo column_info = 1;
+      case 1: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_column_info()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bool lower_inclusive = 2;
+      case 2: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
+
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &lower_inclusive_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // bool upper_inclusive = 3;
+      case 3: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (24 & 0xFF)) {
+
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &upper_inclusive_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.GenericValue lower_value = 4;
+      case 4: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (34 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_lower_value()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.GenericValue upper_value = 5;
+      case 5: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (42 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_upper_value()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SkipField(
+              input, tag, _internal_metadata_.mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.BinaryRangeExpr)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.BinaryRangeExpr)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void BinaryRangeExpr::SerializeWithCachedSizes(
+    ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.BinaryRangeExpr)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, _Internal::column_info(this), output);
+  }
+
+  // bool lower_inclusive = 2;
+  if (this->lower_inclusive() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBool(2, this->lower_inclusive(), output);
+  }
+
+  // bool upper_inclusive = 3;
+  if (this->upper_inclusive() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBool(3, this->upper_inclusive(), output);
+  }
+
+  // .milvus.proto.plan.GenericValue lower_value = 4;
+  if (this->has_lower_value()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, _Internal::lower_value(this), output);
+  }
+
+  // .milvus.proto.plan.GenericValue upper_value = 5;
+  if (this->has_upper_value()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, _Internal::upper_value(this), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
+        _internal_metadata_.unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.BinaryRangeExpr)
+}
+
+::PROTOBUF_NAMESPACE_ID::uint8* BinaryRangeExpr::InternalSerializeWithCachedSizesToArray(
+    ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.BinaryRangeExpr)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        1, _Internal::column_info(this), target);
+  }
+
+  // bool lower_inclusive = 2;
+  if (this->lower_inclusive() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBoolToArray(2, this->lower_inclusive(), target);
+  }
+
+  // bool upper_inclusive = 3;
+  if (this->upper_inclusive() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBoolToArray(3, this->upper_inclusive(), target);
+  }
+
+  // .milvus.proto.plan.GenericValue lower_value = 4;
+  if (this->has_lower_value()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        4, _Internal::lower_value(this), target);
+  }
+
+  // .milvus.proto.plan.GenericValue upper_value = 5;
+  if (this->has_upper_value()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        5, _Internal::upper_value(this), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.ColumnInfo)
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.BinaryRangeExpr)
   return target;
 }
 
-size_t ColumnInfo::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.ColumnInfo)
+size_t BinaryRangeExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.BinaryRangeExpr)
   size_t total_size = 0;
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -1592,26 +3100,34 @@ size_t ColumnInfo::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // int64 field_id = 1;
-  if (this->field_id() != 0) {
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
     total_size += 1 +
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::Int64Size(
-        this->field_id());
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *column_info_);
   }
 
-  // .milvus.proto.schema.DataType data_type = 2;
-  if (this->data_type() != 0) {
+  // .milvus.proto.plan.GenericValue lower_value = 4;
+  if (this->has_lower_value()) {
     total_size += 1 +
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->data_type());
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *lower_value_);
   }
 
-  // bool is_primary_key = 3;
-  if (this->is_primary_key() != 0) {
+  // .milvus.proto.plan.GenericValue upper_value = 5;
+  if (this->has_upper_value()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *upper_value_);
+  }
+
+  // bool lower_inclusive = 2;
+  if (this->lower_inclusive() != 0) {
     total_size += 1 + 1;
   }
 
-  // bool is_autoID = 4;
-  if (this->is_autoid() != 0) {
+  // bool upper_inclusive = 3;
+  if (this->upper_inclusive() != 0) {
     total_size += 1 + 1;
   }
 
@@ -1620,191 +3136,195 @@ size_t ColumnInfo::ByteSizeLong() const {
   return total_size;
 }
 
-void ColumnInfo::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.ColumnInfo)
+void BinaryRangeExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.BinaryRangeExpr)
   GOOGLE_DCHECK_NE(&from, this);
-  const ColumnInfo* source =
-      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<ColumnInfo>(
+  const BinaryRangeExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<BinaryRangeExpr>(
           &from);
   if (source == nullptr) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.ColumnInfo)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.BinaryRangeExpr)
     ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.ColumnInfo)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.BinaryRangeExpr)
     MergeFrom(*source);
   }
 }
 
-void ColumnInfo::MergeFrom(const ColumnInfo& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.ColumnInfo)
+void BinaryRangeExpr::MergeFrom(const BinaryRangeExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.BinaryRangeExpr)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.field_id() != 0) {
-    set_field_id(from.field_id());
+  if (from.has_column_info()) {
+    mutable_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.column_info());
   }
-  if (from.data_type() != 0) {
-    set_data_type(from.data_type());
+  if (from.has_lower_value()) {
+    mutable_lower_value()->::milvus::proto::plan::GenericValue::MergeFrom(from.lower_value());
   }
-  if (from.is_primary_key() != 0) {
-    set_is_primary_key(from.is_primary_key());
+  if (from.has_upper_value()) {
+    mutable_upper_value()->::milvus::proto::plan::GenericValue::MergeFrom(from.upper_value());
   }
-  if (from.is_autoid() != 0) {
-    set_is_autoid(from.is_autoid());
+  if (from.lower_inclusive() != 0) {
+    set_lower_inclusive(from.lower_inclusive());
+  }
+  if (from.upper_inclusive() != 0) {
+    set_upper_inclusive(from.upper_inclusive());
   }
 }
 
-void ColumnInfo::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.ColumnInfo)
+void BinaryRangeExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.BinaryRangeExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ColumnInfo::CopyFrom(const ColumnInfo& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.ColumnInfo)
+void BinaryRangeExpr::CopyFrom(const BinaryRangeExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.BinaryRangeExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ColumnInfo::IsInitialized() const {
+bool BinaryRangeExpr::IsInitialized() const {
   return true;
 }
 
-void ColumnInfo::InternalSwap(ColumnInfo* other) {
+void BinaryRangeExpr::InternalSwap(BinaryRangeExpr* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  swap(field_id_, other->field_id_);
-  swap(data_type_, other->data_type_);
-  swap(is_primary_key_, other->is_primary_key_);
-  swap(is_autoid_, other->is_autoid_);
+  swap(column_info_, other->column_info_);
+  swap(lower_value_, other->lower_value_);
+  swap(upper_value_, other->upper_value_);
+  swap(lower_inclusive_, other->lower_inclusive_);
+  swap(upper_inclusive_, other->upper_inclusive_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata ColumnInfo::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata BinaryRangeExpr::GetMetadata() const {
   return GetMetadataStatic();
 }
 
 
 // ===================================================================
 
-void UnaryRangeExpr::InitAsDefaultInstance() {
-  ::milvus::proto::plan::_UnaryRangeExpr_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
+void CompareExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_CompareExpr_default_instance_._instance.get_mutable()->left_column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
+      ::milvus::proto::plan::ColumnInfo::internal_default_instance());
+  ::milvus::proto::plan::_CompareExpr_default_instance_._instance.get_mutable()->right_column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
       ::milvus::proto::plan::ColumnInfo::internal_default_instance());
-  ::milvus::proto::plan::_UnaryRangeExpr_default_instance_._instance.get_mutable()->value_ = const_cast< ::milvus::proto::plan::GenericValue*>(
-      ::milvus::proto::plan::GenericValue::internal_default_instance());
 }
-class UnaryRangeExpr::_Internal {
+class CompareExpr::_Internal {
  public:
-  static const ::milvus::proto::plan::ColumnInfo& column_info(const UnaryRangeExpr* msg);
-  static const ::milvus::proto::plan::GenericValue& value(const UnaryRangeExpr* msg);
+  static const ::milvus::proto::plan::ColumnInfo& left_column_info(const CompareExpr* msg);
+  static const ::milvus::proto::plan::ColumnInfo& right_column_info(const CompareExpr* msg);
 };
 
 const ::milvus::proto::plan::ColumnInfo&
-UnaryRangeExpr::_Internal::column_info(const UnaryRangeExpr* msg) {
-  return *msg->column_info_;
+CompareExpr::_Internal::left_column_info(const CompareExpr* msg) {
+  return *msg->left_column_info_;
 }
-const ::milvus::proto::plan::GenericValue&
-UnaryRangeExpr::_Internal::value(const UnaryRangeExpr* msg) {
-  return *msg->value_;
+const ::milvus::proto::plan::ColumnInfo&
+CompareExpr::_Internal::right_column_info(const CompareExpr* msg) {
+  return *msg->right_column_info_;
 }
-UnaryRangeExpr::UnaryRangeExpr()
+CompareExpr::CompareExpr()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.CompareExpr)
 }
-UnaryRangeExpr::UnaryRangeExpr(const UnaryRangeExpr& from)
+CompareExpr::CompareExpr(const CompareExpr& from)
   : ::PROTOBUF_NAMESPACE_ID::Message(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  if (from.has_column_info()) {
-    column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.column_info_);
+  if (from.has_left_column_info()) {
+    left_column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.left_column_info_);
   } else {
-    column_info_ = nullptr;
+    left_column_info_ = nullptr;
   }
-  if (from.has_value()) {
-    value_ = new ::milvus::proto::plan::GenericValue(*from.value_);
+  if (from.has_right_column_info()) {
+    right_column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.right_column_info_);
   } else {
-    value_ = nullptr;
+    right_column_info_ = nullptr;
   }
   op_ = from.op_;
-  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.CompareExpr)
 }
 
-void UnaryRangeExpr::SharedCtor() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_UnaryRangeExpr_plan_2eproto.base);
-  ::memset(&column_info_, 0, static_cast<size_t>(
+void CompareExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_CompareExpr_plan_2eproto.base);
+  ::memset(&left_column_info_, 0, static_cast<size_t>(
       reinterpret_cast<char*>(&op_) -
-      reinterpret_cast<char*>(&column_info_)) + sizeof(op_));
+      reinterpret_cast<char*>(&left_column_info_)) + sizeof(op_));
 }
 
-UnaryRangeExpr::~UnaryRangeExpr() {
-  // @@protoc_insertion_point(destructor:milvus.proto.plan.UnaryRangeExpr)
+CompareExpr::~CompareExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.CompareExpr)
   SharedDtor();
 }
-
-void UnaryRangeExpr::SharedDtor() {
-  if (this != internal_default_instance()) delete column_info_;
-  if (this != internal_default_instance()) delete value_;
+
+void CompareExpr::SharedDtor() {
+  if (this != internal_default_instance()) delete left_column_info_;
+  if (this != internal_default_instance()) delete right_column_info_;
 }
 
-void UnaryRangeExpr::SetCachedSize(int size) const {
+void CompareExpr::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const UnaryRangeExpr& UnaryRangeExpr::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_UnaryRangeExpr_plan_2eproto.base);
+const CompareExpr& CompareExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_CompareExpr_plan_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void UnaryRangeExpr::Clear() {
-// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.UnaryRangeExpr)
+void CompareExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.CompareExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
-    delete column_info_;
+  if (GetArenaNoVirtual() == nullptr && left_column_info_ != nullptr) {
+    delete left_column_info_;
   }
-  column_info_ = nullptr;
-  if (GetArenaNoVirtual() == nullptr && value_ != nullptr) {
-    delete value_;
+  left_column_info_ = nullptr;
+  if (GetArenaNoVirtual() == nullptr && right_column_info_ != nullptr) {
+    delete right_column_info_;
   }
-  value_ = nullptr;
+  right_column_info_ = nullptr;
   op_ = 0;
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* UnaryRangeExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+const char* CompareExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     ::PROTOBUF_NAMESPACE_ID::uint32 tag;
     ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
     CHK_(ptr);
     switch (tag >> 3) {
-      // .milvus.proto.plan.ColumnInfo column_info = 1;
+      // .milvus.proto.plan.ColumnInfo left_column_info = 1;
       case 1:
         if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 10)) {
-          ptr = ctx->ParseMessage(mutable_column_info(), ptr);
+          ptr = ctx->ParseMessage(mutable_left_column_info(), ptr);
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
-      // .milvus.proto.plan.OpType op = 2;
+      // .milvus.proto.plan.ColumnInfo right_column_info = 2;
       case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
-          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 18)) {
+          ptr = ctx->ParseMessage(mutable_right_column_info(), ptr);
           CHK_(ptr);
-          set_op(static_cast<::milvus::proto::plan::OpType>(val));
         } else goto handle_unusual;
         continue;
-      // .milvus.proto.plan.GenericValue value = 3;
+      // .milvus.proto.plan.OpType op = 3;
       case 3:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 26)) {
-          ptr = ctx->ParseMessage(mutable_value(), ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 24)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
           CHK_(ptr);
+          set_op(static_cast<::milvus::proto::plan::OpType>(val));
         } else goto handle_unusual;
         continue;
       default: {
@@ -1827,46 +3347,46 @@ failure:
 #undef CHK_
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool UnaryRangeExpr::MergePartialFromCodedStream(
+bool CompareExpr::MergePartialFromCodedStream(
     ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::PROTOBUF_NAMESPACE_ID::uint32 tag;
-  // @@protoc_insertion_point(parse_start:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.CompareExpr)
   for (;;) {
     ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // .milvus.proto.plan.ColumnInfo column_info = 1;
+      // .milvus.proto.plan.ColumnInfo left_column_info = 1;
       case 1: {
         if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (10 & 0xFF)) {
           DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_column_info()));
+               input, mutable_left_column_info()));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // .milvus.proto.plan.OpType op = 2;
+      // .milvus.proto.plan.ColumnInfo right_column_info = 2;
       case 2: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
-          int value = 0;
-          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
-                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
-                 input, &value)));
-          set_op(static_cast< ::milvus::proto::plan::OpType >(value));
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_right_column_info()));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // .milvus.proto.plan.GenericValue value = 3;
+      // .milvus.proto.plan.OpType op = 3;
       case 3: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (26 & 0xFF)) {
-          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_value()));
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (24 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_op(static_cast< ::milvus::proto::plan::OpType >(value));
         } else {
           goto handle_unusual;
         }
@@ -1885,82 +3405,82 @@ bool UnaryRangeExpr::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.CompareExpr)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.CompareExpr)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void UnaryRangeExpr::SerializeWithCachedSizes(
+void CompareExpr::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.CompareExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.ColumnInfo column_info = 1;
-  if (this->has_column_info()) {
+  // .milvus.proto.plan.ColumnInfo left_column_info = 1;
+  if (this->has_left_column_info()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, _Internal::column_info(this), output);
+      1, _Internal::left_column_info(this), output);
   }
 
-  // .milvus.proto.plan.OpType op = 2;
-  if (this->op() != 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
-      2, this->op(), output);
+  // .milvus.proto.plan.ColumnInfo right_column_info = 2;
+  if (this->has_right_column_info()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, _Internal::right_column_info(this), output);
   }
 
-  // .milvus.proto.plan.GenericValue value = 3;
-  if (this->has_value()) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, _Internal::value(this), output);
+  // .milvus.proto.plan.OpType op = 3;
+  if (this->op() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
+      3, this->op(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.CompareExpr)
 }
 
-::PROTOBUF_NAMESPACE_ID::uint8* UnaryRangeExpr::InternalSerializeWithCachedSizesToArray(
+::PROTOBUF_NAMESPACE_ID::uint8* CompareExpr::InternalSerializeWithCachedSizesToArray(
     ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.CompareExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.ColumnInfo column_info = 1;
-  if (this->has_column_info()) {
+  // .milvus.proto.plan.ColumnInfo left_column_info = 1;
+  if (this->has_left_column_info()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
       InternalWriteMessageToArray(
-        1, _Internal::column_info(this), target);
-  }
-
-  // .milvus.proto.plan.OpType op = 2;
-  if (this->op() != 0) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
-      2, this->op(), target);
+        1, _Internal::left_column_info(this), target);
   }
 
-  // .milvus.proto.plan.GenericValue value = 3;
-  if (this->has_value()) {
+  // .milvus.proto.plan.ColumnInfo right_column_info = 2;
+  if (this->has_right_column_info()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
       InternalWriteMessageToArray(
-        3, _Internal::value(this), target);
+        2, _Internal::right_column_info(this), target);
+  }
+
+  // .milvus.proto.plan.OpType op = 3;
+  if (this->op() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
+      3, this->op(), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.CompareExpr)
   return target;
 }
 
-size_t UnaryRangeExpr::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.UnaryRangeExpr)
+size_t CompareExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.CompareExpr)
   size_t total_size = 0;
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -1972,21 +3492,21 @@ size_t UnaryRangeExpr::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.ColumnInfo column_info = 1;
-  if (this->has_column_info()) {
+  // .milvus.proto.plan.ColumnInfo left_column_info = 1;
+  if (this->has_left_column_info()) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *column_info_);
+        *left_column_info_);
   }
 
-  // .milvus.proto.plan.GenericValue value = 3;
-  if (this->has_value()) {
+  // .milvus.proto.plan.ColumnInfo right_column_info = 2;
+  if (this->has_right_column_info()) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *value_);
+        *right_column_info_);
   }
 
-  // .milvus.proto.plan.OpType op = 2;
+  // .milvus.proto.plan.OpType op = 3;
   if (this->op() != 0) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->op());
@@ -1997,182 +3517,142 @@ size_t UnaryRangeExpr::ByteSizeLong() const {
   return total_size;
 }
 
-void UnaryRangeExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.UnaryRangeExpr)
+void CompareExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.CompareExpr)
   GOOGLE_DCHECK_NE(&from, this);
-  const UnaryRangeExpr* source =
-      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<UnaryRangeExpr>(
+  const CompareExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<CompareExpr>(
           &from);
   if (source == nullptr) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.CompareExpr)
     ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.UnaryRangeExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.CompareExpr)
     MergeFrom(*source);
   }
 }
 
-void UnaryRangeExpr::MergeFrom(const UnaryRangeExpr& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.UnaryRangeExpr)
+void CompareExpr::MergeFrom(const CompareExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.CompareExpr)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.has_column_info()) {
-    mutable_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.column_info());
+  if (from.has_left_column_info()) {
+    mutable_left_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.left_column_info());
   }
-  if (from.has_value()) {
-    mutable_value()->::milvus::proto::plan::GenericValue::MergeFrom(from.value());
+  if (from.has_right_column_info()) {
+    mutable_right_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.right_column_info());
   }
   if (from.op() != 0) {
     set_op(from.op());
   }
 }
 
-void UnaryRangeExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.UnaryRangeExpr)
+void CompareExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.CompareExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void UnaryRangeExpr::CopyFrom(const UnaryRangeExpr& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.UnaryRangeExpr)
+void CompareExpr::CopyFrom(const CompareExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.CompareExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool UnaryRangeExpr::IsInitialized() const {
+bool CompareExpr::IsInitialized() const {
   return true;
 }
 
-void UnaryRangeExpr::InternalSwap(UnaryRangeExpr* other) {
+void CompareExpr::InternalSwap(CompareExpr* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  swap(column_info_, other->column_info_);
-  swap(value_, other->value_);
+  swap(left_column_info_, other->left_column_info_);
+  swap(right_column_info_, other->right_column_info_);
   swap(op_, other->op_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata UnaryRangeExpr::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata CompareExpr::GetMetadata() const {
   return GetMetadataStatic();
 }
 
 
 // ===================================================================
 
-void BinaryRangeExpr::InitAsDefaultInstance() {
-  ::milvus::proto::plan::_BinaryRangeExpr_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
+void TermExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_TermExpr_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
       ::milvus::proto::plan::ColumnInfo::internal_default_instance());
-  ::milvus::proto::plan::_BinaryRangeExpr_default_instance_._instance.get_mutable()->lower_value_ = const_cast< ::milvus::proto::plan::GenericValue*>(
-      ::milvus::proto::plan::GenericValue::internal_default_instance());
-  ::milvus::proto::plan::_BinaryRangeExpr_default_instance_._instance.get_mutable()->upper_value_ = const_cast< ::milvus::proto::plan::GenericValue*>(
-      ::milvus::proto::plan::GenericValue::internal_default_instance());
 }
-class BinaryRangeExpr::_Internal {
+class TermExpr::_Internal {
  public:
-  static const ::milvus::proto::plan::ColumnInfo& column_info(const BinaryRangeExpr* msg);
-  static const ::milvus::proto::plan::GenericValue& lower_value(const BinaryRangeExpr* msg);
-  static const ::milvus::proto::plan::GenericValue& upper_value(const BinaryRangeExpr* msg);
+  static const ::milvus::proto::plan::ColumnInfo& column_info(const TermExpr* msg);
 };
 
 const ::milvus::proto::plan::ColumnInfo&
-BinaryRangeExpr::_Internal::column_info(const BinaryRangeExpr* msg) {
+TermExpr::_Internal::column_info(const TermExpr* msg) {
   return *msg->column_info_;
 }
-const ::milvus::proto::plan::GenericValue&
-BinaryRangeExpr::_Internal::lower_value(const BinaryRangeExpr* msg) {
-  return *msg->lower_value_;
-}
-const ::milvus::proto::plan::GenericValue&
-BinaryRangeExpr::_Internal::upper_value(const BinaryRangeExpr* msg) {
-  return *msg->upper_value_;
-}
-BinaryRangeExpr::BinaryRangeExpr()
+TermExpr::TermExpr()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.TermExpr)
 }
-BinaryRangeExpr::BinaryRangeExpr(const BinaryRangeExpr& from)
+TermExpr::TermExpr(const TermExpr& from)
   : ::PROTOBUF_NAMESPACE_ID::Message(),
-      _internal_metadata_(nullptr) {
+      _internal_metadata_(nullptr),
+      values_(from.values_) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   if (from.has_column_info()) {
     column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.column_info_);
   } else {
     column_info_ = nullptr;
   }
-  if (from.has_lower_value()) {
-    lower_value_ = new ::milvus::proto::plan::GenericValue(*from.lower_value_);
-  } else {
-    lower_value_ = nullptr;
-  }
-  if (from.has_upper_value()) {
-    upper_value_ = new ::milvus::proto::plan::GenericValue(*from.upper_value_);
-  } else {
-    upper_value_ = nullptr;
-  }
-  ::memcpy(&lower_inclusive_, &from.lower_inclusive_,
-    static_cast<size_t>(reinterpret_cast<char*>(&upper_inclusive_) -
-    reinterpret_cast<char*>(&lower_inclusive_)) + sizeof(upper_inclusive_));
-  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.TermExpr)
 }
 
-void BinaryRangeExpr::SharedCtor() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_BinaryRangeExpr_plan_2eproto.base);
-  ::memset(&column_info_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&upper_inclusive_) -
-      reinterpret_cast<char*>(&column_info_)) + sizeof(upper_inclusive_));
+void TermExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_TermExpr_plan_2eproto.base);
+  column_info_ = nullptr;
 }
 
-BinaryRangeExpr::~BinaryRangeExpr() {
-  // @@protoc_insertion_point(destructor:milvus.proto.plan.BinaryRangeExpr)
+TermExpr::~TermExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.TermExpr)
   SharedDtor();
 }
 
-void BinaryRangeExpr::SharedDtor() {
+void TermExpr::SharedDtor() {
   if (this != internal_default_instance()) delete column_info_;
-  if (this != internal_default_instance()) delete lower_value_;
-  if (this != internal_default_instance()) delete upper_value_;
 }
 
-void BinaryRangeExpr::SetCachedSize(int size) const {
+void TermExpr::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const BinaryRangeExpr& BinaryRangeExpr::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_BinaryRangeExpr_plan_2eproto.base);
+const TermExpr& TermExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_TermExpr_plan_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void BinaryRangeExpr::Clear() {
-// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.BinaryRangeExpr)
+void TermExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.TermExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
+  values_.Clear();
   if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
     delete column_info_;
   }
   column_info_ = nullptr;
-  if (GetArenaNoVirtual() == nullptr && lower_value_ != nullptr) {
-    delete lower_value_;
-  }
-  lower_value_ = nullptr;
-  if (GetArenaNoVirtual() == nullptr && upper_value_ != nullptr) {
-    delete upper_value_;
-  }
-  upper_value_ = nullptr;
-  ::memset(&lower_inclusive_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&upper_inclusive_) -
-      reinterpret_cast<char*>(&lower_inclusive_)) + sizeof(upper_inclusive_));
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* BinaryRangeExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+const char* TermExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     ::PROTOBUF_NAMESPACE_ID::uint32 tag;
@@ -2186,32 +3666,16 @@ const char* BinaryRangeExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPAC
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
-      // bool lower_inclusive = 2;
+      // repeated .milvus.proto.plan.GenericValue values = 2;
       case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
-          lower_inclusive_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
-          CHK_(ptr);
-        } else goto handle_unusual;
-        continue;
-      // bool upper_inclusive = 3;
-      case 3:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 24)) {
-          upper_inclusive_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
-          CHK_(ptr);
-        } else goto handle_unusual;
-        continue;
-      // .milvus.proto.plan.GenericValue lower_value = 4;
-      case 4:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 34)) {
-          ptr = ctx->ParseMessage(mutable_lower_value(), ptr);
-          CHK_(ptr);
-        } else goto handle_unusual;
-        continue;
-      // .milvus.proto.plan.GenericValue upper_value = 5;
-      case 5:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 42)) {
-          ptr = ctx->ParseMessage(mutable_upper_value(), ptr);
-          CHK_(ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 18)) {
+          ptr -= 1;
+          do {
+            ptr += 1;
+            ptr = ctx->ParseMessage(add_values(), ptr);
+            CHK_(ptr);
+            if (!ctx->DataAvailable(ptr)) break;
+          } while (::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<::PROTOBUF_NAMESPACE_ID::uint8>(ptr) == 18);
         } else goto handle_unusual;
         continue;
       default: {
@@ -2234,11 +3698,11 @@ failure:
 #undef CHK_
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool BinaryRangeExpr::MergePartialFromCodedStream(
+bool TermExpr::MergePartialFromCodedStream(
     ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::PROTOBUF_NAMESPACE_ID::uint32 tag;
-  // @@protoc_insertion_point(parse_start:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.TermExpr)
   for (;;) {
     ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
@@ -2255,48 +3719,11 @@ bool BinaryRangeExpr::MergePartialFromCodedStream(
         break;
       }
 
-      // bool lower_inclusive = 2;
+      // repeated .milvus.proto.plan.GenericValue values = 2;
       case 2: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
-
-          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &lower_inclusive_)));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
-
-      // bool upper_inclusive = 3;
-      case 3: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (24 & 0xFF)) {
-
-          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &upper_inclusive_)));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
-
-      // .milvus.proto.plan.GenericValue lower_value = 4;
-      case 4: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (34 & 0xFF)) {
-          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_lower_value()));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
-
-      // .milvus.proto.plan.GenericValue upper_value = 5;
-      case 5: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (42 & 0xFF)) {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (18 & 0xFF)) {
           DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_upper_value()));
+                input, add_values()));
         } else {
           goto handle_unusual;
         }
@@ -2315,18 +3742,18 @@ bool BinaryRangeExpr::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.TermExpr)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.TermExpr)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void BinaryRangeExpr::SerializeWithCachedSizes(
+void TermExpr::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.TermExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
@@ -2336,38 +3763,25 @@ void BinaryRangeExpr::SerializeWithCachedSizes(
       1, _Internal::column_info(this), output);
   }
 
-  // bool lower_inclusive = 2;
-  if (this->lower_inclusive() != 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBool(2, this->lower_inclusive(), output);
-  }
-
-  // bool upper_inclusive = 3;
-  if (this->upper_inclusive() != 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBool(3, this->upper_inclusive(), output);
-  }
-
-  // .milvus.proto.plan.GenericValue lower_value = 4;
-  if (this->has_lower_value()) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      4, _Internal::lower_value(this), output);
-  }
-
-  // .milvus.proto.plan.GenericValue upper_value = 5;
-  if (this->has_upper_value()) {
+  // repeated .milvus.proto.plan.GenericValue values = 2;
+  for (unsigned int i = 0,
+      n = static_cast<unsigned int>(this->values_size()); i < n; i++) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      5, _Internal::upper_value(this), output);
+      2,
+      this->values(static_cast<int>(i)),
+      output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.TermExpr)
 }
 
-::PROTOBUF_NAMESPACE_ID::uint8* BinaryRangeExpr::InternalSerializeWithCachedSizesToArray(
+::PROTOBUF_NAMESPACE_ID::uint8* TermExpr::InternalSerializeWithCachedSizesToArray(
     ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.TermExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
@@ -2378,40 +3792,24 @@ void BinaryRangeExpr::SerializeWithCachedSizes(
         1, _Internal::column_info(this), target);
   }
 
-  // bool lower_inclusive = 2;
-  if (this->lower_inclusive() != 0) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBoolToArray(2, this->lower_inclusive(), target);
-  }
-
-  // bool upper_inclusive = 3;
-  if (this->upper_inclusive() != 0) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteBoolToArray(3, this->upper_inclusive(), target);
-  }
-
-  // .milvus.proto.plan.GenericValue lower_value = 4;
-  if (this->has_lower_value()) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
-      InternalWriteMessageToArray(
-        4, _Internal::lower_value(this), target);
-  }
-
-  // .milvus.proto.plan.GenericValue upper_value = 5;
-  if (this->has_upper_value()) {
+  // repeated .milvus.proto.plan.GenericValue values = 2;
+  for (unsigned int i = 0,
+      n = static_cast<unsigned int>(this->values_size()); i < n; i++) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
       InternalWriteMessageToArray(
-        5, _Internal::upper_value(this), target);
+        2, this->values(static_cast<int>(i)), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.TermExpr)
   return target;
 }
 
-size_t BinaryRangeExpr::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.BinaryRangeExpr)
+size_t TermExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.TermExpr)
   size_t total_size = 0;
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -2423,6 +3821,17 @@ size_t BinaryRangeExpr::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
+  // repeated .milvus.proto.plan.GenericValue values = 2;
+  {
+    unsigned int count = static_cast<unsigned int>(this->values_size());
+    total_size += 1UL * count;
+    for (unsigned int i = 0; i < count; i++) {
+      total_size +=
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          this->values(static_cast<int>(i)));
+    }
+  }
+
   // .milvus.proto.plan.ColumnInfo column_info = 1;
   if (this->has_column_info()) {
     total_size += 1 +
@@ -2430,224 +3839,176 @@ size_t BinaryRangeExpr::ByteSizeLong() const {
         *column_info_);
   }
 
-  // .milvus.proto.plan.GenericValue lower_value = 4;
-  if (this->has_lower_value()) {
-    total_size += 1 +
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *lower_value_);
-  }
-
-  // .milvus.proto.plan.GenericValue upper_value = 5;
-  if (this->has_upper_value()) {
-    total_size += 1 +
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *upper_value_);
-  }
-
-  // bool lower_inclusive = 2;
-  if (this->lower_inclusive() != 0) {
-    total_size += 1 + 1;
-  }
-
-  // bool upper_inclusive = 3;
-  if (this->upper_inclusive() != 0) {
-    total_size += 1 + 1;
-  }
-
   int cached_size = ::PROTOBUF_NAMESPACE_ID::internal::ToCachedSize(total_size);
   SetCachedSize(cached_size);
   return total_size;
 }
 
-void BinaryRangeExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.BinaryRangeExpr)
+void TermExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.TermExpr)
   GOOGLE_DCHECK_NE(&from, this);
-  const BinaryRangeExpr* source =
-      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<BinaryRangeExpr>(
+  const TermExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<TermExpr>(
           &from);
   if (source == nullptr) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.TermExpr)
     ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.BinaryRangeExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.TermExpr)
     MergeFrom(*source);
   }
 }
 
-void BinaryRangeExpr::MergeFrom(const BinaryRangeExpr& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.BinaryRangeExpr)
+void TermExpr::MergeFrom(const TermExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.TermExpr)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
+  values_.MergeFrom(from.values_);
   if (from.has_column_info()) {
     mutable_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.column_info());
   }
-  if (from.has_lower_value()) {
-    mutable_lower_value()->::milvus::proto::plan::GenericValue::MergeFrom(from.lower_value());
-  }
-  if (from.has_upper_value()) {
-    mutable_upper_value()->::milvus::proto::plan::GenericValue::MergeFrom(from.upper_value());
-  }
-  if (from.lower_inclusive() != 0) {
-    set_lower_inclusive(from.lower_inclusive());
-  }
-  if (from.upper_inclusive() != 0) {
-    set_upper_inclusive(from.upper_inclusive());
-  }
 }
 
-void BinaryRangeExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.BinaryRangeExpr)
+void TermExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.TermExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void BinaryRangeExpr::CopyFrom(const BinaryRangeExpr& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.BinaryRangeExpr)
+void TermExpr::CopyFrom(const TermExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.TermExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool BinaryRangeExpr::IsInitialized() const {
+bool TermExpr::IsInitialized() const {
   return true;
 }
 
-void BinaryRangeExpr::InternalSwap(BinaryRangeExpr* other) {
+void TermExpr::InternalSwap(TermExpr* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
+  CastToBase(&values_)->InternalSwap(CastToBase(&other->values_));
   swap(column_info_, other->column_info_);
-  swap(lower_value_, other->lower_value_);
-  swap(upper_value_, other->upper_value_);
-  swap(lower_inclusive_, other->lower_inclusive_);
-  swap(upper_inclusive_, other->upper_inclusive_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata BinaryRangeExpr::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata TermExpr::GetMetadata() const {
   return GetMetadataStatic();
 }
 
 
 // ===================================================================
 
-void CompareExpr::InitAsDefaultInstance() {
-  ::milvus::proto::plan::_CompareExpr_default_instance_._instance.get_mutable()->left_column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
-      ::milvus::proto::plan::ColumnInfo::internal_default_instance());
-  ::milvus::proto::plan::_CompareExpr_default_instance_._instance.get_mutable()->right_column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
+void StringMatchExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_StringMatchExpr_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
       ::milvus::proto::plan::ColumnInfo::internal_default_instance());
 }
-class CompareExpr::_Internal {
+class StringMatchExpr::_Internal {
  public:
-  static const ::milvus::proto::plan::ColumnInfo& left_column_info(const CompareExpr* msg);
-  static const ::milvus::proto::plan::ColumnInfo& right_column_info(const CompareExpr* msg);
+  static const ::milvus::proto::plan::ColumnInfo& column_info(const StringMatchExpr* msg);
 };
 
 const ::milvus::proto::plan::ColumnInfo&
-CompareExpr::_Internal::left_column_info(const CompareExpr* msg) {
-  return *msg->left_column_info_;
-}
-const ::milvus::proto::plan::ColumnInfo&
-CompareExpr::_Internal::right_column_info(const CompareExpr* msg) {
-  return *msg->right_column_info_;
+StringMatchExpr::_Internal::column_info(const StringMatchExpr* msg) {
+  return *msg->column_info_;
 }
-CompareExpr::CompareExpr()
+StringMatchExpr::StringMatchExpr()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.StringMatchExpr)
 }
-CompareExpr::CompareExpr(const CompareExpr& from)
+StringMatchExpr::StringMatchExpr(const StringMatchExpr& from)
   : ::PROTOBUF_NAMESPACE_ID::Message(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  if (from.has_left_column_info()) {
-    left_column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.left_column_info_);
-  } else {
-    left_column_info_ = nullptr;
+  pattern_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  if (!from.pattern().empty()) {
+    pattern_.AssignWithDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), from.pattern_);
   }
-  if (from.has_right_column_info()) {
-    right_column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.right_column_info_);
+  if (from.has_column_info()) {
+    column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.column_info_);
   } else {
-    right_column_info_ = nullptr;
+    column_info_ = nullptr;
   }
   op_ = from.op_;
-  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.StringMatchExpr)
 }
 
-void CompareExpr::SharedCtor() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_CompareExpr_plan_2eproto.base);
-  ::memset(&left_column_info_, 0, static_cast<size_t>(
+void StringMatchExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_StringMatchExpr_plan_2eproto.base);
+  pattern_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  ::memset(&column_info_, 0, static_cast<size_t>(
       reinterpret_cast<char*>(&op_) -
-      reinterpret_cast<char*>(&left_column_info_)) + sizeof(op_));
+      reinterpret_cast<char*>(&column_info_)) + sizeof(op_));
 }
 
-CompareExpr::~CompareExpr() {
-  // @@protoc_insertion_point(destructor:milvus.proto.plan.CompareExpr)
+StringMatchExpr::~StringMatchExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.StringMatchExpr)
   SharedDtor();
 }
 
-void CompareExpr::SharedDtor() {
-  if (this != internal_default_instance()) delete left_column_info_;
-  if (this != internal_default_instance()) delete right_column_info_;
+void StringMatchExpr::SharedDtor() {
+  pattern_.DestroyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  if (this != internal_default_instance()) delete column_info_;
 }
 
-void CompareExpr::SetCachedSize(int size) const {
+void StringMatchExpr::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const CompareExpr& CompareExpr::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_CompareExpr_plan_2eproto.base);
+const StringMatchExpr& StringMatchExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_StringMatchExpr_plan_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void CompareExpr::Clear() {
-// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.CompareExpr)
+void StringMatchExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.StringMatchExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  if (GetArenaNoVirtual() == nullptr && left_column_info_ != nullptr) {
-    delete left_column_info_;
-  }
-  left_column_info_ = nullptr;
-  if (GetArenaNoVirtual() == nullptr && right_column_info_ != nullptr) {
-    delete right_column_info_;
+  pattern_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
+    delete column_info_;
   }
-  right_column_info_ = nullptr;
+  column_info_ = nullptr;
   op_ = 0;
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* CompareExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+const char* StringMatchExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     ::PROTOBUF_NAMESPACE_ID::uint32 tag;
     ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
     CHK_(ptr);
     switch (tag >> 3) {
-      // .milvus.proto.plan.ColumnInfo left_column_info = 1;
+      // .milvus.proto.plan.ColumnInfo column_info = 1;
       case 1:
         if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 10)) {
-          ptr = ctx->ParseMessage(mutable_left_column_info(), ptr);
+          ptr = ctx->ParseMessage(mutable_column_info(), ptr);
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
-      // .milvus.proto.plan.ColumnInfo right_column_info = 2;
+      // .milvus.proto.plan.StringMatchExpr.MatchOp op = 2;
       case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 18)) {
-          ptr = ctx->ParseMessage(mutable_right_column_info(), ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
           CHK_(ptr);
+          set_op(static_cast<::milvus::proto::plan::StringMatchExpr_MatchOp>(val));
         } else goto handle_unusual;
         continue;
-      // .milvus.proto.plan.OpType op = 3;
+      // string pattern = 3;
       case 3:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 24)) {
-          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 26)) {
+          ptr = ::PROTOBUF_NAMESPACE_ID::internal::InlineGreedyStringParserUTF8(mutable_pattern(), ptr, ctx, "milvus.proto.plan.StringMatchExpr.pattern");
           CHK_(ptr);
-          set_op(static_cast<::milvus::proto::plan::OpType>(val));
         } else goto handle_unusual;
         continue;
       default: {
@@ -2670,46 +4031,50 @@ failure:
 #undef CHK_
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool CompareExpr::MergePartialFromCodedStream(
+bool StringMatchExpr::MergePartialFromCodedStream(
     ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::PROTOBUF_NAMESPACE_ID::uint32 tag;
-  // @@protoc_insertion_point(parse_start:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.StringMatchExpr)
   for (;;) {
     ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // .milvus.proto.plan.ColumnInfo left_column_info = 1;
+      // .milvus.proto.plan.ColumnInfo column_info = 1;
       case 1: {
         if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (10 & 0xFF)) {
           DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_left_column_info()));
+               input, mutable_column_info()));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // .milvus.proto.plan.ColumnInfo right_column_info = 2;
+      // .milvus.proto.plan.StringMatchExpr.MatchOp op = 2;
       case 2: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (18 & 0xFF)) {
-          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_right_column_info()));
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_op(static_cast< ::milvus::proto::plan::StringMatchExpr_MatchOp >(value));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // .milvus.proto.plan.OpType op = 3;
+      // string pattern = 3;
       case 3: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (24 & 0xFF)) {
-          int value = 0;
-          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
-                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
-                 input, &value)));
-          set_op(static_cast< ::milvus::proto::plan::OpType >(value));
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadString(
+                input, this->mutable_pattern()));
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+            this->pattern().data(), static_cast<int>(this->pattern().length()),
+            ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::PARSE,
+            "milvus.proto.plan.StringMatchExpr.pattern"));
         } else {
           goto handle_unusual;
         }
@@ -2728,82 +4093,90 @@ bool CompareExpr::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.StringMatchExpr)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.StringMatchExpr)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void CompareExpr::SerializeWithCachedSizes(
+void StringMatchExpr::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.StringMatchExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.ColumnInfo left_column_info = 1;
-  if (this->has_left_column_info()) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, _Internal::left_column_info(this), output);
-  }
-
-  // .milvus.proto.plan.ColumnInfo right_column_info = 2;
-  if (this->has_right_column_info()) {
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, _Internal::right_column_info(this), output);
+      1, _Internal::column_info(this), output);
   }
 
-  // .milvus.proto.plan.OpType op = 3;
+  // .milvus.proto.plan.StringMatchExpr.MatchOp op = 2;
   if (this->op() != 0) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
-      3, this->op(), output);
+      2, this->op(), output);
+  }
+
+  // string pattern = 3;
+  if (this->pattern().size() > 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->pattern().data(), static_cast<int>(this->pattern().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "milvus.proto.plan.StringMatchExpr.pattern");
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringMaybeAliased(
+      3, this->pattern(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.StringMatchExpr)
 }
 
-::PROTOBUF_NAMESPACE_ID::uint8* CompareExpr::InternalSerializeWithCachedSizesToArray(
+::PROTOBUF_NAMESPACE_ID::uint8* StringMatchExpr::InternalSerializeWithCachedSizesToArray(
     ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.StringMatchExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.ColumnInfo left_column_info = 1;
-  if (this->has_left_column_info()) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
-      InternalWriteMessageToArray(
-        1, _Internal::left_column_info(this), target);
-  }
-
-  // .milvus.proto.plan.ColumnInfo right_column_info = 2;
-  if (this->has_right_column_info()) {
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
       InternalWriteMessageToArray(
-        2, _Internal::right_column_info(this), target);
+        1, _Internal::column_info(this), target);
   }
 
-  // .milvus.proto.plan.OpType op = 3;
+  // .milvus.proto.plan.StringMatchExpr.MatchOp op = 2;
   if (this->op() != 0) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
-      3, this->op(), target);
+      2, this->op(), target);
+  }
+
+  // string pattern = 3;
+  if (this->pattern().size() > 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->pattern().data(), static_cast<int>(this->pattern().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "milvus.proto.plan.StringMatchExpr.pattern");
+    target =
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteStringToArray(
+        3, this->pattern(), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.StringMatchExpr)
   return target;
 }
 
-size_t CompareExpr::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.CompareExpr)
+size_t StringMatchExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.StringMatchExpr)
   size_t total_size = 0;
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -2815,21 +4188,21 @@ size_t CompareExpr::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.ColumnInfo left_column_info = 1;
-  if (this->has_left_column_info()) {
+  // string pattern = 3;
+  if (this->pattern().size() > 0) {
     total_size += 1 +
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *left_column_info_);
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->pattern());
   }
 
-  // .milvus.proto.plan.ColumnInfo right_column_info = 2;
-  if (this->has_right_column_info()) {
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *right_column_info_);
+        *column_info_);
   }
 
-  // .milvus.proto.plan.OpType op = 3;
+  // .milvus.proto.plan.StringMatchExpr.MatchOp op = 2;
   if (this->op() != 0) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->op());
@@ -2840,142 +4213,184 @@ size_t CompareExpr::ByteSizeLong() const {
   return total_size;
 }
 
-void CompareExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.CompareExpr)
+void StringMatchExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.StringMatchExpr)
   GOOGLE_DCHECK_NE(&from, this);
-  const CompareExpr* source =
-      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<CompareExpr>(
+  const StringMatchExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<StringMatchExpr>(
           &from);
   if (source == nullptr) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.StringMatchExpr)
     ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.CompareExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.StringMatchExpr)
     MergeFrom(*source);
   }
 }
 
-void CompareExpr::MergeFrom(const CompareExpr& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.CompareExpr)
+void StringMatchExpr::MergeFrom(const StringMatchExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.StringMatchExpr)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.has_left_column_info()) {
-    mutable_left_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.left_column_info());
+  if (from.pattern().size() > 0) {
+
+    pattern_.AssignWithDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), from.pattern_);
   }
-  if (from.has_right_column_info()) {
-    mutable_right_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.right_column_info());
+  if (from.has_column_info()) {
+    mutable_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.column_info());
   }
   if (from.op() != 0) {
     set_op(from.op());
   }
 }
 
-void CompareExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.CompareExpr)
+void StringMatchExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.StringMatchExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void CompareExpr::CopyFrom(const CompareExpr& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.CompareExpr)
+void StringMatchExpr::CopyFrom(const StringMatchExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.StringMatchExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool CompareExpr::IsInitialized() const {
+bool StringMatchExpr::IsInitialized() const {
   return true;
 }
 
-void CompareExpr::InternalSwap(CompareExpr* other) {
+void StringMatchExpr::InternalSwap(StringMatchExpr* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  swap(left_column_info_, other->left_column_info_);
-  swap(right_column_info_, other->right_column_info_);
+  pattern_.Swap(&other->pattern_, &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+    GetArenaNoVirtual());
+  swap(column_info_, other->column_info_);
   swap(op_, other->op_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata CompareExpr::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata StringMatchExpr::GetMetadata() const {
   return GetMetadataStatic();
 }
 
 
 // ===================================================================
 
-void TermExpr::InitAsDefaultInstance() {
-  ::milvus::proto::plan::_TermExpr_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
+void BinaryArithOpEvalRangeExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_BinaryArithOpEvalRangeExpr_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
       ::milvus::proto::plan::ColumnInfo::internal_default_instance());
+  ::milvus::proto::plan::_BinaryArithOpEvalRangeExpr_default_instance_._instance.get_mutable()->right_operand_ = const_cast< ::milvus::proto::plan::GenericValue*>(
+      ::milvus::proto::plan::GenericValue::internal_default_instance());
+  ::milvus::proto::plan::_BinaryArithOpEvalRangeExpr_default_instance_._instance.get_mutable()->value_ = const_cast< ::milvus::proto::plan::GenericValue*>(
+      ::milvus::proto::plan::GenericValue::internal_default_instance());
 }
-class TermExpr::_Internal {
+class BinaryArithOpEvalRangeExpr::_Internal {
  public:
-  static const ::milvus::proto::plan::ColumnInfo& column_info(const TermExpr* msg);
+  static const ::milvus::proto::plan::ColumnInfo& column_info(const BinaryArithOpEvalRangeExpr* msg);
+  static const ::milvus::proto::plan::GenericValue& right_operand(const BinaryArithOpEvalRangeExpr* msg);
+  static const ::milvus::proto::plan::GenericValue& value(const BinaryArithOpEvalRangeExpr* msg);
 };
 
 const ::milvus::proto::plan::ColumnInfo&
-TermExpr::_Internal::column_info(const TermExpr* msg) {
+BinaryArithOpEvalRangeExpr::_Internal::column_info(const BinaryArithOpEvalRangeExpr* msg) {
   return *msg->column_info_;
 }
-TermExpr::TermExpr()
+const ::milvus::proto::plan::GenericValue&
+BinaryArithOpEvalRangeExpr::_Internal::right_operand(const BinaryArithOpEvalRangeExpr* msg) {
+  return *msg->right_operand_;
+}
+const ::milvus::proto::plan::GenericValue&
+BinaryArithOpEvalRangeExpr::_Internal::value(const BinaryArithOpEvalRangeExpr* msg) {
+  return *msg->value_;
+}
+BinaryArithOpEvalRangeExpr::BinaryArithOpEvalRangeExpr()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:milvus.proto.plan.TermExpr)
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
 }
-TermExpr::TermExpr(const TermExpr& from)
+BinaryArithOpEvalRangeExpr::BinaryArithOpEvalRangeExpr(const BinaryArithOpEvalRangeExpr& from)
   : ::PROTOBUF_NAMESPACE_ID::Message(),
-      _internal_metadata_(nullptr),
-      values_(from.values_) {
+      _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   if (from.has_column_info()) {
     column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.column_info_);
   } else {
     column_info_ = nullptr;
   }
-  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.TermExpr)
+  if (from.has_right_operand()) {
+    right_operand_ = new ::milvus::proto::plan::GenericValue(*from.right_operand_);
+  } else {
+    right_operand_ = nullptr;
+  }
+  if (from.has_value()) {
+    value_ = new ::milvus::proto::plan::GenericValue(*from.value_);
+  } else {
+    value_ = nullptr;
+  }
+  ::memcpy(&arith_op_, &from.arith_op_,
+    static_cast<size_t>(reinterpret_cast<char*>(&op_) -
+    reinterpret_cast<char*>(&arith_op_)) + sizeof(op_));
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
 }
 
-void TermExpr::SharedCtor() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_TermExpr_plan_2eproto.base);
-  column_info_ = nullptr;
+void BinaryArithOpEvalRangeExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_BinaryArithOpEvalRangeExpr_plan_2eproto.base);
+  ::memset(&column_info_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&op_) -
+      reinterpret_cast<char*>(&column_info_)) + sizeof(op_));
 }
 
-TermExpr::~TermExpr() {
-  // @@protoc_insertion_point(destructor:milvus.proto.plan.TermExpr)
+BinaryArithOpEvalRangeExpr::~BinaryArithOpEvalRangeExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   SharedDtor();
 }
 
-void TermExpr::SharedDtor() {
+void BinaryArithOpEvalRangeExpr::SharedDtor() {
   if (this != internal_default_instance()) delete column_info_;
+  if (this != internal_default_instance()) delete right_operand_;
+  if (this != internal_default_instance()) delete value_;
 }
 
-void TermExpr::SetCachedSize(int size) const {
+void BinaryArithOpEvalRangeExpr::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const TermExpr& TermExpr::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_TermExpr_plan_2eproto.base);
+const BinaryArithOpEvalRangeExpr& BinaryArithOpEvalRangeExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_BinaryArithOpEvalRangeExpr_plan_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void TermExpr::Clear() {
-// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.TermExpr)
+void BinaryArithOpEvalRangeExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  values_.Clear();
   if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
     delete column_info_;
   }
   column_info_ = nullptr;
+  if (GetArenaNoVirtual() == nullptr && right_operand_ != nullptr) {
+    delete right_operand_;
+  }
+  right_operand_ = nullptr;
+  if (GetArenaNoVirtual() == nullptr && value_ != nullptr) {
+    delete value_;
+  }
+  value_ = nullptr;
+  ::memset(&arith_op_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&op_) -
+      reinterpret_cast<char*>(&arith_op_)) + sizeof(op_));
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* TermExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+const char* BinaryArithOpEvalRangeExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     ::PROTOBUF_NAMESPACE_ID::uint32 tag;
@@ -2989,16 +4404,34 @@ const char* TermExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::i
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
-      // repeated .milvus.proto.plan.GenericValue values = 2;
-      case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 18)) {
-          ptr -= 1;
-          do {
-            ptr += 1;
-            ptr = ctx->ParseMessage(add_values(), ptr);
-            CHK_(ptr);
-            if (!ctx->DataAvailable(ptr)) break;
-          } while (::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<::PROTOBUF_NAMESPACE_ID::uint8>(ptr) == 18);
+      // .milvus.proto.plan.ArithOpType arith_op = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+          set_arith_op(static_cast<::milvus::proto::plan::ArithOpType>(val));
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.GenericValue right_operand = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 26)) {
+          ptr = ctx->ParseMessage(mutable_right_operand(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.OpType op = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 32)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+          set_op(static_cast<::milvus::proto::plan::OpType>(val));
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.GenericValue value = 5;
+      case 5:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 42)) {
+          ptr = ctx->ParseMessage(mutable_value(), ptr);
+          CHK_(ptr);
         } else goto handle_unusual;
         continue;
       default: {
@@ -3021,11 +4454,11 @@ failure:
 #undef CHK_
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool TermExpr::MergePartialFromCodedStream(
+bool BinaryArithOpEvalRangeExpr::MergePartialFromCodedStream(
     ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::PROTOBUF_NAMESPACE_ID::uint32 tag;
-  // @@protoc_insertion_point(parse_start:milvus.proto.plan.TermExpr)
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   for (;;) {
     ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
@@ -3042,11 +4475,50 @@ bool TermExpr::MergePartialFromCodedStream(
         break;
       }
 
-      // repeated .milvus.proto.plan.GenericValue values = 2;
+      // .milvus.proto.plan.ArithOpType arith_op = 2;
       case 2: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (18 & 0xFF)) {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_arith_op(static_cast< ::milvus::proto::plan::ArithOpType >(value));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.GenericValue right_operand = 3;
+      case 3: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (26 & 0xFF)) {
           DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-                input, add_values()));
+               input, mutable_right_operand()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.OpType op = 4;
+      case 4: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (32 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_op(static_cast< ::milvus::proto::plan::OpType >(value));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.GenericValue value = 5;
+      case 5: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (42 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_value()));
         } else {
           goto handle_unusual;
         }
@@ -3065,18 +4537,18 @@ bool TermExpr::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:milvus.proto.plan.TermExpr)
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.TermExpr)
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void TermExpr::SerializeWithCachedSizes(
+void BinaryArithOpEvalRangeExpr::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.TermExpr)
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
@@ -3086,25 +4558,40 @@ void TermExpr::SerializeWithCachedSizes(
       1, _Internal::column_info(this), output);
   }
 
-  // repeated .milvus.proto.plan.GenericValue values = 2;
-  for (unsigned int i = 0,
-      n = static_cast<unsigned int>(this->values_size()); i < n; i++) {
+  // .milvus.proto.plan.ArithOpType arith_op = 2;
+  if (this->arith_op() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
+      2, this->arith_op(), output);
+  }
+
+  // .milvus.proto.plan.GenericValue right_operand = 3;
+  if (this->has_right_operand()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2,
-      this->values(static_cast<int>(i)),
-      output);
+      3, _Internal::right_operand(this), output);
+  }
+
+  // .milvus.proto.plan.OpType op = 4;
+  if (this->op() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
+      4, this->op(), output);
+  }
+
+  // .milvus.proto.plan.GenericValue value = 5;
+  if (this->has_value()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, _Internal::value(this), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.TermExpr)
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
 }
 
-::PROTOBUF_NAMESPACE_ID::uint8* TermExpr::InternalSerializeWithCachedSizesToArray(
+::PROTOBUF_NAMESPACE_ID::uint8* BinaryArithOpEvalRangeExpr::InternalSerializeWithCachedSizesToArray(
     ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.TermExpr)
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
@@ -3115,24 +4602,42 @@ void TermExpr::SerializeWithCachedSizes(
         1, _Internal::column_info(this), target);
   }
 
-  // repeated .milvus.proto.plan.GenericValue values = 2;
-  for (unsigned int i = 0,
-      n = static_cast<unsigned int>(this->values_size()); i < n; i++) {
+  // .milvus.proto.plan.ArithOpType arith_op = 2;
+  if (this->arith_op() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
+      2, this->arith_op(), target);
+  }
+
+  // .milvus.proto.plan.GenericValue right_operand = 3;
+  if (this->has_right_operand()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
       InternalWriteMessageToArray(
-        2, this->values(static_cast<int>(i)), target);
+        3, _Internal::right_operand(this), target);
+  }
+
+  // .milvus.proto.plan.OpType op = 4;
+  if (this->op() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
+      4, this->op(), target);
+  }
+
+  // .milvus.proto.plan.GenericValue value = 5;
+  if (this->has_value()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        5, _Internal::value(this), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.TermExpr)
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   return target;
 }
 
-size_t TermExpr::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.TermExpr)
+size_t BinaryArithOpEvalRangeExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   size_t total_size = 0;
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -3144,17 +4649,6 @@ size_t TermExpr::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // repeated .milvus.proto.plan.GenericValue values = 2;
-  {
-    unsigned int count = static_cast<unsigned int>(this->values_size());
-    total_size += 1UL * count;
-    for (unsigned int i = 0; i < count; i++) {
-      total_size +=
-        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-          this->values(static_cast<int>(i)));
-    }
-  }
-
   // .milvus.proto.plan.ColumnInfo column_info = 1;
   if (this->has_column_info()) {
     total_size += 1 +
@@ -3162,65 +4656,105 @@ size_t TermExpr::ByteSizeLong() const {
         *column_info_);
   }
 
+  // .milvus.proto.plan.GenericValue right_operand = 3;
+  if (this->has_right_operand()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *right_operand_);
+  }
+
+  // .milvus.proto.plan.GenericValue value = 5;
+  if (this->has_value()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *value_);
+  }
+
+  // .milvus.proto.plan.ArithOpType arith_op = 2;
+  if (this->arith_op() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->arith_op());
+  }
+
+  // .milvus.proto.plan.OpType op = 4;
+  if (this->op() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->op());
+  }
+
   int cached_size = ::PROTOBUF_NAMESPACE_ID::internal::ToCachedSize(total_size);
   SetCachedSize(cached_size);
   return total_size;
 }
 
-void TermExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.TermExpr)
+void BinaryArithOpEvalRangeExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   GOOGLE_DCHECK_NE(&from, this);
-  const TermExpr* source =
-      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<TermExpr>(
+  const BinaryArithOpEvalRangeExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<BinaryArithOpEvalRangeExpr>(
           &from);
   if (source == nullptr) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.TermExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
     ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.TermExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
     MergeFrom(*source);
   }
 }
 
-void TermExpr::MergeFrom(const TermExpr& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.TermExpr)
+void BinaryArithOpEvalRangeExpr::MergeFrom(const BinaryArithOpEvalRangeExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  values_.MergeFrom(from.values_);
   if (from.has_column_info()) {
     mutable_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.column_info());
   }
+  if (from.has_right_operand()) {
+    mutable_right_operand()->::milvus::proto::plan::GenericValue::MergeFrom(from.right_operand());
+  }
+  if (from.has_value()) {
+    mutable_value()->::milvus::proto::plan::GenericValue::MergeFrom(from.value());
+  }
+  if (from.arith_op() != 0) {
+    set_arith_op(from.arith_op());
+  }
+  if (from.op() != 0) {
+    set_op(from.op());
+  }
 }
 
-void TermExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.TermExpr)
+void BinaryArithOpEvalRangeExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void TermExpr::CopyFrom(const TermExpr& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.TermExpr)
+void BinaryArithOpEvalRangeExpr::CopyFrom(const BinaryArithOpEvalRangeExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool TermExpr::IsInitialized() const {
+bool BinaryArithOpEvalRangeExpr::IsInitialized() const {
   return true;
 }
 
-void TermExpr::InternalSwap(TermExpr* other) {
+void BinaryArithOpEvalRangeExpr::InternalSwap(BinaryArithOpEvalRangeExpr* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  CastToBase(&values_)->InternalSwap(CastToBase(&other->values_));
   swap(column_info_, other->column_info_);
+  swap(right_operand_, other->right_operand_);
+  swap(value_, other->value_);
+  swap(arith_op_, other->arith_op_);
+  swap(op_, other->op_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata TermExpr::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata BinaryArithOpEvalRangeExpr::GetMetadata() const {
   return GetMetadataStatic();
 }
 
@@ -3927,6 +5461,10 @@ void Expr::InitAsDefaultInstance() {
       ::milvus::proto::plan::UnaryRangeExpr::internal_default_instance());
   ::milvus::proto::plan::_Expr_default_instance_.binary_range_expr_ = const_cast< ::milvus::proto::plan::BinaryRangeExpr*>(
       ::milvus::proto::plan::BinaryRangeExpr::internal_default_instance());
+  ::milvus::proto::plan::_Expr_default_instance_.binary_arith_op_eval_range_expr_ = const_cast< ::milvus::proto::plan::BinaryArithOpEvalRangeExpr*>(
+      ::milvus::proto::plan::BinaryArithOpEvalRangeExpr::internal_default_instance());
+  ::milvus::proto::plan::_Expr_default_instance_.string_match_expr_ = const_cast< ::milvus::proto::plan::StringMatchExpr*>(
+      ::milvus::proto::plan::StringMatchExpr::internal_default_instance());
 }
 class Expr::_Internal {
  public:
@@ -3936,6 +5474,8 @@ class Expr::_Internal {
   static const ::milvus::proto::plan::CompareExpr& compare_expr(const Expr* msg);
   static const ::milvus::proto::plan::UnaryRangeExpr& unary_range_expr(const Expr* msg);
   static const ::milvus::proto::plan::BinaryRangeExpr& binary_range_expr(const Expr* msg);
+  static const ::milvus::proto::plan::BinaryArithOpEvalRangeExpr& binary_arith_op_eval_range_expr(const Expr* msg);
+  static const ::milvus::proto::plan::StringMatchExpr& string_match_expr(const Expr* msg);
 };
 
 const ::milvus::proto::plan::TermExpr&
@@ -3962,6 +5502,14 @@ const ::milvus::proto::plan::BinaryRangeExpr&
 Expr::_Internal::binary_range_expr(const Expr* msg) {
   return *msg->expr_.binary_range_expr_;
 }
+const ::milvus::proto::plan::BinaryArithOpEvalRangeExpr&
+Expr::_Internal::binary_arith_op_eval_range_expr(const Expr* msg) {
+  return *msg->expr_.binary_arith_op_eval_range_expr_;
+}
+const ::milvus::proto::plan::StringMatchExpr&
+Expr::_Internal::string_match_expr(const Expr* msg) {
+  return *msg->expr_.string_match_expr_;
+}
 void Expr::set_allocated_term_expr(::milvus::proto::plan::TermExpr* term_expr) {
   ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
   clear_expr();
@@ -4046,6 +5594,34 @@ void Expr::set_allocated_binary_range_expr(::milvus::proto::plan::BinaryRangeExp
   }
   // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.Expr.binary_range_expr)
 }
+void Expr::set_allocated_binary_arith_op_eval_range_expr(::milvus::proto::plan::BinaryArithOpEvalRangeExpr* binary_arith_op_eval_range_expr) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  clear_expr();
+  if (binary_arith_op_eval_range_expr) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      binary_arith_op_eval_range_expr = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, binary_arith_op_eval_range_expr, submessage_arena);
+    }
+    set_has_binary_arith_op_eval_range_expr();
+    expr_.binary_arith_op_eval_range_expr_ = binary_arith_op_eval_range_expr;
+  }
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.Expr.binary_arith_op_eval_range_expr)
+}
+void Expr::set_allocated_string_match_expr(::milvus::proto::plan::StringMatchExpr* string_match_expr) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  clear_expr();
+  if (string_match_expr) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      string_match_expr = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, string_match_expr, submessage_arena);
+    }
+    set_has_string_match_expr();
+    expr_.string_match_expr_ = string_match_expr;
+  }
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.Expr.string_match_expr)
+}
 Expr::Expr()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
@@ -4081,6 +5657,14 @@ Expr::Expr(const Expr& from)
       mutable_binary_range_expr()->::milvus::proto::plan::BinaryRangeExpr::MergeFrom(from.binary_range_expr());
       break;
     }
+    case kBinaryArithOpEvalRangeExpr: {
+      mutable_binary_arith_op_eval_range_expr()->::milvus::proto::plan::BinaryArithOpEvalRangeExpr::MergeFrom(from.binary_arith_op_eval_range_expr());
+      break;
+    }
+    case kStringMatchExpr: {
+      mutable_string_match_expr()->::milvus::proto::plan::StringMatchExpr::MergeFrom(from.string_match_expr());
+      break;
+    }
     case EXPR_NOT_SET: {
       break;
     }
@@ -4140,6 +5724,14 @@ void Expr::clear_expr() {
       delete expr_.binary_range_expr_;
       break;
     }
+    case kBinaryArithOpEvalRangeExpr: {
+      delete expr_.binary_arith_op_eval_range_expr_;
+      break;
+    }
+    case kStringMatchExpr: {
+      delete expr_.string_match_expr_;
+      break;
+    }
     case EXPR_NOT_SET: {
       break;
     }
@@ -4208,6 +5800,20 @@ const char* Expr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::inter
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
+      // .milvus.proto.plan.BinaryArithOpEvalRangeExpr binary_arith_op_eval_range_expr = 7;
+      case 7:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 58)) {
+          ptr = ctx->ParseMessage(mutable_binary_arith_op_eval_range_expr(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.StringMatchExpr string_match_expr = 8;
+      case 8:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 66)) {
+          ptr = ctx->ParseMessage(mutable_string_match_expr(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
       default: {
       handle_unusual:
         if ((tag & 7) == 4 || tag == 0) {
@@ -4304,6 +5910,28 @@ bool Expr::MergePartialFromCodedStream(
         break;
       }
 
+      // .milvus.proto.plan.BinaryArithOpEvalRangeExpr binary_arith_op_eval_range_expr = 7;
+      case 7: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (58 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_binary_arith_op_eval_range_expr()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.StringMatchExpr string_match_expr = 8;
+      case 8: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (66 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_string_match_expr()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
       default: {
       handle_unusual:
         if (tag == 0) {
@@ -4367,6 +5995,18 @@ void Expr::SerializeWithCachedSizes(
       6, _Internal::binary_range_expr(this), output);
   }
 
+  // .milvus.proto.plan.BinaryArithOpEvalRangeExpr binary_arith_op_eval_range_expr = 7;
+  if (has_binary_arith_op_eval_range_expr()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, _Internal::binary_arith_op_eval_range_expr(this), output);
+  }
+
+  // .milvus.proto.plan.StringMatchExpr string_match_expr = 8;
+  if (has_string_match_expr()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      8, _Internal::string_match_expr(this), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
@@ -4422,6 +6062,20 @@ void Expr::SerializeWithCachedSizes(
         6, _Internal::binary_range_expr(this), target);
   }
 
+  // .milvus.proto.plan.BinaryArithOpEvalRangeExpr binary_arith_op_eval_range_expr = 7;
+  if (has_binary_arith_op_eval_range_expr()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        7, _Internal::binary_arith_op_eval_range_expr(this), target);
+  }
+
+  // .milvus.proto.plan.StringMatchExpr string_match_expr = 8;
+  if (has_string_match_expr()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        8, _Internal::string_match_expr(this), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
@@ -4486,6 +6140,20 @@ size_t Expr::ByteSizeLong() const {
           *expr_.binary_range_expr_);
       break;
     }
+    // .milvus.proto.plan.BinaryArithOpEvalRangeExpr binary_arith_op_eval_range_expr = 7;
+    case kBinaryArithOpEvalRangeExpr: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *expr_.binary_arith_op_eval_range_expr_);
+      break;
+    }
+    // .milvus.proto.plan.StringMatchExpr string_match_expr = 8;
+    case kStringMatchExpr: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *expr_.string_match_expr_);
+      break;
+    }
     case EXPR_NOT_SET: {
       break;
     }
@@ -4542,6 +6210,14 @@ void Expr::MergeFrom(const Expr& from) {
       mutable_binary_range_expr()->::milvus::proto::plan::BinaryRangeExpr::MergeFrom(from.binary_range_expr());
       break;
     }
+    case kBinaryArithOpEvalRangeExpr: {
+      mutable_binary_arith_op_eval_range_expr()->::milvus::proto::plan::BinaryArithOpEvalRangeExpr::MergeFrom(from.binary_arith_op_eval_range_expr());
+      break;
+    }
+    case kStringMatchExpr: {
+      mutable_string_match_expr()->::milvus::proto::plan::StringMatchExpr::MergeFrom(from.string_match_expr());
+      break;
+    }
     case EXPR_NOT_SET: {
       break;
     }
@@ -5114,6 +6790,7 @@ PlanNode::PlanNode(const PlanNode& from)
       _internal_metadata_(nullptr),
       output_field_ids_(from.output_field_ids_) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
+  limit_ = from.limit_;
   clear_has_node();
   switch (from.node_case()) {
     case kVectorAnns: {
@@ -5133,6 +6810,7 @@ PlanNode::PlanNode(const PlanNode& from)
 
 void PlanNode::SharedCtor() {
   ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_PlanNode_plan_2eproto.base);
+  limit_ = PROTOBUF_LONGLONG(0);
   clear_has_node();
 }
 
@@ -5182,6 +6860,7 @@ void PlanNode::Clear() {
   (void) cached_has_bits;
 
   output_field_ids_.Clear();
+  limit_ = PROTOBUF_LONGLONG(0);
   clear_node();
   _internal_metadata_.Clear();
 }
@@ -5218,6 +6897,13 @@ const char* PlanNode::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::i
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
+      // int64 limit = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 32)) {
+          limit_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
       default: {
       handle_unusual:
         if ((tag & 7) == 4 || tag == 0) {
@@ -5286,6 +6972,19 @@ bool PlanNode::MergePartialFromCodedStream(
         break;
       }
 
+      // int64 limit = 4;
+      case 4: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (32 & 0xFF)) {
+
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   ::PROTOBUF_NAMESPACE_ID::int64, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_INT64>(
+                 input, &limit_)));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
       default: {
       handle_unusual:
         if (tag == 0) {
@@ -5336,6 +7035,11 @@ void PlanNode::SerializeWithCachedSizes(
       this->output_field_ids(i), output);
   }
 
+  // int64 limit = 4;
+  if (this->limit() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64(4, this->limit(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
@@ -5376,6 +7080,11 @@ void PlanNode::SerializeWithCachedSizes(
       WriteInt64NoTagToArray(this->output_field_ids_, target);
   }
 
+  // int64 limit = 4;
+  if (this->limit() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteInt64ToArray(4, this->limit(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
@@ -5412,6 +7121,13 @@ size_t PlanNode::ByteSizeLong() const {
     total_size += data_size;
   }
 
+  // int64 limit = 4;
+  if (this->limit() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::Int64Size(
+        this->limit());
+  }
+
   switch (node_case()) {
     // .milvus.proto.plan.VectorANNS vector_anns = 1;
     case kVectorAnns: {
@@ -5459,6 +7175,9 @@ void PlanNode::MergeFrom(const PlanNode& from) {
   (void) cached_has_bits;
 
   output_field_ids_.MergeFrom(from.output_field_ids_);
+  if (from.limit() != 0) {
+    set_limit(from.limit());
+  }
   switch (from.node_case()) {
     case kVectorAnns: {
       mutable_vector_anns()->::milvus::proto::plan::VectorANNS::MergeFrom(from.vector_anns());
@@ -5496,6 +7215,7 @@ void PlanNode::InternalSwap(PlanNode* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
   output_field_ids_.InternalSwap(&other->output_field_ids_);
+  swap(limit_, other->limit_);
   swap(node_, other->node_);
   swap(_oneof_case_[0], other->_oneof_case_[0]);
 }
@@ -5513,6 +7233,9 @@ PROTOBUF_NAMESPACE_OPEN
 template<> PROTOBUF_NOINLINE ::milvus::proto::plan::GenericValue* Arena::CreateMaybeMessage< ::milvus::proto::plan::GenericValue >(Arena* arena) {
   return Arena::CreateInternal< ::milvus::proto::plan::GenericValue >(arena);
 }
+template<> PROTOBUF_NOINLINE ::milvus::proto::plan::RangeSearchInfo* Arena::CreateMaybeMessage< ::milvus::proto::plan::RangeSearchInfo >(Arena* arena) {
+  return Arena::CreateInternal< ::milvus::proto::plan::RangeSearchInfo >(arena);
+}
 template<> PROTOBUF_NOINLINE ::milvus::proto::plan::QueryInfo* Arena::CreateMaybeMessage< ::milvus::proto::plan::QueryInfo >(Arena* arena) {
   return Arena::CreateInternal< ::milvus::proto::plan::QueryInfo >(arena);
 }
@@ -5531,6 +7254,12 @@ template<> PROTOBUF_NOINLINE ::milvus::proto::plan::CompareExpr* Arena::CreateMa
 template<> PROTOBUF_NOINLINE ::milvus::proto::plan::TermExpr* Arena::CreateMaybeMessage< ::milvus::proto::plan::TermExpr >(Arena* arena) {
   return Arena::CreateInternal< ::milvus::proto::plan::TermExpr >(arena);
 }
+template<> PROTOBUF_NOINLINE ::milvus::proto::plan::StringMatchExpr* Arena::CreateMaybeMessage< ::milvus::proto::plan::StringMatchExpr >(Arena* arena) {
+  return Arena::CreateInternal< ::milvus::proto::plan::StringMatchExpr >(arena);
+}
+template<> PROTOBUF_NOINLINE ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* Arena::CreateMaybeMessage< ::milvus::proto::plan::BinaryArithOpEvalRangeExpr >(Arena* arena) {
+  return Arena::CreateInternal< ::milvus::proto::plan::BinaryArithOpEvalRangeExpr >(arena);
+}
 template<> PROTOBUF_NOINLINE ::milvus::proto::plan::UnaryExpr* Arena::CreateMaybeMessage< ::milvus::proto::plan::UnaryExpr >(Arena* arena) {
   return Arena::CreateInternal< ::milvus::proto::plan::UnaryExpr >(arena);
 }
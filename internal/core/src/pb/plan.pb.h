@@ -49,7 +49,7 @@ struct TableStruct_plan_2eproto {
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
   static const ::PROTOBUF_NAMESPACE_ID::internal::AuxillaryParseTableField aux[]
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
-  static const ::PROTOBUF_NAMESPACE_ID::internal::ParseTable schema[12]
+  static const ::PROTOBUF_NAMESPACE_ID::internal::ParseTable schema[15]
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
   static const ::PROTOBUF_NAMESPACE_ID::internal::FieldMetadata field_metadata[];
   static const ::PROTOBUF_NAMESPACE_ID::internal::SerializationTable serialization_table[];
@@ -59,6 +59,9 @@ extern const ::PROTOBUF_NAMESPACE_ID::internal::DescriptorTable descriptor_table
 namespace milvus {
 namespace proto {
 namespace plan {
+class BinaryArithOpEvalRangeExpr;
+class BinaryArithOpEvalRangeExprDefaultTypeInternal;
+extern BinaryArithOpEvalRangeExprDefaultTypeInternal _BinaryArithOpEvalRangeExpr_default_instance_;
 class BinaryExpr;
 class BinaryExprDefaultTypeInternal;
 extern BinaryExprDefaultTypeInternal _BinaryExpr_default_instance_;
@@ -83,6 +86,12 @@ extern PlanNodeDefaultTypeInternal _PlanNode_default_instance_;
 class QueryInfo;
 class QueryInfoDefaultTypeInternal;
 extern QueryInfoDefaultTypeInternal _QueryInfo_default_instance_;
+class RangeSearchInfo;
+class RangeSearchInfoDefaultTypeInternal;
+extern RangeSearchInfoDefaultTypeInternal _RangeSearchInfo_default_instance_;
+class StringMatchExpr;
+class StringMatchExprDefaultTypeInternal;
+extern StringMatchExprDefaultTypeInternal _StringMatchExpr_default_instance_;
 class TermExpr;
 class TermExprDefaultTypeInternal;
 extern TermExprDefaultTypeInternal _TermExpr_default_instance_;
@@ -99,6 +108,7 @@ extern VectorANNSDefaultTypeInternal _VectorANNS_default_instance_;
 }  // namespace proto
 }  // namespace milvus
 PROTOBUF_NAMESPACE_OPEN
+template<> ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::BinaryArithOpEvalRangeExpr>(Arena*);
 template<> ::milvus::proto::plan::BinaryExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::BinaryExpr>(Arena*);
 template<> ::milvus::proto::plan::BinaryRangeExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::BinaryRangeExpr>(Arena*);
 template<> ::milvus::proto::plan::ColumnInfo* Arena::CreateMaybeMessage<::milvus::proto::plan::ColumnInfo>(Arena*);
@@ -107,6 +117,8 @@ template<> ::milvus::proto::plan::Expr* Arena::CreateMaybeMessage<::milvus::prot
 template<> ::milvus::proto::plan::GenericValue* Arena::CreateMaybeMessage<::milvus::proto::plan::GenericValue>(Arena*);
 template<> ::milvus::proto::plan::PlanNode* Arena::CreateMaybeMessage<::milvus::proto::plan::PlanNode>(Arena*);
 template<> ::milvus::proto::plan::QueryInfo* Arena::CreateMaybeMessage<::milvus::proto::plan::QueryInfo>(Arena*);
+template<> ::milvus::proto::plan::RangeSearchInfo* Arena::CreateMaybeMessage<::milvus::proto::plan::RangeSearchInfo>(Arena*);
+template<> ::milvus::proto::plan::StringMatchExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::StringMatchExpr>(Arena*);
 template<> ::milvus::proto::plan::TermExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::TermExpr>(Arena*);
 template<> ::milvus::proto::plan::UnaryExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::UnaryExpr>(Arena*);
 template<> ::milvus::proto::plan::UnaryRangeExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::UnaryRangeExpr>(Arena*);
@@ -116,6 +128,33 @@ namespace milvus {
 namespace proto {
 namespace plan {
 
+enum StringMatchExpr_MatchOp : int {
+  StringMatchExpr_MatchOp_Invalid = 0,
+  StringMatchExpr_MatchOp_Prefix = 1,
+  StringMatchExpr_MatchOp_Suffix = 2,
+  StringMatchExpr_MatchOp_Contains = 3,
+  StringMatchExpr_MatchOp_StringMatchExpr_MatchOp_INT_MIN_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::min(),
+  StringMatchExpr_MatchOp_StringMatchExpr_MatchOp_INT_MAX_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::max()
+};
+bool StringMatchExpr_MatchOp_IsValid(int value);
+constexpr StringMatchExpr_MatchOp StringMatchExpr_MatchOp_MatchOp_MIN = StringMatchExpr_MatchOp_Invalid;
+constexpr StringMatchExpr_MatchOp StringMatchExpr_MatchOp_MatchOp_MAX = StringMatchExpr_MatchOp_Contains;
+constexpr int StringMatchExpr_MatchOp_MatchOp_ARRAYSIZE = StringMatchExpr_MatchOp_MatchOp_MAX + 1;
+
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* StringMatchExpr_MatchOp_descriptor();
+template<typename T>
+inline const std::string& StringMatchExpr_MatchOp_Name(T enum_t_value) {
+  static_assert(::std::is_same<T, StringMatchExpr_MatchOp>::value ||
+    ::std::is_integral<T>::value,
+    "Incorrect type passed to function StringMatchExpr_MatchOp_Name.");
+  return ::PROTOBUF_NAMESPACE_ID::internal::NameOfEnum(
+    StringMatchExpr_MatchOp_descriptor(), enum_t_value);
+}
+inline bool StringMatchExpr_MatchOp_Parse(
+    const std::string& name, StringMatchExpr_MatchOp* value) {
+  return ::PROTOBUF_NAMESPACE_ID::internal::ParseNamedEnum<StringMatchExpr_MatchOp>(
+    StringMatchExpr_MatchOp_descriptor(), name, value);
+}
 enum UnaryExpr_UnaryOp : int {
   UnaryExpr_UnaryOp_Invalid = 0,
   UnaryExpr_UnaryOp_Not = 1,
@@ -197,6 +236,35 @@ inline bool OpType_Parse(
   return ::PROTOBUF_NAMESPACE_ID::internal::ParseNamedEnum<OpType>(
     OpType_descriptor(), name, value);
 }
+enum ArithOpType : int {
+  Unknown = 0,
+  Add = 1,
+  Sub = 2,
+  Mul = 3,
+  Div = 4,
+  Mod = 5,
+  ArithOpType_INT_MIN_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::min(),
+  ArithOpType_INT_MAX_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::max()
+};
+bool ArithOpType_IsValid(int value);
+constexpr ArithOpType ArithOpType_MIN = Unknown;
+constexpr ArithOpType ArithOpType_MAX = Mod;
+constexpr int ArithOpType_ARRAYSIZE = ArithOpType_MAX + 1;
+
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* ArithOpType_descriptor();
+template<typename T>
+inline const std::string& ArithOpType_Name(T enum_t_value) {
+  static_assert(::std::is_same<T, ArithOpType>::value ||
+    ::std::is_integral<T>::value,
+    "Incorrect type passed to function ArithOpType_Name.");
+  return ::PROTOBUF_NAMESPACE_ID::internal::NameOfEnum(
+    ArithOpType_descriptor(), enum_t_value);
+}
+inline bool ArithOpType_Parse(
+    const std::string& name, ArithOpType* value) {
+  return ::PROTOBUF_NAMESPACE_ID::internal::ParseNamedEnum<ArithOpType>(
+    ArithOpType_descriptor(), name, value);
+}
 // ===================================================================
 
 class GenericValue :
@@ -239,6 +307,7 @@ class GenericValue :
     kBoolVal = 1,
     kInt64Val = 2,
     kFloatVal = 3,
+    kStringVal = 4,
     VAL_NOT_SET = 0,
   };
 
@@ -322,6 +391,7 @@ class GenericValue :
     kBoolValFieldNumber = 1,
     kInt64ValFieldNumber = 2,
     kFloatValFieldNumber = 3,
+    kStringValFieldNumber = 4,
   };
   // bool bool_val = 1;
   private:
@@ -347,6 +417,20 @@ class GenericValue :
   double float_val() const;
   void set_float_val(double value);
 
+  // string string_val = 4;
+  private:
+  bool has_string_val() const;
+  public:
+  void clear_string_val();
+  const std::string& string_val() const;
+  void set_string_val(const std::string& value);
+  void set_string_val(std::string&& value);
+  void set_string_val(const char* value);
+  void set_string_val(const char* value, size_t size);
+  std::string* mutable_string_val();
+  std::string* release_string_val();
+  void set_allocated_string_val(std::string* string_val);
+
   void clear_val();
   ValCase val_case() const;
   // @@protoc_insertion_point(class_scope:milvus.proto.plan.GenericValue)
@@ -355,6 +439,7 @@ class GenericValue :
   void set_has_bool_val();
   void set_has_int64_val();
   void set_has_float_val();
+  void set_has_string_val();
 
   inline bool has_val() const;
   inline void clear_has_val();
@@ -365,6 +450,7 @@ class GenericValue :
     bool bool_val_;
     ::PROTOBUF_NAMESPACE_ID::int64 int64_val_;
     double float_val_;
+    ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr string_val_;
   } val_;
   mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   ::PROTOBUF_NAMESPACE_ID::uint32 _oneof_case_[1];
@@ -373,6 +459,144 @@ class GenericValue :
 };
 // -------------------------------------------------------------------
 
+class RangeSearchInfo :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.plan.RangeSearchInfo) */ {
+ public:
+  RangeSearchInfo();
+  virtual ~RangeSearchInfo();
+
+  RangeSearchInfo(const RangeSearchInfo& from);
+  RangeSearchInfo(RangeSearchInfo&& from) noexcept
+    : RangeSearchInfo() {
+    *this = ::std::move(from);
+  }
+
+  inline RangeSearchInfo& operator=(const RangeSearchInfo& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline RangeSearchInfo& operator=(RangeSearchInfo&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return GetMetadataStatic().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return GetMetadataStatic().reflection;
+  }
+  static const RangeSearchInfo& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const RangeSearchInfo* internal_default_instance() {
+    return reinterpret_cast<const RangeSearchInfo*>(
+               &_RangeSearchInfo_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    1;
+
+  friend void swap(RangeSearchInfo& a, RangeSearchInfo& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(RangeSearchInfo* other) {
+    if (other == this) return;
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline RangeSearchInfo* New() const final {
+    return CreateMaybeMessage<RangeSearchInfo>(nullptr);
+  }
+
+  RangeSearchInfo* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<RangeSearchInfo>(arena);
+  }
+  void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void CopyFrom(const RangeSearchInfo& from);
+  void MergeFrom(const RangeSearchInfo& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  #else
+  bool MergePartialFromCodedStream(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const final;
+  ::PROTOBUF_NAMESPACE_ID::uint8* InternalSerializeWithCachedSizesToArray(
+      ::PROTOBUF_NAMESPACE_ID::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  inline void SharedCtor();
+  inline void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(RangeSearchInfo* other);
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "milvus.proto.plan.RangeSearchInfo";
+  }
+  private:
+  inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+  private:
+  static ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadataStatic() {
+    ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&::descriptor_table_plan_2eproto);
+    return ::descriptor_table_plan_2eproto.file_level_metadata[kIndexInFileMessages];
+  }
+
+  public:
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kRadiusFieldNumber = 1,
+    kRangeFilterFieldNumber = 2,
+  };
+  // float radius = 1;
+  void clear_radius();
+  float radius() const;
+  void set_radius(float value);
+
+  // float range_filter = 2;
+  void clear_range_filter();
+  float range_filter() const;
+  void set_range_filter(float value);
+
+  // @@protoc_insertion_point(class_scope:milvus.proto.plan.RangeSearchInfo)
+ private:
+  class _Internal;
+
+  ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
+  float radius_;
+  float range_filter_;
+  mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_plan_2eproto;
+};
+// -------------------------------------------------------------------
+
 class QueryInfo :
     public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.plan.QueryInfo) */ {
  public:
@@ -415,7 +639,7 @@ class QueryInfo :
                &_QueryInfo_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    1;
+    2;
 
   friend void swap(QueryInfo& a, QueryInfo& b) {
     a.Swap(&b);
@@ -488,7 +712,10 @@ class QueryInfo :
   enum : int {
     kMetricTypeFieldNumber = 3,
     kSearchParamsFieldNumber = 4,
+    kRangeSearchInfoFieldNumber = 5,
     kTopkFieldNumber = 1,
+    kGroupByFieldIdFieldNumber = 6,
+    kGroupSizeFieldNumber = 7,
   };
   // string metric_type = 3;
   void clear_metric_type();
@@ -512,11 +739,29 @@ class QueryInfo :
   std::string* release_search_params();
   void set_allocated_search_params(std::string* search_params);
 
+  // .milvus.proto.plan.RangeSearchInfo range_search_info = 5;
+  bool has_range_search_info() const;
+  void clear_range_search_info();
+  const ::milvus::proto::plan::RangeSearchInfo& range_search_info() const;
+  ::milvus::proto::plan::RangeSearchInfo* release_range_search_info();
+  ::milvus::proto::plan::RangeSearchInfo* mutable_range_search_info();
+  void set_allocated_range_search_info(::milvus::proto::plan::RangeSearchInfo* range_search_info);
+
   // int64 topk = 1;
   void clear_topk();
   ::PROTOBUF_NAMESPACE_ID::int64 topk() const;
   void set_topk(::PROTOBUF_NAMESPACE_ID::int64 value);
 
+  // int64 group_by_field_id = 6;
+  void clear_group_by_field_id();
+  ::PROTOBUF_NAMESPACE_ID::int64 group_by_field_id() const;
+  void set_group_by_field_id(::PROTOBUF_NAMESPACE_ID::int64 value);
+
+  // int64 group_size = 7;
+  void clear_group_size();
+  ::PROTOBUF_NAMESPACE_ID::int64 group_size() const;
+  void set_group_size(::PROTOBUF_NAMESPACE_ID::int64 value);
+
   // @@protoc_insertion_point(class_scope:milvus.proto.plan.QueryInfo)
  private:
   class _Internal;
@@ -524,7 +769,10 @@ class QueryInfo :
   ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
   ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr metric_type_;
   ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr search_params_;
+  ::milvus::proto::plan::RangeSearchInfo* range_search_info_;
   ::PROTOBUF_NAMESPACE_ID::int64 topk_;
+  ::PROTOBUF_NAMESPACE_ID::int64 group_by_field_id_;
+  ::PROTOBUF_NAMESPACE_ID::int64 group_size_;
   mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_plan_2eproto;
 };
@@ -572,7 +820,7 @@ class ColumnInfo :
                &_ColumnInfo_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    2;
+    3;
 
   friend void swap(ColumnInfo& a, ColumnInfo& b) {
     a.Swap(&b);
@@ -724,7 +972,7 @@ class UnaryRangeExpr :
                &_UnaryRangeExpr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    3;
+    4;
 
   friend void swap(UnaryRangeExpr& a, UnaryRangeExpr& b) {
     a.Swap(&b);
@@ -875,7 +1123,7 @@ class BinaryRangeExpr :
                &_BinaryRangeExpr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    4;
+    5;
 
   friend void swap(BinaryRangeExpr& a, BinaryRangeExpr& b) {
     a.Swap(&b);
@@ -1043,7 +1291,7 @@ class CompareExpr :
                &_CompareExpr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    5;
+    6;
 
   friend void swap(CompareExpr& a, CompareExpr& b) {
     a.Swap(&b);
@@ -1194,7 +1442,7 @@ class TermExpr :
                &_TermExpr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    6;
+    7;
 
   friend void swap(TermExpr& a, TermExpr& b) {
     a.Swap(&b);
@@ -1299,6 +1547,362 @@ class TermExpr :
 };
 // -------------------------------------------------------------------
 
+class StringMatchExpr :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.plan.StringMatchExpr) */ {
+ public:
+  StringMatchExpr();
+  virtual ~StringMatchExpr();
+
+  StringMatchExpr(const StringMatchExpr& from);
+  StringMatchExpr(StringMatchExpr&& from) noexcept
+    : StringMatchExpr() {
+    *this = ::std::move(from);
+  }
+
+  inline StringMatchExpr& operator=(const StringMatchExpr& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline StringMatchExpr& operator=(StringMatchExpr&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return GetMetadataStatic().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return GetMetadataStatic().reflection;
+  }
+  static const StringMatchExpr& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const StringMatchExpr* internal_default_instance() {
+    return reinterpret_cast<const StringMatchExpr*>(
+               &_StringMatchExpr_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    8;
+
+  friend void swap(StringMatchExpr& a, StringMatchExpr& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(StringMatchExpr* other) {
+    if (other == this) return;
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline StringMatchExpr* New() const final {
+    return CreateMaybeMessage<StringMatchExpr>(nullptr);
+  }
+
+  StringMatchExpr* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<StringMatchExpr>(arena);
+  }
+  void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void CopyFrom(const StringMatchExpr& from);
+  void MergeFrom(const StringMatchExpr& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  #else
+  bool MergePartialFromCodedStream(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const final;
+  ::PROTOBUF_NAMESPACE_ID::uint8* InternalSerializeWithCachedSizesToArray(
+      ::PROTOBUF_NAMESPACE_ID::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  inline void SharedCtor();
+  inline void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(StringMatchExpr* other);
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "milvus.proto.plan.StringMatchExpr";
+  }
+  private:
+  inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+  private:
+  static ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadataStatic() {
+    ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&::descriptor_table_plan_2eproto);
+    return ::descriptor_table_plan_2eproto.file_level_metadata[kIndexInFileMessages];
+  }
+
+  public:
+
+  // nested types ----------------------------------------------------
+
+  typedef StringMatchExpr_MatchOp MatchOp;
+  static constexpr MatchOp Invalid =
+    StringMatchExpr_MatchOp_Invalid;
+  static constexpr MatchOp Prefix =
+    StringMatchExpr_MatchOp_Prefix;
+  static constexpr MatchOp Suffix =
+    StringMatchExpr_MatchOp_Suffix;
+  static constexpr MatchOp Contains =
+    StringMatchExpr_MatchOp_Contains;
+  static inline bool MatchOp_IsValid(int value) {
+    return StringMatchExpr_MatchOp_IsValid(value);
+  }
+  static constexpr MatchOp MatchOp_MIN =
+    StringMatchExpr_MatchOp_MatchOp_MIN;
+  static constexpr MatchOp MatchOp_MAX =
+    StringMatchExpr_MatchOp_MatchOp_MAX;
+  static constexpr int MatchOp_ARRAYSIZE =
+    StringMatchExpr_MatchOp_MatchOp_ARRAYSIZE;
+  static inline const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor*
+  MatchOp_descriptor() {
+    return StringMatchExpr_MatchOp_descriptor();
+  }
+  template<typename T>
+  static inline const std::string& MatchOp_Name(T enum_t_value) {
+    static_assert(::std::is_same<T, MatchOp>::value ||
+      ::std::is_integral<T>::value,
+      "Incorrect type passed to function MatchOp_Name.");
+    return StringMatchExpr_MatchOp_Name(enum_t_value);
+  }
+  static inline bool MatchOp_Parse(const std::string& name,
+      MatchOp* value) {
+    return StringMatchExpr_MatchOp_Parse(name, value);
+  }
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kPatternFieldNumber = 3,
+    kColumnInfoFieldNumber = 1,
+    kOpFieldNumber = 2,
+  };
+  // string pattern = 3;
+  void clear_pattern();
+  const std::string& pattern() const;
+  void set_pattern(const std::string& value);
+  void set_pattern(std::string&& value);
+  void set_pattern(const char* value);
+  void set_pattern(const char* value, size_t size);
+  std::string* mutable_pattern();
+  std::string* release_pattern();
+  void set_allocated_pattern(std::string* pattern);
+
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  bool has_column_info() const;
+  void clear_column_info();
+  const ::milvus::proto::plan::ColumnInfo& column_info() const;
+  ::milvus::proto::plan::ColumnInfo* release_column_info();
+  ::milvus::proto::plan::ColumnInfo* mutable_column_info();
+  void set_allocated_column_info(::milvus::proto::plan::ColumnInfo* column_info);
+
+  // .milvus.proto.plan.StringMatchExpr.MatchOp op = 2;
+  void clear_op();
+  ::milvus::proto::plan::StringMatchExpr_MatchOp op() const;
+  void set_op(::milvus::proto::plan::StringMatchExpr_MatchOp value);
+
+  // @@protoc_insertion_point(class_scope:milvus.proto.plan.StringMatchExpr)
+ private:
+  class _Internal;
+
+  ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr pattern_;
+  ::milvus::proto::plan::ColumnInfo* column_info_;
+  int op_;
+  mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_plan_2eproto;
+};
+// -------------------------------------------------------------------
+
+class BinaryArithOpEvalRangeExpr :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.plan.BinaryArithOpEvalRangeExpr) */ {
+ public:
+  BinaryArithOpEvalRangeExpr();
+  virtual ~BinaryArithOpEvalRangeExpr();
+
+  BinaryArithOpEvalRangeExpr(const BinaryArithOpEvalRangeExpr& from);
+  BinaryArithOpEvalRangeExpr(BinaryArithOpEvalRangeExpr&& from) noexcept
+    : BinaryArithOpEvalRangeExpr() {
+    *this = ::std::move(from);
+  }
+
+  inline BinaryArithOpEvalRangeExpr& operator=(const BinaryArithOpEvalRangeExpr& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline BinaryArithOpEvalRangeExpr& operator=(BinaryArithOpEvalRangeExpr&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return GetMetadataStatic().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return GetMetadataStatic().reflection;
+  }
+  static const BinaryArithOpEvalRangeExpr& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const BinaryArithOpEvalRangeExpr* internal_default_instance() {
+    return reinterpret_cast<const BinaryArithOpEvalRangeExpr*>(
+               &_BinaryArithOpEvalRangeExpr_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    9;
+
+  friend void swap(BinaryArithOpEvalRangeExpr& a, BinaryArithOpEvalRangeExpr& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(BinaryArithOpEvalRangeExpr* other) {
+    if (other == this) return;
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline BinaryArithOpEvalRangeExpr* New() const final {
+    return CreateMaybeMessage<BinaryArithOpEvalRangeExpr>(nullptr);
+  }
+
+  BinaryArithOpEvalRangeExpr* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<BinaryArithOpEvalRangeExpr>(arena);
+  }
+  void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void CopyFrom(const BinaryArithOpEvalRangeExpr& from);
+  void MergeFrom(const BinaryArithOpEvalRangeExpr& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  #else
+  bool MergePartialFromCodedStream(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const final;
+  ::PROTOBUF_NAMESPACE_ID::uint8* InternalSerializeWithCachedSizesToArray(
+      ::PROTOBUF_NAMESPACE_ID::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  inline void SharedCtor();
+  inline void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(BinaryArithOpEvalRangeExpr* other);
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "milvus.proto.plan.BinaryArithOpEvalRangeExpr";
+  }
+  private:
+  inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+  private:
+  static ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadataStatic() {
+    ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&::descriptor_table_plan_2eproto);
+    return ::descriptor_table_plan_2eproto.file_level_metadata[kIndexInFileMessages];
+  }
+
+  public:
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kColumnInfoFieldNumber = 1,
+    kRightOperandFieldNumber = 3,
+    kValueFieldNumber = 5,
+    kArithOpFieldNumber = 2,
+    kOpFieldNumber = 4,
+  };
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  bool has_column_info() const;
+  void clear_column_info();
+  const ::milvus::proto::plan::ColumnInfo& column_info() const;
+  ::milvus::proto::plan::ColumnInfo* release_column_info();
+  ::milvus::proto::plan::ColumnInfo* mutable_column_info();
+  void set_allocated_column_info(::milvus::proto::plan::ColumnInfo* column_info);
+
+  // .milvus.proto.plan.GenericValue right_operand = 3;
+  bool has_right_operand() const;
+  void clear_right_operand();
+  const ::milvus::proto::plan::GenericValue& right_operand() const;
+  ::milvus::proto::plan::GenericValue* release_right_operand();
+  ::milvus::proto::plan::GenericValue* mutable_right_operand();
+  void set_allocated_right_operand(::milvus::proto::plan::GenericValue* right_operand);
+
+  // .milvus.proto.plan.GenericValue value = 5;
+  bool has_value() const;
+  void clear_value();
+  const ::milvus::proto::plan::GenericValue& value() const;
+  ::milvus::proto::plan::GenericValue* release_value();
+  ::milvus::proto::plan::GenericValue* mutable_value();
+  void set_allocated_value(::milvus::proto::plan::GenericValue* value);
+
+  // .milvus.proto.plan.ArithOpType arith_op = 2;
+  void clear_arith_op();
+  ::milvus::proto::plan::ArithOpType arith_op() const;
+  void set_arith_op(::milvus::proto::plan::ArithOpType value);
+
+  // .milvus.proto.plan.OpType op = 4;
+  void clear_op();
+  ::milvus::proto::plan::OpType op() const;
+  void set_op(::milvus::proto::plan::OpType value);
+
+  // @@protoc_insertion_point(class_scope:milvus.proto.plan.BinaryArithOpEvalRangeExpr)
+ private:
+  class _Internal;
+
+  ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
+  ::milvus::proto::plan::ColumnInfo* column_info_;
+  ::milvus::proto::plan::GenericValue* right_operand_;
+  ::milvus::proto::plan::GenericValue* value_;
+  int arith_op_;
+  int op_;
+  mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_plan_2eproto;
+};
+// -------------------------------------------------------------------
+
 class UnaryExpr :
     public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.plan.UnaryExpr) */ {
  public:
@@ -1341,7 +1945,7 @@ class UnaryExpr :
                &_UnaryExpr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    7;
+    10;
 
   friend void swap(UnaryExpr& a, UnaryExpr& b) {
     a.Swap(&b);
@@ -1512,7 +2116,7 @@ class BinaryExpr :
                &_BinaryExpr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    8;
+    11;
 
   friend void swap(BinaryExpr& a, BinaryExpr& b) {
     a.Swap(&b);
@@ -1696,6 +2300,8 @@ class Expr :
     kCompareExpr = 4,
     kUnaryRangeExpr = 5,
     kBinaryRangeExpr = 6,
+    kBinaryArithOpEvalRangeExpr = 7,
+    kStringMatchExpr = 8,
     EXPR_NOT_SET = 0,
   };
 
@@ -1705,7 +2311,7 @@ class Expr :
                &_Expr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    9;
+    12;
 
   friend void swap(Expr& a, Expr& b) {
     a.Swap(&b);
@@ -1782,6 +2388,8 @@ class Expr :
     kCompareExprFieldNumber = 4,
     kUnaryRangeExprFieldNumber = 5,
     kBinaryRangeExprFieldNumber = 6,
+    kBinaryArithOpEvalRangeExprFieldNumber = 7,
+    kStringMatchExprFieldNumber = 8,
   };
   // .milvus.proto.plan.TermExpr term_expr = 1;
   bool has_term_expr() const;
@@ -1831,6 +2439,22 @@ class Expr :
   ::milvus::proto::plan::BinaryRangeExpr* mutable_binary_range_expr();
   void set_allocated_binary_range_expr(::milvus::proto::plan::BinaryRangeExpr* binary_range_expr);
 
+  // .milvus.proto.plan.BinaryArithOpEvalRangeExpr binary_arith_op_eval_range_expr = 7;
+  bool has_binary_arith_op_eval_range_expr() const;
+  void clear_binary_arith_op_eval_range_expr();
+  const ::milvus::proto::plan::BinaryArithOpEvalRangeExpr& binary_arith_op_eval_range_expr() const;
+  ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* release_binary_arith_op_eval_range_expr();
+  ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* mutable_binary_arith_op_eval_range_expr();
+  void set_allocated_binary_arith_op_eval_range_expr(::milvus::proto::plan::BinaryArithOpEvalRangeExpr* binary_arith_op_eval_range_expr);
+
+  // .milvus.proto.plan.StringMatchExpr string_match_expr = 8;
+  bool has_string_match_expr() const;
+  void clear_string_match_expr();
+  const ::milvus::proto::plan::StringMatchExpr& string_match_expr() const;
+  ::milvus::proto::plan::StringMatchExpr* release_string_match_expr();
+  ::milvus::proto::plan::StringMatchExpr* mutable_string_match_expr();
+  void set_allocated_string_match_expr(::milvus::proto::plan::StringMatchExpr* string_match_expr);
+
   void clear_expr();
   ExprCase expr_case() const;
   // @@protoc_insertion_point(class_scope:milvus.proto.plan.Expr)
@@ -1842,6 +2466,8 @@ class Expr :
   void set_has_compare_expr();
   void set_has_unary_range_expr();
   void set_has_binary_range_expr();
+  void set_has_binary_arith_op_eval_range_expr();
+  void set_has_string_match_expr();
 
   inline bool has_expr() const;
   inline void clear_has_expr();
@@ -1855,6 +2481,8 @@ class Expr :
     ::milvus::proto::plan::CompareExpr* compare_expr_;
     ::milvus::proto::plan::UnaryRangeExpr* unary_range_expr_;
     ::milvus::proto::plan::BinaryRangeExpr* binary_range_expr_;
+    ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* binary_arith_op_eval_range_expr_;
+    ::milvus::proto::plan::StringMatchExpr* string_match_expr_;
   } expr_;
   mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   ::PROTOBUF_NAMESPACE_ID::uint32 _oneof_case_[1];
@@ -1905,7 +2533,7 @@ class VectorANNS :
                &_VectorANNS_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    10;
+    13;
 
   friend void swap(VectorANNS& a, VectorANNS& b) {
     a.Swap(&b);
@@ -2082,7 +2710,7 @@ class PlanNode :
                &_PlanNode_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    11;
+    14;
 
   friend void swap(PlanNode& a, PlanNode& b) {
     a.Swap(&b);
@@ -2154,6 +2782,7 @@ class PlanNode :
 
   enum : int {
     kOutputFieldIdsFieldNumber = 3,
+    kLimitFieldNumber = 4,
     kVectorAnnsFieldNumber = 1,
     kPredicatesFieldNumber = 2,
   };
@@ -2168,6 +2797,11 @@ class PlanNode :
   ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 >*
       mutable_output_field_ids();
 
+  // int64 limit = 4;
+  void clear_limit();
+  ::PROTOBUF_NAMESPACE_ID::int64 limit() const;
+  void set_limit(::PROTOBUF_NAMESPACE_ID::int64 value);
+
   // .milvus.proto.plan.VectorANNS vector_anns = 1;
   bool has_vector_anns() const;
   void clear_vector_anns();
@@ -2198,6 +2832,7 @@ class PlanNode :
   ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
   ::PROTOBUF_NAMESPACE_ID::RepeatedField< ::PROTOBUF_NAMESPACE_ID::int64 > output_field_ids_;
   mutable std::atomic<int> _output_field_ids_cached_byte_size_;
+  ::PROTOBUF_NAMESPACE_ID::int64 limit_;
   union NodeUnion {
     NodeUnion() {}
     ::milvus::proto::plan::VectorANNS* vector_anns_;
@@ -2306,6 +2941,96 @@ inline void GenericValue::set_float_val(double value) {
   // @@protoc_insertion_point(field_set:milvus.proto.plan.GenericValue.float_val)
 }
 
+// string string_val = 4;
+inline bool GenericValue::has_string_val() const {
+  return val_case() == kStringVal;
+}
+inline void GenericValue::set_has_string_val() {
+  _oneof_case_[0] = kStringVal;
+}
+inline void GenericValue::clear_string_val() {
+  if (has_string_val()) {
+    val_.string_val_.DestroyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+    clear_has_val();
+  }
+}
+inline const std::string& GenericValue::string_val() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.GenericValue.string_val)
+  if (has_string_val()) {
+    return val_.string_val_.GetNoArena();
+  }
+  return *&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited();
+}
+inline void GenericValue::set_string_val(const std::string& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.GenericValue.string_val)
+  if (!has_string_val()) {
+    clear_val();
+    set_has_string_val();
+    val_.string_val_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  }
+  val_.string_val_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.GenericValue.string_val)
+}
+inline void GenericValue::set_string_val(std::string&& value) {
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.GenericValue.string_val)
+  if (!has_string_val()) {
+    clear_val();
+    set_has_string_val();
+    val_.string_val_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  }
+  val_.string_val_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.plan.GenericValue.string_val)
+}
+inline void GenericValue::set_string_val(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  if (!has_string_val()) {
+    clear_val();
+    set_has_string_val();
+    val_.string_val_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  }
+  val_.string_val_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.plan.GenericValue.string_val)
+}
+inline void GenericValue::set_string_val(const char* value, size_t size) {
+  if (!has_string_val()) {
+    clear_val();
+    set_has_string_val();
+    val_.string_val_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  }
+  val_.string_val_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(
+      reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.plan.GenericValue.string_val)
+}
+inline std::string* GenericValue::mutable_string_val() {
+  if (!has_string_val()) {
+    clear_val();
+    set_has_string_val();
+    val_.string_val_.UnsafeSetDefault(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.GenericValue.string_val)
+  return val_.string_val_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline std::string* GenericValue::release_string_val() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.GenericValue.string_val)
+  if (has_string_val()) {
+    clear_has_val();
+    return val_.string_val_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+  } else {
+    return nullptr;
+  }
+}
+inline void GenericValue::set_allocated_string_val(std::string* string_val) {
+  if (has_val()) {
+    clear_val();
+  }
+  if (string_val != nullptr) {
+    set_has_string_val();
+    val_.string_val_.UnsafeSetDefault(string_val);
+  }
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.GenericValue.string_val)
+}
+
 inline bool GenericValue::has_val() const {
   return val_case() != VAL_NOT_SET;
 }
@@ -2317,6 +3042,38 @@ inline GenericValue::ValCase GenericValue::val_case() const {
 }
 // -------------------------------------------------------------------
 
+// RangeSearchInfo
+
+// float radius = 1;
+inline void RangeSearchInfo::clear_radius() {
+  radius_ = 0;
+}
+inline float RangeSearchInfo::radius() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.RangeSearchInfo.radius)
+  return radius_;
+}
+inline void RangeSearchInfo::set_radius(float value) {
+  
+  radius_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.RangeSearchInfo.radius)
+}
+
+// float range_filter = 2;
+inline void RangeSearchInfo::clear_range_filter() {
+  range_filter_ = 0;
+}
+inline float RangeSearchInfo::range_filter() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.RangeSearchInfo.range_filter)
+  return range_filter_;
+}
+inline void RangeSearchInfo::set_range_filter(float value) {
+  
+  range_filter_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.RangeSearchInfo.range_filter)
+}
+
+// -------------------------------------------------------------------
+
 // QueryInfo
 
 // int64 topk = 1;
@@ -2425,14 +3182,93 @@ inline std::string* QueryInfo::release_search_params() {
   
   return search_params_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
 }
-inline void QueryInfo::set_allocated_search_params(std::string* search_params) {
-  if (search_params != nullptr) {
-    
-  } else {
-    
-  }
-  search_params_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), search_params);
-  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.QueryInfo.search_params)
+inline void QueryInfo::set_allocated_search_params(std::string* search_params) {
+  if (search_params != nullptr) {
+    
+  } else {
+    
+  }
+  search_params_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), search_params);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.QueryInfo.search_params)
+}
+
+// .milvus.proto.plan.RangeSearchInfo range_search_info = 5;
+inline bool QueryInfo::has_range_search_info() const {
+  return this != internal_default_instance() && range_search_info_ != nullptr;
+}
+inline void QueryInfo::clear_range_search_info() {
+  if (GetArenaNoVirtual() == nullptr && range_search_info_ != nullptr) {
+    delete range_search_info_;
+  }
+  range_search_info_ = nullptr;
+}
+inline const ::milvus::proto::plan::RangeSearchInfo& QueryInfo::range_search_info() const {
+  const ::milvus::proto::plan::RangeSearchInfo* p = range_search_info_;
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.QueryInfo.range_search_info)
+  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::plan::RangeSearchInfo*>(
+      &::milvus::proto::plan::_RangeSearchInfo_default_instance_);
+}
+inline ::milvus::proto::plan::RangeSearchInfo* QueryInfo::release_range_search_info() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.QueryInfo.range_search_info)
+  
+  ::milvus::proto::plan::RangeSearchInfo* temp = range_search_info_;
+  range_search_info_ = nullptr;
+  return temp;
+}
+inline ::milvus::proto::plan::RangeSearchInfo* QueryInfo::mutable_range_search_info() {
+  
+  if (range_search_info_ == nullptr) {
+    auto* p = CreateMaybeMessage<::milvus::proto::plan::RangeSearchInfo>(GetArenaNoVirtual());
+    range_search_info_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.QueryInfo.range_search_info)
+  return range_search_info_;
+}
+inline void QueryInfo::set_allocated_range_search_info(::milvus::proto::plan::RangeSearchInfo* range_search_info) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete range_search_info_;
+  }
+  if (range_search_info) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      range_search_info = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, range_search_info, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  range_search_info_ = range_search_info;
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.QueryInfo.range_search_info)
+}
+
+// int64 group_by_field_id = 6;
+inline void QueryInfo::clear_group_by_field_id() {
+  group_by_field_id_ = PROTOBUF_LONGLONG(0);
+}
+inline ::PROTOBUF_NAMESPACE_ID::int64 QueryInfo::group_by_field_id() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.QueryInfo.group_by_field_id)
+  return group_by_field_id_;
+}
+inline void QueryInfo::set_group_by_field_id(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  group_by_field_id_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.QueryInfo.group_by_field_id)
+}
+
+// int64 group_size = 7;
+inline void QueryInfo::clear_group_size() {
+  group_size_ = PROTOBUF_LONGLONG(0);
+}
+inline ::PROTOBUF_NAMESPACE_ID::int64 QueryInfo::group_size() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.QueryInfo.group_size)
+  return group_size_;
+}
+inline void QueryInfo::set_group_size(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  group_size_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.QueryInfo.group_size)
 }
 
 // -------------------------------------------------------------------
@@ -3007,6 +3843,311 @@ TermExpr::values() const {
 
 // -------------------------------------------------------------------
 
+// StringMatchExpr
+
+// .milvus.proto.plan.ColumnInfo column_info = 1;
+inline bool StringMatchExpr::has_column_info() const {
+  return this != internal_default_instance() && column_info_ != nullptr;
+}
+inline void StringMatchExpr::clear_column_info() {
+  if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
+    delete column_info_;
+  }
+  column_info_ = nullptr;
+}
+inline const ::milvus::proto::plan::ColumnInfo& StringMatchExpr::column_info() const {
+  const ::milvus::proto::plan::ColumnInfo* p = column_info_;
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.StringMatchExpr.column_info)
+  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::plan::ColumnInfo*>(
+      &::milvus::proto::plan::_ColumnInfo_default_instance_);
+}
+inline ::milvus::proto::plan::ColumnInfo* StringMatchExpr::release_column_info() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.StringMatchExpr.column_info)
+  
+  ::milvus::proto::plan::ColumnInfo* temp = column_info_;
+  column_info_ = nullptr;
+  return temp;
+}
+inline ::milvus::proto::plan::ColumnInfo* StringMatchExpr::mutable_column_info() {
+  
+  if (column_info_ == nullptr) {
+    auto* p = CreateMaybeMessage<::milvus::proto::plan::ColumnInfo>(GetArenaNoVirtual());
+    column_info_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.StringMatchExpr.column_info)
+  return column_info_;
+}
+inline void StringMatchExpr::set_allocated_column_info(::milvus::proto::plan::ColumnInfo* column_info) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete column_info_;
+  }
+  if (column_info) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      column_info = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, column_info, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  column_info_ = column_info;
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.StringMatchExpr.column_info)
+}
+
+// .milvus.proto.plan.StringMatchExpr.MatchOp op = 2;
+inline void StringMatchExpr::clear_op() {
+  op_ = 0;
+}
+inline ::milvus::proto::plan::StringMatchExpr_MatchOp StringMatchExpr::op() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.StringMatchExpr.op)
+  return static_cast< ::milvus::proto::plan::StringMatchExpr_MatchOp >(op_);
+}
+inline void StringMatchExpr::set_op(::milvus::proto::plan::StringMatchExpr_MatchOp value) {
+  
+  op_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.StringMatchExpr.op)
+}
+
+// string pattern = 3;
+inline void StringMatchExpr::clear_pattern() {
+  pattern_.ClearToEmptyNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline const std::string& StringMatchExpr::pattern() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.StringMatchExpr.pattern)
+  return pattern_.GetNoArena();
+}
+inline void StringMatchExpr::set_pattern(const std::string& value) {
+  
+  pattern_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.StringMatchExpr.pattern)
+}
+inline void StringMatchExpr::set_pattern(std::string&& value) {
+  
+  pattern_.SetNoArena(
+    &::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::move(value));
+  // @@protoc_insertion_point(field_set_rvalue:milvus.proto.plan.StringMatchExpr.pattern)
+}
+inline void StringMatchExpr::set_pattern(const char* value) {
+  GOOGLE_DCHECK(value != nullptr);
+  
+  pattern_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:milvus.proto.plan.StringMatchExpr.pattern)
+}
+inline void StringMatchExpr::set_pattern(const char* value, size_t size) {
+  
+  pattern_.SetNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:milvus.proto.plan.StringMatchExpr.pattern)
+}
+inline std::string* StringMatchExpr::mutable_pattern() {
+  
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.StringMatchExpr.pattern)
+  return pattern_.MutableNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline std::string* StringMatchExpr::release_pattern() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.StringMatchExpr.pattern)
+  
+  return pattern_.ReleaseNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited());
+}
+inline void StringMatchExpr::set_allocated_pattern(std::string* pattern) {
+  if (pattern != nullptr) {
+    
+  } else {
+    
+  }
+  pattern_.SetAllocatedNoArena(&::PROTOBUF_NAMESPACE_ID::internal::GetEmptyStringAlreadyInited(), pattern);
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.StringMatchExpr.pattern)
+}
+
+// -------------------------------------------------------------------
+
+// BinaryArithOpEvalRangeExpr
+
+// .milvus.proto.plan.ColumnInfo column_info = 1;
+inline bool BinaryArithOpEvalRangeExpr::has_column_info() const {
+  return this != internal_default_instance() && column_info_ != nullptr;
+}
+inline void BinaryArithOpEvalRangeExpr::clear_column_info() {
+  if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
+    delete column_info_;
+  }
+  column_info_ = nullptr;
+}
+inline const ::milvus::proto::plan::ColumnInfo& BinaryArithOpEvalRangeExpr::column_info() const {
+  const ::milvus::proto::plan::ColumnInfo* p = column_info_;
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.BinaryArithOpEvalRangeExpr.column_info)
+  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::plan::ColumnInfo*>(
+      &::milvus::proto::plan::_ColumnInfo_default_instance_);
+}
+inline ::milvus::proto::plan::ColumnInfo* BinaryArithOpEvalRangeExpr::release_column_info() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.BinaryArithOpEvalRangeExpr.column_info)
+  
+  ::milvus::proto::plan::ColumnInfo* temp = column_info_;
+  column_info_ = nullptr;
+  return temp;
+}
+inline ::milvus::proto::plan::ColumnInfo* BinaryArithOpEvalRangeExpr::mutable_column_info() {
+  
+  if (column_info_ == nullptr) {
+    auto* p = CreateMaybeMessage<::milvus::proto::plan::ColumnInfo>(GetArenaNoVirtual());
+    column_info_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.BinaryArithOpEvalRangeExpr.column_info)
+  return column_info_;
+}
+inline void BinaryArithOpEvalRangeExpr::set_allocated_column_info(::milvus::proto::plan::ColumnInfo* column_info) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete column_info_;
+  }
+  if (column_info) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      column_info = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, column_info, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  column_info_ = column_info;
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.BinaryArithOpEvalRangeExpr.column_info)
+}
+
+// .milvus.proto.plan.ArithOpType arith_op = 2;
+inline void BinaryArithOpEvalRangeExpr::clear_arith_op() {
+  arith_op_ = 0;
+}
+inline ::milvus::proto::plan::ArithOpType BinaryArithOpEvalRangeExpr::arith_op() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.BinaryArithOpEvalRangeExpr.arith_op)
+  return static_cast< ::milvus::proto::plan::ArithOpType >(arith_op_);
+}
+inline void BinaryArithOpEvalRangeExpr::set_arith_op(::milvus::proto::plan::ArithOpType value) {
+  
+  arith_op_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.BinaryArithOpEvalRangeExpr.arith_op)
+}
+
+// .milvus.proto.plan.GenericValue right_operand = 3;
+inline bool BinaryArithOpEvalRangeExpr::has_right_operand() const {
+  return this != internal_default_instance() && right_operand_ != nullptr;
+}
+inline void BinaryArithOpEvalRangeExpr::clear_right_operand() {
+  if (GetArenaNoVirtual() == nullptr && right_operand_ != nullptr) {
+    delete right_operand_;
+  }
+  right_operand_ = nullptr;
+}
+inline const ::milvus::proto::plan::GenericValue& BinaryArithOpEvalRangeExpr::right_operand() const {
+  const ::milvus::proto::plan::GenericValue* p = right_operand_;
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.BinaryArithOpEvalRangeExpr.right_operand)
+  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::plan::GenericValue*>(
+      &::milvus::proto::plan::_GenericValue_default_instance_);
+}
+inline ::milvus::proto::plan::GenericValue* BinaryArithOpEvalRangeExpr::release_right_operand() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.BinaryArithOpEvalRangeExpr.right_operand)
+  
+  ::milvus::proto::plan::GenericValue* temp = right_operand_;
+  right_operand_ = nullptr;
+  return temp;
+}
+inline ::milvus::proto::plan::GenericValue* BinaryArithOpEvalRangeExpr::mutable_right_operand() {
+  
+  if (right_operand_ == nullptr) {
+    auto* p = CreateMaybeMessage<::milvus::proto::plan::GenericValue>(GetArenaNoVirtual());
+    right_operand_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.BinaryArithOpEvalRangeExpr.right_operand)
+  return right_operand_;
+}
+inline void BinaryArithOpEvalRangeExpr::set_allocated_right_operand(::milvus::proto::plan::GenericValue* right_operand) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete right_operand_;
+  }
+  if (right_operand) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      right_operand = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, right_operand, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  right_operand_ = right_operand;
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.BinaryArithOpEvalRangeExpr.right_operand)
+}
+
+// .milvus.proto.plan.OpType op = 4;
+inline void BinaryArithOpEvalRangeExpr::clear_op() {
+  op_ = 0;
+}
+inline ::milvus::proto::plan::OpType BinaryArithOpEvalRangeExpr::op() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.BinaryArithOpEvalRangeExpr.op)
+  return static_cast< ::milvus::proto::plan::OpType >(op_);
+}
+inline void BinaryArithOpEvalRangeExpr::set_op(::milvus::proto::plan::OpType value) {
+  
+  op_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.BinaryArithOpEvalRangeExpr.op)
+}
+
+// .milvus.proto.plan.GenericValue value = 5;
+inline bool BinaryArithOpEvalRangeExpr::has_value() const {
+  return this != internal_default_instance() && value_ != nullptr;
+}
+inline void BinaryArithOpEvalRangeExpr::clear_value() {
+  if (GetArenaNoVirtual() == nullptr && value_ != nullptr) {
+    delete value_;
+  }
+  value_ = nullptr;
+}
+inline const ::milvus::proto::plan::GenericValue& BinaryArithOpEvalRangeExpr::value() const {
+  const ::milvus::proto::plan::GenericValue* p = value_;
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.BinaryArithOpEvalRangeExpr.value)
+  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::plan::GenericValue*>(
+      &::milvus::proto::plan::_GenericValue_default_instance_);
+}
+inline ::milvus::proto::plan::GenericValue* BinaryArithOpEvalRangeExpr::release_value() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.BinaryArithOpEvalRangeExpr.value)
+  
+  ::milvus::proto::plan::GenericValue* temp = value_;
+  value_ = nullptr;
+  return temp;
+}
+inline ::milvus::proto::plan::GenericValue* BinaryArithOpEvalRangeExpr::mutable_value() {
+  
+  if (value_ == nullptr) {
+    auto* p = CreateMaybeMessage<::milvus::proto::plan::GenericValue>(GetArenaNoVirtual());
+    value_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.BinaryArithOpEvalRangeExpr.value)
+  return value_;
+}
+inline void BinaryArithOpEvalRangeExpr::set_allocated_value(::milvus::proto::plan::GenericValue* value) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete value_;
+  }
+  if (value) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      value = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, value, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  value_ = value;
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.BinaryArithOpEvalRangeExpr.value)
+}
+
+// -------------------------------------------------------------------
+
 // UnaryExpr
 
 // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
@@ -3444,6 +4585,88 @@ inline ::milvus::proto::plan::BinaryRangeExpr* Expr::mutable_binary_range_expr()
   return expr_.binary_range_expr_;
 }
 
+// .milvus.proto.plan.BinaryArithOpEvalRangeExpr binary_arith_op_eval_range_expr = 7;
+inline bool Expr::has_binary_arith_op_eval_range_expr() const {
+  return expr_case() == kBinaryArithOpEvalRangeExpr;
+}
+inline void Expr::set_has_binary_arith_op_eval_range_expr() {
+  _oneof_case_[0] = kBinaryArithOpEvalRangeExpr;
+}
+inline void Expr::clear_binary_arith_op_eval_range_expr() {
+  if (has_binary_arith_op_eval_range_expr()) {
+    delete expr_.binary_arith_op_eval_range_expr_;
+    clear_has_expr();
+  }
+}
+inline ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* Expr::release_binary_arith_op_eval_range_expr() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.Expr.binary_arith_op_eval_range_expr)
+  if (has_binary_arith_op_eval_range_expr()) {
+    clear_has_expr();
+      ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* temp = expr_.binary_arith_op_eval_range_expr_;
+    expr_.binary_arith_op_eval_range_expr_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
+}
+inline const ::milvus::proto::plan::BinaryArithOpEvalRangeExpr& Expr::binary_arith_op_eval_range_expr() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.Expr.binary_arith_op_eval_range_expr)
+  return has_binary_arith_op_eval_range_expr()
+      ? *expr_.binary_arith_op_eval_range_expr_
+      : *reinterpret_cast< ::milvus::proto::plan::BinaryArithOpEvalRangeExpr*>(&::milvus::proto::plan::_BinaryArithOpEvalRangeExpr_default_instance_);
+}
+inline ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* Expr::mutable_binary_arith_op_eval_range_expr() {
+  if (!has_binary_arith_op_eval_range_expr()) {
+    clear_expr();
+    set_has_binary_arith_op_eval_range_expr();
+    expr_.binary_arith_op_eval_range_expr_ = CreateMaybeMessage< ::milvus::proto::plan::BinaryArithOpEvalRangeExpr >(
+        GetArenaNoVirtual());
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.Expr.binary_arith_op_eval_range_expr)
+  return expr_.binary_arith_op_eval_range_expr_;
+}
+
+// .milvus.proto.plan.StringMatchExpr string_match_expr = 8;
+inline bool Expr::has_string_match_expr() const {
+  return expr_case() == kStringMatchExpr;
+}
+inline void Expr::set_has_string_match_expr() {
+  _oneof_case_[0] = kStringMatchExpr;
+}
+inline void Expr::clear_string_match_expr() {
+  if (has_string_match_expr()) {
+    delete expr_.string_match_expr_;
+    clear_has_expr();
+  }
+}
+inline ::milvus::proto::plan::StringMatchExpr* Expr::release_string_match_expr() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.Expr.string_match_expr)
+  if (has_string_match_expr()) {
+    clear_has_expr();
+      ::milvus::proto::plan::StringMatchExpr* temp = expr_.string_match_expr_;
+    expr_.string_match_expr_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
+}
+inline const ::milvus::proto::plan::StringMatchExpr& Expr::string_match_expr() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.Expr.string_match_expr)
+  return has_string_match_expr()
+      ? *expr_.string_match_expr_
+      : *reinterpret_cast< ::milvus::proto::plan::StringMatchExpr*>(&::milvus::proto::plan::_StringMatchExpr_default_instance_);
+}
+inline ::milvus::proto::plan::StringMatchExpr* Expr::mutable_string_match_expr() {
+  if (!has_string_match_expr()) {
+    clear_expr();
+    set_has_string_match_expr();
+    expr_.string_match_expr_ = CreateMaybeMessage< ::milvus::proto::plan::StringMatchExpr >(
+        GetArenaNoVirtual());
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.Expr.string_match_expr)
+  return expr_.string_match_expr_;
+}
+
 inline bool Expr::has_expr() const {
   return expr_case() != EXPR_NOT_SET;
 }
@@ -3754,6 +4977,20 @@ PlanNode::mutable_output_field_ids() {
   return &output_field_ids_;
 }
 
+// int64 limit = 4;
+inline void PlanNode::clear_limit() {
+  limit_ = PROTOBUF_LONGLONG(0);
+}
+inline ::PROTOBUF_NAMESPACE_ID::int64 PlanNode::limit() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.PlanNode.limit)
+  return limit_;
+}
+inline void PlanNode::set_limit(::PROTOBUF_NAMESPACE_ID::int64 value) {
+  
+  limit_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.PlanNode.limit)
+}
+
 inline bool PlanNode::has_node() const {
   return node_case() != NODE_NOT_SET;
 }
@@ -3788,6 +5025,12 @@ inline PlanNode::NodeCase PlanNode::node_case() const {
 
 // -------------------------------------------------------------------
 
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
 
 // @@protoc_insertion_point(namespace_scope)
 
@@ -3797,6 +5040,11 @@ inline PlanNode::NodeCase PlanNode::node_case() const {
 
 PROTOBUF_NAMESPACE_OPEN
 
+template <> struct is_proto_enum< ::milvus::proto::plan::StringMatchExpr_MatchOp> : ::std::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::milvus::proto::plan::StringMatchExpr_MatchOp>() {
+  return ::milvus::proto::plan::StringMatchExpr_MatchOp_descriptor();
+}
 template <> struct is_proto_enum< ::milvus::proto::plan::UnaryExpr_UnaryOp> : ::std::true_type {};
 template <>
 inline const EnumDescriptor* GetEnumDescriptor< ::milvus::proto::plan::UnaryExpr_UnaryOp>() {
@@ -3812,6 +5060,11 @@ template <>
 inline const EnumDescriptor* GetEnumDescriptor< ::milvus::proto::plan::OpType>() {
   return ::milvus::proto::plan::OpType_descriptor();
 }
+template <> struct is_proto_enum< ::milvus::proto::plan::ArithOpType> : ::std::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::milvus::proto::plan::ArithOpType>() {
+  return ::milvus::proto::plan::ArithOpType_descriptor();
+}
 
 PROTOBUF_NAMESPACE_CLOSE
 
@@ -103,6 +103,30 @@ struct UnaryRangeExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+enum class ArithOpType {
+    Unknown = 0,
+    Add = 1,
+    Sub = 2,
+    Mul = 3,
+    Div = 4,
+    Mod = 5,
+};
+
+struct BinaryArithOpEvalRangeExpr : Expr {
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+    ArithOpType arith_op_;
+    OpType op_type_;
+
+ protected:
+    // prevent accidential instantiation
+    BinaryArithOpEvalRangeExpr() = default;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 struct BinaryRangeExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
@@ -118,6 +142,24 @@ struct BinaryRangeExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+enum class StringMatchOpType {
+    Invalid = 0,
+    Prefix = 1,
+    Suffix = 2,
+    Contains = 3,
+};
+
+struct StringMatchExpr : Expr {
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+    StringMatchOpType op_type_;
+    std::string pattern_;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 struct CompareExpr : Expr {
     FieldOffset left_field_offset_;
     FieldOffset right_field_offset_;
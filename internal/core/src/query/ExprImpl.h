@@ -12,6 +12,7 @@
 #pragma once
 #include "Expr.h"
 #include <tuple>
+#include <type_traits>
 #include <vector>
 #include <boost/container/vector.hpp>
 
@@ -26,6 +27,14 @@ struct UnaryRangeExprImpl : UnaryRangeExpr {
     T value_;
 };
 
+template <typename T>
+struct BinaryArithOpEvalRangeExprImpl : BinaryArithOpEvalRangeExpr {
+    // the arithmetic of integer fields is done in int64_t, so the result is not truncated to the field type
+    using ValueType = std::conditional_t<std::is_integral_v<T>, int64_t, double>;
+    ValueType right_operand_;
+    ValueType value_;
+};
+
 template <typename T>
 struct BinaryRangeExprImpl : BinaryRangeExpr {
     T lower_value_;
@@ -30,11 +30,23 @@ struct PlanNode {
 
 using PlanNodePtr = std::unique_ptr<PlanNode>;
 
+// hits are kept if range_filter_ <= distance < radius_, or radius_ < distance <= range_filter_ for IP,
+// topk is the max results of a range search, and the search fails if more hits may be in range
+struct RangeSearchInfo {
+    float radius_;
+    float range_filter_;
+};
+
 struct SearchInfo {
     int64_t topk_;
     FieldOffset field_offset_;
     MetricType metric_type_;
     nlohmann::json search_params_;
+    std::optional<RangeSearchInfo> range_search_info_;
+    // hits are grouped by the field, each group keeps at most group_size_ hits,
+    // fewer than topk hits are returned if the nearest hits searched fill few groups
+    std::optional<FieldOffset> group_by_field_offset_;
+    int64_t group_size_;
 };
 
 struct VectorPlanNode : PlanNode {
@@ -61,6 +73,8 @@ struct RetrievePlanNode : PlanNode {
     accept(PlanNodeVisitor&) override;
 
     ExprPtr predicate_;
+    // max number of entities retrieved from a segment, ordered by primary key, 0 means no limit
+    int64_t limit_ = 0;
 };
 
 }  // namespace milvus::query
@@ -21,7 +21,7 @@ namespace planpb = milvus::proto::plan;
 template <typename T>
 std::unique_ptr<TermExprImpl<T>>
 ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::TermExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string>);
     auto result = std::make_unique<TermExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
@@ -37,6 +37,9 @@ ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::
         } else if constexpr (std::is_floating_point_v<T>) {
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             result->terms_.emplace_back(static_cast<T>(value_proto.float_val()));
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            result->terms_.emplace_back(value_proto.string_val());
         } else {
             static_assert(always_false<T>);
         }
@@ -47,7 +50,7 @@ ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::
 template <typename T>
 std::unique_ptr<UnaryRangeExprImpl<T>>
 ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const planpb::UnaryRangeExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string>);
     auto result = std::make_unique<UnaryRangeExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
@@ -63,10 +66,42 @@ ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const pl
         } else if constexpr (std::is_floating_point_v<T>) {
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             v = static_cast<T>(value_proto.float_val());
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            v = value_proto.string_val();
+        } else {
+            static_assert(always_false<T>);
+        }
+    };
+    setValue(result->value_, expr_proto.value());
+    return result;
+}
+
+template <typename T>
+std::unique_ptr<BinaryArithOpEvalRangeExprImpl<T>>
+ExtractBinaryArithOpEvalRangeExprImpl(FieldOffset field_offset,
+                                      DataType data_type,
+                                      const planpb::BinaryArithOpEvalRangeExpr& expr_proto) {
+    static_assert(std::is_fundamental_v<T>);
+    auto result = std::make_unique<BinaryArithOpEvalRangeExprImpl<T>>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = data_type;
+    result->arith_op_ = static_cast<ArithOpType>(expr_proto.arith_op());
+    result->op_type_ = static_cast<OpType>(expr_proto.op());
+
+    using ValueType = typename BinaryArithOpEvalRangeExprImpl<T>::ValueType;
+    auto setValue = [&](ValueType& v, const auto& value_proto) {
+        if constexpr (std::is_integral_v<T>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
+            v = static_cast<ValueType>(value_proto.int64_val());
+        } else if constexpr (std::is_floating_point_v<T>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
+            v = static_cast<ValueType>(value_proto.float_val());
         } else {
             static_assert(always_false<T>);
         }
     };
+    setValue(result->right_operand_, expr_proto.right_operand());
     setValue(result->value_, expr_proto.value());
     return result;
 }
@@ -74,7 +109,7 @@ ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const pl
 template <typename T>
 std::unique_ptr<BinaryRangeExprImpl<T>>
 ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const planpb::BinaryRangeExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string>);
     auto result = std::make_unique<BinaryRangeExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
@@ -89,6 +124,9 @@ ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const p
         } else if constexpr (std::is_floating_point_v<T>) {
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             v = static_cast<T>(value_proto.float_val());
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            v = value_proto.string_val();
         } else {
             static_assert(always_false<T>);
         }
@@ -123,6 +161,18 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     search_info.metric_type_ = GetMetricType(query_info_proto.metric_type());
     search_info.topk_ = query_info_proto.topk();
     search_info.search_params_ = json::parse(query_info_proto.search_params());
+    if (query_info_proto.has_range_search_info()) {
+        auto& range_proto = query_info_proto.range_search_info();
+        search_info.range_search_info_ = RangeSearchInfo{range_proto.radius(), range_proto.range_filter()};
+    }
+    if (query_info_proto.group_by_field_id() != 0) {
+        auto group_by_field_offset = schema.get_offset(FieldId(query_info_proto.group_by_field_id()));
+        AssertInfo(schema[group_by_field_offset].get_data_type() == DataType::INT64,
+                   "group by field should be of type int64");
+        AssertInfo(query_info_proto.group_size() > 0, "group size should be positive");
+        search_info.group_by_field_offset_ = group_by_field_offset;
+        search_info.group_size_ = query_info_proto.group_size();
+    }
 
     auto plan_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         if (anns_proto.is_binary()) {
@@ -139,12 +189,13 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
 
 std::unique_ptr<RetrievePlanNode>
 ProtoParser::RetrievePlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
-    Assert(plan_node_proto.has_predicates());
-    auto& predicate_proto = plan_node_proto.predicates();
-    auto expr_opt = [&]() -> ExprPtr { return ParseExpr(predicate_proto); }();
-
+    AssertInfo(!plan_node_proto.has_vector_anns(), "retrieve plan must not have vector anns");
     auto plan_node = [&]() -> std::unique_ptr<RetrievePlanNode> { return std::make_unique<RetrievePlanNode>(); }();
-    plan_node->predicate_ = std::move(expr_opt);
+    // a plan without predicates matches every entity
+    if (plan_node_proto.has_predicates()) {
+        plan_node->predicate_ = ParseExpr(plan_node_proto.predicates());
+    }
+    plan_node->limit_ = plan_node_proto.limit();
     return plan_node;
 }
 
@@ -219,6 +270,45 @@ ProtoParser::ParseUnaryRangeExpr(const proto::plan::UnaryRangeExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractUnaryRangeExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::STRING: {
+                return ExtractUnaryRangeExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
+            default: {
+                PanicInfo("unsupported data type");
+            }
+        }
+    }();
+    return result;
+}
+
+ExprPtr
+ProtoParser::ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEvalRangeExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto field_offset = schema.get_offset(field_id);
+    auto data_type = schema[field_offset].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+
+    auto result = [&]() -> ExprPtr {
+        switch (data_type) {
+            case DataType::INT8: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<int8_t>(field_offset, data_type, expr_pb);
+            }
+            case DataType::INT16: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<int16_t>(field_offset, data_type, expr_pb);
+            }
+            case DataType::INT32: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<int32_t>(field_offset, data_type, expr_pb);
+            }
+            case DataType::INT64: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<int64_t>(field_offset, data_type, expr_pb);
+            }
+            case DataType::FLOAT: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<float>(field_offset, data_type, expr_pb);
+            }
+            case DataType::DOUBLE: {
+                return ExtractBinaryArithOpEvalRangeExprImpl<double>(field_offset, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -258,6 +348,9 @@ ProtoParser::ParseBinaryRangeExpr(const proto::plan::BinaryRangeExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractBinaryRangeExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::STRING: {
+                return ExtractBinaryRangeExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -323,6 +416,9 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractTermExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::STRING: {
+                return ExtractTermExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -331,6 +427,23 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
     return result;
 }
 
+ExprPtr
+ProtoParser::ParseStringMatchExpr(const proto::plan::StringMatchExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto field_offset = schema.get_offset(field_id);
+    auto data_type = schema[field_offset].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    AssertInfo(datatype_is_string(data_type), "string match is only supported on string field");
+
+    auto result = std::make_unique<StringMatchExpr>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = data_type;
+    result->op_type_ = static_cast<StringMatchOpType>(expr_pb.op());
+    result->pattern_ = expr_pb.pattern();
+    return result;
+}
+
 ExprPtr
 ProtoParser::ParseUnaryExpr(const proto::plan::UnaryExpr& expr_pb) {
     auto op = static_cast<LogicalUnaryExpr::OpType>(expr_pb.op());
@@ -376,6 +489,12 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kCompareExpr: {
             return ParseCompareExpr(expr_pb.compare_expr());
         }
+        case ppe::kBinaryArithOpEvalRangeExpr: {
+            return ParseBinaryArithOpEvalRangeExpr(expr_pb.binary_arith_op_eval_range_expr());
+        }
+        case ppe::kStringMatchExpr: {
+            return ParseStringMatchExpr(expr_pb.string_match_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
@@ -29,6 +29,9 @@ class ProtoParser {
     ExprPtr
     ParseUnaryRangeExpr(const proto::plan::UnaryRangeExpr& expr_pb);
 
+    ExprPtr
+    ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEvalRangeExpr& expr_pb);
+
     ExprPtr
     ParseBinaryRangeExpr(const proto::plan::BinaryRangeExpr& expr_pb);
 
@@ -38,6 +41,9 @@ class ProtoParser {
     ExprPtr
     ParseTermExpr(const proto::plan::TermExpr& expr_pb);
 
+    ExprPtr
+    ParseStringMatchExpr(const proto::plan::StringMatchExpr& expr_pb);
+
     ExprPtr
     ParseUnaryExpr(const proto::plan::UnaryExpr& expr_pb);
 
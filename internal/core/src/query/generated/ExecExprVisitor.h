@@ -17,6 +17,7 @@
 #include <boost/variant.hpp>
 #include <utility>
 #include <deque>
+#include <string_view>
 #include "segcore/SegmentGrowingImpl.h"
 #include "query/ExprImpl.h"
 #include "ExprVisitor.h"
@@ -36,12 +37,18 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(UnaryRangeExpr& expr) override;
 
+    void
+    visit(BinaryArithOpEvalRangeExpr& expr) override;
+
     void
     visit(BinaryRangeExpr& expr) override;
 
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(StringMatchExpr& expr) override;
+
  public:
     using RetType = boost::dynamic_bitset<>;
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment, int64_t row_count, Timestamp timestamp)
@@ -70,10 +77,31 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
 
+    template <typename T, typename ElementFunc>
+    auto
+    ExecDataRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
+    template <typename T>
+    auto
+    ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType;
+
+    template <typename T, typename ArithFunc>
+    auto
+    ExecBinaryArithOpEvalRangeVisitorImpl(BinaryArithOpEvalRangeExpr& expr_raw, ArithFunc arith_func) -> RetType;
+
     template <typename T>
     auto
     ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType;
 
+    auto
+    ExecStringUnaryRangeVisitor(UnaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecStringBinaryRangeVisitor(BinaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecStringTermVisitor(TermExpr& expr_raw) -> RetType;
+
     template <typename CmpFunc>
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> RetType;
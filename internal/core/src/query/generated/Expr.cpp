@@ -35,6 +35,11 @@ UnaryRangeExpr::accept(ExprVisitor& visitor) {
     visitor.visit(*this);
 }
 
+void
+BinaryArithOpEvalRangeExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
 void
 BinaryRangeExpr::accept(ExprVisitor& visitor) {
     visitor.visit(*this);
@@ -45,4 +50,9 @@ CompareExpr::accept(ExprVisitor& visitor) {
     visitor.visit(*this);
 }
 
+void
+StringMatchExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
 }  // namespace milvus::query
@@ -31,10 +31,16 @@ class ExprVisitor {
     virtual void
     visit(UnaryRangeExpr&) = 0;
 
+    virtual void
+    visit(BinaryArithOpEvalRangeExpr&) = 0;
+
     virtual void
     visit(BinaryRangeExpr&) = 0;
 
     virtual void
     visit(CompareExpr&) = 0;
+
+    virtual void
+    visit(StringMatchExpr&) = 0;
 };
 }  // namespace milvus::query
@@ -30,12 +30,18 @@ class ExtractInfoExprVisitor : public ExprVisitor {
     void
     visit(UnaryRangeExpr& expr) override;
 
+    void
+    visit(BinaryArithOpEvalRangeExpr& expr) override;
+
     void
     visit(BinaryRangeExpr& expr) override;
 
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(StringMatchExpr& expr) override;
+
  public:
     explicit ExtractInfoExprVisitor(ExtractedPlanInfo& plan_info) : plan_info_(plan_info) {
     }
@@ -31,12 +31,18 @@ class ShowExprVisitor : public ExprVisitor {
     void
     visit(UnaryRangeExpr& expr) override;
 
+    void
+    visit(BinaryArithOpEvalRangeExpr& expr) override;
+
     void
     visit(BinaryRangeExpr& expr) override;
 
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(StringMatchExpr& expr) override;
+
  public:
     using RetType = Json;
 
@@ -36,12 +36,18 @@ class VerifyExprVisitor : public ExprVisitor {
     void
     visit(UnaryRangeExpr& expr) override;
 
+    void
+    visit(BinaryArithOpEvalRangeExpr& expr) override;
+
     void
     visit(BinaryRangeExpr& expr) override;
 
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(StringMatchExpr& expr) override;
+
  public:
 };
 }  // namespace milvus::query
@@ -14,6 +14,7 @@
 #include <boost/variant.hpp>
 #include <utility>
 #include <deque>
+#include <string_view>
 #include "segcore/SegmentGrowingImpl.h"
 #include "query/ExprImpl.h"
 #include "query/generated/ExecExprVisitor.h"
@@ -52,10 +53,31 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
 
+    template <typename T, typename ElementFunc>
+    auto
+    ExecDataRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
+    template <typename T>
+    auto
+    ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr_raw) -> RetType;
+
+    template <typename T, typename ArithFunc>
+    auto
+    ExecBinaryArithOpEvalRangeVisitorImpl(BinaryArithOpEvalRangeExpr& expr_raw, ArithFunc arith_func) -> RetType;
+
     template <typename T>
     auto
     ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType;
 
+    auto
+    ExecStringUnaryRangeVisitor(UnaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecStringBinaryRangeVisitor(BinaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecStringTermVisitor(TermExpr& expr_raw) -> RetType;
+
     template <typename CmpFunc>
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> RetType;
@@ -257,6 +279,200 @@ ExecExprVisitor::ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) ->
 }
 #pragma clang diagnostic pop
 
+// ExecDataRangeVisitorImpl evaluates element_func on the raw data of all chunks, for the exprs which scalar index can't help
+template <typename T, typename ElementFunc>
+auto
+ExecExprVisitor::ExecDataRangeVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<RetType> results;
+    for (auto chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        RetType result(this_size);
+        auto chunk = segment_.chunk_data<T>(field_offset, chunk_id);
+        for (int index = 0; index < this_size; ++index) {
+            result[index] = element_func(chunk[index]);
+        }
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Final result size not equal to row count");
+    return final_result;
+}
+
+#pragma clang diagnostic push
+#pragma ide diagnostic ignored "Simplify"
+template <typename T, typename ArithFunc>
+auto
+ExecExprVisitor::ExecBinaryArithOpEvalRangeVisitorImpl(BinaryArithOpEvalRangeExpr& expr_raw, ArithFunc arith_func)
+    -> RetType {
+    auto& expr = static_cast<BinaryArithOpEvalRangeExprImpl<T>&>(expr_raw);
+    using ValueType = typename BinaryArithOpEvalRangeExprImpl<T>::ValueType;
+    auto operand = expr.right_operand_;
+    auto val = expr.value_;
+    switch (expr.op_type_) {
+        case OpType::Equal: {
+            auto elem_func = [=](T x) { return (arith_func(static_cast<ValueType>(x), operand) == val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        case OpType::NotEqual: {
+            auto elem_func = [=](T x) { return (arith_func(static_cast<ValueType>(x), operand) != val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        case OpType::GreaterEqual: {
+            auto elem_func = [=](T x) { return (arith_func(static_cast<ValueType>(x), operand) >= val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        case OpType::GreaterThan: {
+            auto elem_func = [=](T x) { return (arith_func(static_cast<ValueType>(x), operand) > val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        case OpType::LessEqual: {
+            auto elem_func = [=](T x) { return (arith_func(static_cast<ValueType>(x), operand) <= val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        case OpType::LessThan: {
+            auto elem_func = [=](T x) { return (arith_func(static_cast<ValueType>(x), operand) < val); };
+            return ExecDataRangeVisitorImpl<T>(expr.field_offset_, elem_func);
+        }
+        default: {
+            PanicInfo("unsupported range node");
+        }
+    }
+}
+#pragma clang diagnostic pop
+
+template <typename T>
+auto
+ExecExprVisitor::ExecBinaryArithOpEvalRangeVisitorDispatcher(BinaryArithOpEvalRangeExpr& expr) -> RetType {
+    switch (expr.arith_op_) {
+        case ArithOpType::Add: {
+            return ExecBinaryArithOpEvalRangeVisitorImpl<T>(expr, std::plus<>{});
+        }
+        case ArithOpType::Sub: {
+            return ExecBinaryArithOpEvalRangeVisitorImpl<T>(expr, std::minus<>{});
+        }
+        case ArithOpType::Mul: {
+            return ExecBinaryArithOpEvalRangeVisitorImpl<T>(expr, std::multiplies<>{});
+        }
+        case ArithOpType::Div: {
+            return ExecBinaryArithOpEvalRangeVisitorImpl<T>(expr, std::divides<>{});
+        }
+        case ArithOpType::Mod: {
+            if constexpr (std::is_integral_v<T>) {
+                return ExecBinaryArithOpEvalRangeVisitorImpl<T>(expr, std::modulus<>{});
+            } else {
+                PanicInfo("modulo is only supported on integer fields");
+            }
+        }
+        default: {
+            PanicInfo("unsupported arithmetic operator");
+        }
+    }
+}
+
+void
+ExecExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    RetType res;
+    switch (expr.data_type_) {
+        case DataType::INT8: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int8_t>(expr);
+            break;
+        }
+        case DataType::INT16: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int16_t>(expr);
+            break;
+        }
+        case DataType::INT32: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int32_t>(expr);
+            break;
+        }
+        case DataType::INT64: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<int64_t>(expr);
+            break;
+        }
+        case DataType::FLOAT: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<float>(expr);
+            break;
+        }
+        case DataType::DOUBLE: {
+            res = ExecBinaryArithOpEvalRangeVisitorDispatcher<double>(expr);
+            break;
+        }
+        default:
+            PanicInfo("unsupported");
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    ret_ = std::move(res);
+}
+
+// string fields have no scalar index, the string exprs are evaluated on the raw data
+auto
+ExecExprVisitor::ExecStringUnaryRangeVisitor(UnaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<UnaryRangeExprImpl<std::string>&>(expr_raw);
+    std::string_view val = expr.value_;
+    switch (expr.op_type_) {
+        case OpType::Equal: {
+            auto elem_func = [val](std::string_view x) { return (x == val); };
+            return ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+        }
+        case OpType::NotEqual: {
+            auto elem_func = [val](std::string_view x) { return (x != val); };
+            return ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+        }
+        case OpType::GreaterEqual: {
+            auto elem_func = [val](std::string_view x) { return (x >= val); };
+            return ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+        }
+        case OpType::GreaterThan: {
+            auto elem_func = [val](std::string_view x) { return (x > val); };
+            return ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+        }
+        case OpType::LessEqual: {
+            auto elem_func = [val](std::string_view x) { return (x <= val); };
+            return ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+        }
+        case OpType::LessThan: {
+            auto elem_func = [val](std::string_view x) { return (x < val); };
+            return ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+        }
+        default: {
+            PanicInfo("unsupported range node");
+        }
+    }
+}
+
+auto
+ExecExprVisitor::ExecStringBinaryRangeVisitor(BinaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<BinaryRangeExprImpl<std::string>&>(expr_raw);
+    bool lower_inclusive = expr.lower_inclusive_;
+    bool upper_inclusive = expr.upper_inclusive_;
+    std::string_view val1 = expr.lower_value_;
+    std::string_view val2 = expr.upper_value_;
+    if (val1 > val2 || (val1 == val2 && !(lower_inclusive && upper_inclusive))) {
+        RetType res(row_count_, false);
+        return res;
+    }
+    auto elem_func = [=](std::string_view x) {
+        auto above = lower_inclusive ? val1 <= x : val1 < x;
+        auto below = upper_inclusive ? x <= val2 : x < val2;
+        return above && below;
+    };
+    return ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+}
+
+auto
+ExecExprVisitor::ExecStringTermVisitor(TermExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<TermExprImpl<std::string>&>(expr_raw);
+    std::vector<std::string_view> terms(expr.terms_.begin(), expr.terms_.end());
+    std::sort(terms.begin(), terms.end());
+    auto elem_func = [&terms](std::string_view x) { return std::binary_search(terms.begin(), terms.end(), x); };
+    return ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+}
+
 void
 ExecExprVisitor::visit(UnaryRangeExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_offset_];
@@ -292,6 +508,10 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
             res = ExecUnaryRangeVisitorDispatcher<double>(expr);
             break;
         }
+        case DataType::STRING: {
+            res = ExecStringUnaryRangeVisitor(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -334,6 +554,10 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
             res = ExecBinaryRangeVisitorDispatcher<double>(expr);
             break;
         }
+        case DataType::STRING: {
+            res = ExecStringBinaryRangeVisitor(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -520,10 +744,51 @@ ExecExprVisitor::visit(TermExpr& expr) {
             res = ExecTermVisitorImpl<double>(expr);
             break;
         }
+        case DataType::STRING: {
+            res = ExecStringTermVisitor(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     ret_ = std::move(res);
 }
+
+void
+ExecExprVisitor::visit(StringMatchExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    AssertInfo(field_meta.is_string(), "[ExecExprVisitor]string match is only supported on string field");
+    std::string_view pattern = expr.pattern_;
+    RetType res;
+    switch (expr.op_type_) {
+        case StringMatchOpType::Prefix: {
+            auto elem_func = [pattern](std::string_view x) {
+                return x.size() >= pattern.size() && x.compare(0, pattern.size(), pattern) == 0;
+            };
+            res = ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+            break;
+        }
+        case StringMatchOpType::Suffix: {
+            auto elem_func = [pattern](std::string_view x) {
+                return x.size() >= pattern.size() &&
+                       x.compare(x.size() - pattern.size(), pattern.size(), pattern) == 0;
+            };
+            res = ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+            break;
+        }
+        case StringMatchOpType::Contains: {
+            auto elem_func = [pattern](std::string_view x) { return x.find(pattern) != std::string_view::npos; };
+            res = ExecDataRangeVisitorImpl<VarChar>(expr.field_offset_, elem_func);
+            break;
+        }
+        default: {
+            PanicInfo("unsupported string match op");
+        }
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    ret_ = std::move(res);
+}
 }  // namespace milvus::query
@@ -18,7 +18,11 @@
 #include "query/generated/ExecExprVisitor.h"
 #include "query/SearchOnGrowing.h"
 #include "query/SearchOnSealed.h"
+#include "query/ExprImpl.h"
 #include "boost_ext/dynamic_bitset_ext.hpp"
+#include <limits>
+#include <optional>
+#include <unordered_map>
 
 namespace milvus::query {
 
@@ -73,6 +77,79 @@ empty_search_result(int64_t num_queries, int64_t topk, MetricType metric_type) {
     return final_result;
 }
 
+// values of the int64 field of the hits, invalid hits get -1
+static std::vector<int64_t>
+GetGroupByValues(const segcore::SegmentInternalInterface& segment,
+                 FieldOffset field_offset,
+                 const std::vector<int64_t>& seg_offsets) {
+    std::vector<int64_t> values(seg_offsets.size(), -1);
+    auto size_per_chunk = segment.size_per_chunk();
+    for (size_t i = 0; i < seg_offsets.size(); ++i) {
+        auto seg_offset = seg_offsets[i];
+        if (seg_offset == -1) {
+            continue;
+        }
+        auto chunk = segment.chunk_data<int64_t>(field_offset, seg_offset / size_per_chunk);
+        values[i] = chunk[seg_offset % size_per_chunk];
+    }
+    return values;
+}
+
+// the max topk accepted by the indexes
+constexpr int64_t MAX_GROUP_BY_FETCH_TOPK = 16384;
+
+// a grouped search keeps at most group_size_ hits of each group, so the topk nearest hits may fill few groups,
+// the search is repeated with a doubled topk until each query gets topk grouped hits or the segment is exhausted,
+// the fetch size is capped at MAX_GROUP_BY_FETCH_TOPK, beyond which the groups found so far are returned
+static SearchResult
+GroupBySearch(const segcore::SegmentInternalInterface& segment,
+              const SearchInfo& search_info,
+              const void* query_data,
+              int64_t num_queries,
+              int64_t active_count,
+              const BitsetView& view) {
+    auto topk = search_info.topk_;
+    auto group_size = search_info.group_size_;
+    auto fetch_info = search_info;
+    while (true) {
+        SearchResult fetched;
+        segment.vector_search(active_count, fetch_info, query_data, num_queries, MAX_TIMESTAMP, view, fetched);
+        auto fetch_topk = fetch_info.topk_;
+        auto values = GetGroupByValues(segment, search_info.group_by_field_offset_.value(), fetched.internal_seg_offsets_);
+
+        auto result = empty_search_result(num_queries, topk, search_info.metric_type_);
+        auto enough = true;
+        for (int64_t q = 0; q < num_queries; ++q) {
+            std::unordered_map<int64_t, int64_t> group_sizes;
+            int64_t kept = 0;
+            for (int64_t i = 0; i < fetch_topk && kept < topk; ++i) {
+                auto loc = q * fetch_topk + i;
+                // invalid hits are behind the valid ones
+                if (fetched.internal_seg_offsets_[loc] == -1) {
+                    break;
+                }
+                auto& size = group_sizes[values[loc]];
+                if (size >= group_size) {
+                    continue;
+                }
+                ++size;
+                result.internal_seg_offsets_[q * topk + kept] = fetched.internal_seg_offsets_[loc];
+                result.result_distances_[q * topk + kept] = fetched.result_distances_[loc];
+                ++kept;
+            }
+            // all the fetched hits are valid, so more hits may belong to the groups not full
+            if (kept < topk && fetched.internal_seg_offsets_[(q + 1) * fetch_topk - 1] != -1) {
+                enough = false;
+            }
+        }
+        auto max_fetch_topk = std::min(active_count, MAX_GROUP_BY_FETCH_TOPK);
+        if (enough || fetch_topk >= max_fetch_topk) {
+            return result;
+        }
+        fetch_info.topk_ = std::min(fetch_topk * 2, max_fetch_topk);
+    }
+}
+
 template <typename VectorType>
 void
 ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
@@ -103,17 +180,53 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         bitset_holder = std::move(expr_ret);
     }
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    segment->mask_with_delete(bitset_holder, active_count, timestamp_);
 
     if (!bitset_holder.empty()) {
         bitset_holder.flip();
         view = BitsetView((uint8_t*)boost_ext::get_data(bitset_holder), bitset_holder.size());
     }
 
+    if (node.search_info_.group_by_field_offset_.has_value()) {
+        ret_ = GroupBySearch(*segment, node.search_info_, src_data, num_queries, active_count, view);
+        return;
+    }
+
     segment->vector_search(active_count, node.search_info_, src_data, num_queries, MAX_TIMESTAMP, view, ret);
 
     ret_ = ret;
 }
 
+// the exclusive lower bound of the int64 primary key given by the predicate, e.g. the cursor of a query iterator,
+// only the range conditions on the primary key joined by "and" at the top of the predicate are considered
+static std::optional<int64_t>
+GetPkLowerBound(const Expr* expr, FieldOffset pk_offset) {
+    if (auto range = dynamic_cast<const UnaryRangeExprImpl<int64_t>*>(expr)) {
+        if (range->field_offset_.get() != pk_offset.get()) {
+            return std::nullopt;
+        }
+        if (range->op_type_ == OpType::GreaterThan) {
+            return range->value_;
+        }
+        if (range->op_type_ == OpType::GreaterEqual && range->value_ > std::numeric_limits<int64_t>::min()) {
+            return range->value_ - 1;
+        }
+        return std::nullopt;
+    }
+    if (auto logical = dynamic_cast<const LogicalBinaryExpr*>(expr)) {
+        if (logical->op_type_ != LogicalBinaryExpr::OpType::LogicalAnd) {
+            return std::nullopt;
+        }
+        auto left = GetPkLowerBound(logical->left_.get(), pk_offset);
+        auto right = GetPkLowerBound(logical->right_.get(), pk_offset);
+        if (!left.has_value() || !right.has_value()) {
+            return left.has_value() ? left : right;
+        }
+        return std::max(left.value(), right.value());
+    }
+    return std::nullopt;
+}
+
 void
 ExecPlanNodeVisitor::visit(RetrievePlanNode& node) {
     assert(!retrieve_ret_.has_value());
@@ -133,9 +246,28 @@ ExecPlanNodeVisitor::visit(RetrievePlanNode& node) {
         ExecExprVisitor::RetType expr_ret =
             ExecExprVisitor(*segment, active_count, timestamp_).call_child(*(node.predicate_));
         bitset_holder = std::move(expr_ret);
+    } else {
+        // no predicate, all entities match
+        bitset_holder.resize(active_count, true);
     }
 
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    segment->mask_with_delete(bitset_holder, active_count, timestamp_);
+
+    // a limited retrieve keeps the entities with the smallest int64 primary keys, which are found by seeking
+    // the entities in the order of primary key from the lower bound, rather than sorting all the matched ones
+    auto& schema = segment->get_schema();
+    auto pk_offset = schema.get_primary_key_offset();
+    if (node.limit_ > 0 && pk_offset.has_value() && schema[pk_offset.value()].get_data_type() == DataType::INT64) {
+        std::optional<int64_t> pk_lower_bound;
+        if (node.predicate_ != nullptr) {
+            pk_lower_bound = GetPkLowerBound(node.predicate_.get(), pk_offset.value());
+        }
+        auto seg_offsets = segment->search_ids_by_pk_order(bitset_holder, pk_lower_bound, node.limit_);
+        ret.result_offsets_.assign((int64_t*)seg_offsets.data(), (int64_t*)seg_offsets.data() + seg_offsets.size());
+        retrieve_ret_ = ret;
+        return;
+    }
 
     auto seg_offsets = std::move(segment->search_ids(bitset_holder, MAX_TIMESTAMP));
     ret.result_offsets_.assign((int64_t*)seg_offsets.data(), (int64_t*)seg_offsets.data() + seg_offsets.size());
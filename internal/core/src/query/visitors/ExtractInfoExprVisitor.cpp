@@ -49,6 +49,11 @@ ExtractInfoExprVisitor::visit(UnaryRangeExpr& expr) {
     plan_info_.add_involved_field(expr.field_offset_);
 }
 
+void
+ExtractInfoExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
+    plan_info_.add_involved_field(expr.field_offset_);
+}
+
 void
 ExtractInfoExprVisitor::visit(BinaryRangeExpr& expr) {
     plan_info_.add_involved_field(expr.field_offset_);
@@ -60,4 +65,9 @@ ExtractInfoExprVisitor::visit(CompareExpr& expr) {
     plan_info_.add_involved_field(expr.right_field_offset_);
 }
 
+void
+ExtractInfoExprVisitor::visit(StringMatchExpr& expr) {
+    plan_info_.add_involved_field(expr.field_offset_);
+}
+
 }  // namespace milvus::query
@@ -50,9 +50,10 @@ ExtractInfoPlanNodeVisitor::visit(BinaryVectorANNS& node) {
 
 void
 ExtractInfoPlanNodeVisitor::visit(RetrievePlanNode& node) {
-    // Assert(node.predicate_.has_value());
-    ExtractInfoExprVisitor expr_visitor(plan_info_);
-    node.predicate_->accept(expr_visitor);
+    if (node.predicate_ != nullptr) {
+        ExtractInfoExprVisitor expr_visitor(plan_info_);
+        node.predicate_->accept(expr_visitor);
+    }
 }
 
 }  // namespace milvus::query
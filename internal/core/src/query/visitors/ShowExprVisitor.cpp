@@ -127,6 +127,8 @@ ShowExprVisitor::visit(TermExpr& expr) {
                 return TermExtract<double>(expr);
             case DataType::FLOAT:
                 return TermExtract<float>(expr);
+            case DataType::STRING:
+                return TermExtract<std::string>(expr);
             default:
                 PanicInfo("unsupported type");
         }
@@ -181,6 +183,56 @@ ShowExprVisitor::visit(UnaryRangeExpr& expr) {
         case DataType::FLOAT:
             ret_ = UnaryRangeExtract<float>(expr);
             return;
+        case DataType::STRING:
+            ret_ = UnaryRangeExtract<std::string>(expr);
+            return;
+        default:
+            PanicInfo("unsupported type");
+    }
+}
+
+template <typename T>
+static Json
+BinaryArithOpEvalRangeExtract(const BinaryArithOpEvalRangeExpr& expr_raw) {
+    using proto::plan::ArithOpType;
+    using proto::plan::ArithOpType_Name;
+    using proto::plan::OpType;
+    using proto::plan::OpType_Name;
+    auto expr = dynamic_cast<const BinaryArithOpEvalRangeExprImpl<T>*>(&expr_raw);
+    AssertInfo(expr, "[ShowExprVisitor]BinaryArithOpEvalRangeExpr cast to BinaryArithOpEvalRangeExprImpl failed");
+    Json res{{"expr_type", "BinaryArithOpEvalRange"},
+             {"field_offset", expr->field_offset_.get()},
+             {"data_type", datatype_name(expr->data_type_)},
+             {"arith_op", ArithOpType_Name(static_cast<ArithOpType>(expr->arith_op_))},
+             {"right_operand", expr->right_operand_},
+             {"op", OpType_Name(static_cast<OpType>(expr->op_type_))},
+             {"value", expr->value_}};
+    return res;
+}
+
+void
+ShowExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
+    AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+    AssertInfo(datatype_is_vector(expr.data_type_) == false, "[ShowExprVisitor]Data type of expr isn't vector type");
+    switch (expr.data_type_) {
+        case DataType::INT8:
+            ret_ = BinaryArithOpEvalRangeExtract<int8_t>(expr);
+            return;
+        case DataType::INT16:
+            ret_ = BinaryArithOpEvalRangeExtract<int16_t>(expr);
+            return;
+        case DataType::INT32:
+            ret_ = BinaryArithOpEvalRangeExtract<int32_t>(expr);
+            return;
+        case DataType::INT64:
+            ret_ = BinaryArithOpEvalRangeExtract<int64_t>(expr);
+            return;
+        case DataType::DOUBLE:
+            ret_ = BinaryArithOpEvalRangeExtract<double>(expr);
+            return;
+        case DataType::FLOAT:
+            ret_ = BinaryArithOpEvalRangeExtract<float>(expr);
+            return;
         default:
             PanicInfo("unsupported type");
     }
@@ -229,6 +281,9 @@ ShowExprVisitor::visit(BinaryRangeExpr& expr) {
         case DataType::FLOAT:
             ret_ = BinaryRangeExtract<float>(expr);
             return;
+        case DataType::STRING:
+            ret_ = BinaryRangeExtract<std::string>(expr);
+            return;
         default:
             PanicInfo("unsupported type");
     }
@@ -248,4 +303,18 @@ ShowExprVisitor::visit(CompareExpr& expr) {
              {"op", OpType_Name(static_cast<OpType>(expr.op_type_))}};
     ret_ = res;
 }
+
+void
+ShowExprVisitor::visit(StringMatchExpr& expr) {
+    using proto::plan::StringMatchExpr_MatchOp;
+    using proto::plan::StringMatchExpr_MatchOp_Name;
+    AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "StringMatch"},
+             {"field_offset", expr.field_offset_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", StringMatchExpr_MatchOp_Name(static_cast<StringMatchExpr_MatchOp>(expr.op_type_))},
+             {"pattern", expr.pattern_}};
+    ret_ = res;
+}
 }  // namespace milvus::query
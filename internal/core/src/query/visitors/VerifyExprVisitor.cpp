@@ -32,6 +32,11 @@ VerifyExprVisitor::visit(UnaryRangeExpr& expr) {
     // TODO
 }
 
+void
+VerifyExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
+    // TODO
+}
+
 void
 VerifyExprVisitor::visit(BinaryRangeExpr& expr) {
     // TODO
@@ -42,4 +47,9 @@ VerifyExprVisitor::visit(CompareExpr& expr) {
     // TODO
 }
 
+void
+VerifyExprVisitor::visit(StringMatchExpr& expr) {
+    // TODO
+}
+
 }  // namespace milvus::query
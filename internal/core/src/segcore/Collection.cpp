@@ -49,4 +49,18 @@ Collection::parse() {
     int i = 1 + 1;
 }
 
+void
+Collection::update_schema(const std::string& collection_proto) {
+    milvus::proto::schema::CollectionSchema collection_schema;
+    auto suc = google::protobuf::TextFormat::ParseFromString(collection_proto, &collection_schema);
+    AssertInfo(suc, "unmarshal schema string failed");
+    auto schema = Schema::ParseFrom(collection_schema);
+
+    std::lock_guard lck(mutex_);
+    AssertInfo(schema->size() >= schema_->size(), "fields can't be removed from the schema of a collection");
+    schema_proto_ = collection_proto;
+    replaced_schemas_.push_back(std::move(schema_));
+    schema_ = std::move(schema);
+}
+
 }  // namespace milvus::segcore
@@ -14,6 +14,8 @@
 #include "common/Schema.h"
 #include <string>
 #include <memory>
+#include <mutex>
+#include <vector>
 
 namespace milvus::segcore {
 
@@ -24,9 +26,14 @@ class Collection {
     void
     parse();
 
+    // replace the schema, the new one may only append scalar fields
+    void
+    update_schema(const std::string& collection_proto);
+
  public:
-    SchemaPtr&
+    SchemaPtr
     get_schema() {
+        std::lock_guard lck(mutex_);
         return schema_;
     }
 
@@ -39,6 +46,9 @@ class Collection {
     std::string collection_name_;
     std::string schema_proto_;
     SchemaPtr schema_;
+    // plans refer to the schema they are created with, so the replaced schemas are kept alive
+    std::vector<SchemaPtr> replaced_schemas_;
+    std::mutex mutex_;
 };
 
 using CollectionPtr = std::unique_ptr<Collection>;
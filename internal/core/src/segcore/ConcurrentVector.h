@@ -258,4 +258,13 @@ class ConcurrentVector<BinaryVector> : public ConcurrentVectorImpl<uint8_t, fals
     int64_t binary_dim_;
 };
 
+// each element is a slot of VarChar
+template <>
+class ConcurrentVector<VarChar> : public ConcurrentVectorImpl<uint8_t, false> {
+ public:
+    ConcurrentVector(int64_t slot_sizeof, int64_t size_per_chunk)
+        : ConcurrentVectorImpl<uint8_t, false>::ConcurrentVectorImpl(slot_sizeof, size_per_chunk) {
+    }
+};
+
 }  // namespace milvus::segcore
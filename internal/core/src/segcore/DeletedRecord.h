@@ -14,8 +14,13 @@
 #include "AckResponder.h"
 #include "common/Schema.h"
 #include "knowhere/index/vector_index/IndexIVF.h"
+#include <algorithm>
 #include <utility>
 #include <memory>
+#include <mutex>
+#include <shared_mutex>
+#include <unordered_map>
+#include <vector>
 #include "segcore/Record.h"
 
 namespace milvus::segcore {
@@ -55,6 +60,41 @@ struct DeletedRecord {
         lru_ = std::move(new_entry);
     }
 
+    // calls fn(uid, timestamp) with the latest timestamp of the deletes of each uid,
+    // deletes after timestamp are invisible
+    template <typename Fn>
+    void
+    for_each_latest_delete(Timestamp timestamp, Fn&& fn) const {
+        update_uid2timestamps();
+        std::shared_lock lck(uid2timestamps_mutex_);
+        for (auto& [uid, del_timestamps] : uid2timestamps_) {
+            auto iter = std::upper_bound(del_timestamps.begin(), del_timestamps.end(), timestamp);
+            if (iter != del_timestamps.begin()) {
+                fn(uid, *(iter - 1));
+            }
+        }
+    }
+
+ private:
+    // index the deletes acked since the last call, so that each delete is only visited once
+    void
+    update_uid2timestamps() const {
+        auto del_barrier = ack_responder_.GetAck();
+        {
+            std::shared_lock lck(uid2timestamps_mutex_);
+            if (indexed_barrier_ >= del_barrier) {
+                return;
+            }
+        }
+        std::lock_guard lck(uid2timestamps_mutex_);
+        for (; indexed_barrier_ < del_barrier; ++indexed_barrier_) {
+            auto del_timestamp = timestamps_[indexed_barrier_];
+            auto& del_timestamps = uid2timestamps_[uids_[indexed_barrier_]];
+            auto iter = std::upper_bound(del_timestamps.begin(), del_timestamps.end(), del_timestamp);
+            del_timestamps.insert(iter, del_timestamp);
+        }
+    }
+
  public:
     std::atomic<int64_t> reserved = 0;
     AckResponder ack_responder_;
@@ -64,6 +104,11 @@ struct DeletedRecord {
  private:
     std::shared_ptr<TmpBitmap> lru_;
     std::shared_mutex shared_mutex_;
+
+    // the sorted timestamps of the deletes of each uid, covering the deletes before indexed_barrier_
+    mutable std::unordered_map<idx_t, std::vector<Timestamp>> uid2timestamps_;
+    mutable int64_t indexed_barrier_ = 0;
+    mutable std::shared_mutex uid2timestamps_mutex_;
 };
 
 inline auto
@@ -96,6 +96,19 @@ IndexingRecord::UpdateResourceAck(int64_t chunk_ack, const InsertRecord& record)
     //    }).detach();
 }
 
+void
+IndexingRecord::AppendField(const FieldMeta& field, FieldOffset field_offset, const InsertRecord& record) {
+    AssertInfo(!field.is_vector(), "vector field can't be appended");
+    // string fields are scanned without index
+    if (field.is_string()) {
+        return;
+    }
+    std::unique_lock lck(mutex_);
+    auto indexing = CreateIndex(field, segcore_config_);
+    indexing->BuildIndexRange(0, resource_ack_, record.get_field_data_base(field_offset));
+    field_indexings_.try_emplace(field_offset, std::move(indexing));
+}
+
 template <typename T>
 void
 ScalarFieldIndexing<T>::BuildIndexRange(int64_t ack_beg, int64_t ack_end, const VectorBase* vec_base) {
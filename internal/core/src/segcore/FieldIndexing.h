@@ -126,12 +126,20 @@ class IndexingRecord {
                     continue;
                 }
             }
+            // string fields are scanned without index
+            if (field.is_string()) {
+                continue;
+            }
 
             field_indexings_.try_emplace(offset, CreateIndex(field, segcore_config_));
         }
         assert(offset_id == schema_.size());
     }
 
+    // add the indexing of a scalar field appended to the schema, built for the finished chunks
+    void
+    AppendField(const FieldMeta& field, FieldOffset field_offset, const InsertRecord& record);
+
     // concurrent, reentrant
     void
     UpdateResourceAck(int64_t chunk_ack, const InsertRecord& record);
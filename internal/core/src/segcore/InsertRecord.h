@@ -53,6 +53,10 @@ struct InsertRecord {
         return ptr;
     }
 
+    // append a column of the field according to its data type
+    void
+    append_field_data(const FieldMeta& field, int64_t size_per_chunk);
+
     // append a column of scalar type
     template <typename Type>
     void
@@ -61,11 +65,11 @@ struct InsertRecord {
         fields_data_.emplace_back(std::make_unique<ConcurrentVector<Type>>(size_per_chunk));
     }
 
-    // append a column of vector type
+    // append a column of vector type, or of string type with dim as the size of slot
     template <typename VectorType>
     void
     append_field_data(int64_t dim, int64_t size_per_chunk) {
-        static_assert(std::is_base_of_v<VectorTrait, VectorType>);
+        static_assert(std::is_base_of_v<VectorTrait, VectorType> || std::is_same_v<VectorType, VarChar>);
         fields_data_.emplace_back(std::make_unique<ConcurrentVector<VectorType>>(dim, size_per_chunk));
     }
 
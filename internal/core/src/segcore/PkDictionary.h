@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+
+#include <deque>
+#include <mutex>
+#include <shared_mutex>
+#include <string>
+#include <string_view>
+#include <unordered_map>
+#include "common/Schema.h"
+#include "exceptions/EasyAssert.h"
+
+namespace milvus::segcore {
+
+// PkDictionary maps the string primary keys of a segment to int64 keys, numbered in the order they are seen,
+// so that the offsets, the deletes and the primary key index of the segment work on int64 keys only
+class PkDictionary {
+ public:
+    // return the int64 key of pk, a new key is assigned if pk is not seen before
+    idx_t
+    intern(std::string_view pk) {
+        {
+            std::shared_lock lck(mutex_);
+            auto iter = pk2key_.find(std::string(pk));
+            if (iter != pk2key_.end()) {
+                return iter->second;
+            }
+        }
+        std::unique_lock lck(mutex_);
+        auto [iter, inserted] = pk2key_.try_emplace(std::string(pk), static_cast<idx_t>(key2pk_.size()));
+        if (inserted) {
+            key2pk_.emplace_back(iter->first);
+        }
+        return iter->second;
+    }
+
+    // return the string primary key of key
+    std::string
+    at(idx_t key) const {
+        std::shared_lock lck(mutex_);
+        AssertInfo(key >= 0 && key < key2pk_.size(), "primary key " + std::to_string(key) + " not found");
+        return key2pk_[key];
+    }
+
+ private:
+    mutable std::shared_mutex mutex_;
+    std::unordered_map<std::string, idx_t> pk2key_;
+    std::deque<std::string> key2pk_;
+};
+
+}  // namespace milvus::segcore
@@ -11,6 +11,8 @@
 
 #include "ScalarIndex.h"
 
+#include <algorithm>
+
 namespace milvus::segcore {
 std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
 ScalarIndexVector::do_search_ids(const IdArray& ids) const {
@@ -47,6 +49,40 @@ ScalarIndexVector::do_search_ids(const IdArray& ids) const {
     }
     return {std::move(res_ids), std::move(dst_offsets)};
 }
+std::vector<SegOffset>
+ScalarIndexVector::search_offsets(int64_t id) const {
+    using Pair = std::pair<T, SegOffset>;
+    auto [iter_beg, iter_end] =
+        std::equal_range(mapping_.begin(), mapping_.end(), std::make_pair(id, SegOffset(0)),
+                         [](const Pair& left, const Pair& right) { return left.first < right.first; });
+    std::vector<SegOffset> offsets;
+    for (auto iter = iter_beg; iter != iter_end; ++iter) {
+        offsets.push_back(iter->second);
+    }
+    return offsets;
+}
+
+std::vector<SegOffset>
+ScalarIndexVector::search_offsets_in_order(const boost::dynamic_bitset<>& bitset,
+                                           std::optional<int64_t> lower_bound,
+                                           int64_t limit) const {
+    using Pair = std::pair<T, SegOffset>;
+    auto iter = mapping_.begin();
+    if (lower_bound.has_value()) {
+        iter = std::upper_bound(mapping_.begin(), mapping_.end(), std::make_pair(lower_bound.value(), SegOffset(0)),
+                                [](const Pair& left, const Pair& right) { return left.first < right.first; });
+    }
+    std::vector<SegOffset> offsets;
+    for (; iter != mapping_.end() && static_cast<int64_t>(offsets.size()) < limit; ++iter) {
+        // the rows beyond the bitset are not visible
+        auto offset = iter->second.get();
+        if (offset < static_cast<int64_t>(bitset.size()) && bitset[offset]) {
+            offsets.push_back(iter->second);
+        }
+    }
+    return offsets;
+}
+
 void
 ScalarIndexVector::append_data(const ScalarIndexVector::T* ids, int64_t count, SegOffset base) {
     for (int64_t i = 0; i < count; ++i) {
@@ -56,6 +92,9 @@ ScalarIndexVector::append_data(const ScalarIndexVector::T* ids, int64_t count, S
 }
 void
 ScalarIndexVector::build() {
-    std::sort(mapping_.begin(), mapping_.end());
+    auto sorted_end = mapping_.begin() + sorted_count_;
+    std::sort(sorted_end, mapping_.end());
+    std::inplace_merge(mapping_.begin(), sorted_end, mapping_.end());
+    sorted_count_ = mapping_.size();
 }
 }  // namespace milvus::segcore
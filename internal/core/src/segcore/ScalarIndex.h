@@ -14,7 +14,9 @@
 #include "common/Types.h"
 #include "pb/schema.pb.h"
 
+#include <boost/dynamic_bitset.hpp>
 #include <memory>
+#include <optional>
 #include <vector>
 #include <string>
 #include <utility>
@@ -25,6 +27,15 @@ class ScalarIndexBase {
  public:
     virtual std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
     do_search_ids(const IdArray& ids) const = 0;
+    // offsets of all the rows with the id, including the repeated ones
+    virtual std::vector<SegOffset>
+    search_offsets(int64_t id) const = 0;
+    // offsets in the order of id, which start after lower_bound if it's set, and stop once
+    // limit offsets whose bits are set in bitset are found
+    virtual std::vector<SegOffset>
+    search_offsets_in_order(const boost::dynamic_bitset<>& bitset,
+                            std::optional<int64_t> lower_bound,
+                            int64_t limit) const = 0;
     virtual ~ScalarIndexBase() = default;
     virtual std::string
     debug() const = 0;
@@ -38,12 +49,26 @@ class ScalarIndexVector : public ScalarIndexBase {
     void
     append_data(const T* ids, int64_t count, SegOffset base);
 
+    // sort the data appended since the last build, and merge it into the sorted data
     void
     build();
 
+    int64_t
+    size() const {
+        return mapping_.size();
+    }
+
     std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
     do_search_ids(const IdArray& ids) const override;
 
+    std::vector<SegOffset>
+    search_offsets(int64_t id) const override;
+
+    std::vector<SegOffset>
+    search_offsets_in_order(const boost::dynamic_bitset<>& bitset,
+                            std::optional<int64_t> lower_bound,
+                            int64_t limit) const override;
+
     std::string
     debug() const override {
         std::string dbg_str;
@@ -55,5 +80,6 @@ class ScalarIndexVector : public ScalarIndexBase {
 
  private:
     std::vector<std::pair<T, SegOffset>> mapping_;
+    int64_t sorted_count_ = 0;
 };
 }  // namespace milvus::segcore
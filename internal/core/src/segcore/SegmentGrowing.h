@@ -60,15 +60,13 @@ class SegmentGrowing : public SegmentInternalInterface {
            const Timestamp* timestamps,
            const ColumnBasedRawData& values) = 0;
 
-    virtual int64_t
-    PreDelete(int64_t size) = 0;
-
-    virtual Status
-    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) = 0;
+    // copies the row ids and timestamps of the first size deletes
+    virtual void
+    GetDeletedRecords(int64_t size, int64_t* row_ids, Timestamp* timestamps) const = 0;
 
- public:
-    virtual ssize_t
-    get_deleted_count() const = 0;
+    // returns the primary keys of the first size deletes and copies their timestamps
+    virtual std::unique_ptr<IdArray>
+    GetDeletedPrimaryKeys(int64_t size, Timestamp* timestamps) const = 0;
 };
 
 using SegmentGrowingPtr = std::unique_ptr<SegmentGrowing>;
@@ -115,6 +115,26 @@ SegmentGrowingImpl::get_deleted_bitmap(int64_t del_barrier,
     return current;
 }
 
+void
+SegmentGrowingImpl::UpdateSchema(SchemaPtr schema) {
+    std::unique_lock lck(mutex_);
+    if (schema == schema_) {
+        return;
+    }
+    check_appended_fields(*schema);
+    auto reserved = record_.reserved.load();
+    for (auto i = schema_->size(); i < schema->size(); ++i) {
+        auto field_offset = FieldOffset(i);
+        auto& field_meta = (*schema)[field_offset];
+        record_.append_field_data(field_meta, size_per_chunk());
+        // the chunks are zero filled on allocation
+        record_.get_field_data_base(field_offset)->grow_to_at_least(reserved);
+        indexing_record_.AppendField(field_meta, field_offset, record_);
+    }
+    replaced_schemas_.push_back(std::move(schema_));
+    schema_ = std::move(schema);
+}
+
 Status
 SegmentGrowingImpl::Insert(int64_t reserved_begin,
                            int64_t size,
@@ -122,8 +142,12 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
                            const Timestamp* timestamps_raw,
                            const RowBasedRawData& entities_raw) {
     AssertInfo(entities_raw.count == size, "Entities_raw count not equal to insert size");
-    // step 1: check schema if valid
-    if (entities_raw.sizeof_per_row != schema_->get_total_sizeof()) {
+    // step 1: check schema if valid, rows written before fields were appended to the schema
+    // end at a field boundary, the missing fields get zero values
+    auto sizeof_infos = schema_->get_sizeof_infos();
+    std::vector<int> offset_infos(schema_->size() + 1, 0);
+    std::partial_sum(sizeof_infos.begin(), sizeof_infos.end(), offset_infos.begin() + 1);
+    if (std::find(offset_infos.begin() + 1, offset_infos.end(), entities_raw.sizeof_per_row) == offset_infos.end()) {
         std::string msg = "entity length = " + std::to_string(entities_raw.sizeof_per_row) +
                           ", schema length = " + std::to_string(schema_->get_total_sizeof());
         throw std::runtime_error(msg);
@@ -141,9 +165,6 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
     std::sort(ordering.begin(), ordering.end());
 
     // step 3: and convert row-based data to column-based data accordingly
-    auto sizeof_infos = schema_->get_sizeof_infos();
-    std::vector<int> offset_infos(schema_->size() + 1, 0);
-    std::partial_sum(sizeof_infos.begin(), sizeof_infos.end(), offset_infos.begin() + 1);
     std::vector<aligned_vector<uint8_t>> entities(schema_->size());
 
     for (int fid = 0; fid < schema_->size(); ++fid) {
@@ -161,6 +182,9 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
         for (int fid = 0; fid < schema_->size(); ++fid) {
             auto len = sizeof_infos[fid];
             auto offset = offset_infos[fid];
+            if (offset + len > len_per_row) {
+                break;
+            }
             auto src = raw_data + order_index * len_per_row + offset;
             auto dst = entities[fid].data() + index * len;
             memcpy(dst, src, len);
@@ -195,9 +219,17 @@ SegmentGrowingImpl::do_insert(int64_t reserved_begin,
         auto offset = schema_->get_primary_key_offset().value_or(FieldOffset(-1));
         AssertInfo(offset.get() != -1, "Primary key offset is -1");
         auto& row = columns_data[offset.get()];
-        auto row_ptr = reinterpret_cast<const int64_t*>(row.data());
-        for (int i = 0; i < size; ++i) {
-            uid2offset_.insert(std::make_pair(row_ptr[i], reserved_begin + i));
+        if (has_string_primary_key()) {
+            auto slot_sizeof = (*schema_)[offset].get_sizeof();
+            for (int i = 0; i < size; ++i) {
+                auto pk = VarCharView(row.data() + i * slot_sizeof);
+                uid2offset_.insert(std::make_pair(pk_dict_.intern(pk), reserved_begin + i));
+            }
+        } else {
+            auto row_ptr = reinterpret_cast<const int64_t*>(row.data());
+            for (int i = 0; i < size; ++i) {
+                uid2offset_.insert(std::make_pair(row_ptr[i], reserved_begin + i));
+            }
         }
     }
 
@@ -240,6 +272,31 @@ SegmentGrowingImpl::Delete(int64_t reserved_begin,
     //    return Status::OK();
 }
 
+void
+SegmentGrowingImpl::GetDeletedRecords(int64_t size, int64_t* row_ids, Timestamp* timestamps) const {
+    AssertInfo(size <= deleted_record_.ack_responder_.GetAck(), "size is larger than deleted count");
+    for (int64_t i = 0; i < size; ++i) {
+        row_ids[i] = deleted_record_.uids_[i];
+        timestamps[i] = deleted_record_.timestamps_[i];
+    }
+}
+
+std::unique_ptr<IdArray>
+SegmentGrowingImpl::GetDeletedPrimaryKeys(int64_t size, Timestamp* timestamps) const {
+    std::vector<int64_t> keys(size);
+    GetDeletedRecords(size, keys.data(), timestamps);
+    auto pks = std::make_unique<IdArray>();
+    if (!has_string_primary_key()) {
+        pks->mutable_int_id()->mutable_data()->Add(keys.begin(), keys.end());
+        return pks;
+    }
+    auto str_ids = pks->mutable_str_id();
+    for (auto key : keys) {
+        str_ids->add_data(pk_dict_.at(key));
+    }
+    return pks;
+}
+
 int64_t
 SegmentGrowingImpl::GetMemoryUsageInBytes() const {
     int64_t total_bytes = 0;
@@ -298,6 +355,10 @@ SegmentGrowingImpl::bulk_subscript(FieldOffset field_offset,
         }
         return;
     }
+    if (field_meta.is_string()) {
+        bulk_subscript_impl<VarChar>(field_meta.get_sizeof(), *vec_ptr, seg_offsets, count, output);
+        return;
+    }
 
     AssertInfo(!field_meta.is_vector(), "Scalar field meta type is vector type");
     switch (field_meta.get_data_type()) {
@@ -342,7 +403,7 @@ SegmentGrowingImpl::bulk_subscript_impl(int64_t element_sizeof,
                                         const int64_t* seg_offsets,
                                         int64_t count,
                                         void* output_raw) const {
-    static_assert(IsVector<T>);
+    static_assert(IsVector<T> || std::is_same_v<T, VarChar>);
     auto vec_ptr = dynamic_cast<const ConcurrentVector<T>*>(&vec_raw);
     AssertInfo(vec_ptr, "Pointer of vec_raw is nullptr");
     auto& vec = *vec_ptr;
@@ -458,6 +519,36 @@ SegmentGrowingImpl::search_ids(const boost::dynamic_bitset<>& bitset, Timestamp
     return res_offsets;
 }
 
+std::vector<SegOffset>
+SegmentGrowingImpl::search_ids_by_pk_order(const boost::dynamic_bitset<>& bitset,
+                                           std::optional<int64_t> pk_lower_bound,
+                                           int64_t limit) const {
+    AssertInfo(!has_string_primary_key(), "string primary key can't be searched in order");
+    std::lock_guard lck(pk_order_mutex_);
+    // the rows visible to the retrieve are sorted at most once, the retrieves at the same timestamp,
+    // e.g. the batches of a query iterator, only seek the sorted rows
+    auto sorted_count = pk_order_.size();
+    auto active_count = static_cast<int64_t>(bitset.size());
+    if (sorted_count < active_count) {
+        auto count = active_count - sorted_count;
+        std::vector<int64_t> pks(count);
+        if (schema_->get_is_auto_id()) {
+            for (int64_t i = 0; i < count; ++i) {
+                pks[i] = record_.uids_[sorted_count + i];
+            }
+        } else {
+            auto pk_offset = schema_->get_primary_key_offset().value();
+            auto pk_column = record_.get_field_data<int64_t>(pk_offset);
+            for (int64_t i = 0; i < count; ++i) {
+                pks[i] = (*pk_column)[sorted_count + i];
+            }
+        }
+        pk_order_.append_data(pks.data(), count, SegOffset(sorted_count));
+        pk_order_.build();
+    }
+    return pk_order_.search_offsets_in_order(bitset, pk_lower_bound, limit);
+}
+
 std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
 SegmentGrowingImpl::search_ids(const IdArray& id_array, Timestamp timestamp) const {
     AssertInfo(id_array.has_int_id(), "Id array doesn't have int_id element");
@@ -503,4 +594,21 @@ SegmentGrowingImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk,
     // DO NOTHING
 }
 
+void
+SegmentGrowingImpl::mask_with_delete(boost::dynamic_bitset<>& bitset, int64_t ins_barrier, Timestamp timestamp) const {
+    deleted_record_.for_each_latest_delete(timestamp, [&](idx_t uid, Timestamp del_timestamp) {
+        if (bitset.empty()) {
+            bitset.resize(ins_barrier, true);
+        }
+        auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
+        for (auto iter = iter_b; iter != iter_e; ++iter) {
+            auto offset = iter->second;
+            // the rows inserted after the delete, e.g. by upsert, are kept
+            if (offset < ins_barrier && record_.timestamps_[offset] < del_timestamp) {
+                bitset[offset] = false;
+            }
+        }
+    });
+}
+
 }  // namespace milvus::segcore
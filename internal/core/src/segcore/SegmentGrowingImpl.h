@@ -29,8 +29,10 @@
 #include "exceptions/EasyAssert.h"
 #include "FieldIndexing.h"
 #include "InsertRecord.h"
+#include "ScalarIndex.h"
 #include <utility>
 #include <memory>
+#include <mutex>
 #include <string>
 #include <vector>
 #include <deque>
@@ -63,6 +65,15 @@ class SegmentGrowingImpl : public SegmentGrowing {
     Status
     Delete(int64_t reserverd_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) override;
 
+    void
+    GetDeletedRecords(int64_t size, int64_t* row_ids, Timestamp* timestamps) const override;
+
+    void
+    UpdateSchema(SchemaPtr schema) override;
+
+    std::unique_ptr<IdArray>
+    GetDeletedPrimaryKeys(int64_t size, Timestamp* timestamps) const override;
+
     int64_t
     GetMemoryUsageInBytes() const override;
 
@@ -126,7 +137,7 @@ class SegmentGrowingImpl : public SegmentGrowing {
 
     ssize_t
     get_deleted_count() const override {
-        return 0;
+        return deleted_record_.ack_responder_.GetAck();
     }
 
     int64_t
@@ -166,6 +177,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_delete(boost::dynamic_bitset<>& bitset, int64_t ins_barrier, Timestamp timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -185,6 +199,11 @@ class SegmentGrowingImpl : public SegmentGrowing {
     std::vector<SegOffset>
     search_ids(const boost::dynamic_bitset<>& view, Timestamp timestamp) const override;
 
+    std::vector<SegOffset>
+    search_ids_by_pk_order(const boost::dynamic_bitset<>& bitset,
+                           std::optional<int64_t> pk_lower_bound,
+                           int64_t limit) const override;
+
  protected:
     int64_t
     num_chunk() const override;
@@ -208,6 +227,8 @@ class SegmentGrowingImpl : public SegmentGrowing {
  private:
     SegcoreConfig segcore_config_;
     SchemaPtr schema_;
+    // the indexings refer to the field metas of the schemas replaced by UpdateSchema
+    std::vector<SchemaPtr> replaced_schemas_;
 
     InsertRecord record_;
     DeletedRecord deleted_record_;
@@ -216,6 +237,10 @@ class SegmentGrowingImpl : public SegmentGrowing {
 
     tbb::concurrent_unordered_multimap<idx_t, int64_t> uid2offset_;
 
+    // the rows sorted by primary key for limited retrieves, the rows inserted later are merged on demand
+    mutable std::mutex pk_order_mutex_;
+    mutable ScalarIndexVector pk_order_;
+
  private:
     bool enable_small_index_ = true;
 };
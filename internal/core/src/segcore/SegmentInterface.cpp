@@ -9,6 +9,9 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
+#include <numeric>
+
 #include "segcore/SegmentInterface.h"
 #include "query/generated/ExecPlanNodeVisitor.h"
 namespace milvus::segcore {
@@ -28,21 +31,26 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
     std::vector<int64_t> element_sizeofs;
     std::vector<aligned_vector<char>> blobs;
 
-    // fill row_ids
+    // fill row_ids, a string primary key takes its slot
     {
-        aligned_vector<char> blob(size * sizeof(int64_t));
         if (plan->schema_.get_is_auto_id()) {
+            aligned_vector<char> blob(size * sizeof(int64_t));
             bulk_subscript(SystemFieldType::RowId, results.internal_seg_offsets_.data(), size, blob.data());
+            blobs.emplace_back(std::move(blob));
+            element_sizeofs.push_back(sizeof(int64_t));
         } else {
             auto key_offset_opt = get_schema().get_primary_key_offset();
             AssertInfo(key_offset_opt.has_value(), "Cannot get primary key offset from schema");
             auto key_offset = key_offset_opt.value();
-            AssertInfo(get_schema()[key_offset].get_data_type() == DataType::INT64,
-                       "Primary key field is not INT64 type");
+            auto& key_meta = get_schema()[key_offset];
+            AssertInfo(key_meta.get_data_type() == DataType::INT64 || key_meta.is_string(),
+                       "Primary key field is neither INT64 nor STRING type");
+            auto key_sizeof = key_meta.get_sizeof();
+            aligned_vector<char> blob(size * key_sizeof);
             bulk_subscript(key_offset, results.internal_seg_offsets_.data(), size, blob.data());
+            blobs.emplace_back(std::move(blob));
+            element_sizeofs.push_back(key_sizeof);
         }
-        blobs.emplace_back(std::move(blob));
-        element_sizeofs.push_back(sizeof(int64_t));
     }
 
     // fill other entries
@@ -73,6 +81,18 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
     }
 }
 
+void
+SegmentInternalInterface::FillGroupByValues(const query::Plan* plan, SearchResult& results) const {
+    std::shared_lock lck(mutex_);
+    AssertInfo(plan, "empty plan");
+    auto& group_by_field_offset = plan->plan_node_->search_info_.group_by_field_offset_;
+    AssertInfo(group_by_field_offset.has_value(), "no group by field in plan");
+    auto size = results.internal_seg_offsets_.size();
+    results.group_by_values_.assign(size, 0);
+    bulk_subscript(group_by_field_offset.value(), results.internal_seg_offsets_.data(), size,
+                   results.group_by_values_.data());
+}
+
 SearchResult
 SegmentInternalInterface::Search(const query::Plan* plan,
                                  const query::PlaceholderGroup& placeholder_group,
@@ -147,7 +167,14 @@ CreateDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_
     data_array->set_field_id(field_meta.get_id().get());
     data_array->set_type(milvus::proto::schema::DataType(field_meta.get_data_type()));
 
-    if (!datatype_is_vector(data_type)) {
+    if (datatype_is_string(data_type)) {
+        auto element_sizeof = field_meta.get_sizeof();
+        auto data = reinterpret_cast<const char*>(data_raw);
+        auto obj = data_array->mutable_scalars()->mutable_string_data();
+        for (int64_t i = 0; i < count; ++i) {
+            obj->add_data(std::string(VarCharView(data + i * element_sizeof)));
+        }
+    } else if (!datatype_is_vector(data_type)) {
         auto scalar_array = CreateScalarArrayFrom(data_raw, count, data_type);
         data_array->set_allocated_scalars(scalar_array.release());
     } else {
@@ -201,6 +228,32 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
     auto retrieve_results = visitor.get_retrieve_result(*plan->plan_node_);
     retrieve_results.segment_ = (void*)this;
 
+    // keep the limit entities with the smallest string primary keys, ordered by primary key,
+    // the entities with int64 primary keys have been retrieved in order
+    auto limit = plan->plan_node_->limit_;
+    auto pk_offset = plan->schema_.get_primary_key_offset();
+    if (limit > 0 && pk_offset.has_value() && plan->schema_[pk_offset.value()].get_data_type() != DataType::INT64) {
+        auto& pk_meta = plan->schema_[pk_offset.value()];
+        AssertInfo(pk_meta.is_string(), "limited retrieve supports int64 or string primary key only");
+        auto& offsets = retrieve_results.result_offsets_;
+        auto count = static_cast<int64_t>(offsets.size());
+        auto pk_sizeof = pk_meta.get_sizeof();
+        aligned_vector<char> pks(count * pk_sizeof);
+        bulk_subscript(pk_offset.value(), offsets.data(), count, pks.data());
+        auto less = [&](int64_t lhs, int64_t rhs) {
+            return VarCharView(pks.data() + lhs * pk_sizeof) < VarCharView(pks.data() + rhs * pk_sizeof);
+        };
+        std::vector<int64_t> idx(count);
+        std::iota(idx.begin(), idx.end(), 0);
+        auto size = std::min(limit, count);
+        std::partial_sort(idx.begin(), idx.begin() + size, idx.end(), less);
+        std::vector<int64_t> limited(size);
+        for (int64_t i = 0; i < size; ++i) {
+            limited[i] = offsets[idx[i]];
+        }
+        offsets = std::move(limited);
+    }
+
     for (auto& seg_offset : retrieve_results.result_offsets_) {
         results->add_offset(seg_offset);
     }
@@ -214,6 +267,10 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
         auto col_data = col.release();
         fields_data->AddAllocated(col_data);
         if (pk_offset.has_value() && pk_offset.value() == field_offset) {
+            if (col_data->scalars().has_string_data()) {
+                ids->mutable_str_id()->mutable_data()->CopyFrom(col_data->scalars().string_data().data());
+                continue;
+            }
             auto int_ids = ids->mutable_int_id();
             for (int j = 0; j < col_data->scalars().long_data().data_size(); ++j) {
                 int_ids->add_data(col_data->scalars().long_data().data(j));
@@ -222,4 +279,36 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
     }
     return results;
 }
+
+void
+SegmentInternalInterface::check_appended_fields(const Schema& schema) const {
+    auto& current = get_schema();
+    AssertInfo(schema.size() >= current.size(), "fields can't be removed from the schema of a segment");
+    for (int64_t i = 0; i < schema.size(); ++i) {
+        auto& field_meta = schema[FieldOffset(i)];
+        if (i < current.size()) {
+            AssertInfo(field_meta.get_id() == current[FieldOffset(i)].get_id(),
+                       "fields of the schema are reordered, field id = " + std::to_string(field_meta.get_id().get()));
+            continue;
+        }
+        AssertInfo(!field_meta.is_vector(),
+                   "vector field can't be appended, field id = " + std::to_string(field_meta.get_id().get()));
+    }
+}
+
+Status
+SegmentInternalInterface::DeletePrimaryKeys(int64_t reserved_offset, const IdArray& pks, const Timestamp* timestamps) {
+    if (pks.has_int_id()) {
+        auto& data = pks.int_id().data();
+        return Delete(reserved_offset, data.size(), data.data(), timestamps);
+    }
+    AssertInfo(pks.has_str_id(), "primary keys are neither int64 nor string");
+    AssertInfo(has_string_primary_key(), "string primary keys are deleted from segment without string primary key");
+    auto& data = pks.str_id().data();
+    std::vector<idx_t> keys(data.size());
+    for (int64_t i = 0; i < data.size(); ++i) {
+        keys[i] = pk_dict_.intern(data[i]);
+    }
+    return Delete(reserved_offset, keys.size(), keys.data(), timestamps);
+}
 }  // namespace milvus::segcore
@@ -15,12 +15,15 @@
 #include "query/Plan.h"
 #include "common/Span.h"
 #include "FieldIndexing.h"
+#include "PkDictionary.h"
 #include <knowhere/index/vector_index/VecIndex.h>
 #include "common/SystemProperty.h"
+#include "utils/Status.h"
 #include "query/PlanNode.h"
 #include "pb/schema.pb.h"
 #include "pb/segcore.pb.h"
 #include <memory>
+#include <optional>
 #include <deque>
 #include <vector>
 #include <utility>
@@ -36,6 +39,10 @@ class SegmentInterface {
     virtual void
     FillTargetEntry(const query::Plan* plan, SearchResult& results) const = 0;
 
+    // fill the values of the group by field of the hits
+    virtual void
+    FillGroupByValues(const query::Plan* plan, SearchResult& results) const = 0;
+
     virtual SearchResult
     Search(const query::Plan* Plan, const query::PlaceholderGroup& placeholder_group, Timestamp timestamp) const = 0;
 
@@ -51,6 +58,20 @@ class SegmentInterface {
     virtual const Schema&
     get_schema() const = 0;
 
+    virtual int64_t
+    PreDelete(int64_t size) = 0;
+
+    virtual Status
+    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) = 0;
+
+    virtual ssize_t
+    get_deleted_count() const = 0;
+
+    // switch to a schema with scalar fields appended, the rows already in the segment
+    // get zero values of the new fields
+    virtual void
+    UpdateSchema(SchemaPtr schema) = 0;
+
     virtual ~SegmentInterface() = default;
 
  protected:
@@ -85,12 +106,27 @@ class SegmentInternalInterface : public SegmentInterface {
     void
     FillTargetEntry(const query::Plan* plan, SearchResult& results) const override;
 
+    void
+    FillGroupByValues(const query::Plan* plan, SearchResult& results) const override;
+
     std::unique_ptr<proto::segcore::RetrieveResults>
     Retrieve(const query::RetrievePlan* plan, Timestamp timestamp) const override;
 
+    // delete the entities of the int64 or string primary keys in pks
+    Status
+    DeletePrimaryKeys(int64_t reserved_offset, const IdArray& pks, const Timestamp* timestamps);
+
     virtual std::string
     debug() const = 0;
 
+    // string primary keys are mapped to the int64 keys of pk_dict_ inside the segment
+    bool
+    has_string_primary_key() const {
+        auto& schema = get_schema();
+        auto pk_offset = schema.get_primary_key_offset();
+        return pk_offset.has_value() && schema[pk_offset.value()].is_string();
+    }
+
  public:
     virtual void
     vector_search(int64_t vec_count,
@@ -108,6 +144,11 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const = 0;
 
+    // clear the bits of the rows deleted at or before timestamp,
+    // a row is deleted only if it was inserted before the delete
+    virtual void
+    mask_with_delete(boost::dynamic_bitset<>& bitset, int64_t ins_barrier, Timestamp timestamp) const = 0;
+
     // count of chunks
     virtual int64_t
     num_chunk() const = 0;
@@ -122,7 +163,18 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual std::vector<SegOffset>
     search_ids(const boost::dynamic_bitset<>& view, Timestamp timestamp) const = 0;
 
+    // offsets of at most limit entities whose bits are set, in the order of int64 primary key,
+    // the primary keys are larger than pk_lower_bound if it's set
+    virtual std::vector<SegOffset>
+    search_ids_by_pk_order(const boost::dynamic_bitset<>& bitset,
+                           std::optional<int64_t> pk_lower_bound,
+                           int64_t limit) const = 0;
+
  protected:
+    // check the new schema keeps the current fields in order and only appends scalar fields
+    void
+    check_appended_fields(const Schema& schema) const;
+
     // internal API: return chunk_data in span
     virtual SpanBase
     chunk_data_impl(FieldOffset field_offset, int64_t chunk_id) const = 0;
@@ -153,6 +205,7 @@ class SegmentInternalInterface : public SegmentInterface {
 
  protected:
     mutable std::shared_mutex mutex_;
+    PkDictionary pk_dict_;
 };
 
 }  // namespace milvus::segcore
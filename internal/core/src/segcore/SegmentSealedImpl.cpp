@@ -123,17 +123,36 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
         auto span = SpanBase(info.blob, info.row_count, element_sizeof);
         auto length_in_bytes = element_sizeof * info.row_count;
         aligned_vector<char> vec_data(length_in_bytes);
-        memcpy(vec_data.data(), info.blob, length_in_bytes);
+        if (field_meta.is_string()) {
+            // the blob holds length prefixed strings one after another, fill them into slots
+            auto src = reinterpret_cast<const char*>(info.blob);
+            for (int64_t i = 0; i < info.row_count; ++i) {
+                auto str = VarCharView(src);
+                AssertInfo(str.size() <= field_meta.get_max_length(), "string is longer than max length");
+                FillVarChar(str, vec_data.data() + i * element_sizeof, element_sizeof);
+                src += sizeof(uint32_t) + str.size();
+            }
+        } else {
+            memcpy(vec_data.data(), info.blob, length_in_bytes);
+        }
 
-        // generate scalar index
+        // generate scalar index, string fields are scanned without index
         std::unique_ptr<knowhere::Index> index;
-        if (!field_meta.is_vector()) {
+        if (!field_meta.is_vector() && !field_meta.is_string()) {
             index = query::generate_scalar_index(span, field_meta.get_data_type());
         }
 
         std::unique_ptr<ScalarIndexBase> pk_index_;
         if (schema_->get_primary_key_offset() == field_offset) {
-            pk_index_ = create_index((const int64_t*)vec_data.data(), info.row_count);
+            if (field_meta.is_string()) {
+                std::vector<idx_t> keys(info.row_count);
+                for (int64_t i = 0; i < info.row_count; ++i) {
+                    keys[i] = pk_dict_.intern(VarCharView(vec_data.data() + i * element_sizeof));
+                }
+                pk_index_ = create_index(keys.data(), info.row_count);
+            } else {
+                pk_index_ = create_index((const int64_t*)vec_data.data(), info.row_count);
+            }
         }
 
         // write data under lock
@@ -417,7 +436,8 @@ SegmentSealedImpl::bulk_subscript(FieldOffset field_offset,
         }
 
         case DataType::VECTOR_FLOAT:
-        case DataType::VECTOR_BINARY: {
+        case DataType::VECTOR_BINARY:
+        case DataType::STRING: {
             bulk_subscript_impl(field_meta.get_sizeof(), src_vec, seg_offsets, count, output);
             break;
         }
@@ -467,6 +487,14 @@ SegmentSealedImpl::search_ids(const boost::dynamic_bitset<>& bitset, Timestamp t
     return std::move(dst_offset);
 }
 
+std::vector<SegOffset>
+SegmentSealedImpl::search_ids_by_pk_order(const boost::dynamic_bitset<>& bitset,
+                                          std::optional<int64_t> pk_lower_bound,
+                                          int64_t limit) const {
+    AssertInfo(primary_key_index_, "Primary key index is null");
+    return primary_key_index_->search_offsets_in_order(bitset, pk_lower_bound, limit);
+}
+
 std::string
 SegmentSealedImpl::debug() const {
     std::string log_str;
@@ -514,6 +542,80 @@ SegmentSealedImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, T
     bitset_chunk &= mask;
 }
 
+void
+SegmentSealedImpl::mask_with_delete(boost::dynamic_bitset<>& bitset, int64_t ins_barrier, Timestamp timestamp) const {
+    deleted_record_.for_each_latest_delete(timestamp, [&](idx_t uid, Timestamp del_timestamp) {
+        AssertInfo(primary_key_index_, "Primary key index is null");
+        if (bitset.empty()) {
+            bitset.resize(ins_barrier, true);
+        }
+        for (auto seg_offset : primary_key_index_->search_offsets(uid)) {
+            auto offset = seg_offset.get();
+            // the rows inserted after the delete, e.g. by upsert, are kept
+            if (offset < ins_barrier && timestamps_[offset] < del_timestamp) {
+                bitset[offset] = false;
+            }
+        }
+    });
+}
+
+int64_t
+SegmentSealedImpl::PreDelete(int64_t size) {
+    auto reserved_begin = deleted_record_.reserved.fetch_add(size);
+    return reserved_begin;
+}
+
+Status
+SegmentSealedImpl::Delete(int64_t reserved_begin,
+                          int64_t size,
+                          const int64_t* uids_raw,
+                          const Timestamp* timestamps_raw) {
+    deleted_record_.timestamps_.set_data(reserved_begin, timestamps_raw, size);
+    deleted_record_.uids_.set_data(reserved_begin, uids_raw, size);
+    deleted_record_.ack_responder_.AddSegment(reserved_begin, reserved_begin + size);
+    return Status::OK();
+}
+
+ssize_t
+SegmentSealedImpl::get_deleted_count() const {
+    return deleted_record_.ack_responder_.GetAck();
+}
+
+void
+SegmentSealedImpl::UpdateSchema(SchemaPtr schema) {
+    std::unique_lock lck(mutex_);
+    if (schema == schema_) {
+        return;
+    }
+    check_appended_fields(*schema);
+    auto old_size = schema_->size();
+    auto new_size = schema->size();
+    fields_data_.resize(new_size);
+    scalar_indexings_.resize(new_size);
+    field_data_ready_bitset_.resize(new_size);
+    vecindex_ready_bitset_.resize(new_size);
+    schema_ = std::move(schema);
+
+    // the appended fields are loaded with the other fields if the segment is not loaded yet
+    if (!row_count_opt_.has_value()) {
+        return;
+    }
+    auto row_count = row_count_opt_.value();
+    for (auto i = old_size; i < new_size; ++i) {
+        auto field_offset = FieldOffset(i);
+        auto& field_meta = (*schema_)[field_offset];
+        auto element_sizeof = field_meta.get_sizeof();
+        aligned_vector<char> vec_data(element_sizeof * row_count);
+        // string fields are scanned without index
+        if (!field_meta.is_string()) {
+            auto span = SpanBase(vec_data.data(), row_count, element_sizeof);
+            scalar_indexings_[i] = query::generate_scalar_index(span, field_meta.get_data_type());
+        }
+        fields_data_[i] = std::move(vec_data);
+        set_bit(field_data_ready_bitset_, field_offset, true);
+    }
+}
+
 SegmentSealedPtr
 CreateSealedSegment(SchemaPtr schema) {
     return std::make_unique<SegmentSealedImpl>(schema);
@@ -14,6 +14,7 @@
 #include "segcore/SegmentSealed.h"
 #include "SealedIndexingRecord.h"
 #include "ScalarIndex.h"
+#include "segcore/DeletedRecord.h"
 #include <deque>
 #include <map>
 #include <vector>
@@ -50,6 +51,18 @@ class SegmentSealedImpl : public SegmentSealed {
     const Schema&
     get_schema() const override;
 
+    int64_t
+    PreDelete(int64_t size) override;
+
+    Status
+    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) override;
+
+    ssize_t
+    get_deleted_count() const override;
+
+    void
+    UpdateSchema(SchemaPtr schema) override;
+
  public:
     int64_t
     num_chunk_index(FieldOffset field_offset) const override;
@@ -109,6 +122,9 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_delete(boost::dynamic_bitset<>& bitset, int64_t ins_barrier, Timestamp timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -129,6 +145,11 @@ class SegmentSealedImpl : public SegmentSealed {
     std::vector<SegOffset>
     search_ids(const boost::dynamic_bitset<>& view, Timestamp timestamp) const override;
 
+    std::vector<SegOffset>
+    search_ids_by_pk_order(const boost::dynamic_bitset<>& bitset,
+                           std::optional<int64_t> pk_lower_bound,
+                           int64_t limit) const override;
+
     //    virtual void
     //    build_index_if_primary_key(FieldId field_id);
 
@@ -153,6 +174,7 @@ class SegmentSealedImpl : public SegmentSealed {
     aligned_vector<idx_t> row_ids_;
     aligned_vector<Timestamp> timestamps_;
     TimestampIndex timestamp_index_;
+    DeletedRecord deleted_record_;
     SchemaPtr schema_;
 };
 }  // namespace milvus::segcore
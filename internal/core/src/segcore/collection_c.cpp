@@ -12,6 +12,7 @@
 #include <iostream>
 #include "segcore/collection_c.h"
 #include "segcore/Collection.h"
+#include "common/CGoHelper.h"
 
 CCollection
 NewCollection(const char* schema_proto_blob) {
@@ -31,3 +32,14 @@ GetCollectionName(CCollection collection) {
     auto col = (milvus::segcore::Collection*)collection;
     return strdup(col->get_collection_name().data());
 }
+
+CStatus
+UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob) {
+    try {
+        auto col = (milvus::segcore::Collection*)collection;
+        col->update_schema(std::string(schema_proto_blob));
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
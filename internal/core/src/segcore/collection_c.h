@@ -15,6 +15,8 @@
 extern "C" {
 #endif
 
+#include "common/type_c.h"
+
 typedef void* CCollection;
 
 CCollection
@@ -26,6 +28,10 @@ DeleteCollection(CCollection collection);
 const char*
 GetCollectionName(CCollection collection);
 
+// replace the schema with one having scalar fields appended, the segments switch to it by UpdateSegmentSchema
+CStatus
+UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob);
+
 #ifdef __cplusplus
 }
 #endif
@@ -9,6 +9,8 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <limits>
+#include <unordered_map>
 #include <vector>
 #include <exceptions/EasyAssert.h>
 
@@ -52,9 +54,52 @@ DeleteMarshaledHits(CMarshaledHits c_marshaled_hits) {
     delete hits;
 }
 
+// distances of metrics other than IP have been negated in Search, so that a larger distance is always closer
+bool
+InSearchRange(const milvus::query::SearchInfo& search_info, float distance) {
+    auto& range = search_info.range_search_info_.value();
+    if (search_info.metric_type_ == milvus::MetricType::METRIC_INNER_PRODUCT) {
+        return distance > range.radius_ && distance <= range.range_filter_;
+    }
+    return -distance < range.radius_ && -distance >= range.range_filter_;
+}
+
+// whether the hit is not farther than radius, hits closer than range_filter included
+bool
+WithinRadius(const milvus::query::SearchInfo& search_info, float distance) {
+    auto& range = search_info.range_search_info_.value();
+    if (search_info.metric_type_ == milvus::MetricType::METRIC_INNER_PRODUCT) {
+        return distance > range.radius_;
+    }
+    return -distance < range.radius_;
+}
+
+// topk of a range search is the max results configured in proxy, every segment returns all its hits in range
+// unless they are more than topk, the hits beyond topk are unknown, so the result is cut if a segment is filled up
+// with hits within radius, or if more than topk hits of the segments are in the search range
+void
+CheckRangeSearchResult(const std::vector<SearchResultPair>& result_pairs,
+                       const milvus::query::SearchInfo& search_info,
+                       int64_t query_offset,
+                       int64_t topk) {
+    for (auto& result_pair : result_pairs) {
+        auto search_result = result_pair.search_result_;
+        auto last = query_offset + topk - 1;
+        auto segment_cut = search_result->internal_seg_offsets_[last] != -1 &&
+                           WithinRadius(search_info, search_result->result_distances_[last]);
+        auto offset = result_pair.offset_;
+        auto reduce_cut = offset < query_offset + topk && search_result->internal_seg_offsets_[offset] != -1 &&
+                          InSearchRange(search_info, search_result->result_distances_[offset]);
+        AssertInfo(!segment_cut && !reduce_cut,
+                   "range search hits more than " + std::to_string(topk) +
+                       " results, reduce radius or increase proxy.rangeSearchMaxResults");
+    }
+}
+
 void
 GetResultData(std::vector<std::vector<int64_t>>& search_records,
               std::vector<SearchResult*>& search_results,
+              const milvus::query::SearchInfo& search_info,
               int64_t query_idx,
               int64_t topk) {
     auto num_segments = search_results.size();
@@ -69,13 +114,49 @@ GetResultData(std::vector<std::vector<int64_t>>& search_records,
     }
     int64_t loc_offset = query_offset;
     AssertInfo(topk > 0, "topk must greater than 0");
-    for (int i = 0; i < topk; ++i) {
-        result_pairs[0].reset_distance();
+    auto query_end = query_offset + topk;
+    auto next = [&](SearchResultPair& result_pair) {
+        result_pair.offset_++;
+        // a segment whose hits are used up is sorted behind the others
+        result_pair.distance_ = result_pair.offset_ < query_end
+                                    ? result_pair.search_result_->result_distances_[result_pair.offset_]
+                                    : std::numeric_limits<float>::lowest();
+    };
+    // hits out of the search range, or of the groups already full, are skipped without taking the place of others,
+    // the places left are filled with invalid hits
+    std::unordered_map<int64_t, int64_t> group_sizes;
+    int64_t kept = 0;
+    while (kept < topk) {
         std::sort(result_pairs.begin(), result_pairs.end(), std::greater<>());
         auto& result_pair = result_pairs[0];
+        if (result_pair.offset_ >= query_end) {
+            break;
+        }
+        if (search_info.range_search_info_.has_value() && !InSearchRange(search_info, result_pair.distance_)) {
+            next(result_pair);
+            continue;
+        }
+        if (search_info.group_by_field_offset_.has_value()) {
+            auto group_value = result_pair.search_result_->group_by_values_[result_pair.offset_];
+            if (group_sizes[group_value] >= search_info.group_size_) {
+                next(result_pair);
+                continue;
+            }
+            group_sizes[group_value]++;
+        }
         auto index = result_pair.index_;
         result_pair.search_result_->result_offsets_.push_back(loc_offset++);
-        search_records[index].push_back(result_pair.offset_++);
+        search_records[index].push_back(result_pair.offset_);
+        next(result_pair);
+        ++kept;
+    }
+    if (search_info.range_search_info_.has_value()) {
+        CheckRangeSearchResult(result_pairs, search_info, query_offset, topk);
+    }
+    for (; kept < topk; ++kept) {
+        auto& result_pair = result_pairs[0];
+        result_pair.search_result_->result_offsets_.push_back(loc_offset++);
+        search_records[result_pair.index_].push_back(-1);
     }
 }
 
@@ -95,6 +176,12 @@ ResetSearchResult(std::vector<std::vector<int64_t>>& search_records, std::vector
 
         for (int j = 0; j < search_records[i].size(); j++) {
             auto& offset = search_records[i][j];
+            if (offset == -1) {
+                // invalid hit, whose id is filled with -1
+                result_distances.push_back(0);
+                internal_seg_offsets.push_back(-1);
+                continue;
+            }
             auto distance = search_result->result_distances_[offset];
             auto internal_seg_offset = search_result->internal_seg_offsets_[offset];
             result_distances.push_back(distance);
@@ -118,8 +205,15 @@ ReduceSearchResultsAndFillData(CSearchPlan c_plan, CSearchResult* c_search_resul
         auto num_queries = search_results[0]->num_queries_;
         std::vector<std::vector<int64_t>> search_records(num_segments);
 
+        if (plan->plan_node_->search_info_.group_by_field_offset_.has_value()) {
+            for (auto search_result : search_results) {
+                auto segment = (milvus::segcore::SegmentInterface*)(search_result->segment_);
+                segment->FillGroupByValues(plan, *search_result);
+            }
+        }
+
         for (int i = 0; i < num_queries; ++i) {
-            GetResultData(search_records, search_results, i, topk);
+            GetResultData(search_records, search_results, plan->plan_node_->search_info_, i, topk);
         }
         ResetSearchResult(search_records, search_results);
 
@@ -179,6 +273,10 @@ ReorganizeSearchResults(CMarshaledHits* c_marshaled_hits, CSearchResult* c_searc
         hits_per_group.hits_.resize(num_queries);
         hits_per_group.blob_length_.resize(num_queries);
         std::vector<milvus::proto::milvus::Hits> hits(num_queries);
+        // a string primary key is left in the row data, it doesn't fit in the int64 ids
+        auto& schema = ((milvus::segcore::SegmentInterface*)(sr->segment_))->get_schema();
+        auto pk_offset = schema.get_primary_key_offset();
+        auto has_int_ids = !pk_offset.has_value() || !schema[pk_offset.value()].is_string();
 #pragma omp parallel for
         for (int m = 0; m < num_queries; m++) {
             for (int n = 0; n < topk; n++) {
@@ -186,7 +284,9 @@ ReorganizeSearchResults(CMarshaledHits* c_marshaled_hits, CSearchResult* c_searc
                 hits[m].add_scores(result_distances[result_offset]);
                 auto& row_data = row_datas[result_offset];
                 hits[m].add_row_data(row_data.data(), row_data.size());
-                hits[m].add_ids(*(int64_t*)row_data.data());
+                if (has_int_ids) {
+                    hits[m].add_ids(*(int64_t*)row_data.data());
+                }
             }
         }
 
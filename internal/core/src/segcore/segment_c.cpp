@@ -102,14 +102,67 @@ GetRowCount(CSegmentInterface c_segment) {
     return row_count;
 }
 
-// TODO: segmentInterface implement get_deleted_count()
 int64_t
 GetDeletedCount(CSegmentInterface c_segment) {
-    auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
+    auto segment = (milvus::segcore::SegmentInterface*)c_segment;
     auto deleted_count = segment->get_deleted_count();
     return deleted_count;
 }
 
+CStatus
+Delete(CSegmentInterface c_segment,
+       int64_t reserved_offset,
+       int64_t size,
+       const int64_t* row_ids,
+       const uint64_t* timestamps) {
+    auto segment = (milvus::segcore::SegmentInterface*)c_segment;
+
+    try {
+        auto res = segment->Delete(reserved_offset, size, row_ids, timestamps);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
+CStatus
+DeleteByIds(CSegmentInterface c_segment,
+            int64_t reserved_offset,
+            const void* ids_blob,
+            int64_t ids_blob_size,
+            const uint64_t* timestamps) {
+    auto segment = (milvus::segcore::SegmentInternalInterface*)c_segment;
+
+    try {
+        milvus::proto::schema::IDs pks;
+        auto suc = pks.ParseFromArray(ids_blob, ids_blob_size);
+        AssertInfo(suc, "unmarshal primary keys failed");
+        auto res = segment->DeletePrimaryKeys(reserved_offset, pks, timestamps);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
+int64_t
+PreDelete(CSegmentInterface c_segment, int64_t size) {
+    auto segment = (milvus::segcore::SegmentInterface*)c_segment;
+
+    return segment->PreDelete(size);
+}
+
+CStatus
+UpdateSegmentSchema(CSegmentInterface c_segment, CCollection c_collection) {
+    try {
+        auto segment = (milvus::segcore::SegmentInterface*)c_segment;
+        auto col = (milvus::segcore::Collection*)c_collection;
+        segment->UpdateSchema(col->get_schema());
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 //////////////////////////////    interfaces for growing segment    //////////////////////////////
 CStatus
 Insert(CSegmentInterface c_segment,
@@ -146,26 +199,25 @@ PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset) {
 }
 
 CStatus
-Delete(CSegmentInterface c_segment,
-       int64_t reserved_offset,
-       int64_t size,
-       const int64_t* row_ids,
-       const uint64_t* timestamps) {
-    auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
-
+GetDeletedRecords(CSegmentInterface c_segment, int64_t size, int64_t* row_ids, uint64_t* timestamps) {
     try {
-        auto res = segment->Delete(reserved_offset, size, row_ids, timestamps);
+        auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
+        segment->GetDeletedRecords(size, row_ids, timestamps);
         return milvus::SuccessCStatus();
     } catch (std::exception& e) {
         return milvus::FailureCStatus(UnexpectedError, e.what());
     }
 }
 
-int64_t
-PreDelete(CSegmentInterface c_segment, int64_t size) {
-    auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
-
-    return segment->PreDelete(size);
+CProtoResult
+GetDeletedIds(CSegmentInterface c_segment, int64_t size, uint64_t* timestamps) {
+    try {
+        auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
+        auto pks = segment->GetDeletedPrimaryKeys(size, timestamps);
+        return milvus::AllocCProtoResult(*pks);
+    } catch (std::exception& e) {
+        return CProtoResult{milvus::FailureCStatus(UnexpectedError, e.what())};
+    }
 }
 
 //////////////////////////////    interfaces for sealed segment    //////////////////////////////
@@ -56,6 +56,28 @@ GetRowCount(CSegmentInterface c_segment);
 int64_t
 GetDeletedCount(CSegmentInterface c_segment);
 
+CStatus
+Delete(CSegmentInterface c_segment,
+       int64_t reserved_offset,
+       int64_t size,
+       const int64_t* row_ids,
+       const uint64_t* timestamps);
+
+// ids_blob is a serialized schema.IDs, of int64 or string primary keys
+CStatus
+DeleteByIds(CSegmentInterface c_segment,
+            int64_t reserved_offset,
+            const void* ids_blob,
+            int64_t ids_blob_size,
+            const uint64_t* timestamps);
+
+int64_t
+PreDelete(CSegmentInterface c_segment, int64_t size);
+
+// switch the segment to the current schema of the collection, which may have scalar fields appended
+CStatus
+UpdateSegmentSchema(CSegmentInterface c_segment, CCollection c_collection);
+
 //////////////////////////////    interfaces for growing segment    //////////////////////////////
 CStatus
 Insert(CSegmentInterface c_segment,
@@ -71,14 +93,11 @@ CStatus
 PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset);
 
 CStatus
-Delete(CSegmentInterface c_segment,
-       int64_t reserved_offset,
-       int64_t size,
-       const int64_t* row_ids,
-       const uint64_t* timestamps);
+GetDeletedRecords(CSegmentInterface c_segment, int64_t size, int64_t* row_ids, uint64_t* timestamps);
 
-int64_t
-PreDelete(CSegmentInterface c_segment, int64_t size);
+// returns the primary keys of the first size deletes as a serialized schema.IDs
+CProtoResult
+GetDeletedIds(CSegmentInterface c_segment, int64_t size, uint64_t* timestamps);
 
 //////////////////////////////    interfaces for sealed segment    //////////////////////////////
 CStatus
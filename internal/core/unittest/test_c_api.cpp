@@ -12,6 +12,7 @@
 #include <iostream>
 #include <string>
 #include <random>
+#include <set>
 #include <gtest/gtest.h>
 #include <chrono>
 #include <google/protobuf/text_format.h>
@@ -26,6 +27,7 @@
 #include <common/LoadInfo.h>
 #include <utils/Types.h>
 #include <segcore/Collection.h>
+#include <segcore/SegmentGrowingImpl.h>
 #include <pb/plan.pb.h>
 #include "test_utils/DataGen.h"
 
@@ -378,9 +380,35 @@ TEST(CApiTest, GetDeletedCountTest) {
     auto del_res = Delete(segment, offset, 3, delete_row_ids, delete_timestamps);
     assert(del_res.error_code == Success);
 
-    // TODO: assert(deleted_count == len(delete_row_ids))
     auto deleted_count = GetDeletedCount(segment);
-    assert(deleted_count == 0);
+    assert(deleted_count == 3);
+
+    DeleteCollection(collection);
+    DeleteSegment(segment);
+}
+
+TEST(CApiTest, GetDeletedRecordsTest) {
+    auto collection = NewCollection(get_default_schema_config());
+    auto segment = NewSegment(collection, 0, Growing);
+
+    long delete_row_ids[] = {100000, 100001, 100002};
+    unsigned long delete_timestamps[] = {3, 2, 1};
+
+    auto offset = PreDelete(segment, 3);
+
+    auto del_res = Delete(segment, offset, 3, delete_row_ids, delete_timestamps);
+    assert(del_res.error_code == Success);
+
+    // deletes are kept in timestamp order
+    std::vector<int64_t> row_ids(3);
+    std::vector<uint64_t> timestamps(3);
+    auto res = GetDeletedRecords(segment, 3, row_ids.data(), timestamps.data());
+    assert(res.error_code == Success);
+    ASSERT_EQ(row_ids, std::vector<int64_t>({100002, 100001, 100000}));
+    ASSERT_EQ(timestamps, std::vector<uint64_t>({1, 2, 3}));
+
+    res = GetDeletedRecords(segment, 4, row_ids.data(), timestamps.data());
+    ASSERT_NE(res.error_code, Success);
 
     DeleteCollection(collection);
     DeleteSegment(segment);
@@ -406,6 +434,66 @@ TEST(CApiTest, GetRowCountTest) {
     DeleteSegment(segment);
 }
 
+TEST(CApiTest, UpdateSchemaTest) {
+    auto collection = NewCollection(get_default_schema_config());
+    auto segment = NewSegment(collection, 0, Growing);
+
+    int N = 1000;
+    auto [raw_data, timestamps, uids] = generate_data(N);
+    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
+
+    int64_t offset;
+    PreInsert(segment, N, &offset);
+    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    ASSERT_EQ(res.error_code, Success);
+
+    std::string new_conf = std::string(get_default_schema_config()) + R"(
+                                fields: <
+                                  fieldID: 102
+                                  name: "weight"
+                                  data_type: Int64
+                                >)";
+    res = UpdateCollectionSchema(collection, new_conf.c_str());
+    ASSERT_EQ(res.error_code, Success);
+    res = UpdateSegmentSchema(segment, collection);
+    ASSERT_EQ(res.error_code, Success);
+    auto growing = dynamic_cast<segcore::SegmentGrowingImpl*>((segcore::SegmentInterface*)segment);
+    ASSERT_NE(growing, nullptr);
+    ASSERT_EQ(growing->get_schema().size(), 3);
+
+    // rows of the old layout are padded, rows of the new layout keep the appended field
+    PreInsert(segment, N, &offset);
+    res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    ASSERT_EQ(res.error_code, Success);
+    std::vector<char> new_raw_data;
+    for (int i = 0; i < N; ++i) {
+        auto row = raw_data.data() + i * line_sizeof;
+        new_raw_data.insert(new_raw_data.end(), row, row + line_sizeof);
+        int64_t weight = i + 1;
+        new_raw_data.insert(new_raw_data.end(), (const char*)&weight, ((const char*)&weight) + sizeof(weight));
+    }
+    PreInsert(segment, N, &offset);
+    res = Insert(segment, offset, N, uids.data(), timestamps.data(), new_raw_data.data(),
+                 (int)(line_sizeof + sizeof(int64_t)), N);
+    ASSERT_EQ(res.error_code, Success);
+    ASSERT_EQ(GetRowCount(segment), 3 * N);
+
+    auto weights = growing->get_insert_record().get_field_data<int64_t>(FieldOffset(2));
+    for (int i = 0; i < 2 * N; ++i) {
+        ASSERT_EQ((*weights)[i], 0);
+    }
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ((*weights)[2 * N + i], i + 1);
+    }
+
+    // fields can't be removed
+    res = UpdateCollectionSchema(collection, get_default_schema_config());
+    ASSERT_NE(res.error_code, Success);
+
+    DeleteCollection(collection);
+    DeleteSegment(segment);
+}
+
 // TEST(CApiTest, SchemaTest) {
 //    std::string schema_string =
 //        "id: 6873737669791618215\nname: \"collection0\"\nschema: \u003c\n  "
@@ -607,6 +695,264 @@ TEST(CApiTest, ReduceSearchWithExpr) {
     DeleteSegment(segment);
 }
 
+TEST(CApiTest, ReduceRangeSearch) {
+    auto collection = NewCollection(get_default_schema_config());
+    auto segment = NewSegment(collection, 0, Growing);
+
+    int N = 10000;
+    auto [raw_data, timestamps, uids] = generate_data(N);
+    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
+
+    int64_t offset;
+    PreInsert(segment, N, &offset);
+    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    assert(ins_res.error_code == Success);
+
+    int num_queries = 10;
+    auto blob = generate_query_data(num_queries);
+
+    auto range_search = [&](const char* serialized_expr_plan) {
+        void* plan = nullptr;
+        auto binary_plan = translate_text_plan_to_binary_plan(serialized_expr_plan);
+        auto status = CreateSearchPlanByExpr(collection, binary_plan.data(), binary_plan.size(), &plan);
+        assert(status.error_code == Success);
+
+        void* placeholderGroup = nullptr;
+        status = ParsePlaceholderGroup(plan, blob.data(), blob.length(), &placeholderGroup);
+        assert(status.error_code == Success);
+
+        CSearchResult res1;
+        CSearchResult res2;
+        auto res = Search(segment, plan, placeholderGroup, 1, &res1);
+        assert(res.error_code == Success);
+        res = Search(segment, plan, placeholderGroup, 1, &res2);
+        assert(res.error_code == Success);
+        std::vector<CSearchResult> results{res1, res2};
+
+        status = ReduceSearchResultsAndFillData(plan, results.data(), results.size());
+        DeleteSearchPlan(plan);
+        DeletePlaceholderGroup(placeholderGroup);
+        DeleteSearchResult(res1);
+        DeleteSearchResult(res2);
+        return status.error_code;
+    };
+
+    // every row is within radius, hits beyond topk would be cut
+    const char* wide_plan = R"(vector_anns: <
+                                   field_id: 100
+                                   query_info: <
+                                       topk: 10
+                                       metric_type: "L2"
+                                       search_params: "{\"nprobe\": 10}"
+                                       range_search_info: <
+                                           radius: 1e+10
+                                           range_filter: 0
+                                       >
+                                   >
+                                   placeholder_tag: "$0"
+                                >)";
+    ASSERT_NE(range_search(wide_plan), Success);
+
+    // no row is within radius
+    const char* narrow_plan = R"(vector_anns: <
+                                     field_id: 100
+                                     query_info: <
+                                         topk: 10
+                                         metric_type: "L2"
+                                         search_params: "{\"nprobe\": 10}"
+                                         range_search_info: <
+                                             radius: 1e-10
+                                             range_filter: 0
+                                         >
+                                     >
+                                     placeholder_tag: "$0"
+                                  >)";
+    ASSERT_EQ(range_search(narrow_plan), Success);
+
+    DeleteCollection(collection);
+    DeleteSegment(segment);
+}
+
+TEST(CApiTest, ReduceGroupBySearch) {
+    auto schema_string = generate_collection_schema("L2", DIM, false);
+    auto collection = NewCollection(schema_string.c_str());
+    auto segment = NewSegment(collection, 0, Growing);
+
+    // the rows of group 0 are the nearest neighbours of the query, the others are far away in their own groups
+    int N = 1000;
+    int num_dominant = 100;
+    std::vector<char> raw_data;
+    std::vector<uint64_t> timestamps(N, 0);
+    std::vector<int64_t> uids;
+    std::default_random_engine e(42);
+    std::normal_distribution<> dis(0.0, 1.0);
+    for (int i = 0; i < N; ++i) {
+        uids.push_back(i);
+        float vec[DIM];
+        for (auto& x : vec) {
+            x = i < num_dominant ? dis(e) * 0.001 : dis(e) + 10;
+        }
+        int64_t counter = i < num_dominant ? 0 : i;
+        double double_field = 0;
+        raw_data.insert(raw_data.end(), (const char*)std::begin(vec), (const char*)std::end(vec));
+        raw_data.insert(raw_data.end(), (const char*)&counter, ((const char*)&counter) + sizeof(counter));
+        raw_data.insert(raw_data.end(), (const char*)&double_field, ((const char*)&double_field) + sizeof(double_field));
+    }
+    auto line_sizeof = sizeof(float) * DIM + sizeof(int64_t) + sizeof(double);
+
+    int64_t offset;
+    PreInsert(segment, N, &offset);
+    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    assert(ins_res.error_code == Success);
+
+    namespace ser = milvus::proto::milvus;
+    ser::PlaceholderGroup raw_group;
+    auto value = raw_group.add_placeholders();
+    value->set_tag("$0");
+    value->set_type(ser::PlaceholderType::FloatVector);
+    std::vector<float> query(DIM, 0);
+    value->add_values(query.data(), query.size() * sizeof(float));
+    auto blob = raw_group.SerializeAsString();
+
+    const char* serialized_expr_plan = R"(vector_anns: <
+                                            field_id: 100
+                                            query_info: <
+                                                topk: 10
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                                group_by_field_id: 101
+                                                group_size: 1
+                                            >
+                                            placeholder_tag: "$0"
+                                         >)";
+    void* plan = nullptr;
+    auto binary_plan = translate_text_plan_to_binary_plan(serialized_expr_plan);
+    auto status = CreateSearchPlanByExpr(collection, binary_plan.data(), binary_plan.size(), &plan);
+    assert(status.error_code == Success);
+
+    void* placeholderGroup = nullptr;
+    status = ParsePlaceholderGroup(plan, blob.data(), blob.length(), &placeholderGroup);
+    assert(status.error_code == Success);
+
+    CSearchResult res1;
+    CSearchResult res2;
+    auto res = Search(segment, plan, placeholderGroup, 1, &res1);
+    assert(res.error_code == Success);
+    res = Search(segment, plan, placeholderGroup, 1, &res2);
+    assert(res.error_code == Success);
+    std::vector<CSearchResult> results{res1, res2};
+
+    status = ReduceSearchResultsAndFillData(plan, results.data(), results.size());
+    ASSERT_EQ(status.error_code, Success);
+
+    // topk hits of distinct groups are found behind the dominant group, one hit of which is kept
+    int64_t num_hits = 0;
+    int64_t num_dominant_hits = 0;
+    std::set<int64_t> hit_groups;
+    for (auto result : results) {
+        auto search_result = (SearchResult*)result;
+        for (auto seg_offset : search_result->internal_seg_offsets_) {
+            ASSERT_NE(seg_offset, -1);
+            ++num_hits;
+            if (seg_offset < num_dominant) {
+                ++num_dominant_hits;
+            } else {
+                hit_groups.insert(seg_offset);
+            }
+        }
+    }
+    ASSERT_EQ(num_hits, 10);
+    ASSERT_EQ(num_dominant_hits, 1);
+    ASSERT_EQ(hit_groups.size(), 9);
+
+    DeleteSearchPlan(plan);
+    DeletePlaceholderGroup(placeholderGroup);
+    DeleteSearchResult(res1);
+    DeleteSearchResult(res2);
+    DeleteCollection(collection);
+    DeleteSegment(segment);
+}
+
+TEST(CApiTest, GroupBySearchPartialGroups) {
+    auto schema_string = generate_collection_schema("L2", DIM, false);
+    auto collection = NewCollection(schema_string.c_str());
+    auto segment = NewSegment(collection, 0, Growing);
+
+    // the group 0 has more nearest neighbours of the query than the max fetch size, the other groups are behind it
+    int N = 20000;
+    int num_dominant = 17000;
+    std::vector<char> raw_data;
+    std::vector<uint64_t> timestamps(N, 0);
+    std::vector<int64_t> uids;
+    std::default_random_engine e(42);
+    std::normal_distribution<> dis(0.0, 1.0);
+    for (int i = 0; i < N; ++i) {
+        uids.push_back(i);
+        float vec[DIM];
+        for (auto& x : vec) {
+            x = i < num_dominant ? dis(e) * 0.001 : dis(e) + 10;
+        }
+        int64_t counter = i < num_dominant ? 0 : i;
+        double double_field = 0;
+        raw_data.insert(raw_data.end(), (const char*)std::begin(vec), (const char*)std::end(vec));
+        raw_data.insert(raw_data.end(), (const char*)&counter, ((const char*)&counter) + sizeof(counter));
+        raw_data.insert(raw_data.end(), (const char*)&double_field, ((const char*)&double_field) + sizeof(double_field));
+    }
+    auto line_sizeof = sizeof(float) * DIM + sizeof(int64_t) + sizeof(double);
+
+    int64_t offset;
+    PreInsert(segment, N, &offset);
+    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    assert(ins_res.error_code == Success);
+
+    namespace ser = milvus::proto::milvus;
+    ser::PlaceholderGroup raw_group;
+    auto value = raw_group.add_placeholders();
+    value->set_tag("$0");
+    value->set_type(ser::PlaceholderType::FloatVector);
+    std::vector<float> query(DIM, 0);
+    value->add_values(query.data(), query.size() * sizeof(float));
+    auto blob = raw_group.SerializeAsString();
+
+    const char* serialized_expr_plan = R"(vector_anns: <
+                                            field_id: 100
+                                            query_info: <
+                                                topk: 10
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                                group_by_field_id: 101
+                                                group_size: 1
+                                            >
+                                            placeholder_tag: "$0"
+                                         >)";
+    void* plan = nullptr;
+    auto binary_plan = translate_text_plan_to_binary_plan(serialized_expr_plan);
+    auto status = CreateSearchPlanByExpr(collection, binary_plan.data(), binary_plan.size(), &plan);
+    assert(status.error_code == Success);
+
+    void* placeholderGroup = nullptr;
+    status = ParsePlaceholderGroup(plan, blob.data(), blob.length(), &placeholderGroup);
+    assert(status.error_code == Success);
+
+    // the fetch stops at the max size, only the hit of the group 0 is returned
+    CSearchResult search_result;
+    auto res = Search(segment, plan, placeholderGroup, 1, &search_result);
+    ASSERT_EQ(res.error_code, Success);
+    auto result = (SearchResult*)search_result;
+    ASSERT_EQ(result->internal_seg_offsets_.size(), 10);
+    ASSERT_NE(result->internal_seg_offsets_[0], -1);
+    ASSERT_LT(result->internal_seg_offsets_[0], num_dominant);
+    for (int i = 1; i < 10; ++i) {
+        ASSERT_EQ(result->internal_seg_offsets_[i], -1);
+    }
+
+    DeleteSearchPlan(plan);
+    DeletePlaceholderGroup(placeholderGroup);
+    DeleteSearchResult(search_result);
+    DeleteCollection(collection);
+    DeleteSegment(segment);
+}
+
 TEST(CApiTest, LoadIndexInfo) {
     // generator index
     constexpr auto TOPK = 10;
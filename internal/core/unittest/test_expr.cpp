@@ -19,6 +19,9 @@
 #include "query/generated/ShowPlanNodeVisitor.h"
 #include "query/generated/ExecExprVisitor.h"
 #include "query/Plan.h"
+#include "query/PlanProto.h"
+#include "pb/plan.pb.h"
+#include <google/protobuf/text_format.h>
 #include "utils/tools.h"
 #include <regex>
 #include <boost/format.hpp>
@@ -583,3 +586,193 @@ TEST(Expr, TestCompare) {
         }
     }
 }
+
+TEST(Expr, TestBinaryArithOpEvalRange) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    std::vector<std::tuple<std::string, std::function<bool(int)>>> testcases = {
+        {R"(arith_op: Add
+            right_operand: < int64_val: 10 >
+            op: Equal
+            value: < int64_val: 100 >)",
+         [](int v) { return (v + 10) == 100; }},
+        {R"(arith_op: Sub
+            right_operand: < int64_val: 100 >
+            op: GreaterThan
+            value: < int64_val: 500 >)",
+         [](int v) { return (v - 100) > 500; }},
+        {R"(arith_op: Mul
+            right_operand: < int64_val: 3 >
+            op: LessEqual
+            value: < int64_val: 1000 >)",
+         [](int v) { return (v * 3) <= 1000; }},
+        {R"(arith_op: Div
+            right_operand: < int64_val: 7 >
+            op: NotEqual
+            value: < int64_val: 10 >)",
+         [](int v) { return (v / 7) != 10; }},
+        {R"(arith_op: Mod
+            right_operand: < int64_val: 10 >
+            op: Equal
+            value: < int64_val: 3 >)",
+         [](int v) { return (v % 10) == 3; }},
+    };
+
+    std::string raw_plan_tmp = R"(vector_anns: <
+                                    field_id: %1%
+                                    predicates: <
+                                        binary_arith_op_eval_range_expr: <
+                                            column_info: <
+                                                field_id: %2%
+                                                data_type: Int32
+                                            >
+                                            @@@@
+                                        >
+                                    >
+                                    query_info: <
+                                        topk: 10
+                                        metric_type: "L2"
+                                        search_params: "{\"nprobe\": 10}"
+                                    >
+                                    placeholder_tag: "$0"
+                                 >)";
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto age_fid = schema->AddDebugField("age", DataType::INT32);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<int> age_col;
+    int num_iters = 100;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_age_col = raw_data.get_col<int>(1);
+        age_col.insert(age_col.end(), new_age_col.begin(), new_age_col.end());
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [clause, ref_func] : testcases) {
+        auto loc = raw_plan_tmp.find("@@@@");
+        auto raw_plan = raw_plan_tmp;
+        raw_plan.replace(loc, 4, clause);
+        auto plan_str = boost::str(boost::format(raw_plan) % vec_fid.get() % age_fid.get());
+        proto::plan::PlanNode plan_node;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(plan_str, &plan_node));
+        auto plan = ProtoParser(*schema).CreatePlan(plan_node);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ans = final[i];
+
+            auto val = age_col[i];
+            auto ref = ref_func(val);
+            ASSERT_EQ(ans, ref) << clause << "@" << i << "!!" << val;
+        }
+    }
+}
+
+TEST(Expr, TestString) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    std::vector<std::tuple<std::string, std::function<bool(std::string_view)>>> testcases = {
+        {R"(unary_range_expr: <
+                column_info: < field_id: %2% data_type: String >
+                op: Equal
+                value: < string_val: "str_100" >
+            >)",
+         [](std::string_view v) { return v == "str_100"; }},
+        {R"(unary_range_expr: <
+                column_info: < field_id: %2% data_type: String >
+                op: GreaterThan
+                value: < string_val: "str_5" >
+            >)",
+         [](std::string_view v) { return v > "str_5"; }},
+        {R"(binary_range_expr: <
+                column_info: < field_id: %2% data_type: String >
+                lower_inclusive: true
+                upper_inclusive: false
+                lower_value: < string_val: "str_2" >
+                upper_value: < string_val: "str_3" >
+            >)",
+         [](std::string_view v) { return "str_2" <= v && v < "str_3"; }},
+        {R"(term_expr: <
+                column_info: < field_id: %2% data_type: String >
+                values: < string_val: "str_7" >
+                values: < string_val: "str_1" >
+                values: < string_val: "str_42" >
+            >)",
+         [](std::string_view v) { return v == "str_7" || v == "str_1" || v == "str_42"; }},
+        {R"(string_match_expr: <
+                column_info: < field_id: %2% data_type: String >
+                op: Prefix
+                pattern: "str_1"
+            >)",
+         [](std::string_view v) { return v.substr(0, 5) == "str_1"; }},
+        {R"(string_match_expr: <
+                column_info: < field_id: %2% data_type: String >
+                op: Suffix
+                pattern: "37"
+            >)",
+         [](std::string_view v) { return v.size() >= 2 && v.substr(v.size() - 2) == "37"; }},
+        {R"(string_match_expr: <
+                column_info: < field_id: %2% data_type: String >
+                op: Contains
+                pattern: "42"
+            >)",
+         [](std::string_view v) { return v.find("42") != std::string_view::npos; }},
+    };
+
+    std::string raw_plan_tmp = R"(vector_anns: <
+                                    field_id: %1%
+                                    predicates: <
+                                        @@@@
+                                    >
+                                    query_info: <
+                                        topk: 10
+                                        metric_type: "L2"
+                                        search_params: "{\"nprobe\": 10}"
+                                    >
+                                    placeholder_tag: "$0"
+                                 >)";
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    auto str_fid = schema->AddDebugField("str", DataType::STRING, 16);
+    auto slot_sizeof = (*schema)[FieldOffset(1)].get_sizeof();
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<std::string> str_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_str_col = raw_data.get_string_col(1, slot_sizeof);
+        str_col.insert(str_col.end(), new_str_col.begin(), new_str_col.end());
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [clause, ref_func] : testcases) {
+        auto loc = raw_plan_tmp.find("@@@@");
+        auto raw_plan = raw_plan_tmp;
+        raw_plan.replace(loc, 4, clause);
+        auto plan_str = boost::str(boost::format(raw_plan) % vec_fid.get() % str_fid.get());
+        proto::plan::PlanNode plan_node;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(plan_str, &plan_node));
+        auto plan = ProtoParser(*schema).CreatePlan(plan_node);
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ans = final[i];
+            auto val = str_col[i];
+            auto ref = ref_func(val);
+            ASSERT_EQ(ans, ref) << clause << "@" << i << "!!" << val;
+        }
+    }
+}
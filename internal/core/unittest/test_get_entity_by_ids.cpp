@@ -12,7 +12,10 @@
 #include <gtest/gtest.h>
 #include "test_utils/DataGen.h"
 #include "segcore/ScalarIndex.h"
+#include "segcore/SegmentGrowing.h"
 #include "query/ExprImpl.h"
+#include "query/Plan.h"
+#include "pb/plan.pb.h"
 using namespace milvus;
 using namespace milvus::segcore;
 
@@ -255,3 +258,263 @@ TEST(GetEntityByIds, PrimaryKey) {
     //        }
     //    }
 }
+
+TEST(Retrieve, Delete) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 100;
+    uint64_t ts_offset = 100;
+    auto dataset = DataGen(schema, N, 42, ts_offset);
+    auto i64_col = dataset.get_col<int64_t>(0);
+
+    auto sealed = CreateSealedSegment(schema);
+    SealedLoader(dataset, *sealed);
+    auto growing = CreateGrowingSegment(schema);
+    growing->PreInsert(N);
+    growing->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>();
+    term_expr->field_offset_ = FieldOffset(0);
+    term_expr->data_type_ = DataType::INT64;
+    std::vector<int64_t> del_pks;
+    for (int i = 0; i < 4; ++i) {
+        term_expr->terms_.emplace_back(i64_col[i]);
+        del_pks.push_back(i64_col[i]);
+    }
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->field_offsets_ = std::vector<FieldOffset>{FieldOffset(0)};
+
+    // the first two rows are inserted before the delete,
+    // the last two rows are inserted at or after the delete, e.g. by upsert, and must be kept
+    std::vector<Timestamp> del_timestamps(del_pks.size(), ts_offset + 2);
+    std::vector<SegmentInterface*> segments{sealed.get(), growing.get()};
+    for (auto segment : segments) {
+        auto offset = segment->PreDelete(del_pks.size());
+        auto status = segment->Delete(offset, del_pks.size(), del_pks.data(), del_timestamps.data());
+        ASSERT_TRUE(status.ok());
+        ASSERT_EQ(segment->get_deleted_count(), del_pks.size());
+
+        auto retrieve_results = segment->Retrieve(plan.get(), ts_offset + N);
+        auto pks = retrieve_results->fields_data(0).scalars().long_data();
+        ASSERT_EQ(pks.data_size(), 2);
+        ASSERT_EQ(pks.data(0), i64_col[2]);
+        ASSERT_EQ(pks.data(1), i64_col[3]);
+    }
+}
+
+TEST(Retrieve, Limit) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 100;
+    int64_t limit = 10;
+    auto dataset = DataGen(schema, N);
+    auto i64_col = dataset.get_col<int64_t>(0);
+
+    auto sealed = CreateSealedSegment(schema);
+    SealedLoader(dataset, *sealed);
+    auto growing = CreateGrowingSegment(schema);
+    growing->PreInsert(N);
+    growing->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>();
+    term_expr->field_offset_ = FieldOffset(0);
+    term_expr->data_type_ = DataType::INT64;
+    for (auto pk : i64_col) {
+        term_expr->terms_.emplace_back(pk);
+    }
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->plan_node_->limit_ = limit;
+    plan->field_offsets_ = std::vector<FieldOffset>{FieldOffset(0)};
+
+    // the entities with the smallest primary keys are kept, ordered by primary key
+    auto sorted_pks = i64_col;
+    std::sort(sorted_pks.begin(), sorted_pks.end());
+    std::vector<SegmentInterface*> segments{sealed.get(), growing.get()};
+    for (auto segment : segments) {
+        auto retrieve_results = segment->Retrieve(plan.get(), MAX_TIMESTAMP);
+        ASSERT_EQ(retrieve_results->offset_size(), limit);
+        auto pks = retrieve_results->fields_data(0).scalars().long_data();
+        ASSERT_EQ(pks.data_size(), limit);
+        for (int i = 0; i < limit; ++i) {
+            ASSERT_EQ(pks.data(i), sorted_pks[i]);
+        }
+    }
+}
+
+TEST(Retrieve, MatchAll) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 100;
+    int64_t limit = 10;
+    auto dataset = DataGen(schema, N);
+    auto i64_col = dataset.get_col<int64_t>(0);
+
+    auto sealed = CreateSealedSegment(schema);
+    SealedLoader(dataset, *sealed);
+    auto growing = CreateGrowingSegment(schema);
+    growing->PreInsert(N);
+    growing->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+
+    // a plan without predicates matches every entity
+    proto::plan::PlanNode plan_node;
+    plan_node.add_output_field_ids(fid_64.get());
+    auto binary_plan = plan_node.SerializeAsString();
+    auto plan = query::CreateRetrievePlanByExpr(*schema, binary_plan.data(), binary_plan.size());
+
+    auto sorted_pks = i64_col;
+    std::sort(sorted_pks.begin(), sorted_pks.end());
+    std::vector<SegmentInterface*> segments{sealed.get(), growing.get()};
+    for (auto segment : segments) {
+        auto retrieve_results = segment->Retrieve(plan.get(), MAX_TIMESTAMP);
+        ASSERT_EQ(retrieve_results->offset_size(), N);
+    }
+
+    plan_node.set_limit(limit);
+    binary_plan = plan_node.SerializeAsString();
+    plan = query::CreateRetrievePlanByExpr(*schema, binary_plan.data(), binary_plan.size());
+    for (auto segment : segments) {
+        auto retrieve_results = segment->Retrieve(plan.get(), MAX_TIMESTAMP);
+        auto pks = retrieve_results->fields_data(0).scalars().long_data();
+        ASSERT_EQ(pks.data_size(), limit);
+        for (int i = 0; i < limit; ++i) {
+            ASSERT_EQ(pks.data(i), sorted_pks[i]);
+        }
+    }
+}
+
+TEST(Retrieve, StringPrimaryKey) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_str = schema->AddDebugField("counter_str", DataType::STRING, 16);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 100;
+    int64_t limit = 3;
+    uint64_t ts_offset = 100;
+    auto dataset = DataGen(schema, N, 42, ts_offset);
+    auto str_col = dataset.get_string_col(0, (*schema)[FieldOffset(0)].get_sizeof());
+
+    auto sealed = CreateSealedSegment(schema);
+    SealedLoader(dataset, *sealed);
+    auto growing = CreateGrowingSegment(schema);
+    growing->PreInsert(N);
+    growing->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    auto term_expr = std::make_unique<query::TermExprImpl<std::string>>();
+    term_expr->field_offset_ = FieldOffset(0);
+    term_expr->data_type_ = DataType::STRING;
+    IdArray del_pks;
+    for (int i = 0; i < 6; ++i) {
+        term_expr->terms_.emplace_back(str_col[i]);
+        if (i < 2) {
+            del_pks.mutable_str_id()->add_data(str_col[i]);
+        }
+    }
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->plan_node_->limit_ = limit;
+    plan->field_offsets_ = std::vector<FieldOffset>{FieldOffset(0)};
+
+    // the first two rows are deleted, the smallest of the rest are kept
+    auto kept_pks = std::vector<std::string>(str_col.begin() + 2, str_col.begin() + 6);
+    std::sort(kept_pks.begin(), kept_pks.end());
+    std::vector<Timestamp> del_timestamps(2, ts_offset + N);
+    std::vector<SegmentInternalInterface*> segments{sealed.get(), growing.get()};
+    for (auto segment : segments) {
+        auto offset = segment->PreDelete(2);
+        auto status = segment->DeletePrimaryKeys(offset, del_pks, del_timestamps.data());
+        ASSERT_TRUE(status.ok());
+
+        auto retrieve_results = segment->Retrieve(plan.get(), MAX_TIMESTAMP);
+        auto& pks = retrieve_results->fields_data(0).scalars().string_data();
+        ASSERT_EQ(pks.data_size(), limit);
+        ASSERT_EQ(retrieve_results->ids().str_id().data_size(), limit);
+        for (int i = 0; i < limit; ++i) {
+            ASSERT_EQ(pks.data(i), kept_pks[i]);
+        }
+    }
+
+    std::vector<Timestamp> timestamps(2);
+    auto deleted = growing->GetDeletedPrimaryKeys(2, timestamps.data());
+    ASSERT_EQ(deleted->str_id().data_size(), 2);
+    ASSERT_EQ(timestamps[0], ts_offset + N);
+}
+
+TEST(Retrieve, SeekByPrimaryKey) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 100;
+    int64_t limit = 7;
+    auto dataset = DataGen(schema, N);
+    auto i64_col = dataset.get_col<int64_t>(0);
+
+    auto sealed = CreateSealedSegment(schema);
+    SealedLoader(dataset, *sealed);
+    auto growing = CreateGrowingSegment(schema);
+    growing->PreInsert(N);
+    growing->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+
+    // the pages after the lower bound of the primary key cover all the entities in order, as a query iterator does
+    auto sorted_pks = i64_col;
+    std::sort(sorted_pks.begin(), sorted_pks.end());
+    std::vector<SegmentInterface*> segments{sealed.get(), growing.get()};
+    for (auto segment : segments) {
+        std::vector<int64_t> iterated;
+        std::optional<int64_t> last_pk;
+        while (true) {
+            auto plan = std::make_unique<query::RetrievePlan>(*schema);
+            plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+            plan->plan_node_->limit_ = limit;
+            plan->field_offsets_ = std::vector<FieldOffset>{FieldOffset(0)};
+            if (last_pk.has_value()) {
+                auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>();
+                term_expr->field_offset_ = FieldOffset(0);
+                term_expr->data_type_ = DataType::INT64;
+                for (auto pk : i64_col) {
+                    term_expr->terms_.emplace_back(pk);
+                }
+                auto range_expr = std::make_unique<query::UnaryRangeExprImpl<int64_t>>();
+                range_expr->field_offset_ = FieldOffset(0);
+                range_expr->data_type_ = DataType::INT64;
+                range_expr->op_type_ = query::OpType::GreaterThan;
+                range_expr->value_ = last_pk.value();
+                auto and_expr = std::make_unique<query::LogicalBinaryExpr>();
+                and_expr->op_type_ = query::LogicalBinaryExpr::OpType::LogicalAnd;
+                and_expr->left_ = std::move(term_expr);
+                and_expr->right_ = std::move(range_expr);
+                plan->plan_node_->predicate_ = std::move(and_expr);
+            }
+            auto retrieve_results = segment->Retrieve(plan.get(), MAX_TIMESTAMP);
+            auto pks = retrieve_results->fields_data(0).scalars().long_data();
+            ASSERT_LE(pks.data_size(), limit);
+            if (pks.data_size() == 0) {
+                break;
+            }
+            iterated.insert(iterated.end(), pks.data().begin(), pks.data().end());
+            last_pk = pks.data(pks.data_size() - 1);
+        }
+        ASSERT_EQ(iterated, sorted_pks);
+    }
+}
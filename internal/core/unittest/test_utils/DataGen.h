@@ -43,6 +43,17 @@ struct GeneratedData {
         memcpy(ret.data(), target.data(), target.size());
         return ret;
     }
+
+    // decode the slots of a string column
+    auto
+    get_string_col(int index, int64_t slot_sizeof) const {
+        auto& target = cols_.at(index);
+        std::vector<std::string> ret(target.size() / slot_sizeof);
+        for (int64_t i = 0; i < ret.size(); ++i) {
+            ret[i] = std::string(VarCharView(target.data() + i * slot_sizeof));
+        }
+        return ret;
+    }
     template <typename T>
     auto
     get_mutable_col(int index) {
@@ -183,6 +194,19 @@ DataGen(SchemaPtr schema, int64_t N, uint64_t seed = 42, uint64_t ts_offset = 0)
                 insert_cols(data);
                 break;
             }
+            case engine::DataType::STRING: {
+                auto slot_sizeof = field.get_sizeof();
+                vector<uint8_t> data(slot_sizeof * N);
+                for (int i = 0; i < N; ++i) {
+                    auto str = "str_" + std::to_string(er() % (2 * N));
+                    if (starts_with(field.get_name().get(), "counter")) {
+                        str = "str_" + std::to_string(i);
+                    }
+                    FillVarChar(str, data.data() + i * slot_sizeof, slot_sizeof);
+                }
+                insert_cols(data);
+                break;
+            }
             default: {
                 throw std::runtime_error("unimplemented");
             }
@@ -321,6 +345,16 @@ SealedLoader(const GeneratedData& dataset, SegmentSealed& seg) {
         info.field_id = meta.get_id().get();
         info.row_count = row_count;
         info.blob = dataset.cols_[field_offset].data();
+        // strings are loaded as length prefixed strings one after another
+        std::vector<char> strings;
+        if (meta.is_string()) {
+            for (auto& str : dataset.get_string_col(field_offset, meta.get_sizeof())) {
+                auto length = static_cast<uint32_t>(str.size());
+                strings.insert(strings.end(), (char*)&length, (char*)&length + sizeof(length));
+                strings.insert(strings.end(), str.begin(), str.end());
+            }
+            info.blob = strings.data();
+        }
         seg.LoadFieldData(info);
         ++field_offset;
     }
@@ -13,7 +13,9 @@ package datacoord
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -52,6 +54,8 @@ const (
 	WatchChannel EventType = 3
 	// FlushSegments EventType const for flush specified segments
 	FlushSegments EventType = 4
+	// CompactSegments EventType const for compact specified segments
+	CompactSegments EventType = 5
 )
 
 // NodeEventType enum for node events
@@ -62,6 +66,8 @@ const (
 	Watch NodeEventType = 1
 	// Flush NodeEventTYpe const for flush specified segments
 	Flush NodeEventType = 2
+	// Compact NodeEventType const for compact specified segments
+	Compact NodeEventType = 3
 )
 
 // Event event wrapper contains EventType and related parameter
@@ -196,6 +202,16 @@ func (c *Cluster) Flush(segments []*datapb.SegmentInfo) {
 	}
 }
 
+// Compaction triggers Compaction event
+// puts Event into buffered channel
+// function returns not guarantee event processed
+func (c *Cluster) Compaction(plan *datapb.CompactionPlan) {
+	c.eventCh <- &Event{
+		Type: CompactSegments,
+		Data: plan,
+	}
+}
+
 // Register triggers Register event
 // put Event into buffered channel
 // function returns not guarantee event processed
@@ -247,6 +263,8 @@ func (c *Cluster) handleNodeEvent() {
 				c.handleWatchChannel(params.Channel, params.CollectionID)
 			case FlushSegments:
 				c.handleFlush(e.Data.([]*datapb.SegmentInfo))
+			case CompactSegments:
+				c.handleCompaction(e.Data.(*datapb.CompactionPlan))
 			default:
 				log.Warn("Unknow node event type")
 			}
@@ -303,6 +321,19 @@ func (c *Cluster) handleEvent(node *NodeInfo) {
 				if err = VerifyResponse(resp, err); err != nil {
 					log.Warn("failed to flush segments", zap.String("addr", node.Info.GetAddress()), zap.Error(err))
 				}
+			case Compact:
+				req, ok := event.Req.(*datapb.CompactionPlan)
+				if !ok {
+					log.Warn("request type is not Compaction")
+					continue
+				}
+				tCtx, cancel := context.WithTimeout(ctx, eventTimeout)
+				resp, err := cli.Compaction(tCtx, req)
+				cancel()
+				if err = VerifyResponse(resp, err); err != nil {
+					log.Warn("failed to execute compaction plan", zap.String("addr", node.Info.GetAddress()),
+						zap.Int64("planID", req.GetPlanID()), zap.Error(err))
+				}
 			default:
 				log.Warn("unknown event type", zap.Any("type", event.Type))
 			}
@@ -544,6 +575,59 @@ func (c *Cluster) handleFlush(segments []*datapb.SegmentInfo) {
 	}
 }
 
+// handleCompaction handles compaction logic
+// finds the data node watching the channel of plan and trigger Node Event
+func (c *Cluster) handleCompaction(plan *datapb.CompactionPlan) {
+	c.mu.Lock()
+	dataNodes := c.nodes.GetNodes()
+	c.mu.Unlock()
+
+	for _, node := range dataNodes {
+		for _, chstatus := range node.Info.GetChannels() {
+			if chstatus.Name != plan.GetChannel() {
+				continue
+			}
+			ch := node.GetEventChannel()
+			ch <- &NodeEvent{
+				Type: Compact,
+				Req:  plan,
+			}
+			return
+		}
+	}
+	log.Warn("no data node watches the channel of compaction plan",
+		zap.Int64("planID", plan.GetPlanID()), zap.String("channel", plan.GetChannel()))
+}
+
+// Import sends the import task to the alive data nodes in random order until one accepts it,
+// returns the id of the accepting node
+func (c *Cluster) Import(ctx context.Context, task *datapb.ImportTaskInfo) (UniqueID, error) {
+	nodes := c.GetNodes()
+	if len(nodes) == 0 {
+		return 0, errors.New("no data node is alive")
+	}
+	var lastErr error
+	for _, i := range rand.Perm(len(nodes)) {
+		nodeID := nodes[i].Info.GetVersion()
+		cli, err := c.getOrCreateClient(ctx, nodeID)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		info := proto.Clone(task).(*datapb.ImportTaskInfo)
+		info.DatanodeID = nodeID
+		resp, err := cli.Import(ctx, info)
+		if err = VerifyResponse(resp, err); err != nil {
+			log.Warn("data node refused import task", zap.String("addr", nodes[i].Info.GetAddress()),
+				zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+			lastErr = err
+			continue
+		}
+		return nodeID, nil
+	}
+	return 0, lastErr
+}
+
 // watch handles watch logic
 // finds corresponding data nodes and trigger Node Events
 func (c *Cluster) watch(n *NodeInfo) {
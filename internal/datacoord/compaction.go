@@ -0,0 +1,274 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// TODO this num should be determined by resources of datanode, for now, we set to a fixed value for simple
+const (
+	maxParallelCompactionTaskNum = 100
+	compactionTimeout            = 10 * 60 // seconds
+	compactionPlanRetention      = 60 * 60 // seconds, how long finished plans stay queryable after their timeout
+	compactionExpirationInterval = 60 * time.Second
+)
+
+// compactionPlanContext manages the life cycle of compaction plans
+type compactionPlanContext interface {
+	start()
+	stop()
+	// execCompactionPlan starts to execute plan and returns immediately
+	execCompactionPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error
+	// completeCompaction records the result of a compaction
+	completeCompaction(result *datapb.CompactionResult) error
+	// getCompaction returns compaction task. If planID does not exist, return nil.
+	getCompaction(planID int64) *compactionTask
+	// expireCompaction sets the state of timed out compactions to timeout, and prunes the finished ones
+	expireCompaction(ts Timestamp) error
+	// isFull returns true if the task pool is full
+	isFull() bool
+	// getCompactionTasksBySignalID returns the compaction tasks triggered by the signal
+	getCompactionTasksBySignalID(signalID int64) []*compactionTask
+}
+
+// compactionExecutor sends compaction plans to the data nodes
+type compactionExecutor interface {
+	Compaction(plan *datapb.CompactionPlan)
+}
+
+var _ compactionExecutor = (*Cluster)(nil)
+
+type compactionTaskState int8
+
+const (
+	executing compactionTaskState = iota + 1
+	completed
+	timeout
+)
+
+type compactionTask struct {
+	triggerInfo *compactionSignal
+	plan        *datapb.CompactionPlan
+	state       compactionTaskState
+}
+
+type compactionTaskOpt func(task *compactionTask)
+
+func (t *compactionTask) shadowClone(opts ...compactionTaskOpt) *compactionTask {
+	task := &compactionTask{
+		triggerInfo: t.triggerInfo,
+		plan:        t.plan,
+		state:       t.state,
+	}
+	for _, opt := range opts {
+		opt(task)
+	}
+	return task
+}
+
+func setState(state compactionTaskState) compactionTaskOpt {
+	return func(task *compactionTask) {
+		task.state = state
+	}
+}
+
+var _ compactionPlanContext = (*compactionPlanHandler)(nil)
+
+type compactionPlanHandler struct {
+	plans            map[int64]*compactionTask // planID -> task
+	executor         compactionExecutor
+	meta             *meta
+	allocator        allocator
+	flushCh          chan UniqueID
+	mu               sync.RWMutex
+	executingTaskNum int
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newCompactionPlanHandler(executor compactionExecutor, meta *meta, allocator allocator, flush chan UniqueID) *compactionPlanHandler {
+	return &compactionPlanHandler{
+		plans:     make(map[int64]*compactionTask),
+		executor:  executor,
+		meta:      meta,
+		allocator: allocator,
+		flushCh:   flush,
+	}
+}
+
+func (c *compactionPlanHandler) start() {
+	c.quit = make(chan struct{})
+	c.wg.Add(1)
+
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(compactionExpirationInterval)
+		defer ticker.Stop()
+		log.Info("compaction handler start")
+		for {
+			select {
+			case <-c.quit:
+				log.Info("compaction handler quit")
+				return
+			case <-ticker.C:
+				cctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				ts, err := c.allocator.allocTimestamp(cctx)
+				cancel()
+				if err != nil {
+					log.Warn("unable to alloc timestamp", zap.Error(err))
+					continue
+				}
+				if err := c.expireCompaction(ts); err != nil {
+					log.Warn("failed to expire compaction", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (c *compactionPlanHandler) stop() {
+	close(c.quit)
+	c.wg.Wait()
+}
+
+// execCompactionPlan marks the segments in plan compacting, and sends the plan to the data node
+func (c *compactionPlanHandler) execCompactionPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.plans[plan.GetPlanID()]; ok {
+		return fmt.Errorf("compaction plan %d already exists", plan.GetPlanID())
+	}
+
+	for _, sb := range plan.GetSegmentBinlogs() {
+		c.meta.SetSegmentCompacting(sb.GetSegmentID(), true)
+	}
+
+	c.plans[plan.GetPlanID()] = &compactionTask{
+		triggerInfo: signal,
+		plan:        plan,
+		state:       executing,
+	}
+	c.executingTaskNum++
+
+	log.Debug("exec compaction plan", zap.Int64("planID", plan.GetPlanID()), zap.String("channel", plan.GetChannel()),
+		zap.Stringer("type", plan.GetType()), zap.Int("segmentNum", len(plan.GetSegmentBinlogs())))
+	c.executor.Compaction(plan)
+	return nil
+}
+
+// completeCompaction swaps the compacted segments in meta, and notifies the compacted to segment is flushed
+func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResult) error {
+	if err := c.swapCompactedSegments(result); err != nil {
+		return err
+	}
+
+	// not under mu, the flush loop may be slow to drain flushCh
+	if result.GetNumOfRows() > 0 {
+		c.flushCh <- result.GetSegmentID()
+	}
+	log.Debug("compaction completed", zap.Int64("planID", result.GetPlanID()), zap.Int64("segmentID", result.GetSegmentID()),
+		zap.Int64("numOfRows", result.GetNumOfRows()))
+	return nil
+}
+
+func (c *compactionPlanHandler) swapCompactedSegments(result *datapb.CompactionResult) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	planID := result.GetPlanID()
+	task, ok := c.plans[planID]
+	if !ok {
+		return fmt.Errorf("plan %d is not found", planID)
+	}
+	if task.state != executing {
+		return fmt.Errorf("plan %d's state is %v, not executing", planID, task.state)
+	}
+
+	if err := c.meta.CompleteCompaction(task.plan.GetSegmentBinlogs(), result); err != nil {
+		return err
+	}
+
+	c.plans[planID] = task.shadowClone(setState(completed))
+	c.executingTaskNum--
+	return nil
+}
+
+func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.plans[planID]
+}
+
+// expireCompaction sets the executing plans which exceed the timeout to timeout, and releases the segments,
+// the finished plans are removed once they exceed the timeout by compactionPlanRetention
+func (c *compactionPlanHandler) expireCompaction(ts Timestamp) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for planID, task := range c.plans {
+		if task.state != executing {
+			if c.isTimeout(ts, task.plan.GetStartTime(), task.plan.GetTimeoutInSeconds()+compactionPlanRetention) {
+				delete(c.plans, planID)
+			}
+			continue
+		}
+		if !c.isTimeout(ts, task.plan.GetStartTime(), task.plan.GetTimeoutInSeconds()) {
+			continue
+		}
+
+		log.Warn("compaction plan timeout", zap.Int64("planID", planID))
+		for _, sb := range task.plan.GetSegmentBinlogs() {
+			c.meta.SetSegmentCompacting(sb.GetSegmentID(), false)
+		}
+		c.plans[planID] = task.shadowClone(setState(timeout))
+		c.executingTaskNum--
+	}
+	return nil
+}
+
+func (c *compactionPlanHandler) isTimeout(now Timestamp, start Timestamp, timeout int32) bool {
+	startTime, _ := tsoutil.ParseTS(start)
+	ts, _ := tsoutil.ParseTS(now)
+	return int32(ts.Sub(startTime).Seconds()) >= timeout
+}
+
+func (c *compactionPlanHandler) isFull() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.executingTaskNum >= maxParallelCompactionTaskNum
+}
+
+func (c *compactionPlanHandler) getCompactionTasksBySignalID(signalID int64) []*compactionTask {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var tasks []*compactionTask
+	for _, t := range c.plans {
+		if t.triggerInfo.id != signalID {
+			continue
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks
+}
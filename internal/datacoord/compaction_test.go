@@ -0,0 +1,223 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type spyCompactionExecutor struct {
+	plans []*datapb.CompactionPlan
+}
+
+func (e *spyCompactionExecutor) Compaction(plan *datapb.CompactionPlan) {
+	e.plans = append(e.plans, plan)
+}
+
+func newCompactionTestMeta(t *testing.T, segments ...*datapb.SegmentInfo) *meta {
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+	for _, segment := range segments {
+		err = meta.AddSegment(NewSegmentInfo(segment))
+		assert.Nil(t, err)
+	}
+	return meta
+}
+
+func newCompactionTestPlan(planID UniqueID, startTime Timestamp, segmentIDs ...UniqueID) *datapb.CompactionPlan {
+	segmentBinlogs := make([]*datapb.CompactionSegmentBinlogs, 0, len(segmentIDs))
+	for _, id := range segmentIDs {
+		segmentBinlogs = append(segmentBinlogs, &datapb.CompactionSegmentBinlogs{SegmentID: id})
+	}
+	return &datapb.CompactionPlan{
+		PlanID:           planID,
+		SegmentBinlogs:   segmentBinlogs,
+		StartTime:        startTime,
+		TimeoutInSeconds: compactionTimeout,
+		Type:             datapb.CompactionType_MergeCompaction,
+		Channel:          "ch1",
+	}
+}
+
+func TestCompactionPlanHandler_execCompactionPlan(t *testing.T) {
+	meta := newCompactionTestMeta(t,
+		&datapb.SegmentInfo{ID: 1, CollectionID: 1, PartitionID: 1, State: commonpb.SegmentState_Flushed},
+		&datapb.SegmentInfo{ID: 2, CollectionID: 1, PartitionID: 1, State: commonpb.SegmentState_Flushed},
+	)
+	executor := &spyCompactionExecutor{}
+	handler := newCompactionPlanHandler(executor, meta, newMockAllocator(), make(chan UniqueID, 1))
+
+	signal := &compactionSignal{id: 100}
+	plan := newCompactionTestPlan(1, 0, 1, 2)
+	err := handler.execCompactionPlan(signal, plan)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, len(executor.plans))
+	assert.True(t, meta.GetSegment(1).isCompacting)
+	assert.True(t, meta.GetSegment(2).isCompacting)
+
+	task := handler.getCompaction(1)
+	assert.NotNil(t, task)
+	assert.Equal(t, executing, task.state)
+	assert.EqualValues(t, 1, len(handler.getCompactionTasksBySignalID(100)))
+	assert.EqualValues(t, 0, len(handler.getCompactionTasksBySignalID(101)))
+
+	// duplicated plan
+	err = handler.execCompactionPlan(signal, plan)
+	assert.NotNil(t, err)
+	assert.EqualValues(t, 1, len(executor.plans))
+}
+
+func TestCompactionPlanHandler_completeCompaction(t *testing.T) {
+	t.Run("plan not found", func(t *testing.T) {
+		handler := newCompactionPlanHandler(&spyCompactionExecutor{}, newCompactionTestMeta(t), newMockAllocator(), make(chan UniqueID, 1))
+		err := handler.completeCompaction(&datapb.CompactionResult{PlanID: 1})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("complete merge compaction", func(t *testing.T) {
+		meta := newCompactionTestMeta(t,
+			&datapb.SegmentInfo{ID: 1, CollectionID: 1, PartitionID: 1, InsertChannel: "ch1", NumOfRows: 1, MaxRowNum: 100, State: commonpb.SegmentState_Flushed},
+			&datapb.SegmentInfo{ID: 2, CollectionID: 1, PartitionID: 1, InsertChannel: "ch1", NumOfRows: 1, MaxRowNum: 100, State: commonpb.SegmentState_Flushed},
+		)
+		flushCh := make(chan UniqueID, 1)
+		handler := newCompactionPlanHandler(&spyCompactionExecutor{}, meta, newMockAllocator(), flushCh)
+		err := handler.execCompactionPlan(&compactionSignal{id: 100}, newCompactionTestPlan(1, 0, 1, 2))
+		assert.Nil(t, err)
+
+		err = handler.completeCompaction(&datapb.CompactionResult{PlanID: 1, SegmentID: 3, NumOfRows: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, completed, handler.getCompaction(1).state)
+		assert.EqualValues(t, 3, <-flushCh)
+
+		assert.Nil(t, meta.GetSegment(1))
+		assert.Nil(t, meta.GetSegment(2))
+		segment := meta.GetSegment(3)
+		assert.NotNil(t, segment)
+		assert.True(t, segment.GetCreatedByCompaction())
+		assert.ElementsMatch(t, []UniqueID{1, 2}, segment.GetCompactionFrom())
+		assert.EqualValues(t, 2, segment.GetNumOfRows())
+		assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+
+		// complete twice
+		err = handler.completeCompaction(&datapb.CompactionResult{PlanID: 1, SegmentID: 3, NumOfRows: 2})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("all rows deleted", func(t *testing.T) {
+		meta := newCompactionTestMeta(t,
+			&datapb.SegmentInfo{ID: 1, CollectionID: 1, PartitionID: 1, NumOfRows: 1, State: commonpb.SegmentState_Flushed},
+		)
+		flushCh := make(chan UniqueID, 1)
+		handler := newCompactionPlanHandler(&spyCompactionExecutor{}, meta, newMockAllocator(), flushCh)
+		err := handler.execCompactionPlan(&compactionSignal{id: 100}, newCompactionTestPlan(1, 0, 1))
+		assert.Nil(t, err)
+
+		err = handler.completeCompaction(&datapb.CompactionResult{PlanID: 1, SegmentID: 2, NumOfRows: 0})
+		assert.Nil(t, err)
+		assert.Nil(t, meta.GetSegment(1))
+		assert.Nil(t, meta.GetSegment(2))
+		assert.EqualValues(t, 0, len(flushCh))
+	})
+
+	t.Run("flush channel full", func(t *testing.T) {
+		meta := newCompactionTestMeta(t,
+			&datapb.SegmentInfo{ID: 1, CollectionID: 1, PartitionID: 1, NumOfRows: 1, State: commonpb.SegmentState_Flushed},
+		)
+		flushCh := make(chan UniqueID)
+		handler := newCompactionPlanHandler(&spyCompactionExecutor{}, meta, newMockAllocator(), flushCh)
+		err := handler.execCompactionPlan(&compactionSignal{id: 100}, newCompactionTestPlan(1, 0, 1))
+		assert.Nil(t, err)
+
+		done := make(chan error)
+		go func() {
+			done <- handler.completeCompaction(&datapb.CompactionResult{PlanID: 1, SegmentID: 2, NumOfRows: 1})
+		}()
+		// the handler is not locked while waiting for the flush loop
+		assert.Eventually(t, func() bool {
+			return handler.getCompaction(1).state == completed
+		}, time.Second, 10*time.Millisecond)
+		assert.False(t, handler.isFull())
+		assert.EqualValues(t, 2, <-flushCh)
+		assert.Nil(t, <-done)
+	})
+}
+
+func TestCompactionPlanHandler_expireCompaction(t *testing.T) {
+	meta := newCompactionTestMeta(t,
+		&datapb.SegmentInfo{ID: 1, CollectionID: 1, PartitionID: 1, State: commonpb.SegmentState_Flushed},
+		&datapb.SegmentInfo{ID: 2, CollectionID: 1, PartitionID: 1, State: commonpb.SegmentState_Flushed},
+	)
+	handler := newCompactionPlanHandler(&spyCompactionExecutor{}, meta, newMockAllocator(), make(chan UniqueID, 1))
+
+	now := time.Now()
+	startTime := tsoutil.ComposeTS(now.UnixNano()/int64(time.Millisecond), 0)
+	err := handler.execCompactionPlan(&compactionSignal{id: 100}, newCompactionTestPlan(1, startTime, 1))
+	assert.Nil(t, err)
+	err = handler.execCompactionPlan(&compactionSignal{id: 100}, newCompactionTestPlan(2, startTime+1, 2))
+	assert.Nil(t, err)
+
+	err = handler.expireCompaction(startTime + 1)
+	assert.Nil(t, err)
+	assert.Equal(t, executing, handler.getCompaction(1).state)
+
+	expired := now.Add(time.Duration(compactionTimeout+1) * time.Second)
+	err = handler.expireCompaction(tsoutil.ComposeTS(expired.UnixNano()/int64(time.Millisecond), 0))
+	assert.Nil(t, err)
+	assert.Equal(t, timeout, handler.getCompaction(1).state)
+	assert.Equal(t, timeout, handler.getCompaction(2).state)
+	assert.False(t, meta.GetSegment(1).isCompacting)
+	assert.False(t, meta.GetSegment(2).isCompacting)
+
+	// timeout plan can not be completed
+	err = handler.completeCompaction(&datapb.CompactionResult{PlanID: 1, SegmentID: 3, NumOfRows: 1})
+	assert.NotNil(t, err)
+	assert.NotNil(t, meta.GetSegment(1))
+
+	// finished plans are pruned after the retention
+	pruned := now.Add(time.Duration(compactionTimeout+compactionPlanRetention+1) * time.Second)
+	err = handler.expireCompaction(tsoutil.ComposeTS(pruned.UnixNano()/int64(time.Millisecond), 0))
+	assert.Nil(t, err)
+	assert.Nil(t, handler.getCompaction(1))
+	assert.Nil(t, handler.getCompaction(2))
+	assert.Empty(t, handler.getCompactionTasksBySignalID(100))
+}
+
+func TestCompactionPlanHandler_isFull(t *testing.T) {
+	handler := newCompactionPlanHandler(&spyCompactionExecutor{}, newCompactionTestMeta(t), newMockAllocator(), make(chan UniqueID, 1))
+	assert.False(t, handler.isFull())
+	handler.executingTaskNum = maxParallelCompactionTaskNum
+	assert.True(t, handler.isFull())
+}
+
+func TestGetCompactionState(t *testing.T) {
+	state, executingCnt, completedCnt, timeoutCnt := getCompactionState(nil)
+	assert.Equal(t, commonpb.CompactionState_Completed, state)
+	assert.Equal(t, 0, executingCnt+completedCnt+timeoutCnt)
+
+	tasks := []*compactionTask{
+		{state: executing},
+		{state: completed},
+		{state: completed},
+		{state: timeout},
+	}
+	state, executingCnt, completedCnt, timeoutCnt = getCompactionState(tasks)
+	assert.Equal(t, commonpb.CompactionState_Executing, state)
+	assert.Equal(t, 1, executingCnt)
+	assert.Equal(t, 2, completedCnt)
+	assert.Equal(t, 1, timeoutCnt)
+}
@@ -0,0 +1,394 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	signalBufferSize = 100
+	// segments smaller than this proportion of max row num are merged
+	smallSegmentProportion = 0.5
+	// max number of segments merged into one segment
+	maxMergeSegmentNum = 10
+	// segments whose deleted rows exceed this ratio are compacted alone
+	singleCompactionRatioThreshold = 0.2
+	globalCompactionInterval       = 60 * time.Second
+)
+
+type timetravel struct {
+	time Timestamp
+}
+
+// getCompactTime returns the time travel of a compaction, which lies the retention duration before now.
+// Deletes later than it are kept, so that search and query within the retention duration still see the
+// rows deleted afterwards.
+func getCompactTime(ctx context.Context, allocator allocator) (*timetravel, error) {
+	ts, err := allocator.allocTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &timetravel{time: tsoutil.AddPhysicalDurationOnTs(ts, -Params.RetentionDuration)}, nil
+}
+
+type trigger interface {
+	start()
+	stop()
+	// triggerCompaction triggers a compaction on all the collections if any compaction condition satisfies
+	triggerCompaction(timetravel *timetravel) error
+	// triggerSingleCompaction triggers a compaction bundled with collection-partition-channel-segment
+	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string, timetravel *timetravel) error
+	// forceTriggerCompaction forces to start a compaction on the collection, returns the compaction id
+	forceTriggerCompaction(collectionID int64, timetravel *timetravel) (UniqueID, error)
+}
+
+type compactionSignal struct {
+	id           UniqueID
+	isForce      bool
+	isGlobal     bool
+	collectionID UniqueID
+	partitionID  UniqueID
+	segmentID    UniqueID
+	channel      string
+	timetravel   *timetravel
+}
+
+var _ trigger = (*compactionTrigger)(nil)
+
+type compactionTrigger struct {
+	meta              *meta
+	allocator         allocator
+	signals           chan *compactionSignal
+	compactionHandler compactionPlanContext
+	globalTrigger     *time.Ticker
+	forceMu           sync.Mutex
+	quit              chan struct{}
+	wg                sync.WaitGroup
+}
+
+func newCompactionTrigger(meta *meta, compactionHandler compactionPlanContext, allocator allocator) *compactionTrigger {
+	return &compactionTrigger{
+		meta:              meta,
+		allocator:         allocator,
+		signals:           make(chan *compactionSignal, signalBufferSize),
+		compactionHandler: compactionHandler,
+	}
+}
+
+func (t *compactionTrigger) start() {
+	t.quit = make(chan struct{})
+	t.globalTrigger = time.NewTicker(globalCompactionInterval)
+	t.wg.Add(2)
+	go func() {
+		defer logutil.LogPanic()
+		defer t.wg.Done()
+
+		for {
+			select {
+			case <-t.quit:
+				log.Debug("compaction trigger quit")
+				return
+			case signal := <-t.signals:
+				if signal.isGlobal {
+					t.handleGlobalSignal(signal)
+				} else {
+					t.handleSignal(signal)
+				}
+			}
+		}
+	}()
+
+	go t.startGlobalCompactionLoop()
+}
+
+func (t *compactionTrigger) startGlobalCompactionLoop() {
+	defer logutil.LogPanic()
+	defer t.wg.Done()
+
+	for {
+		select {
+		case <-t.quit:
+			t.globalTrigger.Stop()
+			log.Info("global compaction loop exit")
+			return
+		case <-t.globalTrigger.C:
+			cctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			tt, err := getCompactTime(cctx, t.allocator)
+			cancel()
+			if err != nil {
+				log.Warn("unable to get compaction time travel", zap.Error(err))
+				continue
+			}
+			if err := t.triggerCompaction(tt); err != nil {
+				log.Warn("unable to trigger global compaction", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (t *compactionTrigger) stop() {
+	close(t.quit)
+	t.wg.Wait()
+}
+
+// triggerCompaction triggers a global compaction, the signal is handled asynchronously
+func (t *compactionTrigger) triggerCompaction(timetravel *timetravel) error {
+	id, err := t.allocSignalID()
+	if err != nil {
+		return err
+	}
+	signal := &compactionSignal{
+		id:         id,
+		isForce:    false,
+		isGlobal:   true,
+		timetravel: timetravel,
+	}
+	t.signals <- signal
+	return nil
+}
+
+// triggerSingleCompaction triggers a compaction on a single segment, the signal is handled asynchronously
+func (t *compactionTrigger) triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string, timetravel *timetravel) error {
+	id, err := t.allocSignalID()
+	if err != nil {
+		return err
+	}
+	signal := &compactionSignal{
+		id:           id,
+		isForce:      false,
+		isGlobal:     false,
+		collectionID: collectionID,
+		partitionID:  partitionID,
+		segmentID:    segmentID,
+		channel:      channel,
+		timetravel:   timetravel,
+	}
+	t.signals <- signal
+	return nil
+}
+
+// forceTriggerCompaction triggers a compaction on the collection, the signal is handled synchronously
+// so that the compaction plans are available by the returned id once the function returns
+func (t *compactionTrigger) forceTriggerCompaction(collectionID int64, timetravel *timetravel) (UniqueID, error) {
+	id, err := t.allocSignalID()
+	if err != nil {
+		return -1, err
+	}
+	signal := &compactionSignal{
+		id:           id,
+		isForce:      true,
+		isGlobal:     false,
+		collectionID: collectionID,
+		timetravel:   timetravel,
+	}
+	t.handleForceSignal(signal)
+	return id, nil
+}
+
+func (t *compactionTrigger) allocSignalID() (UniqueID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return t.allocator.allocID(ctx)
+}
+
+func (t *compactionTrigger) handleForceSignal(signal *compactionSignal) {
+	t.forceMu.Lock()
+	defer t.forceMu.Unlock()
+
+	segments := t.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == signal.collectionID && isSegmentCompactable(segment)
+	})
+	t.generateAndExecPlans(signal, segments)
+}
+
+func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
+	t.forceMu.Lock()
+	defer t.forceMu.Unlock()
+
+	segments := t.meta.SelectSegments(isSegmentCompactable)
+	t.generateAndExecPlans(signal, segments)
+}
+
+func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
+	t.forceMu.Lock()
+	defer t.forceMu.Unlock()
+
+	segment := t.meta.GetSegment(signal.segmentID)
+	if segment == nil || !isSegmentCompactable(segment) {
+		return
+	}
+	if !needSingleCompaction(segment, signal.isForce) {
+		return
+	}
+	if t.compactionHandler.isFull() {
+		log.Warn("compaction plan skipped due to handler full", zap.Int64("segmentID", signal.segmentID))
+		return
+	}
+	if err := t.execPlan(signal, datapb.CompactionType_InnerCompaction, []*SegmentInfo{segment}); err != nil {
+		log.Warn("failed to execute single compaction", zap.Int64("segmentID", signal.segmentID), zap.Error(err))
+	}
+}
+
+// generateAndExecPlans groups the segments by collection-partition-channel, segments with high delete ratio
+// are compacted alone, small segments are merged
+func (t *compactionTrigger) generateAndExecPlans(signal *compactionSignal, segments []*SegmentInfo) {
+	type groupKey struct {
+		collectionID UniqueID
+		partitionID  UniqueID
+		channel      string
+	}
+	groups := make(map[groupKey][]*SegmentInfo)
+	for _, segment := range segments {
+		key := groupKey{
+			collectionID: segment.GetCollectionID(),
+			partitionID:  segment.GetPartitionID(),
+			channel:      segment.GetInsertChannel(),
+		}
+		groups[key] = append(groups[key], segment)
+	}
+
+	for _, group := range groups {
+		var smallSegments []*SegmentInfo
+		for _, segment := range group {
+			if !signal.isForce && t.compactionHandler.isFull() {
+				log.Warn("compaction plans skipped due to handler full", zap.Int64("signalID", signal.id))
+				return
+			}
+			if needSingleCompaction(segment, signal.isForce) {
+				if err := t.execPlan(signal, datapb.CompactionType_InnerCompaction, []*SegmentInfo{segment}); err != nil {
+					log.Warn("failed to execute single compaction", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+				}
+				continue
+			}
+			if isSmallSegment(segment) {
+				smallSegments = append(smallSegments, segment)
+			}
+		}
+
+		for _, bucket := range mergeSmallSegments(smallSegments) {
+			if !signal.isForce && t.compactionHandler.isFull() {
+				log.Warn("compaction plans skipped due to handler full", zap.Int64("signalID", signal.id))
+				return
+			}
+			if err := t.execPlan(signal, datapb.CompactionType_MergeCompaction, bucket); err != nil {
+				log.Warn("failed to execute merge compaction", zap.Int64("signalID", signal.id), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (t *compactionTrigger) execPlan(signal *compactionSignal, compactionType datapb.CompactionType, segments []*SegmentInfo) error {
+	plan, err := t.buildPlan(signal, compactionType, segments)
+	if err != nil {
+		return err
+	}
+	return t.compactionHandler.execCompactionPlan(signal, plan)
+}
+
+func (t *compactionTrigger) buildPlan(signal *compactionSignal, compactionType datapb.CompactionType, segments []*SegmentInfo) (*datapb.CompactionPlan, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	planID, err := t.allocator.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := t.allocator.allocTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	segmentBinlogs := make([]*datapb.CompactionSegmentBinlogs, 0, len(segments))
+	for _, segment := range segments {
+		segmentBinlogs = append(segmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:    segment.GetID(),
+			FieldBinlogs: segment.GetBinlogs(),
+			Deltalogs:    segment.GetDeltalogs(),
+		})
+	}
+
+	return &datapb.CompactionPlan{
+		PlanID:           planID,
+		SegmentBinlogs:   segmentBinlogs,
+		StartTime:        startTime,
+		TimeoutInSeconds: compactionTimeout,
+		Type:             compactionType,
+		Timetravel:       signal.timetravel.time,
+		Channel:          segments[0].GetInsertChannel(),
+		CollectionID:     segments[0].GetCollectionID(),
+		PartitionID:      segments[0].GetPartitionID(),
+	}, nil
+}
+
+// isSegmentCompactable checks whether the segment is flushed and not under compaction
+func isSegmentCompactable(segment *SegmentInfo) bool {
+	return segment.GetState() == commonpb.SegmentState_Flushed && !segment.isCompacting
+}
+
+// needSingleCompaction checks whether the deleted rows in segment exceed the threshold,
+// any segment with delta logs is compacted if forced
+func needSingleCompaction(segment *SegmentInfo, isForce bool) bool {
+	var deletedRows uint64
+	for _, deltalog := range segment.GetDeltalogs() {
+		deletedRows += deltalog.GetRecordEntries()
+	}
+	if deletedRows == 0 {
+		return false
+	}
+	if isForce || segment.GetNumOfRows() == 0 {
+		return true
+	}
+	return float64(deletedRows)/float64(segment.GetNumOfRows()) >= singleCompactionRatioThreshold
+}
+
+func isSmallSegment(segment *SegmentInfo) bool {
+	return float64(segment.GetNumOfRows()) < float64(segment.GetMaxRowNum())*smallSegmentProportion
+}
+
+// mergeSmallSegments buckets the small segments from the smallest one, each bucket has at least 2 segments,
+// and the total rows of a bucket won't exceed the max row num of segment
+func mergeSmallSegments(segments []*SegmentInfo) [][]*SegmentInfo {
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetNumOfRows() < segments[j].GetNumOfRows()
+	})
+
+	var buckets [][]*SegmentInfo
+	var bucket []*SegmentInfo
+	var bucketRows int64
+	for _, segment := range segments {
+		if len(bucket) >= maxMergeSegmentNum ||
+			(len(bucket) > 0 && bucketRows+segment.GetNumOfRows() > bucket[0].GetMaxRowNum()) {
+			if len(bucket) > 1 {
+				buckets = append(buckets, bucket)
+			}
+			bucket = nil
+			bucketRows = 0
+		}
+		bucket = append(bucket, segment)
+		bucketRows += segment.GetNumOfRows()
+	}
+	if len(bucket) > 1 {
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
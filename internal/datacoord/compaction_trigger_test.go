@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type spyCompactionHandler struct {
+	compactionPlanHandler
+	full  bool
+	plans []*datapb.CompactionPlan
+}
+
+func (h *spyCompactionHandler) execCompactionPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error {
+	h.plans = append(h.plans, plan)
+	return nil
+}
+
+func (h *spyCompactionHandler) isFull() bool {
+	return h.full
+}
+
+func flushedSegment(id, collID, partID UniqueID, channel string, numOfRows int64, deletedRows uint64) *datapb.SegmentInfo {
+	segment := &datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  collID,
+		PartitionID:   partID,
+		InsertChannel: channel,
+		NumOfRows:     numOfRows,
+		MaxRowNum:     100,
+		State:         commonpb.SegmentState_Flushed,
+	}
+	if deletedRows > 0 {
+		segment.Deltalogs = []*datapb.DeltaLogInfo{{RecordEntries: deletedRows, DeltaLogPath: "delta"}}
+	}
+	return segment
+}
+
+func planSegmentIDs(plan *datapb.CompactionPlan) []UniqueID {
+	ids := make([]UniqueID, 0, len(plan.GetSegmentBinlogs()))
+	for _, sb := range plan.GetSegmentBinlogs() {
+		ids = append(ids, sb.GetSegmentID())
+	}
+	return ids
+}
+
+func TestCompactionTrigger_forceTriggerCompaction(t *testing.T) {
+	meta := newCompactionTestMeta(t,
+		flushedSegment(1, 1, 1, "ch1", 10, 0),
+		flushedSegment(2, 1, 1, "ch1", 20, 0),
+		flushedSegment(3, 1, 1, "ch2", 10, 0),
+		flushedSegment(4, 1, 1, "ch1", 90, 1),
+		flushedSegment(5, 2, 1, "ch1", 10, 0),
+		flushedSegment(6, 2, 1, "ch1", 10, 0),
+	)
+	handler := &spyCompactionHandler{}
+	trigger := newCompactionTrigger(meta, handler, newMockAllocator())
+
+	id, err := trigger.forceTriggerCompaction(1, &timetravel{time: 100})
+	assert.Nil(t, err)
+	assert.NotEqual(t, UniqueID(0), id)
+
+	// segment 3 is alone in its channel, collection 2 is not compacted
+	assert.EqualValues(t, 2, len(handler.plans))
+	for _, plan := range handler.plans {
+		assert.EqualValues(t, 1, plan.GetCollectionID())
+		assert.EqualValues(t, 100, plan.GetTimetravel())
+		switch plan.GetType() {
+		case datapb.CompactionType_InnerCompaction:
+			assert.ElementsMatch(t, []UniqueID{4}, planSegmentIDs(plan))
+		case datapb.CompactionType_MergeCompaction:
+			assert.ElementsMatch(t, []UniqueID{1, 2}, planSegmentIDs(plan))
+			assert.Equal(t, "ch1", plan.GetChannel())
+		default:
+			t.FailNow()
+		}
+	}
+}
+
+func TestCompactionTrigger_handleGlobalSignal(t *testing.T) {
+	t.Run("delete ratio and small segments", func(t *testing.T) {
+		meta := newCompactionTestMeta(t,
+			flushedSegment(1, 1, 1, "ch1", 90, 10),
+			flushedSegment(2, 1, 1, "ch1", 90, 30),
+			flushedSegment(3, 1, 1, "ch1", 30, 0),
+			flushedSegment(4, 1, 2, "ch1", 30, 0),
+		)
+		handler := &spyCompactionHandler{}
+		trigger := newCompactionTrigger(meta, handler, newMockAllocator())
+		trigger.handleGlobalSignal(&compactionSignal{id: 1, isGlobal: true, timetravel: &timetravel{time: 100}})
+
+		// segment 1 has low delete ratio, segment 3 and 4 are in different partitions
+		assert.EqualValues(t, 1, len(handler.plans))
+		assert.Equal(t, datapb.CompactionType_InnerCompaction, handler.plans[0].GetType())
+		assert.ElementsMatch(t, []UniqueID{2}, planSegmentIDs(handler.plans[0]))
+	})
+
+	t.Run("compacting segments are skipped", func(t *testing.T) {
+		meta := newCompactionTestMeta(t,
+			flushedSegment(1, 1, 1, "ch1", 10, 0),
+			flushedSegment(2, 1, 1, "ch1", 10, 0),
+		)
+		meta.SetSegmentCompacting(1, true)
+		handler := &spyCompactionHandler{}
+		trigger := newCompactionTrigger(meta, handler, newMockAllocator())
+		trigger.handleGlobalSignal(&compactionSignal{id: 1, isGlobal: true, timetravel: &timetravel{time: 100}})
+		assert.EqualValues(t, 0, len(handler.plans))
+	})
+
+	t.Run("handler full", func(t *testing.T) {
+		meta := newCompactionTestMeta(t,
+			flushedSegment(1, 1, 1, "ch1", 10, 0),
+			flushedSegment(2, 1, 1, "ch1", 10, 0),
+		)
+		handler := &spyCompactionHandler{full: true}
+		trigger := newCompactionTrigger(meta, handler, newMockAllocator())
+		trigger.handleGlobalSignal(&compactionSignal{id: 1, isGlobal: true, timetravel: &timetravel{time: 100}})
+		assert.EqualValues(t, 0, len(handler.plans))
+	})
+}
+
+func TestCompactionTrigger_handleSignal(t *testing.T) {
+	meta := newCompactionTestMeta(t,
+		flushedSegment(1, 1, 1, "ch1", 90, 10),
+		flushedSegment(2, 1, 1, "ch1", 90, 30),
+	)
+	handler := &spyCompactionHandler{}
+	trigger := newCompactionTrigger(meta, handler, newMockAllocator())
+
+	trigger.handleSignal(&compactionSignal{id: 1, segmentID: 1, timetravel: &timetravel{time: 100}})
+	assert.EqualValues(t, 0, len(handler.plans))
+	trigger.handleSignal(&compactionSignal{id: 2, segmentID: 2, timetravel: &timetravel{time: 100}})
+	assert.EqualValues(t, 1, len(handler.plans))
+	assert.ElementsMatch(t, []UniqueID{2}, planSegmentIDs(handler.plans[0]))
+	// segment not found
+	trigger.handleSignal(&compactionSignal{id: 3, segmentID: 3, timetravel: &timetravel{time: 100}})
+	assert.EqualValues(t, 1, len(handler.plans))
+}
+
+func TestMergeSmallSegments(t *testing.T) {
+	newSegment := func(id UniqueID, numOfRows int64) *SegmentInfo {
+		return NewSegmentInfo(&datapb.SegmentInfo{ID: id, NumOfRows: numOfRows, MaxRowNum: 100})
+	}
+
+	buckets := mergeSmallSegments([]*SegmentInfo{newSegment(1, 40)})
+	assert.EqualValues(t, 0, len(buckets))
+
+	buckets = mergeSmallSegments([]*SegmentInfo{newSegment(1, 40), newSegment(2, 10), newSegment(3, 45), newSegment(4, 30)})
+	assert.EqualValues(t, 1, len(buckets))
+	// 10 + 30 + 40 fit in one segment, 45 is left alone
+	ids := make([]UniqueID, 0, len(buckets[0]))
+	for _, segment := range buckets[0] {
+		ids = append(ids, segment.GetID())
+	}
+	assert.ElementsMatch(t, []UniqueID{1, 2, 4}, ids)
+}
+
+func TestGetCompactTime(t *testing.T) {
+	Params.Init()
+	tt, err := getCompactTime(context.TODO(), newMockAllocator())
+	assert.Nil(t, err)
+	physical, _ := tsoutil.ParseTS(tt.time)
+	assert.WithinDuration(t, time.Now().Add(-Params.RetentionDuration), physical, time.Minute)
+
+	_, err = getCompactTime(context.TODO(), &FailsAllocator{})
+	assert.NotNil(t, err)
+}
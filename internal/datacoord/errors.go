@@ -22,6 +22,9 @@ var errNilKvClient = errors.New("kv client not initialized")
 // serverNotServingErrMsg used for Status Reason when datacoord is not healthy
 const serverNotServingErrMsg = "server is not serving"
 
+// compactionNotEnabledErrMsg used for Status Reason when compaction is disabled
+const compactionNotEnabledErrMsg = "compaction is not enabled"
+
 // errors for VerifyResponse
 var errNilResponse = errors.New("response is nil")
 var errNilStatusResponse = errors.New("response has nil status")
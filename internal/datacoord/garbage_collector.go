@@ -0,0 +1,353 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/logutil"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	gcTypeInsert = "insert"
+	gcTypeStats  = "stats"
+	gcTypeDelta  = "delta"
+
+	gcStatusRemoved = "removed"
+	gcStatusDryRun  = "dryrun"
+	gcStatusFailed  = "failed"
+
+	gcRootCoordTimeout = 5 * time.Second
+)
+
+// gcStorage is the object storage abstraction used by garbage collector
+type gcStorage interface {
+	// ListObjects lists all the objects with prefix recursively, with their last modified time
+	ListObjects(prefix string) ([]string, []time.Time, error)
+	Remove(key string) error
+}
+
+// GcOption garbage collection options
+type GcOption struct {
+	cli               gcStorage     // client of object storage
+	enabled           bool          // enable switch
+	dryRun            bool          // only log and count the orphaned files, do not remove them
+	checkInterval     time.Duration // each interval
+	missingTolerance  time.Duration // key missing in meta tolerance time
+	retentionDuration time.Duration // keep the segments of collections and partitions dropped within it
+
+	insertRootPath string
+	statsRootPath  string
+	deltaRootPath  string
+}
+
+// garbageCollector handles the garbage files in object storage
+// which could be dropped collections, dropped partitions, failed flushes or compacted segments
+type garbageCollector struct {
+	option    GcOption
+	meta      *meta
+	rootCoord types.RootCoord
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+	closeCh   chan struct{}
+}
+
+// newGarbageCollector create garbage collector with meta and option
+func newGarbageCollector(meta *meta, rootCoord types.RootCoord, opt GcOption) *garbageCollector {
+	log.Info("GC with option", zap.Bool("enabled", opt.enabled), zap.Bool("dryRun", opt.dryRun),
+		zap.Duration("interval", opt.checkInterval), zap.Duration("missingTolerance", opt.missingTolerance))
+	return &garbageCollector{
+		meta:      meta,
+		rootCoord: rootCoord,
+		option:    opt,
+		closeCh:   make(chan struct{}),
+	}
+}
+
+// start a goroutine and perform gc check every `checkInterval`
+func (gc *garbageCollector) start() {
+	if gc.option.enabled {
+		if gc.option.cli == nil {
+			log.Warn("DataCoord gc enabled, but storage client is nil, gc will not start")
+			return
+		}
+		gc.startOnce.Do(func() {
+			gc.wg.Add(1)
+			go gc.work()
+		})
+	}
+}
+
+// work contains actual looping check logic
+func (gc *garbageCollector) work() {
+	defer logutil.LogPanic()
+	defer gc.wg.Done()
+	ticker := time.NewTicker(gc.option.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			gc.recycleDroppedSegments()
+			gc.scan()
+		case <-gc.closeCh:
+			log.Warn("garbage collector quit")
+			return
+		}
+	}
+}
+
+func (gc *garbageCollector) close() {
+	gc.stopOnce.Do(func() {
+		close(gc.closeCh)
+		gc.wg.Wait()
+	})
+}
+
+// recycleDroppedSegments drops the flushed segments of dropped collections and partitions from meta,
+// their binlogs become orphaned and are removed by the following scans
+func (gc *garbageCollector) recycleDroppedSegments() {
+	if gc.rootCoord == nil {
+		return
+	}
+	segments := gc.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetState() == commonpb.SegmentState_Flushed
+	})
+	if len(segments) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), gcRootCoordTimeout)
+	defer cancel()
+	// search and query may travel back within the retention duration, so the segments are kept
+	// as long as their collection and partition existed at the retention cutoff
+	snapshots := make([]*collectionSnapshot, 0, 2)
+	timestamps := []Timestamp{0}
+	if gc.option.retentionDuration > 0 {
+		timestamps = append(timestamps, tsoutil.ComposeTSByTime(time.Now().Add(-gc.option.retentionDuration), 0))
+	}
+	for _, ts := range timestamps {
+		snapshot, err := gc.newCollectionSnapshot(ctx, ts)
+		if err != nil {
+			log.Warn("failed to show collections for garbage collection", zap.Uint64("timestamp", ts), zap.Error(err))
+			return
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	for _, segment := range segments {
+		exist := false
+		for _, snapshot := range snapshots {
+			ok, err := snapshot.contains(ctx, segment)
+			if err != nil {
+				log.Warn("failed to show partitions for garbage collection", zap.Int64("collectionID", segment.GetCollectionID()),
+					zap.Uint64("timestamp", snapshot.ts), zap.Error(err))
+				return
+			}
+			if ok {
+				exist = true
+				break
+			}
+		}
+		if exist {
+			continue
+		}
+
+		log.Info("drop segment of dropped collection or partition", zap.Int64("collectionID", segment.GetCollectionID()),
+			zap.Int64("partitionID", segment.GetPartitionID()), zap.Int64("segmentID", segment.GetID()))
+		if gc.option.dryRun {
+			continue
+		}
+		if err := gc.meta.DropSegment(segment.GetID()); err != nil {
+			log.Warn("failed to drop segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		}
+	}
+}
+
+// collectionSnapshot is the collections and partitions existing at a timestamp, 0 means the latest
+type collectionSnapshot struct {
+	gc          *garbageCollector
+	ts          Timestamp
+	collections map[UniqueID]struct{}
+	partitions  map[UniqueID]map[UniqueID]struct{} // partitions of existing collections, loaded lazily
+}
+
+func (gc *garbageCollector) newCollectionSnapshot(ctx context.Context, ts Timestamp) (*collectionSnapshot, error) {
+	collections, err := gc.listCollections(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+	return &collectionSnapshot{
+		gc:          gc,
+		ts:          ts,
+		collections: collections,
+		partitions:  make(map[UniqueID]map[UniqueID]struct{}),
+	}, nil
+}
+
+// contains reports whether the collection and partition of the segment exist in the snapshot
+func (s *collectionSnapshot) contains(ctx context.Context, segment *SegmentInfo) (bool, error) {
+	collectionID := segment.GetCollectionID()
+	if _, ok := s.collections[collectionID]; !ok {
+		return false, nil
+	}
+	parts, ok := s.partitions[collectionID]
+	if !ok {
+		resp, err := s.gc.rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_ShowPartitions,
+				SourceID: Params.NodeID,
+			},
+			DbName:       "",
+			CollectionID: collectionID,
+			TimeStamp:    s.ts,
+		})
+		if err = VerifyResponse(resp, err); err != nil {
+			return false, err
+		}
+		parts = make(map[UniqueID]struct{}, len(resp.GetPartitionIDs()))
+		for _, partitionID := range resp.GetPartitionIDs() {
+			parts[partitionID] = struct{}{}
+		}
+		s.partitions[collectionID] = parts
+	}
+	_, ok = parts[segment.GetPartitionID()]
+	return ok, nil
+}
+
+// listCollections returns the ids of the collections in all the databases at the timestamp, 0 means the latest
+func (gc *garbageCollector) listCollections(ctx context.Context, ts Timestamp) (map[UniqueID]struct{}, error) {
+	dbResp, err := gc.rootCoord.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ListDatabases,
+			SourceID: Params.NodeID,
+		},
+	})
+	if err = VerifyResponse(dbResp, err); err != nil {
+		return nil, err
+	}
+	collections := make(map[UniqueID]struct{})
+	for _, dbName := range dbResp.GetDbNames() {
+		resp, err := gc.rootCoord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_ShowCollections,
+				SourceID: Params.NodeID,
+			},
+			DbName:    dbName,
+			TimeStamp: ts,
+		})
+		if err = VerifyResponse(resp, err); err != nil {
+			return nil, err
+		}
+		for _, collectionID := range resp.GetCollectionIds() {
+			collections[collectionID] = struct{}{}
+		}
+	}
+	return collections, nil
+}
+
+// scan lists the binlogs in object storage and removes the ones not referenced by meta
+func (gc *garbageCollector) scan() {
+	insertKeys, insertModTimes, err := gc.option.cli.ListObjects(gc.option.insertRootPath + "/")
+	if err != nil {
+		log.Warn("failed to list insert binlogs", zap.Error(err))
+		return
+	}
+	statsKeys, statsModTimes, err := gc.option.cli.ListObjects(gc.option.statsRootPath + "/")
+	if err != nil {
+		log.Warn("failed to list stats binlogs", zap.Error(err))
+		return
+	}
+	deltaKeys, deltaModTimes, err := gc.option.cli.ListObjects(gc.option.deltaRootPath + "/")
+	if err != nil {
+		log.Warn("failed to list delta logs", zap.Error(err))
+		return
+	}
+
+	// meta is loaded after listing, so the files referenced by the latest meta are always kept
+	insertLogs, deltaLogs := gc.validLogs()
+
+	gc.recycle(gcTypeInsert, insertKeys, insertModTimes, func(key string) bool {
+		_, ok := insertLogs[key]
+		return ok
+	})
+	// stats binlog shares the same key with the insert binlog of the same field
+	gc.recycle(gcTypeStats, statsKeys, statsModTimes, func(key string) bool {
+		if !strings.HasPrefix(key, gc.option.statsRootPath) {
+			return false
+		}
+		_, ok := insertLogs[gc.option.insertRootPath+strings.TrimPrefix(key, gc.option.statsRootPath)]
+		return ok
+	})
+	gc.recycle(gcTypeDelta, deltaKeys, deltaModTimes, func(key string) bool {
+		_, ok := deltaLogs[key]
+		return ok
+	})
+}
+
+// validLogs returns the binlog paths and delta log paths of all the segments in meta
+func (gc *garbageCollector) validLogs() (map[string]struct{}, map[string]struct{}) {
+	insertLogs := make(map[string]struct{})
+	deltaLogs := make(map[string]struct{})
+	segments := gc.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return true
+	})
+	for _, segment := range segments {
+		for _, fieldBinlog := range segment.GetBinlogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				insertLogs[binlog] = struct{}{}
+			}
+		}
+		for _, deltaLog := range segment.GetDeltalogs() {
+			deltaLogs[deltaLog.GetDeltaLogPath()] = struct{}{}
+		}
+	}
+	return insertLogs, deltaLogs
+}
+
+// recycle removes the keys not referenced and not modified within the missing tolerance
+func (gc *garbageCollector) recycle(logType string, keys []string, modTimes []time.Time, referenced func(key string) bool) {
+	now := time.Now()
+	for i, key := range keys {
+		if referenced(key) {
+			continue
+		}
+		// the file may be written but not yet saved to meta
+		if now.Sub(modTimes[i]) < gc.option.missingTolerance {
+			continue
+		}
+		if gc.option.dryRun {
+			log.Info("orphaned file found (dry run)", zap.String("type", logType), zap.String("key", key))
+			metrics.DataCoordGarbageCollectedFilesCounter.WithLabelValues(logType, gcStatusDryRun).Inc()
+			continue
+		}
+		if err := gc.option.cli.Remove(key); err != nil {
+			log.Warn("failed to remove orphaned file", zap.String("type", logType), zap.String("key", key), zap.Error(err))
+			metrics.DataCoordGarbageCollectedFilesCounter.WithLabelValues(logType, gcStatusFailed).Inc()
+			continue
+		}
+		log.Debug("orphaned file removed", zap.String("type", logType), zap.String("key", key))
+		metrics.DataCoordGarbageCollectedFilesCounter.WithLabelValues(logType, gcStatusRemoved).Inc()
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockGcStorage struct {
+	sync.Mutex
+	objects map[string]time.Time
+	listErr error
+}
+
+func newMockGcStorage() *mockGcStorage {
+	return &mockGcStorage{objects: make(map[string]time.Time)}
+}
+
+func (s *mockGcStorage) ListObjects(prefix string) ([]string, []time.Time, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.listErr != nil {
+		return nil, nil, s.listErr
+	}
+	var keys []string
+	var modTimes []time.Time
+	for key, modTime := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+			modTimes = append(modTimes, modTime)
+		}
+	}
+	return keys, modTimes, nil
+}
+
+func (s *mockGcStorage) Remove(key string) error {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.objects, key)
+	return nil
+}
+
+type gcRootCoord struct {
+	mockRootCoordService
+	// database name to collection ids
+	databases   map[string][]UniqueID
+	collections map[UniqueID][]UniqueID
+	// the same as above, but returned for the requests with a timestamp
+	oldDatabases   map[string][]UniqueID
+	oldCollections map[UniqueID][]UniqueID
+}
+
+func (m *gcRootCoord) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	resp := &milvuspb.ListDatabasesResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	for dbName := range m.databases {
+		resp.DbNames = append(resp.DbNames, dbName)
+	}
+	return resp, nil
+}
+
+func (m *gcRootCoord) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	databases := m.databases
+	if req.GetTimeStamp() != 0 {
+		databases = m.oldDatabases
+	}
+	return &milvuspb.ShowCollectionsResponse{
+		Status:        &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		CollectionIds: databases[req.GetDbName()],
+	}, nil
+}
+
+func (m *gcRootCoord) ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	collections := m.collections
+	if req.GetTimeStamp() != 0 {
+		collections = m.oldCollections
+	}
+	return &milvuspb.ShowPartitionsResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		PartitionIDs: collections[req.GetCollectionID()],
+	}, nil
+}
+
+func newTestGcOption(cli gcStorage) GcOption {
+	return GcOption{
+		cli:              cli,
+		enabled:          true,
+		checkInterval:    time.Millisecond * 10,
+		missingTolerance: time.Hour,
+		insertRootPath:   "files/insert_log",
+		statsRootPath:    "files/stats_log",
+		deltaRootPath:    "files/delta_log",
+	}
+}
+
+func TestGarbageCollector_scan(t *testing.T) {
+	old := time.Now().Add(-2 * time.Hour)
+	recent := time.Now()
+	newStorage := func() *mockGcStorage {
+		cli := newMockGcStorage()
+		// referenced by meta
+		cli.objects["files/insert_log/1/1/1/100/1"] = old
+		cli.objects["files/stats_log/1/1/1/100/1"] = old
+		cli.objects["files/delta_log/1/1/1/1"] = old
+		// orphaned
+		cli.objects["files/insert_log/1/1/2/100/2"] = old
+		cli.objects["files/stats_log/1/1/2/100/2"] = old
+		cli.objects["files/delta_log/1/1/2/2"] = old
+		// orphaned but within missing tolerance
+		cli.objects["files/insert_log/1/1/3/100/3"] = recent
+		// not binlogs
+		cli.objects["files/index_files/1/1/1/1/1"] = old
+		return cli
+	}
+	newMeta := func() *meta {
+		return newCompactionTestMeta(t, &datapb.SegmentInfo{
+			ID:           1,
+			CollectionID: 1,
+			PartitionID:  1,
+			State:        commonpb.SegmentState_Flushed,
+			Binlogs:      []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []string{"files/insert_log/1/1/1/100/1"}}},
+			Deltalogs:    []*datapb.DeltaLogInfo{{DeltaLogPath: "files/delta_log/1/1/1/1"}},
+		})
+	}
+
+	t.Run("remove orphaned files", func(t *testing.T) {
+		cli := newStorage()
+		gc := newGarbageCollector(newMeta(), nil, newTestGcOption(cli))
+		gc.scan()
+
+		keys := make([]string, 0, len(cli.objects))
+		for key := range cli.objects {
+			keys = append(keys, key)
+		}
+		assert.ElementsMatch(t, []string{
+			"files/insert_log/1/1/1/100/1",
+			"files/stats_log/1/1/1/100/1",
+			"files/delta_log/1/1/1/1",
+			"files/insert_log/1/1/3/100/3",
+			"files/index_files/1/1/1/1/1",
+		}, keys)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		cli := newStorage()
+		opt := newTestGcOption(cli)
+		opt.dryRun = true
+		gc := newGarbageCollector(newMeta(), nil, opt)
+		gc.scan()
+		assert.Equal(t, 8, len(cli.objects))
+	})
+
+	t.Run("list failed", func(t *testing.T) {
+		cli := newStorage()
+		cli.listErr = errors.New("mocked")
+		gc := newGarbageCollector(newMeta(), nil, newTestGcOption(cli))
+		gc.scan()
+		cli.listErr = nil
+		assert.Equal(t, 8, len(cli.objects))
+	})
+}
+
+func TestGarbageCollector_recycleDroppedSegments(t *testing.T) {
+	newMeta := func() *meta {
+		return newCompactionTestMeta(t,
+			flushedSegment(1, 1, 1, "ch1", 10, 0),
+			flushedSegment(2, 1, 2, "ch1", 10, 0),
+			flushedSegment(3, 2, 1, "ch1", 10, 0),
+			&datapb.SegmentInfo{ID: 4, CollectionID: 2, PartitionID: 1, State: commonpb.SegmentState_Growing},
+			flushedSegment(5, 3, 1, "ch2", 10, 0),
+		)
+	}
+	// partition 2 of collection 1 and collection 2 are dropped, collection 3 is in another database
+	rootCoord := &gcRootCoord{
+		databases:   map[string][]UniqueID{common.DefaultDatabaseName: {1}, "db1": {3}},
+		collections: map[UniqueID][]UniqueID{1: {1}, 3: {1}},
+	}
+
+	t.Run("drop segments", func(t *testing.T) {
+		meta := newMeta()
+		gc := newGarbageCollector(meta, rootCoord, newTestGcOption(newMockGcStorage()))
+		gc.recycleDroppedSegments()
+		assert.NotNil(t, meta.GetSegment(1))
+		assert.NotNil(t, meta.GetSegment(5))
+		assert.Nil(t, meta.GetSegment(2))
+		assert.Nil(t, meta.GetSegment(3))
+		// growing segments are left to the flush procedure
+		assert.NotNil(t, meta.GetSegment(4))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		meta := newMeta()
+		opt := newTestGcOption(newMockGcStorage())
+		opt.dryRun = true
+		gc := newGarbageCollector(meta, rootCoord, opt)
+		gc.recycleDroppedSegments()
+		assert.NotNil(t, meta.GetSegment(2))
+		assert.NotNil(t, meta.GetSegment(3))
+	})
+
+	t.Run("keep segments within retention", func(t *testing.T) {
+		meta := newMeta()
+		opt := newTestGcOption(newMockGcStorage())
+		opt.retentionDuration = time.Hour
+		// collection 2 was dropped within the retention duration, partition 2 of collection 1 before it
+		rootCoord := &gcRootCoord{
+			databases:      rootCoord.databases,
+			collections:    rootCoord.collections,
+			oldDatabases:   map[string][]UniqueID{common.DefaultDatabaseName: {1, 2}},
+			oldCollections: map[UniqueID][]UniqueID{1: {1}, 2: {1}},
+		}
+		gc := newGarbageCollector(meta, rootCoord, opt)
+		gc.recycleDroppedSegments()
+		assert.NotNil(t, meta.GetSegment(1))
+		assert.NotNil(t, meta.GetSegment(3))
+		assert.NotNil(t, meta.GetSegment(5))
+		assert.Nil(t, meta.GetSegment(2))
+	})
+}
+
+func TestGarbageCollector_startAndClose(t *testing.T) {
+	cli := newMockGcStorage()
+	cli.objects["files/insert_log/1/1/2/100/2"] = time.Now().Add(-2 * time.Hour)
+	gc := newGarbageCollector(newCompactionTestMeta(t), nil, newTestGcOption(cli))
+	gc.start()
+	assert.Eventually(t, func() bool {
+		keys, _, _ := cli.ListObjects("files/")
+		return len(keys) == 0
+	}, time.Second, 10*time.Millisecond)
+	gc.close()
+
+	// start with nil client is no-op
+	opt := newTestGcOption(nil)
+	gc = newGarbageCollector(newCompactionTestMeta(t), nil, opt)
+	gc.start()
+	gc.close()
+}
@@ -0,0 +1,272 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+const (
+	importTaskPrefix      = metaPrefix + "/import-task"
+	importCheckInterval   = 5 * time.Second
+	importDispatchTimeout = 5 * time.Second
+)
+
+// importExecutor sends import tasks to the data nodes
+type importExecutor interface {
+	// Import sends the task to one of the alive data nodes, returns the id of the node accepting it
+	Import(ctx context.Context, task *datapb.ImportTaskInfo) (UniqueID, error)
+	GetNodes() []*NodeInfo
+}
+
+var _ importExecutor = (*Cluster)(nil)
+
+// importManager manages the life cycle of bulk import tasks,
+// tasks are persisted in kv so that they survive data coord restarts
+type importManager struct {
+	mu        sync.RWMutex
+	tasks     map[UniqueID]*datapb.ImportTaskInfo // taskID -> task
+	kv        kv.TxnKV
+	meta      *meta
+	executor  importExecutor
+	allocator allocator
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newImportManager creates the import manager and reloads the tasks from kv
+func newImportManager(kv kv.TxnKV, meta *meta, executor importExecutor, allocator allocator) (*importManager, error) {
+	m := &importManager{
+		tasks:     make(map[UniqueID]*datapb.ImportTaskInfo),
+		kv:        kv,
+		meta:      meta,
+		executor:  executor,
+		allocator: allocator,
+	}
+	if err := m.loadFromKV(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *importManager) loadFromKV() error {
+	_, values, err := m.kv.LoadWithPrefix(importTaskPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		task := &datapb.ImportTaskInfo{}
+		if err := proto.Unmarshal([]byte(value), task); err != nil {
+			return fmt.Errorf("importManager unmarshal task failed: %w", err)
+		}
+		m.tasks[task.GetTaskID()] = task
+	}
+	return nil
+}
+
+// start a goroutine dispatching the pending tasks and checking the started ones every `importCheckInterval`
+func (m *importManager) start() {
+	m.quit = make(chan struct{})
+	m.wg.Add(1)
+
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(importCheckInterval)
+		defer ticker.Stop()
+		log.Info("import manager start")
+		for {
+			select {
+			case <-m.quit:
+				log.Info("import manager quit")
+				return
+			case <-ticker.C:
+				m.checkTasks()
+				m.dispatchTasks()
+			}
+		}
+	}()
+}
+
+func (m *importManager) stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+// createTask persists a new pending task of the request and tries to dispatch it
+func (m *importManager) createTask(ctx context.Context, req *datapb.ImportTask) (UniqueID, error) {
+	taskID, err := m.allocator.allocID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// rows imported are visible to the queries after this timestamp
+	ts, err := m.allocator.allocTimestamp(ctx)
+	if err != nil {
+		return 0, err
+	}
+	task := &datapb.ImportTaskInfo{
+		TaskID:       taskID,
+		CollectionID: req.GetCollectionID(),
+		PartitionID:  req.GetPartitionID(),
+		ChannelNames: req.GetChannelNames(),
+		Files:        req.GetFiles(),
+		Timestamp:    ts,
+		State:        commonpb.ImportState_ImportPending,
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.saveTask(task); err != nil {
+		return 0, err
+	}
+	m.tasks[taskID] = task
+	log.Info("import task created", zap.Int64("taskID", taskID), zap.Int64("collectionID", task.GetCollectionID()),
+		zap.Int64("partitionID", task.GetPartitionID()), zap.Strings("files", task.GetFiles()))
+	// a failed dispatch is retried by the background loop
+	m.dispatch(ctx, task)
+	return taskID, nil
+}
+
+// dispatchTasks dispatches all the pending tasks
+func (m *importManager) dispatchTasks() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, task := range m.tasks {
+		if task.GetState() != commonpb.ImportState_ImportPending {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), importDispatchTimeout)
+		m.dispatch(ctx, task)
+		cancel()
+	}
+}
+
+// dispatch sends the pending task to a data node, the caller must hold the lock
+func (m *importManager) dispatch(ctx context.Context, task *datapb.ImportTaskInfo) {
+	nodeID, err := m.executor.Import(ctx, task)
+	if err != nil {
+		log.Warn("failed to dispatch import task", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+		return
+	}
+	started := proto.Clone(task).(*datapb.ImportTaskInfo)
+	started.DatanodeID = nodeID
+	started.State = commonpb.ImportState_ImportStarted
+	if err := m.saveTask(started); err != nil {
+		// the task is reported or found lost later
+		log.Warn("failed to save started import task", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+	}
+	m.tasks[task.GetTaskID()] = started
+	log.Info("import task dispatched", zap.Int64("taskID", task.GetTaskID()), zap.Int64("nodeID", nodeID))
+}
+
+// checkTasks fails the started tasks whose data node is no longer alive
+func (m *importManager) checkTasks() {
+	alive := make(map[UniqueID]struct{})
+	for _, node := range m.executor.GetNodes() {
+		alive[node.Info.GetVersion()] = struct{}{}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, task := range m.tasks {
+		if task.GetState() != commonpb.ImportState_ImportStarted {
+			continue
+		}
+		if _, ok := alive[task.GetDatanodeID()]; ok {
+			continue
+		}
+		log.Warn("data node of import task is lost", zap.Int64("taskID", task.GetTaskID()),
+			zap.Int64("nodeID", task.GetDatanodeID()))
+		failed := proto.Clone(task).(*datapb.ImportTaskInfo)
+		failed.State = commonpb.ImportState_ImportFailed
+		failed.FailedReason = fmt.Sprintf("data node %d is lost", task.GetDatanodeID())
+		if err := m.saveTask(failed); err != nil {
+			log.Warn("failed to save failed import task", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+			continue
+		}
+		m.tasks[task.GetTaskID()] = failed
+	}
+}
+
+// reportImport records the result of an import task reported by the data node
+func (m *importManager) reportImport(result *datapb.ImportResult) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	task, ok := m.tasks[result.GetTaskID()]
+	if !ok {
+		return fmt.Errorf("import task %d not found", result.GetTaskID())
+	}
+	if task.GetState() != commonpb.ImportState_ImportStarted {
+		return fmt.Errorf("import task %d is not started, state: %s", result.GetTaskID(), task.GetState().String())
+	}
+
+	updated := proto.Clone(task).(*datapb.ImportTaskInfo)
+	updated.State = result.GetState()
+	updated.RowCount = result.GetRowCount()
+	updated.SegmentIDs = result.GetSegmentIDs()
+	if result.GetState() == commonpb.ImportState_ImportFailed {
+		updated.FailedReason = result.GetStatus().GetReason()
+		m.dropImportingSegments(result.GetSegmentIDs())
+	}
+	if err := m.saveTask(updated); err != nil {
+		return err
+	}
+	m.tasks[result.GetTaskID()] = updated
+	log.Info("import task reported", zap.Int64("taskID", result.GetTaskID()), zap.String("state", result.GetState().String()),
+		zap.Int64("rowCount", result.GetRowCount()), zap.Int64s("segmentIDs", result.GetSegmentIDs()))
+	return nil
+}
+
+// dropImportingSegments drops the segments of a failed task which are not flushed yet
+func (m *importManager) dropImportingSegments(segmentIDs []UniqueID) {
+	for _, segmentID := range segmentIDs {
+		segment := m.meta.GetSegment(segmentID)
+		if segment == nil || !segment.GetIsImporting() || segment.GetState() != commonpb.SegmentState_Growing {
+			continue
+		}
+		if err := m.meta.DropSegment(segmentID); err != nil {
+			log.Warn("failed to drop importing segment", zap.Int64("segmentID", segmentID), zap.Error(err))
+		}
+	}
+}
+
+// getTask returns a copy of the task, nil if the task does not exist
+func (m *importManager) getTask(taskID UniqueID) *datapb.ImportTaskInfo {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	task, ok := m.tasks[taskID]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(task).(*datapb.ImportTaskInfo)
+}
+
+func (m *importManager) saveTask(task *datapb.ImportTaskInfo) error {
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return m.kv.Save(path.Join(importTaskPrefix, strconv.FormatInt(task.GetTaskID(), 10)), string(value))
+}
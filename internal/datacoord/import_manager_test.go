@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockImportExecutor struct {
+	sync.Mutex
+	nodes []*NodeInfo
+	tasks []*datapb.ImportTaskInfo
+	err   error
+}
+
+func (e *mockImportExecutor) Import(ctx context.Context, task *datapb.ImportTaskInfo) (UniqueID, error) {
+	e.Lock()
+	defer e.Unlock()
+	if e.err != nil {
+		return 0, e.err
+	}
+	if len(e.nodes) == 0 {
+		return 0, errors.New("no data node is alive")
+	}
+	e.tasks = append(e.tasks, task)
+	return e.nodes[0].Info.GetVersion(), nil
+}
+
+func (e *mockImportExecutor) GetNodes() []*NodeInfo {
+	e.Lock()
+	defer e.Unlock()
+	return e.nodes
+}
+
+func newMockImportExecutor(nodeIDs ...UniqueID) *mockImportExecutor {
+	e := &mockImportExecutor{}
+	for _, id := range nodeIDs {
+		e.nodes = append(e.nodes, NewNodeInfo(context.TODO(), &datapb.DataNodeInfo{Version: id}))
+	}
+	return e
+}
+
+func newTestImportTask() *datapb.ImportTask {
+	return &datapb.ImportTask{
+		CollectionID: 1,
+		PartitionID:  2,
+		ChannelNames: []string{"ch1", "ch2"},
+		Files:        []string{"a.json", "b.npy"},
+	}
+}
+
+func TestImportManager_createTask(t *testing.T) {
+	t.Run("dispatched", func(t *testing.T) {
+		executor := newMockImportExecutor(10)
+		m, err := newImportManager(memkv.NewMemoryKV(), newCompactionTestMeta(t), executor, newMockAllocator())
+		assert.Nil(t, err)
+
+		taskID, err := m.createTask(context.TODO(), newTestImportTask())
+		assert.Nil(t, err)
+		task := m.getTask(taskID)
+		assert.NotNil(t, task)
+		assert.Equal(t, commonpb.ImportState_ImportStarted, task.GetState())
+		assert.EqualValues(t, 10, task.GetDatanodeID())
+		assert.ElementsMatch(t, []string{"a.json", "b.npy"}, task.GetFiles())
+		assert.NotZero(t, task.GetTimestamp())
+		assert.Equal(t, 1, len(executor.tasks))
+	})
+
+	t.Run("dispatch failed", func(t *testing.T) {
+		executor := newMockImportExecutor()
+		m, err := newImportManager(memkv.NewMemoryKV(), newCompactionTestMeta(t), executor, newMockAllocator())
+		assert.Nil(t, err)
+
+		taskID, err := m.createTask(context.TODO(), newTestImportTask())
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ImportState_ImportPending, m.getTask(taskID).GetState())
+
+		// retried once a node is up
+		executor.nodes = newMockImportExecutor(11).nodes
+		m.dispatchTasks()
+		task := m.getTask(taskID)
+		assert.Equal(t, commonpb.ImportState_ImportStarted, task.GetState())
+		assert.EqualValues(t, 11, task.GetDatanodeID())
+	})
+
+	t.Run("alloc failed", func(t *testing.T) {
+		m, err := newImportManager(memkv.NewMemoryKV(), newCompactionTestMeta(t), newMockImportExecutor(10), &FailsAllocator{})
+		assert.Nil(t, err)
+		_, err = m.createTask(context.TODO(), newTestImportTask())
+		assert.NotNil(t, err)
+	})
+}
+
+func TestImportManager_reload(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m, err := newImportManager(kv, newCompactionTestMeta(t), newMockImportExecutor(), newMockAllocator())
+	assert.Nil(t, err)
+	taskID, err := m.createTask(context.TODO(), newTestImportTask())
+	assert.Nil(t, err)
+
+	// a restarted manager dispatches the pending task
+	executor := newMockImportExecutor(10)
+	m, err = newImportManager(kv, newCompactionTestMeta(t), executor, newMockAllocator())
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportPending, m.getTask(taskID).GetState())
+	m.dispatchTasks()
+	assert.Equal(t, commonpb.ImportState_ImportStarted, m.getTask(taskID).GetState())
+	assert.Nil(t, m.getTask(taskID+100))
+}
+
+func TestImportManager_checkTasks(t *testing.T) {
+	executor := newMockImportExecutor(10)
+	m, err := newImportManager(memkv.NewMemoryKV(), newCompactionTestMeta(t), executor, newMockAllocator())
+	assert.Nil(t, err)
+	taskID, err := m.createTask(context.TODO(), newTestImportTask())
+	assert.Nil(t, err)
+
+	m.checkTasks()
+	assert.Equal(t, commonpb.ImportState_ImportStarted, m.getTask(taskID).GetState())
+
+	executor.nodes = nil
+	m.checkTasks()
+	task := m.getTask(taskID)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, task.GetState())
+	assert.NotEmpty(t, task.GetFailedReason())
+}
+
+func TestImportManager_reportImport(t *testing.T) {
+	newManager := func(meta *meta) (*importManager, UniqueID) {
+		m, err := newImportManager(memkv.NewMemoryKV(), meta, newMockImportExecutor(10), newMockAllocator())
+		assert.Nil(t, err)
+		taskID, err := m.createTask(context.TODO(), newTestImportTask())
+		assert.Nil(t, err)
+		return m, taskID
+	}
+
+	t.Run("completed", func(t *testing.T) {
+		m, taskID := newManager(newCompactionTestMeta(t))
+		err := m.reportImport(&datapb.ImportResult{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			TaskID:     taskID,
+			State:      commonpb.ImportState_ImportCompleted,
+			RowCount:   100,
+			SegmentIDs: []UniqueID{1, 2},
+		})
+		assert.Nil(t, err)
+		task := m.getTask(taskID)
+		assert.Equal(t, commonpb.ImportState_ImportCompleted, task.GetState())
+		assert.EqualValues(t, 100, task.GetRowCount())
+		assert.ElementsMatch(t, []UniqueID{1, 2}, task.GetSegmentIDs())
+
+		// reported twice
+		err = m.reportImport(&datapb.ImportResult{TaskID: taskID, State: commonpb.ImportState_ImportCompleted})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		meta := newCompactionTestMeta(t,
+			&datapb.SegmentInfo{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Growing, IsImporting: true},
+			&datapb.SegmentInfo{ID: 2, CollectionID: 1, State: commonpb.SegmentState_Flushed, IsImporting: true},
+			&datapb.SegmentInfo{ID: 3, CollectionID: 1, State: commonpb.SegmentState_Growing},
+		)
+		m, taskID := newManager(meta)
+		err := m.reportImport(&datapb.ImportResult{
+			Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "bad file"},
+			TaskID:     taskID,
+			State:      commonpb.ImportState_ImportFailed,
+			SegmentIDs: []UniqueID{1, 2, 3},
+		})
+		assert.Nil(t, err)
+		task := m.getTask(taskID)
+		assert.Equal(t, commonpb.ImportState_ImportFailed, task.GetState())
+		assert.Equal(t, "bad file", task.GetFailedReason())
+		// only the importing segments not flushed are dropped
+		assert.Nil(t, meta.GetSegment(1))
+		assert.NotNil(t, meta.GetSegment(2))
+		assert.NotNil(t, meta.GetSegment(3))
+	})
+
+	t.Run("task not found", func(t *testing.T) {
+		m, taskID := newManager(newCompactionTestMeta(t))
+		err := m.reportImport(&datapb.ImportResult{TaskID: taskID + 100, State: commonpb.ImportState_ImportCompleted})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestImportManager_startAndStop(t *testing.T) {
+	m, err := newImportManager(memkv.NewMemoryKV(), newCompactionTestMeta(t), newMockImportExecutor(), newMockAllocator())
+	assert.Nil(t, err)
+	m.start()
+	m.stop()
+}
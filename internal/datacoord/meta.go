@@ -23,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 const (
@@ -153,7 +154,7 @@ func (m *meta) SetState(segmentID UniqueID, state commonpb.SegmentState) error {
 // `binlogs`, `checkpoints` and `statPositions` are persistence data for segment
 func (m *meta) UpdateFlushSegmentsInfo(segmentID UniqueID, flushed bool,
 	binlogs []*datapb.FieldBinlog, checkpoints []*datapb.CheckPoint,
-	startPositions []*datapb.SegmentStartPosition) error {
+	startPositions []*datapb.SegmentStartPosition, deltalogs []*datapb.DeltaLogInfo) error {
 	m.Lock()
 	defer m.Unlock()
 
@@ -190,6 +191,10 @@ func (m *meta) UpdateFlushSegmentsInfo(segmentID UniqueID, flushed bool,
 	m.segments.SetBinlogs(segmentID, currBinlogs)
 	modSegments[segmentID] = struct{}{}
 
+	if len(deltalogs) > 0 {
+		m.segments.AddDeltalogs(segmentID, deltalogs)
+	}
+
 	for _, pos := range startPositions {
 		if len(pos.GetStartPosition().GetMsgID()) == 0 {
 			continue
@@ -382,6 +387,134 @@ func (m *meta) MoveSegmentBinlogs(segmentID UniqueID, oldPathPrefix string, fiel
 	return m.client.MultiSaveAndRemoveWithPrefix(kv, removals)
 }
 
+// SelectSegments select segments with selector
+func (m *meta) SelectSegments(selector SegmentInfoSelector) []*SegmentInfo {
+	m.RLock()
+	defer m.RUnlock()
+	var ret []*SegmentInfo
+	segments := m.segments.GetSegments()
+	for _, info := range segments {
+		if selector(info) {
+			ret = append(ret, info)
+		}
+	}
+	return ret
+}
+
+// SetSegmentCompacting set compaction state for segment
+// Note that isCompacting is not persisted in KV store
+func (m *meta) SetSegmentCompacting(segmentID UniqueID, compacting bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.segments.SetIsCompacting(segmentID, compacting)
+}
+
+// CompleteCompaction replaces the segments compacted from with the segment compacted to, the segment
+// infos are swapped in a single kv transaction so that the segments are always visible exactly once.
+// Delta logs persisted to the compacted from segments after the plan is generated are carried over.
+// If all the rows are deleted by compaction, the compacted from segments are simply removed.
+func (m *meta) CompleteCompaction(compactionLogs []*datapb.CompactionSegmentBinlogs, result *datapb.CompactionResult) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if len(compactionLogs) == 0 {
+		return fmt.Errorf("no segment compacted in plan %d", result.GetPlanID())
+	}
+
+	segments := make([]*SegmentInfo, 0, len(compactionLogs))
+	for _, cl := range compactionLogs {
+		segment := m.segments.GetSegment(cl.GetSegmentID())
+		if segment == nil {
+			return fmt.Errorf("segment %d compacted in plan %d not found", cl.GetSegmentID(), result.GetPlanID())
+		}
+		segments = append(segments, segment)
+	}
+
+	compactedDeltalogs := make(map[string]struct{})
+	for _, cl := range compactionLogs {
+		for _, deltalog := range cl.GetDeltalogs() {
+			compactedDeltalogs[deltalog.GetDeltaLogPath()] = struct{}{}
+		}
+	}
+
+	var (
+		compactionFrom = make([]UniqueID, 0, len(segments))
+		deltalogs      = result.GetDeltalogs()
+		maxRowNum      int64
+		startPosition  *internalpb.MsgPosition
+		dmlPosition    *internalpb.MsgPosition
+		lastExpireTime Timestamp
+	)
+	for _, segment := range segments {
+		compactionFrom = append(compactionFrom, segment.GetID())
+		for _, deltalog := range segment.GetDeltalogs() {
+			if _, ok := compactedDeltalogs[deltalog.GetDeltaLogPath()]; !ok {
+				deltalogs = append(deltalogs, deltalog)
+			}
+		}
+		if segment.GetMaxRowNum() > maxRowNum {
+			maxRowNum = segment.GetMaxRowNum()
+		}
+		if segment.GetStartPosition() != nil &&
+			(startPosition == nil || segment.GetStartPosition().GetTimestamp() < startPosition.GetTimestamp()) {
+			startPosition = segment.GetStartPosition()
+		}
+		if segment.GetDmlPosition() != nil &&
+			(dmlPosition == nil || segment.GetDmlPosition().GetTimestamp() > dmlPosition.GetTimestamp()) {
+			dmlPosition = segment.GetDmlPosition()
+		}
+		if segment.GetLastExpireTime() > lastExpireTime {
+			lastExpireTime = segment.GetLastExpireTime()
+		}
+	}
+
+	removals := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		removals = append(removals, buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID()))
+	}
+
+	kv := make(map[string]string)
+	var compactedTo *SegmentInfo
+	if result.GetNumOfRows() > 0 {
+		compactedTo = NewSegmentInfo(&datapb.SegmentInfo{
+			ID:                  result.GetSegmentID(),
+			CollectionID:        segments[0].GetCollectionID(),
+			PartitionID:         segments[0].GetPartitionID(),
+			InsertChannel:       segments[0].GetInsertChannel(),
+			NumOfRows:           result.GetNumOfRows(),
+			State:               commonpb.SegmentState_Flushing,
+			MaxRowNum:           maxRowNum,
+			LastExpireTime:      lastExpireTime,
+			StartPosition:       startPosition,
+			DmlPosition:         dmlPosition,
+			Binlogs:             result.GetInsertLogs(),
+			Deltalogs:           deltalogs,
+			CreatedByCompaction: true,
+			CompactionFrom:      compactionFrom,
+		})
+		segBytes, err := proto.Marshal(compactedTo.SegmentInfo)
+		if err != nil {
+			log.Error("DataCoord CompleteCompaction marshal failed", zap.Int64("segmentID", compactedTo.GetID()), zap.Error(err))
+			return fmt.Errorf("DataCoord CompleteCompaction segmentID:%d, marshal failed:%w", compactedTo.GetID(), err)
+		}
+		key := buildSegmentPath(compactedTo.GetCollectionID(), compactedTo.GetPartitionID(), compactedTo.GetID())
+		kv[key] = string(segBytes)
+	}
+
+	if err := m.client.MultiSaveAndRemove(kv, removals); err != nil {
+		return err
+	}
+
+	for _, segment := range segments {
+		m.segments.DropSegment(segment.GetID())
+	}
+	if compactedTo != nil {
+		m.segments.SetSegment(compactedTo.GetID(), compactedTo)
+	}
+	return nil
+}
+
 // saveSegmentInfo utility function saving segment info into kv store
 func (m *meta) saveSegmentInfo(segment *SegmentInfo) error {
 	segBytes, err := proto.Marshal(segment.SegmentInfo)
@@ -422,3 +555,6 @@ func buildSegment(collectionID UniqueID, partitionID UniqueID, segmentID UniqueI
 	}
 	return NewSegmentInfo(info)
 }
+
+// SegmentInfoSelector is the function type to select SegmentInfo from meta
+type SegmentInfoSelector func(*SegmentInfo) bool
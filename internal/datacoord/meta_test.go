@@ -204,3 +204,65 @@ func TestGetUnFlushedSegments(t *testing.T) {
 	assert.EqualValues(t, 0, segments[0].ID)
 	assert.NotEqualValues(t, commonpb.SegmentState_Flushed, segments[0].State)
 }
+
+func TestMeta_CompleteCompaction(t *testing.T) {
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+
+	deltalog1 := &datapb.DeltaLogInfo{RecordEntries: 1, DeltaLogPath: "delta1"}
+	deltalog2 := &datapb.DeltaLogInfo{RecordEntries: 1, DeltaLogPath: "delta2"}
+	s1 := &datapb.SegmentInfo{
+		ID:             1,
+		CollectionID:   1,
+		PartitionID:    1,
+		InsertChannel:  "ch1",
+		NumOfRows:      10,
+		MaxRowNum:      100,
+		State:          commonpb.SegmentState_Flushed,
+		LastExpireTime: 20,
+		// deltalog2 is saved after the compaction plan generated
+		Deltalogs: []*datapb.DeltaLogInfo{deltalog1, deltalog2},
+	}
+	s2 := &datapb.SegmentInfo{
+		ID:             2,
+		CollectionID:   1,
+		PartitionID:    1,
+		InsertChannel:  "ch1",
+		NumOfRows:      10,
+		MaxRowNum:      200,
+		State:          commonpb.SegmentState_Flushed,
+		LastExpireTime: 10,
+	}
+	err = meta.AddSegment(NewSegmentInfo(s1))
+	assert.Nil(t, err)
+	err = meta.AddSegment(NewSegmentInfo(s2))
+	assert.Nil(t, err)
+
+	compactionLogs := []*datapb.CompactionSegmentBinlogs{
+		{SegmentID: 1, Deltalogs: []*datapb.DeltaLogInfo{deltalog1}},
+		{SegmentID: 2},
+	}
+
+	err = meta.CompleteCompaction(nil, &datapb.CompactionResult{PlanID: 1, SegmentID: 3, NumOfRows: 19})
+	assert.NotNil(t, err)
+	err = meta.CompleteCompaction([]*datapb.CompactionSegmentBinlogs{{SegmentID: 4}},
+		&datapb.CompactionResult{PlanID: 1, SegmentID: 3, NumOfRows: 19})
+	assert.NotNil(t, err)
+
+	err = meta.CompleteCompaction(compactionLogs, &datapb.CompactionResult{PlanID: 1, SegmentID: 3, NumOfRows: 19})
+	assert.Nil(t, err)
+	assert.Nil(t, meta.GetSegment(1))
+	assert.Nil(t, meta.GetSegment(2))
+
+	segment := meta.GetSegment(3)
+	assert.NotNil(t, segment)
+	assert.EqualValues(t, 19, segment.GetNumOfRows())
+	assert.EqualValues(t, 200, segment.GetMaxRowNum())
+	assert.EqualValues(t, 20, segment.GetLastExpireTime())
+	assert.Equal(t, "ch1", segment.GetInsertChannel())
+	assert.True(t, segment.GetCreatedByCompaction())
+	assert.ElementsMatch(t, []UniqueID{1, 2}, segment.GetCompactionFrom())
+	assert.EqualValues(t, 1, len(segment.GetDeltalogs()))
+	assert.Equal(t, "delta2", segment.GetDeltalogs()[0].GetDeltaLogPath())
+}
@@ -12,6 +12,8 @@
 package datacoord
 
 import (
+	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -40,6 +42,16 @@ type ParamTable struct {
 	// --- Rocksmq ---
 	RocksmqPath string
 
+	// --- MinIO ---
+	MinioAddress         string
+	MinioAccessKeyID     string
+	MinioSecretAccessKey string
+	MinioUseSSL          bool
+	MinioBucketName      string
+	InsertBinlogRootPath string
+	StatsBinlogRootPath  string
+	DeleteBinlogRootPath string
+
 	FlushStreamPosSubPath string
 	StatsStreamPosSubPath string
 
@@ -48,6 +60,17 @@ type ParamTable struct {
 	SegmentSealProportion   float64
 	SegAssignmentExpiration int64
 
+	// --- COMPACTION ---
+	EnableCompaction bool
+	// RetentionDuration keeps the data needed by time travel within it from compaction and gc
+	RetentionDuration time.Duration
+
+	// --- GC ---
+	EnableGarbageCollection bool
+	GCInterval              time.Duration
+	GCMissingTolerance      time.Duration
+	GCDryRun                bool
+
 	// --- Channels ---
 	ClusterChannelPrefix      string
 	InsertChannelPrefixName   string
@@ -82,10 +105,27 @@ func (p *ParamTable) Init() {
 	p.initPulsarAddress()
 	p.initRocksmqPath()
 
+	p.initMinioAddress()
+	p.initMinioAccessKeyID()
+	p.initMinioSecretAccessKey()
+	p.initMinioUseSSL()
+	p.initMinioBucketName()
+	p.initInsertBinlogRootPath()
+	p.initStatsBinlogRootPath()
+	p.initDeleteBinlogRootPath()
+
 	p.initSegmentMaxSize()
 	p.initSegmentSealProportion()
 	p.initSegAssignmentExpiration()
 
+	p.initEnableCompaction()
+	p.initRetentionDuration()
+
+	p.initEnableGarbageCollection()
+	p.initGCInterval()
+	p.initGCMissingTolerance()
+	p.initGCDryRun()
+
 	// Has to init global msgchannel prefix before other channel names
 	p.initClusterMsgChannelPrefix()
 	p.initInsertChannelPrefixName()
@@ -178,6 +218,30 @@ func (p *ParamTable) initSegmentSealProportion() {
 	p.SegmentSealProportion = p.ParseFloat("datacoord.segment.sealProportion")
 }
 
+func (p *ParamTable) initEnableCompaction() {
+	p.EnableCompaction = p.ParseBool("datacoord.compaction.enable", false)
+}
+
+func (p *ParamTable) initRetentionDuration() {
+	p.RetentionDuration = time.Duration(p.ParseInt64("common.retentionDuration")) * time.Second
+}
+
+func (p *ParamTable) initEnableGarbageCollection() {
+	p.EnableGarbageCollection = p.ParseBool("datacoord.gc.enable", false)
+}
+
+func (p *ParamTable) initGCInterval() {
+	p.GCInterval = time.Duration(p.ParseInt64("datacoord.gc.interval")) * time.Second
+}
+
+func (p *ParamTable) initGCMissingTolerance() {
+	p.GCMissingTolerance = time.Duration(p.ParseInt64("datacoord.gc.missingTolerance")) * time.Second
+}
+
+func (p *ParamTable) initGCDryRun() {
+	p.GCDryRun = p.ParseBool("datacoord.gc.dryRun", false)
+}
+
 func (p *ParamTable) initSegAssignmentExpiration() {
 	p.SegAssignmentExpiration = p.ParseInt64("datacoord.segment.assignmentExpiration")
 }
@@ -254,3 +318,72 @@ func (p *ParamTable) initStatsStreamPosSubPath() {
 	}
 	p.StatsStreamPosSubPath = subPath
 }
+
+// --- MinIO ---
+func (p *ParamTable) initMinioAddress() {
+	endpoint, err := p.Load("_MinioAddress")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioAddress = endpoint
+}
+
+func (p *ParamTable) initMinioAccessKeyID() {
+	keyID, err := p.Load("minio.accessKeyID")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioAccessKeyID = keyID
+}
+
+func (p *ParamTable) initMinioSecretAccessKey() {
+	key, err := p.Load("minio.secretAccessKey")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioSecretAccessKey = key
+}
+
+func (p *ParamTable) initMinioUseSSL() {
+	usessl, err := p.Load("minio.useSSL")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioUseSSL, err = strconv.ParseBool(usessl)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (p *ParamTable) initMinioBucketName() {
+	bucketName, err := p.Load("minio.bucketName")
+	if err != nil {
+		panic(err)
+	}
+	p.MinioBucketName = bucketName
+}
+
+// initInsertBinlogRootPath must be consistent with the one of datanode
+func (p *ParamTable) initInsertBinlogRootPath() {
+	rootPath, err := p.Load("minio.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.InsertBinlogRootPath = path.Join(rootPath, "insert_log")
+}
+
+func (p *ParamTable) initStatsBinlogRootPath() {
+	rootPath, err := p.Load("minio.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.StatsBinlogRootPath = path.Join(rootPath, "stats_log")
+}
+
+func (p *ParamTable) initDeleteBinlogRootPath() {
+	rootPath, err := p.Load("minio.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.DeleteBinlogRootPath = path.Join(rootPath, "delta_log")
+}
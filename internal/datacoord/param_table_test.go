@@ -12,7 +12,9 @@
 package datacoord
 
 import (
+	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -36,4 +38,13 @@ func TestParamTable(t *testing.T) {
 	assert.Equal(t, Params.DataCoordSubscriptionName, "by-dev-dataCoord")
 	t.Logf("data coord subscription channel = %s", Params.DataCoordSubscriptionName)
 
+	assert.True(t, Params.EnableGarbageCollection)
+	assert.Equal(t, 5*24*time.Hour, Params.RetentionDuration)
+	assert.Equal(t, time.Hour, Params.GCInterval)
+	assert.Equal(t, 24*time.Hour, Params.GCMissingTolerance)
+	assert.False(t, Params.GCDryRun)
+	assert.Equal(t, "insert_log", path.Base(Params.InsertBinlogRootPath))
+	assert.Equal(t, "stats_log", path.Base(Params.StatsBinlogRootPath))
+	assert.Equal(t, "delta_log", path.Base(Params.DeleteBinlogRootPath))
+
 }
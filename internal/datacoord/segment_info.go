@@ -20,6 +20,7 @@ type SegmentInfo struct {
 	currRows      int64
 	allocations   []*Allocation
 	lastFlushTime time.Time
+	isCompacting  bool
 }
 
 // NewSegmentInfo create `SegmentInfo` wrapper from `datapb.SegmentInfo`
@@ -138,6 +139,19 @@ func (s *SegmentsInfo) AddSegmentBinlogs(segmentID UniqueID, field2Binlogs map[U
 	}
 }
 
+func (s *SegmentsInfo) AddDeltalogs(segmentID UniqueID, deltalogs []*datapb.DeltaLogInfo) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = segment.Clone(addDeltalogs(deltalogs))
+	}
+}
+
+// SetIsCompacting sets compacting status for segment
+func (s *SegmentsInfo) SetIsCompacting(segmentID UniqueID, isCompacting bool) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = segment.ShadowClone(SetIsCompacting(isCompacting))
+	}
+}
+
 func (s *SegmentInfo) Clone(opts ...SegmentInfoOption) *SegmentInfo {
 	info := proto.Clone(s.SegmentInfo).(*datapb.SegmentInfo)
 	cloned := &SegmentInfo{
@@ -145,6 +159,7 @@ func (s *SegmentInfo) Clone(opts ...SegmentInfoOption) *SegmentInfo {
 		currRows:      s.currRows,
 		allocations:   s.allocations,
 		lastFlushTime: s.lastFlushTime,
+		isCompacting:  s.isCompacting,
 	}
 	for _, opt := range opts {
 		opt(cloned)
@@ -158,6 +173,7 @@ func (s *SegmentInfo) ShadowClone(opts ...SegmentInfoOption) *SegmentInfo {
 		currRows:      s.currRows,
 		allocations:   s.allocations,
 		lastFlushTime: s.lastFlushTime,
+		isCompacting:  s.isCompacting,
 	}
 
 	for _, opt := range opts {
@@ -251,3 +267,16 @@ func addSegmentBinlogs(field2Binlogs map[UniqueID][]string) SegmentInfoOption {
 		}
 	}
 }
+
+func addDeltalogs(deltalogs []*datapb.DeltaLogInfo) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.Deltalogs = append(segment.Deltalogs, deltalogs...)
+	}
+}
+
+// SetIsCompacting is the option to set compacting state for segment info
+func SetIsCompacting(isCompacting bool) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.isCompacting = isCompacting
+	}
+}
@@ -69,6 +69,8 @@ const segmentMaxLifetime = 24 * time.Hour
 type Manager interface {
 	// AllocSegment allocate rows and record the allocation.
 	AllocSegment(ctx context.Context, collectionID, partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error)
+	// AllocImportSegment allocate rows in a new segment dedicated to bulk import.
+	AllocImportSegment(ctx context.Context, collectionID, partitionID UniqueID, channelName string, requestRows int64) (*Allocation, error)
 	// DropSegment drop the segment from allocator.
 	DropSegment(ctx context.Context, segmentID UniqueID)
 	// SealAllSegments sealed all segmetns of collection with collectionID and return sealed segments
@@ -204,6 +206,10 @@ func (s *SegmentManager) loadSegmentsFromMeta() {
 	segments := s.meta.GetUnFlushedSegments()
 	segmentsID := make([]UniqueID, 0, len(segments))
 	for _, segment := range segments {
+		// importing segments are never shared with the inserts
+		if segment.GetIsImporting() {
+			continue
+		}
 		segmentsID = append(segmentsID, segment.GetID())
 	}
 	s.segments = segmentsID
@@ -268,6 +274,53 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	return allocations, nil
 }
 
+// AllocImportSegment opens a new segment for bulk import and allocates at most the max rows of segment in it,
+// the segment is not tracked by the manager so that no insert is assigned to it, and is flushed once the
+// binlogs of import are saved
+func (s *SegmentManager) AllocImportSegment(ctx context.Context, collectionID UniqueID,
+	partitionID UniqueID, channelName string, requestRows int64) (*Allocation, error) {
+	sp, _ := trace.StartSpanFromContext(ctx)
+	defer sp.Finish()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maxNumOfRows, err := s.estimateMaxNumOfRows(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.allocator.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	numOfRows := requestRows
+	if numOfRows > int64(maxNumOfRows) {
+		numOfRows = int64(maxNumOfRows)
+	}
+
+	segmentInfo := &datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  collectionID,
+		PartitionID:   partitionID,
+		InsertChannel: channelName,
+		NumOfRows:     numOfRows,
+		State:         commonpb.SegmentState_Growing,
+		MaxRowNum:     int64(maxNumOfRows),
+		IsImporting:   true,
+	}
+	if err := s.meta.AddSegment(NewSegmentInfo(segmentInfo)); err != nil {
+		return nil, err
+	}
+	log.Debug("datacoord: open import segment",
+		zap.Int64("CollectionID", collectionID),
+		zap.Int64("SegmentID", id),
+		zap.Int64("Rows", numOfRows),
+		zap.String("Channel", channelName))
+
+	allocation := getAllocation(numOfRows)
+	allocation.SegmentID = id
+	return allocation, nil
+}
+
 func (s *SegmentManager) genExpireTs(ctx context.Context) (Timestamp, error) {
 	ts, err := s.allocator.allocTimestamp(ctx)
 	if err != nil {
@@ -28,6 +28,7 @@ import (
 	"go.uber.org/zap"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/types"
@@ -93,6 +94,11 @@ type Server struct {
 	cluster         *Cluster
 	rootCoordClient types.RootCoord
 
+	compactionTrigger trigger
+	compactionHandler compactionPlanContext
+	garbageCollector  *garbageCollector
+	importManager     *importManager
+
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
 	flushCh   chan UniqueID
@@ -230,6 +236,21 @@ func (s *Server) Start() error {
 		return err
 	}
 
+	if Params.EnableCompaction {
+		s.createCompactionHandler()
+		s.createCompactionTrigger()
+	}
+
+	if err = s.initGarbageCollection(); err != nil {
+		return err
+	}
+	s.garbageCollector.start()
+
+	if s.importManager, err = newImportManager(s.kvClient, s.meta, s.cluster, s.allocator); err != nil {
+		return err
+	}
+	s.importManager.start()
+
 	s.startServerLoop()
 	Params.CreatedTime = time.Now()
 	Params.UpdatedTime = time.Now()
@@ -249,6 +270,59 @@ func (s *Server) initCluster() error {
 	return err
 }
 
+func (s *Server) initGarbageCollection() error {
+	var cli *miniokv.MinIOKV
+	var err error
+	if Params.EnableGarbageCollection {
+		cli, err = miniokv.NewMinIOKV(s.ctx, &miniokv.Option{
+			Address:           Params.MinioAddress,
+			AccessKeyID:       Params.MinioAccessKeyID,
+			SecretAccessKeyID: Params.MinioSecretAccessKey,
+			UseSSL:            Params.MinioUseSSL,
+			BucketName:        Params.MinioBucketName,
+			CreateBucket:      true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	opt := GcOption{
+		enabled:           Params.EnableGarbageCollection,
+		dryRun:            Params.GCDryRun,
+		checkInterval:     Params.GCInterval,
+		missingTolerance:  Params.GCMissingTolerance,
+		retentionDuration: Params.RetentionDuration,
+		insertRootPath:    Params.InsertBinlogRootPath,
+		statsRootPath:     Params.StatsBinlogRootPath,
+		deltaRootPath:     Params.DeleteBinlogRootPath,
+	}
+	// assign the client only if it is not nil, otherwise the nil pointer is wrapped as a non-nil interface
+	if cli != nil {
+		opt.cli = cli
+	}
+	s.garbageCollector = newGarbageCollector(s.meta, s.rootCoordClient, opt)
+	return nil
+}
+
+func (s *Server) createCompactionHandler() {
+	s.compactionHandler = newCompactionPlanHandler(s.cluster, s.meta, s.allocator, s.flushCh)
+	s.compactionHandler.start()
+}
+
+func (s *Server) stopCompactionHandler() {
+	s.compactionHandler.stop()
+}
+
+func (s *Server) createCompactionTrigger() {
+	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator)
+	s.compactionTrigger.start()
+}
+
+func (s *Server) stopCompactionTrigger() {
+	s.compactionTrigger.stop()
+}
+
 func (s *Server) initServiceDiscovery() error {
 	sessions, rev, err := s.session.GetSessions(typeutil.DataNodeRole)
 	if err != nil {
@@ -548,6 +622,13 @@ func (s *Server) Stop() error {
 	log.Debug("dataCoord server shutdown")
 	s.cluster.Close()
 	s.stopServerLoop()
+	s.garbageCollector.close()
+	s.importManager.stop()
+
+	if Params.EnableCompaction {
+		s.stopCompactionTrigger()
+		s.stopCompactionHandler()
+	}
 	return nil
 }
 
@@ -631,6 +712,10 @@ func (s *Server) GetVChanPositions(vchans []vchannel, seekFromStartPosition bool
 		for _, s := range segments {
 			if s.State == commonpb.SegmentState_Flushing || s.State == commonpb.SegmentState_Flushed {
 				flushedSegmentIDs = append(flushedSegmentIDs, s.ID)
+				// imported segments have no dml position
+				if s.DmlPosition == nil {
+					continue
+				}
 				if seekPosition == nil || (!useUnflushedPosition && s.DmlPosition.Timestamp > seekPosition.Timestamp) {
 					seekPosition = s.DmlPosition
 				}
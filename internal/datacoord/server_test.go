@@ -93,6 +93,38 @@ func TestAssignSegmentID(t *testing.T) {
 		assert.EqualValues(t, 1000, assign.Count)
 	})
 
+	t.Run("assign import segment", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{
+			ID:         collID,
+			Schema:     newTestSchema(),
+			Partitions: []int64{},
+		})
+		req := &datapb.SegmentIDRequest{
+			Count:        1000,
+			ChannelName:  channel0,
+			CollectionID: collID,
+			PartitionID:  partID,
+			IsImport:     true,
+		}
+
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			SegmentIDRequests: []*datapb.SegmentIDRequest{req, req},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, 2, len(resp.SegIDAssignments))
+		// each import request gets a dedicated segment
+		assert.NotEqual(t, resp.SegIDAssignments[0].SegID, resp.SegIDAssignments[1].SegID)
+		for _, assign := range resp.SegIDAssignments {
+			assert.EqualValues(t, 1000, assign.Count)
+			segment := svr.meta.GetSegment(assign.SegID)
+			assert.NotNil(t, segment)
+			assert.True(t, segment.GetIsImporting())
+			assert.EqualValues(t, 1000, segment.GetNumOfRows())
+		}
+	})
+
 	t.Run("with closed server", func(t *testing.T) {
 		req := &datapb.SegmentIDRequest{
 			Count:        100,
@@ -752,6 +784,15 @@ func TestSaveBinlogPaths(t *testing.T) {
 					NumOfRows: 10,
 				},
 			},
+			Deltalogs: []*datapb.DeltaLogInfo{
+				{
+					RecordEntries: 5,
+					TimestampFrom: 100,
+					TimestampTo:   200,
+					DeltaLogPath:  "/by-dev/test/0/1/2/delta1",
+					DeltaLogSize:  1024,
+				},
+			},
 			Flushed: false,
 		})
 		assert.Nil(t, err)
@@ -768,6 +809,11 @@ func TestSaveBinlogPaths(t *testing.T) {
 		assert.EqualValues(t, "/by-dev/test/0/1/2/1/Allo1", fieldBinlogs.GetBinlogs()[0])
 		assert.EqualValues(t, "/by-dev/test/0/1/2/1/Allo2", fieldBinlogs.GetBinlogs()[1])
 
+		deltalogs := segment.GetDeltalogs()
+		assert.EqualValues(t, 1, len(deltalogs))
+		assert.EqualValues(t, "/by-dev/test/0/1/2/delta1", deltalogs[0].GetDeltaLogPath())
+		assert.EqualValues(t, 5, deltalogs[0].GetRecordEntries())
+
 		segmentInfo := svr.meta.GetSegment(0)
 		assert.NotNil(t, segmentInfo)
 		assert.EqualValues(t, segmentInfo.DmlPosition.ChannelName, "ch1")
@@ -1218,6 +1264,14 @@ func TestGetRecoveryInfo(t *testing.T) {
 					},
 				},
 			},
+			Deltalogs: []*datapb.DeltaLogInfo{
+				{
+					RecordEntries: 1,
+					TimestampFrom: 100,
+					TimestampTo:   100,
+					DeltaLogPath:  "/deltalog/file1",
+				},
+			},
 		}
 		segment := createSegment(0, 0, 0, 100, 10, "ch1", commonpb.SegmentState_Flushed)
 		err := svr.meta.AddSegment(NewSegmentInfo(segment))
@@ -1238,6 +1292,8 @@ func TestGetRecoveryInfo(t *testing.T) {
 		assert.EqualValues(t, 1, len(resp.GetBinlogs()[0].GetFieldBinlogs()))
 		assert.EqualValues(t, 1, resp.GetBinlogs()[0].GetFieldBinlogs()[0].GetFieldID())
 		assert.ElementsMatch(t, []string{"/binlog/file1", "/binlog/file2"}, resp.GetBinlogs()[0].GetFieldBinlogs()[0].GetBinlogs())
+		assert.EqualValues(t, 1, len(resp.GetBinlogs()[0].GetDeltalogs()))
+		assert.EqualValues(t, "/deltalog/file1", resp.GetBinlogs()[0].GetDeltalogs()[0].GetDeltaLogPath())
 	})
 
 	t.Run("with closed server", func(t *testing.T) {
@@ -1492,3 +1548,139 @@ func initEtcd(etcdEndpoints []string) (*clientv3.Client, error) {
 	}
 	return etcdCli, nil
 }
+
+func TestManualCompaction(t *testing.T) {
+	t.Run("test manual compaction successfully", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID: 1, CollectionID: 1, PartitionID: 1, InsertChannel: "ch1", NumOfRows: 1, MaxRowNum: 100,
+			State: commonpb.SegmentState_Flushed,
+		}))
+		assert.Nil(t, err)
+		err = svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID: 2, CollectionID: 1, PartitionID: 1, InsertChannel: "ch1", NumOfRows: 1, MaxRowNum: 100,
+			State: commonpb.SegmentState_Flushed,
+		}))
+		assert.Nil(t, err)
+
+		resp, err := svr.ManualCompaction(context.TODO(), &milvuspb.ManualCompactionRequest{
+			CollectionID: 1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		stateResp, err := svr.GetCompactionState(context.TODO(), &milvuspb.GetCompactionStateRequest{
+			CompactionID: resp.CompactionID,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, stateResp.Status.ErrorCode)
+		assert.Equal(t, commonpb.CompactionState_Executing, stateResp.State)
+		assert.EqualValues(t, 1, stateResp.ExecutingPlanNo)
+	})
+
+	t.Run("test manual compaction with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		resp, err := svr.ManualCompaction(context.TODO(), &milvuspb.ManualCompactionRequest{
+			CollectionID: 1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.Status.Reason)
+
+		stateResp, err := svr.GetCompactionState(context.TODO(), &milvuspb.GetCompactionStateRequest{
+			CompactionID: 1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stateResp.Status.ErrorCode)
+	})
+}
+
+func TestCompleteCompaction(t *testing.T) {
+	t.Run("test complete unknown plan", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.CompleteCompaction(context.TODO(), &datapb.CompactionResult{PlanID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+	})
+
+	t.Run("test complete compaction with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		resp, err := svr.CompleteCompaction(context.TODO(), &datapb.CompactionResult{PlanID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.ErrorCode)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.Reason)
+	})
+}
+
+func TestImport(t *testing.T) {
+	t.Run("test import and get state", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 1, Schema: newTestSchema()})
+
+		resp, err := svr.Import(context.TODO(), &datapb.ImportTask{
+			CollectionID: 1,
+			PartitionID:  1,
+			ChannelNames: []string{"ch1"},
+			Files:        []string{"a.json"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+
+		// no data node is alive, the task is pending
+		stateResp, err := svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{TaskID: resp.TaskID})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, stateResp.Status.ErrorCode)
+		assert.Equal(t, commonpb.ImportState_ImportPending, stateResp.State)
+
+		stateResp, err = svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{TaskID: resp.TaskID + 100})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stateResp.Status.ErrorCode)
+
+		// pending task is not reportable
+		status, err := svr.ReportImport(context.TODO(), &datapb.ImportResult{TaskID: resp.TaskID})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+
+	t.Run("test import without files", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.Import(context.TODO(), &datapb.ImportTask{
+			CollectionID: 1,
+			ChannelNames: []string{"ch1"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
+	t.Run("test import with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		resp, err := svr.Import(context.TODO(), &datapb.ImportTask{
+			CollectionID: 1,
+			ChannelNames: []string{"ch1"},
+			Files:        []string{"a.json"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.NodeID), resp.Status.Reason)
+
+		stateResp, err := svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{TaskID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stateResp.Status.ErrorCode)
+
+		status, err := svr.ReportImport(context.TODO(), &datapb.ImportResult{TaskID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+}
@@ -106,8 +106,20 @@ func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentI
 
 		s.cluster.Watch(r.ChannelName, r.CollectionID)
 
-		allocations, err := s.segmentManager.AllocSegment(ctx,
-			r.CollectionID, r.PartitionID, r.ChannelName, int64(r.Count))
+		var allocations []*Allocation
+		var err error
+		if r.GetIsImport() {
+			// imported rows are written to binlogs directly, they never share segments with the inserts
+			var allocation *Allocation
+			allocation, err = s.segmentManager.AllocImportSegment(ctx,
+				r.CollectionID, r.PartitionID, r.ChannelName, int64(r.Count))
+			if err == nil {
+				allocations = []*Allocation{allocation}
+			}
+		} else {
+			allocations, err = s.segmentManager.AllocSegment(ctx,
+				r.CollectionID, r.PartitionID, r.ChannelName, int64(r.Count))
+		}
 		if err != nil {
 			log.Warn("failed to alloc segment", zap.Any("request", r), zap.Error(err))
 			continue
@@ -289,7 +301,7 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 
 	// set segment to SegmentState_Flushing and save binlogs and checkpoints
 	err := s.meta.UpdateFlushSegmentsInfo(req.GetSegmentID(), req.GetFlushed(),
-		req.GetField2BinlogPaths(), req.GetCheckPoints(), req.GetStartPositions())
+		req.GetField2BinlogPaths(), req.GetCheckPoints(), req.GetStartPositions(), req.GetDeltalogs())
 	if err != nil {
 		log.Error("save binlog and checkpoints failed",
 			zap.Int64("segmentID", req.GetSegmentID()),
@@ -304,10 +316,33 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 		s.segmentManager.DropSegment(ctx, req.SegmentID)
 		s.flushCh <- req.SegmentID
 	}
+
+	if Params.EnableCompaction && len(req.GetDeltalogs()) > 0 {
+		s.triggerSingleCompaction(ctx, req.GetSegmentID())
+	}
 	resp.ErrorCode = commonpb.ErrorCode_Success
 	return resp, nil
 }
 
+// triggerSingleCompaction checks whether the segment needs a compaction after new delta logs saved,
+// failure is only logged since the segment will be checked again in global compaction
+func (s *Server) triggerSingleCompaction(ctx context.Context, segmentID UniqueID) {
+	segment := s.meta.GetSegment(segmentID)
+	if segment == nil {
+		return
+	}
+	tt, err := getCompactTime(ctx, s.allocator)
+	if err != nil {
+		log.Warn("failed to alloc timestamp for compaction", zap.Int64("segmentID", segmentID), zap.Error(err))
+		return
+	}
+	err = s.compactionTrigger.triggerSingleCompaction(segment.GetCollectionID(), segment.GetPartitionID(),
+		segmentID, segment.GetInsertChannel(), tt)
+	if err != nil {
+		log.Warn("failed to trigger single compaction", zap.Int64("segmentID", segmentID), zap.Error(err))
+	}
+}
+
 // GetComponentStates returns DataCoord's current state
 func (s *Server) GetComponentStates(ctx context.Context) (*internalpb.ComponentStates, error) {
 	resp := &internalpb.ComponentStates{
@@ -351,6 +386,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	}
 	segmentIDs := s.meta.GetSegmentsOfPartition(collectionID, partitionID)
 	segment2Binlogs := make(map[UniqueID][]*datapb.FieldBinlog)
+	segment2Deltalogs := make(map[UniqueID][]*datapb.DeltaLogInfo)
 	segmentsNumOfRows := make(map[UniqueID]int64)
 	for _, id := range segmentIDs {
 		segment := s.meta.GetSegment(id)
@@ -378,6 +414,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 			segment2Binlogs[id] = append(segment2Binlogs[id], fieldBinlogs)
 		}
 
+		segment2Deltalogs[id] = segment.GetDeltalogs()
 		segmentsNumOfRows[id] = segment.NumOfRows
 	}
 
@@ -387,6 +424,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 			SegmentID:    segmentID,
 			NumOfRows:    segmentsNumOfRows[segmentID],
 			FieldBinlogs: fieldBinlogs,
+			Deltalogs:    segment2Deltalogs[segmentID],
 		}
 		binlogs = append(binlogs, sbl)
 	}
@@ -543,3 +581,238 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 		Response: "",
 	}, nil
 }
+
+// CompleteCompaction completes a compaction with the result, the compacted segments are replaced in meta
+func (s *Server) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error) {
+	log.Debug("receive complete compaction request", zap.Int64("planID", req.GetPlanID()),
+		zap.Int64("segmentID", req.GetSegmentID()))
+
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to complete compaction", zap.Int64("planID", req.GetPlanID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Reason = compactionNotEnabledErrMsg
+		return resp, nil
+	}
+
+	if err := s.compactionHandler.completeCompaction(req); err != nil {
+		log.Error("failed to complete compaction", zap.Int64("planID", req.GetPlanID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Debug("success to complete compaction", zap.Int64("planID", req.GetPlanID()))
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ManualCompaction triggers a compaction on the collection, returns the compaction id for state query
+func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	log.Debug("receive manual compaction", zap.Int64("collectionID", req.GetCollectionID()))
+
+	resp := &milvuspb.ManualCompactionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to execute manual compaction", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Status.Reason = compactionNotEnabledErrMsg
+		return resp, nil
+	}
+
+	tt, err := getCompactTime(ctx, s.allocator)
+	if err != nil {
+		log.Error("failed to alloc timestamp for manual compaction", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	// the requested time travel can only be earlier, deletes within the retention duration are always kept
+	if ts := req.GetTimetravel(); ts != 0 && ts < tt.time {
+		tt.time = ts
+	}
+
+	id, err := s.compactionTrigger.forceTriggerCompaction(req.GetCollectionID(), tt)
+	if err != nil {
+		log.Error("failed to trigger manual compaction", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Debug("success to trigger manual compaction", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("compactionID", id))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.CompactionID = id
+	return resp, nil
+}
+
+// GetCompactionState gets the state of a compaction by aggregating the states of its plans
+func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	log.Debug("receive get compaction state request", zap.Int64("compactionID", req.GetCompactionID()))
+
+	resp := &milvuspb.GetCompactionStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get compaction state", zap.Int64("compactionID", req.GetCompactionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if !Params.EnableCompaction {
+		resp.Status.Reason = compactionNotEnabledErrMsg
+		return resp, nil
+	}
+
+	tasks := s.compactionHandler.getCompactionTasksBySignalID(req.GetCompactionID())
+	state, executingCnt, completedCnt, timeoutCnt := getCompactionState(tasks)
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.State = state
+	resp.ExecutingPlanNo = int64(executingCnt)
+	resp.CompletedPlanNo = int64(completedCnt)
+	resp.TimeoutPlanNo = int64(timeoutCnt)
+	return resp, nil
+}
+
+func getCompactionState(tasks []*compactionTask) (state commonpb.CompactionState, executingCnt, completedCnt, timeoutCnt int) {
+	for _, t := range tasks {
+		switch t.state {
+		case executing:
+			executingCnt++
+		case completed:
+			completedCnt++
+		case timeout:
+			timeoutCnt++
+		}
+	}
+	if executingCnt != 0 {
+		state = commonpb.CompactionState_Executing
+	} else {
+		state = commonpb.CompactionState_Completed
+	}
+	return
+}
+
+// Import creates a bulk import task of the files, the task is dispatched to a data node asynchronously
+func (s *Server) Import(ctx context.Context, req *datapb.ImportTask) (*datapb.ImportTaskResponse, error) {
+	log.Debug("receive import request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()), zap.Strings("files", req.GetFiles()))
+
+	resp := &datapb.ImportTaskResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to import", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if len(req.GetFiles()) == 0 {
+		resp.Status.Reason = "no file to import"
+		return resp, nil
+	}
+	if len(req.GetChannelNames()) == 0 {
+		resp.Status.Reason = fmt.Sprintf("no channel of collection %d to import", req.GetCollectionID())
+		return resp, nil
+	}
+
+	if coll := s.meta.GetCollection(req.GetCollectionID()); coll == nil {
+		if err := s.loadCollectionFromRootCoord(ctx, req.GetCollectionID()); err != nil {
+			log.Error("load collection from rootcoord error", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+	}
+
+	taskID, err := s.importManager.createTask(ctx, req)
+	if err != nil {
+		log.Error("failed to create import task", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.TaskID = taskID
+	return resp, nil
+}
+
+// GetImportState gets the state of a bulk import task
+func (s *Server) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	log.Debug("receive get import state request", zap.Int64("taskID", req.GetTaskID()))
+
+	resp := &milvuspb.GetImportStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get import state", zap.Int64("taskID", req.GetTaskID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	task := s.importManager.getTask(req.GetTaskID())
+	if task == nil {
+		resp.Status.Reason = fmt.Sprintf("import task %d not found", req.GetTaskID())
+		return resp, nil
+	}
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.State = task.GetState()
+	resp.RowCount = task.GetRowCount()
+	resp.SegmentIDs = task.GetSegmentIDs()
+	resp.FailedReason = task.GetFailedReason()
+	return resp, nil
+}
+
+// ReportImport receives the result of a bulk import task from the data node
+func (s *Server) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	log.Debug("receive report import request", zap.Int64("taskID", req.GetTaskID()),
+		zap.String("state", req.GetState().String()))
+
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to report import", zap.Int64("taskID", req.GetTaskID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.NodeID)
+		return resp, nil
+	}
+
+	if err := s.importManager.reportImport(req); err != nil {
+		log.Error("failed to report import", zap.Int64("taskID", req.GetTaskID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
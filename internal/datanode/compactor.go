@@ -0,0 +1,517 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+var (
+	errCompactionEmptyPlan   = errors.New("compaction plan contains no segment")
+	errIllegalCompactionPlan = errors.New("compaction plan illegal")
+)
+
+type compactor interface {
+	compact() error
+}
+
+// compactionTask merges the segments in a compaction plan into a new segment,
+// the rows deleted before the time travel of plan are dropped
+type compactionTask struct {
+	ctx context.Context
+
+	plan        *datapb.CompactionPlan
+	replica     Replica
+	delNode     *deleteNode
+	kv          kv.BaseKV
+	idAllocator allocatorInterface
+	dataCoord   types.DataCoord
+}
+
+var _ compactor = (*compactionTask)(nil)
+
+func newCompactionTask(
+	ctx context.Context,
+	plan *datapb.CompactionPlan,
+	replica Replica,
+	delNode *deleteNode,
+	kv kv.BaseKV,
+	idAllocator allocatorInterface,
+	dataCoord types.DataCoord,
+) *compactionTask {
+	return &compactionTask{
+		ctx:         ctx,
+		plan:        plan,
+		replica:     replica,
+		delNode:     delNode,
+		kv:          kv,
+		idAllocator: idAllocator,
+		dataCoord:   dataCoord,
+	}
+}
+
+// compact executes the compaction plan, the steps are:
+// 1. load the delta logs and binlogs of the segments in plan
+// 2. merge the rows, drop the rows deleted before time travel
+// 3. save the merged binlogs and the remaining delta logs to storage
+// 4. report the result to data coord, and replace the segments in replica
+func (t *compactionTask) compact() error {
+	plan := t.plan
+	if len(plan.GetSegmentBinlogs()) == 0 {
+		return errCompactionEmptyPlan
+	}
+	log.Debug("compaction start", zap.Int64("planID", plan.GetPlanID()),
+		zap.Stringer("type", plan.GetType()), zap.Int("segmentNum", len(plan.GetSegmentBinlogs())))
+
+	// use the latest schema, segments flushed after a field was added carry data of that field
+	schema, err := t.replica.getCollectionSchema(plan.GetCollectionID(), 0)
+	if err != nil {
+		return err
+	}
+
+	deletes, err := t.loadDeltaLogs()
+	if err != nil {
+		return err
+	}
+
+	insertDatas := make([]*InsertData, 0, len(plan.GetSegmentBinlogs()))
+	for _, sb := range plan.GetSegmentBinlogs() {
+		iData, err := t.loadInsertData(sb.GetFieldBinlogs())
+		if err != nil {
+			return err
+		}
+		if iData != nil {
+			// segments flushed before a field was added have no data for it
+			if err := storage.FillMissingFields(schema, iData); err != nil {
+				return err
+			}
+			insertDatas = append(insertDatas, iData)
+		}
+	}
+
+	merged, pks, err := mergeInsertData(schema, insertDatas, deletes, plan.GetTimetravel())
+	if err != nil {
+		return err
+	}
+
+	targetSegID, err := t.idAllocator.allocID()
+	if err != nil {
+		return err
+	}
+
+	result := &datapb.CompactionResult{
+		PlanID:    plan.GetPlanID(),
+		SegmentID: targetSegID,
+		NumOfRows: int64(len(pks)),
+	}
+
+	if len(pks) > 0 {
+		meta := &etcdpb.CollectionMeta{ID: plan.GetCollectionID(), Schema: schema}
+		insertLogs, err := t.saveInsertData(meta, targetSegID, merged)
+		if err != nil {
+			return err
+		}
+		result.InsertLogs = insertLogs
+
+		remains := remainingDeletes(merged, pks, deletes, plan.GetTimetravel())
+		if len(remains.Data) > 0 {
+			deltaLog, err := t.saveDeltaData(targetSegID, remains)
+			if err != nil {
+				return err
+			}
+			result.Deltalogs = []*datapb.DeltaLogInfo{deltaLog}
+		}
+	}
+
+	status, err := t.dataCoord.CompleteCompaction(t.ctx, result)
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("complete compaction failed, reason = %s", status.GetReason())
+	}
+
+	compactedFrom := make([]UniqueID, 0, len(plan.GetSegmentBinlogs()))
+	for _, sb := range plan.GetSegmentBinlogs() {
+		compactedFrom = append(compactedFrom, sb.GetSegmentID())
+	}
+	err = t.replica.mergeFlushedSegments(targetSegID, plan.GetCollectionID(), plan.GetPartitionID(),
+		compactedFrom, plan.GetChannel(), result.GetNumOfRows(), merged.Data[getPrimaryKeyFieldID(schema)])
+	if err != nil {
+		return err
+	}
+	if t.delNode != nil {
+		t.delNode.mergeDelBuf(targetSegID, compactedFrom)
+	}
+
+	log.Debug("compaction done", zap.Int64("planID", plan.GetPlanID()),
+		zap.Int64("segmentID", targetSegID), zap.Int64("numOfRows", result.GetNumOfRows()))
+	return nil
+}
+
+// loadDeltaLogs loads all delta logs in plan, returns the delete timestamps of each primary key
+func (t *compactionTask) loadDeltaLogs() (map[string][]Timestamp, error) {
+	deletes := make(map[string][]Timestamp)
+	dCodec := storage.NewDeleteCodec(&etcdpb.CollectionMeta{ID: t.plan.GetCollectionID()})
+	defer dCodec.Close()
+
+	for _, sb := range t.plan.GetSegmentBinlogs() {
+		for _, deltaLog := range sb.GetDeltalogs() {
+			value, err := t.kv.Load(deltaLog.GetDeltaLogPath())
+			if err != nil {
+				return nil, err
+			}
+			_, _, deleteData, err := dCodec.Deserialize(&storage.Blob{
+				Key:   deltaLog.GetDeltaLogPath(),
+				Value: []byte(value),
+			})
+			if err != nil {
+				return nil, err
+			}
+			for key, ts := range deleteData.Data {
+				deletes[key] = append(deletes[key], Timestamp(ts))
+			}
+		}
+	}
+	return deletes, nil
+}
+
+// loadInsertData loads the binlogs of a segment, returns nil if the segment has no binlog
+func (t *compactionTask) loadInsertData(fieldBinlogs []*datapb.FieldBinlog) (*InsertData, error) {
+	var blobs []*storage.Blob
+	for _, fb := range fieldBinlogs {
+		for _, p := range fb.GetBinlogs() {
+			value, err := t.kv.Load(p)
+			if err != nil {
+				return nil, err
+			}
+			blobs = append(blobs, &storage.Blob{
+				Key:   p,
+				Value: []byte(value),
+			})
+		}
+	}
+	if len(blobs) == 0 {
+		return nil, nil
+	}
+
+	iCodec := storage.NewInsertCodec(nil)
+	defer iCodec.Close()
+	_, _, iData, err := iCodec.Deserialize(blobs)
+	if err != nil {
+		return nil, err
+	}
+	return iData, nil
+}
+
+func (t *compactionTask) saveInsertData(meta *etcdpb.CollectionMeta, segID UniqueID, iData *InsertData) ([]*datapb.FieldBinlog, error) {
+	return saveInsertBinlogs(t.kv, t.idAllocator, meta, t.plan.GetPartitionID(), segID, iData)
+}
+
+// saveInsertBinlogs serializes the insert data of a segment into binlogs and stats binlogs,
+// saves them to kv and returns the binlog paths of each field
+func saveInsertBinlogs(kv kv.BaseKV, idAllocator allocatorInterface, meta *etcdpb.CollectionMeta,
+	partID, segID UniqueID, iData *InsertData) ([]*datapb.FieldBinlog, error) {
+	iCodec := storage.NewInsertCodec(meta)
+	binLogs, statsBinlogs, err := iCodec.Serialize(partID, segID, iData)
+	if err != nil {
+		return nil, err
+	}
+
+	collID := meta.GetID()
+	kvs := make(map[string]string, len(binLogs)+len(statsBinlogs))
+	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
+	insertLogs := make([]*datapb.FieldBinlog, 0, len(binLogs))
+	for _, blob := range binLogs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		logidx, err := idAllocator.allocID()
+		if err != nil {
+			return nil, err
+		}
+
+		// no error raise if alloc=false
+		k, _ := idAllocator.genKey(false, collID, partID, segID, fieldID, logidx)
+		key := path.Join(Params.InsertBinlogRootPath, k)
+		kvs[key] = string(blob.GetValue())
+		field2Logidx[fieldID] = logidx
+		insertLogs = append(insertLogs, &datapb.FieldBinlog{FieldID: fieldID, Binlogs: []string{key}})
+	}
+
+	for _, blob := range statsBinlogs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		// no error raise if alloc=false
+		k, _ := idAllocator.genKey(false, collID, partID, segID, fieldID, field2Logidx[fieldID])
+		key := path.Join(Params.StatsBinlogRootPath, k)
+		kvs[key] = string(blob.GetValue())
+	}
+
+	if err := kv.MultiSave(kvs); err != nil {
+		return nil, err
+	}
+	return insertLogs, nil
+}
+
+func (t *compactionTask) saveDeltaData(segID UniqueID, dData *DeleteData) (*datapb.DeltaLogInfo, error) {
+	collID, partID := t.plan.GetCollectionID(), t.plan.GetPartitionID()
+	dCodec := storage.NewDeleteCodec(&etcdpb.CollectionMeta{ID: collID})
+	blob, err := dCodec.Serialize(partID, segID, dData)
+	if err != nil {
+		return nil, err
+	}
+
+	logID, err := t.idAllocator.allocID()
+	if err != nil {
+		return nil, err
+	}
+
+	// no error raise if alloc=false
+	k, _ := t.idAllocator.genKey(false, collID, partID, segID, logID)
+	key := path.Join(Params.DeleteBinlogRootPath, k)
+	if err := t.kv.Save(key, string(blob.GetValue())); err != nil {
+		return nil, err
+	}
+
+	deltaLog := &datapb.DeltaLogInfo{
+		RecordEntries: uint64(len(dData.Data)),
+		DeltaLogPath:  key,
+		DeltaLogSize:  int64(len(blob.GetValue())),
+	}
+	for _, ts := range dData.Data {
+		if deltaLog.TimestampFrom == 0 || Timestamp(ts) < deltaLog.TimestampFrom {
+			deltaLog.TimestampFrom = Timestamp(ts)
+		}
+		if Timestamp(ts) > deltaLog.TimestampTo {
+			deltaLog.TimestampTo = Timestamp(ts)
+		}
+	}
+	return deltaLog, nil
+}
+
+// getPrimaryKeyFieldID returns the int64 or string primary key field of schema, and uses the row id field if not found
+func getPrimaryKeyFieldID(schema *schemapb.CollectionSchema) UniqueID {
+	for _, field := range schema.GetFields() {
+		if field.GetIsPrimaryKey() &&
+			(field.GetDataType() == schemapb.DataType_Int64 || field.GetDataType() == schemapb.DataType_String) {
+			return field.GetFieldID()
+		}
+	}
+	return rootcoord.RowIDField
+}
+
+// getPrimaryKeys returns the primary keys of field data in the form of delta log keys
+func getPrimaryKeys(data storage.FieldData) ([]string, error) {
+	switch d := data.(type) {
+	case *storage.Int64FieldData:
+		keys := make([]string, 0, len(d.Data))
+		for _, pk := range d.Data {
+			keys = append(keys, strconv.FormatInt(pk, 10))
+		}
+		return keys, nil
+	case *storage.StringFieldData:
+		return d.Data, nil
+	default:
+		return nil, errors.New("unsupported primary key field data")
+	}
+}
+
+// isRowDeleted checks if the row inserted at rowTs is deleted by the deletes no later than time travel,
+// a row is only deleted by the deletes after it, so the row inserted again by upsert is kept
+func isRowDeleted(delTss []Timestamp, rowTs Timestamp, timetravel Timestamp) bool {
+	for _, delTs := range delTss {
+		if rowTs < delTs && delTs <= timetravel {
+			return true
+		}
+	}
+	return false
+}
+
+// remainingDeletes returns the deletes after time travel that apply to the rows of the merged insert data.
+// For each row the earliest delete after it is taken, and the latest of them is kept if a primary key has
+// several rows, since a delta log holds one delete per primary key.
+func remainingDeletes(merged *InsertData, pks []string, deletes map[string][]Timestamp, timetravel Timestamp) *DeleteData {
+	remains := &DeleteData{Data: make(map[string]int64)}
+	tsData := merged.Data[rootcoord.TimeStampField].(*storage.Int64FieldData)
+	for i, pk := range pks {
+		rowTs := Timestamp(tsData.Data[i])
+		var next Timestamp
+		for _, delTs := range deletes[pk] {
+			if delTs > timetravel && delTs > rowTs && (next == 0 || delTs < next) {
+				next = delTs
+			}
+		}
+		if next == 0 {
+			continue
+		}
+		if old, ok := remains.Data[pk]; !ok || int64(next) > old {
+			remains.Data[pk] = int64(next)
+		}
+	}
+	return remains
+}
+
+// mergeInsertData merges the rows of insert datas into one, the rows deleted no later than
+// time travel are dropped. It returns the merged insert data and its primary keys in the form of delta log keys.
+func mergeInsertData(schema *schemapb.CollectionSchema, iDatas []*InsertData, deletes map[string][]Timestamp,
+	timetravel Timestamp) (*InsertData, []string, error) {
+	pkFieldID := getPrimaryKeyFieldID(schema)
+
+	merged := &InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+	var pks []string
+	for _, iData := range iDatas {
+		pkKeys, err := getPrimaryKeys(iData.Data[pkFieldID])
+		if err != nil {
+			return nil, nil, fmt.Errorf("primary key field %d not found in insert data", pkFieldID)
+		}
+		tsData, ok := iData.Data[rootcoord.TimeStampField].(*storage.Int64FieldData)
+		if !ok {
+			return nil, nil, fmt.Errorf("timestamp field %d not found in insert data", rootcoord.TimeStampField)
+		}
+
+		for i, pk := range pkKeys {
+			if isRowDeleted(deletes[pk], Timestamp(tsData.Data[i]), timetravel) {
+				continue
+			}
+			for fieldID, fieldData := range iData.Data {
+				appended, err := appendFieldDataRow(merged.Data[fieldID], fieldData, i)
+				if err != nil {
+					return nil, nil, err
+				}
+				merged.Data[fieldID] = appended
+			}
+			pks = append(pks, pk)
+		}
+	}
+
+	// all rows are written into one binlog
+	for _, fieldData := range merged.Data {
+		setFieldDataNumRows(fieldData, int64(len(pks)))
+	}
+	return merged, pks, nil
+}
+
+// appendFieldDataRow appends the idx-th row of src to dst, dst is created if nil
+func appendFieldDataRow(dst storage.FieldData, src storage.FieldData, idx int) (storage.FieldData, error) {
+	switch data := src.(type) {
+	case *storage.BoolFieldData:
+		if dst == nil {
+			dst = &storage.BoolFieldData{}
+		}
+		d := dst.(*storage.BoolFieldData)
+		d.Data = append(d.Data, data.Data[idx])
+	case *storage.Int8FieldData:
+		if dst == nil {
+			dst = &storage.Int8FieldData{}
+		}
+		d := dst.(*storage.Int8FieldData)
+		d.Data = append(d.Data, data.Data[idx])
+	case *storage.Int16FieldData:
+		if dst == nil {
+			dst = &storage.Int16FieldData{}
+		}
+		d := dst.(*storage.Int16FieldData)
+		d.Data = append(d.Data, data.Data[idx])
+	case *storage.Int32FieldData:
+		if dst == nil {
+			dst = &storage.Int32FieldData{}
+		}
+		d := dst.(*storage.Int32FieldData)
+		d.Data = append(d.Data, data.Data[idx])
+	case *storage.Int64FieldData:
+		if dst == nil {
+			dst = &storage.Int64FieldData{}
+		}
+		d := dst.(*storage.Int64FieldData)
+		d.Data = append(d.Data, data.Data[idx])
+	case *storage.FloatFieldData:
+		if dst == nil {
+			dst = &storage.FloatFieldData{}
+		}
+		d := dst.(*storage.FloatFieldData)
+		d.Data = append(d.Data, data.Data[idx])
+	case *storage.DoubleFieldData:
+		if dst == nil {
+			dst = &storage.DoubleFieldData{}
+		}
+		d := dst.(*storage.DoubleFieldData)
+		d.Data = append(d.Data, data.Data[idx])
+	case *storage.StringFieldData:
+		if dst == nil {
+			dst = &storage.StringFieldData{}
+		}
+		d := dst.(*storage.StringFieldData)
+		d.Data = append(d.Data, data.Data[idx])
+	case *storage.BinaryVectorFieldData:
+		if dst == nil {
+			dst = &storage.BinaryVectorFieldData{Dim: data.Dim}
+		}
+		d := dst.(*storage.BinaryVectorFieldData)
+		rowBytes := data.Dim / 8
+		d.Data = append(d.Data, data.Data[idx*rowBytes:(idx+1)*rowBytes]...)
+	case *storage.FloatVectorFieldData:
+		if dst == nil {
+			dst = &storage.FloatVectorFieldData{Dim: data.Dim}
+		}
+		d := dst.(*storage.FloatVectorFieldData)
+		d.Data = append(d.Data, data.Data[idx*data.Dim:(idx+1)*data.Dim]...)
+	default:
+		return nil, fmt.Errorf("unexpected field data type %T", src)
+	}
+	return dst, nil
+}
+
+func setFieldDataNumRows(fieldData storage.FieldData, numRows int64) {
+	switch data := fieldData.(type) {
+	case *storage.BoolFieldData:
+		data.NumRows = []int64{numRows}
+	case *storage.Int8FieldData:
+		data.NumRows = []int64{numRows}
+	case *storage.Int16FieldData:
+		data.NumRows = []int64{numRows}
+	case *storage.Int32FieldData:
+		data.NumRows = []int64{numRows}
+	case *storage.Int64FieldData:
+		data.NumRows = []int64{numRows}
+	case *storage.FloatFieldData:
+		data.NumRows = []int64{numRows}
+	case *storage.DoubleFieldData:
+		data.NumRows = []int64{numRows}
+	case *storage.StringFieldData:
+		data.NumRows = []int64{numRows}
+	case *storage.BinaryVectorFieldData:
+		data.NumRows = []int64{numRows}
+	case *storage.FloatVectorFieldData:
+		data.NumRows = []int64{numRows}
+	}
+}
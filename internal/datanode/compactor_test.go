@@ -0,0 +1,247 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// genCompactionInsertData generates insert data of the schema in MetaFactory, timestamps are the row ids
+func genCompactionInsertData(rowIDs []int64) *InsertData {
+	n := len(rowIDs)
+	iData := &InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+	iData.Data[0] = &storage.Int64FieldData{NumRows: []int64{int64(n)}, Data: append([]int64{}, rowIDs...)}
+	iData.Data[1] = &storage.Int64FieldData{NumRows: []int64{int64(n)}, Data: append([]int64{}, rowIDs...)}
+
+	floatVector := &storage.FloatVectorFieldData{NumRows: []int64{int64(n)}, Dim: 2}
+	binaryVector := &storage.BinaryVectorFieldData{NumRows: []int64{int64(n)}, Dim: 32}
+	boolData := &storage.BoolFieldData{NumRows: []int64{int64(n)}}
+	int8Data := &storage.Int8FieldData{NumRows: []int64{int64(n)}}
+	int16Data := &storage.Int16FieldData{NumRows: []int64{int64(n)}}
+	int32Data := &storage.Int32FieldData{NumRows: []int64{int64(n)}}
+	int64Data := &storage.Int64FieldData{NumRows: []int64{int64(n)}}
+	floatData := &storage.FloatFieldData{NumRows: []int64{int64(n)}}
+	doubleData := &storage.DoubleFieldData{NumRows: []int64{int64(n)}}
+	for _, id := range rowIDs {
+		floatVector.Data = append(floatVector.Data, float32(id), float32(id))
+		binaryVector.Data = append(binaryVector.Data, byte(id), byte(id), byte(id), byte(id))
+		boolData.Data = append(boolData.Data, id%2 == 0)
+		int8Data.Data = append(int8Data.Data, int8(id))
+		int16Data.Data = append(int16Data.Data, int16(id))
+		int32Data.Data = append(int32Data.Data, int32(id))
+		int64Data.Data = append(int64Data.Data, id)
+		floatData.Data = append(floatData.Data, float32(id))
+		doubleData.Data = append(doubleData.Data, float64(id))
+	}
+	iData.Data[100] = floatVector
+	iData.Data[101] = binaryVector
+	iData.Data[102] = boolData
+	iData.Data[103] = int8Data
+	iData.Data[104] = int16Data
+	iData.Data[105] = int32Data
+	iData.Data[106] = int64Data
+	iData.Data[107] = floatData
+	iData.Data[108] = doubleData
+	return iData
+}
+
+func saveCompactionTestBinlogs(t *testing.T, kv *memkv.MemoryKV, collMeta *etcdpb.CollectionMeta,
+	segID UniqueID, iData *InsertData) []*datapb.FieldBinlog {
+	iCodec := storage.NewInsertCodec(collMeta)
+	blobs, _, err := iCodec.Serialize(0, segID, iData)
+	require.NoError(t, err)
+
+	fieldBinlogs := make([]*datapb.FieldBinlog, 0, len(blobs))
+	for idx, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		require.NoError(t, err)
+		key := path.Join("insert_log", strconv.FormatInt(segID, 10), blob.GetKey(), strconv.Itoa(idx))
+		err = kv.Save(key, string(blob.GetValue()))
+		require.NoError(t, err)
+		fieldBinlogs = append(fieldBinlogs, &datapb.FieldBinlog{FieldID: fieldID, Binlogs: []string{key}})
+	}
+	return fieldBinlogs
+}
+
+func saveCompactionTestDeltaLog(t *testing.T, kv *memkv.MemoryKV, segID UniqueID, deletes map[string]int64) *datapb.DeltaLogInfo {
+	dCodec := storage.NewDeleteCodec(&etcdpb.CollectionMeta{ID: 1})
+	blob, err := dCodec.Serialize(0, segID, &DeleteData{Data: deletes})
+	require.NoError(t, err)
+	key := path.Join("delta_log", strconv.FormatInt(segID, 10))
+	err = kv.Save(key, string(blob.GetValue()))
+	require.NoError(t, err)
+	return &datapb.DeltaLogInfo{RecordEntries: uint64(len(deletes)), DeltaLogPath: key}
+}
+
+func TestCompactionTask_compact(t *testing.T) {
+	ctx := context.Background()
+
+	mf := &MetaFactory{}
+	collMeta := mf.CollectionMetaFactory(1, "test_compaction")
+	kv := memkv.NewMemoryKV()
+
+	// segment ids are out of the range of AllocatorFactory
+	const seg1, seg2 = UniqueID(10001), UniqueID(10002)
+	seg1Logs := saveCompactionTestBinlogs(t, kv, collMeta, seg1, genCompactionInsertData([]int64{1, 2, 3}))
+	seg2Logs := saveCompactionTestBinlogs(t, kv, collMeta, seg2, genCompactionInsertData([]int64{4, 5}))
+	// pk 2 is deleted before time travel, pk 5 is deleted after time travel
+	seg1Delta := saveCompactionTestDeltaLog(t, kv, seg1, map[string]int64{"2": 20})
+	seg2Delta := saveCompactionTestDeltaLog(t, kv, seg2, map[string]int64{"5": 200})
+
+	replica := newReplica(&RootCoordFactory{collectionID: 1}, 1)
+	err := replica.addNormalSegment(seg1, 1, 0, "ch1", 3, &segmentCheckPoint{})
+	require.NoError(t, err)
+	replica.segmentFlushed(seg1)
+
+	plan := &datapb.CompactionPlan{
+		PlanID: 1,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: seg1, FieldBinlogs: seg1Logs, Deltalogs: []*datapb.DeltaLogInfo{seg1Delta}},
+			{SegmentID: seg2, FieldBinlogs: seg2Logs, Deltalogs: []*datapb.DeltaLogInfo{seg2Delta}},
+		},
+		Type:         datapb.CompactionType_MergeCompaction,
+		Timetravel:   100,
+		Channel:      "ch1",
+		CollectionID: 1,
+		PartitionID:  0,
+	}
+
+	t.Run("complete compaction failed", func(t *testing.T) {
+		dc := &DataCoordFactory{CompleteCompactionNotSuccess: true}
+		task := newCompactionTask(ctx, plan, replica, nil, kv, NewAllocatorFactory(), dc)
+		err := task.compact()
+		assert.Error(t, err)
+		assert.True(t, replica.hasSegment(seg1, true))
+	})
+
+	t.Run("empty plan", func(t *testing.T) {
+		task := newCompactionTask(ctx, &datapb.CompactionPlan{PlanID: 2}, replica, nil, kv, NewAllocatorFactory(), &DataCoordFactory{})
+		err := task.compact()
+		assert.Equal(t, errCompactionEmptyPlan, err)
+	})
+
+	t.Run("merge compaction", func(t *testing.T) {
+		dc := &DataCoordFactory{}
+		task := newCompactionTask(ctx, plan, replica, nil, kv, NewAllocatorFactory(), dc)
+		err := task.compact()
+		require.NoError(t, err)
+
+		require.Equal(t, 1, len(dc.compactionResults))
+		result := dc.compactionResults[0]
+		assert.EqualValues(t, 1, result.GetPlanID())
+		assert.EqualValues(t, 4, result.GetNumOfRows())
+		assert.Equal(t, len(collMeta.Schema.Fields), len(result.GetInsertLogs()))
+		require.Equal(t, 1, len(result.GetDeltalogs()))
+		assert.EqualValues(t, 1, result.GetDeltalogs()[0].GetRecordEntries())
+		assert.EqualValues(t, 200, result.GetDeltalogs()[0].GetTimestampFrom())
+
+		assert.False(t, replica.hasSegment(seg1, true))
+		assert.True(t, replica.hasSegment(result.GetSegmentID(), true))
+
+		task = newCompactionTask(ctx, plan, replica, nil, kv, NewAllocatorFactory(), dc)
+		iData, err := task.loadInsertData(result.GetInsertLogs())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int64{1, 3, 4, 5}, iData.Data[0].(*storage.Int64FieldData).Data)
+		assert.ElementsMatch(t, []float32{1, 1, 3, 3, 4, 4, 5, 5}, iData.Data[100].(*storage.FloatVectorFieldData).Data)
+	})
+}
+
+func TestMergeInsertData(t *testing.T) {
+	mf := &MetaFactory{}
+	collMeta := mf.CollectionMetaFactory(1, "test_merge")
+
+	// pk 1 is inserted again at 50 by upsert, after its delete at 10
+	upserted := genCompactionInsertData([]int64{1})
+	upserted.Data[1].(*storage.Int64FieldData).Data[0] = 50
+	iDatas := []*InsertData{
+		genCompactionInsertData([]int64{1, 2, 3}),
+		genCompactionInsertData([]int64{4}),
+		upserted,
+	}
+	deletes := map[string][]Timestamp{
+		"1": {10},
+		"4": {10},
+		"3": {1000},
+	}
+
+	merged, pks, err := mergeInsertData(collMeta.Schema, iDatas, deletes, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "3", "1"}, pks)
+	assert.Equal(t, []int64{2, 3, 1}, merged.Data[0].(*storage.Int64FieldData).Data)
+	assert.Equal(t, []int64{3}, merged.Data[0].(*storage.Int64FieldData).NumRows)
+	assert.Equal(t, []int64{2, 3, 50}, merged.Data[1].(*storage.Int64FieldData).Data)
+	assert.Equal(t, []float32{2, 2, 3, 3, 1, 1}, merged.Data[100].(*storage.FloatVectorFieldData).Data)
+	assert.Equal(t, []byte{2, 2, 2, 2, 3, 3, 3, 3, 1, 1, 1, 1}, merged.Data[101].(*storage.BinaryVectorFieldData).Data)
+	assert.Equal(t, []bool{true, false, false}, merged.Data[102].(*storage.BoolFieldData).Data)
+	assert.Equal(t, []float64{2, 3, 1}, merged.Data[108].(*storage.DoubleFieldData).Data)
+
+	// only the deletes after time travel and after the rows remain
+	deletes["1"] = append(deletes["1"], 40, 200)
+	remains := remainingDeletes(merged, pks, deletes, 100)
+	assert.Equal(t, map[string]int64{"1": 200, "3": 1000}, remains.Data)
+
+	// primary key field missing
+	_, _, err = mergeInsertData(collMeta.Schema, []*InsertData{{Data: map[storage.FieldID]storage.FieldData{}}}, deletes, 100)
+	assert.Error(t, err)
+
+	// timestamp field missing
+	noTs := genCompactionInsertData([]int64{1})
+	delete(noTs.Data, 1)
+	_, _, err = mergeInsertData(collMeta.Schema, []*InsertData{noTs}, deletes, 100)
+	assert.Error(t, err)
+}
+
+func TestSegmentReplica_mergeFlushedSegments(t *testing.T) {
+	replica := newReplica(&RootCoordFactory{}, 1)
+	err := replica.addNormalSegment(1, 1, 0, "ch1", 3, &segmentCheckPoint{})
+	require.NoError(t, err)
+	err = replica.addNormalSegment(2, 1, 0, "ch1", 3, &segmentCheckPoint{})
+	require.NoError(t, err)
+
+	err = replica.mergeFlushedSegments(3, 2, 0, []UniqueID{1, 2}, "ch1", 2, &storage.Int64FieldData{Data: []int64{10, 20}})
+	assert.Error(t, err)
+
+	err = replica.mergeFlushedSegments(3, 1, 0, []UniqueID{1, 2}, "ch1", 2, &storage.Int64FieldData{Data: []int64{10, 20}})
+	assert.NoError(t, err)
+	assert.False(t, replica.hasSegment(1, true))
+	assert.False(t, replica.hasSegment(2, true))
+	assert.True(t, replica.hasSegment(3, true))
+
+	segments := replica.filterSegments("ch1", 0)
+	require.Equal(t, 1, len(segments))
+	assert.EqualValues(t, 10, segments[0].minPK)
+	assert.EqualValues(t, 20, segments[0].maxPK)
+
+	// string primary keys only fill the bloom filter
+	err = replica.mergeFlushedSegments(5, 1, 0, []UniqueID{3}, "ch1", 2, &storage.StringFieldData{Data: []string{"a", "b"}})
+	assert.NoError(t, err)
+	segments = replica.filterSegments("ch1", 0)
+	require.Equal(t, 1, len(segments))
+	assert.True(t, segments[0].pkFilter.Test([]byte("a")))
+
+	// all rows deleted
+	err = replica.mergeFlushedSegments(4, 1, 0, []UniqueID{5}, "ch1", 0, nil)
+	assert.NoError(t, err)
+	assert.False(t, replica.hasSegment(5, true))
+	assert.False(t, replica.hasSegment(4, true))
+}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -557,6 +558,94 @@ func (node *DataNode) FlushSegments(ctx context.Context, req *datapb.FlushSegmen
 	return status, nil
 }
 
+// Compaction handles the compaction plan from data coord, the plan is executed asynchronously
+// and the result is reported to data coord by `CompleteCompaction`
+func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if !node.isHealthy() {
+		status.Reason = msgDataNodeIsUnhealthy(node.NodeID)
+		return status, nil
+	}
+
+	node.chanMut.RLock()
+	ds, ok := node.vchan2SyncService[req.GetChannel()]
+	node.chanMut.RUnlock()
+	if !ok {
+		log.Warn("illegal compaction plan, channel not in this DataNode", zap.String("channel", req.GetChannel()))
+		status.Reason = errIllegalCompactionPlan.Error()
+		return status, nil
+	}
+
+	option := &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+	}
+	minIOKV, err := miniokv.NewMinIOKV(node.ctx, option)
+	if err != nil {
+		status.Reason = err.Error()
+		return status, nil
+	}
+
+	task := newCompactionTask(node.ctx, req, ds.replica, ds.delNode, minIOKV, ds.idAllocator, node.dataCoord)
+	go func() {
+		defer logutil.LogPanic()
+		if err := task.compact(); err != nil {
+			log.Warn("compaction failed", zap.Int64("planID", req.GetPlanID()), zap.Error(err))
+		}
+	}()
+
+	status.ErrorCode = commonpb.ErrorCode_Success
+	return status, nil
+}
+
+// Import executes a bulk import task asynchronously, the result is reported to data coord when done
+func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskInfo) (*commonpb.Status, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if !node.isHealthy() {
+		status.Reason = msgDataNodeIsUnhealthy(node.NodeID)
+		return status, nil
+	}
+
+	if len(req.GetFiles()) == 0 || len(req.GetChannelNames()) == 0 {
+		log.Warn("illegal import task", zap.Int64("taskID", req.GetTaskID()))
+		status.Reason = errImportEmptyTask.Error()
+		return status, nil
+	}
+
+	option := &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+	}
+	minIOKV, err := miniokv.NewMinIOKV(node.ctx, option)
+	if err != nil {
+		status.Reason = err.Error()
+		return status, nil
+	}
+
+	task := newImportTask(node.ctx, req, minIOKV, newAllocator(node.rootCoord), node.rootCoord, node.dataCoord)
+	go func() {
+		defer logutil.LogPanic()
+		task.execute()
+	}()
+
+	status.ErrorCode = commonpb.ErrorCode_Success
+	return status, nil
+}
+
 // Stop will release DataNode resources and shutdown datanode
 func (node *DataNode) Stop() error {
 	node.cancel()
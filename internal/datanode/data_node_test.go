@@ -280,6 +280,21 @@ func TestDataNode(t *testing.T) {
 		wg.Wait()
 	})
 
+	t.Run("Test Compaction", func(t *testing.T) {
+		status, err := node.Compaction(node.ctx, &datapb.CompactionPlan{
+			PlanID:  1,
+			Channel: "fake-channel-not-watched",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		assert.Equal(t, errIllegalCompactionPlan.Error(), status.Reason)
+
+		node1 := newIDLEDataNodeMock(node.ctx)
+		status, err = node1.Compaction(node.ctx, &datapb.CompactionPlan{PlanID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+
 	t.Run("Test GetTimeTickChannel", func(t *testing.T) {
 		_, err := node.GetTimeTickChannel(node.ctx)
 		assert.NoError(t, err)
@@ -40,6 +40,7 @@ type dataSyncService struct {
 	clearSignal  chan<- UniqueID
 
 	flushingSegCache *Cache
+	delNode          *deleteNode
 
 	saveBinlog func(fu *segmentFlushUnit) error
 }
@@ -149,6 +150,7 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 			Field2BinlogPaths: id2path,
 			CheckPoints:       checkPoints,
 			StartPositions:    fu.startPositions,
+			Deltalogs:         fu.deltaLogs,
 			Flushed:           fu.flushed,
 		}
 		rsp, err := dsService.dataCoord.SaveBinlogPaths(dsService.ctx, req)
@@ -190,9 +192,19 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		return err
 	}
 
-	dn := newDeleteNode(dsService.replica, vchanInfo.GetChannelName(), dsService.flushChs.deleteBufferCh)
-
-	var deleteNode Node = dn
+	var deleteNode Node
+	dsService.delNode, err = newDeleteNode(
+		dsService.ctx,
+		dsService.replica,
+		dsService.idAllocator,
+		vchanInfo.GetChannelName(),
+		dsService.flushChs.deleteBufferCh,
+		saveBinlog,
+	)
+	if err != nil {
+		return err
+	}
+	deleteNode = dsService.delNode
 
 	// recover segment checkpoints
 	for _, us := range vchanInfo.GetUnflushedSegments() {
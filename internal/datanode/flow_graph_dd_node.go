@@ -62,6 +62,7 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 
 	var fgMsg = flowGraphMsg{
 		insertMessages: make([]*msgstream.InsertMsg, 0),
+		deleteMessages: make([]*msgstream.DeleteMsg, 0),
 		timeRange: TimeRange{
 			timestampMin: msMsg.TimestampMin(),
 			timestampMax: msMsg.TimestampMax(),
@@ -78,6 +79,13 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 				ddn.clearSignal <- ddn.collectionID
 				return []Msg{}
 			}
+		case commonpb.MsgType_AddField:
+			// schema is fetched from rootcoord by the timestamp of each insert message,
+			// so insert messages after this one are buffered with the new field
+			if msg.(*msgstream.AddFieldMsg).GetCollectionID() == ddn.collectionID {
+				log.Info("Field added to the collection", zap.Int64("collectionID", ddn.collectionID),
+					zap.String("field", msg.(*msgstream.AddFieldMsg).GetField().GetName()))
+			}
 		case commonpb.MsgType_Insert:
 			log.Debug("DDNode with insert messages")
 			imsg := msg.(*msgstream.InsertMsg)
@@ -97,6 +105,13 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 				}
 			}
 			fgMsg.insertMessages = append(fgMsg.insertMessages, imsg)
+		case commonpb.MsgType_Delete:
+			log.Debug("DDNode with delete messages")
+			dmsg := msg.(*msgstream.DeleteMsg)
+			if dmsg.CollectionID != ddn.collectionID {
+				continue
+			}
+			fgMsg.deleteMessages = append(fgMsg.deleteMessages, dmsg)
 		}
 	}
 
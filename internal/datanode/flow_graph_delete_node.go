@@ -12,22 +12,75 @@
 package datanode
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
+	"path"
+	"strconv"
+	"sync"
 
+	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/trace"
 )
 
+// DeleteData of storage
+type DeleteData = storage.DeleteData
+
 // DeleteNode is to process delete msg, flush delete info into storage.
 type deleteNode struct {
 	BaseNode
 
 	channelName string
+	delBufMu    sync.Mutex
+	delBuf      sync.Map // SegmentID to DelDataBuf
 	replica     Replica
+	idAllocator allocatorInterface
 
 	flushCh <-chan *flushMsg
+	minIOKV kv.BaseKV
+
+	dsSaveBinlog func(fu *segmentFlushUnit) error
+}
+
+// DelDataBuf buffers the delete data of a segment, as well as the time range of the deletes
+type DelDataBuf struct {
+	delData *DeleteData
+	size    int64
+	tsFrom  Timestamp
+	tsTo    Timestamp
+}
+
+func newDelDataBuf() *DelDataBuf {
+	return &DelDataBuf{
+		delData: &DeleteData{
+			Data: make(map[string]int64),
+		},
+		size:   0,
+		tsFrom: 0,
+		tsTo:   0,
+	}
+}
+
+func (ddb *DelDataBuf) updateSize(size int64) {
+	ddb.size += size
+}
+
+func (ddb *DelDataBuf) updateTimeRange(ts Timestamp) {
+	if ddb.tsFrom == 0 || ts < ddb.tsFrom {
+		ddb.tsFrom = ts
+	}
+	if ts > ddb.tsTo {
+		ddb.tsTo = ts
+	}
 }
 
 func (dn *deleteNode) Name() string {
@@ -46,12 +99,26 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 		return []Msg{}
 	}
 
-	_, ok := in[0].(*MsgStreamMsg)
+	fgMsg, ok := in[0].(*flowGraphMsg)
 	if !ok {
-		log.Warn("type assertion failed for MsgStreamMsg")
+		log.Warn("type assertion failed for flowGraphMsg")
 		return []Msg{}
 	}
 
+	var spans []opentracing.Span
+	for _, msg := range fgMsg.deleteMessages {
+		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+		spans = append(spans, sp)
+		msg.SetTraceCtx(ctx)
+	}
+
+	// delete messages -> buffer
+	for _, msg := range fgMsg.deleteMessages {
+		if err := dn.bufferDeleteMsg(msg); err != nil {
+			log.Warn("buffer delete msg failed", zap.Error(err))
+		}
+	}
+
 	select {
 	case fmsg := <-dn.flushCh:
 		currentSegID := fmsg.segmentID
@@ -59,12 +126,173 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 			zap.Int64("segmentID", currentSegID),
 			zap.Int64("collectionID", fmsg.collectionID),
 		)
+		// deletes may target any segment in this channel including the flushed ones,
+		// so all buffered delete data are flushed as delta logs
+		dn.flushDelData()
 	default:
 	}
 
+	for _, sp := range spans {
+		sp.Finish()
+	}
+
 	return []Msg{}
 }
 
+// bufferDeleteMsg routes the primary keys of delete message to the segments which may contain them,
+// and buffers them with the delete timestamp
+func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg) error {
+	log.Debug("bufferDeleteMsg", zap.Any("primary keys", msg.PrimaryKeys), zap.Strings("string primary keys", msg.StringPrimaryKeys))
+
+	// primary keys are buffered as the keys of delta log
+	keyToSegIDs := make(map[string][]int64)
+	if len(msg.StringPrimaryKeys) > 0 {
+		keyToSegIDs = dn.filterSegmentByStringPK(msg.PartitionID, msg.StringPrimaryKeys)
+	} else {
+		segIDToPks, err := dn.filterSegmentByPK(msg.PartitionID, msg.PrimaryKeys)
+		if err != nil {
+			return err
+		}
+		for pk, segIDs := range segIDToPks {
+			keyToSegIDs[strconv.FormatInt(pk, 10)] = segIDs
+		}
+	}
+
+	dn.delBufMu.Lock()
+	defer dn.delBufMu.Unlock()
+	for key, segIDs := range keyToSegIDs {
+		for _, segID := range segIDs {
+			var delDataBuf *DelDataBuf
+			if value, ok := dn.delBuf.Load(segID); ok {
+				delDataBuf = value.(*DelDataBuf)
+			} else {
+				delDataBuf = newDelDataBuf()
+			}
+
+			// keep the earliest delete timestamp of the primary key
+			if ts, ok := delDataBuf.delData.Data[key]; !ok || int64(msg.Timestamp) < ts {
+				if !ok {
+					delDataBuf.updateSize(1)
+				}
+				delDataBuf.delData.Data[key] = int64(msg.Timestamp)
+			}
+			delDataBuf.updateTimeRange(msg.Timestamp)
+			dn.delBuf.Store(segID, delDataBuf)
+		}
+	}
+
+	return nil
+}
+
+// flushDelData serializes all buffered delete data into delta logs, saves them into storage
+// and reports the paths of delta logs to data coord
+func (dn *deleteNode) flushDelData() {
+	dn.delBufMu.Lock()
+	defer dn.delBufMu.Unlock()
+	dn.delBuf.Range(func(key, value interface{}) bool {
+		segID := key.(UniqueID)
+		delDataBuf := value.(*DelDataBuf)
+
+		deltaLog, err := dn.saveDeltaLog(segID, delDataBuf)
+		if err != nil {
+			log.Error("Flush delete data failed", zap.Int64("segmentID", segID), zap.Error(err))
+			return true
+		}
+
+		collID, _, err := dn.replica.getCollectionAndPartitionID(segID)
+		if err != nil {
+			log.Error("Flush delete data failed .. cannot get segment ..", zap.Int64("segmentID", segID), zap.Error(err))
+			return true
+		}
+
+		err = dn.dsSaveBinlog(&segmentFlushUnit{
+			collID:     collID,
+			segID:      segID,
+			field2Path: map[UniqueID]string{},
+			deltaLogs:  []*datapb.DeltaLogInfo{deltaLog},
+			flushed:    false,
+		})
+		if err != nil {
+			log.Error("data service save delta log path failed", zap.Int64("segmentID", segID), zap.Error(err))
+			return true
+		}
+
+		dn.delBuf.Delete(segID)
+		return true
+	})
+}
+
+// mergeDelBuf moves the buffered delete data of compacted segments to the segment they are compacted to
+func (dn *deleteNode) mergeDelBuf(compactedTo UniqueID, compactedFrom []UniqueID) {
+	dn.delBufMu.Lock()
+	defer dn.delBufMu.Unlock()
+
+	var target *DelDataBuf
+	if value, ok := dn.delBuf.Load(compactedTo); ok {
+		target = value.(*DelDataBuf)
+	} else {
+		target = newDelDataBuf()
+	}
+
+	for _, segID := range compactedFrom {
+		value, ok := dn.delBuf.LoadAndDelete(segID)
+		if !ok {
+			continue
+		}
+		delDataBuf := value.(*DelDataBuf)
+		for pk, ts := range delDataBuf.delData.Data {
+			if old, ok := target.delData.Data[pk]; !ok || ts < old {
+				if !ok {
+					target.updateSize(1)
+				}
+				target.delData.Data[pk] = ts
+			}
+			target.updateTimeRange(Timestamp(ts))
+		}
+	}
+
+	if target.size > 0 {
+		dn.delBuf.Store(compactedTo, target)
+	}
+}
+
+func (dn *deleteNode) saveDeltaLog(segID UniqueID, delDataBuf *DelDataBuf) (*datapb.DeltaLogInfo, error) {
+	collID, partID, err := dn.replica.getCollectionAndPartitionID(segID)
+	if err != nil {
+		return nil, err
+	}
+
+	delCodec := storage.NewDeleteCodec(&etcdpb.CollectionMeta{
+		ID: collID,
+	})
+	blob, err := delCodec.Serialize(partID, segID, delDataBuf.delData)
+	if err != nil {
+		return nil, err
+	}
+
+	logID, err := dn.idAllocator.allocID()
+	if err != nil {
+		return nil, err
+	}
+
+	// no error raise if alloc=false
+	k, _ := dn.idAllocator.genKey(false, collID, partID, segID, logID)
+	key := path.Join(Params.DeleteBinlogRootPath, k)
+
+	err = dn.minIOKV.Save(key, string(blob.Value))
+	if err != nil {
+		return nil, err
+	}
+
+	return &datapb.DeltaLogInfo{
+		RecordEntries: uint64(delDataBuf.size),
+		TimestampFrom: delDataBuf.tsFrom,
+		TimestampTo:   delDataBuf.tsTo,
+		DeltaLogPath:  key,
+		DeltaLogSize:  int64(len(blob.Value)),
+	}, nil
+}
+
 // filterSegmentByPK returns the bloom filter check result.
 // If the key may exists in the segment, returns it in map.
 // If the key not exists in the segment, the segment is filter out.
@@ -87,16 +315,57 @@ func (dn *deleteNode) filterSegmentByPK(partID UniqueID, pks []int64) (map[int64
 	return results, nil
 }
 
-func newDeleteNode(replica Replica, channelName string, flushCh <-chan *flushMsg) *deleteNode {
+// filterSegmentByStringPK returns the segments which may contain each of the string primary keys
+func (dn *deleteNode) filterSegmentByStringPK(partID UniqueID, pks []string) map[string][]int64 {
+	results := make(map[string][]int64)
+	segments := dn.replica.filterSegments(dn.channelName, partID)
+	for _, segment := range segments {
+		for _, pk := range pks {
+			if segment.pkFilter.Test([]byte(pk)) {
+				results[pk] = append(results[pk], segment.segmentID)
+			}
+		}
+	}
+	return results
+}
+
+func newDeleteNode(
+	ctx context.Context,
+	replica Replica,
+	idAllocator allocatorInterface,
+	channelName string,
+	flushCh <-chan *flushMsg,
+	saveBinlog func(*segmentFlushUnit) error,
+) (*deleteNode, error) {
 	baseNode := BaseNode{}
 	baseNode.SetMaxParallelism(Params.FlowGraphMaxQueueLength)
 
+	// MinIO
+	option := &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+	}
+
+	minIOKV, err := miniokv.NewMinIOKV(ctx, option)
+	if err != nil {
+		return nil, err
+	}
+
 	return &deleteNode{
 		BaseNode: baseNode,
 
 		channelName: channelName,
+		delBuf:      sync.Map{},
 		replica:     replica,
+		idAllocator: idAllocator,
 
 		flushCh: flushCh,
-	}
+		minIOKV: minIOKV,
+
+		dsSaveBinlog: saveBinlog,
+	}, nil
 }
@@ -12,11 +12,17 @@
 package datanode
 
 import (
+	"context"
 	"encoding/binary"
 	"testing"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 type mockReplica struct {
@@ -41,6 +47,10 @@ func (replica *mockReplica) filterSegments(channelName string, partitionID Uniqu
 	return results
 }
 
+func (replica *mockReplica) getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error) {
+	return 0, 1, nil
+}
+
 func TestFlowGraphDeleteNode_newDeleteNode(te *testing.T) {
 	tests := []struct {
 		replica Replica
@@ -52,7 +62,8 @@ func TestFlowGraphDeleteNode_newDeleteNode(te *testing.T) {
 
 	for _, test := range tests {
 		te.Run(test.description, func(t *testing.T) {
-			dn := newDeleteNode(test.replica, "", make(chan *flushMsg))
+			dn, err := newDeleteNode(context.Background(), test.replica, NewAllocatorFactory(), "", make(chan *flushMsg), nil)
+			assert.Nil(t, err)
 
 			assert.NotNil(t, dn)
 			assert.Equal(t, "deleteNode", dn.Name())
@@ -73,9 +84,9 @@ func TestFlowGraphDeleteNode_Operate(te *testing.T) {
 			"Invalid input length == 0"},
 		{[]Msg{&flowGraphMsg{}, &flowGraphMsg{}, &flowGraphMsg{}}, nil,
 			"Invalid input length == 3"},
-		{[]Msg{&flowGraphMsg{}}, nil,
-			"Invalid input length == 1 but input message is not msgStreamMsg"},
-		{nil, []Msg{&MsgStreamMsg{}},
+		{[]Msg{&MsgStreamMsg{}}, nil,
+			"Invalid input length == 1 but input message is not flowGraphMsg"},
+		{nil, []Msg{&flowGraphMsg{}},
 			"valid input"},
 	}
 
@@ -147,7 +158,8 @@ func Test_GetSegmentsByPKs(t *testing.T) {
 	mockReplica.normalSegments[segment4.segmentID] = segment4
 	mockReplica.flushedSegments[segment5.segmentID] = segment5
 	mockReplica.flushedSegments[segment6.segmentID] = segment6
-	dn := newDeleteNode(mockReplica, "test", make(chan *flushMsg))
+	dn, err := newDeleteNode(context.Background(), mockReplica, NewAllocatorFactory(), "test", make(chan *flushMsg), nil)
+	assert.Nil(t, err)
 	results, err := dn.filterSegmentByPK(0, []int64{0, 1, 2, 3, 4})
 	assert.Nil(t, err)
 	expected := map[int64][]int64{
@@ -160,4 +172,133 @@ func Test_GetSegmentsByPKs(t *testing.T) {
 	for key, value := range expected {
 		assert.ElementsMatch(t, value, results[key])
 	}
+
+	segment1.updateStringPKRange([]string{"a"})
+	segment4.updateStringPKRange([]string{"b"})
+	strResults := dn.filterSegmentByStringPK(0, []string{"a", "b"})
+	assert.Contains(t, strResults["a"], segment1.segmentID)
+	assert.Contains(t, strResults["b"], segment4.segmentID)
+}
+
+func TestFlowGraphDeleteNode_FlushDeltaLogs(t *testing.T) {
+	buf := make([]byte, 8)
+	filter := bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive)
+	for i := 0; i < 3; i++ {
+		binary.BigEndian.PutUint64(buf, uint64(i))
+		filter.Add(buf)
+	}
+	mockReplica := &mockReplica{
+		newSegments: map[UniqueID]*Segment{
+			1: {segmentID: 1, channelName: "test", pkFilter: filter},
+		},
+		normalSegments:  make(map[UniqueID]*Segment),
+		flushedSegments: make(map[UniqueID]*Segment),
+	}
+
+	var flushUnits []*segmentFlushUnit
+	saveBinlog := func(fu *segmentFlushUnit) error {
+		flushUnits = append(flushUnits, fu)
+		return nil
+	}
+
+	flushCh := make(chan *flushMsg, 10)
+	dn, err := newDeleteNode(context.Background(), mockReplica, NewAllocatorFactory(), "test", flushCh, saveBinlog)
+	require.NoError(t, err)
+
+	genDeleteMsg := func(pks []int64, ts Timestamp) *msgstream.DeleteMsg {
+		return &msgstream.DeleteMsg{
+			BaseMsg: msgstream.BaseMsg{
+				HashValues: []uint32{0},
+			},
+			DeleteRequest: internalpb.DeleteRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_Delete,
+				},
+				CollectionID: 0,
+				PartitionID:  1,
+				PrimaryKeys:  pks,
+				Timestamp:    ts,
+			},
+		}
+	}
+
+	fgMsg := &flowGraphMsg{
+		deleteMessages: []*msgstream.DeleteMsg{
+			genDeleteMsg([]int64{0, 1, 10000}, 200),
+			genDeleteMsg([]int64{1, 2}, 100),
+		},
+	}
+
+	rt := dn.Operate([]Msg{fgMsg})
+	assert.Empty(t, rt)
+	assert.Empty(t, flushUnits)
+
+	value, ok := dn.delBuf.Load(UniqueID(1))
+	require.True(t, ok)
+	delDataBuf := value.(*DelDataBuf)
+	assert.EqualValues(t, 3, delDataBuf.size)
+	assert.EqualValues(t, 100, delDataBuf.tsFrom)
+	assert.EqualValues(t, 200, delDataBuf.tsTo)
+	assert.EqualValues(t, 200, delDataBuf.delData.Data["0"])
+	assert.EqualValues(t, 100, delDataBuf.delData.Data["1"])
+	assert.EqualValues(t, 100, delDataBuf.delData.Data["2"])
+
+	flushCh <- &flushMsg{0, 300, 1, 0}
+	rt = dn.Operate([]Msg{&flowGraphMsg{}})
+	assert.Empty(t, rt)
+
+	require.Equal(t, 1, len(flushUnits))
+	assert.EqualValues(t, 1, flushUnits[0].segID)
+	assert.False(t, flushUnits[0].flushed)
+	require.Equal(t, 1, len(flushUnits[0].deltaLogs))
+	deltaLog := flushUnits[0].deltaLogs[0]
+	assert.EqualValues(t, 3, deltaLog.GetRecordEntries())
+	assert.EqualValues(t, 100, deltaLog.GetTimestampFrom())
+	assert.EqualValues(t, 200, deltaLog.GetTimestampTo())
+	assert.NotEmpty(t, deltaLog.GetDeltaLogPath())
+
+	_, ok = dn.delBuf.Load(UniqueID(1))
+	assert.False(t, ok)
+}
+
+func TestFlowGraphDeleteNode_mergeDelBuf(t *testing.T) {
+	dn := &deleteNode{}
+
+	buf1 := newDelDataBuf()
+	buf1.delData.Data["1"] = 100
+	buf1.delData.Data["2"] = 200
+	buf1.updateSize(2)
+	buf1.updateTimeRange(100)
+	buf1.updateTimeRange(200)
+	dn.delBuf.Store(UniqueID(1), buf1)
+
+	buf2 := newDelDataBuf()
+	buf2.delData.Data["2"] = 50
+	buf2.delData.Data["3"] = 300
+	buf2.updateSize(2)
+	buf2.updateTimeRange(50)
+	buf2.updateTimeRange(300)
+	dn.delBuf.Store(UniqueID(2), buf2)
+
+	dn.mergeDelBuf(3, []UniqueID{1, 2, 4})
+
+	_, ok := dn.delBuf.Load(UniqueID(1))
+	assert.False(t, ok)
+	_, ok = dn.delBuf.Load(UniqueID(2))
+	assert.False(t, ok)
+
+	value, ok := dn.delBuf.Load(UniqueID(3))
+	require.True(t, ok)
+	merged := value.(*DelDataBuf)
+	assert.EqualValues(t, 3, merged.size)
+	assert.EqualValues(t, 50, merged.tsFrom)
+	assert.EqualValues(t, 300, merged.tsTo)
+	assert.EqualValues(t, 100, merged.delData.Data["1"])
+	assert.EqualValues(t, 50, merged.delData.Data["2"])
+	assert.EqualValues(t, 300, merged.delData.Data["3"])
+
+	// nothing to merge
+	dn.mergeDelBuf(5, []UniqueID{6})
+	_, ok = dn.delBuf.Load(UniqueID(5))
+	assert.False(t, ok)
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -78,6 +79,7 @@ type segmentFlushUnit struct {
 	field2Path     map[UniqueID]string
 	checkPoint     map[UniqueID]segmentCheckPoint
 	startPositions []*datapb.SegmentStartPosition
+	deltaLogs      []*datapb.DeltaLogInfo
 	flushed        bool
 }
 
@@ -340,7 +342,8 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		sp.Finish()
 	}
 
-	return nil
+	// the flowgraph message is passed on to deleteNode
+	return []Msg{fgMsg}
 }
 
 func (ibNode *insertBufferNode) updateSegStatesInReplica(insertMsgs []*msgstream.InsertMsg, startPos, endPos *internalpb.MsgPosition) (seg2Upload []UniqueID, err error) {
@@ -427,6 +430,12 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 	buffer := bd.(*BufferData)
 	idata := buffer.buffer
 
+	// rows buffered before a field was added to the collection have no data for it
+	if err := storage.FillMissingFields(collSchema, idata); err != nil {
+		log.Error("fill missing fields failed", zap.Int64("segmentID", currentSegID), zap.Error(err))
+		return err
+	}
+
 	// 1.2 Get Fields
 	var pos int = 0 // Record position of blob
 	var fieldIDs []int64
@@ -436,6 +445,9 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 		fieldTypes = append(fieldTypes, field.DataType)
 	}
 
+	// primary keys of the entities, row IDs are used if there's no primary key field
+	pks := msg.GetRowIDs()
+	var strPKs []string
 	for _, field := range collSchema.Fields {
 		switch field.DataType {
 		case schemapb.DataType_FloatVector:
@@ -599,6 +611,9 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 				}
 				pos += int(unsafe.Sizeof(*(&v)))
 				fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+				if field.IsPrimaryKey {
+					pks = fieldData.Data[len(fieldData.Data)-len(msg.RowData):]
+				}
 			}
 
 		case schemapb.DataType_Float:
@@ -635,6 +650,40 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 
 			pos += int(unsafe.Sizeof(*(&v)))
 			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+
+		case schemapb.DataType_String:
+			maxLength, err := typeutil.GetMaxLength(field)
+			if err != nil {
+				log.Error("failed to get max length of string field", zap.Error(err))
+				return err
+			}
+
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.StringFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([]string, 0),
+				}
+			}
+
+			// a string is stored as 4 bytes length followed by max length bytes
+			fieldData := idata.Data[field.FieldID].(*storage.StringFieldData)
+			for _, blob := range msg.RowData {
+				if len(blob.GetValue()) < pos+int(unsafe.Sizeof(uint32(0)))+maxLength {
+					return fmt.Errorf("invalid row data length %d of insert message", len(blob.GetValue()))
+				}
+				var length uint32
+				readBinary(blob.GetValue()[pos:], &length, field.DataType)
+				if int(length) > maxLength {
+					return fmt.Errorf("invalid length %d of string field %s, max length = %d", length, field.Name, maxLength)
+				}
+				start := pos + int(unsafe.Sizeof(length))
+				fieldData.Data = append(fieldData.Data, string(blob.GetValue()[start:start+int(length)]))
+			}
+			pos += int(unsafe.Sizeof(uint32(0))) + maxLength
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			if field.IsPrimaryKey {
+				strPKs = fieldData.Data[len(fieldData.Data)-len(msg.RowData):]
+			}
 		}
 	}
 
@@ -648,7 +697,11 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 	ibNode.replica.updateSegmentEndPosition(currentSegID, endPos)
 
 	// update segment pk filter
-	ibNode.replica.updateSegmentPKRange(currentSegID, msg.GetRowIDs())
+	if strPKs != nil {
+		ibNode.replica.updateSegmentStringPKRange(currentSegID, strPKs)
+	} else {
+		ibNode.replica.updateSegmentPKRange(currentSegID, pks)
+	}
 	return nil
 }
 
@@ -693,6 +746,13 @@ func flushSegment(
 		return
 	}
 
+	// the schema may have new fields added after the data was buffered
+	if err := storage.FillMissingFields(collMeta.Schema, data.(*InsertData)); err != nil {
+		log.Error("Flush failed ... cannot fill missing fields ..", zap.Error(err))
+		clearFn(false)
+		return
+	}
+
 	binLogs, statsBinlogs, err := inCodec.Serialize(partitionID, segID, data.(*InsertData))
 	if err != nil {
 		log.Error("Flush failed ... cannot generate binlog ..", zap.Error(err))
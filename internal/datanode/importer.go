@@ -0,0 +1,314 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+var errImportEmptyTask = errors.New("import task contains no file or no channel")
+
+// importTask parses the files of a bulk import task, writes the rows into the binlogs of new segments
+// and registers the segments as flushed, the rows never go through the dml channels
+type importTask struct {
+	ctx context.Context
+
+	info        *datapb.ImportTaskInfo
+	kv          kv.BaseKV
+	idAllocator allocatorInterface
+	rootCoord   types.RootCoord
+	dataCoord   types.DataCoord
+}
+
+// importSegment is a segment written by import task
+type importSegment struct {
+	segmentID UniqueID
+	channel   string
+	binlogs   []*datapb.FieldBinlog
+}
+
+func newImportTask(
+	ctx context.Context,
+	info *datapb.ImportTaskInfo,
+	kv kv.BaseKV,
+	idAllocator allocatorInterface,
+	rootCoord types.RootCoord,
+	dataCoord types.DataCoord,
+) *importTask {
+	return &importTask{
+		ctx:         ctx,
+		info:        info,
+		kv:          kv,
+		idAllocator: idAllocator,
+		rootCoord:   rootCoord,
+		dataCoord:   dataCoord,
+	}
+}
+
+// execute imports the files and reports the result to data coord
+func (t *importTask) execute() {
+	rowCount, segmentIDs, err := t.importFiles()
+	result := &datapb.ImportResult{
+		Status:     &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		TaskID:     t.info.GetTaskID(),
+		State:      commonpb.ImportState_ImportCompleted,
+		RowCount:   rowCount,
+		SegmentIDs: segmentIDs,
+	}
+	if err != nil {
+		log.Warn("import failed", zap.Int64("taskID", t.info.GetTaskID()), zap.Error(err))
+		result.Status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: err.Error()}
+		result.State = commonpb.ImportState_ImportFailed
+		result.RowCount = 0
+	}
+
+	status, err := t.dataCoord.ReportImport(t.ctx, result)
+	if err != nil {
+		log.Warn("failed to report import", zap.Int64("taskID", t.info.GetTaskID()), zap.Error(err))
+		return
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		log.Warn("failed to report import", zap.Int64("taskID", t.info.GetTaskID()), zap.String("reason", status.GetReason()))
+		return
+	}
+	log.Debug("import done", zap.Int64("taskID", t.info.GetTaskID()), zap.String("state", result.GetState().String()),
+		zap.Int64("rowCount", result.GetRowCount()), zap.Int64s("segmentIDs", segmentIDs))
+}
+
+// importFiles executes the import, the steps are:
+// 1. load and parse the files into columns, fill the system fields and the auto id primary keys
+// 2. shard the rows to the channels by primary key, the same as the inserts through proxy
+// 3. allocate import segments of each shard from data coord and save the binlogs of each segment
+// 4. register the segments as flushed
+// It returns the number of rows and the segments allocated, which are dropped by data coord if import fails.
+func (t *importTask) importFiles() (int64, []UniqueID, error) {
+	info := t.info
+	channels := info.GetChannelNames()
+	if len(info.GetFiles()) == 0 || len(channels) == 0 {
+		return 0, nil, errImportEmptyTask
+	}
+	log.Debug("import start", zap.Int64("taskID", info.GetTaskID()), zap.Strings("files", info.GetFiles()))
+
+	schema, err := newMetaService(t.rootCoord, info.GetCollectionID()).getCollectionSchema(t.ctx, info.GetCollectionID(), 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	columns, rowCount, err := t.loadColumns(schema)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// rows are sharded by the primary key field, which may be a string field
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return 0, nil, err
+	}
+	pkField, err := helper.GetPrimaryKeyField()
+	if err != nil {
+		return 0, nil, err
+	}
+	pkFieldID := pkField.GetFieldID()
+	shards := make([][]int, len(channels))
+	switch pkData := columns[pkFieldID].(type) {
+	case *storage.Int64FieldData:
+		for i, pk := range pkData.Data {
+			hash, _ := typeutil.Hash32Int64(pk)
+			idx := hash % uint32(len(channels))
+			shards[idx] = append(shards[idx], i)
+		}
+	case *storage.StringFieldData:
+		for i, pk := range pkData.Data {
+			hash, _ := typeutil.Hash32String(pk)
+			idx := uint32(hash) % uint32(len(channels))
+			shards[idx] = append(shards[idx], i)
+		}
+	default:
+		return 0, nil, fmt.Errorf("primary key field %d not found in import data", pkFieldID)
+	}
+
+	meta := &etcdpb.CollectionMeta{ID: info.GetCollectionID(), Schema: schema}
+	var segments []*importSegment
+	segmentIDs := func() []UniqueID {
+		ids := make([]UniqueID, 0, len(segments))
+		for _, segment := range segments {
+			ids = append(ids, segment.segmentID)
+		}
+		return ids
+	}
+	for i, rows := range shards {
+		for len(rows) > 0 {
+			segmentID, count, err := t.allocSegment(channels[i], len(rows))
+			if err != nil {
+				return 0, segmentIDs(), err
+			}
+			segment := &importSegment{segmentID: segmentID, channel: channels[i]}
+			segments = append(segments, segment)
+
+			iData, err := sliceColumns(columns, rows[:count])
+			if err != nil {
+				return 0, segmentIDs(), err
+			}
+			segment.binlogs, err = saveInsertBinlogs(t.kv, t.idAllocator, meta, info.GetPartitionID(), segmentID, iData)
+			if err != nil {
+				return 0, segmentIDs(), err
+			}
+			rows = rows[count:]
+		}
+	}
+
+	// segments are registered after all the binlogs are saved, so that a failed import leaves no flushed segment in most cases
+	for _, segment := range segments {
+		status, err := t.dataCoord.SaveBinlogPaths(t.ctx, &datapb.SaveBinlogPathsRequest{
+			Base: &commonpb.MsgBase{
+				SourceID: Params.NodeID,
+			},
+			SegmentID:         segment.segmentID,
+			CollectionID:      info.GetCollectionID(),
+			Field2BinlogPaths: segment.binlogs,
+			Flushed:           true,
+		})
+		if err != nil {
+			return 0, segmentIDs(), err
+		}
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			return 0, segmentIDs(), fmt.Errorf("save binlog paths of segment %d failed, reason = %s", segment.segmentID, status.GetReason())
+		}
+	}
+	return int64(rowCount), segmentIDs(), nil
+}
+
+// loadColumns loads and parses all the files of task, returns the columns of all fields with the number of rows
+func (t *importTask) loadColumns(schema *schemapb.CollectionSchema) (importutil.Columns, int, error) {
+	columns := make(importutil.Columns)
+	for _, file := range t.info.GetFiles() {
+		value, err := t.kv.Load(file)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to load file %s: %w", file, err)
+		}
+		parsed, err := importutil.Parse(file, []byte(value), schema)
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := importutil.Merge(columns, parsed); err != nil {
+			return nil, 0, err
+		}
+	}
+	rowCount, err := importutil.Validate(columns, schema)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rowIDs, err := t.allocRowIDs(rowCount)
+	if err != nil {
+		return nil, 0, err
+	}
+	timestamps := make([]int64, rowCount)
+	for i := range timestamps {
+		timestamps[i] = int64(t.info.GetTimestamp())
+	}
+	columns[common.RowIDField] = &storage.Int64FieldData{Data: rowIDs}
+	columns[common.TimeStampField] = &storage.Int64FieldData{Data: timestamps}
+	for _, field := range schema.GetFields() {
+		if field.GetIsPrimaryKey() && field.GetAutoID() {
+			columns[field.GetFieldID()] = &storage.Int64FieldData{Data: append([]int64(nil), rowIDs...)}
+		}
+	}
+	return columns, rowCount, nil
+}
+
+// allocRowIDs allocates continuous row ids from root coord
+func (t *importTask) allocRowIDs(count int) ([]int64, error) {
+	resp, err := t.rootCoord.AllocID(t.ctx, &rootcoordpb.AllocIDRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_RequestID,
+			SourceID: Params.NodeID,
+		},
+		Count: uint32(count),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	rowIDs := make([]int64, count)
+	for i := range rowIDs {
+		rowIDs[i] = resp.GetID() + int64(i)
+	}
+	return rowIDs, nil
+}
+
+// allocSegment allocates a new import segment for the rows of channel, returns the segment and the number of rows assigned
+func (t *importTask) allocSegment(channel string, count int) (UniqueID, int, error) {
+	resp, err := t.dataCoord.AssignSegmentID(t.ctx, &datapb.AssignSegmentIDRequest{
+		NodeID:   Params.NodeID,
+		PeerRole: typeutil.DataNodeRole,
+		SegmentIDRequests: []*datapb.SegmentIDRequest{
+			{
+				ChannelName:  channel,
+				Count:        uint32(count),
+				CollectionID: t.info.GetCollectionID(),
+				PartitionID:  t.info.GetPartitionID(),
+				IsImport:     true,
+			},
+		},
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return 0, 0, errors.New(resp.GetStatus().GetReason())
+	}
+	if len(resp.GetSegIDAssignments()) == 0 || resp.GetSegIDAssignments()[0].GetCount() == 0 {
+		return 0, 0, fmt.Errorf("no segment assigned for channel %s", channel)
+	}
+	assignment := resp.GetSegIDAssignments()[0]
+	assigned := int(assignment.GetCount())
+	if assigned > count {
+		assigned = count
+	}
+	return assignment.GetSegID(), assigned, nil
+}
+
+// sliceColumns builds the insert data of the rows in columns
+func sliceColumns(columns importutil.Columns, rows []int) (*InsertData, error) {
+	iData := &InsertData{Data: make(map[storage.FieldID]storage.FieldData, len(columns))}
+	for fieldID, fieldData := range columns {
+		var sliced storage.FieldData
+		for _, row := range rows {
+			var err error
+			if sliced, err = appendFieldDataRow(sliced, fieldData, row); err != nil {
+				return nil, err
+			}
+		}
+		setFieldDataNumRows(sliced, int64(len(rows)))
+		iData.Data[fieldID] = sliced
+	}
+	return iData, nil
+}
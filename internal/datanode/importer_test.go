@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+// importDataCoord assigns import segments of at most maxRows rows and records the requests
+type importDataCoord struct {
+	DataCoordFactory
+	maxRows   uint32
+	nextSegID UniqueID
+
+	saved   []*datapb.SaveBinlogPathsRequest
+	results []*datapb.ImportResult
+}
+
+func (dc *importDataCoord) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
+	resp := &datapb.AssignSegmentIDResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	for _, r := range req.GetSegmentIDRequests() {
+		count := r.GetCount()
+		if count > dc.maxRows {
+			count = dc.maxRows
+		}
+		dc.nextSegID++
+		resp.SegIDAssignments = append(resp.SegIDAssignments, &datapb.SegmentIDAssignment{
+			SegID:       dc.nextSegID,
+			ChannelName: r.GetChannelName(),
+			Count:       count,
+		})
+	}
+	return resp, nil
+}
+
+func (dc *importDataCoord) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
+	status, err := dc.DataCoordFactory.SaveBinlogPaths(ctx, req)
+	if err == nil && status.GetErrorCode() == commonpb.ErrorCode_Success {
+		dc.saved = append(dc.saved, req)
+	}
+	return status, err
+}
+
+func (dc *importDataCoord) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	dc.results = append(dc.results, req)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+const importTestJSON = `{
+	"float_vector_field": [[0.1, 0.2], [0.3, 0.4], [0.5, 0.6]],
+	"binary_vector_field": [[1, 2, 3, 4], [5, 6, 7, 8], [9, 10, 11, 12]],
+	"bool_field": [true, false, true],
+	"int8_field": [1, 2, 3],
+	"int16_field": [1, 2, 3],
+	"int32_field": [1, 2, 3],
+	"int64_field": [1, 2, 3],
+	"float32_field": [0.1, 0.2, 0.3],
+	"float64_field": [0.1, 0.2, 0.3]
+}`
+
+func TestImportTask(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kv := memkv.NewMemoryKV()
+	err := kv.Save("import/rows.json", importTestJSON)
+	assert.Nil(t, err)
+	rc := &RootCoordFactory{ID: 1000, collectionID: 1, collectionName: "import"}
+
+	newInfo := func(files ...string) *datapb.ImportTaskInfo {
+		return &datapb.ImportTaskInfo{
+			TaskID:       10,
+			CollectionID: 1,
+			PartitionID:  2,
+			ChannelNames: []string{"ch1"},
+			Files:        files,
+			Timestamp:    100,
+		}
+	}
+
+	t.Run("import rows", func(t *testing.T) {
+		dc := &importDataCoord{maxRows: 2}
+		task := newImportTask(ctx, newInfo("import/rows.json"), kv, NewAllocatorFactory(), rc, dc)
+		task.execute()
+
+		assert.Equal(t, 1, len(dc.results))
+		result := dc.results[0]
+		assert.Equal(t, commonpb.ImportState_ImportCompleted, result.GetState())
+		assert.EqualValues(t, 3, result.GetRowCount())
+		// rows are split by the max rows of segment
+		assert.ElementsMatch(t, []UniqueID{1, 2}, result.GetSegmentIDs())
+		assert.Equal(t, 2, len(dc.saved))
+		for _, req := range dc.saved {
+			assert.True(t, req.GetFlushed())
+			assert.NotEmpty(t, req.GetField2BinlogPaths())
+			for _, fieldBinlog := range req.GetField2BinlogPaths() {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					_, err := kv.Load(binlog)
+					assert.Nil(t, err)
+				}
+			}
+		}
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		dc := &importDataCoord{maxRows: 2}
+		task := newImportTask(ctx, newInfo("import/missing.json"), kv, NewAllocatorFactory(), rc, dc)
+		task.execute()
+
+		assert.Equal(t, 1, len(dc.results))
+		assert.Equal(t, commonpb.ImportState_ImportFailed, dc.results[0].GetState())
+		assert.NotEmpty(t, dc.results[0].GetStatus().GetReason())
+	})
+
+	t.Run("save binlog paths failed", func(t *testing.T) {
+		dc := &importDataCoord{maxRows: 2}
+		dc.SaveBinlogPathNotSucess = true
+		task := newImportTask(ctx, newInfo("import/rows.json"), kv, NewAllocatorFactory(), rc, dc)
+		task.execute()
+
+		assert.Equal(t, 1, len(dc.results))
+		assert.Equal(t, commonpb.ImportState_ImportFailed, dc.results[0].GetState())
+		// allocated segments are reported to be dropped
+		assert.ElementsMatch(t, []UniqueID{1, 2}, dc.results[0].GetSegmentIDs())
+	})
+
+	t.Run("empty task", func(t *testing.T) {
+		dc := &importDataCoord{maxRows: 2}
+		task := newImportTask(ctx, newInfo(), kv, NewAllocatorFactory(), rc, dc)
+		task.execute()
+
+		assert.Equal(t, 1, len(dc.results))
+		assert.Equal(t, commonpb.ImportState_ImportFailed, dc.results[0].GetState())
+		assert.Equal(t, errImportEmptyTask.Error(), dc.results[0].GetStatus().GetReason())
+	})
+}
+
+func TestSliceColumns(t *testing.T) {
+	columns := map[storage.FieldID]storage.FieldData{
+		100: &storage.Int64FieldData{Data: []int64{1, 2, 3}},
+		101: &storage.FloatVectorFieldData{Data: []float32{1, 1, 2, 2, 3, 3}, Dim: 2},
+	}
+	iData, err := sliceColumns(columns, []int{0, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 3}, iData.Data[100].(*storage.Int64FieldData).Data)
+	assert.Equal(t, []int64{2}, iData.Data[100].(*storage.Int64FieldData).NumRows)
+	assert.Equal(t, []float32{1, 1, 3, 3}, iData.Data[101].(*storage.FloatVectorFieldData).Data)
+}
@@ -147,6 +147,17 @@ type DataCoordFactory struct {
 
 	SaveBinlogPathError     bool
 	SaveBinlogPathNotSucess bool
+
+	CompleteCompactionNotSuccess bool
+	compactionResults            []*datapb.CompactionResult
+}
+
+func (ds *DataCoordFactory) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error) {
+	if ds.CompleteCompactionNotSuccess {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}, nil
+	}
+	ds.compactionResults = append(ds.compactionResults, req)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
 func (ds *DataCoordFactory) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
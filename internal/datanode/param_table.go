@@ -34,6 +34,7 @@ type ParamTable struct {
 	FlushInsertBufferSize   int64
 	InsertBinlogRootPath    string
 	StatsBinlogRootPath     string
+	DeleteBinlogRootPath    string
 	Alias                   string // Different datanode in one machine
 
 	// === DataNode External Components Configs ===
@@ -100,6 +101,7 @@ func (p *ParamTable) Init() {
 	p.initFlushInsertBufferSize()
 	p.initInsertBinlogRootPath()
 	p.initStatsBinlogRootPath()
+	p.initDeleteBinlogRootPath()
 
 	// === DataNode External Components Configs ===
 	// --- Pulsar ---
@@ -162,6 +164,14 @@ func (p *ParamTable) initStatsBinlogRootPath() {
 	p.StatsBinlogRootPath = path.Join(rootPath, "stats_log")
 }
 
+func (p *ParamTable) initDeleteBinlogRootPath() {
+	rootPath, err := p.Load("minio.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.DeleteBinlogRootPath = path.Join(rootPath, "delta_log")
+}
+
 // ---- Pulsar ----
 func (p *ParamTable) initPulsarAddress() {
 	url, err := p.Load("_PulsarAddress")
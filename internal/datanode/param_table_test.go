@@ -134,4 +134,10 @@ func TestParamTable(t *testing.T) {
 		p.Init()
 		assert.Equal(t, path.Join("files", "stats_log"), Params.StatsBinlogRootPath)
 	})
+
+	t.Run("Test DeleteBinlogRootPath", func(t *testing.T) {
+		p := new(ParamTable)
+		p.Init()
+		assert.Equal(t, path.Join("files", "delta_log"), Params.DeleteBinlogRootPath)
+	})
 }
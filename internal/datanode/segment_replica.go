@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -43,12 +44,14 @@ type Replica interface {
 
 	addNewSegment(segID, collID, partitionID UniqueID, channelName string, startPos, endPos *internalpb.MsgPosition) error
 	addNormalSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64, cp *segmentCheckPoint) error
+	mergeFlushedSegments(segID, collID, partitionID UniqueID, compactedFrom []UniqueID, channelName string, numOfRows int64, pks storage.FieldData) error
 	filterSegments(channelName string, partitionID UniqueID) []*Segment
 	listNewSegmentsStartPositions() []*datapb.SegmentStartPosition
 	listSegmentsCheckPoints() map[UniqueID]segmentCheckPoint
 	updateSegmentEndPosition(segID UniqueID, endPos *internalpb.MsgPosition)
 	updateSegmentCheckPoint(segID UniqueID)
 	updateSegmentPKRange(segID UniqueID, rowIDs []int64)
+	updateSegmentStringPKRange(segID UniqueID, pks []string)
 	hasSegment(segID UniqueID, countFlushed bool) bool
 
 	updateStatistics(segID UniqueID, numRows int64)
@@ -105,6 +108,13 @@ func (s *Segment) updatePKRange(rowIDs []int64) {
 	}
 }
 
+// updateStringPKRange adds string primary keys into the bloom filter, the pk range only holds int64 primary keys
+func (s *Segment) updateStringPKRange(pks []string) {
+	for _, pk := range pks {
+		s.pkFilter.Add([]byte(pk))
+	}
+}
+
 var _ Replica = &SegmentReplica{}
 
 func newReplica(rc types.RootCoord, collID UniqueID) Replica {
@@ -333,6 +343,58 @@ func (replica *SegmentReplica) addFlushedSegment(segID, collID, partitionID Uniq
 	return nil
 }
 
+// mergeFlushedSegments replaces the compacted segments with a *Flushed* segment, the bloom filter
+// and pk range of the new segment are built from the primary key field data
+func (replica *SegmentReplica) mergeFlushedSegments(segID, collID, partitionID UniqueID, compactedFrom []UniqueID,
+	channelName string, numOfRows int64, pks storage.FieldData) error {
+	if collID != replica.collectionID {
+		log.Warn("Mismatch collection",
+			zap.Int64("input ID", collID),
+			zap.Int64("expected ID", replica.collectionID))
+		return fmt.Errorf("Mismatch collection, ID=%d", collID)
+	}
+
+	log.Debug("Merge flushed segments",
+		zap.Int64("segment ID", segID),
+		zap.Int64s("compacted from", compactedFrom),
+		zap.Int64("collection ID", collID),
+		zap.Int64("partition ID", partitionID),
+		zap.String("channel name", channelName),
+	)
+
+	seg := &Segment{
+		collectionID: collID,
+		partitionID:  partitionID,
+		segmentID:    segID,
+		channelName:  channelName,
+		numRows:      numOfRows,
+
+		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		minPK:    math.MaxInt64, // use max value, represents no value
+		maxPK:    math.MinInt64, // use min value represents no value
+	}
+	switch data := pks.(type) {
+	case *storage.Int64FieldData:
+		seg.updatePKRange(data.Data)
+	case *storage.StringFieldData:
+		seg.updateStringPKRange(data.Data)
+	}
+	seg.isNew.Store(false)
+	seg.isFlushed.Store(true)
+
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+	for _, id := range compactedFrom {
+		delete(replica.newSegments, id)
+		delete(replica.normalSegments, id)
+		delete(replica.flushedSegments, id)
+	}
+	if numOfRows > 0 {
+		replica.flushedSegments[segID] = seg
+	}
+	return nil
+}
+
 // listNewSegmentsStartPositions gets all *New Segments* start positions and
 //   transfer segments states from *New* to *Normal*.
 func (replica *SegmentReplica) listNewSegmentsStartPositions() []*datapb.SegmentStartPosition {
@@ -410,6 +472,25 @@ func (replica *SegmentReplica) updateSegmentPKRange(segID UniqueID, rowIDs []int
 	log.Warn("No match segment to update PK range", zap.Int64("ID", segID))
 }
 
+func (replica *SegmentReplica) updateSegmentStringPKRange(segID UniqueID, pks []string) {
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	seg, ok := replica.newSegments[segID]
+	if ok {
+		seg.updateStringPKRange(pks)
+		return
+	}
+
+	seg, ok = replica.normalSegments[segID]
+	if ok {
+		seg.updateStringPKRange(pks)
+		return
+	}
+
+	log.Warn("No match segment to update PK range", zap.Int64("ID", segID))
+}
+
 func (replica *SegmentReplica) removeSegment(segID UniqueID) error {
 	return nil
 }
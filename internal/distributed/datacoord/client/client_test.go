@@ -78,6 +78,30 @@ func (m *MockDataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMe
 	return &milvuspb.GetMetricsResponse{}, m.err
 }
 
+func (m *MockDataCoordClient) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	return &milvuspb.ManualCompactionResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
+	return &milvuspb.GetCompactionStateResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) Import(ctx context.Context, in *datapb.ImportTask, opts ...grpc.CallOption) (*datapb.ImportTaskResponse, error) {
+	return &datapb.ImportTaskResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+	return &milvuspb.GetImportStateResponse{}, m.err
+}
+
+func (m *MockDataCoordClient) ReportImport(ctx context.Context, in *datapb.ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
 func Test_NewClient(t *testing.T) {
 	proxy.Params.InitOnce()
 
@@ -150,6 +174,24 @@ func Test_NewClient(t *testing.T) {
 
 		r15, err := client.GetMetrics(ctx, nil)
 		retCheck(retNotNil, r15, err)
+
+		r16, err := client.CompleteCompaction(ctx, nil)
+		retCheck(retNotNil, r16, err)
+
+		r17, err := client.ManualCompaction(ctx, nil)
+		retCheck(retNotNil, r17, err)
+
+		r18, err := client.GetCompactionState(ctx, nil)
+		retCheck(retNotNil, r18, err)
+
+		r19, err := client.Import(ctx, nil)
+		retCheck(retNotNil, r19, err)
+
+		r20, err := client.GetImportState(ctx, nil)
+		retCheck(retNotNil, r20, err)
+
+		r21, err := client.ReportImport(ctx, nil)
+		retCheck(retNotNil, r21, err)
 	}
 
 	client.getGrpcClient = func() (datapb.DataCoordClient, error) {
@@ -30,7 +30,9 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -121,8 +123,16 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
+	tlsOpt, err := tlsutil.ServerOption(typeutil.DataCoordRole)
+	if err != nil {
+		log.Error("grpc server failed to load tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		tlsOpt,
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(
@@ -250,3 +260,33 @@ func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.dataCoord.GetMetrics(ctx, req)
 }
+
+// CompleteCompaction receives the compaction result from datanode
+func (s *Server) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error) {
+	return s.dataCoord.CompleteCompaction(ctx, req)
+}
+
+// ManualCompaction triggers a compaction for a collection
+func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return s.dataCoord.ManualCompaction(ctx, req)
+}
+
+// GetCompactionState gets the state of a compaction
+func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	return s.dataCoord.GetCompactionState(ctx, req)
+}
+
+// Import creates a bulk import task
+func (s *Server) Import(ctx context.Context, req *datapb.ImportTask) (*datapb.ImportTaskResponse, error) {
+	return s.dataCoord.Import(ctx, req)
+}
+
+// GetImportState gets the state of a bulk import task
+func (s *Server) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return s.dataCoord.GetImportState(ctx, req)
+}
+
+// ReportImport reports the result of a bulk import task
+func (s *Server) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	return s.dataCoord.ReportImport(ctx, req)
+}
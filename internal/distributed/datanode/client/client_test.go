@@ -38,6 +38,14 @@ func (m *MockDataNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMet
 	return &milvuspb.GetMetricsResponse{}, m.err
 }
 
+func (m *MockDataNodeClient) Compaction(ctx context.Context, req *datapb.CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockDataNodeClient) Import(ctx context.Context, req *datapb.ImportTaskInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
 func Test_NewClient(t *testing.T) {
 	proxy.Params.InitOnce()
 
@@ -84,6 +92,12 @@ func Test_NewClient(t *testing.T) {
 
 		r5, err := client.GetMetrics(ctx, nil)
 		retCheck(retNotNil, r5, err)
+
+		r6, err := client.Compaction(ctx, nil)
+		retCheck(retNotNil, r6, err)
+
+		r7, err := client.Import(ctx, nil)
+		retCheck(retNotNil, r7, err)
 	}
 
 	client.getGrpcClient = func() (datapb.DataNodeClient, error) {
@@ -37,7 +37,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type Server struct {
@@ -91,8 +93,16 @@ func (s *Server) startGrpc() error {
 func (s *Server) startGrpcLoop(listener net.Listener) {
 	defer s.wg.Done()
 
+	tlsOpt, err := tlsutil.ServerOption(typeutil.DataNodeRole)
+	if err != nil {
+		log.Error("grpc server failed to load tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		tlsOpt,
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(
@@ -288,3 +298,11 @@ func (s *Server) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsReq
 func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.datanode.GetMetrics(ctx, request)
 }
+
+func (s *Server) Compaction(ctx context.Context, request *datapb.CompactionPlan) (*commonpb.Status, error) {
+	return s.datanode.Compaction(ctx, request)
+}
+
+func (s *Server) Import(ctx context.Context, request *datapb.ImportTaskInfo) (*commonpb.Status, error) {
+	return s.datanode.Import(ctx, request)
+}
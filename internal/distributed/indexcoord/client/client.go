@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
@@ -134,8 +135,12 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 		log.Debug("IndexCoordClient try connect ", zap.String("address", c.addr))
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
+		tlsOpt, err := tlsutil.DialOption(typeutil.IndexCoordRole)
+		if err != nil {
+			return err
+		}
 		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
+			tlsOpt, grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
 				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
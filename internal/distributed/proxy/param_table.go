@@ -31,8 +31,11 @@ type ParamTable struct {
 	DataCoordAddress  string
 	QueryCoordAddress string
 
-	IP      string
-	Port    int
+	IP   string
+	Port int
+	// InternalPort serves the Proxy service called by the other components, it is not exposed to clients
+	InternalPort int
+	// Address is the internal address registered in the session
 	Address string
 
 	ServerMaxSendSize int
@@ -49,7 +52,7 @@ func (pt *ParamTable) Init() {
 
 		pt.LoadFromEnv()
 		pt.LoadFromArgs()
-		pt.Address = pt.IP + ":" + strconv.FormatInt(int64(pt.Port), 10)
+		pt.Address = pt.IP + ":" + strconv.FormatInt(int64(pt.InternalPort), 10)
 
 		pt.initServerMaxSendSize()
 		pt.initServerMaxRecvSize()
@@ -66,6 +69,7 @@ func (pt *ParamTable) LoadFromEnv() {
 
 func (pt *ParamTable) initParams() {
 	pt.initPort()
+	pt.initInternalPort()
 	pt.initRootCoordAddress()
 	pt.initIndexCoordAddress()
 	pt.initDataCoordAddress()
@@ -113,6 +117,11 @@ func (pt *ParamTable) initPort() {
 	pt.Port = port
 }
 
+func (pt *ParamTable) initInternalPort() {
+	port := pt.ParseInt("proxy.internalPort")
+	pt.InternalPort = port
+}
+
 func (pt *ParamTable) initServerMaxSendSize() {
 	var err error
 
@@ -28,6 +28,7 @@ import (
 	grpcquerycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -37,7 +38,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/opentracing/opentracing-go"
 )
 
@@ -46,10 +49,11 @@ const (
 )
 
 type Server struct {
-	ctx        context.Context
-	wg         sync.WaitGroup
-	proxy      *proxy.Proxy
-	grpcServer *grpc.Server
+	ctx                context.Context
+	wg                 sync.WaitGroup
+	proxy              *proxy.Proxy
+	grpcServer         *grpc.Server
+	grpcInternalServer *grpc.Server
 
 	grpcErrChan chan error
 
@@ -92,16 +96,26 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
+	tlsOpt, err := tlsutil.ServerOption(typeutil.ProxyRole)
+	if err != nil {
+		log.Error("grpc server failed to load tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		tlsOpt,
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.MaxRecvMsgSize(GRPCMaxMagSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			grpc_opentracing.UnaryServerInterceptor(opts...),
+			proxy.AuthenticationInterceptor,
+			proxy.PrivilegeInterceptor,
+		)),
 		grpc.StreamInterceptor(
 			grpc_opentracing.StreamServerInterceptor(opts...)))
-	proxypb.RegisterProxyServer(s.grpcServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -111,6 +125,48 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 
 }
 
+// startInternalGrpcLoop serves the Proxy service on the internal port, the requests come from
+// the other components and don't carry client credentials, so they must not reach the public port
+func (s *Server) startInternalGrpcLoop(grpcPort int) {
+
+	defer s.wg.Done()
+
+	log.Debug("proxy", zap.Int("internal network port", grpcPort))
+	lis, err := net.Listen("tcp", ":"+strconv.Itoa(grpcPort))
+	if err != nil {
+		log.Warn("proxy", zap.String("Server:failed to listen:", err.Error()))
+		s.grpcErrChan <- err
+		return
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	tlsOpt, err := tlsutil.InternalServerOption()
+	if err != nil {
+		log.Error("grpc server failed to load tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
+	opts := trace.GetInterceptorOpts()
+	s.grpcInternalServer = grpc.NewServer(
+		tlsOpt,
+		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
+		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
+		grpc.UnaryInterceptor(
+			grpc_opentracing.UnaryServerInterceptor(opts...)),
+		grpc.StreamInterceptor(
+			grpc_opentracing.StreamServerInterceptor(opts...)))
+	proxypb.RegisterProxyServer(s.grpcInternalServer, s)
+
+	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
+	if err := s.grpcInternalServer.Serve(lis); err != nil {
+		s.grpcErrChan <- err
+	}
+
+}
+
 func (s *Server) Run() error {
 
 	if err := s.init(); err != nil {
@@ -132,12 +188,17 @@ func (s *Server) init() error {
 		Params.Port = funcutil.GetAvailablePort()
 		log.Warn("Proxy init", zap.Any("Port", Params.Port))
 	}
+	if !funcutil.CheckPortAvailable(Params.InternalPort) {
+		Params.InternalPort = funcutil.GetAvailablePort()
+		Params.Address = Params.IP + ":" + strconv.Itoa(Params.InternalPort)
+		log.Warn("Proxy init", zap.Any("InternalPort", Params.InternalPort))
+	}
 
 	proxy.Params.InitOnce()
 	log.Debug("init params done ...")
 
 	// NetworkPort & IP don't matter here, NetworkAddress matters
-	proxy.Params.NetworkPort = Params.Port
+	proxy.Params.NetworkPort = Params.InternalPort
 	proxy.Params.IP = Params.IP
 
 	proxy.Params.NetworkAddress = Params.Address
@@ -149,6 +210,7 @@ func (s *Server) init() error {
 
 	log.Debug("proxy", zap.String("proxy host", Params.IP))
 	log.Debug("proxy", zap.Int("proxy port", Params.Port))
+	log.Debug("proxy", zap.Int("proxy internal port", Params.InternalPort))
 	log.Debug("proxy", zap.String("proxy address", Params.Address))
 
 	err = s.proxy.Register()
@@ -166,6 +228,15 @@ func (s *Server) init() error {
 		return err
 	}
 
+	s.wg.Add(1)
+	go s.startInternalGrpcLoop(Params.InternalPort)
+	// wait for internal grpc server loop start
+	err = <-s.grpcErrChan
+	log.Debug("create internal grpc server ...")
+	if err != nil {
+		return err
+	}
+
 	rootCoordAddr := Params.RootCoordAddress
 	log.Debug("Proxy", zap.String("RootCoord address", rootCoordAddr))
 	s.rootCoordClient, err = rcc.NewClient(s.ctx, proxy.Params.MetaRootPath, proxy.Params.EtcdEndpoints)
@@ -257,6 +328,9 @@ func (s *Server) Stop() error {
 	if s.grpcServer != nil {
 		s.grpcServer.GracefulStop()
 	}
+	if s.grpcInternalServer != nil {
+		s.grpcInternalServer.GracefulStop()
+	}
 
 	err = s.proxy.Stop()
 	if err != nil {
@@ -280,6 +354,10 @@ func (s *Server) InvalidateCollectionMetaCache(ctx context.Context, request *pro
 	return s.proxy.InvalidateCollectionMetaCache(ctx, request)
 }
 
+func (s *Server) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.InvalidateCredentialCache(ctx, request)
+}
+
 func (s *Server) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	return s.proxy.ReleaseDQLMessageStream(ctx, request)
 }
@@ -374,10 +452,18 @@ func (s *Server) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*
 	return s.proxy.Delete(ctx, request)
 }
 
+func (s *Server) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	return s.proxy.Upsert(ctx, request)
+}
+
 func (s *Server) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return s.proxy.Search(ctx, request)
 }
 
+func (s *Server) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	return s.proxy.HybridSearch(ctx, request)
+}
+
 func (s *Server) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	return s.proxy.Flush(ctx, request)
 }
@@ -386,6 +472,10 @@ func (s *Server) Query(ctx context.Context, request *milvuspb.QueryRequest) (*mi
 	return s.proxy.Query(ctx, request)
 }
 
+func (s *Server) QueryIterator(ctx context.Context, request *milvuspb.QueryIteratorRequest) (*milvuspb.QueryIteratorResults, error) {
+	return s.proxy.QueryIterator(ctx, request)
+}
+
 func (s *Server) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return s.proxy.CalcDistance(ctx, request)
 }
@@ -415,6 +505,26 @@ func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsReq
 	return s.proxy.GetMetrics(ctx, request)
 }
 
+func (s *Server) ManualCompaction(ctx context.Context, request *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return s.proxy.ManualCompaction(ctx, request)
+}
+
+func (s *Server) GetCompactionState(ctx context.Context, request *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	return s.proxy.GetCompactionState(ctx, request)
+}
+
+func (s *Server) BulkLoad(ctx context.Context, request *milvuspb.BulkLoadRequest) (*milvuspb.BulkLoadResponse, error) {
+	return s.proxy.BulkLoad(ctx, request)
+}
+
+func (s *Server) GetImportState(ctx context.Context, request *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return s.proxy.GetImportState(ctx, request)
+}
+
+func (s *Server) LoadBalance(ctx context.Context, request *milvuspb.LoadBalanceRequest) (*commonpb.Status, error) {
+	return s.proxy.LoadBalance(ctx, request)
+}
+
 func (s *Server) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateAlias(ctx, request)
 }
@@ -426,3 +536,59 @@ func (s *Server) DropAlias(ctx context.Context, request *milvuspb.DropAliasReque
 func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
 	return s.proxy.AlterAlias(ctx, request)
 }
+
+func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateDatabase(ctx, request)
+}
+
+func (s *Server) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.DropDatabase(ctx, request)
+}
+
+func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.proxy.ListDatabases(ctx, request)
+}
+
+func (s *Server) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateCredential(ctx, request)
+}
+
+func (s *Server) UpdateCredential(ctx context.Context, request *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.UpdateCredential(ctx, request)
+}
+
+func (s *Server) DeleteCredential(ctx context.Context, request *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.DeleteCredential(ctx, request)
+}
+
+func (s *Server) ListCredUsers(ctx context.Context, request *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return s.proxy.ListCredUsers(ctx, request)
+}
+
+func (s *Server) CreateRole(ctx context.Context, request *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateRole(ctx, request)
+}
+
+func (s *Server) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRole(ctx, request)
+}
+
+func (s *Server) AddUserToRole(ctx context.Context, request *milvuspb.AddUserToRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.AddUserToRole(ctx, request)
+}
+
+func (s *Server) RemoveUserFromRole(ctx context.Context, request *milvuspb.RemoveUserFromRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.RemoveUserFromRole(ctx, request)
+}
+
+func (s *Server) GrantPrivilege(ctx context.Context, request *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	return s.proxy.GrantPrivilege(ctx, request)
+}
+
+func (s *Server) RevokePrivilege(ctx context.Context, request *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	return s.proxy.RevokePrivilege(ctx, request)
+}
+
+func (s *Server) ListGrants(ctx context.Context, request *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return s.proxy.ListGrants(ctx, request)
+}
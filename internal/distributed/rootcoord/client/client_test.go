@@ -69,6 +69,70 @@ func (m *MockRootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.Alter
 	return &commonpb.Status{}, m.err
 }
 
+func (m *MockRootCoordClient) AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+	return &milvuspb.ListDatabasesResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) UpdateCredential(ctx context.Context, in *milvuspb.UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
+	return &milvuspb.ListCredUsersResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error) {
+	return &rootcoordpb.GetCredentialResponse{}, m.err
+}
+
+func (m *MockRootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) AddUserToRole(ctx context.Context, in *milvuspb.AddUserToRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) RemoveUserFromRole(ctx context.Context, in *milvuspb.RemoveUserFromRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) GrantPrivilege(ctx context.Context, in *milvuspb.GrantPrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) RevokePrivilege(ctx context.Context, in *milvuspb.RevokePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.err
+}
+
+func (m *MockRootCoordClient) ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest, opts ...grpc.CallOption) (*milvuspb.ListGrantsResponse, error) {
+	return &milvuspb.ListGrantsResponse{}, m.err
+}
+
 func (m *MockRootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	return &milvuspb.ShowCollectionsResponse{}, m.err
 }
@@ -238,6 +302,54 @@ func Test_NewClient(t *testing.T) {
 
 		r26, err := client.AlterAlias(ctx, nil)
 		retCheck(retNotNil, r26, err)
+
+		r27, err := client.CreateDatabase(ctx, nil)
+		retCheck(retNotNil, r27, err)
+
+		r28, err := client.DropDatabase(ctx, nil)
+		retCheck(retNotNil, r28, err)
+
+		r29, err := client.ListDatabases(ctx, nil)
+		retCheck(retNotNil, r29, err)
+
+		r30, err := client.CreateCredential(ctx, nil)
+		retCheck(retNotNil, r30, err)
+
+		r31, err := client.UpdateCredential(ctx, nil)
+		retCheck(retNotNil, r31, err)
+
+		r32, err := client.DeleteCredential(ctx, nil)
+		retCheck(retNotNil, r32, err)
+
+		r33, err := client.ListCredUsers(ctx, nil)
+		retCheck(retNotNil, r33, err)
+
+		r34, err := client.GetCredential(ctx, nil)
+		retCheck(retNotNil, r34, err)
+
+		r35, err := client.CreateRole(ctx, nil)
+		retCheck(retNotNil, r35, err)
+
+		r36, err := client.DropRole(ctx, nil)
+		retCheck(retNotNil, r36, err)
+
+		r37, err := client.AddUserToRole(ctx, nil)
+		retCheck(retNotNil, r37, err)
+
+		r38, err := client.RemoveUserFromRole(ctx, nil)
+		retCheck(retNotNil, r38, err)
+
+		r39, err := client.GrantPrivilege(ctx, nil)
+		retCheck(retNotNil, r39, err)
+
+		r40, err := client.RevokePrivilege(ctx, nil)
+		retCheck(retNotNil, r40, err)
+
+		r41, err := client.ListGrants(ctx, nil)
+		retCheck(retNotNil, r41, err)
+
+		r42, err := client.AddField(ctx, nil)
+		retCheck(retNotNil, r42, err)
 	}
 
 	client.getGrpcClient = func() (rootcoordpb.RootCoordClient, error) {
@@ -38,7 +38,9 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // Server grpc wrapper
@@ -75,6 +77,70 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.rootCoord.AlterAlias(ctx, request)
 }
 
+func (s *Server) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddField(ctx, request)
+}
+
+func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateDatabase(ctx, request)
+}
+
+func (s *Server) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropDatabase(ctx, request)
+}
+
+func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.rootCoord.ListDatabases(ctx, request)
+}
+
+func (s *Server) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateCredential(ctx, request)
+}
+
+func (s *Server) UpdateCredential(ctx context.Context, request *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return s.rootCoord.UpdateCredential(ctx, request)
+}
+
+func (s *Server) DeleteCredential(ctx context.Context, request *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DeleteCredential(ctx, request)
+}
+
+func (s *Server) ListCredUsers(ctx context.Context, request *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return s.rootCoord.ListCredUsers(ctx, request)
+}
+
+func (s *Server) GetCredential(ctx context.Context, request *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	return s.rootCoord.GetCredential(ctx, request)
+}
+
+func (s *Server) CreateRole(ctx context.Context, request *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateRole(ctx, request)
+}
+
+func (s *Server) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropRole(ctx, request)
+}
+
+func (s *Server) AddUserToRole(ctx context.Context, request *milvuspb.AddUserToRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddUserToRole(ctx, request)
+}
+
+func (s *Server) RemoveUserFromRole(ctx context.Context, request *milvuspb.RemoveUserFromRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RemoveUserFromRole(ctx, request)
+}
+
+func (s *Server) GrantPrivilege(ctx context.Context, request *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	return s.rootCoord.GrantPrivilege(ctx, request)
+}
+
+func (s *Server) RevokePrivilege(ctx context.Context, request *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RevokePrivilege(ctx, request)
+}
+
+func (s *Server) ListGrants(ctx context.Context, request *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return s.rootCoord.ListGrants(ctx, request)
+}
+
 func NewServer(ctx context.Context, factory msgstream.Factory) (*Server, error) {
 	ctx1, cancel := context.WithCancel(ctx)
 	s := &Server{
@@ -216,8 +282,16 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
+	tlsOpt, err := tlsutil.ServerOption(typeutil.RootCoordRole)
+	if err != nil {
+		log.Error("grpc server failed to load tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		tlsOpt,
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(grpc_opentracing.UnaryServerInterceptor(opts...)),
@@ -26,6 +26,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -53,9 +54,15 @@ func (p *proxyMock) InvalidateCollectionMetaCache(ctx context.Context, request *
 	return p.invalidateCollectionMetaCache(ctx, request)
 }
 
+func (p *proxyMock) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func TestGrpcService(t *testing.T) {
 	const (
-		dbName    = "testDB"
+		dbName    = common.DefaultDatabaseName
 		collName  = "testColl"
 		collName2 = "testColl-again"
 		partName  = "testPartition"
@@ -325,7 +332,7 @@ func TestGrpcService(t *testing.T) {
 
 		status, err := cli.CreateCollection(ctx, req)
 		assert.Nil(t, err)
-		colls, err := core.MetaTable.ListCollections(0)
+		colls, err := core.MetaTable.ListCollections(dbName, 0)
 		assert.Nil(t, err)
 
 		assert.Equal(t, 1, len(colls))
@@ -359,7 +366,7 @@ func TestGrpcService(t *testing.T) {
 		status, err = cli.CreateCollection(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		colls, err = core.MetaTable.ListCollections(0)
+		colls, err = core.MetaTable.ListCollections(dbName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(colls))
 		_, has = colls[collName2]
@@ -389,7 +396,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 110,
 				SourceID:  110,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		rsp, err := cli.HasCollection(ctx, req)
@@ -404,7 +411,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 111,
 				SourceID:  111,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: "testColl2",
 		}
 		rsp, err = cli.HasCollection(ctx, req)
@@ -420,7 +427,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 111,
 				SourceID:  111,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: "testColl2",
 		}
 		rsp, err = cli.HasCollection(ctx, req)
@@ -430,7 +437,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -439,7 +446,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 120,
 				SourceID:  120,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		rsp, err := cli.DescribeCollection(ctx, req)
@@ -457,7 +464,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 130,
 				SourceID:  130,
 			},
-			DbName: "testDb",
+			DbName: dbName,
 		}
 		rsp, err := cli.ShowCollections(ctx, req)
 		assert.Nil(t, err)
@@ -481,7 +488,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.CreatePartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partName2, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -509,7 +516,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -518,7 +525,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 160,
 				SourceID:  160,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 			CollectionID:   coll.ID,
 		}
@@ -530,7 +537,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -575,13 +582,13 @@ func TestGrpcService(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Zero(t, len(collMeta.FieldIndexes))
 		rsp, err := cli.CreateIndex(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 
@@ -597,7 +604,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -637,7 +644,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -698,7 +705,7 @@ func TestGrpcService(t *testing.T) {
 			FieldName:      fieldName,
 			IndexName:      rootcoord.Params.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, rootcoord.Params.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName(dbName, collName, rootcoord.Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, len(idx), 1)
 		rsp, err := cli.DropIndex(ctx, req)
@@ -726,7 +733,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -743,7 +750,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 230,
 				SourceID:  230,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 
@@ -763,7 +770,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 231,
 				SourceID:  231,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		status, err = cli.DropCollection(ctx, req)
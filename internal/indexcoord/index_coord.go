@@ -17,6 +17,7 @@ import (
 	"math/rand"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -30,6 +31,7 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -235,6 +237,11 @@ func (i *IndexCoord) Start() error {
 		i.loopWg.Add(1)
 		go i.recycleUnusedIndexFiles()
 
+		if Params.EnableGarbageCollection {
+			i.loopWg.Add(1)
+			go i.recycleOrphanIndexFiles()
+		}
+
 		i.loopWg.Add(1)
 		go i.assignTaskLoop()
 
@@ -648,6 +655,83 @@ func (i *IndexCoord) recycleUnusedIndexFiles() {
 	}
 }
 
+// orphanIndexFileStorage is the object storage which index files are stored in, it's implemented by MinIOKV.
+type orphanIndexFileStorage interface {
+	ListObjects(prefix string) ([]string, []time.Time, error)
+	Remove(key string) error
+}
+
+// recycleOrphanIndexFiles is used to delete the index files which are not referenced by any index meta,
+// such as the files left by failed removal of dropped indexes.
+func (i *IndexCoord) recycleOrphanIndexFiles() {
+	ctx, cancel := context.WithCancel(i.loopCtx)
+
+	defer cancel()
+	defer i.loopWg.Done()
+
+	storage, ok := i.kv.(orphanIndexFileStorage)
+	if !ok {
+		log.Warn("IndexCoord recycleOrphanIndexFiles, kv does not support listing objects, loop quit")
+		return
+	}
+
+	timeTicker := time.NewTicker(Params.GCInterval)
+	defer timeTicker.Stop()
+	log.Debug("IndexCoord start recycleOrphanIndexFiles loop")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeTicker.C:
+			i.removeOrphanIndexFiles(storage, Params.IndexRootPath, Params.GCMissingTolerance, Params.GCDryRun)
+		}
+	}
+}
+
+// removeOrphanIndexFiles lists the index files under rootPath, the files whose index build id is not found in
+// meta and not modified within missingTolerance are removed. The layout of index file is
+// rootPath/indexBuildID/version/partitionID/segmentID/key.
+func (i *IndexCoord) removeOrphanIndexFiles(storage orphanIndexFileStorage, rootPath string, missingTolerance time.Duration, dryRun bool) {
+	prefix := rootPath + "/"
+	keys, modTimes, err := storage.ListObjects(prefix)
+	if err != nil {
+		log.Warn("IndexCoord removeOrphanIndexFiles list index files failed", zap.Error(err))
+		return
+	}
+	// meta is loaded after listing, index meta is always saved before its index files are written
+	indexBuildIDs := i.metaTable.GetIndexBuildIDs()
+
+	now := time.Now()
+	for idx, key := range keys {
+		indexBuildID, err := strconv.ParseInt(strings.SplitN(strings.TrimPrefix(key, prefix), "/", 2)[0], 10, 64)
+		if err != nil {
+			log.Warn("IndexCoord removeOrphanIndexFiles unknown index file", zap.String("key", key))
+			continue
+		}
+		if _, ok := indexBuildIDs[indexBuildID]; ok {
+			continue
+		}
+		if now.Sub(modTimes[idx]) < missingTolerance {
+			continue
+		}
+		if dryRun {
+			log.Info("IndexCoord removeOrphanIndexFiles orphaned index file found (dry run)",
+				zap.Int64("indexBuildID", indexBuildID), zap.String("key", key))
+			metrics.IndexCoordGarbageCollectedFilesCounter.WithLabelValues("dryrun").Inc()
+			continue
+		}
+		if err := storage.Remove(key); err != nil {
+			log.Warn("IndexCoord removeOrphanIndexFiles remove index file failed", zap.String("key", key), zap.Error(err))
+			metrics.IndexCoordGarbageCollectedFilesCounter.WithLabelValues("failed").Inc()
+			continue
+		}
+		log.Debug("IndexCoord removeOrphanIndexFiles orphaned index file removed",
+			zap.Int64("indexBuildID", indexBuildID), zap.String("key", key))
+		metrics.IndexCoordGarbageCollectedFilesCounter.WithLabelValues("removed").Inc()
+	}
+}
+
 // watchNodeLoop is used to monitor IndexNode going online and offline.
 func (i *IndexCoord) watchNodeLoop() {
 	ctx, cancel := context.WithCancel(i.loopCtx)
@@ -14,6 +14,7 @@ package indexcoord
 import (
 	"context"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -201,3 +202,61 @@ func TestIndexCoord(t *testing.T) {
 	err = ic.Stop()
 	assert.Nil(t, err)
 }
+
+type mockOrphanIndexFileStorage struct {
+	objects map[string]time.Time
+}
+
+func (s *mockOrphanIndexFileStorage) ListObjects(prefix string) ([]string, []time.Time, error) {
+	var keys []string
+	var modTimes []time.Time
+	for key, modTime := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+			modTimes = append(modTimes, modTime)
+		}
+	}
+	return keys, modTimes, nil
+}
+
+func (s *mockOrphanIndexFileStorage) Remove(key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+func TestIndexCoord_removeOrphanIndexFiles(t *testing.T) {
+	old := time.Now().Add(-2 * time.Hour)
+	newStorage := func() *mockOrphanIndexFileStorage {
+		return &mockOrphanIndexFileStorage{objects: map[string]time.Time{
+			"files/index_files/1/1/1/1/IVF":  old,
+			"files/index_files/2/1/1/1/IVF":  old,
+			"files/index_files/3/1/1/1/IVF":  time.Now(),
+			"files/index_files/unknown/file": old,
+			"files/insert_log/1/1/1/1/1":     old,
+		}}
+	}
+	ic := &IndexCoord{
+		metaTable: &metaTable{indexBuildID2Meta: map[UniqueID]Meta{1: {}}},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		storage := newStorage()
+		ic.removeOrphanIndexFiles(storage, "files/index_files", time.Hour, true)
+		assert.Equal(t, 5, len(storage.objects))
+	})
+
+	t.Run("remove orphaned index files", func(t *testing.T) {
+		storage := newStorage()
+		ic.removeOrphanIndexFiles(storage, "files/index_files", time.Hour, false)
+		keys := make([]string, 0, len(storage.objects))
+		for key := range storage.objects {
+			keys = append(keys, key)
+		}
+		assert.ElementsMatch(t, []string{
+			"files/index_files/1/1/1/1/IVF",
+			"files/index_files/3/1/1/1/IVF",
+			"files/index_files/unknown/file",
+			"files/insert_log/1/1/1/1/1",
+		}, keys)
+	})
+}
@@ -503,6 +503,18 @@ func (mt *metaTable) GetNodeTaskStats() map[UniqueID]int {
 	return nodePriority
 }
 
+// GetIndexBuildIDs returns the index build ids of all the index metas.
+func (mt *metaTable) GetIndexBuildIDs() map[UniqueID]struct{} {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+
+	indexBuildIDs := make(map[UniqueID]struct{}, len(mt.indexBuildID2Meta))
+	for indexBuildID := range mt.indexBuildID2Meta {
+		indexBuildIDs[indexBuildID] = struct{}{}
+	}
+	return indexBuildIDs
+}
+
 func (mt *metaTable) GetIndexMetaByIndexBuildID(indexBuildID UniqueID) *indexpb.IndexMeta {
 	mt.lock.RLock()
 	defer mt.lock.RUnlock()
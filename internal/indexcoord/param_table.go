@@ -39,6 +39,12 @@ type ParamTable struct {
 	MinIOUseSSL          bool
 	MinioBucketName      string
 
+	// --- GC ---
+	EnableGarbageCollection bool
+	GCInterval              time.Duration
+	GCMissingTolerance      time.Duration
+	GCDryRun                bool
+
 	CreatedTime time.Time
 	UpdatedTime time.Time
 }
@@ -66,6 +72,10 @@ func (pt *ParamTable) Init() {
 	pt.initMinIOUseSSL()
 	pt.initMinioBucketName()
 	pt.initIndexRootPath()
+	pt.initEnableGarbageCollection()
+	pt.initGCInterval()
+	pt.initGCMissingTolerance()
+	pt.initGCDryRun()
 }
 
 // InitOnce is used to initialize configuration items, and it will only be called once.
@@ -161,3 +171,19 @@ func (pt *ParamTable) initIndexRootPath() {
 func (pt *ParamTable) initLogCfg() {
 	pt.InitLogCfg("indexcoord", 0)
 }
+
+func (pt *ParamTable) initEnableGarbageCollection() {
+	pt.EnableGarbageCollection = pt.ParseBool("indexCoord.gc.enable", false)
+}
+
+func (pt *ParamTable) initGCInterval() {
+	pt.GCInterval = time.Duration(pt.ParseInt64("indexCoord.gc.interval")) * time.Second
+}
+
+func (pt *ParamTable) initGCMissingTolerance() {
+	pt.GCMissingTolerance = time.Duration(pt.ParseInt64("indexCoord.gc.missingTolerance")) * time.Second
+}
+
+func (pt *ParamTable) initGCDryRun() {
+	pt.GCDryRun = pt.ParseBool("indexCoord.gc.dryRun", false)
+}
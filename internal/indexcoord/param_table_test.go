@@ -72,6 +72,11 @@ func TestParamTable(t *testing.T) {
 	t.Run("initIndexRootPath", func(t *testing.T) {
 		t.Logf("IndexRootPath: %v", Params.IndexRootPath)
 	})
+
+	t.Run("GC", func(t *testing.T) {
+		t.Logf("EnableGarbageCollection: %v, GCInterval: %v, GCMissingTolerance: %v, GCDryRun: %v",
+			Params.EnableGarbageCollection, Params.GCInterval, Params.GCMissingTolerance, Params.GCDryRun)
+	})
 }
 
 //TODO: Params Load should be return error when key does not exist.
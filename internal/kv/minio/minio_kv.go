@@ -18,6 +18,7 @@ import (
 
 	"io"
 	"strings"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -110,6 +111,30 @@ func (kv *MinIOKV) LoadWithPrefix(key string) ([]string, []string, error) {
 	return objectsKeys, objectsValues, nil
 }
 
+// ListObjects lists all objects with the same prefix @prefix recursively, returns the object keys
+// and the last modified time of each object.
+func (kv *MinIOKV) ListObjects(prefix string) ([]string, []time.Time, error) {
+	var objectsKeys []string
+	var modTimes []time.Time
+	var resultErr error
+
+	// drain the channel even if an error occurs, the listing goroutine of minio client blocks otherwise
+	for object := range kv.minioClient.ListObjects(kv.ctx, kv.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			if resultErr == nil {
+				resultErr = object.Err
+			}
+			continue
+		}
+		objectsKeys = append(objectsKeys, object.Key)
+		modTimes = append(modTimes, object.LastModified)
+	}
+	if resultErr != nil {
+		return nil, nil, resultErr
+	}
+	return objectsKeys, modTimes, nil
+}
+
 // LoadWithPrefix load an object with @key.
 func (kv *MinIOKV) Load(key string) (string, error) {
 	object, err := kv.minioClient.GetObject(kv.ctx, kv.bucketName, key, minio.GetObjectOptions{})
@@ -17,6 +17,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -189,6 +190,41 @@ func TestMinIOKV_Remove(t *testing.T) {
 	assert.Empty(t, val)
 }
 
+func TestMinIOKV_ListObjects(t *testing.T) {
+	Params.Init()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucketName := "fantastic-tech-test"
+	MinIOKV, err := newMinIOKVClient(ctx, bucketName)
+	assert.Nil(t, err)
+	defer MinIOKV.RemoveWithPrefix("")
+
+	before := time.Now().Add(-time.Minute)
+	kvs := map[string]string{
+		"list/a/key_1":   "123",
+		"list/a/b/key_2": "456",
+		"list/c/key_3":   "789",
+		"other/key_4":    "012",
+	}
+	err = MinIOKV.MultiSave(kvs)
+	assert.Nil(t, err)
+
+	keys, modTimes, err := MinIOKV.ListObjects("list/")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"list/a/key_1", "list/a/b/key_2", "list/c/key_3"}, keys)
+	assert.Equal(t, len(keys), len(modTimes))
+	for _, modTime := range modTimes {
+		assert.True(t, modTime.After(before))
+	}
+
+	keys, modTimes, err = MinIOKV.ListObjects("not_exist/")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(keys))
+	assert.Equal(t, 0, len(modTimes))
+}
+
 func TestMinIOKV_FGetObject(t *testing.T) {
 	Params.Init()
 	path := "/tmp/milvus/data"
@@ -11,11 +11,12 @@ import (
 )
 
 const (
-	milvusNamespace    = "milvus"
-	subSystemRootCoord = "rootcoord"
-	subSystemDataCoord = "dataCoord"
-	subSystemDataNode  = "dataNode"
-	subSystemProxy     = "proxy"
+	milvusNamespace     = "milvus"
+	subSystemRootCoord  = "rootcoord"
+	subSystemDataCoord  = "dataCoord"
+	subSystemDataNode   = "dataNode"
+	subSystemIndexCoord = "indexCoord"
+	subSystemProxy      = "proxy"
 )
 
 var (
@@ -591,11 +592,22 @@ var (
 			Help:      "List of data nodes registered within etcd",
 		}, []string{"status"},
 	)
+
+	//DataCoordGarbageCollectedFilesCounter counts the orphaned files found by garbage collector of data coord
+	DataCoordGarbageCollectedFilesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataCoord,
+			Name:      "garbage_collected_files_total",
+			Help:      "Counter of orphaned files found by garbage collector",
+		}, []string{"type", "status"},
+	)
 )
 
 //RegisterDataCoord register DataCoord metrics
 func RegisterDataCoord() {
 	prometheus.MustRegister(DataCoordDataNodeList)
+	prometheus.MustRegister(DataCoordGarbageCollectedFilesCounter)
 }
 
 var (
@@ -624,9 +636,21 @@ func RegisterDataNode() {
 	prometheus.MustRegister(DataNodeWatchDmChannelsCounter)
 }
 
+var (
+	//IndexCoordGarbageCollectedFilesCounter counts the orphaned index files found by garbage collector of index coord
+	IndexCoordGarbageCollectedFilesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemIndexCoord,
+			Name:      "garbage_collected_files_total",
+			Help:      "Counter of orphaned index files found by garbage collector",
+		}, []string{"status"},
+	)
+)
+
 //RegisterIndexCoord register IndexCoord metrics
 func RegisterIndexCoord() {
-
+	prometheus.MustRegister(IndexCoordGarbageCollectedFilesCounter)
 }
 
 //RegisterIndexNode register IndexNode metrics
@@ -16,6 +16,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/Shopify/sarama"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -87,3 +88,40 @@ func TestRmsFactory_SetParams(t *testing.T) {
 	err := rmsFactory.SetParams(m)
 	assert.NotNil(t, err)
 }
+
+func TestKmsFactory(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).SetBroker(broker.Addr(), broker.BrokerID()),
+	})
+
+	kmsFactory := NewKmsFactory([]string{broker.Addr()})
+
+	m := map[string]interface{}{
+		"ReceiveBufSize": 1024,
+		"KafkaBufSize":   1024,
+	}
+	kmsFactory.SetParams(m)
+
+	ctx := context.Background()
+	_, err := kmsFactory.NewMsgStream(ctx)
+	assert.Nil(t, err)
+
+	_, err = kmsFactory.NewTtMsgStream(ctx)
+	assert.Nil(t, err)
+
+	_, err = kmsFactory.NewQueryMsgStream(ctx)
+	assert.Nil(t, err)
+}
+
+func TestKmsFactory_SetParams(t *testing.T) {
+	kmsFactory := (*KmsFactory)(nil)
+
+	m := map[string]interface{}{
+		"ReceiveBufSize": 1024,
+		"KafkaBufSize":   1024,
+	}
+	err := kmsFactory.SetParams(m)
+	assert.NotNil(t, err)
+}
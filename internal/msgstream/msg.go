@@ -865,6 +865,61 @@ func (dp *DropPartitionMsg) Unmarshal(input MarshalType) (TsMsg, error) {
 	return dropPartitionMsg, nil
 }
 
+/////////////////////////////////////////AddField//////////////////////////////////////////
+
+// AddFieldMsg is a message pack that contains add field request
+type AddFieldMsg struct {
+	BaseMsg
+	internalpb.AddFieldRequest
+}
+
+// interface implementation validation
+var _ TsMsg = &AddFieldMsg{}
+
+// ID returns the ID of this message pack
+func (af *AddFieldMsg) ID() UniqueID {
+	return af.Base.MsgID
+}
+
+// Type returns the type of this message pack
+func (af *AddFieldMsg) Type() MsgType {
+	return af.Base.MsgType
+}
+
+// SourceID indicated which component generated this message
+func (af *AddFieldMsg) SourceID() int64 {
+	return af.Base.SourceID
+}
+
+// Marshal is used to serializing a message pack to byte array
+func (af *AddFieldMsg) Marshal(input TsMsg) (MarshalType, error) {
+	addFieldMsg := input.(*AddFieldMsg)
+	addFieldRequest := &addFieldMsg.AddFieldRequest
+	mb, err := proto.Marshal(addFieldRequest)
+	if err != nil {
+		return nil, err
+	}
+	return mb, nil
+}
+
+// Unmarshal is used to deserializing a message pack from byte array
+func (af *AddFieldMsg) Unmarshal(input MarshalType) (TsMsg, error) {
+	addFieldRequest := internalpb.AddFieldRequest{}
+	in, err := convertToByteArray(input)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(in, &addFieldRequest)
+	if err != nil {
+		return nil, err
+	}
+	addFieldMsg := &AddFieldMsg{AddFieldRequest: addFieldRequest}
+	addFieldMsg.BeginTimestamp = addFieldMsg.Base.Timestamp
+	addFieldMsg.EndTimestamp = addFieldMsg.Base.Timestamp
+
+	return addFieldMsg, nil
+}
+
 /////////////////////////////////////////LoadIndex//////////////////////////////////////////
 // FIXME(wxyu): comment it until really needed
 /*
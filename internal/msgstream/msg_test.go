@@ -740,6 +740,61 @@ func TestDropPartitionMsg_Unmarshal_IllegalParameter(t *testing.T) {
 	assert.Nil(t, tsMsg)
 }
 
+func TestAddFieldMsg(t *testing.T) {
+	addFieldMsg := &AddFieldMsg{
+		BaseMsg: generateBaseMsg(),
+		AddFieldRequest: internalpb.AddFieldRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AddField,
+				MsgID:     1,
+				Timestamp: 2,
+				SourceID:  3,
+			},
+			DbName:         "test_db",
+			CollectionName: "test_collection",
+			DbID:           4,
+			CollectionID:   5,
+			Field: &schemapb.FieldSchema{
+				FieldID:  101,
+				Name:     "test_field",
+				DataType: schemapb.DataType_Int64,
+			},
+			SchemaVersion: 1,
+		},
+	}
+
+	assert.NotNil(t, addFieldMsg.TraceCtx())
+
+	ctx := context.Background()
+	addFieldMsg.SetTraceCtx(ctx)
+	assert.Equal(t, ctx, addFieldMsg.TraceCtx())
+
+	assert.Equal(t, int64(1), addFieldMsg.ID())
+	assert.Equal(t, commonpb.MsgType_AddField, addFieldMsg.Type())
+	assert.Equal(t, int64(3), addFieldMsg.SourceID())
+
+	bytes, err := addFieldMsg.Marshal(addFieldMsg)
+	assert.Nil(t, err)
+
+	tsMsg, err := addFieldMsg.Unmarshal(bytes)
+	assert.Nil(t, err)
+
+	addFieldMsg2, ok := tsMsg.(*AddFieldMsg)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), addFieldMsg2.ID())
+	assert.Equal(t, commonpb.MsgType_AddField, addFieldMsg2.Type())
+	assert.Equal(t, int64(3), addFieldMsg2.SourceID())
+	assert.Equal(t, "test_field", addFieldMsg2.Field.Name)
+	assert.Equal(t, int64(1), addFieldMsg2.SchemaVersion)
+}
+
+func TestAddFieldMsg_Unmarshal_IllegalParameter(t *testing.T) {
+	addFieldMsg := &AddFieldMsg{}
+	tsMsg, err := addFieldMsg.Unmarshal(10)
+	assert.NotNil(t, err)
+	assert.Nil(t, tsMsg)
+}
+
 func TestLoadBalanceSegmentsMsg(t *testing.T) {
 	loadBalanceSegmentsMsg := &LoadBalanceSegmentsMsg{
 		BaseMsg: generateBaseMsg(),
@@ -103,10 +103,11 @@ func DeleteRepackFunc(tsMsgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, e
 				Timestamp: deleteRequest.Timestamp,
 				SourceID:  deleteRequest.Base.SourceID,
 			},
-			CollectionName: deleteRequest.CollectionName,
-			ShardName:      deleteRequest.ShardName,
-			Timestamp:      deleteRequest.Timestamp,
-			PrimaryKeys:    deleteRequest.PrimaryKeys,
+			CollectionName:    deleteRequest.CollectionName,
+			ShardName:         deleteRequest.ShardName,
+			Timestamp:         deleteRequest.Timestamp,
+			PrimaryKeys:       deleteRequest.PrimaryKeys,
+			StringPrimaryKeys: deleteRequest.StringPrimaryKeys,
 		}
 
 		deleteMsg := &DeleteMsg{
@@ -208,6 +208,11 @@ const (
 	MsgType_CreateAlias        MsgType = 108
 	MsgType_DropAlias          MsgType = 109
 	MsgType_AlterAlias         MsgType = 110
+	MsgType_AddField           MsgType = 111
+	// DEFINITION REQUESTS: DATABASE
+	MsgType_CreateDatabase MsgType = 150
+	MsgType_DropDatabase   MsgType = 151
+	MsgType_ListDatabases  MsgType = 152
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -256,6 +261,20 @@ const (
 	MsgType_SegmentStatistics MsgType = 1206
 	MsgType_SegmentFlushDone  MsgType = 1207
 	MsgType_DataNodeTt        MsgType = 1208
+	// CREDENTIALS
+	MsgType_CreateCredential  MsgType = 1500
+	MsgType_GetCredential     MsgType = 1501
+	MsgType_DeleteCredential  MsgType = 1502
+	MsgType_UpdateCredential  MsgType = 1503
+	MsgType_ListCredUsernames MsgType = 1504
+	// RBAC
+	MsgType_CreateRole         MsgType = 1600
+	MsgType_DropRole           MsgType = 1601
+	MsgType_AddUserToRole      MsgType = 1602
+	MsgType_RemoveUserFromRole MsgType = 1603
+	MsgType_GrantPrivilege     MsgType = 1604
+	MsgType_RevokePrivilege    MsgType = 1605
+	MsgType_ListGrants         MsgType = 1606
 )
 
 var MsgType_name = map[int32]string{
@@ -271,6 +290,10 @@ var MsgType_name = map[int32]string{
 	108:  "CreateAlias",
 	109:  "DropAlias",
 	110:  "AlterAlias",
+	111:  "AddField",
+	150:  "CreateDatabase",
+	151:  "DropDatabase",
+	152:  "ListDatabases",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -312,6 +335,18 @@ var MsgType_name = map[int32]string{
 	1206: "SegmentStatistics",
 	1207: "SegmentFlushDone",
 	1208: "DataNodeTt",
+	1500: "CreateCredential",
+	1501: "GetCredential",
+	1502: "DeleteCredential",
+	1503: "UpdateCredential",
+	1504: "ListCredUsernames",
+	1600: "CreateRole",
+	1601: "DropRole",
+	1602: "AddUserToRole",
+	1603: "RemoveUserFromRole",
+	1604: "GrantPrivilege",
+	1605: "RevokePrivilege",
+	1606: "ListGrants",
 }
 
 var MsgType_value = map[string]int32{
@@ -327,6 +362,10 @@ var MsgType_value = map[string]int32{
 	"CreateAlias":             108,
 	"DropAlias":               109,
 	"AlterAlias":              110,
+	"AddField":                111,
+	"CreateDatabase":          150,
+	"DropDatabase":            151,
+	"ListDatabases":           152,
 	"CreatePartition":         200,
 	"DropPartition":           201,
 	"HasPartition":            202,
@@ -368,6 +407,18 @@ var MsgType_value = map[string]int32{
 	"SegmentStatistics":       1206,
 	"SegmentFlushDone":        1207,
 	"DataNodeTt":              1208,
+	"CreateCredential":        1500,
+	"GetCredential":           1501,
+	"DeleteCredential":        1502,
+	"UpdateCredential":        1503,
+	"ListCredUsernames":       1504,
+	"CreateRole":              1600,
+	"DropRole":                1601,
+	"AddUserToRole":           1602,
+	"RemoveUserFromRole":      1603,
+	"GrantPrivilege":          1604,
+	"RevokePrivilege":         1605,
+	"ListGrants":              1606,
 }
 
 func (x MsgType) String() string {
@@ -403,6 +454,100 @@ func (DslType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_555bd8c177793206, []int{4}
 }
 
+type CompactionState int32
+
+const (
+	CompactionState_UndefiedState CompactionState = 0
+	CompactionState_Executing     CompactionState = 1
+	CompactionState_Completed     CompactionState = 2
+)
+
+var CompactionState_name = map[int32]string{
+	0: "UndefiedState",
+	1: "Executing",
+	2: "Completed",
+}
+
+var CompactionState_value = map[string]int32{
+	"UndefiedState": 0,
+	"Executing":     1,
+	"Completed":     2,
+}
+
+func (x CompactionState) String() string {
+	return proto.EnumName(CompactionState_name, int32(x))
+}
+
+func (CompactionState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{5}
+}
+
+type ImportState int32
+
+const (
+	ImportState_ImportPending   ImportState = 0
+	ImportState_ImportFailed    ImportState = 1
+	ImportState_ImportStarted   ImportState = 2
+	ImportState_ImportCompleted ImportState = 3
+)
+
+var ImportState_name = map[int32]string{
+	0: "ImportPending",
+	1: "ImportFailed",
+	2: "ImportStarted",
+	3: "ImportCompleted",
+}
+
+var ImportState_value = map[string]int32{
+	"ImportPending":   0,
+	"ImportFailed":    1,
+	"ImportStarted":   2,
+	"ImportCompleted": 3,
+}
+
+func (x ImportState) String() string {
+	return proto.EnumName(ImportState_name, int32(x))
+}
+
+func (ImportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{6}
+}
+
+type ConsistencyLevel int32
+
+const (
+	// Wait until the data written before the request is visible
+	ConsistencyLevel_Strong ConsistencyLevel = 0
+	// Wait until the data written by the session itself is visible
+	ConsistencyLevel_Session ConsistencyLevel = 1
+	// Tolerate the data written within the staleness window to be invisible
+	ConsistencyLevel_Bounded ConsistencyLevel = 2
+	// Never wait
+	ConsistencyLevel_Eventually ConsistencyLevel = 3
+)
+
+var ConsistencyLevel_name = map[int32]string{
+	0: "Strong",
+	1: "Session",
+	2: "Bounded",
+	3: "Eventually",
+}
+
+var ConsistencyLevel_value = map[string]int32{
+	"Strong":     0,
+	"Session":    1,
+	"Bounded":    2,
+	"Eventually": 3,
+}
+
+func (x ConsistencyLevel) String() string {
+	return proto.EnumName(ConsistencyLevel_name, int32(x))
+}
+
+func (ConsistencyLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{7}
+}
+
 type Status struct {
 	ErrorCode            ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=milvus.proto.common.ErrorCode" json:"error_code,omitempty"`
 	Reason               string    `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
@@ -787,6 +932,9 @@ func init() {
 	proto.RegisterEnum("milvus.proto.common.SegmentState", SegmentState_name, SegmentState_value)
 	proto.RegisterEnum("milvus.proto.common.MsgType", MsgType_name, MsgType_value)
 	proto.RegisterEnum("milvus.proto.common.DslType", DslType_name, DslType_value)
+	proto.RegisterEnum("milvus.proto.common.CompactionState", CompactionState_name, CompactionState_value)
+	proto.RegisterEnum("milvus.proto.common.ImportState", ImportState_name, ImportState_value)
+	proto.RegisterEnum("milvus.proto.common.ConsistencyLevel", ConsistencyLevel_name, ConsistencyLevel_value)
 	proto.RegisterType((*Status)(nil), "milvus.proto.common.Status")
 	proto.RegisterType((*KeyValuePair)(nil), "milvus.proto.common.KeyValuePair")
 	proto.RegisterType((*KeyDataPair)(nil), "milvus.proto.common.KeyDataPair")
@@ -800,90 +948,107 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xd9, 0x6e, 0x1b, 0x37,
-	0x17, 0xf6, 0x68, 0x64, 0xcb, 0xa2, 0x65, 0x9b, 0xa6, 0x97, 0x38, 0xf9, 0x8d, 0x1f, 0x81, 0xae,
-	0x02, 0x03, 0xb1, 0xff, 0xbf, 0x41, 0xdb, 0xab, 0x5c, 0xd8, 0x1a, 0x2f, 0x42, 0xe2, 0xa5, 0x23,
-	0x27, 0x2d, 0x7a, 0xd1, 0x80, 0x9e, 0x39, 0x92, 0xd8, 0xcc, 0x90, 0x2a, 0x49, 0x39, 0xd6, 0x5b,
-	0xb4, 0x79, 0x8e, 0xb6, 0xe8, 0x92, 0x2e, 0xe8, 0x13, 0x74, 0xbf, 0xee, 0x23, 0xf4, 0x01, 0xba,
-	0x66, 0x2d, 0x0e, 0x67, 0x24, 0x4d, 0x80, 0xe4, 0xaa, 0x77, 0x3c, 0x1f, 0x0f, 0x3f, 0x7e, 0xfc,
-	0xce, 0x21, 0x67, 0x48, 0x2d, 0x52, 0x69, 0xaa, 0xe4, 0x46, 0x4f, 0x2b, 0xab, 0xd8, 0x62, 0x2a,
-	0x92, 0xb3, 0xbe, 0xc9, 0xa2, 0x8d, 0x6c, 0xaa, 0x7e, 0x87, 0x4c, 0xb5, 0x2c, 0xb7, 0x7d, 0xc3,
-	0xae, 0x13, 0x02, 0x5a, 0x2b, 0x7d, 0x27, 0x52, 0x31, 0xac, 0x7a, 0x97, 0xbd, 0x2b, 0x73, 0xaf,
-	0xfc, 0x77, 0xe3, 0x05, 0x6b, 0x36, 0x76, 0x30, 0xad, 0xa1, 0x62, 0x08, 0xab, 0x30, 0x1c, 0xb2,
-	0x15, 0x32, 0xa5, 0x81, 0x1b, 0x25, 0x57, 0x4b, 0x97, 0xbd, 0x2b, 0xd5, 0x30, 0x8f, 0xea, 0xaf,
-	0x91, 0xda, 0x0d, 0x18, 0xdc, 0xe6, 0x49, 0x1f, 0x8e, 0xb9, 0xd0, 0x8c, 0x12, 0xff, 0x2e, 0x0c,
-	0x1c, 0x7f, 0x35, 0xc4, 0x21, 0x5b, 0x22, 0x93, 0x67, 0x38, 0x9d, 0x2f, 0xcc, 0x82, 0xfa, 0x35,
-	0x32, 0x73, 0x03, 0x06, 0x01, 0xb7, 0xfc, 0x25, 0xcb, 0x18, 0x29, 0xc7, 0xdc, 0x72, 0xb7, 0xaa,
-	0x16, 0xba, 0x71, 0x7d, 0x8d, 0x94, 0xb7, 0x13, 0x75, 0x3a, 0xa6, 0xf4, 0xdc, 0x64, 0x4e, 0x79,
-	0x95, 0x54, 0xb6, 0xe2, 0x58, 0x83, 0x31, 0x6c, 0x8e, 0x94, 0x44, 0x2f, 0x67, 0x2b, 0x89, 0x1e,
-	0x92, 0xf5, 0x94, 0xb6, 0x8e, 0xcc, 0x0f, 0xdd, 0xb8, 0x7e, 0xdf, 0x23, 0x95, 0x03, 0xd3, 0xd9,
-	0xe6, 0x06, 0xd8, 0xeb, 0x64, 0x3a, 0x35, 0x9d, 0x3b, 0x76, 0xd0, 0x1b, 0x5a, 0xb3, 0xf6, 0x42,
-	0x6b, 0x0e, 0x4c, 0xe7, 0x64, 0xd0, 0x83, 0xb0, 0x92, 0x66, 0x03, 0x54, 0x92, 0x9a, 0x4e, 0x33,
-	0xc8, 0x99, 0xb3, 0x80, 0xad, 0x91, 0xaa, 0x15, 0x29, 0x18, 0xcb, 0xd3, 0xde, 0xaa, 0x7f, 0xd9,
-	0xbb, 0x52, 0x0e, 0xc7, 0x00, 0xbb, 0x44, 0xa6, 0x8d, 0xea, 0xeb, 0x08, 0x9a, 0xc1, 0x6a, 0xd9,
-	0x2d, 0x1b, 0xc5, 0xf5, 0xeb, 0xa4, 0x7a, 0x60, 0x3a, 0xfb, 0xc0, 0x63, 0xd0, 0xec, 0x7f, 0xa4,
-	0x7c, 0xca, 0x4d, 0xa6, 0x68, 0xe6, 0xe5, 0x8a, 0xf0, 0x04, 0xa1, 0xcb, 0xac, 0xbf, 0x43, 0x6a,
-	0xc1, 0xc1, 0xcd, 0x7f, 0xc1, 0x80, 0xd2, 0x4d, 0x97, 0xeb, 0xf8, 0x90, 0xa7, 0xc3, 0x8a, 0x8d,
-	0x81, 0xf5, 0x6f, 0xca, 0xa4, 0x3a, 0x6a, 0x0f, 0x36, 0x43, 0x2a, 0xad, 0x7e, 0x14, 0x81, 0x31,
-	0x74, 0x82, 0x2d, 0x92, 0xf9, 0x5b, 0x12, 0xce, 0x7b, 0x10, 0x59, 0x88, 0x5d, 0x0e, 0xf5, 0xd8,
-	0x02, 0x99, 0x6d, 0x28, 0x29, 0x21, 0xb2, 0xbb, 0x5c, 0x24, 0x10, 0xd3, 0x12, 0x5b, 0x22, 0xf4,
-	0x18, 0x74, 0x2a, 0x8c, 0x11, 0x4a, 0x06, 0x20, 0x05, 0xc4, 0xd4, 0x67, 0x17, 0xc8, 0x62, 0x43,
-	0x25, 0x09, 0x44, 0x56, 0x28, 0x79, 0xa8, 0xec, 0xce, 0xb9, 0x30, 0xd6, 0xd0, 0x32, 0xd2, 0x36,
-	0x93, 0x04, 0x3a, 0x3c, 0xd9, 0xd2, 0x9d, 0x7e, 0x0a, 0xd2, 0xd2, 0x49, 0xe4, 0xc8, 0xc1, 0x40,
-	0xa4, 0x20, 0x91, 0x89, 0x56, 0x0a, 0x68, 0x53, 0xc6, 0x70, 0x8e, 0xf5, 0xa1, 0xd3, 0xec, 0x22,
-	0x59, 0xce, 0xd1, 0xc2, 0x06, 0x3c, 0x05, 0x5a, 0x65, 0xf3, 0x64, 0x26, 0x9f, 0x3a, 0x39, 0x3a,
-	0xbe, 0x41, 0x49, 0x81, 0x21, 0x54, 0xf7, 0x42, 0x88, 0x94, 0x8e, 0xe9, 0x4c, 0x41, 0xc2, 0x6d,
-	0x88, 0xac, 0xd2, 0xcd, 0x80, 0xd6, 0x50, 0x70, 0x0e, 0xb6, 0x80, 0xeb, 0xa8, 0x1b, 0x82, 0xe9,
-	0x27, 0x96, 0xce, 0x32, 0x4a, 0x6a, 0xbb, 0x22, 0x81, 0x43, 0x65, 0x77, 0x55, 0x5f, 0xc6, 0x74,
-	0x8e, 0xcd, 0x11, 0x72, 0x00, 0x96, 0xe7, 0x0e, 0xcc, 0xe3, 0xb6, 0x0d, 0x1e, 0x75, 0x21, 0x07,
-	0x28, 0x5b, 0x21, 0xac, 0xc1, 0xa5, 0x54, 0xb6, 0xa1, 0x81, 0x5b, 0xd8, 0x55, 0x49, 0x0c, 0x9a,
-	0x2e, 0xa0, 0x9c, 0xe7, 0x70, 0x91, 0x00, 0x65, 0xe3, 0xec, 0x00, 0x12, 0x18, 0x65, 0x2f, 0x8e,
-	0xb3, 0x73, 0x1c, 0xb3, 0x97, 0x50, 0xfc, 0x76, 0x5f, 0x24, 0xb1, 0xb3, 0x24, 0x2b, 0xcb, 0x32,
-	0x6a, 0xcc, 0xc5, 0x1f, 0xde, 0x6c, 0xb6, 0x4e, 0xe8, 0x0a, 0x5b, 0x26, 0x0b, 0x39, 0x72, 0x00,
-	0x56, 0x8b, 0xc8, 0x99, 0x77, 0x01, 0xa5, 0x1e, 0xf5, 0xed, 0x51, 0xfb, 0x00, 0x52, 0xa5, 0x07,
-	0x74, 0x15, 0x0b, 0xea, 0x98, 0x86, 0x25, 0xa2, 0x17, 0x71, 0x87, 0x9d, 0xb4, 0x67, 0x07, 0x63,
-	0x7b, 0xe9, 0x25, 0xc6, 0xc8, 0x6c, 0x10, 0x84, 0xf0, 0x5e, 0x1f, 0x8c, 0x0d, 0x79, 0x04, 0xf4,
-	0xd7, 0xca, 0xfa, 0x5b, 0x84, 0xb8, 0xb5, 0xf8, 0x20, 0x01, 0x63, 0x64, 0x6e, 0x1c, 0x1d, 0x2a,
-	0x09, 0x74, 0x82, 0xd5, 0xc8, 0xf4, 0x2d, 0x29, 0x8c, 0xe9, 0x43, 0x4c, 0x3d, 0xf4, 0xad, 0x29,
-	0x8f, 0xb5, 0xea, 0xe0, 0x95, 0xa6, 0x25, 0x9c, 0xdd, 0x15, 0x52, 0x98, 0xae, 0xeb, 0x18, 0x42,
-	0xa6, 0x72, 0x03, 0xcb, 0xeb, 0x6d, 0x52, 0x6b, 0x41, 0x07, 0x9b, 0x23, 0xe3, 0x5e, 0x22, 0xb4,
-	0x18, 0x8f, 0xd9, 0x47, 0xb2, 0x3d, 0x6c, 0xde, 0x3d, 0xad, 0xee, 0x09, 0xd9, 0xa1, 0x25, 0x24,
-	0x6b, 0x01, 0x4f, 0x1c, 0xf1, 0x0c, 0xa9, 0xec, 0x26, 0x7d, 0xb7, 0x4b, 0xd9, 0xed, 0x89, 0x01,
-	0xa6, 0x4d, 0xae, 0x3f, 0x98, 0x76, 0x4f, 0x86, 0xbb, 0xf9, 0xb3, 0xa4, 0x7a, 0x4b, 0xc6, 0xd0,
-	0x16, 0x12, 0x62, 0x3a, 0xe1, 0xdc, 0x77, 0x55, 0x2a, 0xd8, 0x10, 0xe3, 0x21, 0x03, 0xad, 0x7a,
-	0x05, 0x0c, 0xd0, 0xc2, 0x7d, 0x6e, 0x0a, 0x50, 0x1b, 0x4b, 0x1a, 0x80, 0x89, 0xb4, 0x38, 0x2d,
-	0x2e, 0xef, 0xa0, 0xb5, 0xad, 0xae, 0xba, 0x37, 0xc6, 0x0c, 0xed, 0xe2, 0x4e, 0x7b, 0x60, 0x5b,
-	0x03, 0x63, 0x21, 0x6d, 0x28, 0xd9, 0x16, 0x1d, 0x43, 0x05, 0xee, 0x74, 0x53, 0xf1, 0xb8, 0xb0,
-	0xfc, 0x5d, 0x2c, 0x6a, 0x08, 0x09, 0x70, 0x53, 0x64, 0xbd, 0xeb, 0xfa, 0xcf, 0x49, 0xdd, 0x4a,
-	0x04, 0x37, 0x34, 0xc1, 0xa3, 0xa0, 0xca, 0x2c, 0x4c, 0xd1, 0xf7, 0xad, 0xc4, 0x82, 0xce, 0x62,
-	0xc9, 0x96, 0xc8, 0x7c, 0x96, 0x7f, 0xcc, 0xb5, 0x15, 0x8e, 0xe4, 0x5b, 0xcf, 0x55, 0x58, 0xab,
-	0xde, 0x18, 0xfb, 0x0e, 0xaf, 0x7b, 0x6d, 0x9f, 0x9b, 0x31, 0xf4, 0xbd, 0xc7, 0x56, 0xc8, 0xc2,
-	0xf0, 0x68, 0x63, 0xfc, 0x07, 0x8f, 0x2d, 0x92, 0x39, 0x3c, 0xda, 0x08, 0x33, 0xf4, 0x47, 0x07,
-	0xe2, 0x21, 0x0a, 0xe0, 0x4f, 0x8e, 0x21, 0x3f, 0x45, 0x01, 0xff, 0xd9, 0x6d, 0x86, 0x0c, 0x79,
-	0xa1, 0x0d, 0x7d, 0xe8, 0xa1, 0xd2, 0xe1, 0x66, 0x39, 0x4c, 0x1f, 0xb9, 0x44, 0x64, 0x1d, 0x25,
-	0x3e, 0x76, 0x89, 0x39, 0xe7, 0x08, 0x7d, 0xe2, 0xd0, 0x7d, 0x2e, 0x63, 0xd5, 0x6e, 0x8f, 0xd0,
-	0xa7, 0x1e, 0x5b, 0x25, 0x8b, 0xb8, 0x7c, 0x9b, 0x27, 0x5c, 0x46, 0xe3, 0xfc, 0x67, 0x1e, 0xa3,
-	0x43, 0x23, 0x5d, 0x23, 0xd3, 0x0f, 0x4b, 0xce, 0x94, 0x5c, 0x40, 0x86, 0x7d, 0x54, 0x62, 0x73,
-	0x99, 0xbb, 0x59, 0xfc, 0x71, 0x89, 0xcd, 0x90, 0xa9, 0xa6, 0x34, 0xa0, 0x2d, 0x7d, 0x1f, 0x9b,
-	0x6d, 0x2a, 0xbb, 0xae, 0xf4, 0x03, 0x6c, 0xe9, 0x49, 0xd7, 0x6c, 0xf4, 0xbe, 0x9b, 0xc8, 0x1e,
-	0x16, 0xfa, 0x9b, 0xef, 0x8e, 0x5a, 0x7c, 0x65, 0x7e, 0xf7, 0x71, 0xa7, 0x3d, 0xb0, 0xe3, 0x1b,
-	0x44, 0xff, 0xf0, 0xd9, 0x25, 0xb2, 0x3c, 0xc4, 0xdc, 0x9d, 0x1f, 0xdd, 0x9d, 0x3f, 0x7d, 0xb6,
-	0x46, 0x2e, 0xec, 0x81, 0x1d, 0xf7, 0x01, 0x2e, 0x12, 0xc6, 0x8a, 0xc8, 0xd0, 0xbf, 0x7c, 0xf6,
-	0x1f, 0xb2, 0xb2, 0x07, 0x76, 0xe4, 0x6f, 0x61, 0xf2, 0x6f, 0x9f, 0xcd, 0x92, 0xe9, 0x10, 0x1f,
-	0x05, 0x38, 0x03, 0xfa, 0xd0, 0xc7, 0x22, 0x0d, 0xc3, 0x5c, 0xce, 0x23, 0x1f, 0xad, 0x7b, 0x93,
-	0xdb, 0xa8, 0x1b, 0xa4, 0x8d, 0x2e, 0x97, 0x12, 0x12, 0x43, 0x1f, 0xfb, 0x6c, 0x99, 0xd0, 0x10,
-	0x52, 0x75, 0x06, 0x05, 0xf8, 0x09, 0x3e, 0xf6, 0xcc, 0x25, 0xbf, 0xd1, 0x07, 0x3d, 0x18, 0x4d,
-	0x3c, 0xf5, 0xd1, 0xea, 0x2c, 0xff, 0xf9, 0x99, 0x67, 0x3e, 0x5a, 0x9d, 0x3b, 0xdf, 0x94, 0x6d,
-	0x45, 0x7f, 0x29, 0xa3, 0xaa, 0x13, 0x91, 0xc2, 0x89, 0x88, 0xee, 0xd2, 0x4f, 0xaa, 0xa8, 0xca,
-	0x2d, 0x3a, 0x54, 0x31, 0xa0, 0x7c, 0x43, 0x3f, 0xad, 0xa2, 0xf5, 0x58, 0xba, 0xcc, 0xfa, 0xcf,
-	0x5c, 0x9c, 0xbf, 0x49, 0xcd, 0x80, 0x7e, 0x8e, 0x1f, 0x00, 0x92, 0xc7, 0x27, 0xad, 0x23, 0xfa,
-	0xa0, 0x8a, 0xc7, 0xd8, 0x4a, 0x12, 0x15, 0x71, 0x3b, 0x6a, 0xa0, 0x2f, 0xaa, 0xd8, 0x81, 0x85,
-	0xe7, 0x24, 0x37, 0xe6, 0xcb, 0x2a, 0x1e, 0x2f, 0xc7, 0x5d, 0xd9, 0x02, 0x7c, 0x66, 0xbe, 0x72,
-	0xac, 0xf8, 0x5f, 0x83, 0x4a, 0x4e, 0x2c, 0xfd, 0xba, 0xba, 0x5e, 0x27, 0x95, 0xc0, 0x24, 0xee,
-	0xd5, 0xa8, 0x10, 0x3f, 0x30, 0x09, 0x9d, 0xc0, 0x4b, 0xb6, 0xad, 0x54, 0xb2, 0x73, 0xde, 0xd3,
-	0xb7, 0xff, 0x4f, 0xbd, 0xed, 0x57, 0xdf, 0xbe, 0xd6, 0x11, 0xb6, 0xdb, 0x3f, 0xc5, 0xcf, 0xf2,
-	0x66, 0xf6, 0x9d, 0xbe, 0x2a, 0x54, 0x3e, 0xda, 0x14, 0xd2, 0x82, 0x96, 0x3c, 0xd9, 0x74, 0x9f,
-	0xee, 0xcd, 0xec, 0xd3, 0xdd, 0x3b, 0x3d, 0x9d, 0x72, 0xf1, 0xb5, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x70, 0xc4, 0x92, 0xd3, 0x0b, 0x0a, 0x00, 0x00,
+	// 1625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x73, 0x1c, 0x49,
+	0x11, 0x56, 0x4f, 0x8f, 0x35, 0x9e, 0x9a, 0x91, 0x94, 0x2e, 0x3d, 0xac, 0x35, 0x0e, 0xc2, 0xa1,
+	0x93, 0x43, 0x11, 0x6b, 0x03, 0x0e, 0xe0, 0xb4, 0x07, 0x69, 0x46, 0x8f, 0x09, 0x4b, 0xb2, 0x98,
+	0x91, 0x0c, 0xb1, 0x07, 0x1c, 0xa5, 0xee, 0xd4, 0x4c, 0xe1, 0xea, 0xaa, 0xa1, 0xaa, 0x46, 0xd6,
+	0xfc, 0x0b, 0xd8, 0x03, 0xf0, 0x23, 0x80, 0xe0, 0x0d, 0xc1, 0x89, 0x77, 0xf0, 0x3e, 0x73, 0xe0,
+	0x75, 0xe4, 0x07, 0xf0, 0x58, 0xf6, 0x49, 0x64, 0x75, 0xcf, 0x74, 0x3b, 0x62, 0xf7, 0xc4, 0xad,
+	0xf3, 0xab, 0xcc, 0xaf, 0xb2, 0xbf, 0xcc, 0xca, 0x2a, 0xd6, 0x4e, 0x4c, 0x96, 0x19, 0xfd, 0x60,
+	0x6c, 0x8d, 0x37, 0x7c, 0x35, 0x93, 0xea, 0x6a, 0xe2, 0x72, 0xeb, 0x41, 0xbe, 0xb4, 0xf5, 0x8c,
+	0x2d, 0x0e, 0xbc, 0xf0, 0x13, 0xc7, 0x5f, 0x63, 0x0c, 0xad, 0x35, 0xf6, 0x59, 0x62, 0x52, 0xdc,
+	0x8c, 0xee, 0x45, 0xf7, 0x97, 0x3f, 0xf1, 0xd1, 0x07, 0x1f, 0x10, 0xf3, 0x60, 0x8f, 0xdc, 0x3a,
+	0x26, 0xc5, 0x7e, 0x13, 0x67, 0x9f, 0x7c, 0x83, 0x2d, 0x5a, 0x14, 0xce, 0xe8, 0xcd, 0xda, 0xbd,
+	0xe8, 0x7e, 0xb3, 0x5f, 0x58, 0x5b, 0x9f, 0x62, 0xed, 0xc7, 0x38, 0x7d, 0x2a, 0xd4, 0x04, 0x4f,
+	0x85, 0xb4, 0x1c, 0x58, 0xfc, 0x1c, 0xa7, 0x81, 0xbf, 0xd9, 0xa7, 0x4f, 0xbe, 0xc6, 0x6e, 0x5c,
+	0xd1, 0x72, 0x11, 0x98, 0x1b, 0x5b, 0x8f, 0x58, 0xeb, 0x31, 0x4e, 0xbb, 0xc2, 0x8b, 0x0f, 0x09,
+	0xe3, 0xac, 0x9e, 0x0a, 0x2f, 0x42, 0x54, 0xbb, 0x1f, 0xbe, 0xb7, 0xee, 0xb2, 0xfa, 0xae, 0x32,
+	0x17, 0x25, 0x65, 0x14, 0x16, 0x0b, 0xca, 0x57, 0x59, 0x63, 0x27, 0x4d, 0x2d, 0x3a, 0xc7, 0x97,
+	0x59, 0x4d, 0x8e, 0x0b, 0xb6, 0x9a, 0x1c, 0x13, 0xd9, 0xd8, 0x58, 0x1f, 0xc8, 0xe2, 0x7e, 0xf8,
+	0xde, 0x7a, 0x23, 0x62, 0x8d, 0x63, 0x37, 0xdc, 0x15, 0x0e, 0xf9, 0xa7, 0xd9, 0xcd, 0xcc, 0x0d,
+	0x9f, 0xf9, 0xe9, 0x78, 0x26, 0xcd, 0xdd, 0x0f, 0x94, 0xe6, 0xd8, 0x0d, 0xcf, 0xa6, 0x63, 0xec,
+	0x37, 0xb2, 0xfc, 0x83, 0x32, 0xc9, 0xdc, 0xb0, 0xd7, 0x2d, 0x98, 0x73, 0x83, 0xdf, 0x65, 0x4d,
+	0x2f, 0x33, 0x74, 0x5e, 0x64, 0xe3, 0xcd, 0xf8, 0x5e, 0x74, 0xbf, 0xde, 0x2f, 0x01, 0x7e, 0x87,
+	0xdd, 0x74, 0x66, 0x62, 0x13, 0xec, 0x75, 0x37, 0xeb, 0x21, 0x6c, 0x6e, 0x6f, 0xbd, 0xc6, 0x9a,
+	0xc7, 0x6e, 0x78, 0x88, 0x22, 0x45, 0xcb, 0x3f, 0xc6, 0xea, 0x17, 0xc2, 0xe5, 0x19, 0xb5, 0x3e,
+	0x3c, 0x23, 0xfa, 0x83, 0x7e, 0xf0, 0xdc, 0xfa, 0x3c, 0x6b, 0x77, 0x8f, 0x8f, 0xfe, 0x0f, 0x06,
+	0x4a, 0xdd, 0x8d, 0x84, 0x4d, 0x4f, 0x44, 0x36, 0xab, 0x58, 0x09, 0x6c, 0xff, 0xb8, 0xce, 0x9a,
+	0xf3, 0xf6, 0xe0, 0x2d, 0xd6, 0x18, 0x4c, 0x92, 0x04, 0x9d, 0x83, 0x05, 0xbe, 0xca, 0x56, 0xce,
+	0x35, 0x5e, 0x8f, 0x31, 0xf1, 0x98, 0x06, 0x1f, 0x88, 0xf8, 0x2d, 0xb6, 0xd4, 0x31, 0x5a, 0x63,
+	0xe2, 0xf7, 0x85, 0x54, 0x98, 0x42, 0x8d, 0xaf, 0x31, 0x38, 0x45, 0x9b, 0x49, 0xe7, 0xa4, 0xd1,
+	0x5d, 0xd4, 0x12, 0x53, 0x88, 0xf9, 0x6d, 0xb6, 0xda, 0x31, 0x4a, 0x61, 0xe2, 0xa5, 0xd1, 0x27,
+	0xc6, 0xef, 0x5d, 0x4b, 0xe7, 0x1d, 0xd4, 0x89, 0xb6, 0xa7, 0x14, 0x0e, 0x85, 0xda, 0xb1, 0xc3,
+	0x49, 0x86, 0xda, 0xc3, 0x0d, 0xe2, 0x28, 0xc0, 0xae, 0xcc, 0x50, 0x13, 0x13, 0x34, 0x2a, 0x68,
+	0x4f, 0xa7, 0x78, 0x4d, 0xf5, 0x81, 0x9b, 0xfc, 0x15, 0xb6, 0x5e, 0xa0, 0x95, 0x0d, 0x44, 0x86,
+	0xd0, 0xe4, 0x2b, 0xac, 0x55, 0x2c, 0x9d, 0x3d, 0x39, 0x7d, 0x0c, 0xac, 0xc2, 0xd0, 0x37, 0x2f,
+	0xfa, 0x98, 0x18, 0x9b, 0x42, 0xab, 0x92, 0xc2, 0x53, 0x4c, 0xbc, 0xb1, 0xbd, 0x2e, 0xb4, 0x29,
+	0xe1, 0x02, 0x1c, 0xa0, 0xb0, 0xc9, 0xa8, 0x8f, 0x6e, 0xa2, 0x3c, 0x2c, 0x71, 0x60, 0xed, 0x7d,
+	0xa9, 0xf0, 0xc4, 0xf8, 0x7d, 0x33, 0xd1, 0x29, 0x2c, 0xf3, 0x65, 0xc6, 0x8e, 0xd1, 0x8b, 0x42,
+	0x81, 0x15, 0xda, 0xb6, 0x23, 0x92, 0x11, 0x16, 0x00, 0xf0, 0x0d, 0xc6, 0x3b, 0x42, 0x6b, 0xe3,
+	0x3b, 0x16, 0x85, 0xc7, 0x7d, 0xa3, 0x52, 0xb4, 0x70, 0x8b, 0xd2, 0x79, 0x09, 0x97, 0x0a, 0x81,
+	0x97, 0xde, 0x5d, 0x54, 0x38, 0xf7, 0x5e, 0x2d, 0xbd, 0x0b, 0x9c, 0xbc, 0xd7, 0x28, 0xf9, 0xdd,
+	0x89, 0x54, 0x69, 0x90, 0x24, 0x2f, 0xcb, 0x3a, 0xe5, 0x58, 0x24, 0x7f, 0x72, 0xd4, 0x1b, 0x9c,
+	0xc1, 0x06, 0x5f, 0x67, 0xb7, 0x0a, 0xe4, 0x18, 0xbd, 0x95, 0x49, 0x10, 0xef, 0x36, 0xa5, 0xfa,
+	0x64, 0xe2, 0x9f, 0x5c, 0x1e, 0x63, 0x66, 0xec, 0x14, 0x36, 0xa9, 0xa0, 0x81, 0x69, 0x56, 0x22,
+	0x78, 0x85, 0x76, 0xd8, 0xcb, 0xc6, 0x7e, 0x5a, 0xca, 0x0b, 0x77, 0x38, 0x67, 0x4b, 0xdd, 0x6e,
+	0x1f, 0xbf, 0x38, 0x41, 0xe7, 0xfb, 0x22, 0x41, 0xf8, 0x47, 0x63, 0xfb, 0x73, 0x8c, 0x85, 0x58,
+	0x1a, 0x48, 0xc8, 0x39, 0x5b, 0x2e, 0xad, 0x13, 0xa3, 0x11, 0x16, 0x78, 0x9b, 0xdd, 0x3c, 0xd7,
+	0xd2, 0xb9, 0x09, 0xa6, 0x10, 0x91, 0x6e, 0x3d, 0x7d, 0x6a, 0xcd, 0x90, 0x8e, 0x34, 0xd4, 0x68,
+	0x75, 0x5f, 0x6a, 0xe9, 0x46, 0xa1, 0x63, 0x18, 0x5b, 0x2c, 0x04, 0xac, 0x6f, 0x5f, 0xb2, 0xf6,
+	0x00, 0x87, 0xd4, 0x1c, 0x39, 0xf7, 0x1a, 0x83, 0xaa, 0x5d, 0xb2, 0xcf, 0xd3, 0x8e, 0xa8, 0x79,
+	0x0f, 0xac, 0x79, 0x21, 0xf5, 0x10, 0x6a, 0x44, 0x36, 0x40, 0xa1, 0x02, 0x71, 0x8b, 0x35, 0xf6,
+	0xd5, 0x24, 0xec, 0x52, 0x0f, 0x7b, 0x92, 0x41, 0x6e, 0x37, 0xb6, 0xdf, 0x64, 0x61, 0x64, 0x84,
+	0x93, 0xbf, 0xc4, 0x9a, 0xe7, 0x3a, 0xc5, 0x4b, 0xa9, 0x31, 0x85, 0x85, 0xa0, 0x7e, 0xa8, 0x52,
+	0x45, 0x86, 0x94, 0x7e, 0xb2, 0x6b, 0xcd, 0xb8, 0x82, 0x21, 0x49, 0x78, 0x28, 0x5c, 0x05, 0xba,
+	0xa4, 0x92, 0x76, 0xd1, 0x25, 0x56, 0x5e, 0x54, 0xc3, 0x87, 0x24, 0xed, 0x60, 0x64, 0x5e, 0x94,
+	0x98, 0x83, 0x11, 0xed, 0x74, 0x80, 0x7e, 0x30, 0x75, 0x1e, 0xb3, 0x8e, 0xd1, 0x97, 0x72, 0xe8,
+	0x40, 0xd2, 0x4e, 0x47, 0x46, 0xa4, 0x95, 0xf0, 0x2f, 0x50, 0x51, 0xfb, 0xa8, 0x50, 0xb8, 0x2a,
+	0xeb, 0xf3, 0xd0, 0x7f, 0x21, 0xd5, 0x1d, 0x25, 0x85, 0x03, 0x45, 0xbf, 0x42, 0x59, 0xe6, 0x66,
+	0x46, 0xba, 0xef, 0x28, 0x8f, 0x36, 0xb7, 0x35, 0x69, 0xb0, 0x93, 0xa6, 0xfb, 0x12, 0x55, 0x0a,
+	0x86, 0xaf, 0xb2, 0xe5, 0x3c, 0x9a, 0x66, 0x37, 0x8d, 0x0c, 0xf8, 0x0a, 0x9d, 0xf3, 0x36, 0x31,
+	0xcc, 0xa1, 0xaf, 0x46, 0xd4, 0x01, 0x47, 0xd2, 0xf9, 0x19, 0xe4, 0xe0, 0x6b, 0x11, 0x5f, 0x63,
+	0x2b, 0x79, 0xec, 0xa9, 0xb0, 0x5e, 0x86, 0x74, 0x7e, 0x1d, 0x3c, 0x29, 0xb8, 0xc4, 0x7e, 0x13,
+	0x08, 0x0f, 0x85, 0x2b, 0xa1, 0xdf, 0x46, 0x7c, 0x83, 0xdd, 0x9a, 0x89, 0x54, 0xe2, 0xbf, 0x8b,
+	0x28, 0x21, 0x12, 0x69, 0x8e, 0x39, 0xf8, 0x7d, 0x00, 0x49, 0x8e, 0x0a, 0xf8, 0x87, 0xc0, 0x50,
+	0xe8, 0x51, 0xc1, 0xff, 0x18, 0x36, 0x23, 0x86, 0xa2, 0x65, 0x1c, 0xbc, 0x15, 0x32, 0x9d, 0x6d,
+	0x56, 0xc0, 0xf0, 0x76, 0x70, 0x24, 0xd6, 0xb9, 0xe3, 0x3b, 0xc1, 0xb1, 0xe0, 0x9c, 0xa3, 0xef,
+	0x06, 0xf4, 0x50, 0xe8, 0xd4, 0x5c, 0x5e, 0xce, 0xd1, 0xf7, 0x22, 0xbe, 0xc9, 0x56, 0x29, 0x7c,
+	0x57, 0x28, 0xa1, 0x93, 0xd2, 0xff, 0xfd, 0x88, 0xc3, 0xac, 0x24, 0xe1, 0x48, 0xc0, 0xd7, 0x6b,
+	0x41, 0x94, 0x22, 0x81, 0x1c, 0xfb, 0x46, 0x8d, 0x2f, 0xe7, 0x75, 0xca, 0xed, 0x6f, 0xd6, 0x78,
+	0x8b, 0x2d, 0xf6, 0xb4, 0x43, 0xeb, 0xe1, 0x4b, 0xd4, 0xb6, 0x8b, 0xf9, 0xc1, 0x87, 0x2f, 0xd3,
+	0xe1, 0xb8, 0x11, 0xda, 0x16, 0xde, 0x08, 0x0b, 0xf9, 0x88, 0x82, 0x7f, 0xc6, 0xe1, 0x57, 0xab,
+	0xf3, 0xea, 0x5f, 0x31, 0xed, 0x74, 0x80, 0xbe, 0x3c, 0x8b, 0xf0, 0xef, 0x98, 0xdf, 0x61, 0xeb,
+	0x33, 0x2c, 0x4c, 0x8f, 0xf9, 0x29, 0xfc, 0x4f, 0xcc, 0xef, 0xb2, 0xdb, 0x07, 0xe8, 0xcb, 0x8e,
+	0xa2, 0x20, 0xe9, 0xbc, 0x4c, 0x1c, 0xbc, 0x19, 0xf3, 0x8f, 0xb0, 0x8d, 0x03, 0xf4, 0x73, 0x7d,
+	0x2b, 0x8b, 0xff, 0x8d, 0xf9, 0x12, 0xbb, 0xd9, 0xa7, 0xf1, 0x82, 0x57, 0x08, 0x6f, 0xc5, 0x54,
+	0xa4, 0x99, 0x59, 0xa4, 0xf3, 0x76, 0x4c, 0xd2, 0x7d, 0x56, 0xf8, 0x64, 0xd4, 0xcd, 0x3a, 0x23,
+	0xa1, 0x35, 0x2a, 0x07, 0xef, 0xc4, 0x7c, 0x9d, 0x41, 0x1f, 0x33, 0x73, 0x85, 0x15, 0xf8, 0x5d,
+	0xba, 0x36, 0x78, 0x70, 0xfe, 0xcc, 0x04, 0xed, 0x74, 0xbe, 0xf0, 0x5e, 0x4c, 0x52, 0xe7, 0xfe,
+	0x2f, 0xaf, 0xbc, 0x1f, 0x93, 0xd4, 0x85, 0xf2, 0x3d, 0x7d, 0x69, 0xe0, 0x4f, 0x75, 0xca, 0xea,
+	0x4c, 0x66, 0x78, 0x26, 0x93, 0xe7, 0xf0, 0xad, 0x26, 0x65, 0x15, 0x82, 0x4e, 0x4c, 0x8a, 0x94,
+	0xbe, 0x83, 0x6f, 0x37, 0x49, 0x7a, 0x2a, 0x5d, 0x2e, 0xfd, 0x77, 0x82, 0x5d, 0x4c, 0xb7, 0x5e,
+	0x17, 0xbe, 0x4b, 0x57, 0x09, 0x2b, 0xec, 0xb3, 0xc1, 0x13, 0xf8, 0x5e, 0x93, 0x7e, 0x63, 0x47,
+	0x29, 0x93, 0x08, 0x3f, 0x6f, 0xa0, 0xef, 0x37, 0xa9, 0x03, 0x2b, 0x83, 0xa9, 0x10, 0xe6, 0x07,
+	0x4d, 0xfa, 0xbd, 0x02, 0x0f, 0x65, 0xeb, 0xd2, 0xc0, 0xfa, 0x61, 0x60, 0xa5, 0xf3, 0x43, 0x99,
+	0x9c, 0x79, 0xf8, 0x51, 0xf0, 0x2b, 0xa6, 0x8c, 0xc5, 0x14, 0xb5, 0x97, 0x42, 0xc1, 0x9f, 0x5b,
+	0x45, 0x09, 0x2b, 0xd8, 0x5f, 0x5a, 0xe4, 0x9a, 0xf7, 0x43, 0x05, 0xfe, 0x6b, 0x80, 0xcf, 0xc7,
+	0xe9, 0xcb, 0x0c, 0x7f, 0x6b, 0x51, 0x62, 0x74, 0x5a, 0x09, 0x3c, 0x77, 0x68, 0xb5, 0xc8, 0xd0,
+	0xc1, 0xdf, 0x5b, 0x94, 0x41, 0xbe, 0x61, 0xdf, 0x28, 0x84, 0x9f, 0xb4, 0x49, 0x2c, 0xea, 0xc1,
+	0x60, 0xfe, 0xb4, 0x4d, 0x3b, 0xef, 0xa4, 0x21, 0xe4, 0xcc, 0x04, 0xec, 0x67, 0x74, 0x35, 0xf2,
+	0x5c, 0x7b, 0x82, 0xf7, 0xad, 0xc9, 0xc2, 0xc2, 0xcf, 0xdb, 0xa4, 0xec, 0x81, 0x15, 0xda, 0x9f,
+	0x5a, 0x79, 0x25, 0x15, 0x0e, 0x11, 0x7e, 0xd1, 0xce, 0x0f, 0xd0, 0x95, 0x79, 0x8e, 0x25, 0xfa,
+	0xcb, 0x36, 0xed, 0x4b, 0xf9, 0x04, 0x77, 0x07, 0xbf, 0x6a, 0x6f, 0x6f, 0xb1, 0x46, 0xd7, 0xa9,
+	0x30, 0x79, 0x1b, 0x2c, 0xee, 0x3a, 0x05, 0x0b, 0x34, 0xa8, 0x76, 0x8d, 0x51, 0x7b, 0xd7, 0x63,
+	0xfb, 0xf4, 0xe3, 0x10, 0x6d, 0xef, 0xb2, 0x95, 0x8e, 0xc9, 0xc6, 0x62, 0xde, 0x96, 0x61, 0xd8,
+	0xe6, 0x53, 0x1a, 0xd3, 0x00, 0xc0, 0x02, 0x4d, 0xbb, 0xbd, 0x6b, 0x4c, 0x26, 0x9e, 0x66, 0x7a,
+	0x44, 0x26, 0x05, 0x91, 0x52, 0x29, 0xd4, 0xb6, 0x5f, 0x67, 0xad, 0x5e, 0x46, 0xef, 0xc3, 0x79,
+	0x7c, 0x6e, 0x9e, 0xa2, 0x4e, 0x29, 0x60, 0x21, 0x5c, 0x9e, 0x01, 0x2a, 0xae, 0x9f, 0xa8, 0x74,
+	0x1a, 0x78, 0x61, 0x03, 0x4d, 0x78, 0x33, 0x04, 0xa8, 0xe4, 0x8e, 0xb7, 0x0f, 0x19, 0x74, 0x8c,
+	0x76, 0xd2, 0x79, 0xd4, 0xc9, 0xf4, 0x08, 0xaf, 0x50, 0x85, 0x9b, 0xc7, 0x5b, 0x13, 0x98, 0xe9,
+	0x3d, 0x85, 0xe1, 0x5d, 0x94, 0xdf, 0x4f, 0xbb, 0xf4, 0x80, 0x08, 0x74, 0xcb, 0x8c, 0xed, 0x5d,
+	0xa1, 0xf6, 0x13, 0xa1, 0xd4, 0x14, 0xe2, 0xdd, 0x4f, 0xbe, 0xfe, 0x68, 0x28, 0xfd, 0x68, 0x72,
+	0x41, 0x8f, 0xb8, 0x87, 0xf9, 0xab, 0xee, 0x55, 0x69, 0x8a, 0xaf, 0x87, 0x52, 0x7b, 0xaa, 0xa1,
+	0x7a, 0x18, 0x1e, 0x7a, 0x0f, 0xf3, 0x87, 0xde, 0xf8, 0xe2, 0x62, 0x31, 0xd8, 0x8f, 0xfe, 0x37,
+	0x00, 0x4b, 0xab, 0xde, 0xb5, 0x39, 0x0c, 0x00, 0x00,
 }
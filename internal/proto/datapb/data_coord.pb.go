@@ -53,6 +53,34 @@ func (ChannelWatchState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_82cd95f524594f49, []int{0}
 }
 
+type CompactionType int32
+
+const (
+	CompactionType_UndefinedCompaction CompactionType = 0
+	CompactionType_InnerCompaction     CompactionType = 1
+	CompactionType_MergeCompaction     CompactionType = 2
+)
+
+var CompactionType_name = map[int32]string{
+	0: "UndefinedCompaction",
+	1: "InnerCompaction",
+	2: "MergeCompaction",
+}
+
+var CompactionType_value = map[string]int32{
+	"UndefinedCompaction": 0,
+	"InnerCompaction":     1,
+	"MergeCompaction":     2,
+}
+
+func (x CompactionType) String() string {
+	return proto.EnumName(CompactionType_name, int32(x))
+}
+
+func (CompactionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{1}
+}
+
 type FlushRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -176,6 +204,7 @@ type SegmentIDRequest struct {
 	ChannelName          string   `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
 	CollectionID         int64    `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,4,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	IsImport             bool     `protobuf:"varint,5,opt,name=isImport,proto3" json:"isImport,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -234,6 +263,13 @@ func (m *SegmentIDRequest) GetPartitionID() int64 {
 	return 0
 }
 
+func (m *SegmentIDRequest) GetIsImport() bool {
+	if m != nil {
+		return m.IsImport
+	}
+	return false
+}
+
 type AssignSegmentIDRequest struct {
 	NodeID               int64               `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	PeerRole             string              `protobuf:"bytes,2,opt,name=peer_role,json=peerRole,proto3" json:"peer_role,omitempty"`
@@ -1377,6 +1413,10 @@ type SegmentInfo struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,9,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	DmlPosition          *internalpb.MsgPosition `protobuf:"bytes,10,opt,name=dml_position,json=dmlPosition,proto3" json:"dml_position,omitempty"`
 	Binlogs              []*FieldBinlog          `protobuf:"bytes,11,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
+	Deltalogs            []*DeltaLogInfo         `protobuf:"bytes,12,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CreatedByCompaction  bool                    `protobuf:"varint,13,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
+	CompactionFrom       []int64                 `protobuf:"varint,14,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	IsImporting          bool                    `protobuf:"varint,15,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1484,6 +1524,34 @@ func (m *SegmentInfo) GetBinlogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SegmentInfo) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetCreatedByCompaction() bool {
+	if m != nil {
+		return m.CreatedByCompaction
+	}
+	return false
+}
+
+func (m *SegmentInfo) GetCompactionFrom() []int64 {
+	if m != nil {
+		return m.CompactionFrom
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetIsImporting() bool {
+	if m != nil {
+		return m.IsImporting
+	}
+	return false
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1539,6 +1607,7 @@ type SaveBinlogPathsRequest struct {
 	CheckPoints          []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
 	StartPositions       []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	Flushed              bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Deltalogs            []*DeltaLogInfo         `protobuf:"bytes,8,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1618,6 +1687,13 @@ func (m *SaveBinlogPathsRequest) GetFlushed() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -1839,12 +1915,13 @@ func (m *DataNodeInfo) GetChannels() []*ChannelStatus {
 }
 
 type SegmentBinlogs struct {
-	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	SegmentID            int64           `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldBinlogs         []*FieldBinlog  `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	NumOfRows            int64           `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Deltalogs            []*DeltaLogInfo `protobuf:"bytes,4,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
@@ -1893,6 +1970,13 @@ func (m *SegmentBinlogs) GetNumOfRows() int64 {
 	return 0
 }
 
+func (m *SegmentBinlogs) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
 type FieldBinlog struct {
 	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	Binlogs              []string `protobuf:"bytes,2,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
@@ -1940,6 +2024,77 @@ func (m *FieldBinlog) GetBinlogs() []string {
 	return nil
 }
 
+type DeltaLogInfo struct {
+	RecordEntries        uint64   `protobuf:"varint,1,opt,name=record_entries,json=recordEntries,proto3" json:"record_entries,omitempty"`
+	TimestampFrom        uint64   `protobuf:"varint,2,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"`
+	TimestampTo          uint64   `protobuf:"varint,3,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`
+	DeltaLogPath         string   `protobuf:"bytes,4,opt,name=delta_log_path,json=deltaLogPath,proto3" json:"delta_log_path,omitempty"`
+	DeltaLogSize         int64    `protobuf:"varint,5,opt,name=delta_log_size,json=deltaLogSize,proto3" json:"delta_log_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeltaLogInfo) Reset()         { *m = DeltaLogInfo{} }
+func (m *DeltaLogInfo) String() string { return proto.CompactTextString(m) }
+func (*DeltaLogInfo) ProtoMessage()    {}
+func (*DeltaLogInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{33}
+}
+
+func (m *DeltaLogInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeltaLogInfo.Unmarshal(m, b)
+}
+func (m *DeltaLogInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeltaLogInfo.Marshal(b, m, deterministic)
+}
+func (m *DeltaLogInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeltaLogInfo.Merge(m, src)
+}
+func (m *DeltaLogInfo) XXX_Size() int {
+	return xxx_messageInfo_DeltaLogInfo.Size(m)
+}
+func (m *DeltaLogInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeltaLogInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeltaLogInfo proto.InternalMessageInfo
+
+func (m *DeltaLogInfo) GetRecordEntries() uint64 {
+	if m != nil {
+		return m.RecordEntries
+	}
+	return 0
+}
+
+func (m *DeltaLogInfo) GetTimestampFrom() uint64 {
+	if m != nil {
+		return m.TimestampFrom
+	}
+	return 0
+}
+
+func (m *DeltaLogInfo) GetTimestampTo() uint64 {
+	if m != nil {
+		return m.TimestampTo
+	}
+	return 0
+}
+
+func (m *DeltaLogInfo) GetDeltaLogPath() string {
+	if m != nil {
+		return m.DeltaLogPath
+	}
+	return ""
+}
+
+func (m *DeltaLogInfo) GetDeltaLogSize() int64 {
+	if m != nil {
+		return m.DeltaLogSize
+	}
+	return 0
+}
+
 type GetRecoveryInfoResponse struct {
 	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Channels             []*VchannelInfo   `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
@@ -1953,7 +2108,7 @@ func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{33}
+	return fileDescriptor_82cd95f524594f49, []int{34}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -2008,7 +2163,7 @@ func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{}
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{34}
+	return fileDescriptor_82cd95f524594f49, []int{35}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2063,7 +2218,7 @@ func (m *GetFlushedSegmentsRequest) Reset()         { *m = GetFlushedSegmentsReq
 func (m *GetFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsRequest) ProtoMessage()    {}
 func (*GetFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{35}
+	return fileDescriptor_82cd95f524594f49, []int{36}
 }
 
 func (m *GetFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2117,7 +2272,7 @@ func (m *GetFlushedSegmentsResponse) Reset()         { *m = GetFlushedSegmentsRe
 func (m *GetFlushedSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsResponse) ProtoMessage()    {}
 func (*GetFlushedSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{36}
+	return fileDescriptor_82cd95f524594f49, []int{37}
 }
 
 func (m *GetFlushedSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2164,7 +2319,7 @@ func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedM
 func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
 func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{37}
+	return fileDescriptor_82cd95f524594f49, []int{38}
 }
 
 func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
@@ -2212,7 +2367,7 @@ func (m *ChannelWatchInfo) Reset()         { *m = ChannelWatchInfo{} }
 func (m *ChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelWatchInfo) ProtoMessage()    {}
 func (*ChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{38}
+	return fileDescriptor_82cd95f524594f49, []int{39}
 }
 
 func (m *ChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2254,306 +2409,904 @@ func (m *ChannelWatchInfo) GetState() ChannelWatchState {
 	return ChannelWatchState_Uncomplete
 }
 
-// Deprecated
-type SegmentFieldBinlogMeta struct {
-	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
-	BinlogPath           string   `protobuf:"bytes,2,opt,name=binlog_path,json=binlogPath,proto3" json:"binlog_path,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CompactionSegmentBinlogs struct {
+	SegmentID            int64           `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldBinlogs         []*FieldBinlog  `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	Deltalogs            []*DeltaLogInfo `protobuf:"bytes,3,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{} }
-func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
-func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
-func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+func (m *CompactionSegmentBinlogs) Reset()         { *m = CompactionSegmentBinlogs{} }
+func (m *CompactionSegmentBinlogs) String() string { return proto.CompactTextString(m) }
+func (*CompactionSegmentBinlogs) ProtoMessage()    {}
+func (*CompactionSegmentBinlogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
-func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SegmentFieldBinlogMeta.Unmarshal(m, b)
+func (m *CompactionSegmentBinlogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionSegmentBinlogs.Unmarshal(m, b)
 }
-func (m *SegmentFieldBinlogMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SegmentFieldBinlogMeta.Marshal(b, m, deterministic)
+func (m *CompactionSegmentBinlogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionSegmentBinlogs.Marshal(b, m, deterministic)
 }
-func (m *SegmentFieldBinlogMeta) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SegmentFieldBinlogMeta.Merge(m, src)
+func (m *CompactionSegmentBinlogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionSegmentBinlogs.Merge(m, src)
 }
-func (m *SegmentFieldBinlogMeta) XXX_Size() int {
-	return xxx_messageInfo_SegmentFieldBinlogMeta.Size(m)
+func (m *CompactionSegmentBinlogs) XXX_Size() int {
+	return xxx_messageInfo_CompactionSegmentBinlogs.Size(m)
 }
-func (m *SegmentFieldBinlogMeta) XXX_DiscardUnknown() {
-	xxx_messageInfo_SegmentFieldBinlogMeta.DiscardUnknown(m)
+func (m *CompactionSegmentBinlogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionSegmentBinlogs.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SegmentFieldBinlogMeta proto.InternalMessageInfo
+var xxx_messageInfo_CompactionSegmentBinlogs proto.InternalMessageInfo
 
-func (m *SegmentFieldBinlogMeta) GetFieldID() int64 {
+func (m *CompactionSegmentBinlogs) GetSegmentID() int64 {
 	if m != nil {
-		return m.FieldID
+		return m.SegmentID
 	}
 	return 0
 }
 
-func (m *SegmentFieldBinlogMeta) GetBinlogPath() string {
+func (m *CompactionSegmentBinlogs) GetFieldBinlogs() []*FieldBinlog {
 	if m != nil {
-		return m.BinlogPath
+		return m.FieldBinlogs
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
-	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
-	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
-	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
-	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
-	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
-	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
-	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
-	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
-	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
-	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
-	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
-	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
-	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
-	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
-	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
-	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
-	proto.RegisterType((*DDLFlushMeta)(nil), "milvus.proto.data.DDLFlushMeta")
-	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
-	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
-	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
-	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
-	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
-	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
-	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
-	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
-	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
-	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
-	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
-	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
-	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
-	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
-	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
-	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+func (m *CompactionSegmentBinlogs) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+type CompactionPlan struct {
+	PlanID               int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
+	StartTime            uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeoutInSeconds     int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
+	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
+	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	CollectionID         int64                       `protobuf:"varint,8,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                       `protobuf:"varint,9,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
 
-var fileDescriptor_82cd95f524594f49 = []byte{
-	// 2014 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf6, 0x72, 0x75, 0x21, 0x0f, 0x29, 0x4a, 0x9a, 0xba, 0x0a, 0xbb, 0x76, 0x64, 0x79, 0xdb,
-	0xd8, 0x8a, 0xdb, 0x48, 0x31, 0xdd, 0xa2, 0x41, 0xdd, 0xb4, 0x88, 0xc5, 0x58, 0x20, 0x2a, 0xb9,
-	0xea, 0xca, 0x49, 0x80, 0xe6, 0x81, 0x58, 0x92, 0x23, 0x6a, 0x6b, 0xee, 0x2e, 0xb3, 0x33, 0x94,
-	0xe5, 0xa7, 0x04, 0x2e, 0x90, 0xa2, 0x45, 0xd1, 0x2b, 0xfa, 0x56, 0xa0, 0x45, 0x9f, 0x0a, 0xf4,
-	0xa5, 0x3f, 0xa3, 0xe8, 0x3f, 0xea, 0x5b, 0x31, 0xb3, 0x33, 0xb3, 0x57, 0x92, 0x2b, 0x2a, 0xb6,
-	0xde, 0x38, 0xb3, 0xe7, 0x36, 0x67, 0xce, 0xe5, 0x9b, 0x23, 0xc1, 0x5a, 0xdf, 0xa6, 0x76, 0xa7,
-	0xe7, 0xfb, 0x41, 0x7f, 0x67, 0x14, 0xf8, 0xd4, 0x47, 0xeb, 0xae, 0x33, 0x3c, 0x1b, 0x93, 0x70,
-	0xb5, 0xc3, 0x3e, 0x1b, 0xb5, 0x9e, 0xef, 0xba, 0xbe, 0x17, 0x6e, 0x19, 0x75, 0xc7, 0xa3, 0x38,
-	0xf0, 0xec, 0xa1, 0x58, 0xd7, 0xe2, 0x0c, 0x46, 0x8d, 0xf4, 0x4e, 0xb1, 0x6b, 0x87, 0x2b, 0xf3,
-	0x1c, 0x6a, 0x8f, 0x87, 0x63, 0x72, 0x6a, 0xe1, 0xcf, 0xc6, 0x98, 0x50, 0xf4, 0x2e, 0x2c, 0x74,
-	0x6d, 0x82, 0x1b, 0xda, 0x96, 0xb6, 0x5d, 0x6d, 0xde, 0xdc, 0x49, 0xe8, 0x12, 0x5a, 0x0e, 0xc9,
-	0xe0, 0x91, 0x4d, 0xb0, 0xc5, 0x29, 0x11, 0x82, 0x85, 0x7e, 0xb7, 0xdd, 0x6a, 0x94, 0xb6, 0xb4,
-	0x6d, 0xdd, 0xe2, 0xbf, 0x91, 0x09, 0xb5, 0x9e, 0x3f, 0x1c, 0xe2, 0x1e, 0x75, 0x7c, 0xaf, 0xdd,
-	0x6a, 0x2c, 0xf0, 0x6f, 0x89, 0x3d, 0xf3, 0xaf, 0x1a, 0xac, 0x08, 0xd5, 0x64, 0xe4, 0x7b, 0x04,
-	0xa3, 0x07, 0xb0, 0x44, 0xa8, 0x4d, 0xc7, 0x44, 0x68, 0xbf, 0x91, 0xab, 0xfd, 0x98, 0x93, 0x58,
-	0x82, 0xb4, 0x90, 0x7a, 0x3d, 0xab, 0x1e, 0x6d, 0x02, 0x10, 0x3c, 0x70, 0xb1, 0x47, 0xdb, 0x2d,
-	0xd2, 0x58, 0xd8, 0xd2, 0xb7, 0x75, 0x2b, 0xb6, 0x63, 0xfe, 0x51, 0x83, 0xb5, 0x63, 0xb9, 0x94,
-	0xde, 0xb9, 0x0e, 0x8b, 0x3d, 0x7f, 0xec, 0x51, 0x6e, 0xe0, 0x8a, 0x15, 0x2e, 0xd0, 0x6d, 0xa8,
-	0xf5, 0x4e, 0x6d, 0xcf, 0xc3, 0xc3, 0x8e, 0x67, 0xbb, 0x98, 0x9b, 0x52, 0xb1, 0xaa, 0x62, 0xef,
-	0x89, 0xed, 0xe2, 0x42, 0x16, 0x6d, 0x41, 0x75, 0x64, 0x07, 0xd4, 0x49, 0xf8, 0x2c, 0xbe, 0x65,
-	0xfe, 0x5d, 0x83, 0x8d, 0x0f, 0x08, 0x71, 0x06, 0x5e, 0xc6, 0xb2, 0x0d, 0x58, 0xf2, 0xfc, 0x3e,
-	0x6e, 0xb7, 0xb8, 0x69, 0xba, 0x25, 0x56, 0xe8, 0x06, 0x54, 0x46, 0x18, 0x07, 0x9d, 0xc0, 0x1f,
-	0x4a, 0xc3, 0xca, 0x6c, 0xc3, 0xf2, 0x87, 0x18, 0xfd, 0x0c, 0xd6, 0x49, 0x4a, 0x10, 0x69, 0xe8,
-	0x5b, 0xfa, 0x76, 0xb5, 0xf9, 0xcd, 0x9d, 0x4c, 0x94, 0xed, 0xa4, 0x95, 0x5a, 0x59, 0x6e, 0xf3,
-	0x8b, 0x12, 0x7c, 0x4d, 0xd1, 0x85, 0xb6, 0xb2, 0xdf, 0xcc, 0x73, 0x04, 0x0f, 0x94, 0x79, 0xe1,
-	0xa2, 0x88, 0xe7, 0x94, 0xcb, 0xf5, 0xb8, 0xcb, 0x0b, 0x04, 0x58, 0xda, 0x9f, 0x8b, 0x19, 0x7f,
-	0xa2, 0x5b, 0x50, 0xc5, 0xe7, 0x23, 0x27, 0xc0, 0x1d, 0xea, 0xb8, 0xb8, 0xb1, 0xb4, 0xa5, 0x6d,
-	0x2f, 0x58, 0x10, 0x6e, 0x3d, 0x75, 0xdc, 0x78, 0x44, 0x2e, 0x17, 0x8e, 0x48, 0xf3, 0x1f, 0x1a,
-	0xbc, 0x91, 0xb9, 0x25, 0x11, 0xe2, 0x16, 0xac, 0xf1, 0x93, 0x47, 0x9e, 0x61, 0xc1, 0xce, 0x1c,
-	0x7e, 0x67, 0x9a, 0xc3, 0x23, 0x72, 0x2b, 0xc3, 0x1f, 0x33, 0xb2, 0x54, 0xdc, 0xc8, 0x67, 0xf0,
-	0xc6, 0x3e, 0xa6, 0x42, 0x01, 0xfb, 0x86, 0xc9, 0xfc, 0x25, 0x20, 0x99, 0x4b, 0xa5, 0x4c, 0x2e,
-	0xfd, 0xbb, 0xa4, 0x72, 0x89, 0xab, 0x6a, 0x7b, 0x27, 0x3e, 0xba, 0x09, 0x15, 0x45, 0x22, 0xa2,
-	0x22, 0xda, 0x40, 0xdf, 0x87, 0x45, 0x66, 0x69, 0x18, 0x12, 0xf5, 0xe6, 0xed, 0xfc, 0x33, 0xc5,
-	0x64, 0x5a, 0x21, 0x3d, 0x6a, 0x43, 0x9d, 0x50, 0x3b, 0xa0, 0x9d, 0x91, 0x4f, 0xf8, 0x3d, 0xf3,
-	0xc0, 0xa9, 0x36, 0xcd, 0xa4, 0x04, 0x55, 0x22, 0x0f, 0xc9, 0xe0, 0x48, 0x50, 0x5a, 0x2b, 0x9c,
-	0x53, 0x2e, 0xd1, 0x87, 0x50, 0xc3, 0x5e, 0x3f, 0x12, 0xb4, 0x50, 0x58, 0x50, 0x15, 0x7b, 0x7d,
-	0x25, 0x26, 0xba, 0x9f, 0xc5, 0xe2, 0xf7, 0xf3, 0x5b, 0x0d, 0x1a, 0xd9, 0x0b, 0xba, 0x4c, 0xa1,
-	0x7c, 0x18, 0x32, 0xe1, 0xf0, 0x82, 0xa6, 0x66, 0xb8, 0xba, 0x24, 0x4b, 0xb0, 0x98, 0x0e, 0x7c,
-	0x3d, 0xb2, 0x86, 0x7f, 0x79, 0x65, 0xc1, 0xf2, 0x4b, 0x0d, 0x36, 0xd2, 0xba, 0x2e, 0x73, 0xee,
-	0xef, 0xc2, 0xa2, 0xe3, 0x9d, 0xf8, 0xf2, 0xd8, 0x9b, 0x53, 0xf2, 0x8c, 0xe9, 0x0a, 0x89, 0x4d,
-	0x17, 0x6e, 0xec, 0x63, 0xda, 0xf6, 0x08, 0x0e, 0xe8, 0x23, 0xc7, 0x1b, 0xfa, 0x83, 0x23, 0x9b,
-	0x9e, 0x5e, 0x22, 0x47, 0x12, 0xe1, 0x5e, 0x4a, 0x85, 0xbb, 0xf9, 0x4f, 0x0d, 0x6e, 0xe6, 0xeb,
-	0x13, 0x47, 0x37, 0xa0, 0x7c, 0xe2, 0xe0, 0x61, 0x9f, 0xf9, 0x4c, 0xe3, 0x3e, 0x53, 0x6b, 0x96,
-	0x2b, 0x23, 0x46, 0x2c, 0x4e, 0x78, 0x7b, 0x42, 0x80, 0x1e, 0xd3, 0xc0, 0xf1, 0x06, 0x07, 0x0e,
-	0xa1, 0x56, 0x48, 0x1f, 0xf3, 0xa7, 0x5e, 0x3c, 0x32, 0x7f, 0xa3, 0xc1, 0xe6, 0x3e, 0xa6, 0x7b,
-	0xaa, 0xd4, 0xb2, 0xef, 0x0e, 0xa1, 0x4e, 0x8f, 0xbc, 0x5a, 0x10, 0x91, 0xd3, 0x33, 0xcd, 0xdf,
-	0x6b, 0x70, 0x6b, 0xa2, 0x31, 0xc2, 0x75, 0xa2, 0x94, 0xc8, 0x42, 0x9b, 0x5f, 0x4a, 0x7e, 0x82,
-	0x5f, 0x7c, 0x6c, 0x0f, 0xc7, 0xf8, 0xc8, 0x76, 0x82, 0xb0, 0x94, 0xcc, 0x59, 0x58, 0xff, 0xa5,
-	0xc1, 0x9b, 0xfb, 0x98, 0x1e, 0xc9, 0x36, 0x73, 0x85, 0xde, 0x29, 0x80, 0x28, 0x7e, 0x17, 0x5e,
-	0x66, 0xae, 0xb5, 0x57, 0xe2, 0xbe, 0x4d, 0x9e, 0x07, 0xb1, 0x84, 0xdc, 0x0b, 0xb1, 0x80, 0x70,
-	0x9e, 0xf9, 0x97, 0x12, 0xd4, 0x3e, 0x16, 0xf8, 0x80, 0xb7, 0x91, 0xb4, 0x1f, 0xb4, 0x7c, 0x3f,
-	0xc4, 0x20, 0x45, 0x1e, 0xca, 0xd8, 0x87, 0x15, 0x82, 0xf1, 0xb3, 0x79, 0x9a, 0x46, 0x8d, 0x31,
-	0xaa, 0x62, 0x7f, 0x00, 0xeb, 0x63, 0xef, 0x84, 0xc1, 0x5a, 0xdc, 0x17, 0xa7, 0x08, 0xd1, 0xe5,
-	0xec, 0xca, 0x93, 0x65, 0x44, 0xdb, 0xb0, 0x9a, 0x96, 0xb5, 0xc8, 0x93, 0x3f, 0xbd, 0x6d, 0xfe,
-	0x5a, 0x83, 0x8d, 0x4f, 0x6c, 0xda, 0x3b, 0x6d, 0xb9, 0xc2, 0x63, 0x97, 0x88, 0xb7, 0xf7, 0xa1,
-	0x72, 0x26, 0xbc, 0x23, 0x8b, 0xca, 0xad, 0x1c, 0xe3, 0xe3, 0xf7, 0x60, 0x45, 0x1c, 0x0c, 0xa6,
-	0x5e, 0xe7, 0xc8, 0x5e, 0x5a, 0xf7, 0xfa, 0x23, 0x7f, 0x16, 0xba, 0x3f, 0x07, 0x10, 0xc6, 0x1d,
-	0x92, 0xc1, 0x1c, 0x76, 0xbd, 0x07, 0xcb, 0x42, 0x9a, 0x08, 0xee, 0x59, 0x97, 0x2b, 0xc9, 0xcd,
-	0x8f, 0xa0, 0xd6, 0x6a, 0x1d, 0x70, 0xf7, 0x1c, 0x62, 0x6a, 0x17, 0x8a, 0xdf, 0xdb, 0x50, 0xeb,
-	0xf2, 0x9e, 0xd0, 0x89, 0xea, 0x7c, 0xc5, 0xaa, 0x76, 0xa3, 0x3e, 0x61, 0xfe, 0x57, 0x83, 0x7a,
-	0x54, 0x05, 0x79, 0x66, 0xd4, 0xa1, 0xa4, 0xe4, 0x95, 0xda, 0x2d, 0xf4, 0x3e, 0x2c, 0x85, 0x4f,
-	0x3f, 0x61, 0xf2, 0x5b, 0x49, 0x93, 0xc5, 0xb3, 0x30, 0x56, 0x4a, 0xf9, 0x86, 0x25, 0x98, 0x98,
-	0x4b, 0x55, 0xe5, 0x08, 0x5f, 0x09, 0xba, 0x15, 0xdb, 0x41, 0x6d, 0x58, 0x4d, 0x02, 0x2f, 0x19,
-	0xf7, 0x5b, 0x93, 0x2a, 0x46, 0xcb, 0xa6, 0x36, 0x2f, 0x18, 0xf5, 0x04, 0xee, 0x22, 0xe6, 0xff,
-	0x74, 0xa8, 0xc6, 0x9c, 0x97, 0x39, 0x49, 0xda, 0x67, 0xa5, 0xd9, 0xb5, 0x4f, 0xcf, 0xa2, 0xff,
-	0xb7, 0xa0, 0xee, 0xf0, 0x7e, 0xdb, 0x11, 0x91, 0xcb, 0x0b, 0x64, 0xc5, 0x5a, 0x09, 0x77, 0x45,
-	0x1a, 0xa1, 0x4d, 0xa8, 0x7a, 0x63, 0xb7, 0xe3, 0x9f, 0x74, 0x02, 0xff, 0x39, 0x11, 0xcf, 0x88,
-	0x8a, 0x37, 0x76, 0x7f, 0x7a, 0x62, 0xf9, 0xcf, 0x49, 0x84, 0x54, 0x97, 0x2e, 0x88, 0x54, 0x37,
-	0xa1, 0xea, 0xda, 0xe7, 0x4c, 0x6a, 0xc7, 0x1b, 0xbb, 0xfc, 0x85, 0xa1, 0x5b, 0x15, 0xd7, 0x3e,
-	0xb7, 0xfc, 0xe7, 0x4f, 0xc6, 0x2e, 0xda, 0x86, 0xb5, 0xa1, 0x4d, 0x68, 0x27, 0xfe, 0x44, 0x29,
-	0xf3, 0x27, 0x4a, 0x9d, 0xed, 0x7f, 0x18, 0x3d, 0x53, 0xb2, 0x98, 0xb7, 0x72, 0x09, 0xcc, 0xdb,
-	0x77, 0x87, 0x91, 0x20, 0x28, 0x8e, 0x79, 0xfb, 0xee, 0x50, 0x89, 0x79, 0x0f, 0x96, 0xc3, 0xe8,
-	0x24, 0x8d, 0xea, 0xc4, 0xe2, 0xf7, 0x98, 0x01, 0x98, 0x10, 0xec, 0x58, 0x92, 0xdc, 0xfc, 0x1c,
-	0xae, 0x47, 0xce, 0x8a, 0x19, 0x96, 0x3d, 0xa3, 0x36, 0xef, 0x19, 0xa7, 0x43, 0xb1, 0x5f, 0xe9,
-	0xb0, 0x71, 0x6c, 0x9f, 0xe1, 0x57, 0x8f, 0xfa, 0x0a, 0x55, 0xb2, 0x03, 0x58, 0xe7, 0x40, 0xaf,
-	0x19, 0xb3, 0x67, 0x4a, 0x43, 0x89, 0xfb, 0x34, 0xcb, 0x88, 0x7e, 0xcc, 0x3a, 0x21, 0xee, 0x3d,
-	0x3b, 0xf2, 0x1d, 0xd9, 0x4c, 0xaa, 0xcd, 0x37, 0x73, 0xe4, 0xec, 0x29, 0x2a, 0x2b, 0xce, 0x81,
-	0x8e, 0xb2, 0x59, 0xbe, 0xc4, 0x85, 0xdc, 0x9d, 0xfa, 0x9c, 0x88, 0xbc, 0x9f, 0x4e, 0x76, 0xd4,
-	0x80, 0x65, 0xd1, 0xcc, 0x78, 0x0a, 0x94, 0x2d, 0xb9, 0x64, 0x48, 0x13, 0x22, 0x3b, 0x66, 0x3c,
-	0x18, 0x7f, 0x04, 0x65, 0x15, 0x19, 0xa5, 0xc2, 0x91, 0xa1, 0x78, 0xd2, 0x69, 0xae, 0xa7, 0xd2,
-	0xdc, 0x7c, 0xa9, 0xc1, 0x0a, 0x2b, 0x58, 0x4f, 0xfc, 0x3e, 0x7e, 0x3a, 0x67, 0xd7, 0x28, 0x30,
-	0xee, 0xb8, 0x09, 0x15, 0x96, 0xe8, 0x84, 0xda, 0xee, 0x88, 0x1b, 0xb1, 0x60, 0x45, 0x1b, 0xec,
-	0x6d, 0xb4, 0x22, 0xea, 0xd2, 0xb1, 0x1a, 0x7f, 0x71, 0x51, 0x1a, 0x17, 0xc5, 0x7f, 0xa3, 0x1f,
-	0x24, 0xdf, 0xce, 0xdf, 0xca, 0xbd, 0x5e, 0x2e, 0x84, 0x23, 0x86, 0x44, 0x51, 0x2a, 0x02, 0xba,
-	0xbf, 0xd0, 0xa0, 0x26, 0x5d, 0xc1, 0xeb, 0x73, 0x03, 0x96, 0xed, 0x7e, 0x3f, 0xc0, 0x84, 0x08,
-	0x3b, 0xe4, 0x92, 0x7d, 0x39, 0xc3, 0x01, 0x91, 0x97, 0xa2, 0x5b, 0x72, 0x89, 0x7e, 0x08, 0x65,
-	0x05, 0x31, 0xf4, 0xbc, 0x3e, 0x11, 0xb7, 0x53, 0x80, 0x44, 0xc5, 0x61, 0xfe, 0x49, 0x83, 0xba,
-	0x88, 0xae, 0x30, 0xbc, 0xc9, 0x8c, 0xf0, 0x78, 0x04, 0xb5, 0x93, 0x28, 0x35, 0xa6, 0x3d, 0x06,
-	0xe3, 0x19, 0x94, 0xe0, 0x99, 0x19, 0x22, 0x1f, 0x40, 0x35, 0xc6, 0xcc, 0x03, 0x3b, 0x7c, 0xa2,
-	0x09, 0x73, 0xe4, 0x92, 0x7d, 0xe9, 0xc6, 0xec, 0xa8, 0x44, 0xd5, 0xef, 0x3f, 0x1a, 0x9f, 0xcb,
-	0x58, 0xb8, 0xe7, 0x9f, 0xe1, 0xe0, 0xc5, 0xe5, 0x5f, 0xbf, 0x0f, 0x63, 0x6e, 0x2e, 0x88, 0xe4,
-	0x14, 0x03, 0x7a, 0x18, 0xd9, 0xa9, 0xe7, 0x81, 0xff, 0x78, 0x92, 0x0b, 0x27, 0x45, 0x47, 0xf9,
-	0x43, 0xf8, 0x8e, 0x4f, 0x1e, 0x65, 0xde, 0x3a, 0xfa, 0x95, 0x74, 0x7c, 0xf3, 0xcf, 0x1a, 0x7c,
-	0x63, 0x1f, 0xd3, 0xc7, 0x49, 0xec, 0x7c, 0xd5, 0x56, 0xb9, 0x60, 0xe4, 0x19, 0x75, 0x99, 0x5b,
-	0x37, 0xa0, 0x4c, 0xe4, 0x83, 0x21, 0x9c, 0xb0, 0xa8, 0xb5, 0xf9, 0xa5, 0x06, 0x0d, 0xa1, 0x85,
-	0xeb, 0xdc, 0xf3, 0xdd, 0xd1, 0x10, 0x53, 0xdc, 0x7f, 0xdd, 0x48, 0xf8, 0x6f, 0x1a, 0xac, 0xc5,
-	0xeb, 0x10, 0x2f, 0x25, 0xdf, 0x83, 0x45, 0xfe, 0x90, 0x10, 0x16, 0xcc, 0x0c, 0xd6, 0x90, 0x9a,
-	0x65, 0x14, 0x6f, 0x2b, 0x4f, 0x89, 0xac, 0x33, 0x62, 0x19, 0x15, 0x43, 0xfd, 0xc2, 0xc5, 0xd0,
-	0x3c, 0x86, 0x0d, 0xe9, 0xa9, 0x28, 0xaf, 0x39, 0x6a, 0x9f, 0x9c, 0xdb, 0xb7, 0xa0, 0x1a, 0xc3,
-	0xea, 0xa2, 0xc4, 0x43, 0x04, 0xd5, 0xef, 0xdd, 0x87, 0xf5, 0x8c, 0x42, 0x54, 0x07, 0xf8, 0xc8,
-	0xeb, 0x89, 0x9b, 0x58, 0xbb, 0x86, 0x6a, 0x50, 0x96, 0xf7, 0xb2, 0xa6, 0x35, 0x5f, 0xae, 0x40,
-	0x85, 0x15, 0xdc, 0x3d, 0xdf, 0x0f, 0xfa, 0x68, 0x04, 0x88, 0x8f, 0x3c, 0xdc, 0x91, 0xef, 0xa9,
-	0xd9, 0x20, 0x7a, 0x77, 0x42, 0xb7, 0xcb, 0x92, 0x8a, 0x78, 0x37, 0xee, 0x4c, 0xe0, 0x48, 0x91,
-	0x9b, 0xd7, 0x90, 0xcb, 0x35, 0x32, 0xa8, 0xf9, 0xd4, 0xe9, 0x3d, 0x93, 0xc0, 0x78, 0x8a, 0xc6,
-	0x14, 0xa9, 0xd4, 0x98, 0x1a, 0x39, 0x8a, 0x45, 0x38, 0x97, 0x92, 0x01, 0x6f, 0x5e, 0x43, 0x9f,
-	0xc1, 0xf5, 0x7d, 0x4c, 0xa3, 0x51, 0x84, 0x54, 0xd8, 0x9c, 0xac, 0x30, 0x43, 0x7c, 0x41, 0x95,
-	0x07, 0xb0, 0xc8, 0x93, 0x01, 0xe5, 0x05, 0x5c, 0xfc, 0x0f, 0x64, 0xc6, 0xd6, 0x64, 0x02, 0x25,
-	0xed, 0x17, 0xb0, 0x9a, 0xfa, 0x03, 0x00, 0x7a, 0x3b, 0x87, 0x2d, 0xff, 0x4f, 0x39, 0xc6, 0xbd,
-	0x22, 0xa4, 0x4a, 0xd7, 0x00, 0xea, 0xc9, 0x81, 0x09, 0xda, 0xce, 0xe1, 0xcf, 0x1d, 0xde, 0x1a,
-	0x6f, 0x17, 0xa0, 0x54, 0x8a, 0x5c, 0x58, 0x4b, 0x0f, 0xa4, 0xd1, 0xbd, 0xa9, 0x02, 0x92, 0xe1,
-	0xf6, 0xed, 0x42, 0xb4, 0x4a, 0xdd, 0x0b, 0x1e, 0x04, 0x99, 0x81, 0x28, 0xda, 0xc9, 0x17, 0x33,
-	0x69, 0x52, 0x6b, 0xec, 0x16, 0xa6, 0x57, 0xaa, 0x5f, 0x86, 0x4d, 0x38, 0x6f, 0xa8, 0x88, 0xee,
-	0xe7, 0x8b, 0x9b, 0x32, 0x0d, 0x35, 0x9a, 0x17, 0x61, 0x51, 0x46, 0x7c, 0xce, 0xbb, 0x67, 0xce,
-	0x60, 0x2e, 0x9d, 0x77, 0x52, 0xde, 0xe4, 0x89, 0xa3, 0x71, 0xff, 0x02, 0x1c, 0xca, 0x00, 0x3f,
-	0x3d, 0xf2, 0x97, 0x69, 0xb8, 0x3b, 0x33, 0x6a, 0xe6, 0xcb, 0xc1, 0x4f, 0x61, 0x35, 0xf5, 0xee,
-	0xca, 0xcd, 0x9a, 0xfc, 0xb7, 0x99, 0x31, 0xad, 0x2f, 0x86, 0x29, 0x99, 0x02, 0x23, 0x68, 0x42,
-	0xf4, 0xe7, 0x00, 0x16, 0xe3, 0x5e, 0x11, 0x52, 0x75, 0x10, 0xc2, 0xcb, 0x65, 0xaa, 0xa1, 0xa3,
-	0xef, 0xe4, 0xcb, 0xc8, 0x07, 0x23, 0xc6, 0x3b, 0x05, 0xa9, 0x95, 0xd2, 0x0e, 0xc0, 0x3e, 0xa6,
-	0x87, 0x98, 0x06, 0x2c, 0x46, 0xee, 0xe4, 0xba, 0x3c, 0x22, 0x90, 0x6a, 0xee, 0xce, 0xa4, 0x93,
-	0x0a, 0x9a, 0x5f, 0x2e, 0x40, 0x59, 0xa2, 0xfe, 0x2b, 0xe8, 0x41, 0x57, 0xd0, 0x14, 0x3e, 0x85,
-	0xd5, 0xd4, 0x48, 0x35, 0x37, 0x66, 0xf2, 0xc7, 0xae, 0xb3, 0x02, 0xf2, 0x13, 0xf1, 0xdf, 0x0f,
-	0x2a, 0x3e, 0xee, 0x4e, 0x6a, 0x2c, 0xe9, 0xd0, 0x98, 0x21, 0xf8, 0x55, 0x07, 0xc2, 0xa3, 0x07,
-	0x3f, 0xbf, 0x3f, 0x70, 0xe8, 0xe9, 0xb8, 0xcb, 0x54, 0xef, 0x86, 0x94, 0xef, 0x38, 0xbe, 0xf8,
-	0xb5, 0x2b, 0x6f, 0x60, 0x97, 0x4b, 0xda, 0x65, 0xe7, 0x18, 0x75, 0xbb, 0x4b, 0x7c, 0xf5, 0xe0,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x00, 0x21, 0x5a, 0xa8, 0xcf, 0x22, 0x00, 0x00,
+func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
+func (m *CompactionPlan) String() string { return proto.CompactTextString(m) }
+func (*CompactionPlan) ProtoMessage()    {}
+func (*CompactionPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{41}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *CompactionPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionPlan.Unmarshal(m, b)
+}
+func (m *CompactionPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionPlan.Marshal(b, m, deterministic)
+}
+func (m *CompactionPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionPlan.Merge(m, src)
+}
+func (m *CompactionPlan) XXX_Size() int {
+	return xxx_messageInfo_CompactionPlan.Size(m)
+}
+func (m *CompactionPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionPlan.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_CompactionPlan proto.InternalMessageInfo
 
-// DataCoordClient is the client API for DataCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DataCoordClient interface {
-	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
-	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
-	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+func (m *CompactionPlan) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
 }
 
-type dataCoordClient struct {
-	cc *grpc.ClientConn
+func (m *CompactionPlan) GetSegmentBinlogs() []*CompactionSegmentBinlogs {
+	if m != nil {
+		return m.SegmentBinlogs
+	}
+	return nil
 }
 
-func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
-	return &dataCoordClient{cc}
+func (m *CompactionPlan) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
 }
 
-func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
-	out := new(internalpb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CompactionPlan) GetTimeoutInSeconds() int32 {
+	if m != nil {
+		return m.TimeoutInSeconds
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CompactionPlan) GetType() CompactionType {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return CompactionType_UndefinedCompaction
 }
 
-func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CompactionPlan) GetTimetravel() uint64 {
+	if m != nil {
+		return m.Timetravel
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
-	out := new(FlushResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CompactionPlan) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
-	out := new(AssignSegmentIDResponse)
+func (m *CompactionPlan) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+type CompactionResult struct {
+	PlanID               int64           `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID            int64           `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64           `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*FieldBinlog  `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Deltalogs            []*DeltaLogInfo `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
+func (m *CompactionResult) String() string { return proto.CompactTextString(m) }
+func (*CompactionResult) ProtoMessage()    {}
+func (*CompactionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{42}
+}
+
+func (m *CompactionResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionResult.Unmarshal(m, b)
+}
+func (m *CompactionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionResult.Marshal(b, m, deterministic)
+}
+func (m *CompactionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionResult.Merge(m, src)
+}
+func (m *CompactionResult) XXX_Size() int {
+	return xxx_messageInfo_CompactionResult.Size(m)
+}
+func (m *CompactionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionResult proto.InternalMessageInfo
+
+func (m *CompactionResult) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+func (m *CompactionResult) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *CompactionResult) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *CompactionResult) GetInsertLogs() []*FieldBinlog {
+	if m != nil {
+		return m.InsertLogs
+	}
+	return nil
+}
+
+func (m *CompactionResult) GetDeltalogs() []*DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+type ImportTask struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	ChannelNames         []string          `protobuf:"bytes,4,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	Files                []string          `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportTask) Reset()         { *m = ImportTask{} }
+func (m *ImportTask) String() string { return proto.CompactTextString(m) }
+func (*ImportTask) ProtoMessage()    {}
+func (*ImportTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{43}
+}
+
+func (m *ImportTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTask.Unmarshal(m, b)
+}
+func (m *ImportTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTask.Marshal(b, m, deterministic)
+}
+func (m *ImportTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTask.Merge(m, src)
+}
+func (m *ImportTask) XXX_Size() int {
+	return xxx_messageInfo_ImportTask.Size(m)
+}
+func (m *ImportTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTask proto.InternalMessageInfo
+
+func (m *ImportTask) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ImportTask) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ImportTask) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *ImportTask) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+func (m *ImportTask) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+type ImportTaskResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskID               int64            `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ImportTaskResponse) Reset()         { *m = ImportTaskResponse{} }
+func (m *ImportTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportTaskResponse) ProtoMessage()    {}
+func (*ImportTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{44}
+}
+
+func (m *ImportTaskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTaskResponse.Unmarshal(m, b)
+}
+func (m *ImportTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTaskResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTaskResponse.Merge(m, src)
+}
+func (m *ImportTaskResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportTaskResponse.Size(m)
+}
+func (m *ImportTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTaskResponse proto.InternalMessageInfo
+
+func (m *ImportTaskResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ImportTaskResponse) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+type ImportTaskInfo struct {
+	TaskID               int64                `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	CollectionID         int64                `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	ChannelNames         []string             `protobuf:"bytes,4,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	Files                []string             `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	Timestamp            uint64               `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	DatanodeID           int64                `protobuf:"varint,7,opt,name=datanodeID,proto3" json:"datanodeID,omitempty"`
+	State                commonpb.ImportState `protobuf:"varint,8,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	RowCount             int64                `protobuf:"varint,9,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	SegmentIDs           []int64              `protobuf:"varint,10,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	FailedReason         string               `protobuf:"bytes,11,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ImportTaskInfo) Reset()         { *m = ImportTaskInfo{} }
+func (m *ImportTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ImportTaskInfo) ProtoMessage()    {}
+func (*ImportTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{45}
+}
+
+func (m *ImportTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTaskInfo.Unmarshal(m, b)
+}
+func (m *ImportTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *ImportTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTaskInfo.Merge(m, src)
+}
+func (m *ImportTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ImportTaskInfo.Size(m)
+}
+func (m *ImportTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTaskInfo proto.InternalMessageInfo
+
+func (m *ImportTaskInfo) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+func (m *ImportTaskInfo) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ImportTaskInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetDatanodeID() int64 {
+	if m != nil {
+		return m.DatanodeID
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ImportTaskInfo) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *ImportTaskInfo) GetFailedReason() string {
+	if m != nil {
+		return m.FailedReason
+	}
+	return ""
+}
+
+type ImportResult struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskID               int64                `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	State                commonpb.ImportState `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	RowCount             int64                `protobuf:"varint,4,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	SegmentIDs           []int64              `protobuf:"varint,5,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ImportResult) Reset()         { *m = ImportResult{} }
+func (m *ImportResult) String() string { return proto.CompactTextString(m) }
+func (*ImportResult) ProtoMessage()    {}
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{46}
+}
+
+func (m *ImportResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResult.Unmarshal(m, b)
+}
+func (m *ImportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResult.Marshal(b, m, deterministic)
+}
+func (m *ImportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResult.Merge(m, src)
+}
+func (m *ImportResult) XXX_Size() int {
+	return xxx_messageInfo_ImportResult.Size(m)
+}
+func (m *ImportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResult proto.InternalMessageInfo
+
+func (m *ImportResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ImportResult) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ImportResult) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ImportResult) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ImportResult) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+// Deprecated
+type SegmentFieldBinlogMeta struct {
+	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	BinlogPath           string   `protobuf:"bytes,2,opt,name=binlog_path,json=binlogPath,proto3" json:"binlog_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{} }
+func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
+func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
+func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{47}
+}
+
+func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentFieldBinlogMeta.Unmarshal(m, b)
+}
+func (m *SegmentFieldBinlogMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentFieldBinlogMeta.Marshal(b, m, deterministic)
+}
+func (m *SegmentFieldBinlogMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentFieldBinlogMeta.Merge(m, src)
+}
+func (m *SegmentFieldBinlogMeta) XXX_Size() int {
+	return xxx_messageInfo_SegmentFieldBinlogMeta.Size(m)
+}
+func (m *SegmentFieldBinlogMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentFieldBinlogMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentFieldBinlogMeta proto.InternalMessageInfo
+
+func (m *SegmentFieldBinlogMeta) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *SegmentFieldBinlogMeta) GetBinlogPath() string {
+	if m != nil {
+		return m.BinlogPath
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
+	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
+	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
+	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
+	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
+	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
+	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
+	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
+	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
+	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
+	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
+	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
+	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
+	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
+	proto.RegisterType((*DDLFlushMeta)(nil), "milvus.proto.data.DDLFlushMeta")
+	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
+	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
+	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
+	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
+	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
+	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
+	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
+	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
+	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
+	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
+	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
+	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
+	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*ImportTask)(nil), "milvus.proto.data.ImportTask")
+	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
+	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
+	proto.RegisterType((*ImportResult)(nil), "milvus.proto.data.ImportResult")
+	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+}
+
+func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+
+var fileDescriptor_82cd95f524594f49 = []byte{
+	// 2737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcf, 0x6f, 0x24, 0x47,
+	0xf5, 0xdf, 0x9e, 0x1f, 0xf6, 0xcc, 0x9b, 0xf1, 0x78, 0x5c, 0xbb, 0x5f, 0x67, 0xbe, 0xb3, 0x1b,
+	0xaf, 0xdd, 0x49, 0x36, 0xce, 0x26, 0xb1, 0x77, 0xbd, 0x04, 0x22, 0x96, 0x10, 0xc5, 0xf6, 0xae,
+	0x35, 0x60, 0x2f, 0xa6, 0xed, 0x4d, 0x10, 0x39, 0x8c, 0xda, 0xd3, 0xe5, 0x71, 0xe3, 0xfe, 0x31,
+	0xdb, 0xd5, 0xe3, 0x5d, 0xe7, 0x92, 0x10, 0x24, 0x24, 0x10, 0x02, 0x24, 0xc4, 0x0d, 0x09, 0x94,
+	0x13, 0x12, 0x42, 0xe2, 0xc2, 0x89, 0x03, 0x37, 0x84, 0xe0, 0x02, 0x27, 0xfe, 0x05, 0xfe, 0x0c,
+	0x54, 0x3f, 0xba, 0xfa, 0xe7, 0xcc, 0xb4, 0xed, 0xec, 0xfa, 0x36, 0x55, 0xfd, 0xea, 0xbd, 0x57,
+	0xaf, 0xde, 0xfb, 0xbc, 0xf7, 0xaa, 0x06, 0x9a, 0x86, 0xee, 0xeb, 0xdd, 0x9e, 0xeb, 0x7a, 0xc6,
+	0xca, 0xc0, 0x73, 0x7d, 0x17, 0xcd, 0xd9, 0xa6, 0x75, 0x32, 0x24, 0x7c, 0xb4, 0x42, 0x3f, 0xb7,
+	0xeb, 0x3d, 0xd7, 0xb6, 0x5d, 0x87, 0x4f, 0xb5, 0x1b, 0xa6, 0xe3, 0x63, 0xcf, 0xd1, 0x2d, 0x31,
+	0xae, 0x47, 0x17, 0xb4, 0xeb, 0xa4, 0x77, 0x84, 0x6d, 0x9d, 0x8f, 0xd4, 0x67, 0x50, 0x7f, 0x68,
+	0x0d, 0xc9, 0x91, 0x86, 0x9f, 0x0c, 0x31, 0xf1, 0xd1, 0x1d, 0x28, 0x1d, 0xe8, 0x04, 0xb7, 0x94,
+	0x45, 0x65, 0xb9, 0xb6, 0x76, 0x63, 0x25, 0x26, 0x4b, 0x48, 0xd9, 0x21, 0xfd, 0x75, 0x9d, 0x60,
+	0x8d, 0x51, 0x22, 0x04, 0x25, 0xe3, 0xa0, 0xb3, 0xd9, 0x2a, 0x2c, 0x2a, 0xcb, 0x45, 0x8d, 0xfd,
+	0x46, 0x2a, 0xd4, 0x7b, 0xae, 0x65, 0xe1, 0x9e, 0x6f, 0xba, 0x4e, 0x67, 0xb3, 0x55, 0x62, 0xdf,
+	0x62, 0x73, 0xea, 0x6f, 0x14, 0x98, 0x11, 0xa2, 0xc9, 0xc0, 0x75, 0x08, 0x46, 0xf7, 0x60, 0x8a,
+	0xf8, 0xba, 0x3f, 0x24, 0x42, 0xfa, 0xf5, 0x4c, 0xe9, 0x7b, 0x8c, 0x44, 0x13, 0xa4, 0xb9, 0xc4,
+	0x17, 0xd3, 0xe2, 0xd1, 0x02, 0x00, 0xc1, 0x7d, 0x1b, 0x3b, 0x7e, 0x67, 0x93, 0xb4, 0x4a, 0x8b,
+	0xc5, 0xe5, 0xa2, 0x16, 0x99, 0x51, 0xff, 0xa8, 0x40, 0x73, 0x2f, 0x18, 0x06, 0xd6, 0xb9, 0x06,
+	0xe5, 0x9e, 0x3b, 0x74, 0x7c, 0xa6, 0xe0, 0x8c, 0xc6, 0x07, 0x68, 0x09, 0xea, 0xbd, 0x23, 0xdd,
+	0x71, 0xb0, 0xd5, 0x75, 0x74, 0x1b, 0x33, 0x55, 0xaa, 0x5a, 0x4d, 0xcc, 0x3d, 0xd2, 0x6d, 0x9c,
+	0x4b, 0xa3, 0x45, 0xa8, 0x0d, 0x74, 0xcf, 0x37, 0x63, 0x36, 0x8b, 0x4e, 0xa1, 0x36, 0x54, 0x4c,
+	0xd2, 0xb1, 0x07, 0xae, 0xe7, 0xb7, 0xca, 0x8b, 0xca, 0x72, 0x45, 0x93, 0x63, 0xf5, 0x77, 0x0a,
+	0xcc, 0x7f, 0x40, 0x88, 0xd9, 0x77, 0x52, 0x5a, 0xcf, 0xc3, 0x94, 0xe3, 0x1a, 0xb8, 0xb3, 0xc9,
+	0xd4, 0x2e, 0x6a, 0x62, 0x84, 0xae, 0x43, 0x75, 0x80, 0xb1, 0xd7, 0xf5, 0x5c, 0x2b, 0x50, 0xba,
+	0x42, 0x27, 0x34, 0xd7, 0xc2, 0xe8, 0xbb, 0x30, 0x47, 0x12, 0x8c, 0x48, 0xab, 0xb8, 0x58, 0x5c,
+	0xae, 0xad, 0xbd, 0xb2, 0x92, 0xf2, 0xc0, 0x95, 0xa4, 0x50, 0x2d, 0xbd, 0x5a, 0xfd, 0xac, 0x00,
+	0x57, 0x25, 0x1d, 0xd7, 0x95, 0xfe, 0xa6, 0x56, 0x25, 0xb8, 0x2f, 0xd5, 0xe3, 0x83, 0x3c, 0x56,
+	0x95, 0xc7, 0x51, 0x8c, 0x1e, 0x47, 0x0e, 0xe7, 0x4b, 0xda, 0xba, 0x9c, 0xb6, 0xf5, 0x4d, 0xa8,
+	0xe1, 0x67, 0x03, 0xd3, 0xc3, 0x5d, 0xdf, 0xb4, 0x71, 0x6b, 0x6a, 0x51, 0x59, 0x2e, 0x69, 0xc0,
+	0xa7, 0xf6, 0x4d, 0x3b, 0xea, 0xad, 0xd3, 0xb9, 0xbd, 0x55, 0xfd, 0x42, 0x81, 0x97, 0x52, 0xa7,
+	0x24, 0xdc, 0x5f, 0x83, 0x26, 0xdb, 0x79, 0x68, 0x19, 0x1a, 0x08, 0xd4, 0xe0, 0xb7, 0xc6, 0x19,
+	0x3c, 0x24, 0xd7, 0x52, 0xeb, 0x23, 0x4a, 0x16, 0xf2, 0x2b, 0x79, 0x0c, 0x2f, 0x6d, 0x61, 0x5f,
+	0x08, 0xa0, 0xdf, 0x30, 0x39, 0x3f, 0x3c, 0xc4, 0xe3, 0xac, 0x90, 0x8a, 0xb3, 0x3f, 0x15, 0xa0,
+	0x19, 0x15, 0xd5, 0x71, 0x0e, 0x5d, 0x74, 0x03, 0xaa, 0x92, 0x44, 0x78, 0x45, 0x38, 0x81, 0xbe,
+	0x06, 0x65, 0xaa, 0x29, 0x77, 0x89, 0xc6, 0xda, 0x52, 0xf6, 0x9e, 0x22, 0x3c, 0x35, 0x4e, 0x8f,
+	0x3a, 0xd0, 0x20, 0xbe, 0xee, 0xf9, 0xdd, 0x81, 0x4b, 0xd8, 0x39, 0x33, 0xc7, 0xa9, 0xad, 0xa9,
+	0x71, 0x0e, 0x12, 0x3e, 0x77, 0x48, 0x7f, 0x57, 0x50, 0x6a, 0x33, 0x6c, 0x65, 0x30, 0x44, 0x0f,
+	0xa0, 0x8e, 0x1d, 0x23, 0x64, 0x54, 0xca, 0xcd, 0xa8, 0x86, 0x1d, 0x43, 0xb2, 0x09, 0xcf, 0xa7,
+	0x9c, 0xff, 0x7c, 0x7e, 0xa6, 0x40, 0x2b, 0x7d, 0x40, 0x17, 0x01, 0xd1, 0xfb, 0x7c, 0x11, 0xe6,
+	0x07, 0x34, 0x36, 0xc2, 0xe5, 0x21, 0x69, 0x62, 0x89, 0x6a, 0xc2, 0xff, 0x85, 0xda, 0xb0, 0x2f,
+	0xcf, 0xcd, 0x59, 0x7e, 0xa4, 0xc0, 0x7c, 0x52, 0xd6, 0x45, 0xf6, 0xfd, 0x15, 0x28, 0x9b, 0xce,
+	0xa1, 0x1b, 0x6c, 0x7b, 0x61, 0x4c, 0x9c, 0x51, 0x59, 0x9c, 0x58, 0xb5, 0xe1, 0xfa, 0x16, 0xf6,
+	0x3b, 0x0e, 0xc1, 0x9e, 0xbf, 0x6e, 0x3a, 0x96, 0xdb, 0xdf, 0xd5, 0xfd, 0xa3, 0x0b, 0xc4, 0x48,
+	0xcc, 0xdd, 0x0b, 0x09, 0x77, 0x57, 0x7f, 0xaf, 0xc0, 0x8d, 0x6c, 0x79, 0x62, 0xeb, 0x6d, 0xa8,
+	0x1c, 0x9a, 0xd8, 0x32, 0x3a, 0x9b, 0x1c, 0x30, 0x8a, 0x9a, 0x1c, 0xd3, 0x58, 0x19, 0x50, 0x62,
+	0xb1, 0xc3, 0xa5, 0x11, 0x0e, 0xba, 0xe7, 0x7b, 0xa6, 0xd3, 0xdf, 0x36, 0x89, 0xaf, 0x71, 0xfa,
+	0x88, 0x3d, 0x8b, 0xf9, 0x3d, 0xf3, 0xa7, 0x0a, 0x2c, 0x6c, 0x61, 0x7f, 0x43, 0x42, 0x2d, 0xfd,
+	0x6e, 0x12, 0xdf, 0xec, 0x91, 0xe7, 0x5b, 0x60, 0x64, 0xe4, 0x53, 0xf5, 0x17, 0x0a, 0xdc, 0x1c,
+	0xa9, 0x8c, 0x30, 0x9d, 0x80, 0x92, 0x00, 0x68, 0xb3, 0xa1, 0xe4, 0xdb, 0xf8, 0xf4, 0x43, 0xdd,
+	0x1a, 0xe2, 0x5d, 0xdd, 0xf4, 0x38, 0x94, 0x9c, 0x13, 0x58, 0xff, 0xa0, 0xc0, 0xcb, 0x5b, 0xd8,
+	0xdf, 0x0d, 0xd2, 0xcc, 0x25, 0x5a, 0x67, 0x72, 0xb5, 0xa1, 0xfe, 0x9c, 0x1f, 0x66, 0xa6, 0xb6,
+	0x97, 0x62, 0xbe, 0x05, 0x16, 0x07, 0x91, 0x80, 0xdc, 0xe0, 0xb5, 0x80, 0x30, 0x9e, 0xfa, 0xeb,
+	0x02, 0xd4, 0x3f, 0x14, 0xf5, 0x01, 0xfd, 0x9c, 0xb2, 0x83, 0x92, 0x6d, 0x87, 0x48, 0x49, 0x91,
+	0x55, 0x65, 0x6c, 0xc1, 0x0c, 0xc1, 0xf8, 0xf8, 0x3c, 0x49, 0xa3, 0x4e, 0x17, 0x06, 0x23, 0xb4,
+	0x0d, 0x73, 0x43, 0xe7, 0x90, 0x96, 0xbc, 0xd8, 0x10, 0xbb, 0xe0, 0x95, 0xe7, 0x64, 0xe4, 0x49,
+	0x2f, 0x44, 0xcb, 0x30, 0x9b, 0xe4, 0x55, 0x66, 0xc1, 0x9f, 0x9c, 0x56, 0x7f, 0xa2, 0xc0, 0xfc,
+	0x47, 0xba, 0xdf, 0x3b, 0xda, 0xb4, 0x85, 0xc5, 0x2e, 0xe0, 0x6f, 0xef, 0x41, 0xf5, 0x44, 0x58,
+	0x27, 0x00, 0x95, 0x9b, 0x19, 0xca, 0x47, 0xcf, 0x41, 0x0b, 0x57, 0xd0, 0x32, 0xf5, 0x1a, 0xab,
+	0xfa, 0x03, 0xed, 0x5e, 0xbc, 0xe7, 0x4f, 0xaa, 0xfc, 0x9f, 0x01, 0x08, 0xe5, 0x76, 0x48, 0xff,
+	0x1c, 0x7a, 0xbd, 0x0b, 0xd3, 0x82, 0x9b, 0x70, 0xee, 0x49, 0x87, 0x1b, 0x90, 0xab, 0x8f, 0xa1,
+	0xbe, 0xb9, 0xb9, 0xcd, 0xcc, 0xb3, 0x83, 0x7d, 0x3d, 0x97, 0xff, 0x2e, 0x41, 0xfd, 0x80, 0xe5,
+	0x84, 0x6e, 0x88, 0xf3, 0x55, 0xad, 0x76, 0x10, 0xe6, 0x09, 0xf5, 0x1f, 0x0a, 0x34, 0x42, 0x14,
+	0x64, 0x91, 0xd1, 0x80, 0x82, 0xe4, 0x57, 0xe8, 0x6c, 0xa2, 0xf7, 0x60, 0x8a, 0xb7, 0x85, 0x42,
+	0xe5, 0xd7, 0xe2, 0x2a, 0xf3, 0x6f, 0x2b, 0x11, 0x28, 0x65, 0x13, 0x9a, 0x58, 0x44, 0x4d, 0x2a,
+	0x91, 0x83, 0x77, 0x09, 0x45, 0x2d, 0x32, 0x83, 0x3a, 0x30, 0x1b, 0x2f, 0xbc, 0x02, 0xbf, 0x5f,
+	0x1c, 0x85, 0x18, 0x9b, 0xba, 0xaf, 0x33, 0xc0, 0x68, 0xc4, 0xea, 0x2e, 0xa2, 0xfe, 0xb9, 0x0c,
+	0xb5, 0x88, 0xf1, 0x52, 0x3b, 0x49, 0xda, 0xac, 0x30, 0x19, 0xfb, 0x8a, 0xe9, 0xea, 0xff, 0x35,
+	0x68, 0x98, 0x2c, 0xdf, 0x76, 0x85, 0xe7, 0x32, 0x80, 0xac, 0x6a, 0x33, 0x7c, 0x56, 0x84, 0x11,
+	0x5a, 0x80, 0x9a, 0x33, 0xb4, 0xbb, 0xee, 0x61, 0xd7, 0x73, 0x9f, 0x12, 0xd1, 0x46, 0x54, 0x9d,
+	0xa1, 0xfd, 0x9d, 0x43, 0xcd, 0x7d, 0x4a, 0xc2, 0x4a, 0x75, 0xea, 0x8c, 0x95, 0xea, 0x02, 0xd4,
+	0x6c, 0xfd, 0x19, 0xe5, 0xda, 0x75, 0x86, 0x36, 0xeb, 0x30, 0x8a, 0x5a, 0xd5, 0xd6, 0x9f, 0x69,
+	0xee, 0xd3, 0x47, 0x43, 0x1b, 0x2d, 0x43, 0xd3, 0xd2, 0x89, 0xdf, 0x8d, 0xb6, 0x28, 0x15, 0xd6,
+	0xa2, 0x34, 0xe8, 0xfc, 0x83, 0xb0, 0x4d, 0x49, 0xd7, 0xbc, 0xd5, 0x0b, 0xd4, 0xbc, 0x86, 0x6d,
+	0x85, 0x8c, 0x20, 0x7f, 0xcd, 0x6b, 0xd8, 0x96, 0x64, 0xf3, 0x2e, 0x4c, 0x73, 0xef, 0x24, 0xad,
+	0xda, 0x48, 0xf0, 0x7b, 0x48, 0x0b, 0x18, 0x5e, 0xec, 0x68, 0x01, 0x39, 0xc5, 0x1e, 0x03, 0x5b,
+	0xbe, 0xce, 0xd6, 0xd6, 0x47, 0x62, 0xcf, 0x26, 0xa5, 0xd9, 0x76, 0xfb, 0x1c, 0x7b, 0xe4, 0x0a,
+	0x74, 0x07, 0xae, 0xf6, 0x3c, 0xac, 0xfb, 0xd8, 0x58, 0x3f, 0xdd, 0x70, 0xed, 0x81, 0xce, 0xfc,
+	0xa1, 0x35, 0xc3, 0x3a, 0xe9, 0xac, 0x4f, 0xe8, 0x16, 0x34, 0x7a, 0x72, 0xf4, 0xd0, 0x73, 0xed,
+	0x56, 0x83, 0xf9, 0x76, 0x62, 0x96, 0x06, 0xa1, 0x49, 0xba, 0x26, 0xeb, 0xc4, 0x4d, 0xa7, 0xdf,
+	0x9a, 0x65, 0x2c, 0x6b, 0x41, 0x73, 0x6e, 0x3a, 0x7d, 0xf5, 0x53, 0xb8, 0x16, 0x1e, 0x74, 0xc4,
+	0xa8, 0xe9, 0xf3, 0x51, 0xce, 0x7b, 0x3e, 0xe3, 0xcb, 0xc8, 0xbf, 0x15, 0x61, 0x7e, 0x4f, 0x3f,
+	0xc1, 0xcf, 0xbf, 0x62, 0xcd, 0x85, 0xc2, 0xdb, 0x30, 0xc7, 0x8a, 0xd4, 0xb5, 0x88, 0x3e, 0xad,
+	0x52, 0x2e, 0x7f, 0x48, 0x2f, 0x44, 0xef, 0xd3, 0x2c, 0x8e, 0x7b, 0xc7, 0xbb, 0xae, 0x19, 0x24,
+	0xc2, 0xda, 0xda, 0xcb, 0x19, 0x7c, 0x36, 0x24, 0x95, 0x16, 0x5d, 0x81, 0x76, 0xd3, 0x08, 0x35,
+	0xc5, 0x98, 0xbc, 0x3e, 0xb6, 0x15, 0x0a, 0xad, 0x9f, 0x04, 0x2a, 0xd4, 0x82, 0x69, 0x91, 0x88,
+	0x59, 0xf8, 0x56, 0xb4, 0x60, 0x18, 0x77, 0xe3, 0xca, 0x59, 0xdd, 0x98, 0x16, 0xd9, 0x10, 0x6e,
+	0x63, 0x42, 0xaf, 0xfc, 0x4d, 0xa8, 0x48, 0xc7, 0x2a, 0xe4, 0x76, 0x2c, 0xb9, 0x26, 0x89, 0x70,
+	0xc5, 0x04, 0xc2, 0xa9, 0x9f, 0x2b, 0x30, 0x43, 0xb1, 0xfa, 0x91, 0x6b, 0xe0, 0xfd, 0x73, 0x26,
+	0xcc, 0x1c, 0x37, 0x3d, 0x37, 0xa0, 0x4a, 0x31, 0x8e, 0xf8, 0xba, 0x3d, 0x60, 0x4a, 0x94, 0xb4,
+	0x70, 0x82, 0xb6, 0x85, 0x33, 0x02, 0x92, 0xf7, 0xe4, 0xad, 0x20, 0x63, 0xa5, 0x30, 0x56, 0xec,
+	0x37, 0xfa, 0x7a, 0xfc, 0xda, 0xe0, 0xd5, 0x4c, 0xef, 0x60, 0x4c, 0x58, 0xb1, 0x14, 0xc3, 0xe3,
+	0x3c, 0xfd, 0xc6, 0x67, 0x0a, 0xd4, 0x03, 0x53, 0xb0, 0xd4, 0xd4, 0x82, 0x69, 0xdd, 0x30, 0x3c,
+	0x4c, 0x88, 0xd0, 0x23, 0x18, 0xd2, 0x2f, 0x27, 0xd8, 0x23, 0xc1, 0xa1, 0x14, 0xb5, 0x60, 0x88,
+	0xbe, 0x01, 0x15, 0x59, 0x5d, 0x15, 0xb3, 0x52, 0x64, 0x54, 0x4f, 0x51, 0x1f, 0xcb, 0x15, 0xea,
+	0xbf, 0x15, 0x68, 0x08, 0xe7, 0x5c, 0x17, 0x98, 0x39, 0xde, 0x3d, 0xd6, 0xa1, 0x7e, 0x18, 0x46,
+	0xd6, 0xb8, 0x3e, 0x38, 0x1a, 0x80, 0xb1, 0x35, 0x93, 0x5c, 0x24, 0xee, 0xee, 0xa5, 0x33, 0xbb,
+	0xfb, 0x07, 0x50, 0x8b, 0xc8, 0x66, 0x61, 0xc5, 0x9b, 0x5b, 0xb1, 0x9b, 0x60, 0x48, 0xbf, 0x1c,
+	0x44, 0xb6, 0x51, 0x95, 0x79, 0x43, 0xfd, 0x27, 0x3d, 0x99, 0x08, 0x7b, 0x9a, 0xde, 0x3d, 0xdc,
+	0x73, 0x3d, 0xa3, 0x8b, 0x1d, 0xdf, 0x33, 0x31, 0x3f, 0xa0, 0x92, 0x36, 0xc3, 0x67, 0x1f, 0xf0,
+	0x49, 0x4a, 0x26, 0x9d, 0xac, 0x7b, 0x48, 0xe1, 0xbf, 0xc0, 0xc9, 0xe4, 0x6c, 0x80, 0xfe, 0x21,
+	0x99, 0xef, 0x0a, 0xff, 0xac, 0xc9, 0xb9, 0x7d, 0x17, 0xbd, 0x0a, 0x0d, 0xb6, 0xa3, 0x6e, 0x50,
+	0xa8, 0x89, 0x7a, 0xa2, 0x6e, 0x08, 0xb5, 0x28, 0x8c, 0xc5, 0xa9, 0x88, 0xf9, 0x09, 0x16, 0x15,
+	0x85, 0xa4, 0xda, 0x33, 0x3f, 0xc1, 0xea, 0xdf, 0x15, 0x76, 0x3f, 0xa7, 0xe1, 0x9e, 0x7b, 0x82,
+	0xbd, 0xd3, 0x8b, 0xdf, 0x82, 0xdc, 0x8f, 0xf8, 0x5c, 0xce, 0x8a, 0x5e, 0x2e, 0x40, 0xf7, 0x43,
+	0xab, 0x17, 0xb3, 0x9a, 0xc0, 0x28, 0x60, 0x0a, 0x8f, 0x09, 0x0f, 0xe6, 0x97, 0xfc, 0x3e, 0x27,
+	0xbe, 0x95, 0xf3, 0xe6, 0xa4, 0x2f, 0xa5, 0xf2, 0x53, 0x7f, 0xa5, 0xc0, 0xff, 0x6f, 0x61, 0xff,
+	0x61, 0xbc, 0x87, 0xba, 0x6c, 0xad, 0x6c, 0x68, 0x67, 0x29, 0x75, 0x91, 0x53, 0x6f, 0x43, 0x85,
+	0x04, 0x8d, 0x23, 0xbf, 0x69, 0x93, 0x63, 0xf5, 0xc7, 0x0a, 0xb4, 0x84, 0x14, 0x26, 0x93, 0x96,
+	0x44, 0x16, 0xf6, 0xb1, 0xf1, 0xa2, 0x3b, 0xa2, 0xdf, 0x2a, 0xd0, 0x8c, 0x82, 0x32, 0x8b, 0xde,
+	0x77, 0xa0, 0xcc, 0x1a, 0x4a, 0xa1, 0xc1, 0x44, 0x67, 0xe5, 0xd4, 0x14, 0x1f, 0x58, 0x8a, 0xde,
+	0x27, 0x01, 0xe8, 0x8a, 0x61, 0x98, 0x19, 0x8a, 0x67, 0xce, 0x0c, 0xea, 0x5f, 0x14, 0x68, 0x85,
+	0x15, 0xe3, 0x0b, 0x07, 0xdf, 0x18, 0xb8, 0x16, 0xcf, 0x0c, 0xae, 0x3f, 0x2c, 0x42, 0x23, 0xd4,
+	0x7e, 0xd7, 0xd2, 0x1d, 0xfa, 0x5a, 0x34, 0xb0, 0xf4, 0xb0, 0xdd, 0x14, 0x23, 0xb4, 0x07, 0x0d,
+	0x12, 0xdb, 0x9d, 0xd0, 0xf7, 0xcd, 0x2c, 0x6b, 0x8d, 0x30, 0x88, 0x96, 0x60, 0x81, 0x5e, 0x06,
+	0xe0, 0x65, 0x17, 0xeb, 0x60, 0x44, 0x62, 0xe7, 0xc7, 0x42, 0x9b, 0x97, 0xb7, 0x00, 0xd1, 0x0f,
+	0xee, 0xd0, 0xef, 0x9a, 0x4e, 0x97, 0xe0, 0x9e, 0xeb, 0x18, 0x84, 0x41, 0x67, 0x59, 0x6b, 0x8a,
+	0x2f, 0x1d, 0x67, 0x8f, 0xcf, 0xa3, 0x77, 0xa0, 0xe4, 0x9f, 0x0e, 0x38, 0x68, 0x36, 0xd6, 0x96,
+	0xc6, 0xea, 0xb5, 0x7f, 0x3a, 0xc0, 0x1a, 0x23, 0xa7, 0xcd, 0x2b, 0x65, 0xe5, 0x7b, 0xfa, 0x09,
+	0xb6, 0x82, 0x87, 0x9e, 0x70, 0x86, 0xfa, 0x4d, 0xd0, 0x04, 0x4e, 0xf3, 0x34, 0x2e, 0x86, 0xa9,
+	0xd8, 0xae, 0x4c, 0x8e, 0xed, 0x6a, 0x3a, 0xb6, 0xff, 0x4b, 0x7d, 0x5c, 0x2a, 0xa6, 0x61, 0x32,
+	0xb4, 0xfc, 0x91, 0xa7, 0x30, 0xbe, 0xf0, 0x9e, 0x94, 0x8a, 0xdf, 0x87, 0x9a, 0x68, 0x6b, 0x23,
+	0xc9, 0x78, 0x92, 0xc3, 0x01, 0x5f, 0xb2, 0x9d, 0x72, 0xb7, 0xf2, 0x99, 0xdd, 0xed, 0xaf, 0x0a,
+	0x00, 0x6f, 0x89, 0xf6, 0x75, 0x72, 0x7c, 0x59, 0x68, 0x8a, 0x5e, 0x81, 0x99, 0x68, 0xc1, 0xc9,
+	0x0d, 0x51, 0xd5, 0xea, 0x91, 0x8a, 0x93, 0xd0, 0xc7, 0xc5, 0x43, 0xd3, 0xc2, 0x7c, 0x9b, 0x55,
+	0x8d, 0x0f, 0x54, 0x1d, 0x50, 0xb8, 0x81, 0x8b, 0x01, 0xf0, 0x3c, 0x4c, 0xf9, 0x3a, 0x39, 0x96,
+	0xbb, 0x10, 0x23, 0x16, 0x93, 0xa1, 0x0c, 0x86, 0x78, 0x21, 0xa9, 0x12, 0x25, 0xbd, 0x74, 0x73,
+	0xc4, 0xeb, 0xf2, 0xa9, 0x44, 0x5d, 0x4e, 0x23, 0x8b, 0xba, 0x83, 0x78, 0x7c, 0xe6, 0x97, 0x18,
+	0x91, 0x19, 0xf4, 0xd5, 0x00, 0x77, 0x2b, 0x2c, 0x62, 0xb3, 0x2f, 0x83, 0xb8, 0x29, 0x62, 0xd5,
+	0xf8, 0x75, 0xa8, 0xd2, 0x9b, 0x11, 0xfe, 0xf6, 0xcb, 0x23, 0xaa, 0xe2, 0xb9, 0x4f, 0x37, 0xe8,
+	0x38, 0x71, 0xbd, 0x07, 0xc9, 0xeb, 0x3d, 0xba, 0xdb, 0x43, 0xdd, 0xb4, 0xb0, 0xd1, 0xf5, 0xb0,
+	0x4e, 0x5c, 0xa7, 0x55, 0xe3, 0x95, 0x18, 0x9f, 0xd4, 0xd8, 0x9c, 0xfa, 0x2f, 0x05, 0xea, 0x5c,
+	0xb0, 0x88, 0xc7, 0x2f, 0xf3, 0x84, 0xc3, 0x7d, 0x17, 0x2f, 0xb0, 0xef, 0xd2, 0xd8, 0x7d, 0x97,
+	0x53, 0xd7, 0x9a, 0x7b, 0x30, 0x1f, 0xa4, 0xf4, 0x30, 0xb8, 0xd9, 0x35, 0xe3, 0xe8, 0x92, 0xfa,
+	0x26, 0xd4, 0x22, 0x97, 0x8b, 0xa2, 0x31, 0x83, 0xf0, 0x6e, 0xf1, 0xf6, 0x5d, 0x98, 0x4b, 0x65,
+	0x46, 0xd4, 0x00, 0x78, 0xec, 0xf4, 0x44, 0xc9, 0xd0, 0xbc, 0x82, 0xea, 0x50, 0x09, 0x0a, 0x88,
+	0xa6, 0x72, 0x7b, 0x0f, 0x1a, 0x71, 0x18, 0x46, 0x2f, 0xc1, 0xd5, 0xc7, 0x8e, 0x81, 0x0f, 0x4d,
+	0x07, 0x1b, 0xe1, 0xa7, 0xe6, 0x15, 0x74, 0x15, 0x66, 0x3b, 0x8e, 0x83, 0xbd, 0xc8, 0xa4, 0x42,
+	0x27, 0x77, 0xb0, 0xd7, 0xc7, 0x91, 0xc9, 0xc2, 0xda, 0x7f, 0xe6, 0xa0, 0x4a, 0x7b, 0xaf, 0x0d,
+	0xd7, 0xf5, 0x0c, 0x34, 0x00, 0xc4, 0x1e, 0x7e, 0xec, 0x81, 0xeb, 0xc8, 0x17, 0x52, 0x74, 0x67,
+	0x44, 0xe3, 0x9b, 0x26, 0x15, 0xd5, 0x5e, 0xfb, 0xd6, 0x88, 0x15, 0x09, 0x72, 0xf5, 0x0a, 0xb2,
+	0x99, 0x44, 0x9a, 0xb3, 0xf6, 0xcd, 0xde, 0x71, 0x70, 0x3d, 0x38, 0x46, 0x62, 0x82, 0x34, 0x90,
+	0x98, 0x78, 0x78, 0x15, 0x03, 0xfe, 0x3a, 0x17, 0xa0, 0x8d, 0x7a, 0x05, 0x3d, 0x81, 0x6b, 0xf4,
+	0x25, 0x44, 0x3e, 0xc8, 0x04, 0x02, 0xd7, 0x46, 0x0b, 0x4c, 0x11, 0x9f, 0x51, 0xe4, 0x36, 0x94,
+	0x59, 0x29, 0x88, 0xb2, 0xf0, 0x3e, 0xfa, 0x17, 0xa2, 0xf6, 0xe2, 0x68, 0x02, 0xc9, 0xed, 0x07,
+	0x30, 0x9b, 0xf8, 0x1b, 0x04, 0x7a, 0x23, 0x63, 0x59, 0xf6, 0x1f, 0x5a, 0xda, 0xb7, 0xf3, 0x90,
+	0x4a, 0x59, 0x7d, 0x68, 0xc4, 0x9f, 0x8d, 0xd0, 0x72, 0xc6, 0xfa, 0xcc, 0x27, 0xec, 0xf6, 0x1b,
+	0x39, 0x28, 0xa5, 0x20, 0x1b, 0x9a, 0xc9, 0x67, 0x79, 0x74, 0x7b, 0x2c, 0x83, 0xb8, 0xbb, 0xbd,
+	0x99, 0x8b, 0x56, 0x8a, 0x3b, 0x85, 0x6b, 0x59, 0xcf, 0xc2, 0x68, 0x25, 0x9b, 0xcd, 0xa8, 0xf7,
+	0xea, 0xf6, 0x6a, 0x6e, 0x7a, 0x29, 0xfa, 0x73, 0xde, 0x82, 0x66, 0x3d, 0xad, 0xa2, 0xbb, 0xd9,
+	0xec, 0xc6, 0xbc, 0x09, 0xb7, 0xd7, 0xce, 0xb2, 0x44, 0x2a, 0xf1, 0x29, 0xcc, 0x67, 0x3f, 0x4f,
+	0xa2, 0x3b, 0xd9, 0xfc, 0x46, 0xbf, 0xbb, 0xb6, 0xef, 0x9e, 0x61, 0x85, 0x54, 0xc0, 0x4d, 0xfe,
+	0xf1, 0x21, 0x08, 0xc3, 0xd5, 0x89, 0x5e, 0x73, 0xbe, 0x18, 0xfc, 0x18, 0x66, 0x13, 0x37, 0xb8,
+	0x99, 0x51, 0x93, 0x7d, 0xcb, 0xdb, 0x1e, 0x97, 0xb2, 0x78, 0x48, 0x26, 0x5a, 0x71, 0x34, 0xc2,
+	0xfb, 0x33, 0xda, 0xf5, 0xf6, 0xed, 0x3c, 0xa4, 0x72, 0x23, 0x84, 0xc1, 0x65, 0xa2, 0x9d, 0x45,
+	0x6f, 0x65, 0xf3, 0xc8, 0x6e, 0xc5, 0xdb, 0x6f, 0xe7, 0xa4, 0x96, 0x42, 0xbb, 0x00, 0x5b, 0xd8,
+	0xdf, 0xc1, 0xbe, 0x47, 0x7d, 0xe4, 0x56, 0xa6, 0xc9, 0x43, 0x82, 0x40, 0xcc, 0xeb, 0x13, 0xe9,
+	0xa4, 0x80, 0xef, 0x01, 0x0a, 0xf2, 0x5c, 0xe4, 0x0d, 0xe1, 0x95, 0xb1, 0x7d, 0x08, 0x2f, 0x2f,
+	0x26, 0x9d, 0xcd, 0x13, 0x68, 0xee, 0xe8, 0xce, 0x50, 0xb7, 0x22, 0x7c, 0xdf, 0xca, 0x54, 0x2c,
+	0x49, 0x36, 0xc2, 0x5a, 0x23, 0xa9, 0xe5, 0x66, 0x9e, 0xca, 0x1c, 0xaa, 0xcb, 0x10, 0xc4, 0x68,
+	0x25, 0x93, 0x4d, 0x9a, 0x70, 0x04, 0xb6, 0x8c, 0xa1, 0x97, 0x82, 0x77, 0x61, 0x8a, 0x97, 0x3e,
+	0x28, 0xeb, 0xfe, 0x3e, 0x2c, 0x8c, 0xdb, 0xaf, 0x8d, 0xfd, 0x1c, 0xe1, 0x78, 0xcc, 0x12, 0x40,
+	0xa4, 0x9e, 0x4a, 0xa2, 0x72, 0xa8, 0x56, 0x84, 0x68, 0x04, 0x2a, 0x8f, 0xa0, 0x95, 0xc2, 0x1e,
+	0x41, 0x5d, 0xc3, 0xf4, 0x83, 0xd8, 0xc4, 0xcd, 0x91, 0x5a, 0xe6, 0x3a, 0xfa, 0xb5, 0x2f, 0xca,
+	0x50, 0x09, 0x6e, 0x95, 0x2f, 0xa1, 0xb0, 0xb9, 0x84, 0x4a, 0xe3, 0x63, 0x98, 0x4d, 0xfc, 0x5b,
+	0x21, 0x13, 0x88, 0xb2, 0xff, 0xd1, 0x30, 0x29, 0x92, 0x3e, 0x12, 0x7f, 0x3a, 0x96, 0xa0, 0xf3,
+	0xfa, 0xa8, 0x6a, 0x25, 0x89, 0x37, 0x13, 0x18, 0x3f, 0x77, 0x74, 0x79, 0x04, 0x10, 0x89, 0xfe,
+	0xf1, 0xb7, 0x1b, 0xf4, 0x22, 0x67, 0x92, 0xc2, 0xdf, 0x92, 0x71, 0xb6, 0x34, 0x36, 0x90, 0x28,
+	0x74, 0x4f, 0xe0, 0xb5, 0x7e, 0xef, 0xfb, 0x77, 0xfb, 0xa6, 0x7f, 0x34, 0x3c, 0xa0, 0x5f, 0x56,
+	0x39, 0xe9, 0xdb, 0xa6, 0x2b, 0x7e, 0xad, 0x06, 0xde, 0xb1, 0xca, 0x56, 0xaf, 0x52, 0x01, 0x83,
+	0x83, 0x83, 0x29, 0x36, 0xba, 0xf7, 0xbf, 0x01, 0x00, 0x26, 0x3d, 0xed, 0x73, 0xe2, 0x2e, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataCoordClient is the client API for DataCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCoordClient interface {
+	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
+	Import(ctx context.Context, in *ImportTask, opts ...grpc.CallOption) (*ImportTaskResponse, error)
+	GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
+	ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+}
+
+type dataCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
+	return &dataCoordClient{cc}
+}
+
+func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
+	out := new(internalpb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
+	out := new(AssignSegmentIDResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2651,6 +3404,60 @@ func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetric
 	return out, nil
 }
 
+func (c *dataCoordClient) CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CompleteCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	out := new(milvuspb.ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ManualCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
+	out := new(milvuspb.GetCompactionStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Import(ctx context.Context, in *ImportTask, opts ...grpc.CallOption) (*ImportTaskResponse, error) {
+	out := new(ImportTaskResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+	out := new(milvuspb.GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -2669,6 +3476,12 @@ type DataCoordServer interface {
 	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	CompleteCompaction(context.Context, *CompactionResult) (*commonpb.Status, error)
+	ManualCompaction(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
+	GetCompactionState(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
+	Import(context.Context, *ImportTask) (*ImportTaskResponse, error)
+	GetImportState(context.Context, *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
+	ReportImport(context.Context, *ImportResult) (*commonpb.Status, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -2720,6 +3533,24 @@ func (*UnimplementedDataCoordServer) GetFlushedSegments(ctx context.Context, req
 func (*UnimplementedDataCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
+func (*UnimplementedDataCoordServer) CompleteCompaction(ctx context.Context, req *CompactionResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
+}
+func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTask) (*ImportTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedDataCoordServer) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportState not implemented")
+}
+func (*UnimplementedDataCoordServer) ReportImport(ctx context.Context, req *ImportResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportImport not implemented")
+}
 
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
@@ -2995,6 +3826,114 @@ func _DataCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_CompleteCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactionResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CompleteCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CompleteCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CompleteCompaction(ctx, req.(*CompactionResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ManualCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ManualCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ManualCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ManualCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ManualCompaction(ctx, req.(*milvuspb.ManualCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetCompactionStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionState(ctx, req.(*milvuspb.GetCompactionStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Import(ctx, req.(*ImportTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetImportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetImportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetImportState(ctx, req.(*milvuspb.GetImportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ReportImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ReportImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ReportImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ReportImport(ctx, req.(*ImportResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -3059,6 +3998,30 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _DataCoord_GetMetrics_Handler,
 		},
+		{
+			MethodName: "CompleteCompaction",
+			Handler:    _DataCoord_CompleteCompaction_Handler,
+		},
+		{
+			MethodName: "ManualCompaction",
+			Handler:    _DataCoord_ManualCompaction_Handler,
+		},
+		{
+			MethodName: "GetCompactionState",
+			Handler:    _DataCoord_GetCompactionState_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _DataCoord_Import_Handler,
+		},
+		{
+			MethodName: "GetImportState",
+			Handler:    _DataCoord_GetImportState_Handler,
+		},
+		{
+			MethodName: "ReportImport",
+			Handler:    _DataCoord_ReportImport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -3074,6 +4037,8 @@ type DataNodeClient interface {
 	FlushSegments(ctx context.Context, in *FlushSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	Compaction(ctx context.Context, in *CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Import(ctx context.Context, in *ImportTaskInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataNodeClient struct {
@@ -3129,6 +4094,24 @@ func (c *dataNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetrics
 	return out, nil
 }
 
+func (c *dataNodeClient) Compaction(ctx context.Context, in *CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Compaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) Import(ctx context.Context, in *ImportTaskInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 type DataNodeServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -3137,6 +4120,8 @@ type DataNodeServer interface {
 	FlushSegments(context.Context, *FlushSegmentsRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	Compaction(context.Context, *CompactionPlan) (*commonpb.Status, error)
+	Import(context.Context, *ImportTaskInfo) (*commonpb.Status, error)
 }
 
 // UnimplementedDataNodeServer can be embedded to have forward compatible implementations.
@@ -3158,6 +4143,12 @@ func (*UnimplementedDataNodeServer) FlushSegments(ctx context.Context, req *Flus
 func (*UnimplementedDataNodeServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
+func (*UnimplementedDataNodeServer) Compaction(ctx context.Context, req *CompactionPlan) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compaction not implemented")
+}
+func (*UnimplementedDataNodeServer) Import(ctx context.Context, req *ImportTaskInfo) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
 	s.RegisterService(&_DataNode_serviceDesc, srv)
@@ -3253,6 +4244,42 @@ func _DataNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_Compaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactionPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).Compaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/Compaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).Compaction(ctx, req.(*CompactionPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTaskInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).Import(ctx, req.(*ImportTaskInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -3277,6 +4304,14 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _DataNode_GetMetrics_Handler,
 		},
+		{
+			MethodName: "Compaction",
+			Handler:    _DataNode_Compaction_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _DataNode_Import_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
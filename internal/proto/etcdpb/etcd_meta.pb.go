@@ -254,9 +254,14 @@ type CollectionInfo struct {
 	PartitionCreatedTimestamps []uint64                   `protobuf:"varint,9,rep,packed,name=partition_created_timestamps,json=partitionCreatedTimestamps,proto3" json:"partition_created_timestamps,omitempty"`
 	ShardsNum                  int32                      `protobuf:"varint,10,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
 	StartPositions             []*commonpb.KeyDataPair    `protobuf:"bytes,11,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                   `json:"-"`
-	XXX_unrecognized           []byte                     `json:"-"`
-	XXX_sizecache              int32                      `json:"-"`
+	// empty means the default database
+	DbName           string                    `protobuf:"bytes,12,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,13,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// increased by one each time a field is added
+	SchemaVersion        int64    `protobuf:"varint,14,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
@@ -361,6 +366,275 @@ func (m *CollectionInfo) GetStartPositions() []*commonpb.KeyDataPair {
 	return nil
 }
 
+func (m *CollectionInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CollectionInfo) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *CollectionInfo) GetSchemaVersion() int64 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+type DatabaseInfo struct {
+	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreateTime           uint64   `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseInfo) Reset()         { *m = DatabaseInfo{} }
+func (m *DatabaseInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseInfo) ProtoMessage()    {}
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DatabaseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseInfo.Unmarshal(m, b)
+}
+func (m *DatabaseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseInfo.Merge(m, src)
+}
+func (m *DatabaseInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseInfo.Size(m)
+}
+func (m *DatabaseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseInfo proto.InternalMessageInfo
+
+func (m *DatabaseInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *DatabaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+type CredentialInfo struct {
+	ID       int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// encrypted by bcrypt
+	EncryptedPassword string `protobuf:"bytes,3,opt,name=encrypted_password,json=encryptedPassword,proto3" json:"encrypted_password,omitempty"`
+	// names of the roles granted to the user
+	Roles                []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	CreateTime           uint64   `protobuf:"varint,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
+func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
+func (*CredentialInfo) ProtoMessage()    {}
+func (*CredentialInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{6}
+}
+
+func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CredentialInfo.Unmarshal(m, b)
+}
+func (m *CredentialInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CredentialInfo.Marshal(b, m, deterministic)
+}
+func (m *CredentialInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialInfo.Merge(m, src)
+}
+func (m *CredentialInfo) XXX_Size() int {
+	return xxx_messageInfo_CredentialInfo.Size(m)
+}
+func (m *CredentialInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialInfo proto.InternalMessageInfo
+
+func (m *CredentialInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *CredentialInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetEncryptedPassword() string {
+	if m != nil {
+		return m.EncryptedPassword
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *CredentialInfo) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+type RoleInfo struct {
+	ID                   int64        `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Grants               []*GrantInfo `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants,omitempty"`
+	CreateTime           uint64       `protobuf:"varint,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RoleInfo) Reset()         { *m = RoleInfo{} }
+func (m *RoleInfo) String() string { return proto.CompactTextString(m) }
+func (*RoleInfo) ProtoMessage()    {}
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{7}
+}
+
+func (m *RoleInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleInfo.Unmarshal(m, b)
+}
+func (m *RoleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleInfo.Marshal(b, m, deterministic)
+}
+func (m *RoleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleInfo.Merge(m, src)
+}
+func (m *RoleInfo) XXX_Size() int {
+	return xxx_messageInfo_RoleInfo.Size(m)
+}
+func (m *RoleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleInfo proto.InternalMessageInfo
+
+func (m *RoleInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *RoleInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RoleInfo) GetGrants() []*GrantInfo {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *RoleInfo) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+type GrantInfo struct {
+	// empty means the default database
+	DbName string `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// collection name, "*" means all collections of the database
+	ObjectName           string   `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Privilege            string   `protobuf:"bytes,3,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantInfo) Reset()         { *m = GrantInfo{} }
+func (m *GrantInfo) String() string { return proto.CompactTextString(m) }
+func (*GrantInfo) ProtoMessage()    {}
+func (*GrantInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{8}
+}
+
+func (m *GrantInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantInfo.Unmarshal(m, b)
+}
+func (m *GrantInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantInfo.Marshal(b, m, deterministic)
+}
+func (m *GrantInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantInfo.Merge(m, src)
+}
+func (m *GrantInfo) XXX_Size() int {
+	return xxx_messageInfo_GrantInfo.Size(m)
+}
+func (m *GrantInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantInfo proto.InternalMessageInfo
+
+func (m *GrantInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GrantInfo) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *GrantInfo) GetPrivilege() string {
+	if m != nil {
+		return m.Privilege
+	}
+	return ""
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -378,7 +652,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{9}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -464,7 +738,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{10}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -533,6 +807,10 @@ func init() {
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.etcd.IndexInfo")
 	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.etcd.FieldIndexInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
+	proto.RegisterType((*DatabaseInfo)(nil), "milvus.proto.etcd.DatabaseInfo")
+	proto.RegisterType((*CredentialInfo)(nil), "milvus.proto.etcd.CredentialInfo")
+	proto.RegisterType((*RoleInfo)(nil), "milvus.proto.etcd.RoleInfo")
+	proto.RegisterType((*GrantInfo)(nil), "milvus.proto.etcd.GrantInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 }
@@ -540,52 +818,66 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xe3, 0x36,
-	0x10, 0x86, 0x22, 0xc7, 0x8e, 0xc6, 0x8e, 0x9d, 0xb0, 0x3f, 0x20, 0x82, 0xb4, 0x55, 0x04, 0x24,
-	0x15, 0x50, 0xd4, 0x46, 0x93, 0xa2, 0xb7, 0x02, 0x6d, 0x23, 0x04, 0x30, 0x8a, 0x06, 0xae, 0x62,
-	0xf4, 0xd0, 0x8b, 0x40, 0x4b, 0xb4, 0x4d, 0x40, 0xa4, 0xbc, 0x22, 0x15, 0xc4, 0xb7, 0x3d, 0xef,
-	0x23, 0xec, 0x23, 0xec, 0x8b, 0xed, 0x61, 0x5f, 0x62, 0x21, 0x52, 0x92, 0xed, 0xc4, 0x39, 0xee,
-	0x4d, 0xf3, 0xcd, 0x0c, 0xf9, 0xcd, 0xc7, 0x6f, 0x04, 0x03, 0xaa, 0xe2, 0x24, 0xe2, 0x54, 0x91,
-	0xe1, 0x2a, 0xcf, 0x54, 0x86, 0x4e, 0x39, 0x4b, 0x1f, 0x0b, 0x69, 0xa2, 0x61, 0x99, 0x3d, 0xeb,
-	0xc5, 0x19, 0xe7, 0x99, 0x30, 0xd0, 0x59, 0x4f, 0xc6, 0x4b, 0xca, 0xab, 0x72, 0xef, 0xbd, 0x05,
-	0x30, 0xa5, 0x82, 0x08, 0xf5, 0x0f, 0x55, 0x04, 0xf5, 0xe1, 0x60, 0x1c, 0x60, 0xcb, 0xb5, 0x7c,
-	0x3b, 0x3c, 0x18, 0x07, 0xe8, 0x0a, 0x06, 0xa2, 0xe0, 0xd1, 0x9b, 0x82, 0xe6, 0xeb, 0x48, 0x64,
-	0x09, 0x95, 0xf8, 0x40, 0x27, 0x8f, 0x45, 0xc1, 0xff, 0x2d, 0xd1, 0xfb, 0x12, 0x44, 0x3f, 0xc1,
-	0x29, 0x13, 0x92, 0xe6, 0x2a, 0x8a, 0x97, 0x44, 0x08, 0x9a, 0x8e, 0x03, 0x89, 0x6d, 0xd7, 0xf6,
-	0x9d, 0xf0, 0xc4, 0x24, 0x6e, 0x1b, 0x1c, 0xfd, 0x08, 0x03, 0x73, 0x60, 0x53, 0x8b, 0x5b, 0xae,
-	0xe5, 0x3b, 0x61, 0x5f, 0xc3, 0x4d, 0xa5, 0xf7, 0xd6, 0x02, 0x67, 0x92, 0x67, 0x4f, 0xeb, 0xbd,
-	0xdc, 0x7e, 0x83, 0x0e, 0x49, 0x92, 0x9c, 0x4a, 0xc3, 0xa9, 0x7b, 0x7d, 0x3e, 0xdc, 0x99, 0xbd,
-	0x9a, 0xfa, 0x4f, 0x53, 0x13, 0xd6, 0xc5, 0x25, 0xd7, 0x9c, 0xca, 0x22, 0xdd, 0xc7, 0xd5, 0x24,
-	0x36, 0x5c, 0xbd, 0x77, 0x16, 0x38, 0x63, 0x91, 0xd0, 0xa7, 0xb1, 0x98, 0x67, 0xe8, 0x3b, 0x00,
-	0x56, 0x06, 0x91, 0x20, 0x9c, 0x6a, 0x2a, 0x4e, 0xe8, 0x68, 0xe4, 0x9e, 0x70, 0x8a, 0x30, 0x74,
-	0x74, 0x30, 0x0e, 0x2a, 0x95, 0xea, 0x10, 0x05, 0xd0, 0x33, 0x8d, 0x2b, 0x92, 0x13, 0x6e, 0xae,
-	0xeb, 0x5e, 0x5f, 0xec, 0x25, 0xfc, 0x37, 0x5d, 0xff, 0x47, 0xd2, 0x82, 0x4e, 0x08, 0xcb, 0xc3,
-	0xae, 0x6e, 0x9b, 0xe8, 0x2e, 0x2f, 0x80, 0xfe, 0x1d, 0xa3, 0x69, 0xb2, 0x21, 0x84, 0xa1, 0x33,
-	0x67, 0x29, 0x4d, 0x1a, 0x61, 0xea, 0xf0, 0x75, 0x2e, 0xde, 0x87, 0x16, 0xf4, 0x6f, 0xb3, 0x34,
-	0xa5, 0xb1, 0x62, 0x99, 0xd0, 0xc7, 0x3c, 0x97, 0xf6, 0x77, 0x68, 0x1b, 0x97, 0x54, 0xca, 0x5e,
-	0xee, 0x12, 0xad, 0x1c, 0xb4, 0x39, 0xe4, 0x41, 0x03, 0x61, 0xd5, 0x84, 0x7e, 0x80, 0x6e, 0x9c,
-	0x53, 0xa2, 0x68, 0xa4, 0x18, 0xa7, 0xd8, 0x76, 0x2d, 0xbf, 0x15, 0x82, 0x81, 0xa6, 0x8c, 0x53,
-	0xe4, 0x41, 0x6f, 0x45, 0x72, 0xc5, 0x34, 0x81, 0x40, 0xe2, 0x96, 0x6b, 0xfb, 0x76, 0xb8, 0x83,
-	0xa1, 0x2b, 0xe8, 0x37, 0x71, 0xa9, 0xae, 0xc4, 0x87, 0xfa, 0x8d, 0x9e, 0xa1, 0xe8, 0x0e, 0x8e,
-	0xe7, 0xa5, 0x28, 0x91, 0x9e, 0x8f, 0x4a, 0xdc, 0xde, 0xa7, 0x6d, 0xb9, 0x08, 0xc3, 0x5d, 0xf1,
-	0xc2, 0xde, 0xbc, 0x89, 0xa9, 0x44, 0xd7, 0xf0, 0xcd, 0x23, 0xcb, 0x55, 0x41, 0xd2, 0xda, 0x17,
-	0xfa, 0x95, 0x25, 0xee, 0xe8, 0x6b, 0xbf, 0xaa, 0x92, 0x95, 0x37, 0xcc, 0xdd, 0xbf, 0xc2, 0xb7,
-	0xab, 0xe5, 0x5a, 0xb2, 0xf8, 0x45, 0xd3, 0x91, 0x6e, 0xfa, 0xba, 0xce, 0xee, 0x74, 0xfd, 0x01,
-	0xe7, 0xcd, 0x0c, 0x91, 0x51, 0x25, 0xd1, 0x4a, 0x49, 0x45, 0xf8, 0x4a, 0x62, 0xc7, 0xb5, 0xfd,
-	0x56, 0x78, 0xd6, 0xd4, 0xdc, 0x9a, 0x92, 0x69, 0x53, 0x51, 0xfa, 0x50, 0x2e, 0x49, 0x9e, 0xc8,
-	0x48, 0x14, 0x1c, 0x83, 0x6b, 0xf9, 0x87, 0xa1, 0x63, 0x90, 0xfb, 0x82, 0xa3, 0x31, 0x0c, 0xa4,
-	0x22, 0xb9, 0x8a, 0x56, 0x99, 0xd4, 0x27, 0x48, 0xdc, 0xd5, 0xa2, 0xb8, 0xaf, 0x19, 0x2e, 0x20,
-	0x8a, 0x68, 0xbf, 0xf5, 0x75, 0xe3, 0xa4, 0xee, 0xf3, 0x3e, 0x5a, 0x70, 0xf2, 0x40, 0x17, 0x9c,
-	0x0a, 0xb5, 0x71, 0x9d, 0x07, 0xbd, 0x78, 0x63, 0xa0, 0xda, 0x38, 0x3b, 0x18, 0x72, 0xa1, 0xbb,
-	0xf5, 0x9c, 0x95, 0x07, 0xb7, 0x21, 0x74, 0x0e, 0x8e, 0xac, 0x4e, 0x0e, 0xb4, 0x47, 0xec, 0x70,
-	0x03, 0x18, 0x67, 0x97, 0xcf, 0x63, 0x7e, 0x0e, 0xda, 0xd9, 0x3a, 0xdc, 0x76, 0xf6, 0xe1, 0xee,
-	0x96, 0x61, 0xe8, 0xcc, 0x0a, 0xa6, 0x7b, 0xda, 0x26, 0x53, 0x85, 0xe8, 0x02, 0x7a, 0x54, 0x90,
-	0x59, 0x4a, 0x8d, 0x4b, 0x70, 0xc7, 0xb5, 0xfc, 0xa3, 0xb0, 0x6b, 0x30, 0x3d, 0x98, 0xf7, 0xc9,
-	0xda, 0x5e, 0x8b, 0xbd, 0x7f, 0x9c, 0x2f, 0xbd, 0x16, 0xdf, 0x03, 0x34, 0x02, 0xd4, 0x4b, 0xb1,
-	0x85, 0xa0, 0xcb, 0xad, 0x95, 0x88, 0x14, 0x59, 0xd4, 0x2b, 0x71, 0xdc, 0xa0, 0x53, 0xb2, 0x90,
-	0x2f, 0xb6, 0xab, 0xfd, 0x72, 0xbb, 0xfe, 0xba, 0xf9, 0xff, 0x97, 0x05, 0x53, 0xcb, 0x62, 0x56,
-	0x9a, 0x60, 0x64, 0xc6, 0xf8, 0x99, 0x65, 0xd5, 0xd7, 0x88, 0x09, 0x45, 0x73, 0x41, 0xd2, 0x91,
-	0x9e, 0x6c, 0x54, 0x6e, 0xcf, 0x6a, 0x36, 0x6b, 0xeb, 0xe8, 0xe6, 0x73, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x9e, 0xc5, 0x2c, 0x1a, 0x75, 0x06, 0x00, 0x00,
+	// 966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6e, 0xe4, 0xc4,
+	0x13, 0x96, 0x33, 0xff, 0xe2, 0x9a, 0xc9, 0x24, 0xe9, 0xdf, 0xfe, 0xc0, 0x8a, 0x02, 0xeb, 0xb5,
+	0x94, 0x65, 0x24, 0xb4, 0x89, 0xc8, 0xae, 0xb8, 0x21, 0x01, 0x19, 0x2d, 0x1a, 0x01, 0x51, 0xe8,
+	0x44, 0x7b, 0xe0, 0x62, 0xf5, 0xd8, 0x95, 0x49, 0x23, 0xbb, 0x6d, 0xba, 0xdb, 0x61, 0xe7, 0xc6,
+	0x85, 0x0b, 0x8f, 0xc0, 0x91, 0x07, 0xe1, 0x75, 0x38, 0xf0, 0x12, 0xa8, 0xbb, 0x6d, 0xcf, 0x4c,
+	0x32, 0x2b, 0xb8, 0x70, 0x9b, 0xfa, 0xaa, 0xaa, 0xfb, 0xab, 0xf2, 0xd7, 0xdf, 0xc0, 0x3e, 0xea,
+	0x24, 0x8d, 0x73, 0xd4, 0xec, 0xb4, 0x94, 0x85, 0x2e, 0xc8, 0x61, 0xce, 0xb3, 0xfb, 0x4a, 0xb9,
+	0xe8, 0xd4, 0x64, 0x8f, 0x46, 0x49, 0x91, 0xe7, 0x85, 0x70, 0xd0, 0xd1, 0x48, 0x25, 0x77, 0x98,
+	0xd7, 0xe5, 0xd1, 0x6f, 0x1e, 0xc0, 0x0d, 0x0a, 0x26, 0xf4, 0xb7, 0xa8, 0x19, 0x19, 0xc3, 0xce,
+	0x6c, 0x1a, 0x78, 0xa1, 0x37, 0xe9, 0xd0, 0x9d, 0xd9, 0x94, 0x3c, 0x87, 0x7d, 0x51, 0xe5, 0xf1,
+	0x8f, 0x15, 0xca, 0x65, 0x2c, 0x8a, 0x14, 0x55, 0xb0, 0x63, 0x93, 0x7b, 0xa2, 0xca, 0xbf, 0x33,
+	0xe8, 0xa5, 0x01, 0xc9, 0xc7, 0x70, 0xc8, 0x85, 0x42, 0xa9, 0xe3, 0xe4, 0x8e, 0x09, 0x81, 0xd9,
+	0x6c, 0xaa, 0x82, 0x4e, 0xd8, 0x99, 0xf8, 0xf4, 0xc0, 0x25, 0x2e, 0x5a, 0x9c, 0x7c, 0x04, 0xfb,
+	0xee, 0xc0, 0xb6, 0x36, 0xe8, 0x86, 0xde, 0xc4, 0xa7, 0x63, 0x0b, 0xb7, 0x95, 0xd1, 0xcf, 0x1e,
+	0xf8, 0x57, 0xb2, 0x78, 0xbb, 0xdc, 0xca, 0xed, 0x53, 0x18, 0xb0, 0x34, 0x95, 0xa8, 0x1c, 0xa7,
+	0xe1, 0xf9, 0xf1, 0xe9, 0xc6, 0xec, 0xf5, 0xd4, 0x5f, 0xb8, 0x1a, 0xda, 0x14, 0x1b, 0xae, 0x12,
+	0x55, 0x95, 0x6d, 0xe3, 0xea, 0x12, 0x2b, 0xae, 0xd1, 0xaf, 0x1e, 0xf8, 0x33, 0x91, 0xe2, 0xdb,
+	0x99, 0xb8, 0x2d, 0xc8, 0x07, 0x00, 0xdc, 0x04, 0xb1, 0x60, 0x39, 0x5a, 0x2a, 0x3e, 0xf5, 0x2d,
+	0x72, 0xc9, 0x72, 0x24, 0x01, 0x0c, 0x6c, 0x30, 0x9b, 0xd6, 0x5b, 0x6a, 0x42, 0x32, 0x85, 0x91,
+	0x6b, 0x2c, 0x99, 0x64, 0xb9, 0xbb, 0x6e, 0x78, 0xfe, 0x6c, 0x2b, 0xe1, 0xaf, 0x71, 0xf9, 0x86,
+	0x65, 0x15, 0x5e, 0x31, 0x2e, 0xe9, 0xd0, 0xb6, 0x5d, 0xd9, 0xae, 0x68, 0x0a, 0xe3, 0xd7, 0x1c,
+	0xb3, 0x74, 0x45, 0x28, 0x80, 0xc1, 0x2d, 0xcf, 0x30, 0x6d, 0x17, 0xd3, 0x84, 0xef, 0xe6, 0x12,
+	0xfd, 0xd1, 0x83, 0xf1, 0x45, 0x91, 0x65, 0x98, 0x68, 0x5e, 0x08, 0x7b, 0xcc, 0xc3, 0xd5, 0x7e,
+	0x06, 0x7d, 0xa7, 0x92, 0x7a, 0xb3, 0x27, 0x9b, 0x44, 0x6b, 0x05, 0xad, 0x0e, 0xb9, 0xb6, 0x00,
+	0xad, 0x9b, 0xc8, 0x53, 0x18, 0x26, 0x12, 0x99, 0xc6, 0x58, 0xf3, 0x1c, 0x83, 0x4e, 0xe8, 0x4d,
+	0xba, 0x14, 0x1c, 0x74, 0xc3, 0x73, 0x24, 0x11, 0x8c, 0x4a, 0x26, 0x35, 0xb7, 0x04, 0xa6, 0x2a,
+	0xe8, 0x86, 0x9d, 0x49, 0x87, 0x6e, 0x60, 0xe4, 0x39, 0x8c, 0xdb, 0xd8, 0x6c, 0x57, 0x05, 0x3d,
+	0xfb, 0x8d, 0x1e, 0xa0, 0xe4, 0x35, 0xec, 0xdd, 0x9a, 0xa5, 0xc4, 0x76, 0x3e, 0x54, 0x41, 0x7f,
+	0xdb, 0x6e, 0xcd, 0x43, 0x38, 0xdd, 0x5c, 0x1e, 0x1d, 0xdd, 0xb6, 0x31, 0x2a, 0x72, 0x0e, 0xff,
+	0xbf, 0xe7, 0x52, 0x57, 0x2c, 0x6b, 0x74, 0x61, 0xbf, 0xb2, 0x0a, 0x06, 0xf6, 0xda, 0xff, 0xd5,
+	0xc9, 0x5a, 0x1b, 0xee, 0xee, 0x57, 0xf0, 0x5e, 0x79, 0xb7, 0x54, 0x3c, 0x79, 0xd4, 0xb4, 0x6b,
+	0x9b, 0x9e, 0x34, 0xd9, 0x8d, 0xae, 0xcf, 0xe1, 0xb8, 0x9d, 0x21, 0x76, 0x5b, 0x49, 0xed, 0xa6,
+	0x94, 0x66, 0x79, 0xa9, 0x02, 0x3f, 0xec, 0x4c, 0xba, 0xf4, 0xa8, 0xad, 0xb9, 0x70, 0x25, 0x37,
+	0x6d, 0x85, 0xd1, 0xa1, 0xba, 0x63, 0x32, 0x55, 0xb1, 0xa8, 0xf2, 0x00, 0x42, 0x6f, 0xd2, 0xa3,
+	0xbe, 0x43, 0x2e, 0xab, 0x9c, 0xcc, 0x60, 0x5f, 0x69, 0x26, 0x75, 0x5c, 0x16, 0xca, 0x9e, 0xa0,
+	0x82, 0xa1, 0x5d, 0x4a, 0xf8, 0x2e, 0xc1, 0x4d, 0x99, 0x66, 0x56, 0x6f, 0x63, 0xdb, 0x78, 0xd5,
+	0xf4, 0x91, 0xf7, 0x61, 0x90, 0xce, 0x9d, 0xdc, 0x47, 0x56, 0xee, 0xfd, 0x74, 0x6e, 0xb5, 0x4e,
+	0xe1, 0x30, 0x29, 0x84, 0xe2, 0x4a, 0xa3, 0x48, 0x96, 0x71, 0x86, 0xf7, 0x98, 0x05, 0x7b, 0xa1,
+	0x37, 0x19, 0x9f, 0x9f, 0x6c, 0xbd, 0xe5, 0x62, 0x55, 0xfd, 0x8d, 0x29, 0xa6, 0x07, 0xc9, 0x03,
+	0x84, 0x9c, 0xc0, 0xd8, 0x29, 0x28, 0xbe, 0x47, 0xa9, 0x78, 0x21, 0x82, 0xb1, 0x33, 0x1b, 0x87,
+	0xbe, 0x71, 0x60, 0x74, 0x0d, 0x23, 0xc3, 0x77, 0xce, 0x14, 0x6e, 0x55, 0x2f, 0x81, 0xae, 0x25,
+	0xbc, 0x63, 0x09, 0xdb, 0xdf, 0xff, 0x28, 0xc9, 0xe8, 0x77, 0x0f, 0xc6, 0x17, 0x12, 0x53, 0x14,
+	0x9a, 0xb3, 0x6c, 0xeb, 0xb9, 0x47, 0xb0, 0x5b, 0x29, 0x94, 0x6b, 0x67, 0xb7, 0x31, 0x79, 0x01,
+	0x04, 0x45, 0x22, 0x97, 0xa5, 0xf9, 0x96, 0x25, 0x53, 0xea, 0xa7, 0x42, 0xa6, 0xf6, 0x1a, 0x9f,
+	0x1e, 0xb6, 0x99, 0xab, 0x3a, 0x41, 0x9e, 0x40, 0x4f, 0x16, 0x19, 0x3a, 0xe5, 0xfb, 0xd4, 0x05,
+	0x0f, 0x49, 0xf6, 0x1e, 0x91, 0xfc, 0xc5, 0x83, 0x5d, 0x5a, 0x64, 0xff, 0x7e, 0xec, 0x57, 0xd0,
+	0x5f, 0x48, 0x26, 0x74, 0xe3, 0x38, 0xc7, 0x5b, 0x5e, 0xc5, 0x57, 0xa6, 0xc0, 0x3e, 0x88, 0xba,
+	0xf6, 0x21, 0x8f, 0xee, 0x23, 0x1e, 0x09, 0xf8, 0x6d, 0xd7, 0xba, 0x44, 0xbc, 0x0d, 0x89, 0x3c,
+	0x85, 0x61, 0x31, 0xff, 0x01, 0x13, 0x1d, 0xaf, 0xf1, 0x02, 0x07, 0xd9, 0x82, 0x63, 0xf0, 0x4b,
+	0xc9, 0xef, 0x79, 0x86, 0x0b, 0xac, 0x77, 0xb5, 0x02, 0xa2, 0x3f, 0x3d, 0x38, 0xb8, 0xc6, 0x45,
+	0x8e, 0x42, 0xb7, 0x6f, 0xd6, 0x38, 0x47, 0xb2, 0xf2, 0xae, 0x66, 0xfc, 0x0d, 0x8c, 0x84, 0x30,
+	0x5c, 0x73, 0x92, 0xda, 0xfe, 0xd6, 0x21, 0x73, 0xb1, 0xaa, 0x4f, 0x9e, 0xda, 0x8b, 0x3b, 0x74,
+	0x05, 0x38, 0x53, 0x35, 0xce, 0xe0, 0xfe, 0x97, 0x3a, 0xb4, 0x09, 0xd7, 0x4d, 0xb5, 0xb7, 0x69,
+	0xf0, 0x01, 0x0c, 0xe6, 0x15, 0xb7, 0x3d, 0x7d, 0x97, 0xa9, 0x43, 0xf2, 0x0c, 0x46, 0x28, 0xd8,
+	0x3c, 0x43, 0x67, 0x50, 0xc1, 0x20, 0xf4, 0x26, 0xbb, 0x74, 0xe8, 0x30, 0x3b, 0x58, 0xf4, 0x97,
+	0xb7, 0xee, 0xc8, 0x5b, 0xff, 0xec, 0xfe, 0x6b, 0x47, 0xfe, 0x10, 0xa0, 0x5d, 0x40, 0xe3, 0xc7,
+	0x6b, 0x88, 0x79, 0x9a, 0x2b, 0xcf, 0xd2, 0x6c, 0xd1, 0xb8, 0xf1, 0x5e, 0x8b, 0xde, 0xb0, 0x85,
+	0x7a, 0x64, 0xec, 0xfd, 0xc7, 0xc6, 0xfe, 0xe5, 0xcb, 0xef, 0x3f, 0x59, 0x70, 0x7d, 0x57, 0xcd,
+	0x8d, 0x33, 0x9c, 0xb9, 0x31, 0x5e, 0xf0, 0xa2, 0xfe, 0x75, 0xc6, 0x85, 0x36, 0x6f, 0x2a, 0x3b,
+	0xb3, 0x93, 0x9d, 0x19, 0x89, 0x96, 0xf3, 0x79, 0xdf, 0x46, 0x2f, 0xff, 0x1e, 0x00, 0x95, 0xa4,
+	0xa3, 0xf3, 0xf0, 0x08, 0x00, 0x00,
 }
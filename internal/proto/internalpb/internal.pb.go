@@ -986,6 +986,93 @@ func (m *AlterAliasRequest) GetAlias() string {
 	return ""
 }
 
+type AddFieldRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	DbID                 int64                 `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID         int64                 `protobuf:"varint,5,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Field                *schemapb.FieldSchema `protobuf:"bytes,6,opt,name=field,proto3" json:"field,omitempty"`
+	SchemaVersion        int64                 `protobuf:"varint,7,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AddFieldRequest) Reset()         { *m = AddFieldRequest{} }
+func (m *AddFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFieldRequest) ProtoMessage()    {}
+func (*AddFieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{17}
+}
+
+func (m *AddFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFieldRequest.Unmarshal(m, b)
+}
+func (m *AddFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFieldRequest.Marshal(b, m, deterministic)
+}
+func (m *AddFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFieldRequest.Merge(m, src)
+}
+func (m *AddFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFieldRequest.Size(m)
+}
+func (m *AddFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddFieldRequest proto.InternalMessageInfo
+
+func (m *AddFieldRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AddFieldRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetDbID() int64 {
+	if m != nil {
+		return m.DbID
+	}
+	return 0
+}
+
+func (m *AddFieldRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *AddFieldRequest) GetField() *schemapb.FieldSchema {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
+func (m *AddFieldRequest) GetSchemaVersion() int64 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
 type CreateIndexRequest struct {
 	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -1004,7 +1091,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{17}
+	return fileDescriptor_41f4a519b878ee3b, []int{18}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1103,7 +1190,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{18}
+	return fileDescriptor_41f4a519b878ee3b, []int{19}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -1222,6 +1309,7 @@ type SearchRequest struct {
 	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
 	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ReplicaID            int64            `protobuf:"varint,13,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1231,7 +1319,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{19}
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -1336,6 +1424,13 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1359,7 +1454,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{20}
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -1465,25 +1560,28 @@ func (m *SearchResults) GetSlicedOffset() int64 {
 }
 
 type RetrieveRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	ResultChannelID      string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
-	DbID                 int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIDs         []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	SerializedExprPlan   []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base               *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ResultChannelID    string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
+	DbID               int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID       int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs       []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	SerializedExprPlan []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ReplicaID          int64             `protobuf:"varint,10,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	// max number of entities returned by each query node, sorted by primary key, 0 means no limit
+	Limit                int64    `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{21}
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -1567,6 +1665,20 @@ func (m *RetrieveRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *RetrieveRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1585,7 +1697,7 @@ func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -1673,6 +1785,8 @@ type DeleteRequest struct {
 	PartitionID          int64             `protobuf:"varint,8,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	PrimaryKeys          []int64           `protobuf:"varint,9,rep,packed,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
 	Timestamp            uint64            `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// primary keys of a collection with string primary field, instead of primary_keys
+	StringPrimaryKeys    []string          `protobuf:"bytes,11,rep,name=string_primary_keys,json=stringPrimaryKeys,proto3" json:"string_primary_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1682,7 +1796,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1773,6 +1887,13 @@ func (m *DeleteRequest) GetTimestamp() uint64 {
 	return 0
 }
 
+func (m *DeleteRequest) GetStringPrimaryKeys() []string {
+	if m != nil {
+		return m.StringPrimaryKeys
+	}
+	return nil
+}
+
 type LoadBalanceSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentIDs           []int64           `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
@@ -1785,7 +1906,7 @@ func (m *LoadBalanceSegmentsRequest) Reset()         { *m = LoadBalanceSegmentsR
 func (m *LoadBalanceSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceSegmentsRequest) ProtoMessage()    {}
 func (*LoadBalanceSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *LoadBalanceSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1836,7 +1957,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -1916,7 +2037,7 @@ func (m *SegmentStatisticsUpdates) Reset()         { *m = SegmentStatisticsUpdat
 func (m *SegmentStatisticsUpdates) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatisticsUpdates) ProtoMessage()    {}
 func (*SegmentStatisticsUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *SegmentStatisticsUpdates) XXX_Unmarshal(b []byte) error {
@@ -1998,7 +2119,7 @@ func (m *SegmentStatistics) Reset()         { *m = SegmentStatistics{} }
 func (m *SegmentStatistics) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatistics) ProtoMessage()    {}
 func (*SegmentStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *SegmentStatistics) XXX_Unmarshal(b []byte) error {
@@ -2045,7 +2166,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -2093,7 +2214,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -2149,7 +2270,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2211,7 +2332,7 @@ func (m *QueryNodeStats) Reset()         { *m = QueryNodeStats{} }
 func (m *QueryNodeStats) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeStats) ProtoMessage()    {}
 func (*QueryNodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{31}
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
 }
 
 func (m *QueryNodeStats) XXX_Unmarshal(b []byte) error {
@@ -2267,7 +2388,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{32}
+	return fileDescriptor_41f4a519b878ee3b, []int{33}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2330,7 +2451,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{33}
+	return fileDescriptor_41f4a519b878ee3b, []int{34}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2398,6 +2519,7 @@ func init() {
 	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.internal.CreateAliasRequest")
 	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.internal.DropAliasRequest")
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.internal.AlterAliasRequest")
+	proto.RegisterType((*AddFieldRequest)(nil), "milvus.proto.internal.AddFieldRequest")
 	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.internal.CreateIndexRequest")
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.internal.InsertRequest")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.internal.SearchRequest")
@@ -2420,132 +2542,138 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 2027 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5f, 0x6f, 0x1c, 0x49,
-	0x11, 0x67, 0x76, 0x76, 0xbd, 0xbb, 0xb5, 0x6b, 0x7b, 0xdd, 0x76, 0x72, 0x63, 0x27, 0x97, 0xf8,
-	0xe6, 0x0e, 0x30, 0x17, 0x11, 0x07, 0x1f, 0x70, 0x27, 0x84, 0xc8, 0xc5, 0xde, 0x23, 0xac, 0x72,
-	0x36, 0x66, 0x9c, 0x3b, 0x89, 0x7b, 0x19, 0xf5, 0xee, 0xb4, 0xd7, 0x43, 0xe6, 0xdf, 0x4d, 0xf7,
-	0x38, 0xd9, 0x7b, 0x42, 0x08, 0x5e, 0x40, 0x20, 0x81, 0xc4, 0xd7, 0xe0, 0x95, 0x27, 0xfe, 0x88,
-	0x27, 0xbe, 0x02, 0xcf, 0x7c, 0x09, 0xc4, 0x13, 0xea, 0xea, 0x9e, 0x3f, 0xbb, 0x5e, 0x3b, 0x8e,
-	0x23, 0xb8, 0x43, 0xca, 0xdb, 0x76, 0x55, 0x75, 0x4f, 0xd7, 0xaf, 0x7e, 0xd5, 0x55, 0xdd, 0x0b,
-	0x4b, 0x7e, 0x24, 0x58, 0x1a, 0xd1, 0xe0, 0x6e, 0x92, 0xc6, 0x22, 0x26, 0xd7, 0x42, 0x3f, 0x38,
-	0xcd, 0xb8, 0x1a, 0xdd, 0xcd, 0x95, 0x1b, 0xdd, 0x51, 0x1c, 0x86, 0x71, 0xa4, 0xc4, 0x1b, 0x5d,
-	0x3e, 0x3a, 0x61, 0x21, 0x55, 0x23, 0xfb, 0xcf, 0x06, 0x2c, 0xee, 0xc5, 0x61, 0x12, 0x47, 0x2c,
-	0x12, 0x83, 0xe8, 0x38, 0x26, 0xd7, 0x61, 0x21, 0x8a, 0x3d, 0x36, 0xe8, 0x5b, 0xc6, 0xa6, 0xb1,
-	0x65, 0x3a, 0x7a, 0x44, 0x08, 0xd4, 0xd3, 0x38, 0x60, 0x56, 0x6d, 0xd3, 0xd8, 0x6a, 0x3b, 0xf8,
-	0x9b, 0xdc, 0x07, 0xe0, 0x82, 0x0a, 0xe6, 0x8e, 0x62, 0x8f, 0x59, 0xe6, 0xa6, 0xb1, 0xb5, 0xb4,
-	0xb3, 0x79, 0x77, 0xee, 0x2e, 0xee, 0x1e, 0x49, 0xc3, 0xbd, 0xd8, 0x63, 0x4e, 0x9b, 0xe7, 0x3f,
-	0xc9, 0xfb, 0x00, 0xec, 0x99, 0x48, 0xa9, 0xeb, 0x47, 0xc7, 0xb1, 0x55, 0xdf, 0x34, 0xb7, 0x3a,
-	0x3b, 0x6f, 0x4c, 0x2f, 0xa0, 0x37, 0xff, 0x88, 0x4d, 0x3e, 0xa6, 0x41, 0xc6, 0x0e, 0xa9, 0x9f,
-	0x3a, 0x6d, 0x9c, 0x24, 0xb7, 0x6b, 0xff, 0xc3, 0x80, 0xe5, 0xc2, 0x01, 0xfc, 0x06, 0x27, 0xdf,
-	0x81, 0x06, 0x7e, 0x02, 0x3d, 0xe8, 0xec, 0xbc, 0x75, 0xce, 0x8e, 0xa6, 0xfc, 0x76, 0xd4, 0x14,
-	0xf2, 0x11, 0xac, 0xf2, 0x6c, 0x38, 0xca, 0x55, 0x2e, 0x4a, 0xb9, 0x55, 0xc3, 0xad, 0x5d, 0x6e,
-	0x25, 0x52, 0x5d, 0x40, 0x6f, 0xe9, 0x1d, 0x58, 0x90, 0x2b, 0x65, 0x1c, 0x51, 0xea, 0xec, 0xdc,
-	0x98, 0xeb, 0xe4, 0x11, 0x9a, 0x38, 0xda, 0xd4, 0xbe, 0x01, 0xeb, 0x0f, 0x99, 0x98, 0xf1, 0xce,
-	0x61, 0x9f, 0x66, 0x8c, 0x0b, 0xad, 0x7c, 0xec, 0x87, 0xec, 0xb1, 0x3f, 0x7a, 0xb2, 0x77, 0x42,
-	0xa3, 0x88, 0x05, 0xb9, 0xf2, 0x75, 0xb8, 0xf1, 0x90, 0xe1, 0x04, 0x9f, 0x0b, 0x7f, 0xc4, 0x67,
-	0xd4, 0xd7, 0x60, 0xf5, 0x21, 0x13, 0x7d, 0x6f, 0x46, 0xfc, 0x31, 0xb4, 0x0e, 0x64, 0xb0, 0x25,
-	0x0d, 0xbe, 0x0d, 0x4d, 0xea, 0x79, 0x29, 0xe3, 0x5c, 0xa3, 0x78, 0x73, 0xee, 0x8e, 0x1f, 0x28,
-	0x1b, 0x27, 0x37, 0x9e, 0x47, 0x13, 0xfb, 0x27, 0x00, 0x83, 0xc8, 0x17, 0x87, 0x34, 0xa5, 0x21,
-	0x3f, 0x97, 0x60, 0x7d, 0xe8, 0x72, 0x41, 0x53, 0xe1, 0x26, 0x68, 0xa7, 0x21, 0xbf, 0x04, 0x1b,
-	0x3a, 0x38, 0x4d, 0xad, 0x6e, 0xff, 0x18, 0xe0, 0x48, 0xa4, 0x7e, 0x34, 0xfe, 0xd0, 0xe7, 0x42,
-	0x7e, 0xeb, 0x54, 0xda, 0x49, 0x27, 0xcc, 0xad, 0xb6, 0xa3, 0x47, 0x95, 0x70, 0xd4, 0x2e, 0x1f,
-	0x8e, 0xfb, 0xd0, 0xc9, 0xe1, 0xde, 0xe7, 0x63, 0x72, 0x0f, 0xea, 0x43, 0xca, 0xd9, 0x85, 0xf0,
-	0xec, 0xf3, 0xf1, 0x2e, 0xe5, 0xcc, 0x41, 0x4b, 0xfb, 0x97, 0x26, 0xbc, 0xb6, 0x97, 0x32, 0x24,
-	0x7f, 0x10, 0xb0, 0x91, 0xf0, 0xe3, 0x48, 0x63, 0xff, 0xe2, 0xab, 0x91, 0xd7, 0xa0, 0xe9, 0x0d,
-	0xdd, 0x88, 0x86, 0x39, 0xd8, 0x0b, 0xde, 0xf0, 0x80, 0x86, 0x8c, 0x7c, 0x05, 0x96, 0x46, 0xc5,
-	0xfa, 0x52, 0x82, 0x9c, 0x6b, 0x3b, 0x33, 0x52, 0xf2, 0x16, 0x2c, 0x26, 0x34, 0x15, 0x7e, 0x61,
-	0x56, 0x47, 0xb3, 0x69, 0xa1, 0x0c, 0xa8, 0x37, 0x1c, 0xf4, 0xad, 0x06, 0x06, 0x0b, 0x7f, 0x13,
-	0x1b, 0xba, 0xe5, 0x5a, 0x83, 0xbe, 0xb5, 0x80, 0xba, 0x29, 0x19, 0xd9, 0x84, 0x4e, 0xb1, 0xd0,
-	0xa0, 0x6f, 0x35, 0xd1, 0xa4, 0x2a, 0x92, 0xc1, 0x51, 0x67, 0x91, 0xd5, 0xda, 0x34, 0xb6, 0xba,
-	0x8e, 0x1e, 0x91, 0x7b, 0xb0, 0x7a, 0xea, 0xa7, 0x22, 0xa3, 0x81, 0xe6, 0xa7, 0xdc, 0x07, 0xb7,
-	0xda, 0x18, 0xc1, 0x79, 0x2a, 0xb2, 0x03, 0x6b, 0xc9, 0xc9, 0x84, 0xfb, 0xa3, 0x99, 0x29, 0x80,
-	0x53, 0xe6, 0xea, 0xec, 0xbf, 0x19, 0x70, 0xad, 0x9f, 0xc6, 0xc9, 0x17, 0x22, 0x14, 0x39, 0xc8,
-	0xf5, 0x0b, 0x40, 0x6e, 0x9c, 0x05, 0xd9, 0xfe, 0x75, 0x0d, 0xae, 0x2b, 0x46, 0x1d, 0xe6, 0xc0,
-	0xfe, 0x17, 0xbc, 0xf8, 0x2a, 0x2c, 0x97, 0x5f, 0x55, 0x06, 0xf3, 0xdd, 0xf8, 0x32, 0x2c, 0x15,
-	0x01, 0x56, 0x76, 0xff, 0x5b, 0x4a, 0xd9, 0xbf, 0xaa, 0xc1, 0x9a, 0x0c, 0xea, 0x2b, 0x34, 0x24,
-	0x1a, 0xbf, 0x30, 0x80, 0x28, 0x76, 0x3c, 0x08, 0x7c, 0xca, 0xaf, 0x8e, 0xc5, 0x1c, 0x97, 0x6b,
-	0x73, 0x5d, 0x5e, 0x83, 0x06, 0x95, 0x9f, 0xd2, 0x88, 0xa8, 0x81, 0xfd, 0x09, 0xf4, 0x64, 0x50,
-	0x5e, 0x72, 0x13, 0xc5, 0xda, 0xb5, 0xea, 0xda, 0x3f, 0x37, 0x60, 0xe5, 0x41, 0x20, 0x58, 0xfa,
-	0xf9, 0xba, 0xf8, 0x97, 0x5a, 0x0e, 0xf5, 0x20, 0xf2, 0xd8, 0xb3, 0xcf, 0x93, 0x76, 0xaf, 0x03,
-	0x1c, 0xfb, 0x2c, 0xf0, 0xaa, 0x94, 0x6b, 0xa3, 0xe4, 0xa5, 0xe8, 0x66, 0x41, 0x13, 0x17, 0x29,
-	0xa8, 0x96, 0x0f, 0x65, 0xe1, 0x56, 0x4d, 0x9c, 0x2e, 0xdc, 0xad, 0x4b, 0x17, 0x6e, 0x9c, 0xa6,
-	0x0b, 0xf7, 0x1f, 0x4c, 0x58, 0x1c, 0x44, 0x9c, 0xa5, 0xe2, 0xea, 0xe0, 0xdd, 0x84, 0x36, 0x3f,
-	0xa1, 0x29, 0x3a, 0xaa, 0xe1, 0x2b, 0x05, 0x55, 0x68, 0xcd, 0xe7, 0x41, 0x5b, 0xbf, 0x64, 0x46,
-	0x37, 0x2e, 0xca, 0xe8, 0x85, 0x0b, 0x20, 0x6e, 0x3e, 0x3f, 0xa3, 0x5b, 0x67, 0x4b, 0xa6, 0x74,
-	0x90, 0x8d, 0x43, 0xd9, 0x69, 0xf6, 0xad, 0x36, 0xea, 0x4b, 0x01, 0xb9, 0x05, 0x20, 0xfc, 0x90,
-	0x71, 0x41, 0xc3, 0x44, 0x15, 0xbf, 0xba, 0x53, 0x91, 0xc8, 0x82, 0x9b, 0xc6, 0x4f, 0x07, 0x7d,
-	0x6e, 0x75, 0x36, 0x4d, 0xd9, 0x79, 0xa9, 0x11, 0xf9, 0x26, 0xb4, 0xd2, 0xf8, 0xa9, 0xeb, 0x51,
-	0x41, 0xad, 0x2e, 0x06, 0x6f, 0x7d, 0x2e, 0xd8, 0xbb, 0x41, 0x3c, 0x74, 0x9a, 0x69, 0xfc, 0xb4,
-	0x4f, 0x05, 0xb5, 0xff, 0x65, 0xc2, 0xe2, 0x11, 0xa3, 0xe9, 0xe8, 0xe4, 0xea, 0x01, 0xfb, 0x1a,
-	0xf4, 0x52, 0xc6, 0xb3, 0x40, 0xb8, 0x23, 0x55, 0x9b, 0x07, 0x7d, 0x1d, 0xb7, 0x65, 0x25, 0xdf,
-	0xcb, 0xc5, 0x05, 0xa8, 0xe6, 0x05, 0xa0, 0xd6, 0xe7, 0x80, 0x6a, 0x43, 0xb7, 0x82, 0x20, 0xb7,
-	0x1a, 0xe8, 0xfa, 0x94, 0x8c, 0xf4, 0xc0, 0xf4, 0x78, 0x80, 0xf1, 0x6a, 0x3b, 0xf2, 0x27, 0xb9,
-	0x03, 0x2b, 0x49, 0x40, 0x47, 0xec, 0x24, 0x0e, 0x3c, 0x96, 0xba, 0xe3, 0x34, 0xce, 0x12, 0x8c,
-	0x59, 0xd7, 0xe9, 0x55, 0x14, 0x0f, 0xa5, 0x9c, 0xbc, 0x0b, 0x2d, 0x8f, 0x07, 0xae, 0x98, 0x24,
-	0x0c, 0x83, 0xb6, 0x74, 0x8e, 0xef, 0x7d, 0x1e, 0x3c, 0x9e, 0x24, 0xcc, 0x69, 0x7a, 0xea, 0x07,
-	0xb9, 0x07, 0x6b, 0x9c, 0xa5, 0x3e, 0x0d, 0xfc, 0xcf, 0x98, 0xe7, 0xb2, 0x67, 0x49, 0xea, 0x26,
-	0x01, 0x8d, 0x30, 0xb2, 0x5d, 0x87, 0x94, 0xba, 0x0f, 0x9e, 0x25, 0xe9, 0x61, 0x40, 0x23, 0xb2,
-	0x05, 0xbd, 0x38, 0x13, 0x49, 0x26, 0x5c, 0xcc, 0x3e, 0xee, 0xfa, 0x1e, 0x06, 0xda, 0x74, 0x96,
-	0x94, 0xfc, 0xfb, 0x28, 0x1e, 0x78, 0x12, 0x5a, 0x91, 0xd2, 0x53, 0x16, 0xb8, 0x05, 0x03, 0xac,
-	0xce, 0xa6, 0xb1, 0x55, 0x77, 0x96, 0x95, 0xfc, 0x71, 0x2e, 0x26, 0xdb, 0xb0, 0x3a, 0xce, 0x68,
-	0x4a, 0x23, 0xc1, 0x58, 0xc5, 0xba, 0x8b, 0xd6, 0xa4, 0x50, 0x15, 0x13, 0xec, 0xdf, 0xd6, 0xcb,
-	0xd0, 0xcb, 0x28, 0xf1, 0x2b, 0x84, 0xfe, 0x2a, 0x2d, 0xf8, 0x5c, 0xbe, 0x98, 0xf3, 0xf9, 0x72,
-	0x1b, 0x3a, 0x21, 0x13, 0xa9, 0x3f, 0x52, 0x71, 0x51, 0x09, 0x0d, 0x4a, 0x84, 0xe0, 0xdf, 0x86,
-	0x4e, 0x94, 0x85, 0xee, 0xa7, 0x19, 0x4b, 0x7d, 0xc6, 0xf5, 0x79, 0x08, 0x51, 0x16, 0xfe, 0x48,
-	0x49, 0xc8, 0x2a, 0x34, 0x44, 0x9c, 0xb8, 0x4f, 0xf2, 0x3c, 0x16, 0x71, 0xf2, 0x88, 0x7c, 0x17,
-	0x36, 0x38, 0xa3, 0x01, 0xf3, 0xdc, 0x22, 0xef, 0xb8, 0xcb, 0x11, 0x0b, 0xe6, 0x59, 0x4d, 0x0c,
-	0x85, 0xa5, 0x2c, 0x8e, 0x0a, 0x83, 0x23, 0xad, 0x97, 0x48, 0x17, 0x1b, 0xaf, 0x4c, 0x6b, 0x61,
-	0x9f, 0x4a, 0x4a, 0x55, 0x31, 0xe1, 0x3d, 0xb0, 0xc6, 0x41, 0x3c, 0xa4, 0x81, 0x7b, 0xe6, 0xab,
-	0xd8, 0x10, 0x9b, 0xce, 0x75, 0xa5, 0x3f, 0x9a, 0xf9, 0xa4, 0x74, 0x8f, 0x07, 0xfe, 0x88, 0x79,
-	0xee, 0x30, 0x88, 0x87, 0x16, 0x20, 0xa5, 0x40, 0x89, 0x64, 0x22, 0x4b, 0x2a, 0x69, 0x03, 0x09,
-	0xc3, 0x28, 0xce, 0x22, 0x81, 0x04, 0x31, 0x9d, 0x25, 0x25, 0x3f, 0xc8, 0xc2, 0x3d, 0x29, 0x25,
-	0x6f, 0xc2, 0xa2, 0xb6, 0x8c, 0x8f, 0x8f, 0x39, 0x13, 0xc8, 0x0c, 0xd3, 0xe9, 0x2a, 0xe1, 0x0f,
-	0x51, 0x66, 0xff, 0xcc, 0x84, 0x65, 0x47, 0xa2, 0xcb, 0x4e, 0xd9, 0xff, 0xfd, 0x81, 0x70, 0x5e,
-	0x62, 0x2e, 0xbc, 0x50, 0x62, 0x36, 0x2f, 0x9d, 0x98, 0xad, 0x17, 0x4a, 0xcc, 0xf6, 0xb9, 0x89,
-	0xf9, 0xa7, 0xa9, 0x20, 0x7c, 0x51, 0x53, 0xf3, 0x6d, 0x30, 0x7d, 0x8f, 0x63, 0x70, 0x3a, 0x3b,
-	0xd6, 0xf4, 0xe2, 0xfa, 0x75, 0x6a, 0xd0, 0xe7, 0x8e, 0x34, 0x22, 0xf7, 0xa1, 0xa3, 0x01, 0xc5,
-	0xf2, 0xd4, 0xc0, 0xf2, 0x74, 0x6b, 0xee, 0x1c, 0x44, 0x58, 0x96, 0x26, 0x47, 0x35, 0x40, 0x5c,
-	0xfe, 0x26, 0xdf, 0x83, 0x1b, 0x67, 0x13, 0x36, 0xd5, 0x18, 0x79, 0xd6, 0x02, 0xc6, 0x68, 0x7d,
-	0x36, 0x63, 0x73, 0x10, 0x3d, 0xf2, 0x0d, 0x58, 0xab, 0xa4, 0x6c, 0x39, 0xb1, 0xa9, 0xae, 0xa3,
-	0xa5, 0xae, 0x9c, 0x72, 0x51, 0xd2, 0xb6, 0x2e, 0x4a, 0x5a, 0xfb, 0x9f, 0x35, 0x58, 0xec, 0xb3,
-	0x80, 0x09, 0xf6, 0xaa, 0x09, 0x3a, 0xb7, 0x09, 0x7a, 0x03, 0xba, 0x49, 0xea, 0x87, 0x34, 0x9d,
-	0xb8, 0x4f, 0xd8, 0x24, 0x3f, 0x07, 0x3b, 0x5a, 0xf6, 0x88, 0x4d, 0xb8, 0xc4, 0xa0, 0x4c, 0x17,
-	0xc0, 0x74, 0x29, 0x05, 0x76, 0x04, 0x1b, 0x1f, 0xc6, 0xd4, 0xdb, 0xa5, 0x01, 0x8d, 0x46, 0x4c,
-	0xc3, 0xff, 0x12, 0x77, 0x87, 0x5b, 0x00, 0x95, 0x08, 0xd7, 0x70, 0x3b, 0x15, 0x89, 0xfd, 0x6f,
-	0x03, 0xda, 0xf2, 0x83, 0x78, 0x35, 0xb8, 0x62, 0x44, 0x8b, 0xae, 0xaf, 0x36, 0xdb, 0xf5, 0xdd,
-	0x84, 0xb2, 0xbb, 0xd7, 0x31, 0xad, 0xb4, 0xfb, 0x95, 0xb6, 0xbd, 0x3e, 0xdd, 0xb6, 0xdf, 0x86,
-	0x8e, 0x2f, 0x37, 0xe4, 0x26, 0x54, 0x9c, 0xa8, 0x63, 0xb0, 0xed, 0x00, 0x8a, 0x0e, 0xa5, 0x44,
-	0xf6, 0xf5, 0xb9, 0x01, 0xf6, 0xf5, 0x0b, 0x97, 0xee, 0xeb, 0xf5, 0x22, 0xd8, 0xd7, 0xff, 0xb5,
-	0x06, 0x96, 0x86, 0xb8, 0x7c, 0x8f, 0xfc, 0x28, 0xf1, 0xf0, 0x59, 0xf4, 0x26, 0xb4, 0x0b, 0xf6,
-	0xeb, 0xe7, 0xc0, 0x52, 0x20, 0x71, 0xdd, 0x67, 0x61, 0x9c, 0x4e, 0x8e, 0xfc, 0xcf, 0x98, 0x76,
-	0xbc, 0x22, 0x91, 0xbe, 0x1d, 0x64, 0xa1, 0x13, 0x3f, 0xe5, 0xba, 0x08, 0xe4, 0x43, 0xe9, 0xdb,
-	0x08, 0x6f, 0x63, 0x78, 0x6a, 0xa2, 0xe7, 0x75, 0x07, 0x94, 0x48, 0x9e, 0x96, 0x64, 0x1d, 0x5a,
-	0x2c, 0xf2, 0x94, 0xb6, 0x81, 0xda, 0x26, 0x8b, 0x3c, 0x54, 0x0d, 0x60, 0x49, 0xbf, 0x43, 0xc6,
-	0x1c, 0x29, 0x87, 0x14, 0xee, 0xec, 0xd8, 0xe7, 0x3c, 0xfe, 0xee, 0xf3, 0xf1, 0xa1, 0xb6, 0x74,
-	0x16, 0xd5, 0x53, 0xa4, 0x1e, 0x92, 0x0f, 0xa0, 0x2b, 0xbf, 0x52, 0x2c, 0xd4, 0xbc, 0xf4, 0x42,
-	0x1d, 0x16, 0x79, 0xf9, 0xc0, 0xfe, 0x9d, 0x01, 0x2b, 0x67, 0x20, 0xbc, 0x02, 0x8f, 0x1e, 0x41,
-	0xeb, 0x88, 0x8d, 0xe5, 0x12, 0xf9, 0xeb, 0xea, 0xf6, 0x79, 0x8f, 0xf5, 0xe7, 0x04, 0xcc, 0x29,
-	0x16, 0x90, 0x17, 0x6f, 0x40, 0x42, 0xe3, 0xf0, 0x0c, 0x59, 0x8c, 0xab, 0x90, 0x45, 0xd6, 0x5d,
-	0xd9, 0x8c, 0xa4, 0x2c, 0xa0, 0xa2, 0x3c, 0x37, 0xb9, 0x8e, 0x3d, 0x89, 0xb2, 0xd0, 0x51, 0xaa,
-	0x3c, 0x69, 0xed, 0xdf, 0x18, 0x00, 0x78, 0xf0, 0xab, 0x6d, 0xcc, 0x9e, 0x30, 0xc6, 0xc5, 0x37,
-	0xd9, 0xda, 0x74, 0x4a, 0xec, 0xe6, 0x29, 0xc1, 0x11, 0x23, 0x73, 0x9e, 0x0f, 0x05, 0x46, 0xa5,
-	0xf3, 0x3a, 0x6b, 0x14, 0x2e, 0xbf, 0x37, 0xa0, 0x5b, 0x81, 0x8f, 0x4f, 0x67, 0xaf, 0x31, 0x9b,
-	0xbd, 0xd8, 0xa6, 0x4a, 0x46, 0xbb, 0xbc, 0x42, 0xf2, 0xb0, 0x24, 0xf9, 0x3a, 0xb4, 0x10, 0x92,
-	0x0a, 0xcb, 0x23, 0xcd, 0xf2, 0x3b, 0xb0, 0x92, 0xb2, 0x11, 0x8b, 0x44, 0x30, 0x71, 0xc3, 0xd8,
-	0xf3, 0x8f, 0x7d, 0xe6, 0x21, 0xd7, 0x5b, 0x4e, 0x2f, 0x57, 0xec, 0x6b, 0xb9, 0xfd, 0x77, 0x03,
-	0x96, 0x64, 0x67, 0x3b, 0x39, 0x88, 0x3d, 0xa6, 0x76, 0xf6, 0xe2, 0x0c, 0x7a, 0x1f, 0x7d, 0xd1,
-	0xf0, 0x28, 0x0a, 0xbd, 0xf9, 0x7c, 0x0a, 0x71, 0xa7, 0xc5, 0x35, 0x6d, 0x24, 0xc4, 0xea, 0x75,
-	0xe2, 0x32, 0x10, 0x97, 0x81, 0xd5, 0x25, 0x5d, 0x41, 0xfc, 0x53, 0x03, 0x3a, 0x95, 0x64, 0x91,
-	0x05, 0x41, 0x97, 0x61, 0x55, 0x8f, 0x0c, 0x3c, 0x04, 0x3b, 0xa3, 0xf2, 0xb9, 0x97, 0xac, 0x41,
-	0x23, 0xe4, 0x63, 0x1d, 0xf1, 0xae, 0xa3, 0x06, 0x64, 0x03, 0x5a, 0x21, 0x1f, 0xe3, 0x25, 0x4e,
-	0x9f, 0x9c, 0xc5, 0x78, 0xba, 0x84, 0xd4, 0x67, 0x4b, 0xc8, 0x1f, 0x0d, 0x20, 0xba, 0xa1, 0x79,
-	0xa9, 0xff, 0x04, 0x90, 0xb0, 0xd5, 0x27, 0xeb, 0x1a, 0x1e, 0xc3, 0x53, 0xb2, 0x99, 0x7b, 0xbd,
-	0x79, 0xe6, 0x5e, 0x7f, 0x07, 0x56, 0x3c, 0x76, 0x4c, 0x65, 0xef, 0x35, 0xbb, 0xe5, 0x9e, 0x56,
-	0x14, 0x2d, 0xe2, 0xdb, 0xef, 0x41, 0xbb, 0xf8, 0x2b, 0x8e, 0xf4, 0xa0, 0x3b, 0x88, 0x7c, 0x81,
-	0xcd, 0xac, 0x1f, 0x8d, 0x7b, 0x5f, 0x22, 0x1d, 0x68, 0xfe, 0x80, 0xd1, 0x40, 0x9c, 0x4c, 0x7a,
-	0x06, 0xe9, 0x42, 0xeb, 0xc1, 0x30, 0x8a, 0xd3, 0x90, 0x06, 0xbd, 0xda, 0xee, 0xbb, 0x9f, 0x7c,
-	0x6b, 0xec, 0x8b, 0x93, 0x6c, 0x28, 0x3d, 0xd9, 0x56, 0xae, 0x7d, 0xdd, 0x8f, 0xf5, 0xaf, 0xed,
-	0x3c, 0x6a, 0xdb, 0xe8, 0x6d, 0x31, 0x4c, 0x86, 0xc3, 0x05, 0x94, 0xbc, 0xf3, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xc8, 0x45, 0x1a, 0x6a, 0xb0, 0x1c, 0x00, 0x00,
+	// 2119 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0x67, 0x76, 0x56, 0xda, 0xdd, 0x37, 0x2b, 0x69, 0xd5, 0xb2, 0x9d, 0xf1, 0x47, 0x6c, 0x65,
+	0x12, 0x40, 0xc4, 0x85, 0x6d, 0x14, 0x48, 0x52, 0x14, 0x85, 0x63, 0x6b, 0x83, 0xd9, 0x72, 0x6c,
+	0xc4, 0xc8, 0x71, 0x15, 0xb9, 0x4c, 0xf5, 0xce, 0xb4, 0x56, 0x83, 0xe7, 0x2b, 0xd3, 0xbd, 0xb2,
+	0x37, 0x27, 0x0e, 0x70, 0x81, 0x82, 0x2a, 0xa0, 0xe0, 0xc4, 0xdf, 0xc0, 0x11, 0x4e, 0x7c, 0x14,
+	0x27, 0xfe, 0x05, 0xfe, 0x15, 0x4e, 0x54, 0xbf, 0xee, 0xf9, 0xd8, 0xd5, 0xae, 0xbc, 0x96, 0x0b,
+	0x1c, 0xaa, 0x72, 0x9b, 0x7e, 0xef, 0xf5, 0x9b, 0x7e, 0xbf, 0xf7, 0x7b, 0x3d, 0xaf, 0x7b, 0x60,
+	0x3d, 0x4c, 0x04, 0xcb, 0x13, 0x1a, 0xdd, 0xc8, 0xf2, 0x54, 0xa4, 0xe4, 0x7c, 0x1c, 0x46, 0xc7,
+	0x63, 0xae, 0x46, 0x37, 0x0a, 0xe5, 0xa5, 0xae, 0x9f, 0xc6, 0x71, 0x9a, 0x28, 0xf1, 0xa5, 0x2e,
+	0xf7, 0x8f, 0x58, 0x4c, 0xd5, 0xc8, 0xf9, 0xab, 0x01, 0x6b, 0x7b, 0x69, 0x9c, 0xa5, 0x09, 0x4b,
+	0xc4, 0x20, 0x39, 0x4c, 0xc9, 0x05, 0x58, 0x4d, 0xd2, 0x80, 0x0d, 0xfa, 0xb6, 0xb1, 0x6d, 0xec,
+	0x98, 0xae, 0x1e, 0x11, 0x02, 0xcd, 0x3c, 0x8d, 0x98, 0xdd, 0xd8, 0x36, 0x76, 0x3a, 0x2e, 0x3e,
+	0x93, 0xdb, 0x00, 0x5c, 0x50, 0xc1, 0x3c, 0x3f, 0x0d, 0x98, 0x6d, 0x6e, 0x1b, 0x3b, 0xeb, 0xbb,
+	0xdb, 0x37, 0xe6, 0xae, 0xe2, 0xc6, 0x81, 0x34, 0xdc, 0x4b, 0x03, 0xe6, 0x76, 0x78, 0xf1, 0x48,
+	0x3e, 0x00, 0x60, 0xcf, 0x44, 0x4e, 0xbd, 0x30, 0x39, 0x4c, 0xed, 0xe6, 0xb6, 0xb9, 0x63, 0xed,
+	0xbe, 0x31, 0xed, 0x40, 0x2f, 0xfe, 0x3e, 0x9b, 0x3c, 0xa6, 0xd1, 0x98, 0xed, 0xd3, 0x30, 0x77,
+	0x3b, 0x38, 0x49, 0x2e, 0xd7, 0xf9, 0x97, 0x01, 0x1b, 0x65, 0x00, 0xf8, 0x0e, 0x4e, 0xbe, 0x0d,
+	0x2b, 0xf8, 0x0a, 0x8c, 0xc0, 0xda, 0x7d, 0x6b, 0xc1, 0x8a, 0xa6, 0xe2, 0x76, 0xd5, 0x14, 0xf2,
+	0x31, 0x6c, 0xf1, 0xf1, 0xd0, 0x2f, 0x54, 0x1e, 0x4a, 0xb9, 0xdd, 0xd8, 0x36, 0x97, 0xf6, 0x44,
+	0xea, 0x0e, 0xf4, 0x92, 0xde, 0x81, 0x55, 0xe9, 0x69, 0xcc, 0x11, 0x25, 0x6b, 0xf7, 0xf2, 0xdc,
+	0x20, 0x0f, 0xd0, 0xc4, 0xd5, 0xa6, 0xce, 0x65, 0xb8, 0x78, 0x8f, 0x89, 0x99, 0xe8, 0x5c, 0xf6,
+	0xe9, 0x98, 0x71, 0xa1, 0x95, 0x8f, 0xc2, 0x98, 0x3d, 0x0a, 0xfd, 0x27, 0x7b, 0x47, 0x34, 0x49,
+	0x58, 0x54, 0x28, 0x5f, 0x87, 0xcb, 0xf7, 0x18, 0x4e, 0x08, 0xb9, 0x08, 0x7d, 0x3e, 0xa3, 0x3e,
+	0x0f, 0x5b, 0xf7, 0x98, 0xe8, 0x07, 0x33, 0xe2, 0xc7, 0xd0, 0x7e, 0x28, 0x93, 0x2d, 0x69, 0xf0,
+	0x2e, 0xb4, 0x68, 0x10, 0xe4, 0x8c, 0x73, 0x8d, 0xe2, 0x95, 0xb9, 0x2b, 0xbe, 0xa3, 0x6c, 0xdc,
+	0xc2, 0x78, 0x1e, 0x4d, 0x9c, 0x1f, 0x03, 0x0c, 0x92, 0x50, 0xec, 0xd3, 0x9c, 0xc6, 0x7c, 0x21,
+	0xc1, 0xfa, 0xd0, 0xe5, 0x82, 0xe6, 0xc2, 0xcb, 0xd0, 0xce, 0x6e, 0x2c, 0xcb, 0x06, 0x0b, 0xa7,
+	0x29, 0xef, 0xce, 0x8f, 0x00, 0x0e, 0x44, 0x1e, 0x26, 0xa3, 0x8f, 0x42, 0x2e, 0xe4, 0xbb, 0x8e,
+	0xa5, 0x9d, 0x0c, 0xc2, 0xdc, 0xe9, 0xb8, 0x7a, 0x54, 0x4b, 0x47, 0x63, 0xf9, 0x74, 0xdc, 0x06,
+	0xab, 0x80, 0xfb, 0x01, 0x1f, 0x91, 0x5b, 0xd0, 0x1c, 0x52, 0xce, 0x4e, 0x85, 0xe7, 0x01, 0x1f,
+	0xdd, 0xa5, 0x9c, 0xb9, 0x68, 0xe9, 0xfc, 0xdc, 0x84, 0xd7, 0xf6, 0x72, 0x86, 0xe4, 0x8f, 0x22,
+	0xe6, 0x8b, 0x30, 0x4d, 0x34, 0xf6, 0x2f, 0xee, 0x8d, 0xbc, 0x06, 0xad, 0x60, 0xe8, 0x25, 0x34,
+	0x2e, 0xc0, 0x5e, 0x0d, 0x86, 0x0f, 0x69, 0xcc, 0xc8, 0x57, 0x60, 0xdd, 0x2f, 0xfd, 0x4b, 0x09,
+	0x72, 0xae, 0xe3, 0xce, 0x48, 0xc9, 0x5b, 0xb0, 0x96, 0xd1, 0x5c, 0x84, 0xa5, 0x59, 0x13, 0xcd,
+	0xa6, 0x85, 0x32, 0xa1, 0xc1, 0x70, 0xd0, 0xb7, 0x57, 0x30, 0x59, 0xf8, 0x4c, 0x1c, 0xe8, 0x56,
+	0xbe, 0x06, 0x7d, 0x7b, 0x15, 0x75, 0x53, 0x32, 0xb2, 0x0d, 0x56, 0xe9, 0x68, 0xd0, 0xb7, 0x5b,
+	0x68, 0x52, 0x17, 0xc9, 0xe4, 0xa8, 0xbd, 0xc8, 0x6e, 0x6f, 0x1b, 0x3b, 0x5d, 0x57, 0x8f, 0xc8,
+	0x2d, 0xd8, 0x3a, 0x0e, 0x73, 0x31, 0xa6, 0x91, 0xe6, 0xa7, 0x5c, 0x07, 0xb7, 0x3b, 0x98, 0xc1,
+	0x79, 0x2a, 0xb2, 0x0b, 0xe7, 0xb2, 0xa3, 0x09, 0x0f, 0xfd, 0x99, 0x29, 0x80, 0x53, 0xe6, 0xea,
+	0x9c, 0x7f, 0x18, 0x70, 0xbe, 0x9f, 0xa7, 0xd9, 0xe7, 0x22, 0x15, 0x05, 0xc8, 0xcd, 0x53, 0x40,
+	0x5e, 0x39, 0x09, 0xb2, 0xf3, 0xcb, 0x06, 0x5c, 0x50, 0x8c, 0xda, 0x2f, 0x80, 0xfd, 0x2f, 0x44,
+	0xf1, 0x55, 0xd8, 0xa8, 0xde, 0xea, 0x25, 0x8b, 0xc3, 0xf8, 0x32, 0xac, 0x97, 0x09, 0x56, 0x76,
+	0xff, 0x5b, 0x4a, 0x39, 0xbf, 0x68, 0xc0, 0x39, 0x99, 0xd4, 0x2f, 0xd0, 0x90, 0x68, 0xfc, 0xcc,
+	0x00, 0xa2, 0xd8, 0x71, 0x27, 0x0a, 0x29, 0x3f, 0x3b, 0x16, 0x73, 0x42, 0x6e, 0xcc, 0x0d, 0xf9,
+	0x1c, 0xac, 0x50, 0xf9, 0x2a, 0x8d, 0x88, 0x1a, 0x38, 0x9f, 0x40, 0x4f, 0x26, 0xe5, 0x25, 0x17,
+	0x51, 0xfa, 0x6e, 0xd4, 0x7d, 0xff, 0xd4, 0x80, 0xcd, 0x3b, 0x91, 0x60, 0xf9, 0xab, 0x0d, 0xf1,
+	0x0f, 0x0d, 0xd8, 0xb8, 0x13, 0x04, 0xdf, 0x0b, 0x59, 0x14, 0xbc, 0x4a, 0xce, 0x9d, 0x71, 0x23,
+	0x21, 0xef, 0xc2, 0xca, 0xa1, 0x5c, 0x3b, 0x32, 0xcd, 0x9a, 0x6d, 0xe2, 0x74, 0xcb, 0x88, 0xd1,
+	0x1d, 0xe0, 0xb3, 0xab, 0xcc, 0x25, 0xc7, 0x95, 0xd2, 0x3b, 0x66, 0x39, 0x0f, 0xd3, 0x44, 0xf3,
+	0x70, 0x4d, 0x49, 0x1f, 0x2b, 0xa1, 0xf3, 0xb7, 0x46, 0xc1, 0xc4, 0x41, 0x12, 0xb0, 0x67, 0xaf,
+	0x12, 0xa1, 0xd7, 0x01, 0x70, 0xe9, 0xf5, 0x8a, 0xec, 0xa0, 0xe4, 0xa5, 0xaa, 0xd1, 0x86, 0x16,
+	0x3a, 0x29, 0x2b, 0xb1, 0x18, 0xca, 0xbe, 0x46, 0xf5, 0xb8, 0xba, 0xaf, 0x69, 0x2f, 0xdd, 0xd7,
+	0xe0, 0x34, 0xdd, 0xd7, 0xfc, 0xd1, 0x84, 0xb5, 0x41, 0xc2, 0x59, 0x2e, 0xce, 0x0e, 0xde, 0x15,
+	0xe8, 0xf0, 0x23, 0x9a, 0x07, 0x0f, 0x2b, 0xf8, 0x2a, 0x41, 0x1d, 0x5a, 0xf3, 0x79, 0xd0, 0x36,
+	0x97, 0xdc, 0xf0, 0x56, 0x4e, 0xdb, 0xf0, 0x56, 0x4f, 0x81, 0xb8, 0xf5, 0xfc, 0x0d, 0xaf, 0x7d,
+	0xb2, 0xa3, 0x90, 0x01, 0xb2, 0x51, 0x2c, 0x1b, 0xf1, 0xbe, 0xdd, 0x41, 0x7d, 0x25, 0x20, 0x57,
+	0x01, 0x44, 0x18, 0x33, 0x2e, 0x68, 0x9c, 0xa9, 0xde, 0xa0, 0xe9, 0xd6, 0x24, 0xb2, 0x1f, 0xc9,
+	0xd3, 0xa7, 0x83, 0x3e, 0xb7, 0xad, 0x6d, 0x53, 0x36, 0xa6, 0x6a, 0x44, 0xbe, 0x09, 0xed, 0x3c,
+	0x7d, 0xea, 0x05, 0x54, 0x50, 0xbb, 0x8b, 0xc9, 0xbb, 0x38, 0x17, 0xec, 0xbb, 0x51, 0x3a, 0x74,
+	0x5b, 0x79, 0xfa, 0xb4, 0x4f, 0x05, 0x75, 0x7e, 0xdf, 0x84, 0xb5, 0x03, 0x46, 0x73, 0xff, 0xe8,
+	0xec, 0x09, 0xfb, 0x1a, 0xf4, 0x72, 0xc6, 0xc7, 0x91, 0xf0, 0x7c, 0xd5, 0xba, 0x0c, 0xfa, 0x3a,
+	0x6f, 0x1b, 0x4a, 0xbe, 0x57, 0x88, 0x4b, 0x50, 0xcd, 0x53, 0x40, 0x6d, 0xce, 0x01, 0xd5, 0x81,
+	0x6e, 0x0d, 0x41, 0x6e, 0xaf, 0x60, 0xe8, 0x53, 0x32, 0xd2, 0x03, 0x33, 0xe0, 0x11, 0xe6, 0xab,
+	0xe3, 0xca, 0x47, 0x72, 0x1d, 0x36, 0xb3, 0x88, 0xfa, 0xec, 0x28, 0x8d, 0x02, 0x96, 0x7b, 0xa3,
+	0x3c, 0x1d, 0x67, 0x98, 0xb3, 0xae, 0xdb, 0xab, 0x29, 0xee, 0x49, 0x39, 0x79, 0x0f, 0xda, 0x01,
+	0x8f, 0x3c, 0x31, 0xc9, 0x18, 0x26, 0x6d, 0x7d, 0x41, 0xec, 0x7d, 0x1e, 0x3d, 0x9a, 0x64, 0xcc,
+	0x6d, 0x05, 0xea, 0x81, 0xdc, 0x82, 0x73, 0x9c, 0xe5, 0x21, 0x8d, 0xc2, 0xcf, 0x58, 0xe0, 0xb1,
+	0x67, 0x59, 0xee, 0x65, 0x11, 0x4d, 0x30, 0xb3, 0x5d, 0x97, 0x54, 0xba, 0x0f, 0x9f, 0x65, 0xf9,
+	0x7e, 0x44, 0x13, 0xb2, 0x03, 0xbd, 0x74, 0x2c, 0xb2, 0xb1, 0xf0, 0xb0, 0xfa, 0xb8, 0x17, 0x06,
+	0x98, 0x68, 0xd3, 0x5d, 0x57, 0x72, 0xdc, 0xc2, 0xf8, 0x20, 0x90, 0xd0, 0x8a, 0x9c, 0x1e, 0xb3,
+	0xc8, 0x2b, 0x19, 0x60, 0x5b, 0xdb, 0xc6, 0x4e, 0xd3, 0xdd, 0x50, 0xf2, 0x47, 0x85, 0x98, 0xdc,
+	0x84, 0xad, 0xd1, 0x98, 0xe6, 0x34, 0x11, 0x8c, 0xd5, 0xac, 0xbb, 0x68, 0x4d, 0x4a, 0x55, 0x35,
+	0xe1, 0x0a, 0x74, 0x72, 0x96, 0x45, 0xa1, 0x4f, 0x07, 0x7d, 0x7b, 0x4d, 0xd1, 0xb0, 0x14, 0x38,
+	0xbf, 0xae, 0x11, 0x43, 0xe6, 0x90, 0x9f, 0x81, 0x18, 0x67, 0x39, 0xbf, 0xcc, 0x65, 0x93, 0x39,
+	0x9f, 0x4d, 0xd7, 0xc0, 0x8a, 0x99, 0xc8, 0x43, 0x5f, 0x65, 0x4d, 0x95, 0x3b, 0x28, 0x11, 0xa6,
+	0xe6, 0x1a, 0x58, 0xc9, 0x38, 0xf6, 0x3e, 0x1d, 0xb3, 0x3c, 0x64, 0x5c, 0xef, 0x96, 0x90, 0x8c,
+	0xe3, 0x1f, 0x2a, 0x09, 0xd9, 0x82, 0x15, 0x91, 0x66, 0xde, 0x93, 0xa2, 0xca, 0x45, 0x9a, 0xdd,
+	0x27, 0xdf, 0x81, 0x4b, 0x9c, 0xd1, 0x88, 0x05, 0x5e, 0x59, 0x95, 0xdc, 0xe3, 0x88, 0x05, 0x0b,
+	0xec, 0x16, 0x26, 0xca, 0x56, 0x16, 0x07, 0xa5, 0xc1, 0x81, 0xd6, 0xcb, 0x3c, 0x94, 0x0b, 0xaf,
+	0x4d, 0x6b, 0x63, 0x93, 0x4f, 0x2a, 0x55, 0x39, 0xe1, 0x7d, 0xb0, 0x47, 0x51, 0x3a, 0xa4, 0x91,
+	0x77, 0xe2, 0xad, 0x78, 0x9a, 0x30, 0xdd, 0x0b, 0x4a, 0x7f, 0x30, 0xf3, 0x4a, 0x19, 0x1e, 0x8f,
+	0x42, 0x9f, 0x05, 0xde, 0x30, 0x4a, 0x87, 0x36, 0x20, 0xe1, 0x40, 0x89, 0x64, 0x99, 0x4b, 0xa2,
+	0x69, 0x03, 0x09, 0x83, 0x9f, 0x8e, 0x13, 0x81, 0xf4, 0x31, 0xdd, 0x75, 0x25, 0x7f, 0x38, 0x8e,
+	0xf7, 0xa4, 0x94, 0xbc, 0x09, 0x6b, 0xda, 0x32, 0x3d, 0x3c, 0xe4, 0x4c, 0x20, 0x6f, 0x4c, 0xb7,
+	0xab, 0x84, 0x3f, 0x40, 0x99, 0xf3, 0x27, 0x13, 0x36, 0x5c, 0x89, 0x2e, 0x3b, 0x66, 0xff, 0xf7,
+	0xdb, 0xc5, 0xa2, 0xb2, 0x5d, 0x7d, 0xa1, 0xb2, 0x6d, 0x2d, 0x5d, 0xb6, 0xed, 0x17, 0x2a, 0xdb,
+	0xce, 0x72, 0x65, 0x0b, 0x33, 0x65, 0x2b, 0xfb, 0xbe, 0x28, 0x8c, 0xc3, 0x22, 0xcd, 0x6a, 0xe0,
+	0xfc, 0x65, 0x2a, 0x71, 0x9f, 0xd7, 0x72, 0x7e, 0x1b, 0xcc, 0x30, 0xe0, 0x98, 0x50, 0x6b, 0xd7,
+	0x9e, 0xdb, 0xdb, 0x0d, 0xfa, 0xdc, 0x95, 0x46, 0xe4, 0x36, 0x58, 0x3a, 0x09, 0xf8, 0xc1, 0x5b,
+	0xc1, 0x0f, 0xde, 0xd5, 0xc5, 0xfd, 0xa0, 0xfc, 0xd8, 0xb9, 0xaa, 0xa5, 0xe2, 0xf2, 0x99, 0x7c,
+	0x17, 0x2e, 0x9f, 0x2c, 0xf2, 0x5c, 0x63, 0x24, 0x1b, 0x4c, 0x99, 0xd7, 0x8b, 0xb3, 0x55, 0x5e,
+	0x80, 0x18, 0x90, 0x6f, 0xc0, 0xb9, 0x5a, 0x99, 0x57, 0x13, 0x5b, 0xea, 0xfc, 0x5f, 0xe9, 0xaa,
+	0x29, 0xa7, 0x15, 0x7a, 0xfb, 0xb4, 0x42, 0x77, 0x7e, 0x6b, 0xc2, 0x5a, 0x9f, 0x45, 0x4c, 0xb0,
+	0x2f, 0xda, 0xaa, 0x85, 0x6d, 0xd5, 0x1b, 0xd0, 0xcd, 0xf2, 0x30, 0xa6, 0xf9, 0xc4, 0x7b, 0xc2,
+	0x26, 0xc5, 0xde, 0x69, 0x69, 0xd9, 0x7d, 0x36, 0xe1, 0x12, 0x83, 0xaa, 0xc4, 0x00, 0x4b, 0xac,
+	0x12, 0x90, 0x1b, 0xb0, 0xc5, 0xf1, 0x52, 0xce, 0x9b, 0xf2, 0x63, 0x61, 0x46, 0x37, 0x95, 0x6a,
+	0xbf, 0xf2, 0xe6, 0x24, 0x70, 0xe9, 0xa3, 0x94, 0x06, 0x77, 0x69, 0x44, 0x13, 0x9f, 0xe9, 0x74,
+	0xbd, 0xc4, 0xe1, 0xee, 0x2a, 0x40, 0x8d, 0x11, 0x0d, 0x5c, 0x7e, 0x4d, 0xe2, 0xfc, 0xdb, 0x80,
+	0x8e, 0x7c, 0x21, 0x1e, 0x4e, 0xce, 0xc8, 0x80, 0xb2, 0xef, 0x6c, 0xcc, 0xf6, 0x9d, 0x57, 0xa0,
+	0x3a, 0x5f, 0x68, 0x0e, 0x54, 0x82, 0xfa, 0xc1, 0xa1, 0x39, 0x7d, 0x70, 0xb8, 0x06, 0x56, 0x28,
+	0x17, 0xe4, 0x65, 0x54, 0x1c, 0xa9, 0xad, 0xb6, 0xe3, 0x02, 0x8a, 0xf6, 0xa5, 0x44, 0x9e, 0x2c,
+	0x0a, 0x03, 0x3c, 0x59, 0xac, 0x2e, 0x7d, 0xb2, 0xd0, 0x4e, 0xf0, 0x64, 0xf1, 0xf7, 0x06, 0xd8,
+	0x1a, 0xe2, 0xea, 0xc2, 0xf8, 0xe3, 0x2c, 0xc0, 0x7b, 0xeb, 0x2b, 0xd0, 0x29, 0xab, 0x45, 0xdf,
+	0xd7, 0x56, 0x02, 0x89, 0xeb, 0x03, 0x16, 0xa7, 0xf9, 0xe4, 0x20, 0xfc, 0x8c, 0xe9, 0xc0, 0x6b,
+	0x12, 0x19, 0xdb, 0xc3, 0x71, 0xec, 0xa6, 0x4f, 0xb9, 0xfe, 0xd0, 0x14, 0x43, 0x19, 0x9b, 0x8f,
+	0xe7, 0x41, 0xdc, 0x99, 0x31, 0xf2, 0xa6, 0x0b, 0x4a, 0x24, 0x77, 0x64, 0x72, 0x11, 0xda, 0x2c,
+	0x09, 0x94, 0x76, 0x05, 0xb5, 0x2d, 0x96, 0x04, 0xa8, 0x1a, 0xc0, 0xba, 0xbe, 0x28, 0x4e, 0x39,
+	0x52, 0x54, 0x1f, 0x5a, 0x9d, 0x05, 0xb7, 0xf3, 0x0f, 0xf8, 0x68, 0x5f, 0x5b, 0xba, 0x6b, 0xea,
+	0xae, 0x58, 0x0f, 0xc9, 0x87, 0xd0, 0x95, 0x6f, 0x29, 0x1d, 0xb5, 0x96, 0x76, 0x64, 0xb1, 0x24,
+	0x28, 0x06, 0xce, 0x6f, 0x0c, 0xd8, 0x3c, 0x01, 0xe1, 0x19, 0x78, 0x74, 0x1f, 0xda, 0x07, 0x6c,
+	0x24, 0x5d, 0x14, 0xd7, 0xdf, 0x37, 0x17, 0xfd, 0x4d, 0x59, 0x90, 0x30, 0xb7, 0x74, 0x20, 0x6f,
+	0x46, 0x00, 0x09, 0x8d, 0xc3, 0x13, 0x64, 0x31, 0xce, 0x42, 0x16, 0xf9, 0x6d, 0x97, 0x0d, 0x4f,
+	0xce, 0x22, 0x2a, 0xaa, 0x7d, 0x96, 0xeb, 0xdc, 0x93, 0x64, 0x1c, 0xbb, 0x4a, 0x55, 0x14, 0xad,
+	0xf3, 0x2b, 0x03, 0x40, 0x5d, 0x1c, 0xe0, 0x32, 0x66, 0x77, 0x24, 0xe3, 0xf4, 0xb3, 0x74, 0x63,
+	0xba, 0x24, 0xee, 0x16, 0x25, 0xc1, 0x11, 0x23, 0x73, 0x5e, 0x0c, 0x25, 0x46, 0x55, 0xf0, 0xba,
+	0x6a, 0x14, 0x2e, 0xbf, 0x33, 0xa0, 0x5b, 0x83, 0x8f, 0x4f, 0x57, 0xaf, 0x31, 0x5b, 0xbd, 0xd8,
+	0x0a, 0x4b, 0x46, 0x7b, 0xbc, 0x46, 0xf2, 0xb8, 0x22, 0xf9, 0x45, 0x68, 0x23, 0x24, 0x35, 0x96,
+	0x27, 0x9a, 0xe5, 0xd7, 0x61, 0x33, 0x67, 0x3e, 0x4b, 0x44, 0x34, 0xf1, 0xe2, 0x34, 0x08, 0x0f,
+	0x43, 0x16, 0x20, 0xd7, 0xdb, 0x6e, 0xaf, 0x50, 0x3c, 0xd0, 0x72, 0xe7, 0x9f, 0x06, 0xac, 0xcb,
+	0xee, 0x79, 0x22, 0xff, 0xc1, 0xa8, 0x95, 0xbd, 0x38, 0x83, 0x3e, 0xc0, 0x58, 0x3c, 0x5e, 0xa3,
+	0xd0, 0x9b, 0xcf, 0xa7, 0x10, 0x77, 0xdb, 0x5c, 0xd3, 0x46, 0x42, 0xac, 0xee, 0x47, 0x96, 0x81,
+	0xb8, 0x4a, 0xac, 0x6e, 0x01, 0x14, 0xc4, 0x3f, 0x31, 0xc0, 0xaa, 0x15, 0x8b, 0xfc, 0x80, 0xe8,
+	0xcf, 0xb6, 0xfa, 0x7e, 0x19, 0xb8, 0x09, 0x5a, 0x7e, 0x75, 0x1f, 0x2f, 0xdb, 0xab, 0x98, 0x8f,
+	0x74, 0xc6, 0xbb, 0xae, 0x1a, 0x90, 0x4b, 0xd0, 0x8e, 0xf9, 0x08, 0x8f, 0x91, 0x7a, 0xe7, 0x2c,
+	0xc7, 0xd3, 0x9f, 0x9c, 0xe6, 0xcc, 0x27, 0xc7, 0xf9, 0xb3, 0xbc, 0xfb, 0x54, 0xfe, 0x5f, 0xea,
+	0xa7, 0x0d, 0x12, 0xb6, 0xfe, 0x4f, 0xa1, 0x81, 0xdb, 0xf0, 0x94, 0x6c, 0xe6, 0x66, 0xc1, 0x3c,
+	0x71, 0xb3, 0x70, 0x1d, 0x36, 0x03, 0x76, 0x48, 0x65, 0xaf, 0x36, 0xbb, 0xe4, 0x9e, 0x56, 0x94,
+	0x6d, 0xe8, 0xdb, 0xef, 0x43, 0xa7, 0xfc, 0x57, 0x4a, 0x7a, 0xd0, 0x95, 0xbf, 0xce, 0xb0, 0x61,
+	0x0e, 0x93, 0x51, 0xef, 0x4b, 0xc4, 0x82, 0xd6, 0xf7, 0x19, 0x8d, 0xc4, 0xd1, 0xa4, 0x67, 0x90,
+	0x2e, 0xb4, 0xef, 0x0c, 0x93, 0x34, 0x8f, 0x69, 0xd4, 0x6b, 0xdc, 0x7d, 0xef, 0x93, 0x6f, 0x8d,
+	0x42, 0x71, 0x34, 0x1e, 0xca, 0x48, 0x6e, 0xaa, 0xd0, 0xbe, 0x1e, 0xa6, 0xfa, 0xe9, 0x66, 0x91,
+	0xb5, 0x9b, 0x18, 0x6d, 0x39, 0xcc, 0x86, 0xc3, 0x55, 0x94, 0xbc, 0xf3, 0x9f, 0x01, 0x00, 0x82,
+	0x32, 0xe5, 0xd4, 0x51, 0x1e, 0x00, 0x00,
 }
@@ -26,7 +26,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-//
 // This is for ShowCollectionsRequest type field.
 type ShowType int32
 
@@ -87,6 +86,7 @@ type CreateAliasRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionName       string            `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	Alias                string            `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	DbName               string            `protobuf:"bytes,4,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -138,9 +138,17 @@ func (m *CreateAliasRequest) GetAlias() string {
 	return ""
 }
 
+func (m *CreateAliasRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
 type DropAliasRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Alias                string            `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	DbName               string            `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -185,10 +193,18 @@ func (m *DropAliasRequest) GetAlias() string {
 	return ""
 }
 
+func (m *DropAliasRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
 type AlterAliasRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionName       string            `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	Alias                string            `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	DbName               string            `protobuf:"bytes,4,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -240,4701 +256,6991 @@ func (m *AlterAliasRequest) GetAlias() string {
 	return ""
 }
 
-//*
-// Create collection in milvus
-type CreateCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The unique collection name in milvus.(Required)
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The serialized `schema.CollectionSchema`(Required)
-	Schema []byte `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
-	// Once set, no modification is allowed (Optional)
-	// https://github.com/milvus-io/milvus/issues/6690
-	ShardsNum            int32    `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AlterAliasRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
-func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateCollectionRequest) ProtoMessage()    {}
-func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+type AddFieldRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Field                *schemapb.FieldSchema `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AddFieldRequest) Reset()         { *m = AddFieldRequest{} }
+func (m *AddFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFieldRequest) ProtoMessage()    {}
+func (*AddFieldRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{3}
 }
 
-func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCollectionRequest.Unmarshal(m, b)
+func (m *AddFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFieldRequest.Unmarshal(m, b)
 }
-func (m *CreateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCollectionRequest.Marshal(b, m, deterministic)
+func (m *AddFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFieldRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCollectionRequest.Merge(m, src)
+func (m *AddFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFieldRequest.Merge(m, src)
 }
-func (m *CreateCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateCollectionRequest.Size(m)
+func (m *AddFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFieldRequest.Size(m)
 }
-func (m *CreateCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCollectionRequest.DiscardUnknown(m)
+func (m *AddFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFieldRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddFieldRequest proto.InternalMessageInfo
 
-func (m *CreateCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *AddFieldRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreateCollectionRequest) GetDbName() string {
+func (m *AddFieldRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreateCollectionRequest) GetCollectionName() string {
+func (m *AddFieldRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *CreateCollectionRequest) GetSchema() []byte {
+func (m *AddFieldRequest) GetField() *schemapb.FieldSchema {
 	if m != nil {
-		return m.Schema
+		return m.Field
 	}
 	return nil
 }
 
-func (m *CreateCollectionRequest) GetShardsNum() int32 {
-	if m != nil {
-		return m.ShardsNum
-	}
-	return 0
-}
-
-//*
-// Drop collection in milvus, also will drop data in collection.
-type DropCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The unique collection name in milvus.(Required)
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Create a database, collections and aliases in different databases are isolated
+type CreateDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
-func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*DropCollectionRequest) ProtoMessage()    {}
-func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{4}
 }
 
-func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropCollectionRequest.Unmarshal(m, b)
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
 }
-func (m *DropCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropCollectionRequest.Marshal(b, m, deterministic)
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *DropCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropCollectionRequest.Merge(m, src)
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
 }
-func (m *DropCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_DropCollectionRequest.Size(m)
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
 }
-func (m *DropCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropCollectionRequest.DiscardUnknown(m)
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
 
-func (m *DropCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropCollectionRequest) GetDbName() string {
+func (m *CreateDatabaseRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropCollectionRequest) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
-}
-
-//*
-// Check collection exist in milvus or not.
-type HasCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to check.
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// Not useful for now
-	TimeStamp            uint64   `protobuf:"varint,4,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Drop an empty database, the default database can't be dropped
+type DropDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
-func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*HasCollectionRequest) ProtoMessage()    {}
-func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{5}
 }
 
-func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasCollectionRequest.Unmarshal(m, b)
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
 }
-func (m *HasCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasCollectionRequest.Marshal(b, m, deterministic)
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *HasCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasCollectionRequest.Merge(m, src)
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
 }
-func (m *HasCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_HasCollectionRequest.Size(m)
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
 }
-func (m *HasCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasCollectionRequest.DiscardUnknown(m)
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
 
-func (m *HasCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HasCollectionRequest) GetDbName() string {
+func (m *DropDatabaseRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *HasCollectionRequest) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
+type ListDatabasesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *HasCollectionRequest) GetTimeStamp() uint64 {
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{6}
+}
+
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
+}
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
+}
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
+}
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
+
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.TimeStamp
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-type BoolResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Value                bool             `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ListDatabasesResponse struct {
+	Status  *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbNames []string         `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	// Hybrid timestamps in milvus
+	CreatedTimestamps    []uint64 `protobuf:"varint,3,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
-func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
-func (*BoolResponse) ProtoMessage()    {}
-func (*BoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{6}
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{7}
 }
 
-func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BoolResponse.Unmarshal(m, b)
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
 }
-func (m *BoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BoolResponse.Marshal(b, m, deterministic)
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
 }
-func (m *BoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BoolResponse.Merge(m, src)
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
 }
-func (m *BoolResponse) XXX_Size() int {
-	return xxx_messageInfo_BoolResponse.Size(m)
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
 }
-func (m *BoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BoolResponse.DiscardUnknown(m)
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
 
-func (m *BoolResponse) GetStatus() *commonpb.Status {
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *BoolResponse) GetValue() bool {
+func (m *ListDatabasesResponse) GetDbNames() []string {
 	if m != nil {
-		return m.Value
+		return m.DbNames
 	}
-	return false
+	return nil
 }
 
-type StringResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Value                string           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *ListDatabasesResponse) GetCreatedTimestamps() []uint64 {
+	if m != nil {
+		return m.CreatedTimestamps
+	}
+	return nil
 }
 
-func (m *StringResponse) Reset()         { *m = StringResponse{} }
-func (m *StringResponse) String() string { return proto.CompactTextString(m) }
-func (*StringResponse) ProtoMessage()    {}
-func (*StringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{7}
+// Create a user, the password is encrypted by proxy before it's sent to root coord
+type CreateCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string            `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *StringResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StringResponse.Unmarshal(m, b)
+func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest{} }
+func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCredentialRequest) ProtoMessage()    {}
+func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{8}
 }
-func (m *StringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StringResponse.Marshal(b, m, deterministic)
+
+func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCredentialRequest.Unmarshal(m, b)
 }
-func (m *StringResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StringResponse.Merge(m, src)
+func (m *CreateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *StringResponse) XXX_Size() int {
-	return xxx_messageInfo_StringResponse.Size(m)
+func (m *CreateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCredentialRequest.Merge(m, src)
 }
-func (m *StringResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StringResponse.DiscardUnknown(m)
+func (m *CreateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCredentialRequest.Size(m)
+}
+func (m *CreateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StringResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateCredentialRequest proto.InternalMessageInfo
 
-func (m *StringResponse) GetStatus() *commonpb.Status {
+func (m *CreateCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *StringResponse) GetValue() string {
+func (m *CreateCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.Value
+		return m.Username
 	}
 	return ""
 }
 
-//*
-// Get collection meta datas like: schema, collectionID, shards number ...
-type DescribeCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to describe
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// Not useful for now
-	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	// Not useful for now
-	TimeStamp            uint64   `protobuf:"varint,5,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateCredentialRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
 }
 
-func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionRequest{} }
-func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeCollectionRequest) ProtoMessage()    {}
-func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{8}
+// Update the password of a user, the old password is verified by proxy
+type UpdateCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	OldPassword          string            `protobuf:"bytes,3,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword          string            `protobuf:"bytes,4,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeCollectionRequest.Unmarshal(m, b)
+func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest{} }
+func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCredentialRequest) ProtoMessage()    {}
+func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{9}
 }
-func (m *DescribeCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeCollectionRequest.Marshal(b, m, deterministic)
+
+func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCredentialRequest.Unmarshal(m, b)
 }
-func (m *DescribeCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeCollectionRequest.Merge(m, src)
+func (m *UpdateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_DescribeCollectionRequest.Size(m)
+func (m *UpdateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCredentialRequest.Merge(m, src)
 }
-func (m *DescribeCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeCollectionRequest.DiscardUnknown(m)
+func (m *UpdateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCredentialRequest.Size(m)
+}
+func (m *UpdateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateCredentialRequest proto.InternalMessageInfo
 
-func (m *DescribeCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *UpdateCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeCollectionRequest) GetDbName() string {
+func (m *UpdateCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.DbName
+		return m.Username
 	}
 	return ""
 }
 
-func (m *DescribeCollectionRequest) GetCollectionName() string {
+func (m *UpdateCredentialRequest) GetOldPassword() string {
 	if m != nil {
-		return m.CollectionName
+		return m.OldPassword
 	}
 	return ""
 }
 
-func (m *DescribeCollectionRequest) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
-}
-
-func (m *DescribeCollectionRequest) GetTimeStamp() uint64 {
+func (m *UpdateCredentialRequest) GetNewPassword() string {
 	if m != nil {
-		return m.TimeStamp
+		return m.NewPassword
 	}
-	return 0
+	return ""
 }
 
-//*
-// DescribeCollection Response
-type DescribeCollectionResponse struct {
-	// Contain error_code and reason
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// The schema param when you created collection.
-	Schema *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
-	// The collection id
-	CollectionID int64 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	// System design related, users should not perceive
-	VirtualChannelNames []string `protobuf:"bytes,4,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
-	// System design related, users should not perceive
-	PhysicalChannelNames []string `protobuf:"bytes,5,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
-	// Hybrid timestamp in milvus
-	CreatedTimestamp uint64 `protobuf:"varint,6,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
-	// The utc timestamp calculated by created_timestamp
-	CreatedUtcTimestamp uint64 `protobuf:"varint,7,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
-	// The shards number you set.
-	ShardsNum int32 `protobuf:"varint,8,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
-	// The aliases of this collection
-	Aliases []string `protobuf:"bytes,9,rep,name=aliases,proto3" json:"aliases,omitempty"`
-	// The message ID/posititon when collection is created
-	StartPositions       []*commonpb.KeyDataPair `protobuf:"bytes,10,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+type DeleteCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionResponse{} }
-func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeCollectionResponse) ProtoMessage()    {}
-func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{9}
+func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest{} }
+func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCredentialRequest) ProtoMessage()    {}
+func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{10}
 }
 
-func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeCollectionResponse.Unmarshal(m, b)
+func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCredentialRequest.Unmarshal(m, b)
 }
-func (m *DescribeCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeCollectionResponse.Marshal(b, m, deterministic)
+func (m *DeleteCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeCollectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeCollectionResponse.Merge(m, src)
+func (m *DeleteCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCredentialRequest.Merge(m, src)
 }
-func (m *DescribeCollectionResponse) XXX_Size() int {
-	return xxx_messageInfo_DescribeCollectionResponse.Size(m)
+func (m *DeleteCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCredentialRequest.Size(m)
 }
-func (m *DescribeCollectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeCollectionResponse.DiscardUnknown(m)
+func (m *DeleteCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeCollectionResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteCredentialRequest proto.InternalMessageInfo
 
-func (m *DescribeCollectionResponse) GetStatus() *commonpb.Status {
+func (m *DeleteCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetSchema() *schemapb.CollectionSchema {
+func (m *DeleteCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.Schema
+		return m.Username
 	}
-	return nil
+	return ""
 }
 
-func (m *DescribeCollectionResponse) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
+type ListCredUsersRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DescribeCollectionResponse) GetVirtualChannelNames() []string {
+func (m *ListCredUsersRequest) Reset()         { *m = ListCredUsersRequest{} }
+func (m *ListCredUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersRequest) ProtoMessage()    {}
+func (*ListCredUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{11}
+}
+
+func (m *ListCredUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersRequest.Unmarshal(m, b)
+}
+func (m *ListCredUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCredUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersRequest.Merge(m, src)
+}
+func (m *ListCredUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersRequest.Size(m)
+}
+func (m *ListCredUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCredUsersRequest proto.InternalMessageInfo
+
+func (m *ListCredUsersRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.VirtualChannelNames
+		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetPhysicalChannelNames() []string {
+type ListCredUsersResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Usernames            []string         `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListCredUsersResponse) Reset()         { *m = ListCredUsersResponse{} }
+func (m *ListCredUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersResponse) ProtoMessage()    {}
+func (*ListCredUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{12}
+}
+
+func (m *ListCredUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersResponse.Unmarshal(m, b)
+}
+func (m *ListCredUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCredUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersResponse.Merge(m, src)
+}
+func (m *ListCredUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersResponse.Size(m)
+}
+func (m *ListCredUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCredUsersResponse proto.InternalMessageInfo
+
+func (m *ListCredUsersResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.PhysicalChannelNames
+		return m.Status
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetCreatedTimestamp() uint64 {
+func (m *ListCredUsersResponse) GetUsernames() []string {
 	if m != nil {
-		return m.CreatedTimestamp
+		return m.Usernames
 	}
-	return 0
+	return nil
 }
 
-func (m *DescribeCollectionResponse) GetCreatedUtcTimestamp() uint64 {
+type CreateRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{13}
+}
+
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleRequest.Unmarshal(m, b)
+}
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
+}
+func (m *CreateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleRequest.Size(m)
+}
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
+
+func (m *CreateRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.CreatedUtcTimestamp
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *DescribeCollectionResponse) GetShardsNum() int32 {
+func (m *CreateRoleRequest) GetRoleName() string {
 	if m != nil {
-		return m.ShardsNum
+		return m.RoleName
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeCollectionResponse) GetAliases() []string {
+// Drop a role, users lose the privileges granted to the role
+type DropRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
+func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DropRoleRequest) ProtoMessage()    {}
+func (*DropRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{14}
+}
+
+func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleRequest.Unmarshal(m, b)
+}
+func (m *DropRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *DropRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleRequest.Merge(m, src)
+}
+func (m *DropRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_DropRoleRequest.Size(m)
+}
+func (m *DropRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropRoleRequest proto.InternalMessageInfo
+
+func (m *DropRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Aliases
+		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetStartPositions() []*commonpb.KeyDataPair {
+func (m *DropRoleRequest) GetRoleName() string {
 	if m != nil {
-		return m.StartPositions
+		return m.RoleName
 	}
-	return nil
+	return ""
 }
 
-//*
-// Load collection data into query nodes, then you can do vector search on this collection.
-type LoadCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to load
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type AddUserToRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	RoleName             string            `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
-func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadCollectionRequest) ProtoMessage()    {}
-func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{10}
+func (m *AddUserToRoleRequest) Reset()         { *m = AddUserToRoleRequest{} }
+func (m *AddUserToRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*AddUserToRoleRequest) ProtoMessage()    {}
+func (*AddUserToRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{15}
 }
 
-func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadCollectionRequest.Unmarshal(m, b)
+func (m *AddUserToRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddUserToRoleRequest.Unmarshal(m, b)
 }
-func (m *LoadCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadCollectionRequest.Marshal(b, m, deterministic)
+func (m *AddUserToRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddUserToRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *LoadCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadCollectionRequest.Merge(m, src)
+func (m *AddUserToRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddUserToRoleRequest.Merge(m, src)
 }
-func (m *LoadCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadCollectionRequest.Size(m)
+func (m *AddUserToRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_AddUserToRoleRequest.Size(m)
 }
-func (m *LoadCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadCollectionRequest.DiscardUnknown(m)
+func (m *AddUserToRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddUserToRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddUserToRoleRequest proto.InternalMessageInfo
 
-func (m *LoadCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *AddUserToRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *LoadCollectionRequest) GetDbName() string {
+func (m *AddUserToRoleRequest) GetUsername() string {
 	if m != nil {
-		return m.DbName
+		return m.Username
 	}
 	return ""
 }
 
-func (m *LoadCollectionRequest) GetCollectionName() string {
+func (m *AddUserToRoleRequest) GetRoleName() string {
 	if m != nil {
-		return m.CollectionName
+		return m.RoleName
 	}
 	return ""
 }
 
-//*
-// Release collection data from query nodes, then you can't do vector search on this collection.
-type ReleaseCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to release
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RemoveUserFromRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	RoleName             string            `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionRequest{} }
-func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*ReleaseCollectionRequest) ProtoMessage()    {}
-func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{11}
+func (m *RemoveUserFromRoleRequest) Reset()         { *m = RemoveUserFromRoleRequest{} }
+func (m *RemoveUserFromRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveUserFromRoleRequest) ProtoMessage()    {}
+func (*RemoveUserFromRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{16}
 }
 
-func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReleaseCollectionRequest.Unmarshal(m, b)
+func (m *RemoveUserFromRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveUserFromRoleRequest.Unmarshal(m, b)
 }
-func (m *ReleaseCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReleaseCollectionRequest.Marshal(b, m, deterministic)
+func (m *RemoveUserFromRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveUserFromRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *ReleaseCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReleaseCollectionRequest.Merge(m, src)
+func (m *RemoveUserFromRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveUserFromRoleRequest.Merge(m, src)
 }
-func (m *ReleaseCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_ReleaseCollectionRequest.Size(m)
+func (m *RemoveUserFromRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveUserFromRoleRequest.Size(m)
 }
-func (m *ReleaseCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReleaseCollectionRequest.DiscardUnknown(m)
+func (m *RemoveUserFromRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveUserFromRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReleaseCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveUserFromRoleRequest proto.InternalMessageInfo
 
-func (m *ReleaseCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *RemoveUserFromRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ReleaseCollectionRequest) GetDbName() string {
+func (m *RemoveUserFromRoleRequest) GetUsername() string {
 	if m != nil {
-		return m.DbName
+		return m.Username
 	}
 	return ""
 }
 
-func (m *ReleaseCollectionRequest) GetCollectionName() string {
+func (m *RemoveUserFromRoleRequest) GetRoleName() string {
 	if m != nil {
-		return m.CollectionName
+		return m.RoleName
 	}
 	return ""
 }
 
-//*
-// Get collection statistics like row_count.
-type GetCollectionStatisticsRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+// A privilege granted to a role on an object
+type GrantEntity struct {
+	RoleName string `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// The database of the object, the default database is used if it's empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want get statistics
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// Collection name, "*" means all collections of the database
+	ObjectName           string   `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Privilege            string   `protobuf:"bytes,4,opt,name=privilege,proto3" json:"privilege,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionStatisticsRequest{} }
-func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
-func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{12}
+func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
+func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
+func (*GrantEntity) ProtoMessage()    {}
+func (*GrantEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{17}
 }
 
-func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCollectionStatisticsRequest.Unmarshal(m, b)
+func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantEntity.Unmarshal(m, b)
 }
-func (m *GetCollectionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCollectionStatisticsRequest.Marshal(b, m, deterministic)
+func (m *GrantEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantEntity.Marshal(b, m, deterministic)
 }
-func (m *GetCollectionStatisticsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollectionStatisticsRequest.Merge(m, src)
+func (m *GrantEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantEntity.Merge(m, src)
 }
-func (m *GetCollectionStatisticsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCollectionStatisticsRequest.Size(m)
+func (m *GrantEntity) XXX_Size() int {
+	return xxx_messageInfo_GrantEntity.Size(m)
 }
-func (m *GetCollectionStatisticsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollectionStatisticsRequest.DiscardUnknown(m)
+func (m *GrantEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantEntity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollectionStatisticsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GrantEntity proto.InternalMessageInfo
 
-func (m *GetCollectionStatisticsRequest) GetBase() *commonpb.MsgBase {
+func (m *GrantEntity) GetRoleName() string {
 	if m != nil {
-		return m.Base
+		return m.RoleName
 	}
-	return nil
+	return ""
 }
 
-func (m *GetCollectionStatisticsRequest) GetDbName() string {
+func (m *GrantEntity) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *GetCollectionStatisticsRequest) GetCollectionName() string {
+func (m *GrantEntity) GetObjectName() string {
 	if m != nil {
-		return m.CollectionName
+		return m.ObjectName
 	}
 	return ""
 }
 
-//*
-// Will return collection statistics in stats field like [{key:"row_count",value:"1"}]
-type GetCollectionStatisticsResponse struct {
-	// Contain error_code and reason
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// Collection statistics data
-	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *GrantEntity) GetPrivilege() string {
+	if m != nil {
+		return m.Privilege
+	}
+	return ""
 }
 
-func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionStatisticsResponse{} }
-func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
-func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{13}
+type GrantPrivilegeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Entity               *GrantEntity      `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCollectionStatisticsResponse.Unmarshal(m, b)
+func (m *GrantPrivilegeRequest) Reset()         { *m = GrantPrivilegeRequest{} }
+func (m *GrantPrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*GrantPrivilegeRequest) ProtoMessage()    {}
+func (*GrantPrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{18}
 }
-func (m *GetCollectionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCollectionStatisticsResponse.Marshal(b, m, deterministic)
+
+func (m *GrantPrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantPrivilegeRequest.Unmarshal(m, b)
 }
-func (m *GetCollectionStatisticsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollectionStatisticsResponse.Merge(m, src)
+func (m *GrantPrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantPrivilegeRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCollectionStatisticsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCollectionStatisticsResponse.Size(m)
+func (m *GrantPrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantPrivilegeRequest.Merge(m, src)
 }
-func (m *GetCollectionStatisticsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollectionStatisticsResponse.DiscardUnknown(m)
+func (m *GrantPrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_GrantPrivilegeRequest.Size(m)
+}
+func (m *GrantPrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantPrivilegeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollectionStatisticsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GrantPrivilegeRequest proto.InternalMessageInfo
 
-func (m *GetCollectionStatisticsResponse) GetStatus() *commonpb.Status {
+func (m *GrantPrivilegeRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetCollectionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
+func (m *GrantPrivilegeRequest) GetEntity() *GrantEntity {
 	if m != nil {
-		return m.Stats
+		return m.Entity
 	}
 	return nil
 }
 
-//
-// List collections
-type ShowCollectionsRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// Not useful for now
-	TimeStamp uint64 `protobuf:"varint,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	// Decide return Loaded collections or All collections(Optional)
-	Type ShowType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
-	// When type is InMemory, will return these collection's inMemory_percentages.(Optional)
-	CollectionNames      []string `protobuf:"bytes,5,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RevokePrivilegeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Entity               *GrantEntity      `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{} }
-func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowCollectionsRequest) ProtoMessage()    {}
-func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{14}
+func (m *RevokePrivilegeRequest) Reset()         { *m = RevokePrivilegeRequest{} }
+func (m *RevokePrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokePrivilegeRequest) ProtoMessage()    {}
+func (*RevokePrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{19}
 }
 
-func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowCollectionsRequest.Unmarshal(m, b)
+func (m *RevokePrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokePrivilegeRequest.Unmarshal(m, b)
 }
-func (m *ShowCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowCollectionsRequest.Marshal(b, m, deterministic)
+func (m *RevokePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokePrivilegeRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowCollectionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowCollectionsRequest.Merge(m, src)
+func (m *RevokePrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokePrivilegeRequest.Merge(m, src)
 }
-func (m *ShowCollectionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowCollectionsRequest.Size(m)
+func (m *RevokePrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokePrivilegeRequest.Size(m)
 }
-func (m *ShowCollectionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowCollectionsRequest.DiscardUnknown(m)
+func (m *RevokePrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokePrivilegeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowCollectionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevokePrivilegeRequest proto.InternalMessageInfo
 
-func (m *ShowCollectionsRequest) GetBase() *commonpb.MsgBase {
+func (m *RevokePrivilegeRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowCollectionsRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *ShowCollectionsRequest) GetTimeStamp() uint64 {
-	if m != nil {
-		return m.TimeStamp
-	}
-	return 0
-}
-
-func (m *ShowCollectionsRequest) GetType() ShowType {
-	if m != nil {
-		return m.Type
-	}
-	return ShowType_All
-}
-
-func (m *ShowCollectionsRequest) GetCollectionNames() []string {
+func (m *RevokePrivilegeRequest) GetEntity() *GrantEntity {
 	if m != nil {
-		return m.CollectionNames
+		return m.Entity
 	}
 	return nil
 }
 
-//
-// Return basic collection infos.
-type ShowCollectionsResponse struct {
-	// Contain error_code and reason
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// Collection name array
-	CollectionNames []string `protobuf:"bytes,2,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
-	// Collection Id array
-	CollectionIds []int64 `protobuf:"varint,3,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
-	// Hybrid timestamps in milvus
-	CreatedTimestamps []uint64 `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
-	// The utc timestamp calculated by created_timestamp
-	CreatedUtcTimestamps []uint64 `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
-	// Load percentage on querynode when type is InMemory
-	InMemoryPercentages  []int64  `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListGrantsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse{} }
-func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
-func (*ShowCollectionsResponse) ProtoMessage()    {}
-func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{15}
+func (m *ListGrantsRequest) Reset()         { *m = ListGrantsRequest{} }
+func (m *ListGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGrantsRequest) ProtoMessage()    {}
+func (*ListGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{20}
 }
 
-func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowCollectionsResponse.Unmarshal(m, b)
+func (m *ListGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGrantsRequest.Unmarshal(m, b)
 }
-func (m *ShowCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowCollectionsResponse.Marshal(b, m, deterministic)
+func (m *ListGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGrantsRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowCollectionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowCollectionsResponse.Merge(m, src)
+func (m *ListGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGrantsRequest.Merge(m, src)
 }
-func (m *ShowCollectionsResponse) XXX_Size() int {
-	return xxx_messageInfo_ShowCollectionsResponse.Size(m)
+func (m *ListGrantsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGrantsRequest.Size(m)
 }
-func (m *ShowCollectionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowCollectionsResponse.DiscardUnknown(m)
+func (m *ListGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGrantsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowCollectionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListGrantsRequest proto.InternalMessageInfo
 
-func (m *ShowCollectionsResponse) GetStatus() *commonpb.Status {
+func (m *ListGrantsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetCollectionNames() []string {
+func (m *ListGrantsRequest) GetRoleName() string {
 	if m != nil {
-		return m.CollectionNames
+		return m.RoleName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShowCollectionsResponse) GetCollectionIds() []int64 {
-	if m != nil {
-		return m.CollectionIds
-	}
-	return nil
+type ListGrantsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Entities             []*GrantEntity   `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ShowCollectionsResponse) GetCreatedTimestamps() []uint64 {
-	if m != nil {
-		return m.CreatedTimestamps
-	}
-	return nil
+func (m *ListGrantsResponse) Reset()         { *m = ListGrantsResponse{} }
+func (m *ListGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGrantsResponse) ProtoMessage()    {}
+func (*ListGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{21}
 }
 
-func (m *ShowCollectionsResponse) GetCreatedUtcTimestamps() []uint64 {
+func (m *ListGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGrantsResponse.Unmarshal(m, b)
+}
+func (m *ListGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGrantsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGrantsResponse.Merge(m, src)
+}
+func (m *ListGrantsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGrantsResponse.Size(m)
+}
+func (m *ListGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGrantsResponse proto.InternalMessageInfo
+
+func (m *ListGrantsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.CreatedUtcTimestamps
+		return m.Status
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
+func (m *ListGrantsResponse) GetEntities() []*GrantEntity {
 	if m != nil {
-		return m.InMemoryPercentages
+		return m.Entities
 	}
 	return nil
 }
 
-//
-// Create partition in created collection.
-type CreatePartitionRequest struct {
+// *
+// Create collection in milvus
+type CreateCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database the request works on, the default database is used if it's empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
+	// The unique collection name in milvus.(Required)
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to create.
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// The serialized `schema.CollectionSchema`(Required)
+	Schema []byte `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Once set, no modification is allowed (Optional)
+	// https://github.com/milvus-io/milvus/issues/6690
+	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	// The default consistency level of search and query on the collection
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{} }
-func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreatePartitionRequest) ProtoMessage()    {}
-func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{16}
+func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
+func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCollectionRequest) ProtoMessage()    {}
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{22}
 }
 
-func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreatePartitionRequest.Unmarshal(m, b)
+func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCollectionRequest.Unmarshal(m, b)
 }
-func (m *CreatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreatePartitionRequest.Marshal(b, m, deterministic)
+func (m *CreateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *CreatePartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreatePartitionRequest.Merge(m, src)
+func (m *CreateCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCollectionRequest.Merge(m, src)
 }
-func (m *CreatePartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreatePartitionRequest.Size(m)
+func (m *CreateCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCollectionRequest.Size(m)
 }
-func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
+func (m *CreateCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateCollectionRequest proto.InternalMessageInfo
 
-func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreatePartitionRequest) GetDbName() string {
+func (m *CreateCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreatePartitionRequest) GetCollectionName() string {
+func (m *CreateCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *CreatePartitionRequest) GetPartitionName() string {
+func (m *CreateCollectionRequest) GetSchema() []byte {
 	if m != nil {
-		return m.PartitionName
+		return m.Schema
 	}
-	return ""
+	return nil
 }
 
-//
-// Drop partition in created collection.
-type DropPartitionRequest struct {
+func (m *CreateCollectionRequest) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+// *
+// Drop collection in milvus, also will drop data in collection.
+type DropCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database the request works on, the default database is used if it's empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to drop
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
-func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*DropPartitionRequest) ProtoMessage()    {}
-func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{17}
+func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
+func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*DropCollectionRequest) ProtoMessage()    {}
+func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{23}
 }
 
-func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
+func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropCollectionRequest.Unmarshal(m, b)
 }
-func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
+func (m *DropCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
+func (m *DropCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropCollectionRequest.Merge(m, src)
 }
-func (m *DropPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_DropPartitionRequest.Size(m)
+func (m *DropCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_DropCollectionRequest.Size(m)
 }
-func (m *DropPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
+func (m *DropCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropCollectionRequest proto.InternalMessageInfo
 
-func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *DropCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropPartitionRequest) GetDbName() string {
+func (m *DropCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetCollectionName() string {
+func (m *DropCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-//
-// Check if partition exist in collection or not.
-type HasPartitionRequest struct {
+// *
+// Check collection exist in milvus or not.
+type HasCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database the request works on, the default database is used if it's empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
+	// The collection name you want to check.
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to check
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// Not useful for now
+	TimeStamp            uint64   `protobuf:"varint,4,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
-func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*HasPartitionRequest) ProtoMessage()    {}
-func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{18}
+func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
+func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*HasCollectionRequest) ProtoMessage()    {}
+func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{24}
 }
 
-func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
+func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasCollectionRequest.Unmarshal(m, b)
 }
-func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
+func (m *HasCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
+func (m *HasCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasCollectionRequest.Merge(m, src)
 }
-func (m *HasPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_HasPartitionRequest.Size(m)
+func (m *HasCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_HasCollectionRequest.Size(m)
 }
-func (m *HasPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
+func (m *HasCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_HasCollectionRequest proto.InternalMessageInfo
 
-func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *HasCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HasPartitionRequest) GetDbName() string {
+func (m *HasCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetCollectionName() string {
+func (m *HasCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetPartitionName() string {
+func (m *HasCollectionRequest) GetTimeStamp() uint64 {
 	if m != nil {
-		return m.PartitionName
+		return m.TimeStamp
 	}
-	return ""
+	return 0
 }
 
-//
-// Load specific partitions data of one collection into query nodes
-// Then you can get these data as result when you do vector search on this collection.
-type LoadPartitionsRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition names you want to load
-	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BoolResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Value                bool             `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
-func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadPartitionsRequest) ProtoMessage()    {}
-func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{19}
+func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
+func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
+func (*BoolResponse) ProtoMessage()    {}
+func (*BoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{25}
 }
 
-func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
+func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoolResponse.Unmarshal(m, b)
 }
-func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
+func (m *BoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoolResponse.Marshal(b, m, deterministic)
 }
-func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
+func (m *BoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoolResponse.Merge(m, src)
 }
-func (m *LoadPartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
+func (m *BoolResponse) XXX_Size() int {
+	return xxx_messageInfo_BoolResponse.Size(m)
 }
-func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
+func (m *BoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_BoolResponse proto.InternalMessageInfo
 
-func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *BoolResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *LoadPartitionsRequest) GetDbName() string {
+func (m *BoolResponse) GetValue() bool {
 	if m != nil {
-		return m.DbName
+		return m.Value
 	}
-	return ""
+	return false
 }
 
-func (m *LoadPartitionsRequest) GetCollectionName() string {
+type StringResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Value                string           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *StringResponse) Reset()         { *m = StringResponse{} }
+func (m *StringResponse) String() string { return proto.CompactTextString(m) }
+func (*StringResponse) ProtoMessage()    {}
+func (*StringResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{26}
+}
+
+func (m *StringResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StringResponse.Unmarshal(m, b)
+}
+func (m *StringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StringResponse.Marshal(b, m, deterministic)
+}
+func (m *StringResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StringResponse.Merge(m, src)
+}
+func (m *StringResponse) XXX_Size() int {
+	return xxx_messageInfo_StringResponse.Size(m)
+}
+func (m *StringResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StringResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StringResponse proto.InternalMessageInfo
+
+func (m *StringResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.CollectionName
+		return m.Status
 	}
-	return ""
+	return nil
 }
 
-func (m *LoadPartitionsRequest) GetPartitionNames() []string {
+func (m *StringResponse) GetValue() string {
 	if m != nil {
-		return m.PartitionNames
+		return m.Value
 	}
-	return nil
+	return ""
 }
 
-//
-// Release specific partitions data of one collection from query nodes.
-// Then you can not get these data as result when you do vector search on this collection.
-type ReleasePartitionsRequest struct {
+// *
+// Get collection meta datas like: schema, collectionID, shards number ...
+type DescribeCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database the request works on, the default database is used if it's empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
+	// The collection name you want to describe
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition names you want to release
-	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// Not useful for now
+	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// Not useful for now
+	TimeStamp            uint64   `protobuf:"varint,5,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
-func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ReleasePartitionsRequest) ProtoMessage()    {}
-func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionRequest{} }
+func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeCollectionRequest) ProtoMessage()    {}
+func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{27}
 }
 
-func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
+func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeCollectionRequest.Unmarshal(m, b)
 }
-func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
+func (m *DescribeCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
+func (m *DescribeCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeCollectionRequest.Merge(m, src)
 }
-func (m *ReleasePartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
+func (m *DescribeCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeCollectionRequest.Size(m)
 }
-func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
+func (m *DescribeCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeCollectionRequest proto.InternalMessageInfo
 
-func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *DescribeCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ReleasePartitionsRequest) GetDbName() string {
+func (m *DescribeCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ReleasePartitionsRequest) GetCollectionName() string {
+func (m *DescribeCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
+func (m *DescribeCollectionRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.PartitionNames
+		return m.CollectionID
 	}
-	return nil
+	return 0
 }
 
-type GetPartitionStatisticsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *DescribeCollectionRequest) GetTimeStamp() uint64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
 }
 
-func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStatisticsRequest{} }
-func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
-func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+// *
+// DescribeCollection Response
+type DescribeCollectionResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The schema param when you created collection.
+	Schema *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The collection id
+	CollectionID int64 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// System design related, users should not perceive
+	VirtualChannelNames []string `protobuf:"bytes,4,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
+	// System design related, users should not perceive
+	PhysicalChannelNames []string `protobuf:"bytes,5,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
+	// Hybrid timestamp in milvus
+	CreatedTimestamp uint64 `protobuf:"varint,6,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
+	// The utc timestamp calculated by created_timestamp
+	CreatedUtcTimestamp uint64 `protobuf:"varint,7,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
+	// The shards number you set.
+	ShardsNum int32 `protobuf:"varint,8,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	// The aliases of this collection
+	Aliases []string `protobuf:"bytes,9,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// The message ID/posititon when collection is created
+	StartPositions []*commonpb.KeyDataPair `protobuf:"bytes,10,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	// The default consistency level of search and query on the collection
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Unmarshal(m, b)
+func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionResponse{} }
+func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeCollectionResponse) ProtoMessage()    {}
+func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{28}
 }
-func (m *GetPartitionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Marshal(b, m, deterministic)
+
+func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeCollectionResponse.Unmarshal(m, b)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPartitionStatisticsRequest.Merge(m, src)
+func (m *DescribeCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeCollectionResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Size(m)
+func (m *DescribeCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeCollectionResponse.Merge(m, src)
 }
-func (m *GetPartitionStatisticsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPartitionStatisticsRequest.DiscardUnknown(m)
+func (m *DescribeCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeCollectionResponse.Size(m)
+}
+func (m *DescribeCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeCollectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPartitionStatisticsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeCollectionResponse proto.InternalMessageInfo
 
-func (m *GetPartitionStatisticsRequest) GetBase() *commonpb.MsgBase {
+func (m *DescribeCollectionResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetPartitionStatisticsRequest) GetDbName() string {
+func (m *DescribeCollectionResponse) GetSchema() *schemapb.CollectionSchema {
 	if m != nil {
-		return m.DbName
+		return m.Schema
 	}
-	return ""
+	return nil
 }
 
-func (m *GetPartitionStatisticsRequest) GetCollectionName() string {
+func (m *DescribeCollectionResponse) GetCollectionID() int64 {
 	if m != nil {
-		return m.CollectionName
+		return m.CollectionID
 	}
-	return ""
+	return 0
 }
 
-func (m *GetPartitionStatisticsRequest) GetPartitionName() string {
+func (m *DescribeCollectionResponse) GetVirtualChannelNames() []string {
 	if m != nil {
-		return m.PartitionName
+		return m.VirtualChannelNames
 	}
-	return ""
+	return nil
 }
 
-type GetPartitionStatisticsResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *DescribeCollectionResponse) GetPhysicalChannelNames() []string {
+	if m != nil {
+		return m.PhysicalChannelNames
+	}
+	return nil
 }
 
-func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStatisticsResponse{} }
-func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
-func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
+func (m *DescribeCollectionResponse) GetCreatedTimestamp() uint64 {
+	if m != nil {
+		return m.CreatedTimestamp
+	}
+	return 0
 }
 
-func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Unmarshal(m, b)
-}
-func (m *GetPartitionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Marshal(b, m, deterministic)
-}
-func (m *GetPartitionStatisticsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPartitionStatisticsResponse.Merge(m, src)
-}
-func (m *GetPartitionStatisticsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Size(m)
-}
-func (m *GetPartitionStatisticsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPartitionStatisticsResponse.DiscardUnknown(m)
+func (m *DescribeCollectionResponse) GetCreatedUtcTimestamp() uint64 {
+	if m != nil {
+		return m.CreatedUtcTimestamp
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetPartitionStatisticsResponse proto.InternalMessageInfo
+func (m *DescribeCollectionResponse) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
 
-func (m *GetPartitionStatisticsResponse) GetStatus() *commonpb.Status {
+func (m *DescribeCollectionResponse) GetAliases() []string {
 	if m != nil {
-		return m.Status
+		return m.Aliases
 	}
 	return nil
 }
 
-func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
+func (m *DescribeCollectionResponse) GetStartPositions() []*commonpb.KeyDataPair {
 	if m != nil {
-		return m.Stats
+		return m.StartPositions
 	}
 	return nil
 }
 
-type ShowPartitionsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,5,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	Type                 ShowType          `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *DescribeCollectionResponse) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
 }
 
-func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
-func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowPartitionsRequest) ProtoMessage()    {}
-func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{23}
+// *
+// Load collection data into query nodes, then you can do vector search on this collection.
+type LoadCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database the request works on, the default database is used if it's empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name you want to load
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The number of in-memory replicas, each replica holds a full copy of the collection
+	ReplicaNumber        int32    `protobuf:"varint,4,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowPartitionsRequest.Unmarshal(m, b)
+func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
+func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadCollectionRequest) ProtoMessage()    {}
+func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{29}
 }
-func (m *ShowPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowPartitionsRequest.Marshal(b, m, deterministic)
+
+func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadCollectionRequest.Unmarshal(m, b)
 }
-func (m *ShowPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowPartitionsRequest.Merge(m, src)
+func (m *LoadCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowPartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowPartitionsRequest.Size(m)
+func (m *LoadCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadCollectionRequest.Merge(m, src)
 }
-func (m *ShowPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowPartitionsRequest.DiscardUnknown(m)
+func (m *LoadCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadCollectionRequest.Size(m)
+}
+func (m *LoadCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_LoadCollectionRequest proto.InternalMessageInfo
 
-func (m *ShowPartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *LoadCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowPartitionsRequest) GetDbName() string {
+func (m *LoadCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ShowPartitionsRequest) GetCollectionName() string {
+func (m *LoadCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *ShowPartitionsRequest) GetCollectionID() int64 {
+func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
 	if m != nil {
-		return m.CollectionID
+		return m.ReplicaNumber
 	}
 	return 0
 }
 
-func (m *ShowPartitionsRequest) GetPartitionNames() []string {
-	if m != nil {
-		return m.PartitionNames
-	}
-	return nil
-}
-
-func (m *ShowPartitionsRequest) GetType() ShowType {
-	if m != nil {
-		return m.Type
-	}
-	return ShowType_All
-}
-
-type ShowPartitionsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	PartitionNames       []string         `protobuf:"bytes,2,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	PartitionIDs         []int64          `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	CreatedTimestamps    []uint64         `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
-	CreatedUtcTimestamps []uint64         `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
-	InMemoryPercentages  []int64          `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+// *
+// Release collection data from query nodes, then you can't do vector search on this collection.
+type ReleaseCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database the request works on, the default database is used if it's empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name you want to release
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{} }
-func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
-func (*ShowPartitionsResponse) ProtoMessage()    {}
-func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionRequest{} }
+func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseCollectionRequest) ProtoMessage()    {}
+func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{30}
 }
 
-func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowPartitionsResponse.Unmarshal(m, b)
+func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseCollectionRequest.Unmarshal(m, b)
 }
-func (m *ShowPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowPartitionsResponse.Marshal(b, m, deterministic)
+func (m *ReleaseCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowPartitionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowPartitionsResponse.Merge(m, src)
+func (m *ReleaseCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseCollectionRequest.Merge(m, src)
 }
-func (m *ShowPartitionsResponse) XXX_Size() int {
-	return xxx_messageInfo_ShowPartitionsResponse.Size(m)
+func (m *ReleaseCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseCollectionRequest.Size(m)
 }
-func (m *ShowPartitionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowPartitionsResponse.DiscardUnknown(m)
+func (m *ReleaseCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowPartitionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseCollectionRequest proto.InternalMessageInfo
 
-func (m *ShowPartitionsResponse) GetStatus() *commonpb.Status {
+func (m *ReleaseCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetPartitionNames() []string {
+func (m *ReleaseCollectionRequest) GetDbName() string {
 	if m != nil {
-		return m.PartitionNames
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
+func (m *ReleaseCollectionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.PartitionIDs
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShowPartitionsResponse) GetCreatedTimestamps() []uint64 {
+// *
+// Get collection statistics like row_count.
+type GetCollectionStatisticsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database the request works on, the default database is used if it's empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name you want get statistics
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionStatisticsRequest{} }
+func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
+func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{31}
+}
+
+func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionStatisticsRequest.Unmarshal(m, b)
+}
+func (m *GetCollectionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionStatisticsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionStatisticsRequest.Merge(m, src)
+}
+func (m *GetCollectionStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionStatisticsRequest.Size(m)
+}
+func (m *GetCollectionStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionStatisticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionStatisticsRequest proto.InternalMessageInfo
+
+func (m *GetCollectionStatisticsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.CreatedTimestamps
+		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetCreatedUtcTimestamps() []uint64 {
+func (m *GetCollectionStatisticsRequest) GetDbName() string {
 	if m != nil {
-		return m.CreatedUtcTimestamps
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
+func (m *GetCollectionStatisticsRequest) GetCollectionName() string {
 	if m != nil {
-		return m.InMemoryPercentages
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-type DescribeSegmentRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// *
+// Will return collection statistics in stats field like [{key:"row_count",value:"1"}]
+type GetCollectionStatisticsResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Collection statistics data
+	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{} }
-func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeSegmentRequest) ProtoMessage()    {}
-func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionStatisticsResponse{} }
+func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
+func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{32}
 }
 
-func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeSegmentRequest.Unmarshal(m, b)
+func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionStatisticsResponse.Unmarshal(m, b)
 }
-func (m *DescribeSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeSegmentRequest.Marshal(b, m, deterministic)
+func (m *GetCollectionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionStatisticsResponse.Marshal(b, m, deterministic)
 }
-func (m *DescribeSegmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeSegmentRequest.Merge(m, src)
+func (m *GetCollectionStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionStatisticsResponse.Merge(m, src)
 }
-func (m *DescribeSegmentRequest) XXX_Size() int {
-	return xxx_messageInfo_DescribeSegmentRequest.Size(m)
+func (m *GetCollectionStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionStatisticsResponse.Size(m)
 }
-func (m *DescribeSegmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeSegmentRequest.DiscardUnknown(m)
+func (m *GetCollectionStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionStatisticsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeSegmentRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetCollectionStatisticsResponse proto.InternalMessageInfo
 
-func (m *DescribeSegmentRequest) GetBase() *commonpb.MsgBase {
+func (m *GetCollectionStatisticsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *DescribeSegmentRequest) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
-}
-
-func (m *DescribeSegmentRequest) GetSegmentID() int64 {
+func (m *GetCollectionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.SegmentID
+		return m.Stats
 	}
-	return 0
+	return nil
 }
 
-type DescribeSegmentResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64            `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	EnableIndex          bool             `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+// List collections
+type ShowCollectionsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database the request works on, the default database is used if it's empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// Not useful for now
+	TimeStamp uint64 `protobuf:"varint,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	// Decide return Loaded collections or All collections(Optional)
+	Type ShowType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	// When type is InMemory, will return these collection's inMemory_percentages.(Optional)
+	CollectionNames      []string `protobuf:"bytes,5,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse{} }
-func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeSegmentResponse) ProtoMessage()    {}
-func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{} }
+func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowCollectionsRequest) ProtoMessage()    {}
+func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{33}
 }
 
-func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeSegmentResponse.Unmarshal(m, b)
+func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowCollectionsRequest.Unmarshal(m, b)
 }
-func (m *DescribeSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeSegmentResponse.Marshal(b, m, deterministic)
+func (m *ShowCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowCollectionsRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeSegmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeSegmentResponse.Merge(m, src)
+func (m *ShowCollectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowCollectionsRequest.Merge(m, src)
 }
-func (m *DescribeSegmentResponse) XXX_Size() int {
-	return xxx_messageInfo_DescribeSegmentResponse.Size(m)
+func (m *ShowCollectionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowCollectionsRequest.Size(m)
 }
-func (m *DescribeSegmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeSegmentResponse.DiscardUnknown(m)
+func (m *ShowCollectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowCollectionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeSegmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_ShowCollectionsRequest proto.InternalMessageInfo
 
-func (m *DescribeSegmentResponse) GetStatus() *commonpb.Status {
+func (m *ShowCollectionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeSegmentResponse) GetIndexID() int64 {
+func (m *ShowCollectionsRequest) GetDbName() string {
 	if m != nil {
-		return m.IndexID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeSegmentResponse) GetBuildID() int64 {
+func (m *ShowCollectionsRequest) GetTimeStamp() uint64 {
 	if m != nil {
-		return m.BuildID
+		return m.TimeStamp
 	}
 	return 0
 }
 
-func (m *DescribeSegmentResponse) GetEnableIndex() bool {
+func (m *ShowCollectionsRequest) GetType() ShowType {
 	if m != nil {
-		return m.EnableIndex
+		return m.Type
 	}
-	return false
+	return ShowType_All
 }
 
-type ShowSegmentsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ShowCollectionsRequest) GetCollectionNames() []string {
+	if m != nil {
+		return m.CollectionNames
+	}
+	return nil
 }
 
-func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
-func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowSegmentsRequest) ProtoMessage()    {}
-func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+// Return basic collection infos.
+type ShowCollectionsResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Collection name array
+	CollectionNames []string `protobuf:"bytes,2,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	// Collection Id array
+	CollectionIds []int64 `protobuf:"varint,3,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+	// Hybrid timestamps in milvus
+	CreatedTimestamps []uint64 `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	// The utc timestamp calculated by created_timestamp
+	CreatedUtcTimestamps []uint64 `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	// Load percentage on querynode when type is InMemory
+	InMemoryPercentages  []int64  `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowSegmentsRequest.Unmarshal(m, b)
+func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse{} }
+func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowCollectionsResponse) ProtoMessage()    {}
+func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
-func (m *ShowSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowSegmentsRequest.Marshal(b, m, deterministic)
+
+func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowCollectionsResponse.Unmarshal(m, b)
 }
-func (m *ShowSegmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowSegmentsRequest.Merge(m, src)
+func (m *ShowCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowCollectionsResponse.Marshal(b, m, deterministic)
 }
-func (m *ShowSegmentsRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowSegmentsRequest.Size(m)
+func (m *ShowCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowCollectionsResponse.Merge(m, src)
 }
-func (m *ShowSegmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowSegmentsRequest.DiscardUnknown(m)
+func (m *ShowCollectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowCollectionsResponse.Size(m)
+}
+func (m *ShowCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowCollectionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowSegmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowCollectionsResponse proto.InternalMessageInfo
 
-func (m *ShowSegmentsRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowCollectionsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *ShowSegmentsRequest) GetCollectionID() int64 {
+func (m *ShowCollectionsResponse) GetCollectionNames() []string {
 	if m != nil {
-		return m.CollectionID
+		return m.CollectionNames
 	}
-	return 0
+	return nil
 }
 
-func (m *ShowSegmentsRequest) GetPartitionID() int64 {
+func (m *ShowCollectionsResponse) GetCollectionIds() []int64 {
 	if m != nil {
-		return m.PartitionID
+		return m.CollectionIds
 	}
-	return 0
-}
-
-type ShowSegmentsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	SegmentIDs           []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
-}
-
-func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
-func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*ShowSegmentsResponse) ProtoMessage()    {}
-func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
+	return nil
 }
 
-func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowSegmentsResponse.Unmarshal(m, b)
-}
-func (m *ShowSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowSegmentsResponse.Marshal(b, m, deterministic)
-}
-func (m *ShowSegmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowSegmentsResponse.Merge(m, src)
-}
-func (m *ShowSegmentsResponse) XXX_Size() int {
-	return xxx_messageInfo_ShowSegmentsResponse.Size(m)
-}
-func (m *ShowSegmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowSegmentsResponse.DiscardUnknown(m)
+func (m *ShowCollectionsResponse) GetCreatedTimestamps() []uint64 {
+	if m != nil {
+		return m.CreatedTimestamps
+	}
+	return nil
 }
 
-var xxx_messageInfo_ShowSegmentsResponse proto.InternalMessageInfo
-
-func (m *ShowSegmentsResponse) GetStatus() *commonpb.Status {
+func (m *ShowCollectionsResponse) GetCreatedUtcTimestamps() []uint64 {
 	if m != nil {
-		return m.Status
+		return m.CreatedUtcTimestamps
 	}
 	return nil
 }
 
-func (m *ShowSegmentsResponse) GetSegmentIDs() []int64 {
+func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	if m != nil {
-		return m.SegmentIDs
+		return m.InMemoryPercentages
 	}
 	return nil
 }
 
-type CreateIndexRequest struct {
-	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string                   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	ExtraParams          []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+// Create partition in created collection.
+type CreatePartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database the request works on, the default database is used if it's empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to create.
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
-func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateIndexRequest) ProtoMessage()    {}
-func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{} }
+func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePartitionRequest) ProtoMessage()    {}
+func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
 
-func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateIndexRequest.Unmarshal(m, b)
+func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePartitionRequest.Unmarshal(m, b)
 }
-func (m *CreateIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateIndexRequest.Marshal(b, m, deterministic)
+func (m *CreatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateIndexRequest.Merge(m, src)
+func (m *CreatePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePartitionRequest.Merge(m, src)
 }
-func (m *CreateIndexRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateIndexRequest.Size(m)
+func (m *CreatePartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePartitionRequest.Size(m)
 }
-func (m *CreateIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateIndexRequest.DiscardUnknown(m)
+func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
 
-func (m *CreateIndexRequest) GetBase() *commonpb.MsgBase {
+func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreateIndexRequest) GetDbName() string {
+func (m *CreatePartitionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreateIndexRequest) GetCollectionName() string {
+func (m *CreatePartitionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *CreateIndexRequest) GetFieldName() string {
+func (m *CreatePartitionRequest) GetPartitionName() string {
 	if m != nil {
-		return m.FieldName
+		return m.PartitionName
 	}
 	return ""
 }
 
-func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
-	if m != nil {
-		return m.ExtraParams
-	}
-	return nil
-}
-
-type DescribeIndexRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// Drop partition in created collection.
+type DropPartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database the request works on, the default database is used if it's empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to drop
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
-func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeIndexRequest) ProtoMessage()    {}
-func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
+func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*DropPartitionRequest) ProtoMessage()    {}
+func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{36}
 }
 
-func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeIndexRequest.Unmarshal(m, b)
+func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
 }
-func (m *DescribeIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeIndexRequest.Marshal(b, m, deterministic)
+func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeIndexRequest.Merge(m, src)
+func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
 }
-func (m *DescribeIndexRequest) XXX_Size() int {
-	return xxx_messageInfo_DescribeIndexRequest.Size(m)
+func (m *DropPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_DropPartitionRequest.Size(m)
 }
-func (m *DescribeIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeIndexRequest.DiscardUnknown(m)
+func (m *DropPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
 
-func (m *DescribeIndexRequest) GetBase() *commonpb.MsgBase {
+func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeIndexRequest) GetDbName() string {
+func (m *DropPartitionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DescribeIndexRequest) GetCollectionName() string {
+func (m *DropPartitionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DescribeIndexRequest) GetFieldName() string {
-	if m != nil {
-		return m.FieldName
-	}
-	return ""
-}
-
-func (m *DescribeIndexRequest) GetIndexName() string {
+func (m *DropPartitionRequest) GetPartitionName() string {
 	if m != nil {
-		return m.IndexName
+		return m.PartitionName
 	}
 	return ""
 }
 
-type IndexDescription struct {
-	IndexName            string                   `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                    `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	Params               []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
-	FieldName            string                   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+// Check if partition exist in collection or not.
+type HasPartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database the request works on, the default database is used if it's empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to check
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
-func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
-func (*IndexDescription) ProtoMessage()    {}
-func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
+func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*HasPartitionRequest) ProtoMessage()    {}
+func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
-func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexDescription.Unmarshal(m, b)
+func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
 }
-func (m *IndexDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexDescription.Marshal(b, m, deterministic)
+func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *IndexDescription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexDescription.Merge(m, src)
+func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
 }
-func (m *IndexDescription) XXX_Size() int {
-	return xxx_messageInfo_IndexDescription.Size(m)
+func (m *HasPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_HasPartitionRequest.Size(m)
 }
-func (m *IndexDescription) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexDescription.DiscardUnknown(m)
+func (m *HasPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexDescription proto.InternalMessageInfo
+var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
 
-func (m *IndexDescription) GetIndexName() string {
+func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.IndexName
+		return m.Base
 	}
-	return ""
+	return nil
 }
 
-func (m *IndexDescription) GetIndexID() int64 {
+func (m *HasPartitionRequest) GetDbName() string {
 	if m != nil {
-		return m.IndexID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *IndexDescription) GetParams() []*commonpb.KeyValuePair {
+func (m *HasPartitionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.Params
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *IndexDescription) GetFieldName() string {
+func (m *HasPartitionRequest) GetPartitionName() string {
 	if m != nil {
-		return m.FieldName
+		return m.PartitionName
 	}
 	return ""
 }
 
-type DescribeIndexResponse struct {
-	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IndexDescriptions    []*IndexDescription `protobuf:"bytes,2,rep,name=index_descriptions,json=indexDescriptions,proto3" json:"index_descriptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+// Load specific partitions data of one collection into query nodes
+// Then you can get these data as result when you do vector search on this collection.
+type LoadPartitionsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database the request works on, the default database is used if it's empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition names you want to load
+	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
-func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeIndexResponse) ProtoMessage()    {}
-func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
+func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadPartitionsRequest) ProtoMessage()    {}
+func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
-func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeIndexResponse.Unmarshal(m, b)
+func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
 }
-func (m *DescribeIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeIndexResponse.Marshal(b, m, deterministic)
+func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeIndexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeIndexResponse.Merge(m, src)
+func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
 }
-func (m *DescribeIndexResponse) XXX_Size() int {
-	return xxx_messageInfo_DescribeIndexResponse.Size(m)
+func (m *LoadPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
 }
-func (m *DescribeIndexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeIndexResponse.DiscardUnknown(m)
+func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeIndexResponse proto.InternalMessageInfo
+var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
 
-func (m *DescribeIndexResponse) GetStatus() *commonpb.Status {
+func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeIndexResponse) GetIndexDescriptions() []*IndexDescription {
+func (m *LoadPartitionsRequest) GetDbName() string {
 	if m != nil {
-		return m.IndexDescriptions
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *LoadPartitionsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *LoadPartitionsRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
 	}
 	return nil
 }
 
-type GetIndexBuildProgressRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// Release specific partitions data of one collection from query nodes.
+// Then you can not get these data as result when you do vector search on this collection.
+type ReleasePartitionsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database the request works on, the default database is used if it's empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition names you want to release
+	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgressRequest{} }
-func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
-func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
-func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
+func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleasePartitionsRequest) ProtoMessage()    {}
+func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{39}
 }
 
-func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexBuildProgressRequest.Unmarshal(m, b)
+func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
 }
-func (m *GetIndexBuildProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexBuildProgressRequest.Marshal(b, m, deterministic)
+func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetIndexBuildProgressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexBuildProgressRequest.Merge(m, src)
+func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
 }
-func (m *GetIndexBuildProgressRequest) XXX_Size() int {
-	return xxx_messageInfo_GetIndexBuildProgressRequest.Size(m)
+func (m *ReleasePartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
 }
-func (m *GetIndexBuildProgressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexBuildProgressRequest.DiscardUnknown(m)
+func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetIndexBuildProgressRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
 
-func (m *GetIndexBuildProgressRequest) GetBase() *commonpb.MsgBase {
+func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetIndexBuildProgressRequest) GetDbName() string {
+func (m *ReleasePartitionsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *GetIndexBuildProgressRequest) GetCollectionName() string {
+func (m *ReleasePartitionsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *GetIndexBuildProgressRequest) GetFieldName() string {
+func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.FieldName
+		return m.PartitionNames
 	}
-	return ""
+	return nil
 }
 
-func (m *GetIndexBuildProgressRequest) GetIndexName() string {
-	if m != nil {
-		return m.IndexName
-	}
-	return ""
+type GetPartitionStatisticsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-type GetIndexBuildProgressResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IndexedRows          int64            `protobuf:"varint,2,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
-	TotalRows            int64            `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStatisticsRequest{} }
+func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
+func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{40}
 }
 
-func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProgressResponse{} }
-func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
-func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
-func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
-}
-
-func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexBuildProgressResponse.Unmarshal(m, b)
-}
-func (m *GetIndexBuildProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexBuildProgressResponse.Marshal(b, m, deterministic)
-}
-func (m *GetIndexBuildProgressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexBuildProgressResponse.Merge(m, src)
-}
-func (m *GetIndexBuildProgressResponse) XXX_Size() int {
-	return xxx_messageInfo_GetIndexBuildProgressResponse.Size(m)
-}
-func (m *GetIndexBuildProgressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexBuildProgressResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetIndexBuildProgressResponse proto.InternalMessageInfo
-
-func (m *GetIndexBuildProgressResponse) GetStatus() *commonpb.Status {
-	if m != nil {
-		return m.Status
-	}
-	return nil
-}
-
-func (m *GetIndexBuildProgressResponse) GetIndexedRows() int64 {
-	if m != nil {
-		return m.IndexedRows
-	}
-	return 0
-}
-
-func (m *GetIndexBuildProgressResponse) GetTotalRows() int64 {
-	if m != nil {
-		return m.TotalRows
-	}
-	return 0
-}
-
-type GetIndexStateRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
-}
-
-func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
-func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetIndexStateRequest) ProtoMessage()    {}
-func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
-}
-
-func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexStateRequest.Unmarshal(m, b)
+func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Unmarshal(m, b)
 }
-func (m *GetIndexStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexStateRequest.Marshal(b, m, deterministic)
+func (m *GetPartitionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetIndexStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexStateRequest.Merge(m, src)
+func (m *GetPartitionStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionStatisticsRequest.Merge(m, src)
 }
-func (m *GetIndexStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetIndexStateRequest.Size(m)
+func (m *GetPartitionStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Size(m)
 }
-func (m *GetIndexStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexStateRequest.DiscardUnknown(m)
+func (m *GetPartitionStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionStatisticsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetIndexStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetPartitionStatisticsRequest proto.InternalMessageInfo
 
-func (m *GetIndexStateRequest) GetBase() *commonpb.MsgBase {
+func (m *GetPartitionStatisticsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetIndexStateRequest) GetDbName() string {
+func (m *GetPartitionStatisticsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *GetIndexStateRequest) GetCollectionName() string {
+func (m *GetPartitionStatisticsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *GetIndexStateRequest) GetFieldName() string {
-	if m != nil {
-		return m.FieldName
-	}
-	return ""
-}
-
-func (m *GetIndexStateRequest) GetIndexName() string {
+func (m *GetPartitionStatisticsRequest) GetPartitionName() string {
 	if m != nil {
-		return m.IndexName
+		return m.PartitionName
 	}
 	return ""
 }
 
-type GetIndexStateResponse struct {
-	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	FailReason           string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type GetPartitionStatisticsResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
-func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetIndexStateResponse) ProtoMessage()    {}
-func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
+func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStatisticsResponse{} }
+func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
+func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{41}
 }
 
-func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexStateResponse.Unmarshal(m, b)
+func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Unmarshal(m, b)
 }
-func (m *GetIndexStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexStateResponse.Marshal(b, m, deterministic)
+func (m *GetPartitionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetIndexStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexStateResponse.Merge(m, src)
+func (m *GetPartitionStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionStatisticsResponse.Merge(m, src)
 }
-func (m *GetIndexStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetIndexStateResponse.Size(m)
+func (m *GetPartitionStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Size(m)
 }
-func (m *GetIndexStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexStateResponse.DiscardUnknown(m)
+func (m *GetPartitionStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionStatisticsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetIndexStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPartitionStatisticsResponse proto.InternalMessageInfo
 
-func (m *GetIndexStateResponse) GetStatus() *commonpb.Status {
+func (m *GetPartitionStatisticsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetIndexStateResponse) GetState() commonpb.IndexState {
-	if m != nil {
-		return m.State
-	}
-	return commonpb.IndexState_IndexStateNone
-}
-
-func (m *GetIndexStateResponse) GetFailReason() string {
+func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.FailReason
+		return m.Stats
 	}
-	return ""
+	return nil
 }
 
-type DropIndexRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type ShowPartitionsRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID   int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,5,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Type           ShowType          `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	// Show the partitions as they were at the timestamp, 0 means the latest
+	TimeStamp            uint64   `protobuf:"varint,7,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
-func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*DropIndexRequest) ProtoMessage()    {}
-func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{37}
+func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
+func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowPartitionsRequest) ProtoMessage()    {}
+func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
-func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropIndexRequest.Unmarshal(m, b)
+func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowPartitionsRequest.Unmarshal(m, b)
 }
-func (m *DropIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropIndexRequest.Marshal(b, m, deterministic)
+func (m *ShowPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowPartitionsRequest.Marshal(b, m, deterministic)
 }
-func (m *DropIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropIndexRequest.Merge(m, src)
+func (m *ShowPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsRequest.Merge(m, src)
 }
-func (m *DropIndexRequest) XXX_Size() int {
-	return xxx_messageInfo_DropIndexRequest.Size(m)
+func (m *ShowPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowPartitionsRequest.Size(m)
 }
-func (m *DropIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropIndexRequest.DiscardUnknown(m)
+func (m *ShowPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowPartitionsRequest proto.InternalMessageInfo
 
-func (m *DropIndexRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowPartitionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropIndexRequest) GetDbName() string {
+func (m *ShowPartitionsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropIndexRequest) GetCollectionName() string {
+func (m *ShowPartitionsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DropIndexRequest) GetFieldName() string {
+func (m *ShowPartitionsRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.FieldName
+		return m.CollectionID
 	}
-	return ""
+	return 0
 }
 
-func (m *DropIndexRequest) GetIndexName() string {
+func (m *ShowPartitionsRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.IndexName
+		return m.PartitionNames
 	}
-	return ""
+	return nil
 }
 
-type InsertRequest struct {
-	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
-	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+func (m *ShowPartitionsRequest) GetType() ShowType {
+	if m != nil {
+		return m.Type
+	}
+	return ShowType_All
 }
 
-func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
-func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
-func (*InsertRequest) ProtoMessage()    {}
-func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{38}
+func (m *ShowPartitionsRequest) GetTimeStamp() uint64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
 }
 
-func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InsertRequest.Unmarshal(m, b)
-}
-func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
+type ShowPartitionsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PartitionNames       []string         `protobuf:"bytes,2,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	PartitionIDs         []int64          `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	CreatedTimestamps    []uint64         `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	CreatedUtcTimestamps []uint64         `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	InMemoryPercentages  []int64          `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *InsertRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertRequest.Merge(m, src)
+
+func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{} }
+func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowPartitionsResponse) ProtoMessage()    {}
+func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
-func (m *InsertRequest) XXX_Size() int {
-	return xxx_messageInfo_InsertRequest.Size(m)
+
+func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowPartitionsResponse.Unmarshal(m, b)
 }
-func (m *InsertRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
+func (m *ShowPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowPartitionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ShowPartitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsResponse.Merge(m, src)
+}
+func (m *ShowPartitionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowPartitionsResponse.Size(m)
+}
+func (m *ShowPartitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowPartitionsResponse proto.InternalMessageInfo
 
-func (m *InsertRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowPartitionsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *InsertRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *InsertRequest) GetCollectionName() string {
+func (m *ShowPartitionsResponse) GetPartitionNames() []string {
 	if m != nil {
-		return m.CollectionName
+		return m.PartitionNames
 	}
-	return ""
+	return nil
 }
 
-func (m *InsertRequest) GetPartitionName() string {
+func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
 	if m != nil {
-		return m.PartitionName
+		return m.PartitionIDs
 	}
-	return ""
+	return nil
 }
 
-func (m *InsertRequest) GetFieldsData() []*schemapb.FieldData {
+func (m *ShowPartitionsResponse) GetCreatedTimestamps() []uint64 {
 	if m != nil {
-		return m.FieldsData
+		return m.CreatedTimestamps
 	}
 	return nil
 }
 
-func (m *InsertRequest) GetHashKeys() []uint32 {
+func (m *ShowPartitionsResponse) GetCreatedUtcTimestamps() []uint64 {
 	if m != nil {
-		return m.HashKeys
+		return m.CreatedUtcTimestamps
 	}
 	return nil
 }
 
-func (m *InsertRequest) GetNumRows() uint32 {
+func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
 	if m != nil {
-		return m.NumRows
+		return m.InMemoryPercentages
 	}
-	return 0
+	return nil
 }
 
-type MutationResult struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
-	SuccIndex            []uint32         `protobuf:"varint,3,rep,packed,name=succ_index,json=succIndex,proto3" json:"succ_index,omitempty"`
-	ErrIndex             []uint32         `protobuf:"varint,4,rep,packed,name=err_index,json=errIndex,proto3" json:"err_index,omitempty"`
-	Acknowledged         bool             `protobuf:"varint,5,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
-	InsertCnt            int64            `protobuf:"varint,6,opt,name=insert_cnt,json=insertCnt,proto3" json:"insert_cnt,omitempty"`
-	DeleteCnt            int64            `protobuf:"varint,7,opt,name=delete_cnt,json=deleteCnt,proto3" json:"delete_cnt,omitempty"`
-	UpsertCnt            int64            `protobuf:"varint,8,opt,name=upsert_cnt,json=upsertCnt,proto3" json:"upsert_cnt,omitempty"`
-	Timestamp            uint64           `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type DescribeSegmentRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *MutationResult) Reset()         { *m = MutationResult{} }
-func (m *MutationResult) String() string { return proto.CompactTextString(m) }
-func (*MutationResult) ProtoMessage()    {}
-func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{} }
+func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeSegmentRequest) ProtoMessage()    {}
+func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
-func (m *MutationResult) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MutationResult.Unmarshal(m, b)
+func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSegmentRequest.Unmarshal(m, b)
 }
-func (m *MutationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MutationResult.Marshal(b, m, deterministic)
+func (m *DescribeSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSegmentRequest.Marshal(b, m, deterministic)
 }
-func (m *MutationResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MutationResult.Merge(m, src)
+func (m *DescribeSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSegmentRequest.Merge(m, src)
 }
-func (m *MutationResult) XXX_Size() int {
-	return xxx_messageInfo_MutationResult.Size(m)
+func (m *DescribeSegmentRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeSegmentRequest.Size(m)
 }
-func (m *MutationResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_MutationResult.DiscardUnknown(m)
+func (m *DescribeSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSegmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MutationResult proto.InternalMessageInfo
+var xxx_messageInfo_DescribeSegmentRequest proto.InternalMessageInfo
 
-func (m *MutationResult) GetStatus() *commonpb.Status {
+func (m *DescribeSegmentRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *MutationResult) GetIDs() *schemapb.IDs {
+func (m *DescribeSegmentRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.IDs
+		return m.CollectionID
 	}
-	return nil
+	return 0
 }
 
-func (m *MutationResult) GetSuccIndex() []uint32 {
+func (m *DescribeSegmentRequest) GetSegmentID() int64 {
 	if m != nil {
-		return m.SuccIndex
+		return m.SegmentID
 	}
-	return nil
+	return 0
 }
 
-func (m *MutationResult) GetErrIndex() []uint32 {
-	if m != nil {
-		return m.ErrIndex
-	}
-	return nil
+type DescribeSegmentResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID              int64            `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	EnableIndex          bool             `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *MutationResult) GetAcknowledged() bool {
-	if m != nil {
-		return m.Acknowledged
-	}
-	return false
+func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse{} }
+func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeSegmentResponse) ProtoMessage()    {}
+func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
-func (m *MutationResult) GetInsertCnt() int64 {
+func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSegmentResponse.Unmarshal(m, b)
+}
+func (m *DescribeSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSegmentResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeSegmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSegmentResponse.Merge(m, src)
+}
+func (m *DescribeSegmentResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeSegmentResponse.Size(m)
+}
+func (m *DescribeSegmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSegmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeSegmentResponse proto.InternalMessageInfo
+
+func (m *DescribeSegmentResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.InsertCnt
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-func (m *MutationResult) GetDeleteCnt() int64 {
+func (m *DescribeSegmentResponse) GetIndexID() int64 {
 	if m != nil {
-		return m.DeleteCnt
+		return m.IndexID
 	}
 	return 0
 }
 
-func (m *MutationResult) GetUpsertCnt() int64 {
+func (m *DescribeSegmentResponse) GetBuildID() int64 {
 	if m != nil {
-		return m.UpsertCnt
+		return m.BuildID
 	}
 	return 0
 }
 
-func (m *MutationResult) GetTimestamp() uint64 {
+func (m *DescribeSegmentResponse) GetEnableIndex() bool {
 	if m != nil {
-		return m.Timestamp
+		return m.EnableIndex
 	}
-	return 0
+	return false
 }
 
-type DeleteRequest struct {
+type ShowSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()    {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
+func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowSegmentsRequest) ProtoMessage()    {}
+func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{46}
 }
 
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowSegmentsRequest.Unmarshal(m, b)
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+func (m *ShowSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowSegmentsRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *ShowSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowSegmentsRequest.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteRequest.Size(m)
+func (m *ShowSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowSegmentsRequest.Size(m)
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+func (m *ShowSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowSegmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowSegmentsRequest proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowSegmentsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DeleteRequest) GetDbName() string {
+func (m *ShowSegmentsRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.DbName
+		return m.CollectionID
 	}
-	return ""
+	return 0
 }
 
-func (m *DeleteRequest) GetCollectionName() string {
+func (m *ShowSegmentsRequest) GetPartitionID() int64 {
 	if m != nil {
-		return m.CollectionName
-	}
-	return ""
-}
-
-func (m *DeleteRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-func (m *DeleteRequest) GetExpr() string {
-	if m != nil {
-		return m.Expr
+		return m.PartitionID
 	}
-	return ""
+	return 0
 }
 
-type PlaceholderValue struct {
-	Tag  string          `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Type PlaceholderType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.milvus.PlaceholderType" json:"type,omitempty"`
-	// values is a 2d-array, every array contains a vector
-	Values               [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ShowSegmentsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SegmentIDs           []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
-func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
-func (*PlaceholderValue) ProtoMessage()    {}
-func (*PlaceholderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
+func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowSegmentsResponse) ProtoMessage()    {}
+func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
-func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlaceholderValue.Unmarshal(m, b)
-}
-func (m *PlaceholderValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlaceholderValue.Marshal(b, m, deterministic)
+func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowSegmentsResponse.Unmarshal(m, b)
 }
-func (m *PlaceholderValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlaceholderValue.Merge(m, src)
+func (m *ShowSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowSegmentsResponse.Marshal(b, m, deterministic)
 }
-func (m *PlaceholderValue) XXX_Size() int {
-	return xxx_messageInfo_PlaceholderValue.Size(m)
+func (m *ShowSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowSegmentsResponse.Merge(m, src)
 }
-func (m *PlaceholderValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlaceholderValue.DiscardUnknown(m)
+func (m *ShowSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowSegmentsResponse.Size(m)
 }
-
-var xxx_messageInfo_PlaceholderValue proto.InternalMessageInfo
-
-func (m *PlaceholderValue) GetTag() string {
-	if m != nil {
-		return m.Tag
-	}
-	return ""
+func (m *ShowSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowSegmentsResponse.DiscardUnknown(m)
 }
 
-func (m *PlaceholderValue) GetType() PlaceholderType {
-	if m != nil {
-		return m.Type
-	}
-	return PlaceholderType_None
-}
+var xxx_messageInfo_ShowSegmentsResponse proto.InternalMessageInfo
 
-func (m *PlaceholderValue) GetValues() [][]byte {
+func (m *ShowSegmentsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Values
+		return m.Status
 	}
 	return nil
 }
 
-type PlaceholderGroup struct {
-	Placeholders         []*PlaceholderValue `protobuf:"bytes,1,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
-}
-
-func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
-func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
-func (*PlaceholderGroup) ProtoMessage()    {}
-func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
-}
-
-func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlaceholderGroup.Unmarshal(m, b)
-}
-func (m *PlaceholderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlaceholderGroup.Marshal(b, m, deterministic)
-}
-func (m *PlaceholderGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlaceholderGroup.Merge(m, src)
-}
-func (m *PlaceholderGroup) XXX_Size() int {
-	return xxx_messageInfo_PlaceholderGroup.Size(m)
-}
-func (m *PlaceholderGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlaceholderGroup.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PlaceholderGroup proto.InternalMessageInfo
-
-func (m *PlaceholderGroup) GetPlaceholders() []*PlaceholderValue {
+func (m *ShowSegmentsResponse) GetSegmentIDs() []int64 {
 	if m != nil {
-		return m.Placeholders
+		return m.SegmentIDs
 	}
 	return nil
 }
 
-type SearchRequest struct {
-	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
-	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+type CreateIndexRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string                   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	ExtraParams          []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
-func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
-func (*SearchRequest) ProtoMessage()    {}
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
+func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateIndexRequest) ProtoMessage()    {}
+func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
-func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateIndexRequest.Unmarshal(m, b)
 }
-func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+func (m *CreateIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *SearchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchRequest.Merge(m, src)
+func (m *CreateIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateIndexRequest.Merge(m, src)
 }
-func (m *SearchRequest) XXX_Size() int {
-	return xxx_messageInfo_SearchRequest.Size(m)
+func (m *CreateIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateIndexRequest.Size(m)
 }
-func (m *SearchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+func (m *CreateIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateIndexRequest proto.InternalMessageInfo
 
-func (m *SearchRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateIndexRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetDbName() string {
+func (m *CreateIndexRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *SearchRequest) GetCollectionName() string {
+func (m *CreateIndexRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *SearchRequest) GetPartitionNames() []string {
-	if m != nil {
-		return m.PartitionNames
-	}
-	return nil
-}
-
-func (m *SearchRequest) GetDsl() string {
+func (m *CreateIndexRequest) GetFieldName() string {
 	if m != nil {
-		return m.Dsl
+		return m.FieldName
 	}
 	return ""
 }
 
-func (m *SearchRequest) GetPlaceholderGroup() []byte {
+func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.PlaceholderGroup
+		return m.ExtraParams
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetDslType() commonpb.DslType {
+type DescribeIndexRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
+func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeIndexRequest) ProtoMessage()    {}
+func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{49}
+}
+
+func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeIndexRequest.Unmarshal(m, b)
+}
+func (m *DescribeIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeIndexRequest.Merge(m, src)
+}
+func (m *DescribeIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeIndexRequest.Size(m)
+}
+func (m *DescribeIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeIndexRequest proto.InternalMessageInfo
+
+func (m *DescribeIndexRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.DslType
+		return m.Base
 	}
-	return commonpb.DslType_Dsl
+	return nil
 }
 
-func (m *SearchRequest) GetOutputFields() []string {
+func (m *DescribeIndexRequest) GetDbName() string {
 	if m != nil {
-		return m.OutputFields
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *SearchRequest) GetSearchParams() []*commonpb.KeyValuePair {
+func (m *DescribeIndexRequest) GetCollectionName() string {
 	if m != nil {
-		return m.SearchParams
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *SearchRequest) GetTravelTimestamp() uint64 {
+func (m *DescribeIndexRequest) GetFieldName() string {
 	if m != nil {
-		return m.TravelTimestamp
+		return m.FieldName
 	}
-	return 0
+	return ""
 }
 
-func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
+func (m *DescribeIndexRequest) GetIndexName() string {
 	if m != nil {
-		return m.GuaranteeTimestamp
+		return m.IndexName
 	}
-	return 0
+	return ""
 }
 
-type Hits struct {
-	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
-	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
-	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type IndexDescription struct {
+	IndexName            string                   `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID              int64                    `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	FieldName            string                   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *Hits) Reset()         { *m = Hits{} }
-func (m *Hits) String() string { return proto.CompactTextString(m) }
-func (*Hits) ProtoMessage()    {}
-func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
+func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
+func (*IndexDescription) ProtoMessage()    {}
+func (*IndexDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
-func (m *Hits) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Hits.Unmarshal(m, b)
+func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexDescription.Unmarshal(m, b)
 }
-func (m *Hits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Hits.Marshal(b, m, deterministic)
+func (m *IndexDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexDescription.Marshal(b, m, deterministic)
 }
-func (m *Hits) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Hits.Merge(m, src)
+func (m *IndexDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexDescription.Merge(m, src)
 }
-func (m *Hits) XXX_Size() int {
-	return xxx_messageInfo_Hits.Size(m)
+func (m *IndexDescription) XXX_Size() int {
+	return xxx_messageInfo_IndexDescription.Size(m)
 }
-func (m *Hits) XXX_DiscardUnknown() {
-	xxx_messageInfo_Hits.DiscardUnknown(m)
+func (m *IndexDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexDescription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Hits proto.InternalMessageInfo
+var xxx_messageInfo_IndexDescription proto.InternalMessageInfo
 
-func (m *Hits) GetIDs() []int64 {
+func (m *IndexDescription) GetIndexName() string {
 	if m != nil {
-		return m.IDs
+		return m.IndexName
 	}
-	return nil
+	return ""
 }
 
-func (m *Hits) GetRowData() [][]byte {
+func (m *IndexDescription) GetIndexID() int64 {
 	if m != nil {
-		return m.RowData
+		return m.IndexID
 	}
-	return nil
+	return 0
 }
 
-func (m *Hits) GetScores() []float32 {
+func (m *IndexDescription) GetParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.Scores
+		return m.Params
 	}
 	return nil
 }
 
-type SearchResults struct {
-	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *IndexDescription) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
 }
 
-func (m *SearchResults) Reset()         { *m = SearchResults{} }
-func (m *SearchResults) String() string { return proto.CompactTextString(m) }
-func (*SearchResults) ProtoMessage()    {}
-func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+type DescribeIndexResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexDescriptions    []*IndexDescription `protobuf:"bytes,2,rep,name=index_descriptions,json=indexDescriptions,proto3" json:"index_descriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *SearchResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
+func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
+func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeIndexResponse) ProtoMessage()    {}
+func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{51}
 }
-func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
+
+func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeIndexResponse.Unmarshal(m, b)
 }
-func (m *SearchResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResults.Merge(m, src)
+func (m *DescribeIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeIndexResponse.Marshal(b, m, deterministic)
 }
-func (m *SearchResults) XXX_Size() int {
-	return xxx_messageInfo_SearchResults.Size(m)
+func (m *DescribeIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeIndexResponse.Merge(m, src)
 }
-func (m *SearchResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResults.DiscardUnknown(m)
+func (m *DescribeIndexResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeIndexResponse.Size(m)
+}
+func (m *DescribeIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeIndexResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+var xxx_messageInfo_DescribeIndexResponse proto.InternalMessageInfo
 
-func (m *SearchResults) GetStatus() *commonpb.Status {
+func (m *DescribeIndexResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+func (m *DescribeIndexResponse) GetIndexDescriptions() []*IndexDescription {
 	if m != nil {
-		return m.Results
+		return m.IndexDescriptions
 	}
 	return nil
 }
 
-type FlushRequest struct {
+type GetIndexBuildProgressRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
-func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
-func (*FlushRequest) ProtoMessage()    {}
-func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgressRequest{} }
+func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
+func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{52}
 }
 
-func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
+func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexBuildProgressRequest.Unmarshal(m, b)
 }
-func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
+func (m *GetIndexBuildProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexBuildProgressRequest.Marshal(b, m, deterministic)
 }
-func (m *FlushRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushRequest.Merge(m, src)
+func (m *GetIndexBuildProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexBuildProgressRequest.Merge(m, src)
 }
-func (m *FlushRequest) XXX_Size() int {
-	return xxx_messageInfo_FlushRequest.Size(m)
+func (m *GetIndexBuildProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexBuildProgressRequest.Size(m)
 }
-func (m *FlushRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
+func (m *GetIndexBuildProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexBuildProgressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexBuildProgressRequest proto.InternalMessageInfo
 
-func (m *FlushRequest) GetBase() *commonpb.MsgBase {
+func (m *GetIndexBuildProgressRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *FlushRequest) GetDbName() string {
+func (m *GetIndexBuildProgressRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *FlushRequest) GetCollectionNames() []string {
+func (m *GetIndexBuildProgressRequest) GetCollectionName() string {
 	if m != nil {
-		return m.CollectionNames
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-type FlushResponse struct {
-	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+func (m *GetIndexBuildProgressRequest) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
 }
 
-func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
-func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
-func (*FlushResponse) ProtoMessage()    {}
-func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+func (m *GetIndexBuildProgressRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
 }
 
-func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
+type GetIndexBuildProgressResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexedRows          int64            `protobuf:"varint,2,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
+	TotalRows            int64            `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
+
+func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProgressResponse{} }
+func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
+func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{53}
 }
-func (m *FlushResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushResponse.Merge(m, src)
+
+func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexBuildProgressResponse.Unmarshal(m, b)
 }
-func (m *FlushResponse) XXX_Size() int {
-	return xxx_messageInfo_FlushResponse.Size(m)
+func (m *GetIndexBuildProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexBuildProgressResponse.Marshal(b, m, deterministic)
 }
-func (m *FlushResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
+func (m *GetIndexBuildProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexBuildProgressResponse.Merge(m, src)
+}
+func (m *GetIndexBuildProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexBuildProgressResponse.Size(m)
+}
+func (m *GetIndexBuildProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexBuildProgressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexBuildProgressResponse proto.InternalMessageInfo
 
-func (m *FlushResponse) GetStatus() *commonpb.Status {
+func (m *GetIndexBuildProgressResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *FlushResponse) GetDbName() string {
+func (m *GetIndexBuildProgressResponse) GetIndexedRows() int64 {
 	if m != nil {
-		return m.DbName
+		return m.IndexedRows
 	}
-	return ""
+	return 0
 }
 
-func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+func (m *GetIndexBuildProgressResponse) GetTotalRows() int64 {
 	if m != nil {
-		return m.CollSegIDs
+		return m.TotalRows
 	}
-	return nil
+	return 0
 }
 
-type QueryRequest struct {
+type GetIndexStateRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
-func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRequest) ProtoMessage()    {}
-func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
+func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStateRequest) ProtoMessage()    {}
+func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
 
-func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStateRequest.Unmarshal(m, b)
 }
-func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+func (m *GetIndexStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStateRequest.Marshal(b, m, deterministic)
 }
-func (m *QueryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRequest.Merge(m, src)
+func (m *GetIndexStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStateRequest.Merge(m, src)
 }
-func (m *QueryRequest) XXX_Size() int {
-	return xxx_messageInfo_QueryRequest.Size(m)
+func (m *GetIndexStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStateRequest.Size(m)
 }
-func (m *QueryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+func (m *GetIndexStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexStateRequest proto.InternalMessageInfo
 
-func (m *QueryRequest) GetBase() *commonpb.MsgBase {
+func (m *GetIndexStateRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *QueryRequest) GetDbName() string {
+func (m *GetIndexStateRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetCollectionName() string {
+func (m *GetIndexStateRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetExpr() string {
+func (m *GetIndexStateRequest) GetFieldName() string {
 	if m != nil {
-		return m.Expr
+		return m.FieldName
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetOutputFields() []string {
+func (m *GetIndexStateRequest) GetIndexName() string {
 	if m != nil {
-		return m.OutputFields
+		return m.IndexName
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryRequest) GetPartitionNames() []string {
+type GetIndexStateResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason           string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
+func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStateResponse) ProtoMessage()    {}
+func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{55}
+}
+
+func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStateResponse.Unmarshal(m, b)
+}
+func (m *GetIndexStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetIndexStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStateResponse.Merge(m, src)
+}
+func (m *GetIndexStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStateResponse.Size(m)
+}
+func (m *GetIndexStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndexStateResponse proto.InternalMessageInfo
+
+func (m *GetIndexStateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.PartitionNames
+		return m.Status
 	}
 	return nil
 }
 
-func (m *QueryRequest) GetTravelTimestamp() uint64 {
+func (m *GetIndexStateResponse) GetState() commonpb.IndexState {
 	if m != nil {
-		return m.TravelTimestamp
+		return m.State
 	}
-	return 0
+	return commonpb.IndexState_IndexStateNone
 }
 
-func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
+func (m *GetIndexStateResponse) GetFailReason() string {
 	if m != nil {
-		return m.GuaranteeTimestamp
+		return m.FailReason
 	}
-	return 0
+	return ""
 }
 
-type QueryResults struct {
-	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type DropIndexRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *QueryResults) Reset()         { *m = QueryResults{} }
-func (m *QueryResults) String() string { return proto.CompactTextString(m) }
-func (*QueryResults) ProtoMessage()    {}
-func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
+func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*DropIndexRequest) ProtoMessage()    {}
+func (*DropIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
 
-func (m *QueryResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
+func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropIndexRequest.Unmarshal(m, b)
 }
-func (m *QueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryResults.Marshal(b, m, deterministic)
+func (m *DropIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *QueryResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResults.Merge(m, src)
+func (m *DropIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropIndexRequest.Merge(m, src)
 }
-func (m *QueryResults) XXX_Size() int {
-	return xxx_messageInfo_QueryResults.Size(m)
+func (m *DropIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_DropIndexRequest.Size(m)
 }
-func (m *QueryResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryResults.DiscardUnknown(m)
+func (m *DropIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryResults proto.InternalMessageInfo
+var xxx_messageInfo_DropIndexRequest proto.InternalMessageInfo
 
-func (m *QueryResults) GetStatus() *commonpb.Status {
+func (m *DropIndexRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
+func (m *DropIndexRequest) GetDbName() string {
 	if m != nil {
-		return m.FieldsData
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-type VectorIDs struct {
-	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IdArray              *schemapb.IDs `protobuf:"bytes,3,opt,name=id_array,json=idArray,proto3" json:"id_array,omitempty"`
-	PartitionNames       []string      `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *DropIndexRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
-func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
-func (*VectorIDs) ProtoMessage()    {}
-func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
+func (m *DropIndexRequest) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
 }
 
-func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VectorIDs.Unmarshal(m, b)
+func (m *DropIndexRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
 }
-func (m *VectorIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VectorIDs.Marshal(b, m, deterministic)
+
+type InsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (m *VectorIDs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorIDs.Merge(m, src)
+
+func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
+func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertRequest) ProtoMessage()    {}
+func (*InsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{57}
 }
-func (m *VectorIDs) XXX_Size() int {
-	return xxx_messageInfo_VectorIDs.Size(m)
+
+func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertRequest.Unmarshal(m, b)
 }
-func (m *VectorIDs) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorIDs.DiscardUnknown(m)
+func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
+}
+func (m *InsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertRequest.Merge(m, src)
+}
+func (m *InsertRequest) XXX_Size() int {
+	return xxx_messageInfo_InsertRequest.Size(m)
+}
+func (m *InsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VectorIDs proto.InternalMessageInfo
+var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
 
-func (m *VectorIDs) GetCollectionName() string {
+func (m *InsertRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *InsertRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *InsertRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *VectorIDs) GetFieldName() string {
+func (m *InsertRequest) GetPartitionName() string {
 	if m != nil {
-		return m.FieldName
+		return m.PartitionName
 	}
 	return ""
 }
 
-func (m *VectorIDs) GetIdArray() *schemapb.IDs {
+func (m *InsertRequest) GetFieldsData() []*schemapb.FieldData {
 	if m != nil {
-		return m.IdArray
+		return m.FieldsData
 	}
 	return nil
 }
 
-func (m *VectorIDs) GetPartitionNames() []string {
+func (m *InsertRequest) GetHashKeys() []uint32 {
 	if m != nil {
-		return m.PartitionNames
+		return m.HashKeys
 	}
 	return nil
 }
 
-type VectorsArray struct {
-	// Types that are valid to be assigned to Array:
-	//	*VectorsArray_IdArray
-	//	*VectorsArray_DataArray
-	Array                isVectorsArray_Array `protobuf_oneof:"array"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *InsertRequest) GetNumRows() uint32 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
 }
 
-func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
-func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
-func (*VectorsArray) ProtoMessage()    {}
-func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+type MutationResult struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
+	SuccIndex            []uint32         `protobuf:"varint,3,rep,packed,name=succ_index,json=succIndex,proto3" json:"succ_index,omitempty"`
+	ErrIndex             []uint32         `protobuf:"varint,4,rep,packed,name=err_index,json=errIndex,proto3" json:"err_index,omitempty"`
+	Acknowledged         bool             `protobuf:"varint,5,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	InsertCnt            int64            `protobuf:"varint,6,opt,name=insert_cnt,json=insertCnt,proto3" json:"insert_cnt,omitempty"`
+	DeleteCnt            int64            `protobuf:"varint,7,opt,name=delete_cnt,json=deleteCnt,proto3" json:"delete_cnt,omitempty"`
+	UpsertCnt            int64            `protobuf:"varint,8,opt,name=upsert_cnt,json=upsertCnt,proto3" json:"upsert_cnt,omitempty"`
+	Timestamp            uint64           `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VectorsArray.Unmarshal(m, b)
+func (m *MutationResult) Reset()         { *m = MutationResult{} }
+func (m *MutationResult) String() string { return proto.CompactTextString(m) }
+func (*MutationResult) ProtoMessage()    {}
+func (*MutationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{58}
 }
-func (m *VectorsArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VectorsArray.Marshal(b, m, deterministic)
+
+func (m *MutationResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MutationResult.Unmarshal(m, b)
 }
-func (m *VectorsArray) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorsArray.Merge(m, src)
+func (m *MutationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MutationResult.Marshal(b, m, deterministic)
 }
-func (m *VectorsArray) XXX_Size() int {
-	return xxx_messageInfo_VectorsArray.Size(m)
+func (m *MutationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MutationResult.Merge(m, src)
 }
-func (m *VectorsArray) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorsArray.DiscardUnknown(m)
+func (m *MutationResult) XXX_Size() int {
+	return xxx_messageInfo_MutationResult.Size(m)
+}
+func (m *MutationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MutationResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VectorsArray proto.InternalMessageInfo
+var xxx_messageInfo_MutationResult proto.InternalMessageInfo
 
-type isVectorsArray_Array interface {
-	isVectorsArray_Array()
+func (m *MutationResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
 }
 
-type VectorsArray_IdArray struct {
-	IdArray *VectorIDs `protobuf:"bytes,1,opt,name=id_array,json=idArray,proto3,oneof"`
+func (m *MutationResult) GetIDs() *schemapb.IDs {
+	if m != nil {
+		return m.IDs
+	}
+	return nil
 }
 
-type VectorsArray_DataArray struct {
-	DataArray *schemapb.VectorField `protobuf:"bytes,2,opt,name=data_array,json=dataArray,proto3,oneof"`
+func (m *MutationResult) GetSuccIndex() []uint32 {
+	if m != nil {
+		return m.SuccIndex
+	}
+	return nil
 }
 
-func (*VectorsArray_IdArray) isVectorsArray_Array() {}
+func (m *MutationResult) GetErrIndex() []uint32 {
+	if m != nil {
+		return m.ErrIndex
+	}
+	return nil
+}
 
-func (*VectorsArray_DataArray) isVectorsArray_Array() {}
+func (m *MutationResult) GetAcknowledged() bool {
+	if m != nil {
+		return m.Acknowledged
+	}
+	return false
+}
 
-func (m *VectorsArray) GetArray() isVectorsArray_Array {
+func (m *MutationResult) GetInsertCnt() int64 {
 	if m != nil {
-		return m.Array
+		return m.InsertCnt
 	}
-	return nil
+	return 0
 }
 
-func (m *VectorsArray) GetIdArray() *VectorIDs {
-	if x, ok := m.GetArray().(*VectorsArray_IdArray); ok {
-		return x.IdArray
+func (m *MutationResult) GetDeleteCnt() int64 {
+	if m != nil {
+		return m.DeleteCnt
 	}
-	return nil
+	return 0
 }
 
-func (m *VectorsArray) GetDataArray() *schemapb.VectorField {
-	if x, ok := m.GetArray().(*VectorsArray_DataArray); ok {
-		return x.DataArray
+func (m *MutationResult) GetUpsertCnt() int64 {
+	if m != nil {
+		return m.UpsertCnt
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*VectorsArray) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*VectorsArray_IdArray)(nil),
-		(*VectorsArray_DataArray)(nil),
+func (m *MutationResult) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
 	}
+	return 0
 }
 
-type CalcDistanceRequest struct {
-	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	OpLeft               *VectorsArray            `protobuf:"bytes,2,opt,name=op_left,json=opLeft,proto3" json:"op_left,omitempty"`
-	OpRight              *VectorsArray            `protobuf:"bytes,3,opt,name=op_right,json=opRight,proto3" json:"op_right,omitempty"`
-	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type DeleteRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Expr                 string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
-func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
-func (*CalcDistanceRequest) ProtoMessage()    {}
-func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{59}
 }
 
-func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CalcDistanceRequest.Unmarshal(m, b)
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
 }
-func (m *CalcDistanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CalcDistanceRequest.Marshal(b, m, deterministic)
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
 }
-func (m *CalcDistanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CalcDistanceRequest.Merge(m, src)
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
 }
-func (m *CalcDistanceRequest) XXX_Size() int {
-	return xxx_messageInfo_CalcDistanceRequest.Size(m)
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
 }
-func (m *CalcDistanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CalcDistanceRequest.DiscardUnknown(m)
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CalcDistanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
 
-func (m *CalcDistanceRequest) GetBase() *commonpb.MsgBase {
+func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CalcDistanceRequest) GetOpLeft() *VectorsArray {
+func (m *DeleteRequest) GetDbName() string {
 	if m != nil {
-		return m.OpLeft
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *CalcDistanceRequest) GetOpRight() *VectorsArray {
+func (m *DeleteRequest) GetCollectionName() string {
 	if m != nil {
-		return m.OpRight
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *CalcDistanceRequest) GetParams() []*commonpb.KeyValuePair {
+func (m *DeleteRequest) GetPartitionName() string {
 	if m != nil {
-		return m.Params
+		return m.PartitionName
 	}
-	return nil
+	return ""
 }
 
-type CalcDistanceResults struct {
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// num(op_left)*num(op_right) distance values, "HAMMIN" return integer distance
-	//
-	// Types that are valid to be assigned to Array:
-	//	*CalcDistanceResults_IntDist
-	//	*CalcDistanceResults_FloatDist
-	Array                isCalcDistanceResults_Array `protobuf_oneof:"array"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *DeleteRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
 }
 
-func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
-func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
-func (*CalcDistanceResults) ProtoMessage()    {}
-func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+type UpsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CalcDistanceResults.Unmarshal(m, b)
+func (m *UpsertRequest) Reset()         { *m = UpsertRequest{} }
+func (m *UpsertRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertRequest) ProtoMessage()    {}
+func (*UpsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{60}
 }
-func (m *CalcDistanceResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CalcDistanceResults.Marshal(b, m, deterministic)
+
+func (m *UpsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertRequest.Unmarshal(m, b)
 }
-func (m *CalcDistanceResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CalcDistanceResults.Merge(m, src)
+func (m *UpsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertRequest.Marshal(b, m, deterministic)
 }
-func (m *CalcDistanceResults) XXX_Size() int {
-	return xxx_messageInfo_CalcDistanceResults.Size(m)
+func (m *UpsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertRequest.Merge(m, src)
 }
-func (m *CalcDistanceResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_CalcDistanceResults.DiscardUnknown(m)
+func (m *UpsertRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertRequest.Size(m)
+}
+func (m *UpsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CalcDistanceResults proto.InternalMessageInfo
+var xxx_messageInfo_UpsertRequest proto.InternalMessageInfo
 
-func (m *CalcDistanceResults) GetStatus() *commonpb.Status {
+func (m *UpsertRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-type isCalcDistanceResults_Array interface {
-	isCalcDistanceResults_Array()
+func (m *UpsertRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-type CalcDistanceResults_IntDist struct {
-	IntDist *schemapb.IntArray `protobuf:"bytes,2,opt,name=int_dist,json=intDist,proto3,oneof"`
+func (m *UpsertRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-type CalcDistanceResults_FloatDist struct {
-	FloatDist *schemapb.FloatArray `protobuf:"bytes,3,opt,name=float_dist,json=floatDist,proto3,oneof"`
+func (m *UpsertRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
 }
 
-func (*CalcDistanceResults_IntDist) isCalcDistanceResults_Array() {}
-
-func (*CalcDistanceResults_FloatDist) isCalcDistanceResults_Array() {}
-
-func (m *CalcDistanceResults) GetArray() isCalcDistanceResults_Array {
+func (m *UpsertRequest) GetFieldsData() []*schemapb.FieldData {
 	if m != nil {
-		return m.Array
+		return m.FieldsData
 	}
 	return nil
 }
 
-func (m *CalcDistanceResults) GetIntDist() *schemapb.IntArray {
-	if x, ok := m.GetArray().(*CalcDistanceResults_IntDist); ok {
-		return x.IntDist
+func (m *UpsertRequest) GetHashKeys() []uint32 {
+	if m != nil {
+		return m.HashKeys
 	}
 	return nil
 }
 
-func (m *CalcDistanceResults) GetFloatDist() *schemapb.FloatArray {
-	if x, ok := m.GetArray().(*CalcDistanceResults_FloatDist); ok {
-		return x.FloatDist
-	}
-	return nil
-}
-
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*CalcDistanceResults) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*CalcDistanceResults_IntDist)(nil),
-		(*CalcDistanceResults_FloatDist)(nil),
+func (m *UpsertRequest) GetNumRows() uint32 {
+	if m != nil {
+		return m.NumRows
 	}
+	return 0
 }
 
-type PersistentSegmentInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	NumRows              int64                 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	State                commonpb.SegmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type PlaceholderValue struct {
+	Tag  string          `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Type PlaceholderType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.milvus.PlaceholderType" json:"type,omitempty"`
+	// values is a 2d-array, every array contains a vector
+	Values               [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
-func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
-func (*PersistentSegmentInfo) ProtoMessage()    {}
-func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
+func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
+func (*PlaceholderValue) ProtoMessage()    {}
+func (*PlaceholderValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{61}
 }
 
-func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PersistentSegmentInfo.Unmarshal(m, b)
+func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaceholderValue.Unmarshal(m, b)
 }
-func (m *PersistentSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PersistentSegmentInfo.Marshal(b, m, deterministic)
+func (m *PlaceholderValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaceholderValue.Marshal(b, m, deterministic)
 }
-func (m *PersistentSegmentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PersistentSegmentInfo.Merge(m, src)
+func (m *PlaceholderValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceholderValue.Merge(m, src)
 }
-func (m *PersistentSegmentInfo) XXX_Size() int {
-	return xxx_messageInfo_PersistentSegmentInfo.Size(m)
+func (m *PlaceholderValue) XXX_Size() int {
+	return xxx_messageInfo_PlaceholderValue.Size(m)
 }
-func (m *PersistentSegmentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PersistentSegmentInfo.DiscardUnknown(m)
+func (m *PlaceholderValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceholderValue.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PersistentSegmentInfo proto.InternalMessageInfo
+var xxx_messageInfo_PlaceholderValue proto.InternalMessageInfo
 
-func (m *PersistentSegmentInfo) GetSegmentID() int64 {
+func (m *PlaceholderValue) GetTag() string {
 	if m != nil {
-		return m.SegmentID
+		return m.Tag
 	}
-	return 0
+	return ""
 }
 
-func (m *PersistentSegmentInfo) GetCollectionID() int64 {
+func (m *PlaceholderValue) GetType() PlaceholderType {
 	if m != nil {
-		return m.CollectionID
+		return m.Type
 	}
-	return 0
+	return PlaceholderType_None
 }
 
-func (m *PersistentSegmentInfo) GetPartitionID() int64 {
+func (m *PlaceholderValue) GetValues() [][]byte {
 	if m != nil {
-		return m.PartitionID
+		return m.Values
 	}
-	return 0
+	return nil
 }
 
-func (m *PersistentSegmentInfo) GetNumRows() int64 {
-	if m != nil {
-		return m.NumRows
-	}
-	return 0
+type PlaceholderGroup struct {
+	Placeholders         []*PlaceholderValue `protobuf:"bytes,1,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *PersistentSegmentInfo) GetState() commonpb.SegmentState {
+func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
+func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
+func (*PlaceholderGroup) ProtoMessage()    {}
+func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{62}
+}
+
+func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaceholderGroup.Unmarshal(m, b)
+}
+func (m *PlaceholderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaceholderGroup.Marshal(b, m, deterministic)
+}
+func (m *PlaceholderGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceholderGroup.Merge(m, src)
+}
+func (m *PlaceholderGroup) XXX_Size() int {
+	return xxx_messageInfo_PlaceholderGroup.Size(m)
+}
+func (m *PlaceholderGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceholderGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaceholderGroup proto.InternalMessageInfo
+
+func (m *PlaceholderGroup) GetPlaceholders() []*PlaceholderValue {
 	if m != nil {
-		return m.State
+		return m.Placeholders
 	}
-	return commonpb.SegmentState_SegmentStateNone
+	return nil
 }
 
-type GetPersistentSegmentInfoRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type SearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
+	// serialized `PlaceholderGroup`
+	PlaceholderGroup   []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	OutputFields       []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	SearchParams       []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	TravelTimestamp    uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	// RFC3339 time to search at, used when travel_timestamp is 0
+	TravelTime string `protobuf:"bytes,12,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
+	// Consistency level of the search, ignored if guarantee_timestamp is set
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,13,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// Use the consistency level of the collection instead of consistency_level
+	UseDefaultConsistency bool     `protobuf:"varint,14,opt,name=use_default_consistency,json=useDefaultConsistency,proto3" json:"use_default_consistency,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
-func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSegmentInfoRequest{} }
-func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
-func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{63}
 }
 
-func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Unmarshal(m, b)
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Marshal(b, m, deterministic)
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPersistentSegmentInfoRequest.Merge(m, src)
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Size(m)
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPersistentSegmentInfoRequest.DiscardUnknown(m)
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPersistentSegmentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
 
-func (m *GetPersistentSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+func (m *SearchRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetPersistentSegmentInfoRequest) GetDbName() string {
+func (m *SearchRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *GetPersistentSegmentInfoRequest) GetCollectionName() string {
+func (m *SearchRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-type GetPersistentSegmentInfoResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Infos                []*PersistentSegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *SearchRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
 }
 
-func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentSegmentInfoResponse{} }
-func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
-func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+func (m *SearchRequest) GetDsl() string {
+	if m != nil {
+		return m.Dsl
+	}
+	return ""
 }
 
-func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Unmarshal(m, b)
-}
-func (m *GetPersistentSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Marshal(b, m, deterministic)
-}
-func (m *GetPersistentSegmentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPersistentSegmentInfoResponse.Merge(m, src)
-}
-func (m *GetPersistentSegmentInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Size(m)
-}
-func (m *GetPersistentSegmentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPersistentSegmentInfoResponse.DiscardUnknown(m)
+func (m *SearchRequest) GetPlaceholderGroup() []byte {
+	if m != nil {
+		return m.PlaceholderGroup
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetPersistentSegmentInfoResponse proto.InternalMessageInfo
+func (m *SearchRequest) GetDslType() commonpb.DslType {
+	if m != nil {
+		return m.DslType
+	}
+	return commonpb.DslType_Dsl
+}
 
-func (m *GetPersistentSegmentInfoResponse) GetStatus() *commonpb.Status {
+func (m *SearchRequest) GetOutputFields() []string {
 	if m != nil {
-		return m.Status
+		return m.OutputFields
 	}
 	return nil
 }
 
-func (m *GetPersistentSegmentInfoResponse) GetInfos() []*PersistentSegmentInfo {
+func (m *SearchRequest) GetSearchParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.Infos
+		return m.SearchParams
 	}
 	return nil
 }
 
-type QuerySegmentInfo struct {
-	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64    `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	MemSize              int64    `protobuf:"varint,4,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
-	NumRows              int64    `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexName            string   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64    `protobuf:"varint,7,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SearchRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
 }
 
-func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
-func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
-func (*QuerySegmentInfo) ProtoMessage()    {}
-func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
 }
 
-func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QuerySegmentInfo.Unmarshal(m, b)
+func (m *SearchRequest) GetTravelTime() string {
+	if m != nil {
+		return m.TravelTime
+	}
+	return ""
 }
-func (m *QuerySegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QuerySegmentInfo.Marshal(b, m, deterministic)
+
+func (m *SearchRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
 }
-func (m *QuerySegmentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySegmentInfo.Merge(m, src)
+
+func (m *SearchRequest) GetUseDefaultConsistency() bool {
+	if m != nil {
+		return m.UseDefaultConsistency
+	}
+	return false
 }
-func (m *QuerySegmentInfo) XXX_Size() int {
-	return xxx_messageInfo_QuerySegmentInfo.Size(m)
+
+// HybridSearchRequest searches several vector fields of a collection, and fuses the hits into a single ranked list
+type HybridSearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// ANN sub-requests, each against a vector field with its own search params and filter
+	Requests     []*SearchRequest `protobuf:"bytes,5,rep,name=requests,proto3" json:"requests,omitempty"`
+	OutputFields []string         `protobuf:"bytes,6,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	// strategy ("rrf" with "k", or "weighted" with "weights"), topk and offset of the fused hits
+	RankParams         []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=rank_params,json=rankParams,proto3" json:"rank_params,omitempty"`
+	TravelTimestamp    uint64                   `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64                   `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	// Consistency level of the search, ignored if guarantee_timestamp is set
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,10,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// Use the consistency level of the collection instead of consistency_level
+	UseDefaultConsistency bool     `protobuf:"varint,11,opt,name=use_default_consistency,json=useDefaultConsistency,proto3" json:"use_default_consistency,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *HybridSearchRequest) Reset()         { *m = HybridSearchRequest{} }
+func (m *HybridSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*HybridSearchRequest) ProtoMessage()    {}
+func (*HybridSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{64}
 }
-func (m *QuerySegmentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySegmentInfo.DiscardUnknown(m)
+
+func (m *HybridSearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridSearchRequest.Unmarshal(m, b)
+}
+func (m *HybridSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridSearchRequest.Marshal(b, m, deterministic)
+}
+func (m *HybridSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridSearchRequest.Merge(m, src)
+}
+func (m *HybridSearchRequest) XXX_Size() int {
+	return xxx_messageInfo_HybridSearchRequest.Size(m)
+}
+func (m *HybridSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridSearchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySegmentInfo proto.InternalMessageInfo
+var xxx_messageInfo_HybridSearchRequest proto.InternalMessageInfo
 
-func (m *QuerySegmentInfo) GetSegmentID() int64 {
+func (m *HybridSearchRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.SegmentID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySegmentInfo) GetCollectionID() int64 {
+func (m *HybridSearchRequest) GetDbName() string {
 	if m != nil {
-		return m.CollectionID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *QuerySegmentInfo) GetPartitionID() int64 {
+func (m *HybridSearchRequest) GetCollectionName() string {
 	if m != nil {
-		return m.PartitionID
+		return m.CollectionName
 	}
-	return 0
+	return ""
 }
 
-func (m *QuerySegmentInfo) GetMemSize() int64 {
+func (m *HybridSearchRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.MemSize
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetRequests() []*SearchRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetRankParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.RankParams
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
 	}
 	return 0
 }
 
-func (m *QuerySegmentInfo) GetNumRows() int64 {
+func (m *HybridSearchRequest) GetGuaranteeTimestamp() uint64 {
 	if m != nil {
-		return m.NumRows
+		return m.GuaranteeTimestamp
 	}
 	return 0
 }
 
-func (m *QuerySegmentInfo) GetIndexName() string {
+func (m *HybridSearchRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
 	if m != nil {
-		return m.IndexName
+		return m.ConsistencyLevel
 	}
-	return ""
+	return commonpb.ConsistencyLevel_Strong
 }
 
-func (m *QuerySegmentInfo) GetIndexID() int64 {
+func (m *HybridSearchRequest) GetUseDefaultConsistency() bool {
 	if m != nil {
-		return m.IndexID
+		return m.UseDefaultConsistency
 	}
-	return 0
+	return false
 }
 
-type GetQuerySegmentInfoRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type Hits struct {
+	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
+	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoRequest{} }
-func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
-func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+func (m *Hits) Reset()         { *m = Hits{} }
+func (m *Hits) String() string { return proto.CompactTextString(m) }
+func (*Hits) ProtoMessage()    {}
+func (*Hits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{65}
 }
 
-func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Unmarshal(m, b)
+func (m *Hits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hits.Unmarshal(m, b)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Marshal(b, m, deterministic)
+func (m *Hits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hits.Marshal(b, m, deterministic)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetQuerySegmentInfoRequest.Merge(m, src)
+func (m *Hits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hits.Merge(m, src)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Size(m)
+func (m *Hits) XXX_Size() int {
+	return xxx_messageInfo_Hits.Size(m)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetQuerySegmentInfoRequest.DiscardUnknown(m)
+func (m *Hits) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hits.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetQuerySegmentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_Hits proto.InternalMessageInfo
 
-func (m *GetQuerySegmentInfoRequest) GetBase() *commonpb.MsgBase {
+func (m *Hits) GetIDs() []int64 {
 	if m != nil {
-		return m.Base
+		return m.IDs
 	}
 	return nil
 }
 
-func (m *GetQuerySegmentInfoRequest) GetDbName() string {
+func (m *Hits) GetRowData() [][]byte {
 	if m != nil {
-		return m.DbName
+		return m.RowData
 	}
-	return ""
+	return nil
 }
 
-func (m *GetQuerySegmentInfoRequest) GetCollectionName() string {
+func (m *Hits) GetScores() []float32 {
 	if m != nil {
-		return m.CollectionName
+		return m.Scores
 	}
-	return ""
+	return nil
 }
 
-type GetQuerySegmentInfoResponse struct {
-	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Infos                []*QuerySegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type SearchResults struct {
+	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfoResponse{} }
-func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
-func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+func (m *SearchResults) Reset()         { *m = SearchResults{} }
+func (m *SearchResults) String() string { return proto.CompactTextString(m) }
+func (*SearchResults) ProtoMessage()    {}
+func (*SearchResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
-func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Unmarshal(m, b)
+func (m *SearchResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Marshal(b, m, deterministic)
+func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetQuerySegmentInfoResponse.Merge(m, src)
+func (m *SearchResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResults.Merge(m, src)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Size(m)
+func (m *SearchResults) XXX_Size() int {
+	return xxx_messageInfo_SearchResults.Size(m)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetQuerySegmentInfoResponse.DiscardUnknown(m)
+func (m *SearchResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResults.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetQuerySegmentInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_SearchResults proto.InternalMessageInfo
 
-func (m *GetQuerySegmentInfoResponse) GetStatus() *commonpb.Status {
+func (m *SearchResults) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetQuerySegmentInfoResponse) GetInfos() []*QuerySegmentInfo {
+func (m *SearchResults) GetResults() *schemapb.SearchResultData {
 	if m != nil {
-		return m.Infos
+		return m.Results
 	}
 	return nil
 }
 
-type DummyRequest struct {
-	RequestType          string   `protobuf:"bytes,1,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type FlushRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
-func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
-func (*DummyRequest) ProtoMessage()    {}
-func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
+func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushRequest) ProtoMessage()    {}
+func (*FlushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{67}
 }
 
-func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DummyRequest.Unmarshal(m, b)
+func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
 }
-func (m *DummyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DummyRequest.Marshal(b, m, deterministic)
+func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
 }
-func (m *DummyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DummyRequest.Merge(m, src)
+func (m *FlushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushRequest.Merge(m, src)
 }
-func (m *DummyRequest) XXX_Size() int {
-	return xxx_messageInfo_DummyRequest.Size(m)
+func (m *FlushRequest) XXX_Size() int {
+	return xxx_messageInfo_FlushRequest.Size(m)
 }
-func (m *DummyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DummyRequest.DiscardUnknown(m)
+func (m *FlushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DummyRequest proto.InternalMessageInfo
+var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
 
-func (m *DummyRequest) GetRequestType() string {
+func (m *FlushRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.RequestType
+		return m.Base
 	}
-	return ""
+	return nil
 }
 
-type DummyResponse struct {
-	Response             string   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *FlushRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
-func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
-func (*DummyResponse) ProtoMessage()    {}
-func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+func (m *FlushRequest) GetCollectionNames() []string {
+	if m != nil {
+		return m.CollectionNames
+	}
+	return nil
 }
 
-func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DummyResponse.Unmarshal(m, b)
+type FlushResponse struct {
+	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
-func (m *DummyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DummyResponse.Marshal(b, m, deterministic)
+
+func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
+func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
+func (*FlushResponse) ProtoMessage()    {}
+func (*FlushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{68}
 }
-func (m *DummyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DummyResponse.Merge(m, src)
+
+func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
 }
-func (m *DummyResponse) XXX_Size() int {
-	return xxx_messageInfo_DummyResponse.Size(m)
+func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
 }
-func (m *DummyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DummyResponse.DiscardUnknown(m)
+func (m *FlushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushResponse.Merge(m, src)
+}
+func (m *FlushResponse) XXX_Size() int {
+	return xxx_messageInfo_FlushResponse.Size(m)
+}
+func (m *FlushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DummyResponse proto.InternalMessageInfo
+var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
 
-func (m *DummyResponse) GetResponse() string {
+func (m *FlushResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Response
+		return m.Status
+	}
+	return nil
+}
+
+func (m *FlushResponse) GetDbName() string {
+	if m != nil {
+		return m.DbName
 	}
 	return ""
 }
 
-type RegisterLinkRequest struct {
+func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+	if m != nil {
+		return m.CollSegIDs
+	}
+	return nil
+}
+
+type QueryRequest struct {
+	Base               *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName             string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName     string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr               string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields       []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames     []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp    uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	// RFC3339 time to query at, used when travel_timestamp is 0
+	TravelTime string `protobuf:"bytes,9,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
+	// Consistency level of the query, ignored if guarantee_timestamp is set
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,10,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// Use the consistency level of the collection instead of consistency_level
+	UseDefaultConsistency bool `protobuf:"varint,11,opt,name=use_default_consistency,json=useDefaultConsistency,proto3" json:"use_default_consistency,omitempty"`
+	// Max number of entities returned, 0 means no limit
+	Limit int64 `protobuf:"varint,12,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Number of entities to skip, sorted by primary key, needs limit
+	Offset               int64    `protobuf:"varint,13,opt,name=offset,proto3" json:"offset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
-func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterLinkRequest) ProtoMessage()    {}
-func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{62}
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{69}
 }
 
-func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterLinkRequest.Unmarshal(m, b)
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
 }
-func (m *RegisterLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterLinkRequest.Marshal(b, m, deterministic)
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
 }
-func (m *RegisterLinkRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterLinkRequest.Merge(m, src)
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
 }
-func (m *RegisterLinkRequest) XXX_Size() int {
-	return xxx_messageInfo_RegisterLinkRequest.Size(m)
+func (m *QueryRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRequest.Size(m)
 }
-func (m *RegisterLinkRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterLinkRequest.DiscardUnknown(m)
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterLinkRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
 
-type RegisterLinkResponse struct {
-	Address              *commonpb.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Status               *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *QueryRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
 }
 
-func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
-func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterLinkResponse) ProtoMessage()    {}
-func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{63}
+func (m *QueryRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterLinkResponse.Unmarshal(m, b)
-}
-func (m *RegisterLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterLinkResponse.Marshal(b, m, deterministic)
-}
-func (m *RegisterLinkResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterLinkResponse.Merge(m, src)
-}
-func (m *RegisterLinkResponse) XXX_Size() int {
-	return xxx_messageInfo_RegisterLinkResponse.Size(m)
-}
-func (m *RegisterLinkResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterLinkResponse.DiscardUnknown(m)
+func (m *QueryRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-var xxx_messageInfo_RegisterLinkResponse proto.InternalMessageInfo
+func (m *QueryRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
 
-func (m *RegisterLinkResponse) GetAddress() *commonpb.Address {
+func (m *QueryRequest) GetOutputFields() []string {
 	if m != nil {
-		return m.Address
+		return m.OutputFields
 	}
 	return nil
 }
 
-func (m *RegisterLinkResponse) GetStatus() *commonpb.Status {
+func (m *QueryRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.Status
+		return m.PartitionNames
 	}
 	return nil
 }
 
-type GetMetricsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Request              string            `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *QueryRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
 }
 
-func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
-func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMetricsRequest) ProtoMessage()    {}
-func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{64}
+func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
 }
 
-func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMetricsRequest.Unmarshal(m, b)
-}
-func (m *GetMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMetricsRequest.Marshal(b, m, deterministic)
-}
-func (m *GetMetricsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetricsRequest.Merge(m, src)
-}
-func (m *GetMetricsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMetricsRequest.Size(m)
+func (m *QueryRequest) GetTravelTime() string {
+	if m != nil {
+		return m.TravelTime
+	}
+	return ""
 }
-func (m *GetMetricsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetricsRequest.DiscardUnknown(m)
+
+func (m *QueryRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
 }
 
-var xxx_messageInfo_GetMetricsRequest proto.InternalMessageInfo
+func (m *QueryRequest) GetUseDefaultConsistency() bool {
+	if m != nil {
+		return m.UseDefaultConsistency
+	}
+	return false
+}
 
-func (m *GetMetricsRequest) GetBase() *commonpb.MsgBase {
+func (m *QueryRequest) GetLimit() int64 {
 	if m != nil {
-		return m.Base
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *GetMetricsRequest) GetRequest() string {
+func (m *QueryRequest) GetOffset() int64 {
 	if m != nil {
-		return m.Request
+		return m.Offset
 	}
-	return ""
+	return 0
 }
 
-type GetMetricsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Response             string           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
-	ComponentName        string           `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type QueryResults struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
-func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMetricsResponse) ProtoMessage()    {}
-func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{65}
+func (m *QueryResults) Reset()         { *m = QueryResults{} }
+func (m *QueryResults) String() string { return proto.CompactTextString(m) }
+func (*QueryResults) ProtoMessage()    {}
+func (*QueryResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{70}
 }
 
-func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMetricsResponse.Unmarshal(m, b)
+func (m *QueryResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
 }
-func (m *GetMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMetricsResponse.Marshal(b, m, deterministic)
+func (m *QueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResults.Marshal(b, m, deterministic)
 }
-func (m *GetMetricsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetricsResponse.Merge(m, src)
+func (m *QueryResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResults.Merge(m, src)
 }
-func (m *GetMetricsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMetricsResponse.Size(m)
+func (m *QueryResults) XXX_Size() int {
+	return xxx_messageInfo_QueryResults.Size(m)
 }
-func (m *GetMetricsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetricsResponse.DiscardUnknown(m)
+func (m *QueryResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResults.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetricsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryResults proto.InternalMessageInfo
 
-func (m *GetMetricsResponse) GetStatus() *commonpb.Status {
+func (m *QueryResults) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetMetricsResponse) GetResponse() string {
+func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
 	if m != nil {
-		return m.Response
+		return m.FieldsData
 	}
-	return ""
+	return nil
 }
 
-func (m *GetMetricsResponse) GetComponentName() string {
-	if m != nil {
-		return m.ComponentName
-	}
-	return ""
+type QueryIteratorRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr           string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields   []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// Timestamp to iterate at, ignored if cursor is set, 0 means the time of the first batch
+	TravelTimestamp uint64 `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	// Max number of entities of each batch
+	BatchSize int64 `protobuf:"varint,8,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Cursor returned with the previous batch, empty for the first batch, it expires after common.retentionDuration
+	Cursor               string   `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
-	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
-	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
-	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
-	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
-	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
-	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
-	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
-	proto.RegisterType((*BoolResponse)(nil), "milvus.proto.milvus.BoolResponse")
-	proto.RegisterType((*StringResponse)(nil), "milvus.proto.milvus.StringResponse")
-	proto.RegisterType((*DescribeCollectionRequest)(nil), "milvus.proto.milvus.DescribeCollectionRequest")
-	proto.RegisterType((*DescribeCollectionResponse)(nil), "milvus.proto.milvus.DescribeCollectionResponse")
-	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.milvus.LoadCollectionRequest")
-	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.milvus.ReleaseCollectionRequest")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.milvus.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
-	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
-	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
-	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
-	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
-	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
-	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.milvus.LoadPartitionsRequest")
-	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.milvus.ReleasePartitionsRequest")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.milvus.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.milvus.GetPartitionStatisticsResponse")
-	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.milvus.ShowPartitionsRequest")
-	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.milvus.ShowPartitionsResponse")
-	proto.RegisterType((*DescribeSegmentRequest)(nil), "milvus.proto.milvus.DescribeSegmentRequest")
-	proto.RegisterType((*DescribeSegmentResponse)(nil), "milvus.proto.milvus.DescribeSegmentResponse")
-	proto.RegisterType((*ShowSegmentsRequest)(nil), "milvus.proto.milvus.ShowSegmentsRequest")
-	proto.RegisterType((*ShowSegmentsResponse)(nil), "milvus.proto.milvus.ShowSegmentsResponse")
-	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.milvus.CreateIndexRequest")
-	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.milvus.DescribeIndexRequest")
-	proto.RegisterType((*IndexDescription)(nil), "milvus.proto.milvus.IndexDescription")
-	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.milvus.DescribeIndexResponse")
-	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.milvus.GetIndexBuildProgressRequest")
-	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.milvus.GetIndexBuildProgressResponse")
-	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.milvus.GetIndexStateRequest")
-	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.milvus.GetIndexStateResponse")
-	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.milvus.DropIndexRequest")
-	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
-	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
-	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
-	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
-	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
-	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
-	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
-	proto.RegisterType((*SearchResults)(nil), "milvus.proto.milvus.SearchResults")
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.milvus.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
-	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
-	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
-	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
-	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
-	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
-	proto.RegisterType((*CalcDistanceRequest)(nil), "milvus.proto.milvus.CalcDistanceRequest")
-	proto.RegisterType((*CalcDistanceResults)(nil), "milvus.proto.milvus.CalcDistanceResults")
-	proto.RegisterType((*PersistentSegmentInfo)(nil), "milvus.proto.milvus.PersistentSegmentInfo")
-	proto.RegisterType((*GetPersistentSegmentInfoRequest)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoRequest")
-	proto.RegisterType((*GetPersistentSegmentInfoResponse)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoResponse")
-	proto.RegisterType((*QuerySegmentInfo)(nil), "milvus.proto.milvus.QuerySegmentInfo")
-	proto.RegisterType((*GetQuerySegmentInfoRequest)(nil), "milvus.proto.milvus.GetQuerySegmentInfoRequest")
-	proto.RegisterType((*GetQuerySegmentInfoResponse)(nil), "milvus.proto.milvus.GetQuerySegmentInfoResponse")
-	proto.RegisterType((*DummyRequest)(nil), "milvus.proto.milvus.DummyRequest")
-	proto.RegisterType((*DummyResponse)(nil), "milvus.proto.milvus.DummyResponse")
-	proto.RegisterType((*RegisterLinkRequest)(nil), "milvus.proto.milvus.RegisterLinkRequest")
-	proto.RegisterType((*RegisterLinkResponse)(nil), "milvus.proto.milvus.RegisterLinkResponse")
-	proto.RegisterType((*GetMetricsRequest)(nil), "milvus.proto.milvus.GetMetricsRequest")
-	proto.RegisterType((*GetMetricsResponse)(nil), "milvus.proto.milvus.GetMetricsResponse")
+func (m *QueryIteratorRequest) Reset()         { *m = QueryIteratorRequest{} }
+func (m *QueryIteratorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIteratorRequest) ProtoMessage()    {}
+func (*QueryIteratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
 
-func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
-
-var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 3150 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1b, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x55, 0xcb, 0x6f, 0x3e, 0x2e, 0x25, 0x6a, 0xf4, 0x61, 0x86, 0xb1, 0x63, 0x69, 0x53, 0x27, 0xb2,
-	0x9d, 0xd8, 0xb1, 0x9c, 0x34, 0x69, 0xd2, 0x36, 0xb1, 0xad, 0xc6, 0x16, 0x62, 0xa7, 0xca, 0x2a,
-	0x09, 0x90, 0x04, 0xc1, 0x62, 0xc5, 0x1d, 0x91, 0x0b, 0x2d, 0x77, 0xd9, 0x9d, 0xa1, 0x65, 0xe6,
-	0x54, 0x20, 0x49, 0x81, 0x22, 0x69, 0x82, 0xa2, 0x45, 0x8b, 0x5e, 0xdb, 0xe6, 0xd0, 0x5b, 0xd3,
-	0x16, 0x68, 0xd1, 0x43, 0xd1, 0x43, 0x0e, 0x3d, 0x14, 0xe8, 0x07, 0xd0, 0x7b, 0x2f, 0x3d, 0xe6,
-	0x1f, 0xf4, 0x50, 0xcc, 0xcc, 0xee, 0x72, 0x97, 0x9c, 0xa5, 0x28, 0x33, 0xa9, 0xa4, 0x1b, 0xf7,
-	0xcd, 0x7b, 0x6f, 0xde, 0xbc, 0x79, 0xf3, 0xe6, 0xcd, 0x7b, 0x8f, 0xa0, 0x76, 0x6c, 0xe7, 0x6e,
-	0x8f, 0x5c, 0xea, 0xfa, 0x1e, 0xf5, 0xd0, 0x42, 0xfc, 0xeb, 0x92, 0xf8, 0x68, 0xa8, 0x4d, 0xaf,
-	0xd3, 0xf1, 0x5c, 0x01, 0x6c, 0xa8, 0xa4, 0xd9, 0xc6, 0x1d, 0x53, 0x7c, 0x69, 0xef, 0x2b, 0x80,
-	0x6e, 0xf8, 0xd8, 0xa4, 0xf8, 0x9a, 0x63, 0x9b, 0x44, 0xc7, 0xdf, 0xe9, 0x61, 0x42, 0xd1, 0x13,
-	0x90, 0xdb, 0x31, 0x09, 0xae, 0x2b, 0x2b, 0xca, 0x5a, 0x65, 0xfd, 0xf4, 0xa5, 0x04, 0xdb, 0x80,
-	0xdd, 0x1d, 0xd2, 0xba, 0x6e, 0x12, 0xac, 0x73, 0x4c, 0xf4, 0x28, 0xcc, 0x35, 0x3d, 0xc7, 0xc1,
-	0x4d, 0x6a, 0x7b, 0xae, 0xe1, 0x9a, 0x1d, 0x5c, 0xcf, 0xac, 0x28, 0x6b, 0x65, 0x7d, 0x76, 0x00,
-	0x7e, 0xd9, 0xec, 0x60, 0xb4, 0x08, 0x79, 0x93, 0x4d, 0x55, 0xcf, 0xf2, 0x61, 0xf1, 0xa1, 0xbd,
-	0x09, 0xb5, 0x0d, 0xdf, 0xeb, 0x4e, 0x29, 0x44, 0xc4, 0x3b, 0x13, 0xe7, 0xfd, 0x9e, 0x02, 0xf3,
-	0xd7, 0x1c, 0x8a, 0xfd, 0xa3, 0x5d, 0xe2, 0x67, 0x0a, 0x9c, 0x12, 0xaa, 0xbe, 0x11, 0xa1, 0xdf,
-	0xbf, 0x30, 0xa7, 0xa0, 0x68, 0xed, 0xc4, 0x85, 0x28, 0x58, 0x3b, 0x7c, 0x72, 0x89, 0x94, 0x59,
-	0xa9, 0x94, 0xcb, 0x50, 0x10, 0xa6, 0x50, 0xcf, 0xad, 0x28, 0x6b, 0xaa, 0x1e, 0x7c, 0xa1, 0x33,
-	0x00, 0xa4, 0x6d, 0xfa, 0x16, 0x31, 0xdc, 0x5e, 0xa7, 0x9e, 0x5f, 0x51, 0xd6, 0xf2, 0x7a, 0x59,
-	0x40, 0x5e, 0xee, 0x75, 0xb4, 0x0f, 0x14, 0x58, 0x62, 0x5b, 0x75, 0x2c, 0x16, 0xa1, 0xfd, 0x4a,
-	0x81, 0xc5, 0x5b, 0x26, 0x39, 0x1e, 0x1a, 0x3d, 0x03, 0x40, 0xed, 0x0e, 0x36, 0x08, 0x35, 0x3b,
-	0x5d, 0xae, 0xd5, 0x9c, 0x5e, 0x66, 0x90, 0x6d, 0x06, 0xd0, 0xde, 0x00, 0xf5, 0xba, 0xe7, 0x39,
-	0x3a, 0x26, 0x5d, 0xcf, 0x25, 0x18, 0x5d, 0x85, 0x02, 0xa1, 0x26, 0xed, 0x91, 0x40, 0xc8, 0x07,
-	0xa5, 0x42, 0x6e, 0x73, 0x14, 0x3d, 0x40, 0x65, 0xb6, 0x75, 0xd7, 0x74, 0x7a, 0x42, 0xc6, 0x92,
-	0x2e, 0x3e, 0xb4, 0xb7, 0x60, 0x76, 0x9b, 0xfa, 0xb6, 0xdb, 0xfa, 0x02, 0x99, 0x97, 0x43, 0xe6,
-	0xff, 0x54, 0xe0, 0x81, 0x0d, 0x4c, 0x9a, 0xbe, 0xbd, 0x73, 0x4c, 0x4c, 0x57, 0x03, 0x75, 0x00,
-	0xd9, 0xdc, 0xe0, 0xaa, 0xce, 0xea, 0x09, 0xd8, 0xd0, 0x66, 0xe4, 0x87, 0x37, 0xe3, 0xdd, 0x1c,
-	0x34, 0x64, 0x8b, 0x9a, 0x46, 0x7d, 0xdf, 0x88, 0x4e, 0x54, 0x86, 0x13, 0x9d, 0x4b, 0x12, 0x05,
-	0x8e, 0x77, 0x30, 0xdb, 0x36, 0x07, 0x44, 0x07, 0x6f, 0x78, 0x55, 0x59, 0xc9, 0xaa, 0xd6, 0x61,
-	0xe9, 0xae, 0xed, 0xd3, 0x9e, 0xe9, 0x18, 0xcd, 0xb6, 0xe9, 0xba, 0xd8, 0xe1, 0x7a, 0x22, 0xf5,
-	0xdc, 0x4a, 0x76, 0xad, 0xac, 0x2f, 0x04, 0x83, 0x37, 0xc4, 0x18, 0x53, 0x16, 0x41, 0x4f, 0xc2,
-	0x72, 0xb7, 0xdd, 0x27, 0x76, 0x73, 0x84, 0x28, 0xcf, 0x89, 0x16, 0xc3, 0xd1, 0x04, 0xd5, 0x45,
-	0x98, 0x6f, 0x72, 0x6f, 0x65, 0x19, 0x4c, 0x6b, 0x42, 0x8d, 0x05, 0xae, 0xc6, 0x5a, 0x30, 0xf0,
-	0x6a, 0x08, 0x67, 0x62, 0x85, 0xc8, 0x3d, 0xda, 0x8c, 0x11, 0x14, 0x39, 0xc1, 0x42, 0x30, 0xf8,
-	0x1a, 0x6d, 0x0e, 0x68, 0x92, 0x7e, 0xa6, 0x34, 0xe4, 0x67, 0x50, 0x1d, 0x8a, 0xdc, 0x6f, 0x62,
-	0x52, 0x2f, 0x73, 0x31, 0xc3, 0x4f, 0xb4, 0x09, 0x73, 0x84, 0x9a, 0x3e, 0x35, 0xba, 0x1e, 0xb1,
-	0x99, 0x5e, 0x48, 0x1d, 0x56, 0xb2, 0x6b, 0x95, 0xf5, 0x15, 0xe9, 0x26, 0xbd, 0x84, 0xfb, 0x1b,
-	0x26, 0x35, 0xb7, 0x4c, 0xdb, 0xd7, 0x67, 0x39, 0xe1, 0x56, 0x48, 0xc7, 0x9d, 0xd9, 0x6d, 0xcf,
-	0xb4, 0x8e, 0x87, 0x33, 0xfb, 0x48, 0x81, 0xba, 0x8e, 0x1d, 0x6c, 0x92, 0xe3, 0x71, 0xce, 0xb4,
-	0x1f, 0x2b, 0xf0, 0xd0, 0x4d, 0x4c, 0x63, 0x16, 0x4b, 0x4d, 0x6a, 0x13, 0x6a, 0x37, 0xc9, 0x51,
-	0x8a, 0xf5, 0xb1, 0x02, 0x67, 0x53, 0xc5, 0x9a, 0xe6, 0x00, 0x3f, 0x0d, 0x79, 0xf6, 0x8b, 0xc5,
-	0x0f, 0xcc, 0x9e, 0x56, 0xd3, 0xec, 0xe9, 0x75, 0xe6, 0x17, 0xb9, 0x41, 0x09, 0x7c, 0xed, 0xdf,
-	0x0a, 0x2c, 0x6f, 0xb7, 0xbd, 0xfd, 0x81, 0x48, 0x5f, 0x86, 0x82, 0x92, 0x2e, 0x2d, 0x3b, 0xe4,
-	0xd2, 0xd0, 0x15, 0xc8, 0xd1, 0x7e, 0x17, 0x73, 0x6f, 0x38, 0xbb, 0x7e, 0xe6, 0x92, 0x24, 0x16,
-	0xbc, 0xc4, 0x84, 0x7c, 0xb5, 0xdf, 0xc5, 0x3a, 0x47, 0x45, 0xe7, 0xa1, 0x36, 0xa4, 0xf2, 0xd0,
-	0x29, 0xcc, 0x25, 0x75, 0x4e, 0xb4, 0x3f, 0x64, 0xe0, 0xd4, 0xc8, 0x12, 0xa7, 0x51, 0xb6, 0x6c,
-	0xee, 0x8c, 0x74, 0x6e, 0x74, 0x0e, 0x62, 0x26, 0x60, 0xd8, 0x16, 0x8b, 0xac, 0xb2, 0x6b, 0x59,
-	0xbd, 0x1a, 0xf3, 0x8d, 0x16, 0x41, 0x8f, 0x03, 0x1a, 0x71, 0x59, 0xc2, 0x33, 0xe6, 0xf4, 0xf9,
-	0x61, 0x9f, 0xc5, 0xfd, 0xa2, 0xd4, 0x69, 0x09, 0x15, 0xe4, 0xf4, 0x45, 0x89, 0xd7, 0x22, 0xe8,
-	0x0a, 0x2c, 0xda, 0xee, 0x1d, 0xdc, 0xf1, 0xfc, 0xbe, 0xd1, 0xc5, 0x7e, 0x13, 0xbb, 0xd4, 0x6c,
-	0x61, 0x52, 0x2f, 0x70, 0x89, 0x16, 0xc2, 0xb1, 0xad, 0xc1, 0x90, 0xf6, 0x5b, 0x05, 0x96, 0x45,
-	0xe4, 0xb7, 0x65, 0xfa, 0xd4, 0x3e, 0xea, 0xdb, 0xf3, 0x1c, 0xcc, 0x76, 0x43, 0x39, 0x04, 0x5e,
-	0x8e, 0xe3, 0x55, 0x23, 0x28, 0x3f, 0x65, 0x9f, 0x2a, 0xb0, 0xc8, 0x02, 0xbd, 0x93, 0x24, 0xf3,
-	0xaf, 0x15, 0x58, 0xb8, 0x65, 0x92, 0x93, 0x24, 0xf2, 0xef, 0x82, 0x2b, 0x28, 0x92, 0xf9, 0x28,
-	0x5d, 0x2b, 0x43, 0x4c, 0x0a, 0x1d, 0x46, 0x16, 0xb3, 0x09, 0xa9, 0x89, 0xf6, 0xfb, 0xc1, 0x5d,
-	0x75, 0xc2, 0x24, 0xff, 0xa3, 0x02, 0x67, 0x6e, 0x62, 0x1a, 0x49, 0x7d, 0x2c, 0xee, 0xb4, 0x49,
-	0xad, 0xe5, 0x23, 0x71, 0x23, 0x4b, 0x85, 0x3f, 0x92, 0x9b, 0xef, 0x83, 0x0c, 0x2c, 0xb1, 0x6b,
-	0xe1, 0x78, 0x18, 0xc1, 0x24, 0x0f, 0x03, 0x89, 0xa1, 0xe4, 0x65, 0x86, 0x12, 0xdd, 0xa7, 0x85,
-	0x89, 0xef, 0x53, 0xed, 0x37, 0x19, 0x11, 0x07, 0xc4, 0xb5, 0x31, 0xcd, 0xb6, 0x48, 0x64, 0xcd,
-	0x48, 0x65, 0xd5, 0x40, 0x8d, 0x20, 0x9b, 0x1b, 0xe1, 0xfd, 0x98, 0x80, 0x1d, 0xdb, 0xeb, 0xf1,
-	0x43, 0x05, 0x96, 0xc3, 0xa7, 0xd8, 0x36, 0x6e, 0x75, 0xb0, 0x4b, 0xef, 0xdf, 0x86, 0x86, 0x2d,
-	0x20, 0x23, 0xb1, 0x80, 0xd3, 0x50, 0x26, 0x62, 0x9e, 0xe8, 0x95, 0x35, 0x00, 0x68, 0x9f, 0x28,
-	0x70, 0x6a, 0x44, 0x9c, 0x69, 0x36, 0xb1, 0x0e, 0x45, 0xdb, 0xb5, 0xf0, 0xbd, 0x48, 0x9a, 0xf0,
-	0x93, 0x8d, 0xec, 0xf4, 0x6c, 0xc7, 0x8a, 0xc4, 0x08, 0x3f, 0xd1, 0x2a, 0xa8, 0xd8, 0x35, 0x77,
-	0x1c, 0x6c, 0x70, 0x5c, 0x6e, 0xc8, 0x25, 0xbd, 0x22, 0x60, 0x9b, 0x0c, 0xa4, 0xfd, 0x40, 0x81,
-	0x05, 0x66, 0x6b, 0x81, 0x8c, 0xe4, 0xcb, 0xd5, 0xd9, 0x0a, 0x54, 0x62, 0xc6, 0x14, 0x88, 0x1b,
-	0x07, 0x69, 0x7b, 0xb0, 0x98, 0x14, 0x67, 0x1a, 0x9d, 0x3d, 0x04, 0x10, 0xed, 0x88, 0xb0, 0xf9,
-	0xac, 0x1e, 0x83, 0x68, 0x9f, 0x47, 0x79, 0x4b, 0xae, 0x8c, 0x23, 0xce, 0xfa, 0xec, 0xda, 0xd8,
-	0xb1, 0xe2, 0x5e, 0xbb, 0xcc, 0x21, 0x7c, 0x78, 0x03, 0x54, 0x7c, 0x8f, 0xfa, 0xa6, 0xd1, 0x35,
-	0x7d, 0xb3, 0x23, 0x0e, 0xcf, 0x44, 0x0e, 0xb6, 0xc2, 0xc9, 0xb6, 0x38, 0x95, 0xf6, 0x17, 0x16,
-	0x8c, 0x05, 0x46, 0x79, 0xdc, 0x57, 0x7c, 0x06, 0x80, 0x1b, 0xad, 0x18, 0xce, 0x8b, 0x61, 0x0e,
-	0xe1, 0x57, 0xd8, 0x27, 0x0a, 0xd4, 0xf8, 0x12, 0xc4, 0x7a, 0xba, 0x8c, 0xed, 0x10, 0x8d, 0x32,
-	0x44, 0x33, 0xe6, 0x08, 0x7d, 0x0d, 0x0a, 0x81, 0x62, 0xb3, 0x93, 0x2a, 0x36, 0x20, 0x38, 0x60,
-	0x19, 0xda, 0xcf, 0x15, 0x58, 0x1a, 0x52, 0xf9, 0x34, 0x16, 0xfd, 0x2a, 0x20, 0xb1, 0x42, 0x6b,
-	0xb0, 0xec, 0xf0, 0xba, 0x3d, 0x27, 0xbd, 0x5b, 0x86, 0x95, 0xa4, 0xcf, 0xdb, 0x43, 0x10, 0xa2,
-	0xfd, 0x5d, 0x81, 0xd3, 0x37, 0x31, 0xe5, 0xa8, 0xd7, 0x99, 0xef, 0xd8, 0xf2, 0xbd, 0x96, 0x8f,
-	0x09, 0x39, 0xb9, 0xf6, 0xf1, 0x13, 0x11, 0x9f, 0xc9, 0x96, 0x34, 0x8d, 0xfe, 0x57, 0x41, 0xe5,
-	0x73, 0x60, 0xcb, 0xf0, 0xbd, 0x7d, 0x12, 0xd8, 0x51, 0x25, 0x80, 0xe9, 0xde, 0x3e, 0x37, 0x08,
-	0xea, 0x51, 0xd3, 0x11, 0x08, 0xc1, 0xc5, 0xc0, 0x21, 0x6c, 0x98, 0x9f, 0xc1, 0x50, 0x30, 0xc6,
-	0x1c, 0x9f, 0x5c, 0x1d, 0xff, 0x52, 0x81, 0xa5, 0xa1, 0xa5, 0x4c, 0xa3, 0xdb, 0xa7, 0x44, 0xf4,
-	0x28, 0x16, 0x33, 0xbb, 0x7e, 0x56, 0x4a, 0x13, 0x9b, 0x4c, 0x60, 0xa3, 0xb3, 0x50, 0xd9, 0x35,
-	0x6d, 0xc7, 0xf0, 0xb1, 0x49, 0x3c, 0x37, 0x58, 0x28, 0x30, 0x90, 0xce, 0x21, 0xda, 0x67, 0x8a,
-	0x28, 0x0b, 0x9d, 0x70, 0x8f, 0xf7, 0x8b, 0x0c, 0x54, 0x37, 0x5d, 0x82, 0x7d, 0x7a, 0xfc, 0x5f,
-	0x18, 0xe8, 0x79, 0xa8, 0xf0, 0x85, 0x11, 0xc3, 0x32, 0xa9, 0x19, 0x5c, 0x57, 0x0f, 0x49, 0x33,
-	0xd9, 0x2f, 0x32, 0xbc, 0x0d, 0x93, 0x9a, 0xba, 0xd0, 0x0e, 0x61, 0xbf, 0xd1, 0x83, 0x50, 0x6e,
-	0x9b, 0xa4, 0x6d, 0xec, 0xe1, 0xbe, 0x08, 0xfb, 0xaa, 0x7a, 0x89, 0x01, 0x5e, 0xc2, 0x7d, 0x82,
-	0x1e, 0x80, 0x92, 0xdb, 0xeb, 0x88, 0x03, 0x56, 0x5c, 0x51, 0xd6, 0xaa, 0x7a, 0xd1, 0xed, 0x75,
-	0xf8, 0xf1, 0xfa, 0x6b, 0x06, 0x66, 0xef, 0xf4, 0xd8, 0x7b, 0x86, 0xe7, 0xe1, 0x7b, 0x0e, 0xbd,
-	0x3f, 0x63, 0xbc, 0x00, 0x59, 0x11, 0x33, 0x30, 0x8a, 0xba, 0x54, 0xf0, 0xcd, 0x0d, 0xa2, 0x33,
-	0x24, 0x9e, 0x83, 0xee, 0x35, 0x9b, 0x41, 0x90, 0x95, 0xe5, 0xc2, 0x96, 0x19, 0x84, 0x5b, 0x1c,
-	0x5b, 0x0a, 0xf6, 0xfd, 0x28, 0x04, 0xe3, 0x4b, 0xc1, 0xbe, 0x2f, 0x06, 0x35, 0x50, 0xcd, 0xe6,
-	0x9e, 0xeb, 0xed, 0x3b, 0xd8, 0x6a, 0x61, 0x8b, 0x6f, 0x7b, 0x49, 0x4f, 0xc0, 0x84, 0x61, 0xb0,
-	0x8d, 0x37, 0x9a, 0x2e, 0xe5, 0x0f, 0x89, 0x2c, 0x33, 0x0c, 0x06, 0xb9, 0xe1, 0x52, 0x36, 0x6c,
-	0x61, 0x07, 0x53, 0xcc, 0x87, 0x8b, 0x62, 0x58, 0x40, 0x82, 0xe1, 0x5e, 0x37, 0xa2, 0x2e, 0x89,
-	0x61, 0x01, 0x61, 0xc3, 0xa7, 0xa1, 0x3c, 0x48, 0xb4, 0x97, 0x07, 0xd9, 0x40, 0x0e, 0xd0, 0xfe,
-	0xa4, 0x40, 0x75, 0x83, 0xb3, 0x3a, 0x01, 0x46, 0x87, 0x20, 0x87, 0xef, 0x75, 0xfd, 0xe0, 0xe8,
-	0xf0, 0xdf, 0xda, 0x5d, 0xa8, 0x6d, 0x39, 0x66, 0x13, 0xb7, 0x3d, 0xc7, 0xc2, 0x3e, 0xbf, 0xbe,
-	0x51, 0x0d, 0xb2, 0xd4, 0x6c, 0x05, 0xf1, 0x01, 0xfb, 0x89, 0x9e, 0x09, 0x1e, 0x69, 0xc2, 0xf3,
-	0x7c, 0x45, 0x7a, 0x91, 0xc6, 0xd8, 0xc4, 0x72, 0x9f, 0xcb, 0x50, 0xe0, 0xf5, 0x2d, 0x11, 0x39,
-	0xa8, 0x7a, 0xf0, 0xa5, 0xbd, 0x9d, 0x98, 0xf7, 0xa6, 0xef, 0xf5, 0xba, 0x68, 0x13, 0xd4, 0xee,
-	0x00, 0xc6, 0xcc, 0x31, 0xfd, 0xda, 0x1e, 0x16, 0x5a, 0x4f, 0x90, 0x6a, 0x9f, 0x67, 0xa1, 0xba,
-	0x8d, 0x4d, 0xbf, 0xd9, 0x3e, 0x09, 0xd9, 0x12, 0xa6, 0x71, 0x8b, 0x38, 0xc1, 0xc6, 0xb0, 0x9f,
-	0xe8, 0x22, 0xcc, 0xc7, 0x16, 0x64, 0xb4, 0x98, 0x82, 0xb8, 0x69, 0xab, 0x7a, 0xad, 0x3b, 0xac,
-	0xb8, 0xa7, 0xa1, 0x64, 0x11, 0xc7, 0xe0, 0x5b, 0x54, 0xe4, 0x5b, 0x24, 0x5f, 0xdf, 0x06, 0x71,
-	0xf8, 0xd6, 0x14, 0x2d, 0xf1, 0x03, 0x3d, 0x0c, 0x55, 0xaf, 0x47, 0xbb, 0x3d, 0x6a, 0x08, 0xd7,
-	0x52, 0x2f, 0x71, 0xf1, 0x54, 0x01, 0xe4, 0x9e, 0x87, 0xa0, 0x17, 0xa1, 0x4a, 0xb8, 0x2a, 0xc3,
-	0xe0, 0xba, 0x3c, 0x69, 0x0c, 0xa8, 0x0a, 0x3a, 0x11, 0x5d, 0xa3, 0xf3, 0x50, 0xa3, 0xbe, 0x79,
-	0x17, 0x3b, 0xb1, 0xca, 0x15, 0xf0, 0x03, 0x35, 0x27, 0xe0, 0x83, 0xaa, 0xd5, 0x65, 0x58, 0x68,
-	0xf5, 0x4c, 0xdf, 0x74, 0x29, 0xc6, 0x31, 0xec, 0x0a, 0xc7, 0x46, 0xd1, 0x50, 0x44, 0xa0, 0xbd,
-	0x04, 0xb9, 0x5b, 0x36, 0xe5, 0x8a, 0x64, 0x6e, 0x49, 0xe1, 0x4f, 0x19, 0xee, 0x7c, 0x1e, 0x80,
-	0x92, 0xef, 0xed, 0x0b, 0x37, 0x9b, 0xe1, 0x26, 0x58, 0xf4, 0xbd, 0x7d, 0xee, 0x43, 0x79, 0x6d,
-	0xde, 0xf3, 0x03, 0xdb, 0xcc, 0xe8, 0xc1, 0x97, 0xf6, 0xbe, 0x32, 0x30, 0x1e, 0xe6, 0x21, 0xc9,
-	0xfd, 0xb9, 0xc8, 0xe7, 0xa1, 0xe8, 0x0b, 0xfa, 0xb1, 0x95, 0xca, 0xf8, 0x4c, 0xdc, 0xcd, 0x87,
-	0x54, 0xda, 0x7b, 0x0a, 0xa8, 0x2f, 0x3a, 0x3d, 0xf2, 0x65, 0xd8, 0xb0, 0xac, 0x2e, 0x90, 0x95,
-	0xd7, 0x24, 0x7e, 0x98, 0x81, 0x6a, 0x20, 0xc6, 0x34, 0xe1, 0x4b, 0xaa, 0x28, 0xdb, 0x50, 0x61,
-	0x53, 0x1a, 0x04, 0xb7, 0xc2, 0xa4, 0x4a, 0x65, 0x7d, 0x5d, 0x7a, 0xea, 0x13, 0x62, 0xf0, 0x1a,
-	0xef, 0x36, 0x27, 0xfa, 0x96, 0x4b, 0xfd, 0xbe, 0x0e, 0xcd, 0x08, 0xd0, 0x78, 0x1b, 0xe6, 0x86,
-	0x86, 0x99, 0x6d, 0xec, 0xe1, 0x7e, 0xe8, 0xd6, 0xf6, 0x70, 0x1f, 0x3d, 0x19, 0xaf, 0xc4, 0xa7,
-	0xdd, 0xbf, 0xb7, 0x3d, 0xb7, 0x75, 0xcd, 0xf7, 0xcd, 0x7e, 0x50, 0xa9, 0x7f, 0x36, 0xf3, 0x8c,
-	0xa2, 0xfd, 0x39, 0x03, 0xea, 0x2b, 0x3d, 0xec, 0xf7, 0x8f, 0xd2, 0xbd, 0x84, 0xfe, 0x3c, 0x37,
-	0xf0, 0xe7, 0xa3, 0x27, 0x3a, 0x2f, 0x39, 0xd1, 0x12, 0xbf, 0x54, 0x90, 0xfa, 0x25, 0xd9, 0x91,
-	0x2d, 0x1e, 0xea, 0xc8, 0x96, 0x52, 0x8f, 0x2c, 0xb3, 0xee, 0x40, 0x85, 0x53, 0x1d, 0xb2, 0x44,
-	0x20, 0x95, 0x39, 0x6c, 0x20, 0xa5, 0x7d, 0xaa, 0x40, 0xf9, 0x75, 0xdc, 0xa4, 0x9e, 0xcf, 0xbc,
-	0x85, 0x44, 0xf7, 0xca, 0x04, 0xb1, 0x6a, 0x66, 0x38, 0x56, 0xbd, 0x0a, 0x25, 0xdb, 0x32, 0x4c,
-	0x66, 0x36, 0x7c, 0xf3, 0xc6, 0xc5, 0x48, 0x45, 0xdb, 0xe2, 0xf6, 0x35, 0x79, 0x72, 0xfd, 0xa7,
-	0x0a, 0xa8, 0x42, 0x66, 0x22, 0x28, 0x9f, 0x8b, 0x4d, 0xa7, 0xc8, 0x6c, 0x39, 0xf8, 0x88, 0x16,
-	0x7a, 0x6b, 0x66, 0x30, 0xed, 0x35, 0x00, 0xa6, 0xbb, 0x80, 0x5c, 0x1c, 0x85, 0x15, 0xa9, 0xb4,
-	0x82, 0x9c, 0xeb, 0xf1, 0xd6, 0x8c, 0x5e, 0x66, 0x54, 0x9c, 0xc5, 0xf5, 0x22, 0xe4, 0x39, 0xb5,
-	0xf6, 0x5f, 0x05, 0x16, 0x6e, 0x98, 0x4e, 0x73, 0xc3, 0x26, 0xd4, 0x74, 0x9b, 0x53, 0x44, 0x45,
-	0xcf, 0x42, 0xd1, 0xeb, 0x1a, 0x0e, 0xde, 0xa5, 0x81, 0x48, 0xab, 0x63, 0x56, 0x24, 0xd4, 0xa0,
-	0x17, 0xbc, 0xee, 0x6d, 0xbc, 0x4b, 0xd1, 0xd7, 0xa1, 0xe4, 0x75, 0x0d, 0xdf, 0x6e, 0xb5, 0x69,
-	0xa0, 0xfd, 0x09, 0x88, 0x8b, 0x5e, 0x57, 0x67, 0x14, 0xb1, 0x64, 0x47, 0xee, 0x90, 0xc9, 0x0e,
-	0xed, 0x1f, 0x23, 0xcb, 0x9f, 0xc2, 0xb4, 0x9f, 0x85, 0x92, 0xed, 0x52, 0xc3, 0xb2, 0x49, 0xa8,
-	0x82, 0x33, 0x72, 0x1b, 0x72, 0x29, 0x5f, 0x01, 0xdf, 0x53, 0x97, 0xb2, 0xb9, 0xd1, 0x0b, 0x00,
-	0xbb, 0x8e, 0x67, 0x06, 0xd4, 0x42, 0x07, 0x67, 0xe5, 0xa7, 0x82, 0xa1, 0x85, 0xf4, 0x65, 0x4e,
-	0xc4, 0x38, 0x0c, 0xb6, 0xf4, 0x6f, 0x0a, 0x2c, 0x6d, 0x61, 0x9f, 0xd8, 0x84, 0x62, 0x97, 0x06,
-	0x89, 0xc7, 0x4d, 0x77, 0xd7, 0x4b, 0x66, 0x78, 0x95, 0xa1, 0x0c, 0xef, 0x17, 0x93, 0xef, 0x4c,
-	0x3c, 0x65, 0x44, 0x9d, 0x21, 0x7c, 0xca, 0x84, 0xd5, 0x14, 0xf1, 0x14, 0x9c, 0x4d, 0xd9, 0xa6,
-	0x40, 0xde, 0xf8, 0x8b, 0x58, 0xfb, 0x91, 0xe8, 0x6c, 0x90, 0x2e, 0xea, 0xfe, 0x0d, 0x76, 0x19,
-	0x02, 0x07, 0x3e, 0xe4, 0xce, 0x1f, 0x81, 0x21, 0xdf, 0x91, 0xd2, 0x6f, 0xf1, 0x33, 0x05, 0x56,
-	0xd2, 0xa5, 0x9a, 0xe6, 0xe6, 0x7d, 0x01, 0xf2, 0xb6, 0xbb, 0xeb, 0x85, 0x79, 0xb0, 0x0b, 0xf2,
-	0x80, 0x5a, 0x3a, 0xaf, 0x20, 0xd4, 0xfe, 0xa3, 0x40, 0x8d, 0xfb, 0xea, 0x23, 0xd8, 0xfe, 0x0e,
-	0xee, 0x18, 0xc4, 0x7e, 0x07, 0x87, 0xdb, 0xdf, 0xc1, 0x9d, 0x6d, 0xfb, 0x1d, 0x9c, 0xb0, 0x8c,
-	0x7c, 0xd2, 0x32, 0x92, 0x99, 0x82, 0xc2, 0x98, 0x3c, 0x67, 0x31, 0x91, 0xe7, 0xd4, 0x3e, 0x52,
-	0xa0, 0x71, 0x13, 0xd3, 0xe1, 0xa5, 0x1e, 0x9d, 0x51, 0x7c, 0xac, 0xc0, 0x83, 0x52, 0x81, 0xa6,
-	0xb1, 0x87, 0xe7, 0x92, 0xf6, 0x20, 0x7f, 0x60, 0x8d, 0x4c, 0x19, 0x98, 0xc2, 0x15, 0x50, 0x37,
-	0x7a, 0x9d, 0x4e, 0x14, 0xf8, 0xac, 0x82, 0xea, 0x8b, 0x9f, 0xe2, 0xfd, 0x21, 0xae, 0xcb, 0x4a,
-	0x00, 0x63, 0xaf, 0x0c, 0xed, 0x22, 0x54, 0x03, 0x92, 0x40, 0xea, 0x06, 0x94, 0xfc, 0xe0, 0x77,
-	0x80, 0x1f, 0x7d, 0x6b, 0x4b, 0xb0, 0xa0, 0xe3, 0x16, 0xb3, 0x44, 0xff, 0xb6, 0xed, 0xee, 0x05,
-	0xd3, 0x68, 0xef, 0x2a, 0xb0, 0x98, 0x84, 0x07, 0xbc, 0xbe, 0x0a, 0x45, 0xd3, 0xb2, 0x7c, 0x4c,
-	0xc8, 0xd8, 0x6d, 0xb9, 0x26, 0x70, 0xf4, 0x10, 0x39, 0xa6, 0xb9, 0xcc, 0xc4, 0x9a, 0xd3, 0x0c,
-	0x98, 0xbf, 0x89, 0xe9, 0x1d, 0x4c, 0xfd, 0xa9, 0x0a, 0xd9, 0x75, 0xf6, 0x32, 0xe0, 0xc4, 0x81,
-	0x59, 0x84, 0x9f, 0xda, 0x87, 0x0a, 0xa0, 0xf8, 0x0c, 0xd3, 0x6c, 0x73, 0x5c, 0xcb, 0x99, 0xa4,
-	0x96, 0x45, 0xaf, 0x4f, 0xa7, 0xeb, 0xb9, 0xd8, 0xa5, 0xf1, 0x10, 0xb3, 0x1a, 0x41, 0x99, 0xf9,
-	0x5d, 0x58, 0x85, 0x52, 0x58, 0x7b, 0x45, 0x45, 0xc8, 0x5e, 0x73, 0x9c, 0xda, 0x0c, 0x52, 0xa1,
-	0xb4, 0x19, 0x14, 0x18, 0x6b, 0xca, 0x85, 0x6f, 0xc2, 0xdc, 0xd0, 0xcb, 0x1f, 0x95, 0x20, 0xf7,
-	0xb2, 0xe7, 0xe2, 0xda, 0x0c, 0xaa, 0x81, 0x7a, 0xdd, 0x76, 0x4d, 0xbf, 0x2f, 0x6e, 0xda, 0x9a,
-	0x85, 0xe6, 0xa0, 0xc2, 0x6f, 0x9c, 0x00, 0x80, 0xd7, 0xff, 0xd5, 0x80, 0xea, 0x1d, 0xbe, 0x98,
-	0x6d, 0xec, 0xdf, 0xb5, 0x9b, 0x18, 0x19, 0x50, 0x1b, 0xee, 0xe0, 0x46, 0x8f, 0x49, 0x6d, 0x34,
-	0xa5, 0xd1, 0xbb, 0x31, 0x4e, 0x3d, 0xda, 0x0c, 0x7a, 0x0b, 0x66, 0x93, 0xbd, 0xd5, 0x48, 0xee,
-	0x12, 0xa5, 0x0d, 0xd8, 0x07, 0x31, 0x37, 0xa0, 0x9a, 0x68, 0x95, 0x46, 0xe7, 0xa5, 0xbc, 0x65,
-	0xed, 0xd4, 0x0d, 0x79, 0x94, 0x12, 0x6f, 0x67, 0x16, 0xd2, 0x27, 0x9b, 0x29, 0x53, 0xa4, 0x97,
-	0x76, 0x5c, 0x1e, 0x24, 0xbd, 0x09, 0xf3, 0x23, 0xbd, 0x91, 0xe8, 0x71, 0x29, 0xff, 0xb4, 0x1e,
-	0xca, 0x83, 0xa6, 0xd8, 0x07, 0x34, 0xda, 0x12, 0x8c, 0x2e, 0xc9, 0x77, 0x20, 0xad, 0x21, 0xba,
-	0x71, 0x79, 0x62, 0xfc, 0x48, 0x71, 0xdf, 0x53, 0xe0, 0x54, 0x4a, 0x43, 0x23, 0xba, 0x2a, 0x65,
-	0x37, 0xbe, 0x2b, 0xb3, 0xf1, 0xe4, 0xe1, 0x88, 0x22, 0x41, 0x5c, 0x98, 0x1b, 0xea, 0xf1, 0x43,
-	0x17, 0x53, 0xfb, 0x1e, 0x46, 0x9b, 0x1d, 0x1b, 0x8f, 0x4d, 0x86, 0x1c, 0xcd, 0xc7, 0xde, 0xc2,
-	0xc9, 0xc6, 0xb8, 0x94, 0xf9, 0xe4, 0xed, 0x73, 0x07, 0x6d, 0xe8, 0x1b, 0x50, 0x4d, 0x74, 0xb0,
-	0xa5, 0x58, 0xbc, 0xac, 0xcb, 0xed, 0x20, 0xd6, 0x6f, 0x83, 0x1a, 0x6f, 0x34, 0x43, 0x6b, 0x69,
-	0x67, 0x69, 0x84, 0xf1, 0x61, 0x8e, 0xd2, 0xa0, 0x8f, 0x64, 0xcc, 0x51, 0x1a, 0x69, 0xbd, 0x99,
-	0xfc, 0x28, 0xc5, 0xf8, 0x8f, 0x3d, 0x4a, 0x87, 0x9e, 0xe2, 0x5d, 0x05, 0x96, 0xe5, 0x7d, 0x4a,
-	0x68, 0x3d, 0xcd, 0x36, 0xd3, 0x3b, 0xb2, 0x1a, 0x57, 0x0f, 0x45, 0x13, 0x69, 0x71, 0x0f, 0x66,
-	0x93, 0xdd, 0x38, 0x29, 0x5a, 0x94, 0x36, 0x30, 0x35, 0x2e, 0x4e, 0x84, 0x1b, 0x4d, 0xf6, 0x1a,
-	0x54, 0x62, 0xff, 0xa4, 0x42, 0x8f, 0x8e, 0xb1, 0xe3, 0xf8, 0x1f, 0x91, 0x0e, 0xd2, 0xe4, 0x2b,
-	0x50, 0x8e, 0xfe, 0x19, 0x85, 0xce, 0xa5, 0xda, 0xef, 0x61, 0x58, 0x6e, 0x03, 0x0c, 0xfe, 0x0f,
-	0x85, 0x1e, 0x91, 0xf2, 0x1c, 0xf9, 0xc3, 0xd4, 0x41, 0x4c, 0xa3, 0xe5, 0x8b, 0xea, 0xc8, 0xb8,
-	0xe5, 0xc7, 0xcb, 0x79, 0x07, 0xb1, 0x6d, 0x43, 0x35, 0x51, 0x84, 0x4f, 0x3b, 0xc2, 0x92, 0xde,
-	0x88, 0xc6, 0x85, 0x49, 0x50, 0xa3, 0xfd, 0x6b, 0x43, 0x35, 0x51, 0x12, 0x4d, 0x99, 0x49, 0x56,
-	0x01, 0x4e, 0x99, 0x49, 0x5a, 0x61, 0xd5, 0x66, 0xd0, 0x77, 0x63, 0xd5, 0xd7, 0x44, 0x85, 0x1b,
-	0x5d, 0x19, 0xcb, 0x47, 0x56, 0xe0, 0x6f, 0xac, 0x1f, 0x86, 0x24, 0x12, 0x21, 0xb0, 0x2a, 0xa1,
-	0xd2, 0x74, 0xab, 0x3a, 0xcc, 0x4e, 0x6d, 0x43, 0x41, 0x14, 0x39, 0x91, 0x96, 0xd2, 0xce, 0x10,
-	0xab, 0x80, 0x36, 0x1e, 0x96, 0xe2, 0x24, 0xeb, 0x7f, 0x82, 0xa9, 0x28, 0x62, 0xa5, 0x30, 0x4d,
-	0x54, 0xb8, 0x26, 0x65, 0xaa, 0x43, 0x41, 0xa4, 0xb6, 0x53, 0x98, 0x26, 0xca, 0x33, 0x8d, 0xf1,
-	0x38, 0x22, 0x1f, 0x3e, 0x83, 0xb6, 0x20, 0xcf, 0x53, 0xc0, 0x68, 0x75, 0x5c, 0x7a, 0x78, 0x1c,
-	0xc7, 0x44, 0x06, 0x59, 0x9b, 0x41, 0xdf, 0x86, 0x3c, 0x7f, 0xe9, 0xa4, 0x70, 0x8c, 0xe7, 0x78,
-	0x1b, 0x63, 0x51, 0x42, 0x11, 0x2d, 0x50, 0xe3, 0x19, 0xa0, 0x94, 0x2b, 0x4b, 0x92, 0x23, 0x6b,
-	0x4c, 0x82, 0x19, 0xce, 0xf2, 0x7d, 0x05, 0xea, 0x69, 0xc9, 0x02, 0x94, 0x1a, 0x97, 0x8c, 0xcb,
-	0x78, 0x34, 0x9e, 0x3a, 0x24, 0x55, 0xa4, 0xc2, 0x77, 0x60, 0x41, 0xf2, 0x44, 0x45, 0x97, 0xd3,
-	0xf8, 0xa5, 0xbc, 0xae, 0x1b, 0x4f, 0x4c, 0x4e, 0x10, 0xcd, 0xbd, 0x05, 0x79, 0xfe, 0xb4, 0x4c,
-	0xd9, 0xbe, 0xf8, 0x4b, 0x35, 0xc5, 0x20, 0x12, 0x2f, 0x53, 0x6d, 0x06, 0x61, 0x50, 0xe3, 0xef,
-	0xcc, 0x94, 0xfd, 0x93, 0x3c, 0x51, 0x1b, 0xe7, 0x27, 0xc0, 0x8c, 0xa6, 0x31, 0x00, 0x06, 0xef,
-	0xbc, 0x94, 0xdb, 0x61, 0xe4, 0xa9, 0xd9, 0x78, 0xf4, 0x40, 0xbc, 0x70, 0x82, 0xf5, 0x1e, 0xa8,
-	0x5b, 0xbe, 0x77, 0xaf, 0x1f, 0xbe, 0xaa, 0xfe, 0x3f, 0xeb, 0xba, 0xfe, 0xd4, 0x9b, 0x57, 0x5b,
-	0x36, 0x6d, 0xf7, 0x76, 0x98, 0xe7, 0xba, 0x2c, 0x70, 0x1f, 0xb7, 0xbd, 0xe0, 0xd7, 0x65, 0xdb,
-	0xa5, 0xd8, 0x77, 0x4d, 0xe7, 0x32, 0xe7, 0x15, 0x40, 0xbb, 0x3b, 0x3b, 0x05, 0xfe, 0x7d, 0xf5,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x48, 0x0d, 0x28, 0x79, 0x69, 0x3d, 0x00, 0x00,
+func (m *QueryIteratorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryIteratorRequest.Unmarshal(m, b)
+}
+func (m *QueryIteratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryIteratorRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryIteratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIteratorRequest.Merge(m, src)
+}
+func (m *QueryIteratorRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryIteratorRequest.Size(m)
+}
+func (m *QueryIteratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIteratorRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryIteratorRequest proto.InternalMessageInfo
 
-// MilvusServiceClient is the client API for MilvusService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MilvusServiceClient interface {
-	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
-	LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
-	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
-	LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error)
-	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
-	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
-	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
-	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
-	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
-	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
-	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
-	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
-	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
-	// TODO: remove
-	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
+func (m *QueryIteratorRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
 }
 
-type milvusServiceClient struct {
-	cc *grpc.ClientConn
+func (m *QueryIteratorRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-func NewMilvusServiceClient(cc *grpc.ClientConn) MilvusServiceClient {
-	return &milvusServiceClient{cc}
+func (m *QueryIteratorRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-func (c *milvusServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIteratorRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIteratorRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
-	out := new(BoolResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIteratorRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIteratorRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
 	}
-	return out, nil
+	return 0
 }
 
-func (c *milvusServiceClient) ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReleaseCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIteratorRequest) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
 	}
-	return out, nil
+	return 0
 }
 
-func (c *milvusServiceClient) DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error) {
-	out := new(DescribeCollectionResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DescribeCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIteratorRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
-	out := new(GetCollectionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCollectionStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryIteratorResults struct {
+	Status     *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FieldsData []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	// Cursor of the next batch, empty if all entities have been returned
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error) {
-	out := new(ShowCollectionsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ShowCollections", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryIteratorResults) Reset()         { *m = QueryIteratorResults{} }
+func (m *QueryIteratorResults) String() string { return proto.CompactTextString(m) }
+func (*QueryIteratorResults) ProtoMessage()    {}
+func (*QueryIteratorResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
-func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryIteratorResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryIteratorResults.Unmarshal(m, b)
+}
+func (m *QueryIteratorResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryIteratorResults.Marshal(b, m, deterministic)
+}
+func (m *QueryIteratorResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIteratorResults.Merge(m, src)
+}
+func (m *QueryIteratorResults) XXX_Size() int {
+	return xxx_messageInfo_QueryIteratorResults.Size(m)
+}
+func (m *QueryIteratorResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIteratorResults.DiscardUnknown(m)
 }
 
-func (c *milvusServiceClient) DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropPartition", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIteratorResults proto.InternalMessageInfo
+
+func (m *QueryIteratorResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
-	out := new(BoolResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasPartition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIteratorResults) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadPartitions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIteratorResults) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReleasePartitions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type VectorIDs struct {
+	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IdArray              *schemapb.IDs `protobuf:"bytes,3,opt,name=id_array,json=idArray,proto3" json:"id_array,omitempty"`
+	PartitionNames       []string      `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	DbName               string        `protobuf:"bytes,5,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *milvusServiceClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
-	out := new(GetPartitionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPartitionStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
+func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
+func (*VectorIDs) ProtoMessage()    {}
+func (*VectorIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{73}
 }
 
-func (c *milvusServiceClient) ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error) {
-	out := new(ShowPartitionsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ShowPartitions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorIDs.Unmarshal(m, b)
 }
-
-func (c *milvusServiceClient) CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateAlias", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *VectorIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorIDs.Marshal(b, m, deterministic)
 }
-
-func (c *milvusServiceClient) DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropAlias", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *VectorIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorIDs.Merge(m, src)
 }
-
-func (c *milvusServiceClient) AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterAlias", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *VectorIDs) XXX_Size() int {
+	return xxx_messageInfo_VectorIDs.Size(m)
+}
+func (m *VectorIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorIDs.DiscardUnknown(m)
 }
 
-func (c *milvusServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VectorIDs proto.InternalMessageInfo
+
+func (m *VectorIDs) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error) {
-	out := new(DescribeIndexResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DescribeIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VectorIDs) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error) {
-	out := new(GetIndexStateResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetIndexState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VectorIDs) GetIdArray() *schemapb.IDs {
+	if m != nil {
+		return m.IdArray
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error) {
-	out := new(GetIndexBuildProgressResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetIndexBuildProgress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VectorIDs) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VectorIDs) GetDbName() string {
+	if m != nil {
+		return m.DbName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *milvusServiceClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
-	out := new(MutationResult)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Insert", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type VectorsArray struct {
+	// Types that are valid to be assigned to Array:
+	//	*VectorsArray_IdArray
+	//	*VectorsArray_DataArray
+	Array                isVectorsArray_Array `protobuf_oneof:"array"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error) {
-	out := new(MutationResult)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
+func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
+func (*VectorsArray) ProtoMessage()    {}
+func (*VectorsArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{74}
 }
 
-func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
-	out := new(SearchResults)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorsArray.Unmarshal(m, b)
+}
+func (m *VectorsArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorsArray.Marshal(b, m, deterministic)
+}
+func (m *VectorsArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorsArray.Merge(m, src)
+}
+func (m *VectorsArray) XXX_Size() int {
+	return xxx_messageInfo_VectorsArray.Size(m)
+}
+func (m *VectorsArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorsArray.DiscardUnknown(m)
 }
 
-func (c *milvusServiceClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
-	out := new(FlushResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Flush", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_VectorsArray proto.InternalMessageInfo
+
+type isVectorsArray_Array interface {
+	isVectorsArray_Array()
 }
 
-func (c *milvusServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error) {
-	out := new(QueryResults)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Query", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type VectorsArray_IdArray struct {
+	IdArray *VectorIDs `protobuf:"bytes,1,opt,name=id_array,json=idArray,proto3,oneof"`
 }
 
-func (c *milvusServiceClient) CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error) {
-	out := new(CalcDistanceResults)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CalcDistance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type VectorsArray_DataArray struct {
+	DataArray *schemapb.VectorField `protobuf:"bytes,2,opt,name=data_array,json=dataArray,proto3,oneof"`
 }
 
-func (c *milvusServiceClient) GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error) {
-	out := new(GetPersistentSegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (*VectorsArray_IdArray) isVectorsArray_Array() {}
+
+func (*VectorsArray_DataArray) isVectorsArray_Array() {}
+
+func (m *VectorsArray) GetArray() isVectorsArray_Array {
+	if m != nil {
+		return m.Array
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error) {
-	out := new(GetQuerySegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetQuerySegmentInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VectorsArray) GetIdArray() *VectorIDs {
+	if x, ok := m.GetArray().(*VectorsArray_IdArray); ok {
+		return x.IdArray
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
-	out := new(DummyResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Dummy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VectorsArray) GetDataArray() *schemapb.VectorField {
+	if x, ok := m.GetArray().(*VectorsArray_DataArray); ok {
+		return x.DataArray
 	}
-	return out, nil
+	return nil
 }
 
-func (c *milvusServiceClient) RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error) {
-	out := new(RegisterLinkResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RegisterLink", in, out, opts...)
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*VectorsArray) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*VectorsArray_IdArray)(nil),
+		(*VectorsArray_DataArray)(nil),
 	}
-	return out, nil
 }
 
-func (c *milvusServiceClient) GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
-	out := new(GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CalcDistanceRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OpLeft               *VectorsArray            `protobuf:"bytes,2,opt,name=op_left,json=opLeft,proto3" json:"op_left,omitempty"`
+	OpRight              *VectorsArray            `protobuf:"bytes,3,opt,name=op_right,json=opRight,proto3" json:"op_right,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-// MilvusServiceServer is the server API for MilvusService service.
+func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
+func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
+func (*CalcDistanceRequest) ProtoMessage()    {}
+func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{75}
+}
+
+func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalcDistanceRequest.Unmarshal(m, b)
+}
+func (m *CalcDistanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalcDistanceRequest.Marshal(b, m, deterministic)
+}
+func (m *CalcDistanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalcDistanceRequest.Merge(m, src)
+}
+func (m *CalcDistanceRequest) XXX_Size() int {
+	return xxx_messageInfo_CalcDistanceRequest.Size(m)
+}
+func (m *CalcDistanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalcDistanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalcDistanceRequest proto.InternalMessageInfo
+
+func (m *CalcDistanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetOpLeft() *VectorsArray {
+	if m != nil {
+		return m.OpLeft
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetOpRight() *VectorsArray {
+	if m != nil {
+		return m.OpRight
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type CalcDistanceResults struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// num(op_left)*num(op_right) distance values, "HAMMIN" return integer distance
+	//
+	// Types that are valid to be assigned to Array:
+	//	*CalcDistanceResults_IntDist
+	//	*CalcDistanceResults_FloatDist
+	Array                isCalcDistanceResults_Array `protobuf_oneof:"array"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
+func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
+func (*CalcDistanceResults) ProtoMessage()    {}
+func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{76}
+}
+
+func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalcDistanceResults.Unmarshal(m, b)
+}
+func (m *CalcDistanceResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalcDistanceResults.Marshal(b, m, deterministic)
+}
+func (m *CalcDistanceResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalcDistanceResults.Merge(m, src)
+}
+func (m *CalcDistanceResults) XXX_Size() int {
+	return xxx_messageInfo_CalcDistanceResults.Size(m)
+}
+func (m *CalcDistanceResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalcDistanceResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalcDistanceResults proto.InternalMessageInfo
+
+func (m *CalcDistanceResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type isCalcDistanceResults_Array interface {
+	isCalcDistanceResults_Array()
+}
+
+type CalcDistanceResults_IntDist struct {
+	IntDist *schemapb.IntArray `protobuf:"bytes,2,opt,name=int_dist,json=intDist,proto3,oneof"`
+}
+
+type CalcDistanceResults_FloatDist struct {
+	FloatDist *schemapb.FloatArray `protobuf:"bytes,3,opt,name=float_dist,json=floatDist,proto3,oneof"`
+}
+
+func (*CalcDistanceResults_IntDist) isCalcDistanceResults_Array() {}
+
+func (*CalcDistanceResults_FloatDist) isCalcDistanceResults_Array() {}
+
+func (m *CalcDistanceResults) GetArray() isCalcDistanceResults_Array {
+	if m != nil {
+		return m.Array
+	}
+	return nil
+}
+
+func (m *CalcDistanceResults) GetIntDist() *schemapb.IntArray {
+	if x, ok := m.GetArray().(*CalcDistanceResults_IntDist); ok {
+		return x.IntDist
+	}
+	return nil
+}
+
+func (m *CalcDistanceResults) GetFloatDist() *schemapb.FloatArray {
+	if x, ok := m.GetArray().(*CalcDistanceResults_FloatDist); ok {
+		return x.FloatDist
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CalcDistanceResults) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CalcDistanceResults_IntDist)(nil),
+		(*CalcDistanceResults_FloatDist)(nil),
+	}
+}
+
+type PersistentSegmentInfo struct {
+	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NumRows              int64                 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	State                commonpb.SegmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
+func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*PersistentSegmentInfo) ProtoMessage()    {}
+func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{77}
+}
+
+func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersistentSegmentInfo.Unmarshal(m, b)
+}
+func (m *PersistentSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersistentSegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *PersistentSegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersistentSegmentInfo.Merge(m, src)
+}
+func (m *PersistentSegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_PersistentSegmentInfo.Size(m)
+}
+func (m *PersistentSegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersistentSegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersistentSegmentInfo proto.InternalMessageInfo
+
+func (m *PersistentSegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+type GetPersistentSegmentInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSegmentInfoRequest{} }
+func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
+func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{78}
+}
+
+func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Unmarshal(m, b)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersistentSegmentInfoRequest.Merge(m, src)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Size(m)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersistentSegmentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersistentSegmentInfoRequest proto.InternalMessageInfo
+
+func (m *GetPersistentSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetPersistentSegmentInfoRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetPersistentSegmentInfoRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetPersistentSegmentInfoResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*PersistentSegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentSegmentInfoResponse{} }
+func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
+func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{79}
+}
+
+func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Unmarshal(m, b)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersistentSegmentInfoResponse.Merge(m, src)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Size(m)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersistentSegmentInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersistentSegmentInfoResponse proto.InternalMessageInfo
+
+func (m *GetPersistentSegmentInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetPersistentSegmentInfoResponse) GetInfos() []*PersistentSegmentInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type QuerySegmentInfo struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64    `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	MemSize              int64    `protobuf:"varint,4,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	NumRows              int64    `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName            string   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID              int64    `protobuf:"varint,7,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
+func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*QuerySegmentInfo) ProtoMessage()    {}
+func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{80}
+}
+
+func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySegmentInfo.Unmarshal(m, b)
+}
+func (m *QuerySegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *QuerySegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySegmentInfo.Merge(m, src)
+}
+func (m *QuerySegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_QuerySegmentInfo.Size(m)
+}
+func (m *QuerySegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySegmentInfo proto.InternalMessageInfo
+
+func (m *QuerySegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetMemSize() int64 {
+	if m != nil {
+		return m.MemSize
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *QuerySegmentInfo) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+type GetQuerySegmentInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoRequest{} }
+func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
+func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{81}
+}
+
+func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Unmarshal(m, b)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuerySegmentInfoRequest.Merge(m, src)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Size(m)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuerySegmentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuerySegmentInfoRequest proto.InternalMessageInfo
+
+func (m *GetQuerySegmentInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetQuerySegmentInfoRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetQuerySegmentInfoRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetQuerySegmentInfoResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*QuerySegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfoResponse{} }
+func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
+func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{82}
+}
+
+func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Unmarshal(m, b)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuerySegmentInfoResponse.Merge(m, src)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Size(m)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuerySegmentInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuerySegmentInfoResponse proto.InternalMessageInfo
+
+func (m *GetQuerySegmentInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetQuerySegmentInfoResponse) GetInfos() []*QuerySegmentInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type DummyRequest struct {
+	RequestType          string   `protobuf:"bytes,1,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
+func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
+func (*DummyRequest) ProtoMessage()    {}
+func (*DummyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{83}
+}
+
+func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DummyRequest.Unmarshal(m, b)
+}
+func (m *DummyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DummyRequest.Marshal(b, m, deterministic)
+}
+func (m *DummyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DummyRequest.Merge(m, src)
+}
+func (m *DummyRequest) XXX_Size() int {
+	return xxx_messageInfo_DummyRequest.Size(m)
+}
+func (m *DummyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DummyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DummyRequest proto.InternalMessageInfo
+
+func (m *DummyRequest) GetRequestType() string {
+	if m != nil {
+		return m.RequestType
+	}
+	return ""
+}
+
+type DummyResponse struct {
+	Response             string   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
+func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
+func (*DummyResponse) ProtoMessage()    {}
+func (*DummyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{84}
+}
+
+func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DummyResponse.Unmarshal(m, b)
+}
+func (m *DummyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DummyResponse.Marshal(b, m, deterministic)
+}
+func (m *DummyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DummyResponse.Merge(m, src)
+}
+func (m *DummyResponse) XXX_Size() int {
+	return xxx_messageInfo_DummyResponse.Size(m)
+}
+func (m *DummyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DummyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DummyResponse proto.InternalMessageInfo
+
+func (m *DummyResponse) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+type RegisterLinkRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
+func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterLinkRequest) ProtoMessage()    {}
+func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{85}
+}
+
+func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterLinkRequest.Unmarshal(m, b)
+}
+func (m *RegisterLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterLinkRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterLinkRequest.Merge(m, src)
+}
+func (m *RegisterLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterLinkRequest.Size(m)
+}
+func (m *RegisterLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterLinkRequest proto.InternalMessageInfo
+
+type RegisterLinkResponse struct {
+	Address              *commonpb.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Status               *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
+func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterLinkResponse) ProtoMessage()    {}
+func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{86}
+}
+
+func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterLinkResponse.Unmarshal(m, b)
+}
+func (m *RegisterLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterLinkResponse.Marshal(b, m, deterministic)
+}
+func (m *RegisterLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterLinkResponse.Merge(m, src)
+}
+func (m *RegisterLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterLinkResponse.Size(m)
+}
+func (m *RegisterLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterLinkResponse proto.InternalMessageInfo
+
+func (m *RegisterLinkResponse) GetAddress() *commonpb.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *RegisterLinkResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type GetMetricsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Request              string            `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
+func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMetricsRequest) ProtoMessage()    {}
+func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{87}
+}
+
+func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetricsRequest.Unmarshal(m, b)
+}
+func (m *GetMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetricsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetricsRequest.Merge(m, src)
+}
+func (m *GetMetricsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMetricsRequest.Size(m)
+}
+func (m *GetMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetricsRequest proto.InternalMessageInfo
+
+func (m *GetMetricsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetMetricsRequest) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+type GetMetricsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Response             string           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	ComponentName        string           `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
+func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMetricsResponse) ProtoMessage()    {}
+func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{88}
+}
+
+func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetricsResponse.Unmarshal(m, b)
+}
+func (m *GetMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetricsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetricsResponse.Merge(m, src)
+}
+func (m *GetMetricsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMetricsResponse.Size(m)
+}
+func (m *GetMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetricsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetricsResponse proto.InternalMessageInfo
+
+func (m *GetMetricsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetMetricsResponse) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func (m *GetMetricsResponse) GetComponentName() string {
+	if m != nil {
+		return m.ComponentName
+	}
+	return ""
+}
+
+type ManualCompactionRequest struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Timetravel           uint64   `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManualCompactionRequest) Reset()         { *m = ManualCompactionRequest{} }
+func (m *ManualCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*ManualCompactionRequest) ProtoMessage()    {}
+func (*ManualCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{89}
+}
+
+func (m *ManualCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManualCompactionRequest.Unmarshal(m, b)
+}
+func (m *ManualCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManualCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *ManualCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualCompactionRequest.Merge(m, src)
+}
+func (m *ManualCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_ManualCompactionRequest.Size(m)
+}
+func (m *ManualCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualCompactionRequest proto.InternalMessageInfo
+
+func (m *ManualCompactionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ManualCompactionRequest) GetTimetravel() uint64 {
+	if m != nil {
+		return m.Timetravel
+	}
+	return 0
+}
+
+type ManualCompactionResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CompactionID         int64            `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionResponse{} }
+func (m *ManualCompactionResponse) String() string { return proto.CompactTextString(m) }
+func (*ManualCompactionResponse) ProtoMessage()    {}
+func (*ManualCompactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{90}
+}
+
+func (m *ManualCompactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManualCompactionResponse.Unmarshal(m, b)
+}
+func (m *ManualCompactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManualCompactionResponse.Marshal(b, m, deterministic)
+}
+func (m *ManualCompactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualCompactionResponse.Merge(m, src)
+}
+func (m *ManualCompactionResponse) XXX_Size() int {
+	return xxx_messageInfo_ManualCompactionResponse.Size(m)
+}
+func (m *ManualCompactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualCompactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualCompactionResponse proto.InternalMessageInfo
+
+func (m *ManualCompactionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ManualCompactionResponse) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type GetCompactionStateRequest struct {
+	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionStateRequest) Reset()         { *m = GetCompactionStateRequest{} }
+func (m *GetCompactionStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionStateRequest) ProtoMessage()    {}
+func (*GetCompactionStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{91}
+}
+
+func (m *GetCompactionStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionStateRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionStateRequest.Merge(m, src)
+}
+func (m *GetCompactionStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionStateRequest.Size(m)
+}
+func (m *GetCompactionStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionStateRequest proto.InternalMessageInfo
+
+func (m *GetCompactionStateRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type GetCompactionStateResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
+	ExecutingPlanNo      int64                    `protobuf:"varint,3,opt,name=executingPlanNo,proto3" json:"executingPlanNo,omitempty"`
+	TimeoutPlanNo        int64                    `protobuf:"varint,4,opt,name=timeoutPlanNo,proto3" json:"timeoutPlanNo,omitempty"`
+	CompletedPlanNo      int64                    `protobuf:"varint,5,opt,name=completedPlanNo,proto3" json:"completedPlanNo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetCompactionStateResponse) Reset()         { *m = GetCompactionStateResponse{} }
+func (m *GetCompactionStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionStateResponse) ProtoMessage()    {}
+func (*GetCompactionStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{92}
+}
+
+func (m *GetCompactionStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionStateResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionStateResponse.Merge(m, src)
+}
+func (m *GetCompactionStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionStateResponse.Size(m)
+}
+func (m *GetCompactionStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionStateResponse proto.InternalMessageInfo
+
+func (m *GetCompactionStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionStateResponse) GetState() commonpb.CompactionState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.CompactionState_UndefiedState
+}
+
+func (m *GetCompactionStateResponse) GetExecutingPlanNo() int64 {
+	if m != nil {
+		return m.ExecutingPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionStateResponse) GetTimeoutPlanNo() int64 {
+	if m != nil {
+		return m.TimeoutPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionStateResponse) GetCompletedPlanNo() int64 {
+	if m != nil {
+		return m.CompletedPlanNo
+	}
+	return 0
+}
+
+type BulkLoadRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Files                []string          `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BulkLoadRequest) Reset()         { *m = BulkLoadRequest{} }
+func (m *BulkLoadRequest) String() string { return proto.CompactTextString(m) }
+func (*BulkLoadRequest) ProtoMessage()    {}
+func (*BulkLoadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{93}
+}
+
+func (m *BulkLoadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkLoadRequest.Unmarshal(m, b)
+}
+func (m *BulkLoadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkLoadRequest.Marshal(b, m, deterministic)
+}
+func (m *BulkLoadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkLoadRequest.Merge(m, src)
+}
+func (m *BulkLoadRequest) XXX_Size() int {
+	return xxx_messageInfo_BulkLoadRequest.Size(m)
+}
+func (m *BulkLoadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkLoadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkLoadRequest proto.InternalMessageInfo
+
+func (m *BulkLoadRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *BulkLoadRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *BulkLoadRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *BulkLoadRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *BulkLoadRequest) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+type BulkLoadResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskID               int64            `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BulkLoadResponse) Reset()         { *m = BulkLoadResponse{} }
+func (m *BulkLoadResponse) String() string { return proto.CompactTextString(m) }
+func (*BulkLoadResponse) ProtoMessage()    {}
+func (*BulkLoadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{94}
+}
+
+func (m *BulkLoadResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkLoadResponse.Unmarshal(m, b)
+}
+func (m *BulkLoadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkLoadResponse.Marshal(b, m, deterministic)
+}
+func (m *BulkLoadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkLoadResponse.Merge(m, src)
+}
+func (m *BulkLoadResponse) XXX_Size() int {
+	return xxx_messageInfo_BulkLoadResponse.Size(m)
+}
+func (m *BulkLoadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkLoadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkLoadResponse proto.InternalMessageInfo
+
+func (m *BulkLoadResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *BulkLoadResponse) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+type GetImportStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskID               int64             `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetImportStateRequest) Reset()         { *m = GetImportStateRequest{} }
+func (m *GetImportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetImportStateRequest) ProtoMessage()    {}
+func (*GetImportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{95}
+}
+
+func (m *GetImportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImportStateRequest.Unmarshal(m, b)
+}
+func (m *GetImportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImportStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetImportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImportStateRequest.Merge(m, src)
+}
+func (m *GetImportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetImportStateRequest.Size(m)
+}
+func (m *GetImportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetImportStateRequest proto.InternalMessageInfo
+
+func (m *GetImportStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetImportStateRequest) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+type GetImportStateResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.ImportState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	RowCount             int64                `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	SegmentIDs           []int64              `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	FailedReason         string               `protobuf:"bytes,5,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetImportStateResponse) Reset()         { *m = GetImportStateResponse{} }
+func (m *GetImportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetImportStateResponse) ProtoMessage()    {}
+func (*GetImportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{96}
+}
+
+func (m *GetImportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImportStateResponse.Unmarshal(m, b)
+}
+func (m *GetImportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImportStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetImportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImportStateResponse.Merge(m, src)
+}
+func (m *GetImportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetImportStateResponse.Size(m)
+}
+func (m *GetImportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImportStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetImportStateResponse proto.InternalMessageInfo
+
+func (m *GetImportStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetImportStateResponse) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *GetImportStateResponse) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *GetImportStateResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *GetImportStateResponse) GetFailedReason() string {
+	if m != nil {
+		return m.FailedReason
+	}
+	return ""
+}
+
+type LoadBalanceRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SrcNodeID            int64             `protobuf:"varint,2,opt,name=src_nodeID,json=srcNodeID,proto3" json:"src_nodeID,omitempty"`
+	DstNodeIDs           []int64           `protobuf:"varint,3,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
+	SealedSegmentIDs     []int64           `protobuf:"varint,4,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
+func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadBalanceRequest) ProtoMessage()    {}
+func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{97}
+}
+
+func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalanceRequest.Unmarshal(m, b)
+}
+func (m *LoadBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalanceRequest.Merge(m, src)
+}
+func (m *LoadBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadBalanceRequest.Size(m)
+}
+func (m *LoadBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalanceRequest proto.InternalMessageInfo
+
+func (m *LoadBalanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSrcNodeID() int64 {
+	if m != nil {
+		return m.SrcNodeID
+	}
+	return 0
+}
+
+func (m *LoadBalanceRequest) GetDstNodeIDs() []int64 {
+	if m != nil {
+		return m.DstNodeIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
+	if m != nil {
+		return m.SealedSegmentIDs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
+	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
+	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
+	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
+	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
+	proto.RegisterType((*AddFieldRequest)(nil), "milvus.proto.milvus.AddFieldRequest")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.milvus.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.milvus.DropDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.milvus.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.milvus.ListDatabasesResponse")
+	proto.RegisterType((*CreateCredentialRequest)(nil), "milvus.proto.milvus.CreateCredentialRequest")
+	proto.RegisterType((*UpdateCredentialRequest)(nil), "milvus.proto.milvus.UpdateCredentialRequest")
+	proto.RegisterType((*DeleteCredentialRequest)(nil), "milvus.proto.milvus.DeleteCredentialRequest")
+	proto.RegisterType((*ListCredUsersRequest)(nil), "milvus.proto.milvus.ListCredUsersRequest")
+	proto.RegisterType((*ListCredUsersResponse)(nil), "milvus.proto.milvus.ListCredUsersResponse")
+	proto.RegisterType((*CreateRoleRequest)(nil), "milvus.proto.milvus.CreateRoleRequest")
+	proto.RegisterType((*DropRoleRequest)(nil), "milvus.proto.milvus.DropRoleRequest")
+	proto.RegisterType((*AddUserToRoleRequest)(nil), "milvus.proto.milvus.AddUserToRoleRequest")
+	proto.RegisterType((*RemoveUserFromRoleRequest)(nil), "milvus.proto.milvus.RemoveUserFromRoleRequest")
+	proto.RegisterType((*GrantEntity)(nil), "milvus.proto.milvus.GrantEntity")
+	proto.RegisterType((*GrantPrivilegeRequest)(nil), "milvus.proto.milvus.GrantPrivilegeRequest")
+	proto.RegisterType((*RevokePrivilegeRequest)(nil), "milvus.proto.milvus.RevokePrivilegeRequest")
+	proto.RegisterType((*ListGrantsRequest)(nil), "milvus.proto.milvus.ListGrantsRequest")
+	proto.RegisterType((*ListGrantsResponse)(nil), "milvus.proto.milvus.ListGrantsResponse")
+	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
+	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
+	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
+	proto.RegisterType((*BoolResponse)(nil), "milvus.proto.milvus.BoolResponse")
+	proto.RegisterType((*StringResponse)(nil), "milvus.proto.milvus.StringResponse")
+	proto.RegisterType((*DescribeCollectionRequest)(nil), "milvus.proto.milvus.DescribeCollectionRequest")
+	proto.RegisterType((*DescribeCollectionResponse)(nil), "milvus.proto.milvus.DescribeCollectionResponse")
+	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.milvus.LoadCollectionRequest")
+	proto.RegisterType((*ReleaseCollectionRequest)(nil), "milvus.proto.milvus.ReleaseCollectionRequest")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.milvus.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
+	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
+	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
+	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
+	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
+	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
+	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.milvus.LoadPartitionsRequest")
+	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.milvus.ReleasePartitionsRequest")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.milvus.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.milvus.GetPartitionStatisticsResponse")
+	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.milvus.ShowPartitionsRequest")
+	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.milvus.ShowPartitionsResponse")
+	proto.RegisterType((*DescribeSegmentRequest)(nil), "milvus.proto.milvus.DescribeSegmentRequest")
+	proto.RegisterType((*DescribeSegmentResponse)(nil), "milvus.proto.milvus.DescribeSegmentResponse")
+	proto.RegisterType((*ShowSegmentsRequest)(nil), "milvus.proto.milvus.ShowSegmentsRequest")
+	proto.RegisterType((*ShowSegmentsResponse)(nil), "milvus.proto.milvus.ShowSegmentsResponse")
+	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.milvus.CreateIndexRequest")
+	proto.RegisterType((*DescribeIndexRequest)(nil), "milvus.proto.milvus.DescribeIndexRequest")
+	proto.RegisterType((*IndexDescription)(nil), "milvus.proto.milvus.IndexDescription")
+	proto.RegisterType((*DescribeIndexResponse)(nil), "milvus.proto.milvus.DescribeIndexResponse")
+	proto.RegisterType((*GetIndexBuildProgressRequest)(nil), "milvus.proto.milvus.GetIndexBuildProgressRequest")
+	proto.RegisterType((*GetIndexBuildProgressResponse)(nil), "milvus.proto.milvus.GetIndexBuildProgressResponse")
+	proto.RegisterType((*GetIndexStateRequest)(nil), "milvus.proto.milvus.GetIndexStateRequest")
+	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.milvus.GetIndexStateResponse")
+	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.milvus.DropIndexRequest")
+	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
+	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
+	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
+	proto.RegisterType((*UpsertRequest)(nil), "milvus.proto.milvus.UpsertRequest")
+	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
+	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
+	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
+	proto.RegisterType((*HybridSearchRequest)(nil), "milvus.proto.milvus.HybridSearchRequest")
+	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
+	proto.RegisterType((*SearchResults)(nil), "milvus.proto.milvus.SearchResults")
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.milvus.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
+	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
+	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
+	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
+	proto.RegisterType((*QueryIteratorRequest)(nil), "milvus.proto.milvus.QueryIteratorRequest")
+	proto.RegisterType((*QueryIteratorResults)(nil), "milvus.proto.milvus.QueryIteratorResults")
+	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
+	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
+	proto.RegisterType((*CalcDistanceRequest)(nil), "milvus.proto.milvus.CalcDistanceRequest")
+	proto.RegisterType((*CalcDistanceResults)(nil), "milvus.proto.milvus.CalcDistanceResults")
+	proto.RegisterType((*PersistentSegmentInfo)(nil), "milvus.proto.milvus.PersistentSegmentInfo")
+	proto.RegisterType((*GetPersistentSegmentInfoRequest)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoRequest")
+	proto.RegisterType((*GetPersistentSegmentInfoResponse)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoResponse")
+	proto.RegisterType((*QuerySegmentInfo)(nil), "milvus.proto.milvus.QuerySegmentInfo")
+	proto.RegisterType((*GetQuerySegmentInfoRequest)(nil), "milvus.proto.milvus.GetQuerySegmentInfoRequest")
+	proto.RegisterType((*GetQuerySegmentInfoResponse)(nil), "milvus.proto.milvus.GetQuerySegmentInfoResponse")
+	proto.RegisterType((*DummyRequest)(nil), "milvus.proto.milvus.DummyRequest")
+	proto.RegisterType((*DummyResponse)(nil), "milvus.proto.milvus.DummyResponse")
+	proto.RegisterType((*RegisterLinkRequest)(nil), "milvus.proto.milvus.RegisterLinkRequest")
+	proto.RegisterType((*RegisterLinkResponse)(nil), "milvus.proto.milvus.RegisterLinkResponse")
+	proto.RegisterType((*GetMetricsRequest)(nil), "milvus.proto.milvus.GetMetricsRequest")
+	proto.RegisterType((*GetMetricsResponse)(nil), "milvus.proto.milvus.GetMetricsResponse")
+	proto.RegisterType((*ManualCompactionRequest)(nil), "milvus.proto.milvus.ManualCompactionRequest")
+	proto.RegisterType((*ManualCompactionResponse)(nil), "milvus.proto.milvus.ManualCompactionResponse")
+	proto.RegisterType((*GetCompactionStateRequest)(nil), "milvus.proto.milvus.GetCompactionStateRequest")
+	proto.RegisterType((*GetCompactionStateResponse)(nil), "milvus.proto.milvus.GetCompactionStateResponse")
+	proto.RegisterType((*BulkLoadRequest)(nil), "milvus.proto.milvus.BulkLoadRequest")
+	proto.RegisterType((*BulkLoadResponse)(nil), "milvus.proto.milvus.BulkLoadResponse")
+	proto.RegisterType((*GetImportStateRequest)(nil), "milvus.proto.milvus.GetImportStateRequest")
+	proto.RegisterType((*GetImportStateResponse)(nil), "milvus.proto.milvus.GetImportStateResponse")
+	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.milvus.LoadBalanceRequest")
+}
+
+func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
+
+var fileDescriptor_02345ba45cc0e303 = []byte{
+	// 4423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4d, 0x8f, 0x24, 0xc9,
+	0x55, 0x93, 0xf5, 0xd1, 0x55, 0xf5, 0xaa, 0xaa, 0xbb, 0x3a, 0xfb, 0xab, 0xb6, 0x76, 0x66, 0x67,
+	0x26, 0xbd, 0xe3, 0x9d, 0x9d, 0xf1, 0xee, 0x78, 0x7b, 0x76, 0xd7, 0xcb, 0x1a, 0xbc, 0x9e, 0x9e,
+	0xf6, 0xce, 0xb4, 0x76, 0x66, 0xdc, 0xce, 0xde, 0x31, 0x5a, 0xac, 0xa5, 0xc8, 0xce, 0x8c, 0xae,
+	0x4e, 0x77, 0x56, 0x66, 0x39, 0x22, 0xaa, 0x7b, 0x7a, 0x4f, 0x80, 0xc1, 0x16, 0x02, 0x6c, 0x21,
+	0x90, 0xf9, 0x12, 0x1c, 0x00, 0x1f, 0x40, 0x02, 0x81, 0x8d, 0x04, 0x42, 0x82, 0x03, 0x70, 0x40,
+	0x08, 0xf1, 0x75, 0xe1, 0xca, 0x05, 0x71, 0xe2, 0x1f, 0x70, 0xb0, 0xe2, 0x23, 0xb3, 0x32, 0xb3,
+	0x22, 0xab, 0xb2, 0xa7, 0x76, 0xdc, 0xdd, 0x92, 0x6f, 0x99, 0x2f, 0x5e, 0xbc, 0x78, 0xf1, 0xe2,
+	0xc5, 0x8b, 0x17, 0x2f, 0x5e, 0x04, 0x34, 0xfa, 0xae, 0x77, 0x38, 0x24, 0xaf, 0x0e, 0x70, 0x40,
+	0x03, 0x7d, 0x29, 0xfe, 0xf7, 0xaa, 0xf8, 0xe9, 0x34, 0xec, 0xa0, 0xdf, 0x0f, 0x7c, 0x01, 0xec,
+	0x34, 0x88, 0xbd, 0x8f, 0xfa, 0x96, 0xf8, 0x33, 0x7e, 0x5f, 0x03, 0xfd, 0x2e, 0x46, 0x16, 0x45,
+	0x77, 0x3c, 0xd7, 0x22, 0x26, 0xfa, 0xda, 0x10, 0x11, 0xaa, 0x7f, 0x1a, 0x4a, 0xbb, 0x16, 0x41,
+	0x6d, 0xed, 0x8a, 0x76, 0xbd, 0xbe, 0x7e, 0xf1, 0xd5, 0x04, 0x59, 0x49, 0xee, 0x21, 0xe9, 0x6d,
+	0x58, 0x04, 0x99, 0x1c, 0x53, 0x7f, 0x09, 0x16, 0xec, 0xc0, 0xf3, 0x90, 0x4d, 0xdd, 0xc0, 0xef,
+	0xfa, 0x56, 0x1f, 0xb5, 0x0b, 0x57, 0xb4, 0xeb, 0x35, 0x73, 0x7e, 0x04, 0x7e, 0x64, 0xf5, 0x91,
+	0xbe, 0x0c, 0x65, 0x8b, 0x35, 0xd5, 0x2e, 0xf2, 0x62, 0xf1, 0xa3, 0xaf, 0x41, 0xc5, 0xd9, 0x15,
+	0xd5, 0x4a, 0x1c, 0x3e, 0xe7, 0xec, 0x32, 0x74, 0x83, 0x40, 0x6b, 0x13, 0x07, 0x83, 0x19, 0xb9,
+	0x8b, 0x1a, 0x2d, 0x64, 0x34, 0x5a, 0x4c, 0x34, 0xfa, 0x7b, 0x1a, 0x2c, 0xde, 0xf1, 0x28, 0xc2,
+	0x67, 0x54, 0x28, 0x7f, 0xab, 0xc1, 0xc2, 0x1d, 0xc7, 0x79, 0xd7, 0x45, 0x9e, 0xf3, 0xf4, 0xdc,
+	0xc5, 0xc8, 0x17, 0xe2, 0xe4, 0x55, 0x6c, 0x17, 0x95, 0x6c, 0xbf, 0x09, 0xe5, 0x3d, 0xc6, 0x03,
+	0x67, 0xaf, 0xbe, 0x7e, 0x25, 0xd9, 0xa8, 0x54, 0x34, 0xce, 0xe5, 0x0e, 0xff, 0x36, 0x05, 0xba,
+	0xb1, 0x0b, 0x2b, 0x42, 0xe9, 0x36, 0x2d, 0x6a, 0x31, 0x5e, 0x3e, 0xfe, 0x4e, 0x18, 0x3f, 0x03,
+	0x4b, 0x4c, 0x71, 0x9e, 0x61, 0x0b, 0xf7, 0x61, 0xf9, 0x81, 0x4b, 0x68, 0xd8, 0xc2, 0xd3, 0xeb,
+	0x89, 0xf1, 0x1d, 0x0d, 0x56, 0x52, 0xa4, 0xc8, 0x20, 0xf0, 0x09, 0xd2, 0x6f, 0xc3, 0x1c, 0xa1,
+	0x16, 0x1d, 0x12, 0x49, 0xed, 0x79, 0x25, 0xb5, 0x1d, 0x8e, 0x62, 0x4a, 0x54, 0xfd, 0x39, 0xa8,
+	0x4a, 0x8e, 0x99, 0xc2, 0x17, 0xaf, 0xd7, 0xcc, 0x8a, 0x60, 0x99, 0xe8, 0xaf, 0x80, 0x6e, 0x73,
+	0xc9, 0x3b, 0x5d, 0xea, 0xf6, 0x11, 0xa1, 0x56, 0x7f, 0xc0, 0xb4, 0xae, 0x78, 0xbd, 0x64, 0x2e,
+	0xca, 0x92, 0xf7, 0xa3, 0x02, 0xe3, 0xeb, 0x1a, 0xac, 0x89, 0x91, 0xba, 0x8b, 0x91, 0x83, 0x7c,
+	0xea, 0x5a, 0xde, 0xd3, 0x4b, 0xb2, 0x03, 0xd5, 0x21, 0x41, 0x38, 0x26, 0xca, 0xe8, 0x9f, 0x95,
+	0x0d, 0x2c, 0x42, 0x8e, 0x02, 0xec, 0x48, 0x65, 0x8b, 0xfe, 0x8d, 0x3f, 0xd5, 0x60, 0xed, 0xf1,
+	0xc0, 0xf9, 0x21, 0x70, 0x71, 0x15, 0x1a, 0x81, 0xe7, 0x74, 0x53, 0x9c, 0xd4, 0x03, 0xcf, 0xd9,
+	0x96, 0x20, 0x86, 0xe2, 0xa3, 0xa3, 0x11, 0x8a, 0x98, 0x99, 0x75, 0x1f, 0x1d, 0x85, 0x28, 0x46,
+	0x0f, 0xd6, 0x36, 0x91, 0x87, 0x9e, 0x39, 0xbb, 0xa1, 0x06, 0xb2, 0x66, 0x1e, 0x13, 0x84, 0x67,
+	0xd0, 0xc0, 0xaf, 0xc2, 0x4a, 0x8a, 0xd2, 0x2c, 0x0a, 0x78, 0x11, 0x6a, 0x21, 0x8f, 0xa1, 0x06,
+	0x8e, 0x00, 0xc6, 0x2e, 0x2c, 0x0a, 0x9d, 0x32, 0x03, 0x6f, 0x86, 0x79, 0xf9, 0x3c, 0xd4, 0x70,
+	0xe0, 0xa1, 0xf8, 0xcc, 0xac, 0x32, 0x80, 0x9c, 0xfd, 0x0b, 0x6c, 0xf6, 0x3f, 0xc3, 0x16, 0x7e,
+	0x4e, 0x83, 0xe5, 0x3b, 0x0e, 0x97, 0xd6, 0xfb, 0xc1, 0x6c, 0xed, 0x4c, 0xd2, 0xc8, 0x04, 0x0f,
+	0xc5, 0x14, 0x0f, 0xdf, 0xd0, 0xe0, 0x39, 0x13, 0xf5, 0x83, 0x43, 0xc4, 0xd8, 0x78, 0x17, 0x07,
+	0xfd, 0x53, 0x62, 0xe4, 0xe7, 0x35, 0xa8, 0xdf, 0xc3, 0x96, 0x4f, 0xbf, 0xe0, 0x53, 0x97, 0x1e,
+	0x27, 0x91, 0xb5, 0x24, 0x72, 0xf6, 0xba, 0x73, 0x19, 0xea, 0xc1, 0xee, 0x57, 0x91, 0x4d, 0xe3,
+	0x8d, 0x80, 0x00, 0x71, 0x84, 0x8b, 0x50, 0x1b, 0x60, 0xf7, 0xd0, 0xf5, 0x50, 0x2f, 0x5c, 0x12,
+	0x47, 0x00, 0x66, 0xac, 0x56, 0x38, 0x13, 0xdb, 0x21, 0xe8, 0xe9, 0x25, 0xf1, 0x16, 0xcc, 0x21,
+	0xde, 0x95, 0x76, 0x41, 0xb5, 0xb4, 0xc9, 0x9f, 0x58, 0x97, 0x4d, 0x89, 0x6f, 0xfc, 0x82, 0x06,
+	0xab, 0x26, 0x3a, 0x0c, 0x0e, 0xd0, 0xa9, 0xb2, 0xb1, 0x0b, 0x8b, 0x6c, 0x42, 0xf3, 0x22, 0xf2,
+	0x8c, 0xa6, 0xc0, 0x37, 0x35, 0xd0, 0xe3, 0x8d, 0xcc, 0x62, 0x32, 0x7e, 0x1c, 0xaa, 0x9c, 0x73,
+	0x57, 0x5a, 0x8c, 0x3c, 0x7d, 0x8d, 0x6a, 0x18, 0xbf, 0x55, 0x88, 0xd6, 0xa9, 0xc8, 0x43, 0x39,
+	0x4d, 0xc7, 0x68, 0x15, 0xe6, 0x84, 0xf7, 0xc3, 0xb5, 0xb4, 0x61, 0xca, 0x3f, 0xfd, 0x12, 0x00,
+	0xd9, 0xb7, 0xb0, 0x43, 0xba, 0xfe, 0xb0, 0xdf, 0x2e, 0x5f, 0xd1, 0xae, 0x97, 0xcd, 0x9a, 0x80,
+	0x3c, 0x1a, 0xf6, 0x75, 0x13, 0x16, 0xed, 0xc0, 0x27, 0x2e, 0xa1, 0xc8, 0xb7, 0x8f, 0xbb, 0x1e,
+	0x3a, 0x44, 0x5e, 0x7b, 0xee, 0x8a, 0x76, 0x7d, 0x7e, 0xfd, 0x9a, 0x92, 0xef, 0xbb, 0x23, 0xec,
+	0x07, 0x0c, 0xd9, 0x6c, 0xd9, 0x29, 0x88, 0xf1, 0xcb, 0x1a, 0xac, 0x30, 0x53, 0x78, 0x26, 0x04,
+	0x63, 0xfc, 0xb1, 0x06, 0xcb, 0xf7, 0x2d, 0x72, 0x36, 0x46, 0xe9, 0x12, 0x00, 0x75, 0xfb, 0xa8,
+	0xcb, 0x9d, 0x1d, 0x3e, 0x52, 0x25, 0xb3, 0xc6, 0x20, 0x3b, 0x0c, 0x60, 0x7c, 0x00, 0x8d, 0x8d,
+	0x20, 0xf0, 0x66, 0xd3, 0xeb, 0x65, 0x28, 0x1f, 0x5a, 0xde, 0x50, 0xf0, 0x58, 0x35, 0xc5, 0x8f,
+	0xf1, 0x15, 0x98, 0xdf, 0xa1, 0xd8, 0xf5, 0x7b, 0x1f, 0x23, 0xf1, 0x5a, 0x48, 0xfc, 0x3f, 0x35,
+	0x78, 0x6e, 0x13, 0x11, 0x1b, 0xbb, 0xbb, 0x67, 0x64, 0x3a, 0x18, 0xd0, 0x18, 0x41, 0xb6, 0x36,
+	0xb9, 0xa8, 0x8b, 0x66, 0x02, 0x96, 0x1a, 0x8c, 0x72, 0x7a, 0x30, 0xfe, 0xab, 0x04, 0x1d, 0x55,
+	0xa7, 0x66, 0x11, 0xdf, 0x4f, 0x44, 0xb3, 0x54, 0x58, 0xd7, 0x6b, 0xca, 0xfd, 0xcb, 0xa8, 0x35,
+	0xb9, 0x89, 0x09, 0x27, 0x73, 0xba, 0x57, 0x45, 0x45, 0xaf, 0xd6, 0x61, 0xe5, 0xd0, 0xc5, 0x74,
+	0x68, 0x79, 0x5d, 0x7b, 0xdf, 0xf2, 0x7d, 0xe4, 0x49, 0xbf, 0xbc, 0xc4, 0xbd, 0xa2, 0x25, 0x59,
+	0x78, 0x57, 0x94, 0x09, 0x1f, 0xfd, 0x75, 0x58, 0x1d, 0xec, 0x1f, 0x13, 0xd7, 0x1e, 0xab, 0x54,
+	0xe6, 0x95, 0x96, 0xc3, 0xd2, 0x44, 0xad, 0x9b, 0xb0, 0x38, 0xe6, 0xd9, 0x73, 0xdb, 0x51, 0x32,
+	0x5b, 0x69, 0xc7, 0x9e, 0xb1, 0x15, 0x22, 0x0f, 0xa9, 0x1d, 0xab, 0x50, 0xe1, 0x15, 0x96, 0x64,
+	0xe1, 0x63, 0x6a, 0x8f, 0xea, 0x24, 0x6d, 0x57, 0x35, 0x6d, 0xbb, 0xda, 0x50, 0xe1, 0xbb, 0x56,
+	0x44, 0xda, 0x35, 0xb1, 0xe7, 0x90, 0xbf, 0xfa, 0x16, 0x2c, 0x10, 0x6a, 0x61, 0xda, 0x1d, 0x04,
+	0xc4, 0x65, 0x72, 0x21, 0x6d, 0x50, 0x59, 0x78, 0x39, 0x48, 0xef, 0xa1, 0x63, 0xb6, 0x0f, 0xda,
+	0xb6, 0x5c, 0x6c, 0xce, 0xf3, 0x8a, 0xdb, 0x61, 0x3d, 0xb5, 0x81, 0xac, 0xcf, 0x66, 0x20, 0xbf,
+	0xc7, 0x36, 0x5f, 0x81, 0xe5, 0x9c, 0x8d, 0xa9, 0x72, 0x0d, 0xe6, 0x31, 0x1a, 0x78, 0xae, 0x6d,
+	0x31, 0x31, 0xef, 0x22, 0xcc, 0x27, 0x4b, 0xd9, 0x6c, 0x4a, 0xe8, 0x23, 0x0e, 0x34, 0xbe, 0xa5,
+	0x41, 0xdb, 0x44, 0x1e, 0xb2, 0xc8, 0xd9, 0x98, 0xe2, 0xc6, 0x6f, 0x68, 0xf0, 0xc2, 0x3d, 0x44,
+	0x63, 0x93, 0x85, 0x5a, 0xd4, 0x25, 0xd4, 0xb5, 0xc9, 0x69, 0xb2, 0xf5, 0x6d, 0x0d, 0x2e, 0x67,
+	0xb2, 0x35, 0x8b, 0xed, 0xf8, 0x0c, 0x94, 0xd9, 0x57, 0xe8, 0xac, 0x5c, 0xcd, 0x52, 0xe5, 0x2f,
+	0x33, 0x93, 0xcc, 0x75, 0x59, 0xe0, 0x1b, 0xff, 0xad, 0xc1, 0xea, 0xce, 0x7e, 0x70, 0x34, 0x62,
+	0xe9, 0x59, 0x08, 0x28, 0x69, 0x4d, 0x8b, 0x29, 0x6b, 0xaa, 0xbf, 0x06, 0x25, 0x7a, 0x3c, 0x10,
+	0x3e, 0xf4, 0xfc, 0xfa, 0x25, 0xa5, 0xa7, 0xc5, 0x98, 0x7c, 0xff, 0x78, 0x80, 0x4c, 0x8e, 0xaa,
+	0xbf, 0x0c, 0xad, 0x94, 0xc8, 0x43, 0x7b, 0xb4, 0x90, 0x94, 0x39, 0x31, 0xfe, 0xba, 0x00, 0x6b,
+	0x63, 0x5d, 0x9c, 0x45, 0xd8, 0xaa, 0xb6, 0x0b, 0xca, 0xb6, 0xd9, 0xfc, 0x89, 0xa1, 0xba, 0x8e,
+	0x08, 0x6e, 0x14, 0xcd, 0x66, 0xcc, 0x2c, 0x3b, 0x59, 0x71, 0x90, 0x52, 0x46, 0x1c, 0x84, 0x99,
+	0x64, 0xa5, 0xbd, 0x14, 0x22, 0x28, 0x99, 0xcb, 0x0a, 0x83, 0x49, 0xf4, 0xd7, 0x60, 0xd9, 0xf5,
+	0x1f, 0xa2, 0x7e, 0x80, 0x8f, 0xbb, 0x03, 0x84, 0x6d, 0xe4, 0x53, 0xab, 0x87, 0x48, 0x7b, 0x8e,
+	0x73, 0xb4, 0x14, 0x96, 0x6d, 0x8f, 0x8a, 0x8c, 0xef, 0x6b, 0xb0, 0x2a, 0x1c, 0xd9, 0x6d, 0x0b,
+	0x53, 0xf7, 0x0c, 0x58, 0xa3, 0x41, 0xc8, 0x47, 0x3c, 0x10, 0xd9, 0x8c, 0xa0, 0x7c, 0x96, 0xfd,
+	0x85, 0x06, 0xcb, 0xcc, 0xc7, 0x3c, 0x4f, 0x3c, 0xff, 0xb9, 0x06, 0x4b, 0xf7, 0x2d, 0x72, 0x9e,
+	0x58, 0xfe, 0x4b, 0xb9, 0x52, 0x45, 0x3c, 0x9f, 0xa6, 0x69, 0x65, 0x88, 0x49, 0xa6, 0x43, 0xa7,
+	0x66, 0x3e, 0xc1, 0x35, 0x31, 0xfe, 0x6a, 0xb4, 0x56, 0x9d, 0x33, 0xce, 0xff, 0x46, 0x83, 0x4b,
+	0xf7, 0x10, 0x8d, 0xb8, 0x3e, 0x13, 0x6b, 0x5a, 0x5e, 0x6d, 0xf9, 0x96, 0x58, 0x91, 0x95, 0xcc,
+	0x9f, 0xca, 0xca, 0xf7, 0x27, 0x05, 0x58, 0x61, 0xcb, 0xc2, 0xd9, 0x50, 0x82, 0x3c, 0x7b, 0x12,
+	0x85, 0xa2, 0x94, 0x55, 0x8a, 0x12, 0xad, 0xa7, 0x73, 0xf9, 0xd7, 0xd3, 0xe4, 0x0a, 0x5d, 0x49,
+	0xef, 0x77, 0xbe, 0x57, 0x80, 0xd5, 0xb4, 0xb0, 0x66, 0x19, 0x35, 0x45, 0x57, 0x0a, 0xca, 0xae,
+	0x18, 0xd0, 0x88, 0x20, 0x5b, 0x9b, 0xe1, 0xf2, 0x99, 0x80, 0x9d, 0xd9, 0xd5, 0xf3, 0x57, 0x34,
+	0x58, 0x0d, 0x37, 0x89, 0x3b, 0xa8, 0xd7, 0x47, 0x3e, 0x7d, 0x7a, 0x15, 0x4b, 0x2b, 0x48, 0x41,
+	0xa1, 0x20, 0x17, 0xa1, 0x46, 0x44, 0x3b, 0xd1, 0xfe, 0x6f, 0x04, 0x30, 0xbe, 0xab, 0xc1, 0xda,
+	0x18, 0x3b, 0xb3, 0x0c, 0x62, 0x1b, 0x2a, 0xae, 0xef, 0xa0, 0x27, 0x11, 0x37, 0xe1, 0x2f, 0x2b,
+	0xd9, 0x1d, 0xba, 0x9e, 0x13, 0xb1, 0x11, 0xfe, 0xb2, 0xf3, 0x0a, 0xe4, 0x5b, 0xbb, 0x1e, 0xea,
+	0x72, 0x5c, 0xae, 0xe7, 0x55, 0xb3, 0x2e, 0x60, 0x5b, 0x0c, 0x64, 0xfc, 0xaa, 0x06, 0x4b, 0x4c,
+	0xd7, 0x24, 0x8f, 0xe4, 0xd9, 0xca, 0xec, 0x0a, 0xd4, 0x63, 0xca, 0x24, 0xd9, 0x8d, 0x83, 0x8c,
+	0x03, 0x58, 0x4e, 0xb2, 0x33, 0x8b, 0xcc, 0x5e, 0x00, 0x88, 0x46, 0x44, 0xe8, 0x7c, 0xd1, 0x8c,
+	0x41, 0x8c, 0xff, 0x8b, 0x4e, 0xc0, 0xb9, 0x30, 0x4e, 0x39, 0x1e, 0xc5, 0xcf, 0x47, 0xe3, 0x46,
+	0xbd, 0xc6, 0x21, 0xbc, 0x78, 0x13, 0x1a, 0xe8, 0x09, 0xc5, 0x56, 0x77, 0x60, 0x61, 0xab, 0x2f,
+	0x26, 0x4f, 0x2e, 0xfb, 0x5b, 0xe7, 0xd5, 0xb6, 0x79, 0x2d, 0xe3, 0x9f, 0x98, 0xaf, 0x26, 0x95,
+	0xf2, 0xac, 0xf7, 0xf8, 0x12, 0x00, 0x57, 0x5a, 0x51, 0x5c, 0x16, 0xc5, 0x1c, 0xc2, 0x57, 0xb8,
+	0xef, 0x6a, 0xd0, 0xe2, 0x5d, 0x10, 0xfd, 0x19, 0x30, 0xb2, 0xa9, 0x3a, 0x5a, 0xaa, 0xce, 0x84,
+	0x29, 0xf4, 0x63, 0x30, 0x27, 0x05, 0x5b, 0xcc, 0x2b, 0x58, 0x59, 0x61, 0x4a, 0x37, 0x8c, 0x3f,
+	0x60, 0x21, 0xd8, 0xa4, 0xc8, 0x67, 0xd1, 0xe8, 0xf7, 0x41, 0x17, 0x3d, 0x74, 0x46, 0xdd, 0x0e,
+	0x57, 0xe3, 0x6b, 0xca, 0xa5, 0x27, 0x2d, 0x24, 0x73, 0xd1, 0x4d, 0x41, 0x88, 0xf1, 0xef, 0x1a,
+	0x5c, 0xbc, 0x87, 0x28, 0x47, 0xdd, 0x60, 0xb6, 0x63, 0x1b, 0x07, 0x3d, 0x8c, 0x08, 0x39, 0xbf,
+	0xfa, 0xf1, 0x1d, 0xe1, 0xbe, 0xa9, 0xba, 0x34, 0x8b, 0xfc, 0xaf, 0x42, 0x83, 0xb7, 0x81, 0x9c,
+	0x2e, 0x0e, 0x8e, 0x88, 0xd4, 0xa3, 0xba, 0x84, 0x99, 0xc1, 0x11, 0x57, 0x08, 0x1a, 0x50, 0xcb,
+	0x13, 0x08, 0x72, 0x61, 0xe0, 0x10, 0x56, 0xcc, 0xe7, 0x60, 0xc8, 0x18, 0x23, 0x8e, 0xce, 0xaf,
+	0x8c, 0xff, 0x88, 0x1d, 0xba, 0x25, 0xbb, 0x32, 0x8b, 0x6c, 0xdf, 0x10, 0xce, 0xa5, 0xe8, 0xcc,
+	0xfc, 0xfa, 0x65, 0x65, 0x9d, 0x58, 0x63, 0x02, 0x9b, 0x9d, 0x1c, 0xee, 0x59, 0xae, 0xd7, 0xc5,
+	0xc8, 0x22, 0x81, 0x2f, 0x3b, 0x0a, 0x0c, 0x64, 0x72, 0x88, 0xf1, 0x8f, 0x9a, 0xc8, 0x23, 0x3a,
+	0xe7, 0x16, 0xef, 0x0f, 0x0b, 0xd0, 0xdc, 0xf2, 0x09, 0xc2, 0xf4, 0xec, 0x6f, 0x40, 0xf4, 0x77,
+	0xa0, 0xce, 0x3b, 0x46, 0xba, 0x8e, 0x45, 0x2d, 0xb9, 0x5c, 0xbd, 0x90, 0x9d, 0x23, 0xc4, 0xa2,
+	0xbe, 0xa6, 0x90, 0x0e, 0x61, 0xdf, 0xec, 0xf0, 0x71, 0xdf, 0x22, 0xfb, 0xdd, 0x03, 0x74, 0x2c,
+	0xdc, 0xbe, 0xa6, 0x59, 0x65, 0x80, 0xf7, 0xd0, 0x31, 0x4f, 0x72, 0xf1, 0x87, 0x7d, 0x31, 0xc1,
+	0x98, 0xf7, 0xdc, 0x34, 0x2b, 0xfe, 0xb0, 0xcf, 0xa7, 0xd7, 0xbf, 0x14, 0x60, 0xfe, 0xe1, 0x90,
+	0x5a, 0xf2, 0x84, 0x60, 0xe8, 0xd1, 0xa7, 0x53, 0xc6, 0x1b, 0x50, 0x14, 0x3e, 0x03, 0xab, 0xd1,
+	0x56, 0x32, 0xbe, 0xb5, 0x49, 0x4c, 0x86, 0xc4, 0x06, 0x8e, 0x0c, 0x6d, 0x5b, 0x3a, 0x59, 0x45,
+	0xce, 0x6c, 0x8d, 0x41, 0xb8, 0xc6, 0xb1, 0xae, 0x20, 0x8c, 0x23, 0x17, 0x8c, 0x77, 0x05, 0x61,
+	0x2c, 0x0a, 0x0d, 0x68, 0x58, 0xf6, 0x81, 0x1f, 0x1c, 0x79, 0xc8, 0xe9, 0x21, 0x87, 0x0f, 0x7b,
+	0xd5, 0x4c, 0xc0, 0x84, 0x62, 0xb0, 0x81, 0xef, 0xda, 0x3e, 0xe5, 0xfb, 0x8c, 0xa2, 0x59, 0x13,
+	0x90, 0xbb, 0x3e, 0x65, 0xc5, 0x0e, 0x4f, 0x39, 0xe1, 0xc5, 0x15, 0x51, 0x2c, 0x20, 0xb2, 0x78,
+	0x38, 0x88, 0x6a, 0x57, 0x45, 0xb1, 0x80, 0xb0, 0xe2, 0x8b, 0x50, 0x1b, 0x1d, 0x01, 0xd4, 0x46,
+	0x5b, 0x11, 0x0e, 0x30, 0xfe, 0x4e, 0x83, 0xa6, 0xc8, 0x67, 0x39, 0x07, 0x4a, 0xa7, 0x43, 0x09,
+	0x3d, 0x19, 0x60, 0x39, 0x75, 0xf8, 0x37, 0x9f, 0x35, 0x8f, 0x07, 0x3f, 0x9a, 0x35, 0x93, 0x67,
+	0xcd, 0x21, 0xb4, 0xb6, 0x3d, 0xcb, 0x46, 0xfb, 0x81, 0xe7, 0x20, 0xcc, 0x9d, 0x1c, 0xbd, 0x05,
+	0x45, 0x6a, 0xf5, 0xa4, 0x17, 0xc5, 0x3e, 0xf5, 0xb7, 0xe4, 0x4e, 0x57, 0xd8, 0xe7, 0x17, 0x95,
+	0xee, 0x46, 0x8c, 0x4c, 0x6c, 0xc3, 0xbb, 0x0a, 0x73, 0xfc, 0x7c, 0x52, 0xf8, 0x57, 0x0d, 0x53,
+	0xfe, 0x19, 0x1f, 0x26, 0xda, 0xbd, 0x87, 0x83, 0xe1, 0x40, 0xdf, 0x82, 0xc6, 0x60, 0x04, 0x63,
+	0x93, 0x36, 0xdb, 0xb9, 0x49, 0x33, 0x6d, 0x26, 0xaa, 0x1a, 0xbf, 0x53, 0x86, 0xe6, 0x0e, 0xb2,
+	0xb0, 0xbd, 0x7f, 0x1e, 0x42, 0x4e, 0x4c, 0xe2, 0x0e, 0xf1, 0xa4, 0xfa, 0xb2, 0x4f, 0x76, 0xb0,
+	0x17, 0xeb, 0x50, 0xb7, 0xc7, 0x04, 0xc4, 0x0d, 0x40, 0xc3, 0x6c, 0x0d, 0xd2, 0x82, 0xfb, 0x0c,
+	0x54, 0x1d, 0xe2, 0x75, 0xf9, 0x10, 0x55, 0xf8, 0x10, 0xa9, 0xfb, 0xb7, 0x49, 0x3c, 0x3e, 0x34,
+	0x15, 0x47, 0x7c, 0xe8, 0x9f, 0x80, 0x66, 0x30, 0xa4, 0x83, 0x21, 0xed, 0x0a, 0x55, 0x6a, 0x57,
+	0x39, 0x7b, 0x0d, 0x01, 0xe4, 0x9a, 0x46, 0xf4, 0x77, 0xa1, 0x49, 0xb8, 0x28, 0xc3, 0x2d, 0x48,
+	0x2d, 0xaf, 0xa7, 0xdc, 0x10, 0xf5, 0xc4, 0x1e, 0x84, 0xc5, 0xf3, 0x29, 0xb6, 0x0e, 0x91, 0x17,
+	0x3b, 0x79, 0x04, 0x6e, 0x76, 0x16, 0x04, 0x7c, 0x74, 0xea, 0x78, 0x0b, 0x96, 0x7a, 0x43, 0x0b,
+	0x5b, 0x3e, 0x45, 0x28, 0x86, 0x5d, 0xe7, 0xd8, 0x7a, 0x54, 0x34, 0xaa, 0x70, 0x19, 0xea, 0x31,
+	0xda, 0xed, 0x86, 0x70, 0x05, 0x46, 0x64, 0xd5, 0x67, 0x88, 0xcd, 0x99, 0xce, 0x10, 0xf5, 0x37,
+	0x61, 0x6d, 0x48, 0x50, 0xd7, 0x41, 0x7b, 0xd6, 0xd0, 0xa3, 0xdd, 0x58, 0x79, 0x7b, 0x9e, 0x1b,
+	0xf3, 0x95, 0x21, 0x41, 0x9b, 0xa2, 0x34, 0x46, 0xce, 0xf8, 0x87, 0x12, 0x2c, 0xdd, 0x3f, 0xde,
+	0xc5, 0xae, 0x73, 0x8e, 0x54, 0xf4, 0x73, 0x50, 0xc5, 0x82, 0xcf, 0x70, 0x0f, 0x6a, 0xa8, 0x03,
+	0x5e, 0xf1, 0x2e, 0x99, 0x51, 0x9d, 0x71, 0x55, 0x9b, 0x53, 0xa8, 0xda, 0x06, 0xd4, 0xb1, 0xe5,
+	0x1f, 0x84, 0x8a, 0x56, 0xc9, 0xab, 0x68, 0xc0, 0x6a, 0x4d, 0x50, 0xb3, 0xea, 0x89, 0xd4, 0xac,
+	0x96, 0xa9, 0x66, 0x4a, 0x2d, 0x82, 0x67, 0xa6, 0x45, 0xf5, 0x49, 0x5a, 0xf4, 0x1e, 0x94, 0xee,
+	0xbb, 0x94, 0xdb, 0x8e, 0xad, 0x4d, 0x61, 0x2c, 0x8b, 0xc2, 0x2b, 0x79, 0x0e, 0xaa, 0x38, 0x38,
+	0x12, 0x2b, 0x49, 0x81, 0x5b, 0xdd, 0x0a, 0x0e, 0x8e, 0xf8, 0x32, 0xc1, 0x53, 0x94, 0x02, 0x2c,
+	0xcd, 0x71, 0xc1, 0x94, 0x7f, 0xc6, 0x2f, 0x6a, 0x23, 0x7b, 0xc9, 0x5c, 0x27, 0xf2, 0x74, 0xbe,
+	0xd3, 0x3b, 0x50, 0xc1, 0xa2, 0xfe, 0xc4, 0xe4, 0x8a, 0x78, 0x4b, 0x7c, 0x25, 0x0b, 0x6b, 0xb1,
+	0x3c, 0xba, 0xc6, 0xbb, 0xde, 0x90, 0x3c, 0x8b, 0x39, 0xa1, 0x3a, 0x4f, 0x2c, 0xaa, 0xcf, 0x32,
+	0x7f, 0xad, 0x00, 0x4d, 0xc9, 0xc6, 0x2c, 0xfb, 0x9a, 0x4c, 0x56, 0x76, 0xa0, 0xce, 0x9a, 0xec,
+	0x12, 0xd4, 0x0b, 0xa3, 0xad, 0xf5, 0xf5, 0x75, 0xe5, 0x7c, 0x4a, 0xb0, 0xc1, 0xd3, 0x52, 0x76,
+	0x78, 0xa5, 0x2f, 0xf8, 0x14, 0x1f, 0x9b, 0x60, 0x47, 0x80, 0xce, 0x87, 0xb0, 0x90, 0x2a, 0x66,
+	0xba, 0x71, 0x80, 0x8e, 0xc3, 0x95, 0xfc, 0x00, 0x1d, 0xeb, 0xaf, 0xc7, 0x93, 0x87, 0xb2, 0x5c,
+	0x8c, 0x07, 0x81, 0xdf, 0xbb, 0x83, 0xb1, 0x75, 0x2c, 0x93, 0x8b, 0xde, 0x2e, 0xbc, 0xa5, 0x19,
+	0xbf, 0x5b, 0x82, 0xc6, 0x97, 0x86, 0x08, 0x1f, 0x9f, 0xa6, 0xb9, 0x0a, 0x1d, 0xbd, 0xd2, 0xc8,
+	0xd1, 0x1b, 0xb7, 0x2c, 0x65, 0x85, 0x65, 0x51, 0xd8, 0xb9, 0x39, 0xa5, 0x9d, 0x53, 0x99, 0x8f,
+	0xca, 0x89, 0xcc, 0x47, 0x35, 0xef, 0x2a, 0x55, 0xcb, 0xb7, 0x4a, 0x9d, 0x8e, 0x7d, 0x61, 0x69,
+	0x66, 0x9e, 0xdb, 0x77, 0x29, 0x5f, 0x4c, 0x8b, 0xa6, 0xf8, 0x61, 0x06, 0x24, 0xd8, 0xdb, 0x23,
+	0x88, 0xf2, 0xc5, 0xb3, 0x68, 0xca, 0x3f, 0x3e, 0x71, 0xa5, 0x76, 0xcc, 0x64, 0x3f, 0x12, 0x6e,
+	0x70, 0xe1, 0xa4, 0x6e, 0xb0, 0xf1, 0xaf, 0x05, 0x58, 0xe6, 0x6c, 0x6c, 0x51, 0x84, 0x2d, 0x1a,
+	0xe0, 0x1f, 0x29, 0xeb, 0x28, 0x91, 0x6b, 0xd7, 0xa2, 0xf6, 0x7e, 0x97, 0xb8, 0x1f, 0xa1, 0x70,
+	0x33, 0xc8, 0x21, 0x3b, 0xee, 0x47, 0xdc, 0x4f, 0xb7, 0x87, 0x98, 0x04, 0x58, 0x6a, 0xa5, 0xfc,
+	0x63, 0x91, 0x9e, 0xb4, 0x40, 0x4f, 0x71, 0x7c, 0x63, 0x6c, 0x16, 0x13, 0x6c, 0xfe, 0xb3, 0x06,
+	0xb5, 0x2f, 0x23, 0x9b, 0x06, 0x98, 0x2d, 0x80, 0x8a, 0x11, 0xd2, 0x72, 0xc4, 0x65, 0x0a, 0xe9,
+	0xb8, 0xcc, 0x6d, 0xa8, 0xba, 0x4e, 0xd7, 0x62, 0x96, 0xb0, 0x5d, 0x9c, 0x12, 0x0f, 0xa8, 0xb8,
+	0x0e, 0x37, 0x99, 0xf9, 0x3d, 0xaa, 0x98, 0x82, 0x95, 0x13, 0x57, 0x8c, 0x7e, 0x53, 0x83, 0x86,
+	0xe8, 0x0c, 0x11, 0x24, 0x3f, 0x1b, 0xe3, 0x43, 0x53, 0xd9, 0x6d, 0xf9, 0x13, 0x49, 0xe0, 0xfe,
+	0x85, 0x11, 0x3f, 0x77, 0x00, 0x98, 0xb0, 0x65, 0xf5, 0xc2, 0x84, 0x3b, 0x5b, 0xa2, 0x3a, 0x17,
+	0xfc, 0xfd, 0x0b, 0x66, 0x8d, 0xd5, 0xe2, 0x24, 0x36, 0x2a, 0x50, 0xe6, 0xb5, 0x8d, 0xff, 0xd7,
+	0x60, 0xe9, 0xae, 0xe5, 0xd9, 0x9b, 0x2e, 0xa1, 0x96, 0x6f, 0xcf, 0x10, 0x1a, 0x78, 0x1b, 0x2a,
+	0xc1, 0xa0, 0xeb, 0xa1, 0x3d, 0x2a, 0x59, 0xba, 0x3a, 0xa1, 0x47, 0x42, 0x0c, 0xe6, 0x5c, 0x30,
+	0x78, 0x80, 0xf6, 0x28, 0xcb, 0x1a, 0x0f, 0x06, 0x5d, 0xec, 0xf6, 0xf6, 0x69, 0xbb, 0x98, 0xb7,
+	0x72, 0x25, 0x18, 0x98, 0xac, 0x46, 0x2c, 0xe2, 0x5f, 0x3a, 0x61, 0xc4, 0xdf, 0xf8, 0x8f, 0xb1,
+	0xee, 0xcf, 0x30, 0x17, 0xde, 0x86, 0xaa, 0xeb, 0xd3, 0xae, 0xe3, 0x92, 0x50, 0x04, 0x97, 0xd4,
+	0xca, 0xe5, 0x53, 0xde, 0x03, 0x3e, 0xa6, 0x3e, 0x65, 0x6d, 0xeb, 0x9f, 0x07, 0xd8, 0xf3, 0x02,
+	0x4b, 0xd6, 0x16, 0x32, 0xb8, 0xac, 0x9e, 0x46, 0x0c, 0x2d, 0xac, 0x5f, 0xe3, 0x95, 0x18, 0x85,
+	0xd1, 0x90, 0xfe, 0x9b, 0x06, 0x2b, 0xdb, 0x08, 0x0b, 0xb3, 0x4f, 0xe5, 0xe9, 0xdb, 0x96, 0xbf,
+	0x17, 0x24, 0x8f, 0x39, 0xb5, 0xd4, 0x31, 0xe7, 0xc7, 0x73, 0xe8, 0x97, 0x88, 0x4c, 0x88, 0xb3,
+	0xf8, 0x30, 0x32, 0x11, 0x66, 0x1c, 0x88, 0xc9, 0x31, 0x9f, 0x31, 0x4c, 0x92, 0xdf, 0x78, 0x58,
+	0xd8, 0xf8, 0x75, 0x91, 0xfd, 0xa7, 0xec, 0xd4, 0xd3, 0x2b, 0xec, 0x2a, 0xc8, 0xe9, 0x99, 0x5a,
+	0x0d, 0x3e, 0x09, 0x29, 0xa3, 0x92, 0x91, 0x93, 0xf8, 0xdb, 0x1a, 0x5c, 0xc9, 0xe6, 0x6a, 0x16,
+	0x2f, 0xf3, 0xf3, 0x50, 0x76, 0xfd, 0xbd, 0x20, 0x3c, 0x0c, 0xba, 0xa1, 0x8e, 0x97, 0x28, 0xdb,
+	0x15, 0x15, 0x8d, 0xff, 0xd1, 0xa0, 0xc5, 0x8d, 0xfc, 0x29, 0x0c, 0x7f, 0x1f, 0xf5, 0xc5, 0x92,
+	0x24, 0x87, 0xbf, 0x8f, 0xfa, 0x7c, 0x41, 0x8a, 0x6b, 0x46, 0x39, 0xa9, 0x19, 0xc9, 0x70, 0xf9,
+	0xdc, 0x84, 0xc3, 0xbe, 0x4a, 0xe2, 0xb0, 0x8f, 0x25, 0xc7, 0x74, 0xee, 0x21, 0x9a, 0xee, 0xea,
+	0xe9, 0x29, 0xc5, 0xb7, 0x35, 0x78, 0x5e, 0xc9, 0xd0, 0x2c, 0xfa, 0xf0, 0xd9, 0xa4, 0x3e, 0xa8,
+	0xe3, 0x67, 0x63, 0x4d, 0x4a, 0x55, 0x78, 0x0d, 0x1a, 0x9b, 0xc3, 0x7e, 0x3f, 0x72, 0xf2, 0xaf,
+	0x42, 0x43, 0x6e, 0xe1, 0x45, 0x78, 0x49, 0xac, 0xa3, 0x75, 0x09, 0x63, 0x41, 0x24, 0xe3, 0x26,
+	0x34, 0x65, 0x15, 0xc9, 0x75, 0x87, 0x85, 0x0a, 0xc4, 0x77, 0x74, 0x0d, 0x4c, 0xfe, 0x1b, 0x2b,
+	0xb0, 0x64, 0xa2, 0x1e, 0xd3, 0x44, 0xfc, 0xc0, 0xf5, 0x0f, 0x64, 0x33, 0xec, 0x16, 0xd7, 0x72,
+	0x12, 0x2e, 0x69, 0xbd, 0x09, 0x15, 0xcb, 0x71, 0x30, 0x22, 0x64, 0xe2, 0xb0, 0xdc, 0x11, 0x38,
+	0x66, 0x88, 0x1c, 0x93, 0x5c, 0x21, 0xb7, 0xe4, 0x8c, 0x2e, 0x2c, 0xde, 0x43, 0xf4, 0x21, 0xa2,
+	0x78, 0xa6, 0x64, 0xaf, 0x36, 0xdb, 0x05, 0xf3, 0xca, 0x52, 0x2d, 0xc2, 0x5f, 0x96, 0xaa, 0xa2,
+	0xc7, 0x5b, 0x98, 0x65, 0x98, 0xe3, 0x52, 0x2e, 0x24, 0xa5, 0x2c, 0xf2, 0x61, 0xfb, 0x83, 0xc0,
+	0x47, 0x7e, 0xe2, 0x5a, 0x5d, 0x33, 0x82, 0x72, 0xf5, 0xfb, 0x10, 0xd6, 0x1e, 0x5a, 0x3e, 0xbb,
+	0x89, 0x10, 0xf4, 0x07, 0x56, 0x22, 0x9b, 0x3c, 0x3d, 0xbf, 0x35, 0xc5, 0xfc, 0x7e, 0x41, 0x24,
+	0x33, 0x09, 0x4f, 0x93, 0xf3, 0x50, 0x32, 0x63, 0x10, 0x83, 0x40, 0x7b, 0x9c, 0xfc, 0x2c, 0x5d,
+	0xe6, 0x4c, 0x85, 0xa4, 0xe2, 0x46, 0x67, 0x04, 0x33, 0xde, 0x81, 0xe7, 0x78, 0xea, 0x77, 0x08,
+	0x4a, 0x9c, 0xb4, 0xa6, 0x09, 0x68, 0x0a, 0x02, 0xdf, 0x2c, 0x40, 0x47, 0x45, 0x61, 0x16, 0xc6,
+	0xdf, 0x4e, 0x1e, 0x70, 0xbe, 0x98, 0xb1, 0x97, 0x4b, 0xb6, 0x28, 0xaa, 0xe8, 0xd7, 0x61, 0x01,
+	0x3d, 0x41, 0xf6, 0x90, 0xba, 0x7e, 0x6f, 0xdb, 0xb3, 0xfc, 0x47, 0x81, 0xb4, 0xa4, 0x69, 0xb0,
+	0xfe, 0x22, 0x34, 0x99, 0xf4, 0x83, 0x21, 0x95, 0x78, 0xc2, 0xa4, 0x26, 0x81, 0x8c, 0x1e, 0xeb,
+	0xaf, 0x87, 0x28, 0x72, 0x24, 0x9e, 0xb0, 0xaf, 0x69, 0xb0, 0xf1, 0xf7, 0x1a, 0x2c, 0x6c, 0x0c,
+	0xbd, 0x03, 0x96, 0x7d, 0x7a, 0x0e, 0xce, 0x50, 0x96, 0xd9, 0xbb, 0x04, 0x5e, 0x94, 0xad, 0x27,
+	0x7e, 0x8c, 0x2e, 0xb4, 0x46, 0x7d, 0x98, 0x65, 0x0c, 0x57, 0x61, 0x8e, 0x5a, 0xe4, 0x20, 0x52,
+	0x3b, 0xf9, 0x67, 0x58, 0xe2, 0x28, 0xbc, 0x3f, 0x08, 0x30, 0x9d, 0xf1, 0x58, 0x3f, 0xab, 0x89,
+	0xff, 0xd5, 0x60, 0x35, 0xdd, 0xc6, 0x2c, 0x5d, 0x79, 0x33, 0xa9, 0x8e, 0xea, 0x1b, 0x39, 0xf1,
+	0xd6, 0xa4, 0x2a, 0xf2, 0x7b, 0xa1, 0x47, 0x5d, 0x3b, 0x18, 0xfa, 0x54, 0x2a, 0x21, 0x8b, 0x34,
+	0xde, 0x65, 0xff, 0xa9, 0x94, 0xab, 0x52, 0x3a, 0xe5, 0x8a, 0xed, 0x7c, 0xd9, 0xd1, 0x3c, 0xcb,
+	0x9f, 0x10, 0xe7, 0xf5, 0x62, 0xd3, 0xd3, 0x10, 0x40, 0x79, 0x62, 0xff, 0x7d, 0x76, 0xb9, 0x34,
+	0xb0, 0x9c, 0x0d, 0xcb, 0x9b, 0x6d, 0x7f, 0xc1, 0x4e, 0x66, 0xb1, 0xdd, 0xf5, 0x03, 0x07, 0x45,
+	0xe2, 0xac, 0x11, 0x6c, 0x3f, 0xe2, 0x00, 0x16, 0x89, 0x71, 0x08, 0x95, 0xc5, 0x61, 0xba, 0x23,
+	0x38, 0x84, 0x8a, 0x72, 0x7e, 0xb1, 0x8a, 0x20, 0x8b, 0x71, 0x3b, 0xd6, 0xa9, 0x96, 0x28, 0xd8,
+	0x89, 0xe0, 0x37, 0xae, 0x42, 0x35, 0xcc, 0xf3, 0xd4, 0x2b, 0x50, 0xbc, 0xe3, 0x79, 0xad, 0x0b,
+	0x7a, 0x03, 0xaa, 0x5b, 0x32, 0x5b, 0xb1, 0xa5, 0xdd, 0xf8, 0x1c, 0x2c, 0xa4, 0x0e, 0xc8, 0xf4,
+	0x2a, 0x94, 0x1e, 0x05, 0x3e, 0x6a, 0x5d, 0xd0, 0x5b, 0xd0, 0xd8, 0x70, 0x7d, 0x0b, 0x1f, 0x8b,
+	0x1d, 0x4b, 0xcb, 0xd1, 0x17, 0xa0, 0xce, 0x3d, 0x77, 0x09, 0x40, 0xeb, 0x7f, 0x76, 0x13, 0x9a,
+	0x0f, 0x79, 0xaf, 0x77, 0x10, 0x3e, 0x74, 0x6d, 0xa4, 0x77, 0xa1, 0x95, 0xbe, 0xfc, 0xaa, 0x7f,
+	0x4a, 0xb9, 0xd6, 0x67, 0xdc, 0x91, 0xed, 0x4c, 0xd2, 0x15, 0xe3, 0x82, 0xfe, 0x15, 0x98, 0x4f,
+	0x5e, 0x21, 0xd5, 0xd5, 0xae, 0xa5, 0xf2, 0x9e, 0xe9, 0x34, 0xe2, 0x5d, 0x68, 0x26, 0x6e, 0x84,
+	0xea, 0x2f, 0x2b, 0x69, 0xab, 0x6e, 0x8d, 0x76, 0xd4, 0xbb, 0xbd, 0xf8, 0xad, 0x4d, 0xc1, 0x7d,
+	0xf2, 0x7e, 0x57, 0x06, 0xf7, 0xca, 0x4b, 0x60, 0xd3, 0xb8, 0xb7, 0x60, 0x71, 0xec, 0x1e, 0x96,
+	0xfe, 0x8a, 0x92, 0x7e, 0xd6, 0x7d, 0xad, 0x69, 0x4d, 0x1c, 0x81, 0x3e, 0x7e, 0xf3, 0x51, 0x7f,
+	0x55, 0x3d, 0x02, 0x59, 0xf7, 0x3e, 0x3b, 0xb7, 0x72, 0xe3, 0x47, 0x82, 0xfb, 0x86, 0x06, 0x6b,
+	0x19, 0x97, 0xa7, 0xf4, 0xdb, 0xea, 0xdb, 0xd9, 0x13, 0x6f, 0x80, 0x75, 0x5e, 0x3f, 0x59, 0xa5,
+	0x88, 0x11, 0x1f, 0x16, 0x52, 0xf7, 0x89, 0xf4, 0x9b, 0x99, 0x39, 0xd6, 0xe3, 0x17, 0xab, 0x3a,
+	0x9f, 0xca, 0x87, 0x1c, 0xb5, 0xc7, 0xe2, 0xe7, 0xc9, 0x4b, 0x38, 0x19, 0xed, 0xa9, 0xaf, 0xea,
+	0x4c, 0x1b, 0xd0, 0x0f, 0xa0, 0x99, 0xb8, 0x2d, 0x93, 0xa1, 0xf1, 0xaa, 0x1b, 0x35, 0xd3, 0x48,
+	0x7f, 0x08, 0x8d, 0xf8, 0xa5, 0x16, 0xfd, 0x7a, 0xd6, 0x5c, 0x1a, 0x23, 0x7c, 0x92, 0xa9, 0x14,
+	0x55, 0x26, 0x13, 0xa6, 0xd2, 0x58, 0x9a, 0x7f, 0xfe, 0xa9, 0x14, 0xa3, 0x3f, 0x71, 0x2a, 0x9d,
+	0xb8, 0x89, 0xaf, 0x8b, 0xe5, 0x53, 0x71, 0x27, 0x42, 0x5f, 0xcf, 0xd2, 0xcd, 0xec, 0xdb, 0x1f,
+	0x9d, 0xdb, 0x27, 0xaa, 0x13, 0x49, 0xf1, 0x00, 0xe6, 0x93, 0xa9, 0xfd, 0x19, 0x52, 0x54, 0x5e,
+	0x96, 0xe8, 0xdc, 0xcc, 0x85, 0x1b, 0x35, 0xf6, 0x18, 0xea, 0xb1, 0x07, 0xbe, 0xf4, 0x97, 0x26,
+	0xe8, 0x71, 0xfc, 0xb5, 0xab, 0x69, 0x92, 0xfc, 0x12, 0xd4, 0xa2, 0x77, 0xb9, 0xf4, 0x6b, 0x99,
+	0xfa, 0x7b, 0x12, 0x92, 0x3b, 0x00, 0xa3, 0x47, 0xb7, 0xf4, 0x4f, 0x2a, 0x69, 0x8e, 0xbd, 0xca,
+	0x35, 0x8d, 0x68, 0xd4, 0x7d, 0x91, 0x6a, 0x35, 0xa9, 0xfb, 0xf1, 0xdc, 0xc0, 0x69, 0x64, 0xf7,
+	0xa1, 0x19, 0x9a, 0x4e, 0x41, 0xf8, 0xe5, 0x89, 0xe6, 0x35, 0x41, 0xfa, 0x46, 0x1e, 0xd4, 0x68,
+	0xfc, 0xf6, 0xa1, 0x99, 0xc8, 0xaf, 0xcc, 0x68, 0x49, 0x95, 0x4e, 0xda, 0xb9, 0x91, 0x07, 0x35,
+	0x6a, 0xe9, 0x67, 0x63, 0xa9, 0x9c, 0x89, 0x74, 0x59, 0xfd, 0xb5, 0x89, 0x74, 0x54, 0xd9, 0xc2,
+	0x9d, 0xf5, 0x93, 0x54, 0x89, 0x58, 0x90, 0x5a, 0x25, 0x44, 0x9a, 0xad, 0x55, 0x27, 0x19, 0xa9,
+	0x1d, 0x98, 0x13, 0x19, 0x93, 0xba, 0x91, 0x91, 0x1b, 0x1d, 0x4b, 0x0c, 0xeb, 0x7c, 0x42, 0x89,
+	0x93, 0x4c, 0x26, 0x14, 0x44, 0x45, 0x46, 0x5c, 0x06, 0xd1, 0x44, 0xba, 0xdc, 0x09, 0x88, 0x8a,
+	0x2c, 0xb5, 0x0c, 0xa2, 0x89, 0x14, 0xb6, 0xbc, 0x44, 0x4d, 0x98, 0x13, 0x67, 0xec, 0x7a, 0x8e,
+	0x24, 0x8d, 0xce, 0x64, 0x1c, 0x71, 0x30, 0x7f, 0x41, 0xff, 0x69, 0x68, 0xc4, 0x93, 0x56, 0xb2,
+	0x16, 0x99, 0xf1, 0xbc, 0x96, 0x9c, 0xf4, 0xb7, 0xa1, 0xcc, 0xcf, 0xba, 0xf5, 0xab, 0x93, 0xce,
+	0xc1, 0x27, 0x51, 0x4c, 0x1c, 0x95, 0x1b, 0x17, 0xf4, 0x2f, 0x42, 0x99, 0x87, 0xb9, 0x32, 0x28,
+	0xc6, 0x0f, 0xb3, 0x3b, 0x13, 0x51, 0x42, 0x16, 0x7b, 0xd0, 0x4c, 0x9c, 0x85, 0x65, 0xcc, 0x4a,
+	0xd5, 0x01, 0x64, 0x27, 0x17, 0x6a, 0xd8, 0x90, 0x03, 0x8d, 0xf8, 0x39, 0x43, 0x86, 0xac, 0x15,
+	0x27, 0x31, 0x9d, 0x3c, 0x98, 0x61, 0x2b, 0xbf, 0xa4, 0x41, 0x3b, 0x2b, 0x24, 0xad, 0x67, 0x7a,
+	0x6d, 0x93, 0xe2, 0xea, 0x9d, 0x37, 0x4e, 0x58, 0x2b, 0x1a, 0xab, 0x8f, 0x60, 0x49, 0x11, 0x08,
+	0xd5, 0x6f, 0x65, 0xd1, 0xcb, 0x88, 0xe1, 0x76, 0x3e, 0x9d, 0xbf, 0x42, 0xd4, 0xf6, 0x36, 0x94,
+	0x79, 0x00, 0x33, 0x43, 0x4f, 0xe2, 0xf1, 0xd0, 0x8e, 0x31, 0x09, 0x25, 0xa2, 0x88, 0xa0, 0x11,
+	0x8f, 0x66, 0x66, 0x8c, 0x9f, 0x22, 0x10, 0xda, 0x79, 0x39, 0x07, 0x66, 0xd4, 0x4c, 0x17, 0x60,
+	0x14, 0x4d, 0xcc, 0x58, 0x3b, 0xc7, 0x02, 0x9a, 0x9d, 0x97, 0xa6, 0xe2, 0x45, 0x0d, 0x7c, 0x0d,
+	0x5a, 0xe9, 0x08, 0x5e, 0xc6, 0x1e, 0x33, 0x23, 0x8e, 0xd8, 0x79, 0x25, 0x27, 0x76, 0xd4, 0xe4,
+	0x11, 0x8f, 0x90, 0xa6, 0x62, 0x61, 0x19, 0xfb, 0x9e, 0xcc, 0x40, 0x5f, 0xe7, 0x56, 0x6e, 0xfc,
+	0xa8, 0xe1, 0x0f, 0xa0, 0x1a, 0x06, 0x8a, 0x74, 0x75, 0x86, 0x6b, 0x2a, 0x16, 0xd6, 0xb9, 0x36,
+	0x05, 0x2b, 0xee, 0xfa, 0x25, 0xc3, 0x37, 0x7a, 0xf6, 0x1a, 0x3d, 0x16, 0x47, 0xea, 0xdc, 0xcc,
+	0x85, 0x1b, 0x77, 0xfd, 0x62, 0x11, 0x94, 0x0c, 0xdf, 0x67, 0x3c, 0xc6, 0x92, 0x23, 0x1a, 0x90,
+	0x7c, 0xbd, 0x33, 0xa3, 0x0f, 0xca, 0x27, 0x3e, 0xa7, 0x11, 0xff, 0x49, 0x68, 0xc4, 0x9f, 0xed,
+	0xcc, 0x98, 0x2f, 0x8a, 0x97, 0x3d, 0x73, 0x78, 0x6c, 0x89, 0x27, 0x36, 0x33, 0x2c, 0xb6, 0xea,
+	0x45, 0xcf, 0xce, 0x8d, 0x3c, 0xa8, 0xb1, 0xb9, 0xd8, 0x4a, 0xbf, 0x99, 0x39, 0x39, 0x1c, 0x93,
+	0x7e, 0x25, 0x72, 0x7a, 0xc4, 0xa4, 0x95, 0x7e, 0x0e, 0x33, 0xa3, 0x81, 0x8c, 0x57, 0x33, 0x73,
+	0x34, 0x90, 0x7e, 0xc0, 0x32, 0xa3, 0x81, 0x8c, 0x77, 0x2e, 0x73, 0x0e, 0x46, 0xf4, 0xdc, 0xe4,
+	0x84, 0xc1, 0x48, 0x3f, 0x6e, 0xd9, 0xb9, 0x91, 0x07, 0x35, 0x1a, 0x8c, 0x1d, 0x80, 0xd1, 0x63,
+	0x93, 0x19, 0x86, 0x71, 0xec, 0x35, 0xca, 0x69, 0xec, 0x7f, 0x11, 0xaa, 0xe1, 0xeb, 0x92, 0x19,
+	0x06, 0x22, 0xf5, 0xf8, 0x64, 0x8e, 0x88, 0x40, 0xe2, 0x2d, 0xc9, 0x0c, 0x79, 0xa8, 0xde, 0x9b,
+	0x9c, 0x46, 0xda, 0x06, 0x7d, 0xfc, 0x89, 0xc8, 0x0c, 0x2b, 0x9a, 0xf9, 0x96, 0x64, 0x0e, 0x93,
+	0x90, 0x7c, 0x79, 0x31, 0xcb, 0xac, 0xa9, 0x9e, 0x67, 0x9c, 0x1e, 0xd3, 0x58, 0x48, 0x3d, 0xa8,
+	0x98, 0x11, 0x8d, 0x51, 0x3f, 0xbb, 0x38, 0x5d, 0xd9, 0x61, 0xf4, 0x88, 0x61, 0x86, 0x86, 0x8c,
+	0x3d, 0xa5, 0xd8, 0x79, 0x69, 0x2a, 0x5e, 0xa8, 0x82, 0xeb, 0x43, 0x68, 0x6c, 0xe3, 0xe0, 0xc9,
+	0x71, 0x18, 0xae, 0xfd, 0xe1, 0xb8, 0x04, 0x1b, 0x6f, 0xfc, 0xd4, 0xed, 0x9e, 0x4b, 0xf7, 0x87,
+	0xbb, 0xac, 0xc7, 0xb7, 0x04, 0xee, 0x2b, 0x6e, 0x20, 0xbf, 0x6e, 0xb9, 0x3e, 0x45, 0xd8, 0xb7,
+	0xbc, 0x5b, 0x9c, 0x96, 0x84, 0x0e, 0x76, 0x77, 0xe7, 0xf8, 0xff, 0xed, 0x1f, 0x0c, 0x00, 0xe1,
+	0xd0, 0x9c, 0x20, 0x59, 0x5c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MilvusServiceClient is the client API for MilvusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MilvusServiceClient interface {
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
+	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error)
+	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
+	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
+	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
+	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
+	HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
+	QueryIterator(ctx context.Context, in *QueryIteratorRequest, opts ...grpc.CallOption) (*QueryIteratorResults, error)
+	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
+	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
+	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
+	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
+	// TODO: remove
+	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
+	ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error)
+	GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error)
+	BulkLoad(ctx context.Context, in *BulkLoadRequest, opts ...grpc.CallOption) (*BulkLoadResponse, error)
+	GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error)
+	LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddUserToRole(ctx context.Context, in *AddUserToRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RemoveUserFromRole(ctx context.Context, in *RemoveUserFromRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GrantPrivilege(ctx context.Context, in *GrantPrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RevokePrivilege(ctx context.Context, in *RevokePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListGrants(ctx context.Context, in *ListGrantsRequest, opts ...grpc.CallOption) (*ListGrantsResponse, error)
+}
+
+type milvusServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMilvusServiceClient(cc *grpc.ClientConn) MilvusServiceClient {
+	return &milvusServiceClient{cc}
+}
+
+func (c *milvusServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropCollection(ctx context.Context, in *DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) HasCollection(ctx context.Context, in *HasCollectionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) LoadCollection(ctx context.Context, in *LoadCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ReleaseCollection(ctx context.Context, in *ReleaseCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReleaseCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error) {
+	out := new(DescribeCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DescribeCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
+	out := new(GetCollectionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCollectionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error) {
+	out := new(ShowCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ShowCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ReleasePartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
+	out := new(GetPartitionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPartitionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error) {
+	out := new(ShowPartitionsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ShowPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error) {
+	out := new(DescribeIndexResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DescribeIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error) {
+	out := new(GetIndexStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetIndexState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error) {
+	out := new(GetIndexBuildProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetIndexBuildProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Insert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HybridSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error) {
+	out := new(QueryResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) QueryIterator(ctx context.Context, in *QueryIteratorRequest, opts ...grpc.CallOption) (*QueryIteratorResults, error) {
+	out := new(QueryIteratorResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/QueryIterator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error) {
+	out := new(CalcDistanceResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CalcDistance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error) {
+	out := new(GetPersistentSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error) {
+	out := new(GetQuerySegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetQuerySegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
+	out := new(DummyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Dummy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error) {
+	out := new(RegisterLinkResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RegisterLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
+	out := new(GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error) {
+	out := new(ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ManualCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error) {
+	out := new(GetCompactionStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) BulkLoad(ctx context.Context, in *BulkLoadRequest, opts ...grpc.CallOption) (*BulkLoadResponse, error) {
+	out := new(BulkLoadResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/BulkLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error) {
+	out := new(GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/LoadBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/UpdateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DeleteCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error) {
+	out := new(ListCredUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListCredUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AddUserToRole(ctx context.Context, in *AddUserToRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AddUserToRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RemoveUserFromRole(ctx context.Context, in *RemoveUserFromRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RemoveUserFromRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GrantPrivilege(ctx context.Context, in *GrantPrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GrantPrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RevokePrivilege(ctx context.Context, in *RevokePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RevokePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListGrants(ctx context.Context, in *ListGrantsRequest, opts ...grpc.CallOption) (*ListGrantsResponse, error) {
+	out := new(ListGrantsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MilvusServiceServer is the server API for MilvusService service.
 type MilvusServiceServer interface {
 	CreateCollection(context.Context, *CreateCollectionRequest) (*commonpb.Status, error)
 	DropCollection(context.Context, *DropCollectionRequest) (*commonpb.Status, error)
@@ -4961,9 +7267,12 @@ type MilvusServiceServer interface {
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
 	Delete(context.Context, *DeleteRequest) (*MutationResult, error)
+	Upsert(context.Context, *UpsertRequest) (*MutationResult, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
+	HybridSearch(context.Context, *HybridSearchRequest) (*SearchResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
+	QueryIterator(context.Context, *QueryIteratorRequest) (*QueryIteratorResults, error)
 	CalcDistance(context.Context, *CalcDistanceRequest) (*CalcDistanceResults, error)
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(context.Context, *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error)
@@ -4972,6 +7281,25 @@ type MilvusServiceServer interface {
 	RegisterLink(context.Context, *RegisterLinkRequest) (*RegisterLinkResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error)
+	ManualCompaction(context.Context, *ManualCompactionRequest) (*ManualCompactionResponse, error)
+	GetCompactionState(context.Context, *GetCompactionStateRequest) (*GetCompactionStateResponse, error)
+	BulkLoad(context.Context, *BulkLoadRequest) (*BulkLoadResponse, error)
+	GetImportState(context.Context, *GetImportStateRequest) (*GetImportStateResponse, error)
+	LoadBalance(context.Context, *LoadBalanceRequest) (*commonpb.Status, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
+	CreateCredential(context.Context, *CreateCredentialRequest) (*commonpb.Status, error)
+	UpdateCredential(context.Context, *UpdateCredentialRequest) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *DeleteCredentialRequest) (*commonpb.Status, error)
+	ListCredUsers(context.Context, *ListCredUsersRequest) (*ListCredUsersResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *DropRoleRequest) (*commonpb.Status, error)
+	AddUserToRole(context.Context, *AddUserToRoleRequest) (*commonpb.Status, error)
+	RemoveUserFromRole(context.Context, *RemoveUserFromRoleRequest) (*commonpb.Status, error)
+	GrantPrivilege(context.Context, *GrantPrivilegeRequest) (*commonpb.Status, error)
+	RevokePrivilege(context.Context, *RevokePrivilegeRequest) (*commonpb.Status, error)
+	ListGrants(context.Context, *ListGrantsRequest) (*ListGrantsResponse, error)
 }
 
 // UnimplementedMilvusServiceServer can be embedded to have forward compatible implementations.
@@ -5047,652 +7375,1114 @@ func (*UnimplementedMilvusServiceServer) GetIndexBuildProgress(ctx context.Conte
 func (*UnimplementedMilvusServiceServer) DropIndex(ctx context.Context, req *DropIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
 }
-func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *InsertRequest) (*MutationResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *InsertRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Upsert(ctx context.Context, req *UpsertRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedMilvusServiceServer) HybridSearch(ctx context.Context, req *HybridSearchRequest) (*SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HybridSearch not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Query(ctx context.Context, req *QueryRequest) (*QueryResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (*UnimplementedMilvusServiceServer) QueryIterator(ctx context.Context, req *QueryIteratorRequest) (*QueryIteratorResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryIterator not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CalcDistance(ctx context.Context, req *CalcDistanceRequest) (*CalcDistanceResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcDistance not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetPersistentSegmentInfo(ctx context.Context, req *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersistentSegmentInfo not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetQuerySegmentInfo(ctx context.Context, req *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuerySegmentInfo not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Dummy(ctx context.Context, req *DummyRequest) (*DummyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RegisterLink(ctx context.Context, req *RegisterLinkRequest) (*RegisterLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterLink not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetMetrics(ctx context.Context, req *GetMetricsRequest) (*GetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ManualCompaction(ctx context.Context, req *ManualCompactionRequest) (*ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetCompactionState(ctx context.Context, req *GetCompactionStateRequest) (*GetCompactionStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
+}
+func (*UnimplementedMilvusServiceServer) BulkLoad(ctx context.Context, req *BulkLoadRequest) (*BulkLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkLoad not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetImportState(ctx context.Context, req *GetImportStateRequest) (*GetImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportState not implemented")
+}
+func (*UnimplementedMilvusServiceServer) LoadBalance(ctx context.Context, req *LoadBalanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadBalance not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateCredential(ctx context.Context, req *CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) UpdateCredential(ctx context.Context, req *UpdateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DeleteCredential(ctx context.Context, req *DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListCredUsers(ctx context.Context, req *ListCredUsersRequest) (*ListCredUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredUsers not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropRole(ctx context.Context, req *DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) AddUserToRole(ctx context.Context, req *AddUserToRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserToRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RemoveUserFromRole(ctx context.Context, req *RemoveUserFromRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GrantPrivilege(ctx context.Context, req *GrantPrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPrivilege not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RevokePrivilege(ctx context.Context, req *RevokePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePrivilege not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListGrants(ctx context.Context, req *ListGrantsRequest) (*ListGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
+
+func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
+	s.RegisterService(&_MilvusService_serviceDesc, srv)
+}
+
+func _MilvusService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropCollection(ctx, req.(*DropCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_HasCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).HasCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HasCollection(ctx, req.(*HasCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_LoadCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).LoadCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/LoadCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).LoadCollection(ctx, req.(*LoadCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ReleaseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ReleaseCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ReleaseCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ReleaseCollection(ctx, req.(*ReleaseCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DescribeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DescribeCollection(ctx, req.(*DescribeCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCollectionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ShowCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ShowCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ShowCollections(ctx, req.(*ShowCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreatePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreatePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreatePartition(ctx, req.(*CreatePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropPartition(ctx, req.(*DropPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).HasPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HasPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HasPartition(ctx, req.(*HasPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*MutationResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func _MilvusService_LoadPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).LoadPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/LoadPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).LoadPartitions(ctx, req.(*LoadPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResults, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+
+func _MilvusService_ReleasePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ReleasePartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ReleasePartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ReleasePartitions(ctx, req.(*ReleasePartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+
+func _MilvusService_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetPartitionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) Query(ctx context.Context, req *QueryRequest) (*QueryResults, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+
+func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ShowPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ShowPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ShowPartitions(ctx, req.(*ShowPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) CalcDistance(ctx context.Context, req *CalcDistanceRequest) (*CalcDistanceResults, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalcDistance not implemented")
+
+func _MilvusService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateAlias(ctx, req.(*CreateAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) GetPersistentSegmentInfo(ctx context.Context, req *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPersistentSegmentInfo not implemented")
+
+func _MilvusService_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropAlias(ctx, req.(*DropAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) GetQuerySegmentInfo(ctx context.Context, req *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQuerySegmentInfo not implemented")
+
+func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AlterAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AlterAlias(ctx, req.(*AlterAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) Dummy(ctx context.Context, req *DummyRequest) (*DummyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
+
+func _MilvusService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) RegisterLink(ctx context.Context, req *RegisterLinkRequest) (*RegisterLinkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterLink not implemented")
+
+func _MilvusService_DescribeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DescribeIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DescribeIndex(ctx, req.(*DescribeIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) GetMetrics(ctx context.Context, req *GetMetricsRequest) (*GetMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+
+func _MilvusService_GetIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetIndexState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetIndexState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetIndexState(ctx, req.(*GetIndexStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
-	s.RegisterService(&_MilvusService_serviceDesc, srv)
+func _MilvusService_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexBuildProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetIndexBuildProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetIndexBuildProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetIndexBuildProgress(ctx, req.(*GetIndexBuildProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCollectionRequest)
+func _MilvusService_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreateCollection(ctx, in)
+		return srv.(MilvusServiceServer).DropIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+		return srv.(MilvusServiceServer).DropIndex(ctx, req.(*DropIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropCollectionRequest)
+func _MilvusService_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropCollection(ctx, in)
+		return srv.(MilvusServiceServer).Insert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Insert",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropCollection(ctx, req.(*DropCollectionRequest))
+		return srv.(MilvusServiceServer).Insert(ctx, req.(*InsertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_HasCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HasCollectionRequest)
+func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).HasCollection(ctx, in)
+		return srv.(MilvusServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Delete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).HasCollection(ctx, req.(*HasCollectionRequest))
+		return srv.(MilvusServiceServer).Delete(ctx, req.(*DeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_LoadCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadCollectionRequest)
+func _MilvusService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).LoadCollection(ctx, in)
+		return srv.(MilvusServiceServer).Upsert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/LoadCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Upsert",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).LoadCollection(ctx, req.(*LoadCollectionRequest))
+		return srv.(MilvusServiceServer).Upsert(ctx, req.(*UpsertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ReleaseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleaseCollectionRequest)
+func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ReleaseCollection(ctx, in)
+		return srv.(MilvusServiceServer).Search(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ReleaseCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Search",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ReleaseCollection(ctx, req.(*ReleaseCollectionRequest))
+		return srv.(MilvusServiceServer).Search(ctx, req.(*SearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeCollectionRequest)
+func _MilvusService_HybridSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HybridSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DescribeCollection(ctx, in)
+		return srv.(MilvusServiceServer).HybridSearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/HybridSearch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DescribeCollection(ctx, req.(*DescribeCollectionRequest))
+		return srv.(MilvusServiceServer).HybridSearch(ctx, req.(*HybridSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionStatisticsRequest)
+func _MilvusService_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, in)
+		return srv.(MilvusServiceServer).Flush(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetCollectionStatistics",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Flush",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+		return srv.(MilvusServiceServer).Flush(ctx, req.(*FlushRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShowCollectionsRequest)
+func _MilvusService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ShowCollections(ctx, in)
+		return srv.(MilvusServiceServer).Query(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ShowCollections",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Query",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ShowCollections(ctx, req.(*ShowCollectionsRequest))
+		return srv.(MilvusServiceServer).Query(ctx, req.(*QueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePartitionRequest)
+func _MilvusService_QueryIterator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIteratorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreatePartition(ctx, in)
+		return srv.(MilvusServiceServer).QueryIterator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreatePartition",
+		FullMethod: "/milvus.proto.milvus.MilvusService/QueryIterator",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreatePartition(ctx, req.(*CreatePartitionRequest))
+		return srv.(MilvusServiceServer).QueryIterator(ctx, req.(*QueryIteratorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropPartitionRequest)
+func _MilvusService_CalcDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalcDistanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropPartition(ctx, in)
+		return srv.(MilvusServiceServer).CalcDistance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropPartition",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CalcDistance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropPartition(ctx, req.(*DropPartitionRequest))
+		return srv.(MilvusServiceServer).CalcDistance(ctx, req.(*CalcDistanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HasPartitionRequest)
+func _MilvusService_GetPersistentSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersistentSegmentInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).HasPartition(ctx, in)
+		return srv.(MilvusServiceServer).GetPersistentSegmentInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/HasPartition",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).HasPartition(ctx, req.(*HasPartitionRequest))
+		return srv.(MilvusServiceServer).GetPersistentSegmentInfo(ctx, req.(*GetPersistentSegmentInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_LoadPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadPartitionsRequest)
+func _MilvusService_GetQuerySegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuerySegmentInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).LoadPartitions(ctx, in)
+		return srv.(MilvusServiceServer).GetQuerySegmentInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/LoadPartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetQuerySegmentInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).LoadPartitions(ctx, req.(*LoadPartitionsRequest))
+		return srv.(MilvusServiceServer).GetQuerySegmentInfo(ctx, req.(*GetQuerySegmentInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ReleasePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleasePartitionsRequest)
+func _MilvusService_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DummyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ReleasePartitions(ctx, in)
+		return srv.(MilvusServiceServer).Dummy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ReleasePartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/Dummy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ReleasePartitions(ctx, req.(*ReleasePartitionsRequest))
+		return srv.(MilvusServiceServer).Dummy(ctx, req.(*DummyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartitionStatisticsRequest)
+func _MilvusService_RegisterLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, in)
+		return srv.(MilvusServiceServer).RegisterLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetPartitionStatistics",
+		FullMethod: "/milvus.proto.milvus.MilvusService/RegisterLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+		return srv.(MilvusServiceServer).RegisterLink(ctx, req.(*RegisterLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShowPartitionsRequest)
+func _MilvusService_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ShowPartitions(ctx, in)
+		return srv.(MilvusServiceServer).GetMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ShowPartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetMetrics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ShowPartitions(ctx, req.(*ShowPartitionsRequest))
+		return srv.(MilvusServiceServer).GetMetrics(ctx, req.(*GetMetricsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAliasRequest)
+func _MilvusService_ManualCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManualCompactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreateAlias(ctx, in)
+		return srv.(MilvusServiceServer).ManualCompaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreateAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ManualCompaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreateAlias(ctx, req.(*CreateAliasRequest))
+		return srv.(MilvusServiceServer).ManualCompaction(ctx, req.(*ManualCompactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropAliasRequest)
+func _MilvusService_GetCompactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropAlias(ctx, in)
+		return srv.(MilvusServiceServer).GetCompactionState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCompactionState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropAlias(ctx, req.(*DropAliasRequest))
+		return srv.(MilvusServiceServer).GetCompactionState(ctx, req.(*GetCompactionStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AlterAliasRequest)
+func _MilvusService_BulkLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkLoadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).AlterAlias(ctx, in)
+		return srv.(MilvusServiceServer).BulkLoad(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/AlterAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/BulkLoad",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).AlterAlias(ctx, req.(*AlterAliasRequest))
+		return srv.(MilvusServiceServer).BulkLoad(ctx, req.(*BulkLoadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateIndexRequest)
+func _MilvusService_GetImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreateIndex(ctx, in)
+		return srv.(MilvusServiceServer).GetImportState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreateIndex",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetImportState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+		return srv.(MilvusServiceServer).GetImportState(ctx, req.(*GetImportStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DescribeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeIndexRequest)
+func _MilvusService_LoadBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadBalanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DescribeIndex(ctx, in)
+		return srv.(MilvusServiceServer).LoadBalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeIndex",
+		FullMethod: "/milvus.proto.milvus.MilvusService/LoadBalance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DescribeIndex(ctx, req.(*DescribeIndexRequest))
+		return srv.(MilvusServiceServer).LoadBalance(ctx, req.(*LoadBalanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetIndexState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIndexStateRequest)
+func _MilvusService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetIndexState(ctx, in)
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetIndexState",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetIndexState(ctx, req.(*GetIndexStateRequest))
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetIndexBuildProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIndexBuildProgressRequest)
+func _MilvusService_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetIndexBuildProgress(ctx, in)
+		return srv.(MilvusServiceServer).DropDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetIndexBuildProgress",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetIndexBuildProgress(ctx, req.(*GetIndexBuildProgressRequest))
+		return srv.(MilvusServiceServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropIndexRequest)
+func _MilvusService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropIndex(ctx, in)
+		return srv.(MilvusServiceServer).ListDatabases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropIndex",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDatabases",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropIndex(ctx, req.(*DropIndexRequest))
+		return srv.(MilvusServiceServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertRequest)
+func _MilvusService_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Insert(ctx, in)
+		return srv.(MilvusServiceServer).CreateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Insert",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Insert(ctx, req.(*InsertRequest))
+		return srv.(MilvusServiceServer).CreateCredential(ctx, req.(*CreateCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _MilvusService_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Delete(ctx, in)
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Delete",
+		FullMethod: "/milvus.proto.milvus.MilvusService/UpdateCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, req.(*UpdateCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
+func _MilvusService_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Search(ctx, in)
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Search",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DeleteCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Search(ctx, req.(*SearchRequest))
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, req.(*DeleteCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FlushRequest)
+func _MilvusService_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Flush(ctx, in)
+		return srv.(MilvusServiceServer).ListCredUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Flush",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListCredUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Flush(ctx, req.(*FlushRequest))
+		return srv.(MilvusServiceServer).ListCredUsers(ctx, req.(*ListCredUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRequest)
+func _MilvusService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Query(ctx, in)
+		return srv.(MilvusServiceServer).CreateRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Query",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Query(ctx, req.(*QueryRequest))
+		return srv.(MilvusServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CalcDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalcDistanceRequest)
+func _MilvusService_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CalcDistance(ctx, in)
+		return srv.(MilvusServiceServer).DropRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CalcDistance",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CalcDistance(ctx, req.(*CalcDistanceRequest))
+		return srv.(MilvusServiceServer).DropRole(ctx, req.(*DropRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetPersistentSegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPersistentSegmentInfoRequest)
+func _MilvusService_AddUserToRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserToRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetPersistentSegmentInfo(ctx, in)
+		return srv.(MilvusServiceServer).AddUserToRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetPersistentSegmentInfo",
+		FullMethod: "/milvus.proto.milvus.MilvusService/AddUserToRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetPersistentSegmentInfo(ctx, req.(*GetPersistentSegmentInfoRequest))
+		return srv.(MilvusServiceServer).AddUserToRole(ctx, req.(*AddUserToRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetQuerySegmentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetQuerySegmentInfoRequest)
+func _MilvusService_RemoveUserFromRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserFromRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetQuerySegmentInfo(ctx, in)
+		return srv.(MilvusServiceServer).RemoveUserFromRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetQuerySegmentInfo",
+		FullMethod: "/milvus.proto.milvus.MilvusService/RemoveUserFromRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetQuerySegmentInfo(ctx, req.(*GetQuerySegmentInfoRequest))
+		return srv.(MilvusServiceServer).RemoveUserFromRole(ctx, req.(*RemoveUserFromRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DummyRequest)
+func _MilvusService_GrantPrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantPrivilegeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).Dummy(ctx, in)
+		return srv.(MilvusServiceServer).GrantPrivilege(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/Dummy",
+		FullMethod: "/milvus.proto.milvus.MilvusService/GrantPrivilege",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).Dummy(ctx, req.(*DummyRequest))
+		return srv.(MilvusServiceServer).GrantPrivilege(ctx, req.(*GrantPrivilegeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_RegisterLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterLinkRequest)
+func _MilvusService_RevokePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePrivilegeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).RegisterLink(ctx, in)
+		return srv.(MilvusServiceServer).RevokePrivilege(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/RegisterLink",
+		FullMethod: "/milvus.proto.milvus.MilvusService/RevokePrivilege",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).RegisterLink(ctx, req.(*RegisterLinkRequest))
+		return srv.(MilvusServiceServer).RevokePrivilege(ctx, req.(*RevokePrivilegeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetricsRequest)
+func _MilvusService_ListGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGrantsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetMetrics(ctx, in)
+		return srv.(MilvusServiceServer).ListGrants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetMetrics",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListGrants",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetMetrics(ctx, req.(*GetMetricsRequest))
+		return srv.(MilvusServiceServer).ListGrants(ctx, req.(*ListGrantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -5801,10 +8591,18 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _MilvusService_Delete_Handler,
 		},
+		{
+			MethodName: "Upsert",
+			Handler:    _MilvusService_Upsert_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _MilvusService_Search_Handler,
 		},
+		{
+			MethodName: "HybridSearch",
+			Handler:    _MilvusService_HybridSearch_Handler,
+		},
 		{
 			MethodName: "Flush",
 			Handler:    _MilvusService_Flush_Handler,
@@ -5813,6 +8611,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _MilvusService_Query_Handler,
 		},
+		{
+			MethodName: "QueryIterator",
+			Handler:    _MilvusService_QueryIterator_Handler,
+		},
 		{
 			MethodName: "CalcDistance",
 			Handler:    _MilvusService_CalcDistance_Handler,
@@ -5837,6 +8639,82 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _MilvusService_GetMetrics_Handler,
 		},
+		{
+			MethodName: "ManualCompaction",
+			Handler:    _MilvusService_ManualCompaction_Handler,
+		},
+		{
+			MethodName: "GetCompactionState",
+			Handler:    _MilvusService_GetCompactionState_Handler,
+		},
+		{
+			MethodName: "BulkLoad",
+			Handler:    _MilvusService_BulkLoad_Handler,
+		},
+		{
+			MethodName: "GetImportState",
+			Handler:    _MilvusService_GetImportState_Handler,
+		},
+		{
+			MethodName: "LoadBalance",
+			Handler:    _MilvusService_LoadBalance_Handler,
+		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MilvusService_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MilvusService_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MilvusService_ListDatabases_Handler,
+		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _MilvusService_CreateCredential_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _MilvusService_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _MilvusService_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListCredUsers",
+			Handler:    _MilvusService_ListCredUsers_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _MilvusService_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _MilvusService_DropRole_Handler,
+		},
+		{
+			MethodName: "AddUserToRole",
+			Handler:    _MilvusService_AddUserToRole_Handler,
+		},
+		{
+			MethodName: "RemoveUserFromRole",
+			Handler:    _MilvusService_RemoveUserFromRole_Handler,
+		},
+		{
+			MethodName: "GrantPrivilege",
+			Handler:    _MilvusService_GrantPrivilege_Handler,
+		},
+		{
+			MethodName: "RevokePrivilege",
+			Handler:    _MilvusService_RevokePrivilege_Handler,
+		},
+		{
+			MethodName: "ListGrants",
+			Handler:    _MilvusService_ListGrants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milvus.proto",
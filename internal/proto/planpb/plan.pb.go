@@ -61,6 +61,74 @@ func (OpType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{0}
 }
 
+type ArithOpType int32
+
+const (
+	ArithOpType_Unknown ArithOpType = 0
+	ArithOpType_Add     ArithOpType = 1
+	ArithOpType_Sub     ArithOpType = 2
+	ArithOpType_Mul     ArithOpType = 3
+	ArithOpType_Div     ArithOpType = 4
+	ArithOpType_Mod     ArithOpType = 5
+)
+
+var ArithOpType_name = map[int32]string{
+	0: "Unknown",
+	1: "Add",
+	2: "Sub",
+	3: "Mul",
+	4: "Div",
+	5: "Mod",
+}
+
+var ArithOpType_value = map[string]int32{
+	"Unknown": 0,
+	"Add":     1,
+	"Sub":     2,
+	"Mul":     3,
+	"Div":     4,
+	"Mod":     5,
+}
+
+func (x ArithOpType) String() string {
+	return proto.EnumName(ArithOpType_name, int32(x))
+}
+
+func (ArithOpType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{1}
+}
+
+type StringMatchExpr_MatchOp int32
+
+const (
+	StringMatchExpr_Invalid  StringMatchExpr_MatchOp = 0
+	StringMatchExpr_Prefix   StringMatchExpr_MatchOp = 1
+	StringMatchExpr_Suffix   StringMatchExpr_MatchOp = 2
+	StringMatchExpr_Contains StringMatchExpr_MatchOp = 3
+)
+
+var StringMatchExpr_MatchOp_name = map[int32]string{
+	0: "Invalid",
+	1: "Prefix",
+	2: "Suffix",
+	3: "Contains",
+}
+
+var StringMatchExpr_MatchOp_value = map[string]int32{
+	"Invalid":  0,
+	"Prefix":   1,
+	"Suffix":   2,
+	"Contains": 3,
+}
+
+func (x StringMatchExpr_MatchOp) String() string {
+	return proto.EnumName(StringMatchExpr_MatchOp_name, int32(x))
+}
+
+func (StringMatchExpr_MatchOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{8, 0}
+}
+
 type UnaryExpr_UnaryOp int32
 
 const (
@@ -83,7 +151,7 @@ func (x UnaryExpr_UnaryOp) String() string {
 }
 
 func (UnaryExpr_UnaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
 }
 
 type BinaryExpr_BinaryOp int32
@@ -111,7 +179,7 @@ func (x BinaryExpr_BinaryOp) String() string {
 }
 
 func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{11, 0}
 }
 
 type GenericValue struct {
@@ -119,6 +187,7 @@ type GenericValue struct {
 	//	*GenericValue_BoolVal
 	//	*GenericValue_Int64Val
 	//	*GenericValue_FloatVal
+	//	*GenericValue_StringVal
 	Val                  isGenericValue_Val `protobuf_oneof:"val"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -166,12 +235,18 @@ type GenericValue_FloatVal struct {
 	FloatVal float64 `protobuf:"fixed64,3,opt,name=float_val,json=floatVal,proto3,oneof"`
 }
 
+type GenericValue_StringVal struct {
+	StringVal string `protobuf:"bytes,4,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
 func (*GenericValue_BoolVal) isGenericValue_Val() {}
 
 func (*GenericValue_Int64Val) isGenericValue_Val() {}
 
 func (*GenericValue_FloatVal) isGenericValue_Val() {}
 
+func (*GenericValue_StringVal) isGenericValue_Val() {}
+
 func (m *GenericValue) GetVal() isGenericValue_Val {
 	if m != nil {
 		return m.Val
@@ -200,19 +275,80 @@ func (m *GenericValue) GetFloatVal() float64 {
 	return 0
 }
 
+func (m *GenericValue) GetStringVal() string {
+	if x, ok := m.GetVal().(*GenericValue_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GenericValue) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*GenericValue_BoolVal)(nil),
 		(*GenericValue_Int64Val)(nil),
 		(*GenericValue_FloatVal)(nil),
+		(*GenericValue_StringVal)(nil),
 	}
 }
 
+// RangeSearchInfo keeps the hits whose distance is within radius, and not closer than range_filter,
+// topk of a range search is the max results, it fails if more hits are in range
+type RangeSearchInfo struct {
+	Radius               float32  `protobuf:"fixed32,1,opt,name=radius,proto3" json:"radius,omitempty"`
+	RangeFilter          float32  `protobuf:"fixed32,2,opt,name=range_filter,json=rangeFilter,proto3" json:"range_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RangeSearchInfo) Reset()         { *m = RangeSearchInfo{} }
+func (m *RangeSearchInfo) String() string { return proto.CompactTextString(m) }
+func (*RangeSearchInfo) ProtoMessage()    {}
+func (*RangeSearchInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{1}
+}
+
+func (m *RangeSearchInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RangeSearchInfo.Unmarshal(m, b)
+}
+func (m *RangeSearchInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RangeSearchInfo.Marshal(b, m, deterministic)
+}
+func (m *RangeSearchInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeSearchInfo.Merge(m, src)
+}
+func (m *RangeSearchInfo) XXX_Size() int {
+	return xxx_messageInfo_RangeSearchInfo.Size(m)
+}
+func (m *RangeSearchInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeSearchInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeSearchInfo proto.InternalMessageInfo
+
+func (m *RangeSearchInfo) GetRadius() float32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *RangeSearchInfo) GetRangeFilter() float32 {
+	if m != nil {
+		return m.RangeFilter
+	}
+	return 0
+}
+
 type QueryInfo struct {
-	Topk                 int64    `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
-	SearchParams         string   `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	Topk            int64            `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType      string           `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	SearchParams    string           `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	RangeSearchInfo *RangeSearchInfo `protobuf:"bytes,5,opt,name=range_search_info,json=rangeSearchInfo,proto3" json:"range_search_info,omitempty"`
+	// group the hits by the int64 field if it is set, and keep at most group_size hits of each group
+	GroupByFieldId       int64    `protobuf:"varint,6,opt,name=group_by_field_id,json=groupByFieldId,proto3" json:"group_by_field_id,omitempty"`
+	GroupSize            int64    `protobuf:"varint,7,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -222,7 +358,7 @@ func (m *QueryInfo) Reset()         { *m = QueryInfo{} }
 func (m *QueryInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryInfo) ProtoMessage()    {}
 func (*QueryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{1}
+	return fileDescriptor_2d655ab2f7683c23, []int{2}
 }
 
 func (m *QueryInfo) XXX_Unmarshal(b []byte) error {
@@ -264,6 +400,27 @@ func (m *QueryInfo) GetSearchParams() string {
 	return ""
 }
 
+func (m *QueryInfo) GetRangeSearchInfo() *RangeSearchInfo {
+	if m != nil {
+		return m.RangeSearchInfo
+	}
+	return nil
+}
+
+func (m *QueryInfo) GetGroupByFieldId() int64 {
+	if m != nil {
+		return m.GroupByFieldId
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetGroupSize() int64 {
+	if m != nil {
+		return m.GroupSize
+	}
+	return 0
+}
+
 type ColumnInfo struct {
 	FieldId              int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
 	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
@@ -278,7 +435,7 @@ func (m *ColumnInfo) Reset()         { *m = ColumnInfo{} }
 func (m *ColumnInfo) String() string { return proto.CompactTextString(m) }
 func (*ColumnInfo) ProtoMessage()    {}
 func (*ColumnInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{2}
+	return fileDescriptor_2d655ab2f7683c23, []int{3}
 }
 
 func (m *ColumnInfo) XXX_Unmarshal(b []byte) error {
@@ -340,7 +497,7 @@ func (m *UnaryRangeExpr) Reset()         { *m = UnaryRangeExpr{} }
 func (m *UnaryRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryRangeExpr) ProtoMessage()    {}
 func (*UnaryRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{3}
+	return fileDescriptor_2d655ab2f7683c23, []int{4}
 }
 
 func (m *UnaryRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -397,7 +554,7 @@ func (m *BinaryRangeExpr) Reset()         { *m = BinaryRangeExpr{} }
 func (m *BinaryRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryRangeExpr) ProtoMessage()    {}
 func (*BinaryRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{4}
+	return fileDescriptor_2d655ab2f7683c23, []int{5}
 }
 
 func (m *BinaryRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -466,7 +623,7 @@ func (m *CompareExpr) Reset()         { *m = CompareExpr{} }
 func (m *CompareExpr) String() string { return proto.CompactTextString(m) }
 func (*CompareExpr) ProtoMessage()    {}
 func (*CompareExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{5}
+	return fileDescriptor_2d655ab2f7683c23, []int{6}
 }
 
 func (m *CompareExpr) XXX_Unmarshal(b []byte) error {
@@ -520,7 +677,7 @@ func (m *TermExpr) Reset()         { *m = TermExpr{} }
 func (m *TermExpr) String() string { return proto.CompactTextString(m) }
 func (*TermExpr) ProtoMessage()    {}
 func (*TermExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{6}
+	return fileDescriptor_2d655ab2f7683c23, []int{7}
 }
 
 func (m *TermExpr) XXX_Unmarshal(b []byte) error {
@@ -555,6 +712,134 @@ func (m *TermExpr) GetValues() []*GenericValue {
 	return nil
 }
 
+type StringMatchExpr struct {
+	ColumnInfo           *ColumnInfo             `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   StringMatchExpr_MatchOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.StringMatchExpr_MatchOp" json:"op,omitempty"`
+	Pattern              string                  `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *StringMatchExpr) Reset()         { *m = StringMatchExpr{} }
+func (m *StringMatchExpr) String() string { return proto.CompactTextString(m) }
+func (*StringMatchExpr) ProtoMessage()    {}
+func (*StringMatchExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{8}
+}
+
+func (m *StringMatchExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StringMatchExpr.Unmarshal(m, b)
+}
+func (m *StringMatchExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StringMatchExpr.Marshal(b, m, deterministic)
+}
+func (m *StringMatchExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StringMatchExpr.Merge(m, src)
+}
+func (m *StringMatchExpr) XXX_Size() int {
+	return xxx_messageInfo_StringMatchExpr.Size(m)
+}
+func (m *StringMatchExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_StringMatchExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StringMatchExpr proto.InternalMessageInfo
+
+func (m *StringMatchExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *StringMatchExpr) GetOp() StringMatchExpr_MatchOp {
+	if m != nil {
+		return m.Op
+	}
+	return StringMatchExpr_Invalid
+}
+
+func (m *StringMatchExpr) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+// BinaryArithOpEvalRangeExpr compares the result of arithmetic between a column and a constant with a value,
+// i.e. `column arith_op right_operand op value`
+type BinaryArithOpEvalRangeExpr struct {
+	ColumnInfo           *ColumnInfo   `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	ArithOp              ArithOpType   `protobuf:"varint,2,opt,name=arith_op,json=arithOp,proto3,enum=milvus.proto.plan.ArithOpType" json:"arith_op,omitempty"`
+	RightOperand         *GenericValue `protobuf:"bytes,3,opt,name=right_operand,json=rightOperand,proto3" json:"right_operand,omitempty"`
+	Op                   OpType        `protobuf:"varint,4,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
+	Value                *GenericValue `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BinaryArithOpEvalRangeExpr) Reset()         { *m = BinaryArithOpEvalRangeExpr{} }
+func (m *BinaryArithOpEvalRangeExpr) String() string { return proto.CompactTextString(m) }
+func (*BinaryArithOpEvalRangeExpr) ProtoMessage()    {}
+func (*BinaryArithOpEvalRangeExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
+}
+
+func (m *BinaryArithOpEvalRangeExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BinaryArithOpEvalRangeExpr.Unmarshal(m, b)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BinaryArithOpEvalRangeExpr.Marshal(b, m, deterministic)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BinaryArithOpEvalRangeExpr.Merge(m, src)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_Size() int {
+	return xxx_messageInfo_BinaryArithOpEvalRangeExpr.Size(m)
+}
+func (m *BinaryArithOpEvalRangeExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_BinaryArithOpEvalRangeExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BinaryArithOpEvalRangeExpr proto.InternalMessageInfo
+
+func (m *BinaryArithOpEvalRangeExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetArithOp() ArithOpType {
+	if m != nil {
+		return m.ArithOp
+	}
+	return ArithOpType_Unknown
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetRightOperand() *GenericValue {
+	if m != nil {
+		return m.RightOperand
+	}
+	return nil
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetOp() OpType {
+	if m != nil {
+		return m.Op
+	}
+	return OpType_Invalid
+}
+
+func (m *BinaryArithOpEvalRangeExpr) GetValue() *GenericValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type UnaryExpr struct {
 	Op                   UnaryExpr_UnaryOp `protobuf:"varint,1,opt,name=op,proto3,enum=milvus.proto.plan.UnaryExpr_UnaryOp" json:"op,omitempty"`
 	Child                *Expr             `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty"`
@@ -567,7 +852,7 @@ func (m *UnaryExpr) Reset()         { *m = UnaryExpr{} }
 func (m *UnaryExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryExpr) ProtoMessage()    {}
 func (*UnaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7}
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
 }
 
 func (m *UnaryExpr) XXX_Unmarshal(b []byte) error {
@@ -615,7 +900,7 @@ func (m *BinaryExpr) Reset()         { *m = BinaryExpr{} }
 func (m *BinaryExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryExpr) ProtoMessage()    {}
 func (*BinaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *BinaryExpr) XXX_Unmarshal(b []byte) error {
@@ -665,6 +950,8 @@ type Expr struct {
 	//	*Expr_CompareExpr
 	//	*Expr_UnaryRangeExpr
 	//	*Expr_BinaryRangeExpr
+	//	*Expr_BinaryArithOpEvalRangeExpr
+	//	*Expr_StringMatchExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -675,7 +962,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -724,6 +1011,14 @@ type Expr_BinaryRangeExpr struct {
 	BinaryRangeExpr *BinaryRangeExpr `protobuf:"bytes,6,opt,name=binary_range_expr,json=binaryRangeExpr,proto3,oneof"`
 }
 
+type Expr_BinaryArithOpEvalRangeExpr struct {
+	BinaryArithOpEvalRangeExpr *BinaryArithOpEvalRangeExpr `protobuf:"bytes,7,opt,name=binary_arith_op_eval_range_expr,json=binaryArithOpEvalRangeExpr,proto3,oneof"`
+}
+
+type Expr_StringMatchExpr struct {
+	StringMatchExpr *StringMatchExpr `protobuf:"bytes,8,opt,name=string_match_expr,json=stringMatchExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -736,6 +1031,10 @@ func (*Expr_UnaryRangeExpr) isExpr_Expr() {}
 
 func (*Expr_BinaryRangeExpr) isExpr_Expr() {}
 
+func (*Expr_BinaryArithOpEvalRangeExpr) isExpr_Expr() {}
+
+func (*Expr_StringMatchExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -785,6 +1084,20 @@ func (m *Expr) GetBinaryRangeExpr() *BinaryRangeExpr {
 	return nil
 }
 
+func (m *Expr) GetBinaryArithOpEvalRangeExpr() *BinaryArithOpEvalRangeExpr {
+	if x, ok := m.GetExpr().(*Expr_BinaryArithOpEvalRangeExpr); ok {
+		return x.BinaryArithOpEvalRangeExpr
+	}
+	return nil
+}
+
+func (m *Expr) GetStringMatchExpr() *StringMatchExpr {
+	if x, ok := m.GetExpr().(*Expr_StringMatchExpr); ok {
+		return x.StringMatchExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -794,6 +1107,8 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_CompareExpr)(nil),
 		(*Expr_UnaryRangeExpr)(nil),
 		(*Expr_BinaryRangeExpr)(nil),
+		(*Expr_BinaryArithOpEvalRangeExpr)(nil),
+		(*Expr_StringMatchExpr)(nil),
 	}
 }
 
@@ -812,7 +1127,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -872,18 +1187,20 @@ type PlanNode struct {
 	// Types that are valid to be assigned to Node:
 	//	*PlanNode_VectorAnns
 	//	*PlanNode_Predicates
-	Node                 isPlanNode_Node `protobuf_oneof:"node"`
-	OutputFieldIds       []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Node           isPlanNode_Node `protobuf_oneof:"node"`
+	OutputFieldIds []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
+	// max number of entities retrieved from each segment, ordered by primary key, 0 means no limit
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -948,6 +1265,13 @@ func (m *PlanNode) GetOutputFieldIds() []int64 {
 	return nil
 }
 
+func (m *PlanNode) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*PlanNode) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -958,15 +1282,20 @@ func (*PlanNode) XXX_OneofWrappers() []interface{} {
 
 func init() {
 	proto.RegisterEnum("milvus.proto.plan.OpType", OpType_name, OpType_value)
+	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
+	proto.RegisterEnum("milvus.proto.plan.StringMatchExpr_MatchOp", StringMatchExpr_MatchOp_name, StringMatchExpr_MatchOp_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
+	proto.RegisterType((*RangeSearchInfo)(nil), "milvus.proto.plan.RangeSearchInfo")
 	proto.RegisterType((*QueryInfo)(nil), "milvus.proto.plan.QueryInfo")
 	proto.RegisterType((*ColumnInfo)(nil), "milvus.proto.plan.ColumnInfo")
 	proto.RegisterType((*UnaryRangeExpr)(nil), "milvus.proto.plan.UnaryRangeExpr")
 	proto.RegisterType((*BinaryRangeExpr)(nil), "milvus.proto.plan.BinaryRangeExpr")
 	proto.RegisterType((*CompareExpr)(nil), "milvus.proto.plan.CompareExpr")
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
+	proto.RegisterType((*StringMatchExpr)(nil), "milvus.proto.plan.StringMatchExpr")
+	proto.RegisterType((*BinaryArithOpEvalRangeExpr)(nil), "milvus.proto.plan.BinaryArithOpEvalRangeExpr")
 	proto.RegisterType((*UnaryExpr)(nil), "milvus.proto.plan.UnaryExpr")
 	proto.RegisterType((*BinaryExpr)(nil), "milvus.proto.plan.BinaryExpr")
 	proto.RegisterType((*Expr)(nil), "milvus.proto.plan.Expr")
@@ -977,72 +1306,95 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1067 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0xb6, 0x2c, 0xdb, 0x91, 0x8e, 0x5c, 0xdb, 0xd5, 0x0d, 0x29, 0xa1, 0x24, 0x88, 0x0e, 0x0d,
-	0x30, 0x4d, 0x86, 0xb6, 0xa4, 0x43, 0x19, 0x98, 0xfc, 0xb4, 0xc4, 0x1e, 0x4a, 0x12, 0x44, 0xc8,
-	0x05, 0x37, 0x9a, 0xb5, 0xb4, 0xb1, 0x77, 0xba, 0xd6, 0x2a, 0xab, 0x95, 0xa9, 0xaf, 0x79, 0x02,
-	0x5e, 0x02, 0xae, 0xe1, 0x8e, 0x77, 0xe0, 0x01, 0xb8, 0xe7, 0x45, 0x98, 0x3d, 0xab, 0xf8, 0xa7,
-	0xe3, 0xa4, 0x61, 0xa6, 0x77, 0xab, 0xb3, 0xe7, 0xef, 0xfb, 0xce, 0xcf, 0x0a, 0x20, 0xe3, 0x24,
-	0xdd, 0xca, 0xa4, 0x50, 0xc2, 0xbf, 0x3d, 0x62, 0x7c, 0x5c, 0xe4, 0xe6, 0x6b, 0x4b, 0x5f, 0xbc,
-	0xdb, 0xcc, 0xe3, 0x21, 0x1d, 0x11, 0x23, 0x0a, 0x32, 0x68, 0x1e, 0xd2, 0x94, 0x4a, 0x16, 0x9f,
-	0x11, 0x5e, 0x50, 0x7f, 0x0d, 0x9c, 0xbe, 0x10, 0x3c, 0x1a, 0x13, 0xbe, 0x6a, 0x6d, 0x58, 0x9b,
-	0x4e, 0xb7, 0x12, 0xae, 0x68, 0xc9, 0x19, 0xe1, 0xfe, 0x5d, 0x70, 0x59, 0xaa, 0x76, 0x1e, 0xe3,
-	0x6d, 0x75, 0xc3, 0xda, 0xb4, 0xbb, 0x95, 0xd0, 0x41, 0x51, 0x79, 0x7d, 0xce, 0x05, 0x51, 0x78,
-	0x6d, 0x6f, 0x58, 0x9b, 0x96, 0xbe, 0x46, 0xd1, 0x19, 0xe1, 0xfb, 0x75, 0xb0, 0xc7, 0x84, 0x07,
-	0x14, 0xdc, 0xef, 0x0b, 0x2a, 0x27, 0xbd, 0xf4, 0x5c, 0xf8, 0x3e, 0xd4, 0x94, 0xc8, 0x5e, 0x62,
-	0x28, 0x3b, 0xc4, 0xb3, 0xbf, 0x0e, 0xde, 0x88, 0x2a, 0xc9, 0xe2, 0x48, 0x4d, 0x32, 0x8a, 0x8e,
-	0xdc, 0x10, 0x8c, 0xe8, 0x74, 0x92, 0x51, 0xff, 0x43, 0xb8, 0x95, 0x53, 0x22, 0xe3, 0x61, 0x94,
-	0x11, 0x49, 0x46, 0xf9, 0x6a, 0x0d, 0x55, 0x9a, 0x46, 0x78, 0x82, 0xb2, 0xe0, 0x37, 0x0b, 0xe0,
-	0x40, 0xf0, 0x62, 0x94, 0x62, 0xa0, 0x3b, 0xe0, 0x9c, 0x33, 0xca, 0x93, 0x88, 0x25, 0x65, 0xb0,
-	0x15, 0xfc, 0xee, 0x25, 0xfe, 0x53, 0x70, 0x13, 0xa2, 0x88, 0x89, 0xa6, 0x51, 0xb5, 0x1e, 0xde,
-	0xdd, 0x5a, 0xe0, 0xad, 0x64, 0xec, 0x19, 0x51, 0x44, 0x27, 0x10, 0x3a, 0x49, 0x79, 0xf2, 0xef,
-	0x41, 0x8b, 0xe5, 0x51, 0x26, 0xd9, 0x88, 0xc8, 0x49, 0xf4, 0x92, 0x4e, 0x30, 0x5d, 0x27, 0x6c,
-	0xb2, 0xfc, 0xc4, 0x08, 0xbf, 0xa5, 0x13, 0x7f, 0x0d, 0x5c, 0x96, 0x47, 0xa4, 0x50, 0xa2, 0xf7,
-	0x0c, 0x93, 0x75, 0x42, 0x87, 0xe5, 0x7b, 0xf8, 0x1d, 0xfc, 0x69, 0x41, 0xeb, 0xc7, 0x94, 0xc8,
-	0x49, 0x48, 0xd2, 0x01, 0x7d, 0xfe, 0x2a, 0x93, 0xfe, 0xd7, 0xe0, 0xc5, 0x98, 0x7a, 0xc4, 0xd2,
-	0x73, 0x81, 0xf9, 0x7a, 0xaf, 0xe7, 0x84, 0x45, 0x9e, 0x01, 0x0c, 0x21, 0x9e, 0x81, 0xfd, 0x18,
-	0xaa, 0x22, 0x2b, 0xa1, 0xdc, 0x59, 0x62, 0x76, 0x9c, 0x21, 0x8c, 0xaa, 0xc8, 0xfc, 0xcf, 0xa1,
-	0x3e, 0xd6, 0x85, 0xc7, 0xbc, 0xbd, 0x87, 0xeb, 0x4b, 0xb4, 0xe7, 0xfb, 0x23, 0x34, 0xda, 0xc1,
-	0xef, 0x55, 0x68, 0xef, 0xb3, 0xb7, 0x9b, 0xf5, 0x7d, 0x68, 0x73, 0xf1, 0x33, 0x95, 0x11, 0x4b,
-	0x63, 0x5e, 0xe4, 0x6c, 0x6c, 0xaa, 0xe1, 0x84, 0x2d, 0x14, 0xf7, 0x2e, 0xa5, 0x5a, 0xb1, 0xc8,
-	0xb2, 0x05, 0x45, 0xc3, 0x7a, 0x0b, 0xc5, 0x33, 0xc5, 0x5d, 0xf0, 0x8c, 0x47, 0x03, 0xb1, 0x76,
-	0x33, 0x88, 0x80, 0x36, 0x66, 0x1c, 0x76, 0xc1, 0x33, 0xa1, 0x8c, 0x87, 0xfa, 0x0d, 0x3d, 0xa0,
-	0x0d, 0x9e, 0x83, 0xbf, 0x2d, 0xf0, 0x0e, 0xc4, 0x28, 0x23, 0xd2, 0xb0, 0x74, 0x08, 0x1d, 0x4e,
-	0xcf, 0x55, 0xf4, 0xbf, 0xa9, 0x6a, 0x69, 0xb3, 0xb9, 0x8e, 0xee, 0xc1, 0x6d, 0xc9, 0x06, 0xc3,
-	0x45, 0x4f, 0xd5, 0x9b, 0x78, 0x6a, 0xa3, 0xdd, 0xc1, 0xeb, 0xfd, 0x62, 0xdf, 0xa0, 0x5f, 0x82,
-	0x5f, 0x2c, 0x70, 0x4e, 0xa9, 0x1c, 0xbd, 0x95, 0x8a, 0x3f, 0x81, 0x06, 0xf2, 0x9a, 0xaf, 0x56,
-	0x37, 0xec, 0x9b, 0x10, 0x5b, 0xaa, 0x07, 0xbf, 0x5a, 0xe0, 0xe2, 0xcc, 0x60, 0x1a, 0x8f, 0x31,
-	0x7d, 0x0b, 0xd3, 0xbf, 0xb7, 0xc4, 0xc5, 0x54, 0xd3, 0x9c, 0x8e, 0x33, 0xec, 0xfc, 0x07, 0x50,
-	0x8f, 0x87, 0x8c, 0x27, 0x25, 0x67, 0xef, 0x2c, 0x31, 0xd4, 0x36, 0xa1, 0xd1, 0x0a, 0xd6, 0x61,
-	0xa5, 0xb4, 0xf6, 0x3d, 0x58, 0xe9, 0xa5, 0x63, 0xc2, 0x59, 0xd2, 0xa9, 0xf8, 0x2b, 0x60, 0x1f,
-	0x09, 0xd5, 0xb1, 0x82, 0x7f, 0x2c, 0x00, 0x33, 0x12, 0x98, 0xd4, 0xce, 0x5c, 0x52, 0x1f, 0x2d,
-	0xf1, 0x3d, 0x53, 0x2d, 0x8f, 0x65, 0x5a, 0x9f, 0x42, 0x4d, 0x17, 0xfa, 0x4d, 0x59, 0xa1, 0x92,
-	0xc6, 0x80, 0xb5, 0x2c, 0xa7, 0xf7, 0x6a, 0x0c, 0xa8, 0x15, 0xec, 0x80, 0x73, 0x19, 0x6b, 0x11,
-	0x44, 0x0b, 0xe0, 0x85, 0x18, 0xb0, 0x98, 0xf0, 0xbd, 0x34, 0xe9, 0x58, 0xfe, 0x2d, 0x70, 0xcb,
-	0xef, 0x63, 0xd9, 0xa9, 0x06, 0x7f, 0xd8, 0x50, 0x43, 0x50, 0x4f, 0xc1, 0x55, 0x54, 0x8e, 0x22,
-	0xfa, 0x2a, 0x93, 0x65, 0xb9, 0xd7, 0x96, 0xc4, 0xbc, 0x6c, 0x10, 0xbd, 0xfe, 0xd5, 0x65, 0xb3,
-	0x7c, 0x05, 0x50, 0xe8, 0xd8, 0xc6, 0xd8, 0xc0, 0x7b, 0xef, 0xba, 0x6a, 0x75, 0x2b, 0xa1, 0x5b,
-	0x4c, 0xf9, 0xdc, 0x05, 0xaf, 0xcf, 0x66, 0xf6, 0xf6, 0x95, 0xbd, 0x36, 0x23, 0xb6, 0x5b, 0x09,
-	0xa1, 0x3f, 0xab, 0xc8, 0x01, 0x34, 0x63, 0x33, 0x88, 0xc6, 0x85, 0x59, 0x07, 0xef, 0x2f, 0x6d,
-	0xd7, 0xe9, 0xbc, 0x76, 0x2b, 0xa1, 0x17, 0xcf, 0x8d, 0xef, 0x77, 0xd0, 0x31, 0x28, 0xa4, 0xde,
-	0x7b, 0xc6, 0x91, 0xd9, 0x0a, 0x1f, 0x5c, 0x85, 0x65, 0xba, 0x21, 0xbb, 0x95, 0xb0, 0x55, 0x2c,
-	0xee, 0xcc, 0x13, 0xb8, 0x5d, 0xa2, 0x9a, 0xf3, 0xd7, 0x40, 0x7f, 0xc1, 0x95, 0xd8, 0xe6, 0x1d,
-	0xb6, 0xfb, 0x8b, 0xa2, 0xfd, 0x06, 0xd4, 0xb4, 0x93, 0xe0, 0x5f, 0x0b, 0xe0, 0x8c, 0xc6, 0x4a,
-	0xc8, 0xbd, 0xa3, 0xa3, 0x1f, 0xca, 0x27, 0xc8, 0x28, 0x9b, 0x87, 0x5d, 0x3f, 0x41, 0xc6, 0xdf,
-	0xc2, 0xe3, 0x58, 0x5d, 0x7c, 0x1c, 0x9f, 0x00, 0x64, 0x92, 0x26, 0x2c, 0x26, 0x8a, 0xe6, 0x6f,
-	0x6a, 0xb3, 0x39, 0x55, 0xff, 0x4b, 0x80, 0x0b, 0xfd, 0xcc, 0x9b, 0xd5, 0x50, 0xbb, 0xb2, 0xdc,
-	0xd3, 0x7f, 0x81, 0xd0, 0xbd, 0x98, 0xfe, 0x16, 0xdc, 0x87, 0x76, 0xc6, 0x49, 0x4c, 0x87, 0x82,
-	0x27, 0x54, 0x46, 0x8a, 0x0c, 0x90, 0x64, 0x37, 0x6c, 0xcd, 0x89, 0x4f, 0xc9, 0x20, 0xf8, 0xcb,
-	0x02, 0xe7, 0x84, 0x93, 0xf4, 0x48, 0x24, 0xb8, 0xac, 0xc7, 0x88, 0x38, 0x22, 0x69, 0x9a, 0x5f,
-	0xb3, 0x8e, 0x66, 0xbc, 0xe8, 0x16, 0x31, 0x36, 0x7b, 0x69, 0x9a, 0xfb, 0x5f, 0x2c, 0xa0, 0xbd,
-	0x7e, 0x04, 0xb5, 0xe9, 0x1c, 0xde, 0x4d, 0xe8, 0x88, 0x42, 0x65, 0x85, 0x8a, 0x2e, 0xa9, 0xd4,
-	0x74, 0xd9, 0x9b, 0x76, 0xd8, 0x32, 0xf2, 0x6f, 0x0c, 0xa3, 0xb9, 0xae, 0x50, 0x2a, 0x12, 0xfa,
-	0x49, 0x0a, 0x0d, 0xb3, 0x58, 0x17, 0x67, 0xb1, 0x0d, 0xde, 0xa1, 0xa4, 0x44, 0x51, 0x79, 0x3a,
-	0x24, 0x69, 0xc7, 0xf2, 0x3b, 0xd0, 0x2c, 0x05, 0xcf, 0x2f, 0x0a, 0xc2, 0x3b, 0x55, 0xbf, 0x09,
-	0xce, 0x0b, 0x9a, 0xe7, 0x78, 0x6f, 0xe3, 0xb0, 0xd2, 0x3c, 0x37, 0x97, 0x35, 0xdf, 0x85, 0xba,
-	0x39, 0xd6, 0xb5, 0xde, 0x91, 0x50, 0xe6, 0xab, 0xb1, 0xff, 0xe8, 0xa7, 0xcf, 0x06, 0x4c, 0x0d,
-	0x8b, 0xfe, 0x56, 0x2c, 0x46, 0xdb, 0x06, 0xd4, 0x03, 0x26, 0xca, 0xd3, 0x36, 0x4b, 0x15, 0x95,
-	0x29, 0xe1, 0xdb, 0x88, 0x73, 0x5b, 0xe3, 0xcc, 0xfa, 0xfd, 0x06, 0x7e, 0x3d, 0xfa, 0x2f, 0x00,
-	0x00, 0xff, 0xff, 0x6e, 0xb0, 0x90, 0xcb, 0x55, 0x0a, 0x00, 0x00,
+	// 1432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x72, 0x13, 0xc7,
+	0x13, 0xd7, 0xea, 0x73, 0xd5, 0x12, 0xd2, 0x7a, 0xeb, 0x5f, 0xff, 0x08, 0x88, 0xb1, 0xd9, 0x50,
+	0x89, 0x21, 0x85, 0x5d, 0x01, 0x02, 0x05, 0xf9, 0x28, 0xfc, 0x01, 0x96, 0x2b, 0x60, 0x3b, 0x6b,
+	0xe3, 0x43, 0x2e, 0x5b, 0xa3, 0xdd, 0x91, 0x34, 0xc5, 0x6a, 0x67, 0x99, 0x9d, 0x15, 0x88, 0x6b,
+	0x9e, 0x80, 0x97, 0x48, 0xee, 0x79, 0x81, 0xbc, 0x40, 0xaa, 0x72, 0xcd, 0x3d, 0x95, 0x87, 0xc8,
+	0x29, 0xa9, 0xe9, 0x59, 0xeb, 0xc3, 0x91, 0x8d, 0xa9, 0xf2, 0xad, 0xe7, 0x37, 0xdd, 0x3d, 0xdd,
+	0xbf, 0x99, 0xee, 0x69, 0x80, 0x38, 0x24, 0xd1, 0x6a, 0x2c, 0xb8, 0xe4, 0xf6, 0xc2, 0x80, 0x85,
+	0xc3, 0x34, 0xd1, 0xab, 0x55, 0xb5, 0x71, 0xa5, 0x9e, 0xf8, 0x7d, 0x3a, 0x20, 0x1a, 0x72, 0xde,
+	0x19, 0x50, 0xdf, 0xa6, 0x11, 0x15, 0xcc, 0x3f, 0x22, 0x61, 0x4a, 0xed, 0xab, 0x60, 0x76, 0x38,
+	0x0f, 0xbd, 0x21, 0x09, 0x5b, 0xc6, 0xb2, 0xb1, 0x62, 0xb6, 0x73, 0x6e, 0x45, 0x21, 0x47, 0x24,
+	0xb4, 0x17, 0xa1, 0xca, 0x22, 0x79, 0xff, 0x1e, 0xee, 0xe6, 0x97, 0x8d, 0x95, 0x42, 0x3b, 0xe7,
+	0x9a, 0x08, 0x65, 0xdb, 0xdd, 0x90, 0x13, 0x89, 0xdb, 0x85, 0x65, 0x63, 0xc5, 0x50, 0xdb, 0x08,
+	0xa9, 0xed, 0x25, 0x80, 0x44, 0x0a, 0x16, 0xf5, 0x70, 0xbf, 0xb8, 0x6c, 0xac, 0x54, 0xdb, 0x39,
+	0xb7, 0xaa, 0xb1, 0x23, 0x12, 0x6e, 0x94, 0xa0, 0x30, 0x24, 0xa1, 0xf3, 0x0c, 0x9a, 0x2e, 0x89,
+	0x7a, 0xf4, 0x80, 0x12, 0xe1, 0xf7, 0x77, 0xa2, 0x2e, 0xb7, 0xff, 0x0f, 0x65, 0x41, 0x02, 0x96,
+	0x26, 0x18, 0x53, 0xde, 0xcd, 0x56, 0xf6, 0x75, 0xa8, 0x0b, 0xa5, 0xea, 0x75, 0x59, 0x28, 0xa9,
+	0xc0, 0x98, 0xf2, 0x6e, 0x0d, 0xb1, 0xa7, 0x08, 0x39, 0xff, 0x18, 0x50, 0xfd, 0x3e, 0xa5, 0x62,
+	0x84, 0x8e, 0x6c, 0x28, 0x4a, 0x1e, 0xbf, 0x44, 0x37, 0x05, 0x17, 0x65, 0x7b, 0x09, 0x6a, 0x03,
+	0x2a, 0x05, 0xf3, 0x3d, 0x39, 0x8a, 0x29, 0x06, 0x5e, 0x75, 0x41, 0x43, 0x87, 0xa3, 0x98, 0xda,
+	0x9f, 0xc0, 0xa5, 0x04, 0x63, 0xf1, 0x62, 0x22, 0xc8, 0x20, 0xd1, 0xb1, 0xbb, 0x75, 0x0d, 0xee,
+	0x23, 0x66, 0xef, 0xc2, 0x82, 0x0e, 0x25, 0x53, 0x65, 0x51, 0x97, 0xb7, 0x4a, 0xcb, 0xc6, 0x4a,
+	0xed, 0x8e, 0xb3, 0xfa, 0x9f, 0x6b, 0x58, 0x3d, 0x91, 0xa1, 0xdb, 0x14, 0x27, 0x52, 0xbe, 0x09,
+	0x0b, 0x3d, 0xc1, 0xd3, 0xd8, 0xeb, 0x8c, 0xbc, 0x2e, 0xa3, 0x61, 0xe0, 0xb1, 0xa0, 0x55, 0xc6,
+	0xb0, 0x1b, 0xb8, 0xb1, 0x31, 0x7a, 0xaa, 0xe0, 0x9d, 0xc0, 0x5e, 0x04, 0xd0, 0xaa, 0x09, 0x7b,
+	0x4b, 0x5b, 0x15, 0xd4, 0xa9, 0x22, 0x72, 0xc0, 0xde, 0x52, 0xe7, 0x27, 0x03, 0x60, 0x93, 0x87,
+	0xe9, 0x20, 0x42, 0xc7, 0x97, 0xc1, 0x1c, 0xfb, 0xd3, 0x34, 0x54, 0xba, 0x99, 0xa3, 0x47, 0x50,
+	0x0d, 0x88, 0x24, 0x9a, 0x07, 0xc5, 0x65, 0xe3, 0xce, 0xe2, 0x6c, 0xec, 0xd9, 0xe3, 0xd9, 0x22,
+	0x92, 0x28, 0x6a, 0x5c, 0x33, 0xc8, 0x24, 0xfb, 0x06, 0x34, 0x58, 0xe2, 0xc5, 0x82, 0x0d, 0x88,
+	0x18, 0x79, 0x2f, 0xe9, 0x08, 0x89, 0x34, 0xdd, 0x3a, 0x4b, 0xf6, 0x35, 0xf8, 0x1d, 0x1d, 0xd9,
+	0x57, 0xa1, 0xca, 0x12, 0x8f, 0xa4, 0x92, 0xef, 0x6c, 0x21, 0x8d, 0xa6, 0x6b, 0xb2, 0x64, 0x1d,
+	0xd7, 0xce, 0x2f, 0x06, 0x34, 0x5e, 0x44, 0x44, 0x8c, 0x90, 0x9c, 0x27, 0x6f, 0x62, 0x61, 0x7f,
+	0x0b, 0x35, 0x1f, 0x43, 0xd7, 0x7c, 0x1a, 0xc8, 0xe7, 0xe2, 0x1c, 0x3e, 0x27, 0x09, 0xba, 0xe0,
+	0x4f, 0x92, 0xbd, 0x09, 0x79, 0x1e, 0x67, 0xa9, 0x5c, 0x9e, 0x63, 0xb6, 0x17, 0x63, 0x1a, 0x79,
+	0x1e, 0xdb, 0x5f, 0x42, 0x69, 0xa8, 0x4a, 0x00, 0xe3, 0xae, 0xdd, 0x59, 0x9a, 0xa3, 0x3d, 0x5d,
+	0x29, 0xae, 0xd6, 0x76, 0x7e, 0xce, 0x43, 0x73, 0x83, 0x5d, 0x6c, 0xd4, 0x9f, 0x41, 0x33, 0xe4,
+	0xaf, 0xa9, 0xf0, 0x58, 0xe4, 0x87, 0x69, 0xc2, 0x86, 0xfa, 0x36, 0x4c, 0xb7, 0x81, 0xf0, 0xce,
+	0x31, 0xaa, 0x14, 0xd3, 0x38, 0x9e, 0x51, 0xd4, 0xac, 0x37, 0x10, 0x9e, 0x28, 0x3e, 0x86, 0x9a,
+	0xf6, 0xa8, 0x53, 0x2c, 0x9e, 0x2f, 0x45, 0x40, 0x1b, 0x94, 0x95, 0x07, 0x7d, 0x94, 0xf6, 0x50,
+	0x3a, 0xa7, 0x07, 0xb4, 0x41, 0xd9, 0xf9, 0xcd, 0x80, 0xda, 0x26, 0x1f, 0xc4, 0x44, 0x68, 0x96,
+	0xb6, 0xc1, 0x0a, 0x69, 0x57, 0x7a, 0x1f, 0x4c, 0x55, 0x43, 0x99, 0x4d, 0xd6, 0xf6, 0x0e, 0x2c,
+	0x08, 0xd6, 0xeb, 0xcf, 0x7a, 0xca, 0x9f, 0xc7, 0x53, 0x13, 0xed, 0x36, 0x4f, 0xbe, 0x97, 0xc2,
+	0x39, 0xde, 0x8b, 0xf3, 0xa3, 0x01, 0xe6, 0x21, 0x15, 0x83, 0x0b, 0xb9, 0xf1, 0x07, 0x50, 0x46,
+	0x5e, 0x93, 0x56, 0x7e, 0xb9, 0x70, 0x1e, 0x62, 0x33, 0x75, 0xe7, 0x2f, 0x03, 0x9a, 0x07, 0xd8,
+	0x41, 0x9f, 0x13, 0xe9, 0xf7, 0x2f, 0x24, 0x98, 0x47, 0x53, 0x45, 0x73, 0x6b, 0x8e, 0xd9, 0x89,
+	0xf3, 0x56, 0x51, 0xda, 0x8b, 0xb1, 0x8a, 0x5a, 0x50, 0x89, 0x89, 0x94, 0x54, 0x44, 0x59, 0x23,
+	0x3d, 0x5e, 0x3a, 0x5f, 0x43, 0x25, 0x53, 0xb4, 0x6b, 0x50, 0xd9, 0x89, 0x86, 0x24, 0x64, 0x81,
+	0x95, 0xb3, 0x01, 0xca, 0xfb, 0x82, 0x76, 0xd9, 0x1b, 0xcb, 0x50, 0xf2, 0x41, 0xda, 0x55, 0x72,
+	0xde, 0xae, 0x83, 0xb9, 0xc9, 0x23, 0x49, 0x58, 0x94, 0x58, 0x05, 0xe7, 0xd7, 0x3c, 0x5c, 0xd1,
+	0x65, 0xb6, 0x2e, 0x98, 0xec, 0xef, 0xc5, 0x4f, 0x86, 0x24, 0xbc, 0xb8, 0x8a, 0x7b, 0x08, 0x26,
+	0x51, 0x7e, 0xbd, 0x71, 0xe2, 0xd7, 0xe6, 0x18, 0x67, 0x47, 0xe3, 0x13, 0xa8, 0x10, 0xbd, 0xb0,
+	0xb7, 0xe0, 0x92, 0x7e, 0x7d, 0x3c, 0xa6, 0x82, 0x44, 0xc1, 0x79, 0xfb, 0x47, 0x1d, 0xad, 0xf6,
+	0xb4, 0x51, 0xf6, 0xf0, 0x8a, 0x1f, 0xd4, 0xa8, 0x4a, 0x1f, 0xd4, 0xa8, 0xde, 0x19, 0x50, 0xc5,
+	0xee, 0x8a, 0x84, 0xdd, 0xc3, 0xf3, 0x0c, 0x3c, 0xef, 0xc6, 0x1c, 0x0f, 0x63, 0x4d, 0x2d, 0x65,
+	0xb7, 0x7b, 0x1b, 0x4a, 0x7e, 0x9f, 0x85, 0x41, 0x56, 0x5d, 0x1f, 0xcd, 0x31, 0x54, 0x36, 0xae,
+	0xd6, 0x72, 0x96, 0xa0, 0x92, 0x59, 0xcf, 0x5e, 0x79, 0x05, 0x0a, 0xbb, 0x5c, 0x5a, 0x86, 0xf3,
+	0x87, 0x01, 0xa0, 0x6f, 0x15, 0x83, 0xba, 0x3f, 0x15, 0xd4, 0xa7, 0x73, 0x7c, 0x4f, 0x54, 0x33,
+	0x31, 0x0b, 0xeb, 0x73, 0x28, 0xaa, 0x96, 0xf0, 0xbe, 0xa8, 0x50, 0x49, 0xe5, 0x80, 0xcc, 0xb7,
+	0x0a, 0x67, 0x6b, 0x6b, 0x2d, 0xe7, 0x3e, 0x98, 0x1b, 0x6c, 0x5e, 0x12, 0x0d, 0x80, 0x67, 0xbc,
+	0xc7, 0x7c, 0x12, 0xae, 0x47, 0x81, 0x65, 0xd8, 0x97, 0xa0, 0x9a, 0xad, 0xf7, 0x84, 0x95, 0x77,
+	0xfe, 0x2e, 0x42, 0x11, 0x93, 0x7a, 0x04, 0x55, 0x49, 0xc5, 0xc0, 0xa3, 0x6f, 0x62, 0x91, 0x3d,
+	0xcc, 0xab, 0x73, 0xce, 0x3c, 0x6e, 0x25, 0x6a, 0x64, 0x92, 0x99, 0x6c, 0x7f, 0x03, 0x90, 0xaa,
+	0xb3, 0xb5, 0xb1, 0x4e, 0xef, 0xe3, 0xb3, 0x6e, 0x4b, 0x0d, 0x54, 0xe9, 0x98, 0xcf, 0xc7, 0x50,
+	0xeb, 0xb0, 0x89, 0x7d, 0xe1, 0xd4, 0xaa, 0x98, 0x10, 0xdb, 0xce, 0xb9, 0xd0, 0x99, 0xdc, 0xc8,
+	0x26, 0xd4, 0x7d, 0xdd, 0xb2, 0xb5, 0x0b, 0xfd, 0x71, 0x5c, 0x9b, 0x5b, 0x58, 0xe3, 0xce, 0xde,
+	0xce, 0xb9, 0x35, 0x7f, 0xb2, 0xb4, 0x9f, 0x83, 0xa5, 0xb3, 0xd0, 0x03, 0x12, 0x3a, 0xd2, 0x6f,
+	0xf7, 0xfa, 0x69, 0xb9, 0x8c, 0x2b, 0xbb, 0x9d, 0x73, 0x1b, 0xe9, 0x0c, 0x62, 0xef, 0xc3, 0x42,
+	0x87, 0x9d, 0xf4, 0x57, 0x3e, 0x75, 0xd2, 0x3a, 0xf1, 0x39, 0xb7, 0x73, 0x6e, 0xb3, 0x33, 0x0b,
+	0xd9, 0x12, 0x96, 0x32, 0x8f, 0xc7, 0x4d, 0xc0, 0xa3, 0x43, 0x12, 0x4e, 0xfb, 0xaf, 0xa0, 0xff,
+	0xdb, 0xa7, 0xfa, 0x9f, 0xd7, 0x95, 0xda, 0x39, 0xf7, 0x4a, 0xe7, 0xd4, 0x5d, 0x95, 0x47, 0x36,
+	0x0f, 0x0f, 0x54, 0x5f, 0xd4, 0xe7, 0x98, 0xa7, 0xe6, 0x71, 0xa2, 0xeb, 0xaa, 0x3c, 0x92, 0x59,
+	0x68, 0xa3, 0x0c, 0x45, 0xe5, 0xc4, 0xf9, 0xd3, 0x00, 0x38, 0xa2, 0xbe, 0xe4, 0x62, 0x7d, 0x77,
+	0xf7, 0x20, 0x1b, 0xba, 0x74, 0x24, 0x2d, 0xe3, 0x78, 0xe8, 0xd2, 0x71, 0xcf, 0x8c, 0x83, 0xf9,
+	0xd9, 0x71, 0xf0, 0x01, 0x40, 0x2c, 0x68, 0xc0, 0x7c, 0x22, 0x69, 0xf2, 0xbe, 0x72, 0x99, 0x52,
+	0xb5, 0xbf, 0x02, 0x78, 0xa5, 0x46, 0x6e, 0xdd, 0x8c, 0x8b, 0xa7, 0x3e, 0xdb, 0xf1, 0x5c, 0xee,
+	0x56, 0x5f, 0x1d, 0x8b, 0x6a, 0xa6, 0x89, 0x43, 0xe2, 0xd3, 0x3e, 0x0f, 0x03, 0x2a, 0x3c, 0x49,
+	0x7a, 0xf8, 0x58, 0xaa, 0x6e, 0x63, 0x0a, 0x3e, 0x24, 0x3d, 0xe7, 0x77, 0x03, 0xcc, 0xfd, 0x90,
+	0x44, 0xbb, 0x3c, 0xc0, 0xf1, 0x64, 0x88, 0x19, 0x7b, 0x24, 0x8a, 0x92, 0x33, 0x3e, 0x80, 0x09,
+	0x2f, 0xea, 0xa9, 0x6b, 0x9b, 0xf5, 0x28, 0x4a, 0xec, 0x87, 0x33, 0xd9, 0x9e, 0xdd, 0x4a, 0x94,
+	0xe9, 0x54, 0xbe, 0x2b, 0x60, 0xf1, 0x54, 0xc6, 0xa9, 0x1c, 0x4f, 0xea, 0x8a, 0xae, 0x82, 0x1a,
+	0xd5, 0x35, 0x9e, 0x4d, 0xea, 0x89, 0xfd, 0x3f, 0x28, 0x85, 0x6c, 0xc0, 0x24, 0x92, 0x52, 0x70,
+	0xf5, 0x42, 0xdd, 0x5b, 0xc4, 0x03, 0x7a, 0x2b, 0x82, 0xb2, 0xee, 0xf3, 0xb3, 0x9d, 0xa6, 0x09,
+	0xb5, 0x6d, 0x41, 0x89, 0xa4, 0xe2, 0xb0, 0x4f, 0x22, 0xcb, 0xb0, 0x2d, 0xa8, 0x67, 0xc0, 0x93,
+	0x57, 0x29, 0x09, 0xf5, 0x67, 0xf9, 0x8c, 0x26, 0x09, 0xee, 0x17, 0xb0, 0x15, 0xd1, 0x24, 0xd1,
+	0x9b, 0x45, 0xbb, 0x0a, 0x25, 0x2d, 0x96, 0x94, 0xde, 0x2e, 0x97, 0x7a, 0x55, 0xbe, 0xb5, 0x0d,
+	0xb5, 0xa9, 0x2f, 0x4d, 0x1d, 0xfa, 0x22, 0x7a, 0x19, 0xf1, 0xd7, 0x91, 0xee, 0xd1, 0xeb, 0x81,
+	0xea, 0x6b, 0x15, 0x28, 0x1c, 0xa4, 0x1d, 0x2b, 0xaf, 0x84, 0xe7, 0x69, 0x68, 0x15, 0x94, 0xb0,
+	0xc5, 0x86, 0x56, 0x11, 0x11, 0x1e, 0x58, 0xa5, 0x8d, 0xbb, 0x3f, 0x7c, 0xd1, 0x63, 0xb2, 0x9f,
+	0x76, 0x56, 0x7d, 0x3e, 0x58, 0xd3, 0x9c, 0xdd, 0x66, 0x3c, 0x93, 0xd6, 0x58, 0xa4, 0x26, 0x00,
+	0x12, 0xae, 0x21, 0x8d, 0x6b, 0x8a, 0xc6, 0xb8, 0xd3, 0x29, 0xe3, 0xea, 0xee, 0xbf, 0x03, 0x00,
+	0x72, 0xb9, 0x7b, 0xea, 0xb1, 0x0e, 0x00, 0x00,
 }
@@ -395,6 +395,7 @@ type LoadCollectionRequest struct {
 	DbID                 int64                      `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	CollectionID         int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	ReplicaNumber        int32                      `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -453,6 +454,13 @@ func (m *LoadCollectionRequest) GetSchema() *schemapb.CollectionSchema {
 	return nil
 }
 
+func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
 type ReleaseCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -973,19 +981,24 @@ func (m *GetSegmentInfoRequest) GetSegmentIDs() []int64 {
 }
 
 type SegmentInfo struct {
-	SegmentID            int64        `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64        `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64        `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	NodeID               int64        `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	MemSize              int64        `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
-	NumRows              int64        `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexName            string       `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64        `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	ChannelID            string       `protobuf:"bytes,9,opt,name=channelID,proto3" json:"channelID,omitempty"`
-	SegmentState         SegmentState `protobuf:"varint,10,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.query.SegmentState" json:"segment_state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	SegmentID    int64        `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID int64        `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64        `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NodeID       int64        `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	MemSize      int64        `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	NumRows      int64        `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName    string       `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID      int64        `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	ChannelID    string       `protobuf:"bytes,9,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	SegmentState SegmentState `protobuf:"varint,10,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.query.SegmentState" json:"segment_state,omitempty"`
+	// the replicas holding the segment, node_ids[i] is the query node of replica_ids[i]
+	ReplicaIds []int64 `protobuf:"varint,11,rep,packed,name=replica_ids,json=replicaIds,proto3" json:"replica_ids,omitempty"`
+	NodeIds    []int64 `protobuf:"varint,12,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	// the segments merged into this segment by compaction
+	CompactionFrom       []int64  `protobuf:"varint,13,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
@@ -1083,6 +1096,27 @@ func (m *SegmentInfo) GetSegmentState() SegmentState {
 	return SegmentState_None
 }
 
+func (m *SegmentInfo) GetReplicaIds() []int64 {
+	if m != nil {
+		return m.ReplicaIds
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetCompactionFrom() []int64 {
+	if m != nil {
+		return m.CompactionFrom
+	}
+	return nil
+}
+
 type GetSegmentInfoResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Infos                []*SegmentInfo   `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
@@ -1137,6 +1171,7 @@ type AddQueryChannelRequest struct {
 	CollectionID         int64             `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	RequestChannelID     string            `protobuf:"bytes,4,opt,name=request_channelID,json=requestChannelID,proto3" json:"request_channelID,omitempty"`
 	ResultChannelID      string            `protobuf:"bytes,5,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
+	ReplicaID            int64             `protobuf:"varint,6,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1202,6 +1237,13 @@ func (m *AddQueryChannelRequest) GetResultChannelID() string {
 	return ""
 }
 
+func (m *AddQueryChannelRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type RemoveQueryChannelRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1281,6 +1323,7 @@ type WatchDmChannelsRequest struct {
 	Infos                []*datapb.VchannelInfo     `protobuf:"bytes,5,rep,name=infos,proto3" json:"infos,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
 	ExcludeInfos         []*datapb.SegmentInfo      `protobuf:"bytes,7,rep,name=exclude_infos,json=excludeInfos,proto3" json:"exclude_infos,omitempty"`
+	ReplicaID            int64                      `protobuf:"varint,8,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1360,18 +1403,28 @@ func (m *WatchDmChannelsRequest) GetExcludeInfos() []*datapb.SegmentInfo {
 	return nil
 }
 
+func (m *WatchDmChannelsRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 //used for handoff task
 type SegmentLoadInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	DbID                 int64                 `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	FlushTime            int64                 `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
-	BinlogPaths          []*datapb.FieldBinlog `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
-	NumOfRows            int64                 `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	SegmentID    int64                  `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID  int64                  `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	CollectionID int64                  `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DbID         int64                  `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	FlushTime    int64                  `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
+	BinlogPaths  []*datapb.FieldBinlog  `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
+	NumOfRows    int64                  `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Deltalogs    []*datapb.DeltaLogInfo `protobuf:"bytes,8,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	// the segments merged into this segment by compaction, released once it is handed off
+	CompactionFrom       []int64  `protobuf:"varint,9,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
@@ -1448,12 +1501,28 @@ func (m *SegmentLoadInfo) GetNumOfRows() int64 {
 	return 0
 }
 
+func (m *SegmentLoadInfo) GetDeltalogs() []*datapb.DeltaLogInfo {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetCompactionFrom() []int64 {
+	if m != nil {
+		return m.CompactionFrom
+	}
+	return nil
+}
+
 type LoadSegmentsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64                      `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Infos                []*SegmentLoadInfo         `protobuf:"bytes,3,rep,name=infos,proto3" json:"infos,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 	LoadCondition        TriggerCondition           `protobuf:"varint,5,opt,name=load_condition,json=loadCondition,proto3,enum=milvus.proto.query.TriggerCondition" json:"load_condition,omitempty"`
+	SourceNodeID         int64                      `protobuf:"varint,6,opt,name=source_nodeID,json=sourceNodeID,proto3" json:"source_nodeID,omitempty"`
+	ReplicaID            int64                      `protobuf:"varint,7,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1519,6 +1588,20 @@ func (m *LoadSegmentsRequest) GetLoadCondition() TriggerCondition {
 	return TriggerCondition_handoff
 }
 
+func (m *LoadSegmentsRequest) GetSourceNodeID() int64 {
+	if m != nil {
+		return m.SourceNodeID
+	}
+	return 0
+}
+
+func (m *LoadSegmentsRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type ReleaseSegmentsRequest struct {
 	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1796,49 +1879,198 @@ func (m *CollectionInfo) GetInMemoryPercentage() int64 {
 	return 0
 }
 
-type HandoffSegments struct {
-	Base                 *commonpb.MsgBase  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Infos                []*SegmentLoadInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type ReplicaInfo struct {
+	ReplicaID            int64    `protobuf:"varint,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	NodeIds              []int64  `protobuf:"varint,3,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HandoffSegments) Reset()         { *m = HandoffSegments{} }
-func (m *HandoffSegments) String() string { return proto.CompactTextString(m) }
-func (*HandoffSegments) ProtoMessage()    {}
-func (*HandoffSegments) Descriptor() ([]byte, []int) {
+func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
+func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicaInfo) ProtoMessage()    {}
+func (*ReplicaInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
 }
 
-func (m *HandoffSegments) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HandoffSegments.Unmarshal(m, b)
+func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaInfo.Unmarshal(m, b)
+}
+func (m *ReplicaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaInfo.Marshal(b, m, deterministic)
 }
-func (m *HandoffSegments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HandoffSegments.Marshal(b, m, deterministic)
+func (m *ReplicaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaInfo.Merge(m, src)
 }
-func (m *HandoffSegments) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HandoffSegments.Merge(m, src)
+func (m *ReplicaInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplicaInfo.Size(m)
 }
-func (m *HandoffSegments) XXX_Size() int {
-	return xxx_messageInfo_HandoffSegments.Size(m)
+func (m *ReplicaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaInfo.DiscardUnknown(m)
 }
-func (m *HandoffSegments) XXX_DiscardUnknown() {
-	xxx_messageInfo_HandoffSegments.DiscardUnknown(m)
+
+var xxx_messageInfo_ReplicaInfo proto.InternalMessageInfo
+
+func (m *ReplicaInfo) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
 }
 
-var xxx_messageInfo_HandoffSegments proto.InternalMessageInfo
+func (m *ReplicaInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
 
-func (m *HandoffSegments) GetBase() *commonpb.MsgBase {
+func (m *ReplicaInfo) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+type GetReplicasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
+func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasRequest) ProtoMessage()    {}
+func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+}
+
+func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasRequest.Unmarshal(m, b)
+}
+func (m *GetReplicasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasRequest.Merge(m, src)
+}
+func (m *GetReplicasRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasRequest.Size(m)
+}
+func (m *GetReplicasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasRequest proto.InternalMessageInfo
+
+func (m *GetReplicasRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HandoffSegments) GetInfos() []*SegmentLoadInfo {
+func (m *GetReplicasRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.Infos
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetReplicasResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Replicas             []*ReplicaInfo   `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
+func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasResponse) ProtoMessage()    {}
+func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+}
+
+func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasResponse.Unmarshal(m, b)
+}
+func (m *GetReplicasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasResponse.Merge(m, src)
+}
+func (m *GetReplicasResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasResponse.Size(m)
+}
+func (m *GetReplicasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasResponse proto.InternalMessageInfo
+
+func (m *GetReplicasResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetReplicasResponse) GetReplicas() []*ReplicaInfo {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+type HandoffSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentInfos         []*SegmentInfo    `protobuf:"bytes,2,rep,name=segmentInfos,proto3" json:"segmentInfos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HandoffSegmentsRequest) Reset()         { *m = HandoffSegmentsRequest{} }
+func (m *HandoffSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*HandoffSegmentsRequest) ProtoMessage()    {}
+func (*HandoffSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+}
+
+func (m *HandoffSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffSegmentsRequest.Unmarshal(m, b)
+}
+func (m *HandoffSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *HandoffSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffSegmentsRequest.Merge(m, src)
+}
+func (m *HandoffSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_HandoffSegmentsRequest.Size(m)
+}
+func (m *HandoffSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffSegmentsRequest proto.InternalMessageInfo
+
+func (m *HandoffSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HandoffSegmentsRequest) GetSegmentInfos() []*SegmentInfo {
+	if m != nil {
+		return m.SegmentInfos
 	}
 	return nil
 }
@@ -1861,7 +2093,7 @@ func (m *LoadBalanceSegmentInfo) Reset()         { *m = LoadBalanceSegmentInfo{}
 func (m *LoadBalanceSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceSegmentInfo) ProtoMessage()    {}
 func (*LoadBalanceSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
 }
 
 func (m *LoadBalanceSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -1942,6 +2174,8 @@ type LoadBalanceRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SourceNodeIDs        []int64           `protobuf:"varint,2,rep,packed,name=source_nodeIDs,json=sourceNodeIDs,proto3" json:"source_nodeIDs,omitempty"`
 	BalanceReason        TriggerCondition  `protobuf:"varint,3,opt,name=balance_reason,json=balanceReason,proto3,enum=milvus.proto.query.TriggerCondition" json:"balance_reason,omitempty"`
+	DstNodeIDs           []int64           `protobuf:"varint,4,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
+	SealedSegmentIDs     []int64           `protobuf:"varint,5,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1951,7 +2185,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -1993,6 +2227,20 @@ func (m *LoadBalanceRequest) GetBalanceReason() TriggerCondition {
 	return TriggerCondition_handoff
 }
 
+func (m *LoadBalanceRequest) GetDstNodeIDs() []int64 {
+	if m != nil {
+		return m.DstNodeIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
+	if m != nil {
+		return m.SealedSegmentIDs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.query.PartitionState", PartitionState_name, PartitionState_value)
 	proto.RegisterEnum("milvus.proto.query.TriggerCondition", TriggerCondition_name, TriggerCondition_value)
@@ -2023,7 +2271,10 @@ func init() {
 	proto.RegisterType((*DmChannelInfo)(nil), "milvus.proto.query.DmChannelInfo")
 	proto.RegisterType((*QueryChannelInfo)(nil), "milvus.proto.query.QueryChannelInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.query.CollectionInfo")
-	proto.RegisterType((*HandoffSegments)(nil), "milvus.proto.query.HandoffSegments")
+	proto.RegisterType((*ReplicaInfo)(nil), "milvus.proto.query.ReplicaInfo")
+	proto.RegisterType((*GetReplicasRequest)(nil), "milvus.proto.query.GetReplicasRequest")
+	proto.RegisterType((*GetReplicasResponse)(nil), "milvus.proto.query.GetReplicasResponse")
+	proto.RegisterType((*HandoffSegmentsRequest)(nil), "milvus.proto.query.HandoffSegmentsRequest")
 	proto.RegisterType((*LoadBalanceSegmentInfo)(nil), "milvus.proto.query.LoadBalanceSegmentInfo")
 	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.query.LoadBalanceRequest")
 }
@@ -2031,131 +2282,146 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 1978 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x73, 0xdc, 0x48,
-	0x15, 0xb7, 0x66, 0xc6, 0xf3, 0xf1, 0xe6, 0x4b, 0xe9, 0xc4, 0x66, 0x32, 0x6c, 0xb2, 0x46, 0xd9,
-	0x6c, 0xb2, 0x5e, 0xd6, 0xde, 0x75, 0x96, 0x2a, 0x72, 0xe0, 0xb0, 0xf1, 0x6c, 0xcc, 0xc0, 0xc6,
-	0x31, 0xb2, 0x59, 0x8a, 0x54, 0xaa, 0x84, 0x46, 0x6a, 0x8f, 0x55, 0x2b, 0xa9, 0x27, 0x6a, 0x4d,
-	0x1c, 0xe7, 0xc0, 0x89, 0x1b, 0x67, 0x4e, 0x50, 0x54, 0x51, 0xc5, 0x47, 0x71, 0xe0, 0x1f, 0xe0,
-	0xb4, 0x17, 0xee, 0xfc, 0x03, 0x50, 0x45, 0xc1, 0x9d, 0x7f, 0x81, 0xea, 0x56, 0x4b, 0xa3, 0x8f,
-	0x1e, 0x7b, 0x62, 0x63, 0x92, 0xda, 0xe2, 0x36, 0xfd, 0xfa, 0xe9, 0xbd, 0xd7, 0xef, 0xe3, 0xd7,
-	0xaf, 0xdf, 0xc0, 0x95, 0x67, 0x53, 0x1c, 0x9c, 0x18, 0x16, 0x21, 0x81, 0xbd, 0x31, 0x09, 0x48,
-	0x48, 0x10, 0xf2, 0x1c, 0xf7, 0xf9, 0x94, 0x46, 0xab, 0x0d, 0xbe, 0xdf, 0x6f, 0x59, 0xc4, 0xf3,
-	0x88, 0x1f, 0xd1, 0xfa, 0xad, 0x34, 0x47, 0xbf, 0xe3, 0xf8, 0x21, 0x0e, 0x7c, 0xd3, 0x8d, 0x77,
-	0xa9, 0x75, 0x84, 0x3d, 0x53, 0xac, 0x54, 0xdb, 0x0c, 0xcd, 0xb4, 0x7c, 0xed, 0x67, 0x0a, 0xac,
-	0xee, 0x1f, 0x91, 0xe3, 0x6d, 0xe2, 0xba, 0xd8, 0x0a, 0x1d, 0xe2, 0x53, 0x1d, 0x3f, 0x9b, 0x62,
-	0x1a, 0xa2, 0x0f, 0xa1, 0x32, 0x32, 0x29, 0xee, 0x29, 0x6b, 0xca, 0xdd, 0xe6, 0xd6, 0x5b, 0x1b,
-	0x19, 0x4b, 0x84, 0x09, 0x8f, 0xe8, 0xf8, 0x81, 0x49, 0xb1, 0xce, 0x39, 0x11, 0x82, 0x8a, 0x3d,
-	0x1a, 0x0e, 0x7a, 0xa5, 0x35, 0xe5, 0x6e, 0x59, 0xe7, 0xbf, 0xd1, 0x3b, 0xd0, 0xb6, 0x12, 0xd9,
-	0xc3, 0x01, 0xed, 0x95, 0xd7, 0xca, 0x77, 0xcb, 0x7a, 0x96, 0xa8, 0xfd, 0x41, 0x81, 0xaf, 0x15,
-	0xcc, 0xa0, 0x13, 0xe2, 0x53, 0x8c, 0xee, 0x41, 0x95, 0x86, 0x66, 0x38, 0xa5, 0xc2, 0x92, 0xaf,
-	0x4b, 0x2d, 0xd9, 0xe7, 0x2c, 0xba, 0x60, 0x2d, 0xaa, 0x2d, 0x49, 0xd4, 0xa2, 0x8f, 0xe0, 0x9a,
-	0xe3, 0x3f, 0xc2, 0x1e, 0x09, 0x4e, 0x8c, 0x09, 0x0e, 0x2c, 0xec, 0x87, 0xe6, 0x18, 0xc7, 0x36,
-	0x5e, 0x8d, 0xf7, 0xf6, 0x66, 0x5b, 0xda, 0xef, 0x14, 0x58, 0x61, 0x96, 0xee, 0x99, 0x41, 0xe8,
-	0x5c, 0x82, 0xbf, 0x34, 0x68, 0xa5, 0x6d, 0xec, 0x95, 0xf9, 0x5e, 0x86, 0xc6, 0x78, 0x26, 0xb1,
-	0x7a, 0x76, 0xb6, 0x0a, 0x37, 0x37, 0x43, 0xd3, 0x7e, 0x2b, 0x02, 0x9b, 0xb6, 0xf3, 0x22, 0x0e,
-	0xcd, 0xeb, 0x2c, 0x15, 0x75, 0x9e, 0xc7, 0x9d, 0x5f, 0x2a, 0xb0, 0xf2, 0x19, 0x31, 0xed, 0x59,
-	0xe0, 0xff, 0xf7, 0xee, 0xfc, 0x0e, 0x54, 0xa3, 0x2a, 0xe9, 0x55, 0xb8, 0xae, 0xdb, 0x59, 0x5d,
-	0xa2, 0x82, 0x66, 0x16, 0xee, 0x73, 0x82, 0x2e, 0x3e, 0xd2, 0x7e, 0xa5, 0x40, 0x4f, 0xc7, 0x2e,
-	0x36, 0x29, 0x7e, 0x9d, 0xa7, 0x58, 0x85, 0xaa, 0x4f, 0x6c, 0x3c, 0x1c, 0xf0, 0x53, 0x94, 0x75,
-	0xb1, 0xd2, 0xfe, 0x25, 0x3c, 0xfc, 0x86, 0x27, 0x6c, 0x2a, 0x0a, 0xcb, 0xe7, 0x89, 0xc2, 0x97,
-	0xb3, 0x28, 0xbc, 0xe9, 0x27, 0x9d, 0x45, 0x6a, 0x39, 0x13, 0xa9, 0x1f, 0xc3, 0xf5, 0xed, 0x00,
-	0x9b, 0x21, 0xfe, 0x01, 0x83, 0xf9, 0xed, 0x23, 0xd3, 0xf7, 0xb1, 0x1b, 0x1f, 0x21, 0xaf, 0x5c,
-	0x91, 0x28, 0xef, 0x41, 0x6d, 0x12, 0x90, 0x17, 0x27, 0x89, 0xdd, 0xf1, 0x52, 0xfb, 0x8d, 0x02,
-	0x7d, 0x99, 0xec, 0x8b, 0x20, 0xc2, 0x1d, 0xe8, 0x06, 0x91, 0x71, 0x86, 0x15, 0xc9, 0xe3, 0x5a,
-	0x1b, 0x7a, 0x47, 0x90, 0x85, 0x16, 0x74, 0x1b, 0x3a, 0x01, 0xa6, 0x53, 0x77, 0xc6, 0x57, 0xe6,
-	0x7c, 0xed, 0x88, 0x2a, 0xd8, 0xb4, 0x3f, 0x2a, 0x70, 0x7d, 0x07, 0x87, 0x49, 0xf4, 0x98, 0x3a,
-	0xfc, 0x86, 0xa2, 0xeb, 0xaf, 0x15, 0xe8, 0xe6, 0x0c, 0x45, 0x6b, 0xd0, 0x4c, 0xf1, 0x88, 0x00,
-	0xa5, 0x49, 0xe8, 0xdb, 0xb0, 0xcc, 0x7c, 0x87, 0xb9, 0x49, 0x9d, 0x2d, 0x6d, 0xa3, 0x78, 0xb9,
-	0x6f, 0x64, 0xa5, 0xea, 0xd1, 0x07, 0x68, 0x13, 0xae, 0x4a, 0x90, 0x55, 0x98, 0x8f, 0x8a, 0xc0,
-	0xaa, 0xfd, 0x49, 0x81, 0xbe, 0xcc, 0x99, 0x17, 0x09, 0xf8, 0x13, 0x58, 0x4d, 0x4e, 0x63, 0xd8,
-	0x98, 0x5a, 0x81, 0x33, 0xe1, 0x65, 0xc6, 0x2f, 0x83, 0xe6, 0xd6, 0xad, 0xb3, 0xcf, 0x43, 0xf5,
-	0x95, 0x44, 0xc4, 0x20, 0x25, 0x41, 0x73, 0x60, 0x65, 0x07, 0x87, 0xfb, 0x78, 0xec, 0x61, 0x3f,
-	0x1c, 0xfa, 0x87, 0xe4, 0xfc, 0x71, 0xbf, 0x09, 0x40, 0x85, 0x9c, 0xe4, 0x9e, 0x4a, 0x51, 0xb4,
-	0xbf, 0x95, 0xa0, 0x99, 0x52, 0x84, 0xde, 0x82, 0x46, 0xb2, 0x2b, 0xa2, 0x36, 0x23, 0x14, 0x32,
-	0xa6, 0x24, 0xc9, 0x98, 0x5c, 0xe4, 0xcb, 0xc5, 0xc8, 0xcf, 0x01, 0x67, 0x74, 0x1d, 0xea, 0x1e,
-	0xf6, 0x0c, 0xea, 0xbc, 0xc4, 0x02, 0x0c, 0x6a, 0x1e, 0xf6, 0xf6, 0x9d, 0x97, 0x98, 0x6d, 0xf9,
-	0x53, 0xcf, 0x08, 0xc8, 0x31, 0xed, 0x55, 0xa3, 0x2d, 0x7f, 0xea, 0xe9, 0xe4, 0x98, 0xa2, 0x1b,
-	0x00, 0x8e, 0x6f, 0xe3, 0x17, 0x86, 0x6f, 0x7a, 0xb8, 0x57, 0xe3, 0xc5, 0xd4, 0xe0, 0x94, 0x5d,
-	0xd3, 0xc3, 0x0c, 0x06, 0xf8, 0x62, 0x38, 0xe8, 0xd5, 0xa3, 0x0f, 0xc5, 0x92, 0x1d, 0x55, 0x94,
-	0xe0, 0x70, 0xd0, 0x6b, 0x44, 0xdf, 0x25, 0x04, 0xf4, 0x29, 0xb4, 0xc5, 0xb9, 0x8d, 0x28, 0x4d,
-	0x81, 0xa7, 0xe9, 0x9a, 0x2c, 0xac, 0xc2, 0x81, 0x51, 0x92, 0xb6, 0x68, 0x6a, 0xc5, 0x5b, 0xca,
-	0x7c, 0x2c, 0x2f, 0x92, 0x76, 0xdf, 0x82, 0x65, 0xc7, 0x3f, 0x24, 0x71, 0x96, 0xbd, 0x7d, 0x8a,
-	0x39, 0x5c, 0x59, 0xc4, 0xad, 0xfd, 0x5d, 0x81, 0xd5, 0x4f, 0x6c, 0x5b, 0x86, 0xa5, 0xaf, 0x9e,
-	0x53, 0xb3, 0xf8, 0x95, 0x32, 0xf1, 0x5b, 0x04, 0x4f, 0xde, 0x87, 0x2b, 0x39, 0x9c, 0x14, 0x69,
-	0xd0, 0xd0, 0xd5, 0x2c, 0x52, 0x0e, 0x07, 0xe8, 0x3d, 0x50, 0xb3, 0x58, 0x29, 0x6e, 0x89, 0x86,
-	0xde, 0xcd, 0xa0, 0xe5, 0x70, 0xa0, 0xfd, 0x43, 0x81, 0xeb, 0x3a, 0xf6, 0xc8, 0x73, 0xfc, 0xd5,
-	0x3d, 0xe3, 0x3f, 0x4b, 0xb0, 0xfa, 0x23, 0x33, 0xb4, 0x8e, 0x06, 0x9e, 0x20, 0xd2, 0xd7, 0x73,
-	0xc0, 0x5c, 0x89, 0x57, 0x8a, 0x25, 0x9e, 0xa4, 0xe9, 0xb2, 0x2c, 0x4d, 0xd9, 0xc3, 0x6b, 0xe3,
-	0xf3, 0xf8, 0xbc, 0xb3, 0x34, 0x4d, 0xb5, 0x3d, 0xd5, 0x73, 0xb4, 0x3d, 0x68, 0x1b, 0xda, 0xf8,
-	0x85, 0xe5, 0x4e, 0x6d, 0x6c, 0x44, 0xda, 0x6b, 0x5c, 0xfb, 0x4d, 0x89, 0xf6, 0x74, 0x8d, 0xb4,
-	0xc4, 0x47, 0x43, 0x5e, 0x2a, 0x3f, 0x2f, 0x41, 0x57, 0xec, 0xb2, 0x4e, 0x71, 0x01, 0x54, 0xcc,
-	0xb9, 0xa3, 0x54, 0x74, 0xc7, 0x22, 0x4e, 0x8d, 0x6f, 0xe8, 0x4a, 0xea, 0x86, 0xbe, 0x01, 0x70,
-	0xe8, 0x4e, 0xe9, 0x91, 0x11, 0x3a, 0x5e, 0x8c, 0x89, 0x0d, 0x4e, 0x39, 0x70, 0x3c, 0x8c, 0x3e,
-	0x81, 0xd6, 0xc8, 0xf1, 0x5d, 0x32, 0x36, 0x26, 0x66, 0x78, 0xc4, 0x90, 0x71, 0xde, 0x71, 0x1f,
-	0x3a, 0xd8, 0xb5, 0x1f, 0x70, 0x5e, 0xbd, 0x19, 0x7d, 0xb3, 0xc7, 0x3e, 0x41, 0x37, 0xa1, 0xc9,
-	0x80, 0x95, 0x1c, 0x46, 0xd8, 0x5a, 0x8b, 0x54, 0xf8, 0x53, 0xef, 0xf1, 0x21, 0x43, 0x57, 0xed,
-	0xf7, 0x25, 0xb8, 0xca, 0xdc, 0x20, 0x3c, 0x72, 0x09, 0x09, 0x77, 0x3f, 0x4e, 0x95, 0xf2, 0xfc,
-	0x7b, 0x33, 0x17, 0x8f, 0x62, 0xba, 0x9c, 0xe7, 0xad, 0x82, 0xbe, 0x0f, 0x1d, 0x97, 0x98, 0xb6,
-	0x61, 0x11, 0xdf, 0xe6, 0x91, 0xe2, 0x1e, 0xee, 0x6c, 0xbd, 0x23, 0x33, 0xe1, 0x20, 0x70, 0xc6,
-	0x63, 0x1c, 0x6c, 0xc7, 0xbc, 0x7a, 0xdb, 0xe5, 0x2f, 0x35, 0xb1, 0xe4, 0x08, 0x2b, 0x5a, 0xee,
-	0xcb, 0xf3, 0x55, 0x9c, 0x23, 0xe5, 0x53, 0xba, 0xb8, 0xca, 0x02, 0x5d, 0xdc, 0xb2, 0xa4, 0x11,
-	0xcf, 0x76, 0x0a, 0xd5, 0x42, 0xa7, 0x70, 0x00, 0xed, 0x04, 0x77, 0x78, 0x51, 0xdc, 0x82, 0x76,
-	0x64, 0x96, 0xc1, 0x3c, 0x81, 0xed, 0xb8, 0x0b, 0x8f, 0x88, 0x9f, 0x71, 0x1a, 0x93, 0x9a, 0xe0,
-	0x5a, 0x74, 0x69, 0x35, 0xf4, 0x14, 0x45, 0xfb, 0x85, 0x02, 0x6a, 0x1a, 0xb1, 0xb9, 0xe4, 0x45,
-	0xda, 0xfb, 0x3b, 0xd0, 0x15, 0x03, 0xa2, 0x04, 0x36, 0x45, 0xc3, 0xfd, 0x2c, 0x2d, 0x6e, 0x80,
-	0x3e, 0x86, 0xd5, 0x88, 0xb1, 0x00, 0xb3, 0x51, 0xe3, 0x7d, 0x8d, 0xef, 0xea, 0x39, 0xac, 0xfd,
-	0x6b, 0x19, 0x3a, 0xb3, 0xc4, 0x59, 0xd8, 0xaa, 0x45, 0x06, 0x03, 0xbb, 0xa0, 0xce, 0x3a, 0x47,
-	0xde, 0x5b, 0x9c, 0x9a, 0xfb, 0xf9, 0x9e, 0xb1, 0x3b, 0xc9, 0xb5, 0xda, 0x0f, 0xa1, 0x2d, 0xce,
-	0x24, 0x50, 0xaf, 0xc2, 0x85, 0x7d, 0x43, 0x26, 0x2c, 0x13, 0x41, 0xbd, 0x95, 0x82, 0x60, 0x8a,
-	0xee, 0x43, 0x83, 0x97, 0x43, 0x78, 0x32, 0xc1, 0xa2, 0x12, 0xde, 0x92, 0xc9, 0x60, 0x91, 0x3d,
-	0x38, 0x99, 0x60, 0xbd, 0xee, 0x8a, 0x5f, 0x17, 0xc5, 0xed, 0x7b, 0xb0, 0x12, 0x44, 0xa5, 0x63,
-	0x1b, 0x19, 0xf7, 0xd5, 0xb8, 0xfb, 0xae, 0xc5, 0x9b, 0x7b, 0x69, 0x37, 0xce, 0x79, 0x05, 0xd4,
-	0xe7, 0xbe, 0x02, 0x7e, 0x0a, 0xdd, 0xef, 0x9a, 0xbe, 0x4d, 0x0e, 0x0f, 0xe3, 0x02, 0x3d, 0x47,
-	0x65, 0xde, 0xcf, 0xf6, 0x5f, 0xaf, 0x80, 0x56, 0xda, 0x2f, 0x4b, 0xb0, 0xca, 0x68, 0x0f, 0x4c,
-	0xd7, 0xf4, 0x2d, 0xbc, 0x78, 0xd7, 0xfd, 0xdf, 0xb9, 0x5f, 0x6e, 0x41, 0x9b, 0x92, 0x69, 0x60,
-	0x61, 0x23, 0xd3, 0x7c, 0xb7, 0x22, 0xe2, 0x6e, 0x04, 0x30, 0x37, 0x00, 0x6c, 0x1a, 0x1a, 0x99,
-	0x17, 0x79, 0xc3, 0xa6, 0xa1, 0xd8, 0x7e, 0x1b, 0x9a, 0x42, 0x86, 0x4d, 0x7c, 0xcc, 0x83, 0x5d,
-	0xd7, 0x21, 0x22, 0x0d, 0x88, 0xcf, 0xfb, 0x74, 0xf6, 0x3d, 0xdf, 0xad, 0xf1, 0xdd, 0x9a, 0x4d,
-	0x43, 0xbe, 0x75, 0x03, 0xe0, 0xb9, 0xe9, 0x3a, 0x36, 0x4f, 0x52, 0x1e, 0xa6, 0xba, 0xde, 0xe0,
-	0x14, 0xe6, 0x02, 0xed, 0xcf, 0x0a, 0xa0, 0x94, 0x77, 0xce, 0x8f, 0x9d, 0xb7, 0xa1, 0x93, 0x39,
-	0x67, 0x32, 0xed, 0x4c, 0x1f, 0x94, 0x32, 0xf0, 0x1f, 0x45, 0xaa, 0x8c, 0x00, 0x9b, 0x94, 0xf8,
-	0xdc, 0x69, 0x0b, 0x83, 0xff, 0x28, 0x36, 0x93, 0x7d, 0xba, 0xfe, 0x12, 0x3a, 0xd9, 0x32, 0x45,
-	0x2d, 0xa8, 0xef, 0x92, 0xf0, 0xd3, 0x17, 0x0e, 0x0d, 0xd5, 0x25, 0xd4, 0x01, 0xd8, 0x25, 0xe1,
-	0x5e, 0x80, 0x29, 0xf6, 0x43, 0x55, 0x41, 0x00, 0xd5, 0xc7, 0xfe, 0xc0, 0xa1, 0x5f, 0xa8, 0x25,
-	0x74, 0x55, 0x3c, 0x9e, 0x4d, 0x77, 0x28, 0x72, 0x56, 0x2d, 0xb3, 0xcf, 0x93, 0x55, 0x05, 0xa9,
-	0xd0, 0x4a, 0x58, 0x76, 0xf6, 0x7e, 0xa8, 0x2e, 0xa3, 0x06, 0x2c, 0x47, 0x3f, 0xab, 0xeb, 0x8f,
-	0x41, 0xcd, 0x9b, 0x87, 0x9a, 0x50, 0x3b, 0x8a, 0x52, 0x5d, 0x5d, 0x42, 0x5d, 0x68, 0xba, 0x33,
-	0xc7, 0xaa, 0x0a, 0x23, 0x8c, 0x83, 0x89, 0x25, 0x5c, 0xac, 0x96, 0x98, 0x36, 0xe6, 0xab, 0x01,
-	0x39, 0xf6, 0xd5, 0xf2, 0xfa, 0xf7, 0xa0, 0x95, 0x7e, 0xd0, 0xa0, 0x3a, 0x54, 0x76, 0x89, 0x8f,
-	0xd5, 0x25, 0x26, 0x76, 0x27, 0x20, 0xc7, 0x8e, 0x3f, 0x8e, 0xce, 0xf0, 0x30, 0x20, 0x2f, 0xb1,
-	0xaf, 0x96, 0xd8, 0x06, 0xc5, 0xa6, 0xcb, 0x36, 0xca, 0x6c, 0x83, 0x2d, 0xb0, 0xad, 0x56, 0xd6,
-	0x3f, 0x82, 0x7a, 0x0c, 0x17, 0xe8, 0x0a, 0xb4, 0x33, 0xa3, 0x37, 0x75, 0x09, 0xa1, 0xe8, 0x06,
-	0x9e, 0x01, 0x83, 0xaa, 0x6c, 0xfd, 0x1b, 0x00, 0xa2, 0x1b, 0x81, 0x90, 0xc0, 0x46, 0x13, 0x40,
-	0x3b, 0x38, 0xdc, 0x26, 0xde, 0x84, 0xf8, 0xb1, 0x49, 0x14, 0x7d, 0x98, 0x8d, 0x52, 0x32, 0xe7,
-	0x2f, 0xb2, 0x8a, 0x53, 0xf6, 0xdf, 0x9d, 0xf3, 0x45, 0x8e, 0x5d, 0x5b, 0x42, 0x1e, 0xd7, 0xc8,
-	0x1a, 0xac, 0x03, 0xc7, 0xfa, 0x22, 0x9e, 0xdb, 0x9c, 0xa2, 0x31, 0xc7, 0x1a, 0x6b, 0xcc, 0x61,
-	0x83, 0x58, 0xec, 0x87, 0x81, 0xe3, 0x8f, 0xe3, 0x47, 0xa0, 0xb6, 0x84, 0x9e, 0xc1, 0x35, 0xf6,
-	0x40, 0x0c, 0xcd, 0xd0, 0xa1, 0xa1, 0x63, 0xd1, 0x58, 0xe1, 0xd6, 0x7c, 0x85, 0x05, 0xe6, 0x57,
-	0x54, 0xe9, 0x42, 0x37, 0xf7, 0xff, 0x02, 0x5a, 0x97, 0x02, 0x99, 0xf4, 0xbf, 0x90, 0xfe, 0xfb,
-	0x0b, 0xf1, 0x26, 0xda, 0x1c, 0xe8, 0x64, 0x67, 0xef, 0xe8, 0xbd, 0x79, 0x02, 0x0a, 0xc3, 0xca,
-	0xfe, 0xfa, 0x22, 0xac, 0x89, 0xaa, 0x27, 0xd0, 0xc9, 0x4e, 0x77, 0xe5, 0xaa, 0xa4, 0x13, 0xe0,
-	0xfe, 0x69, 0xef, 0x6f, 0x6d, 0x09, 0xfd, 0x04, 0xae, 0x14, 0x46, 0xaa, 0xe8, 0x9b, 0x32, 0xf1,
-	0xf3, 0x26, 0xaf, 0x67, 0x69, 0x10, 0xd6, 0xcf, 0xbc, 0x38, 0xdf, 0xfa, 0xc2, 0x6c, 0x7d, 0x71,
-	0xeb, 0x53, 0xe2, 0x4f, 0xb3, 0xfe, 0x95, 0x35, 0x4c, 0x01, 0x15, 0x87, 0xaa, 0xe8, 0x03, 0x99,
-	0x8a, 0xb9, 0x83, 0xdd, 0xfe, 0xc6, 0xa2, 0xec, 0x49, 0xc8, 0xa7, 0xbc, 0x5a, 0xf3, 0xe3, 0x47,
-	0xa9, 0xda, 0xb9, 0xf3, 0x54, 0xb9, 0xda, 0xf9, 0x13, 0xc3, 0x28, 0xa9, 0xb3, 0x63, 0x1d, 0x79,
-	0xac, 0xa4, 0x63, 0x3c, 0x79, 0x52, 0xcb, 0xa7, 0x44, 0xda, 0x12, 0x32, 0x00, 0x76, 0x70, 0xf8,
-	0x08, 0x87, 0x81, 0x63, 0x51, 0xf4, 0xae, 0xb4, 0xc4, 0x67, 0x0c, 0xb1, 0x8e, 0x3b, 0x67, 0xf2,
-	0xc5, 0x0a, 0xb6, 0xfe, 0xd2, 0x80, 0x06, 0xf7, 0x2e, 0xbb, 0x1b, 0xff, 0x0f, 0xb8, 0x97, 0x00,
-	0xb8, 0x4f, 0xa1, 0x9b, 0x9b, 0xbe, 0xc9, 0x01, 0x57, 0x3e, 0xa2, 0x3b, 0xab, 0xf2, 0x46, 0x80,
-	0x8a, 0xa3, 0x2f, 0x79, 0x09, 0xcc, 0x1d, 0x91, 0x9d, 0xa5, 0xe3, 0x29, 0x74, 0x73, 0xa3, 0x27,
-	0xf9, 0x09, 0xe4, 0xf3, 0xa9, 0xb3, 0xa4, 0x7f, 0x0e, 0xad, 0xf4, 0x90, 0x01, 0xdd, 0x99, 0x87,
-	0x7b, 0xb9, 0xa7, 0xf5, 0xeb, 0x47, 0xbd, 0xcb, 0xbf, 0x15, 0x9e, 0x42, 0x37, 0x37, 0x57, 0x90,
-	0x7b, 0x5e, 0x3e, 0x7c, 0x38, 0x4b, 0xfa, 0x57, 0x08, 0xc7, 0x1e, 0x7c, 0xfc, 0x64, 0x6b, 0xec,
-	0x84, 0x47, 0xd3, 0x11, 0x3b, 0xe5, 0x66, 0xc4, 0xf9, 0x81, 0x43, 0xc4, 0xaf, 0xcd, 0xb8, 0xa0,
-	0x37, 0xb9, 0xa4, 0x4d, 0x6e, 0xed, 0x64, 0x34, 0xaa, 0xf2, 0xe5, 0xbd, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x70, 0xb6, 0x53, 0xcf, 0x70, 0x22, 0x00, 0x00,
+	// 2216 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0x4d, 0x6f, 0x1c, 0x49,
+	0xd5, 0x3d, 0x1f, 0x9e, 0x99, 0x37, 0x5f, 0x9d, 0x4a, 0x6c, 0x26, 0x43, 0x3e, 0x4c, 0x67, 0xf3,
+	0xb1, 0x5e, 0xd6, 0xde, 0x75, 0x16, 0x89, 0x15, 0xda, 0xc3, 0xc6, 0xb3, 0x31, 0x03, 0x89, 0x63,
+	0xda, 0x66, 0x11, 0x51, 0xa4, 0xa1, 0x67, 0xba, 0x3c, 0xee, 0xdd, 0xee, 0xae, 0x49, 0x57, 0x4f,
+	0x1c, 0xe7, 0x86, 0x84, 0x04, 0x37, 0x4e, 0x9c, 0x40, 0x48, 0x48, 0xa0, 0x15, 0x07, 0xfe, 0x03,
+	0x1c, 0xb8, 0xf3, 0x0b, 0x90, 0x90, 0x90, 0x10, 0x57, 0xce, 0x1c, 0x50, 0x7d, 0xf4, 0x77, 0x8f,
+	0x3d, 0xb6, 0xf1, 0x26, 0x42, 0xdc, 0xba, 0x5f, 0xbd, 0x7a, 0xdf, 0xf5, 0xde, 0xab, 0x57, 0x70,
+	0xe9, 0xf9, 0x14, 0x7b, 0x47, 0x83, 0x11, 0x21, 0x9e, 0xb9, 0x36, 0xf1, 0x88, 0x4f, 0x10, 0x72,
+	0x2c, 0xfb, 0xc5, 0x94, 0x8a, 0xbf, 0x35, 0xbe, 0xde, 0x6d, 0x8c, 0x88, 0xe3, 0x10, 0x57, 0xc0,
+	0xba, 0x8d, 0x38, 0x46, 0xb7, 0x65, 0xb9, 0x3e, 0xf6, 0x5c, 0xc3, 0x0e, 0x56, 0xe9, 0xe8, 0x00,
+	0x3b, 0x86, 0xfc, 0x53, 0x4d, 0xc3, 0x37, 0xe2, 0xf4, 0xb5, 0x9f, 0x28, 0xb0, 0xbc, 0x7b, 0x40,
+	0x0e, 0x37, 0x89, 0x6d, 0xe3, 0x91, 0x6f, 0x11, 0x97, 0xea, 0xf8, 0xf9, 0x14, 0x53, 0x1f, 0xbd,
+	0x07, 0xa5, 0xa1, 0x41, 0x71, 0x47, 0x59, 0x51, 0xee, 0xd5, 0x37, 0xae, 0xad, 0x25, 0x24, 0x91,
+	0x22, 0x3c, 0xa6, 0xe3, 0x07, 0x06, 0xc5, 0x3a, 0xc7, 0x44, 0x08, 0x4a, 0xe6, 0xb0, 0xdf, 0xeb,
+	0x14, 0x56, 0x94, 0x7b, 0x45, 0x9d, 0x7f, 0xa3, 0xb7, 0xa0, 0x39, 0x0a, 0x69, 0xf7, 0x7b, 0xb4,
+	0x53, 0x5c, 0x29, 0xde, 0x2b, 0xea, 0x49, 0xa0, 0xf6, 0x85, 0x02, 0x5f, 0xc9, 0x88, 0x41, 0x27,
+	0xc4, 0xa5, 0x18, 0xdd, 0x87, 0x45, 0xea, 0x1b, 0xfe, 0x94, 0x4a, 0x49, 0xbe, 0x9a, 0x2b, 0xc9,
+	0x2e, 0x47, 0xd1, 0x25, 0x6a, 0x96, 0x6d, 0x21, 0x87, 0x2d, 0x7a, 0x1f, 0xae, 0x58, 0xee, 0x63,
+	0xec, 0x10, 0xef, 0x68, 0x30, 0xc1, 0xde, 0x08, 0xbb, 0xbe, 0x31, 0xc6, 0x81, 0x8c, 0x97, 0x83,
+	0xb5, 0x9d, 0x68, 0x49, 0xfb, 0x9d, 0x02, 0x4b, 0x4c, 0xd2, 0x1d, 0xc3, 0xf3, 0xad, 0x0b, 0xb0,
+	0x97, 0x06, 0x8d, 0xb8, 0x8c, 0x9d, 0x22, 0x5f, 0x4b, 0xc0, 0x18, 0xce, 0x24, 0x60, 0xcf, 0x74,
+	0x2b, 0x71, 0x71, 0x13, 0x30, 0xed, 0xb7, 0xd2, 0xb1, 0x71, 0x39, 0xcf, 0x63, 0xd0, 0x34, 0xcf,
+	0x42, 0x96, 0xe7, 0x59, 0xcc, 0xf9, 0x0f, 0x05, 0x96, 0x1e, 0x11, 0xc3, 0x8c, 0x1c, 0xff, 0xe5,
+	0x9b, 0xf3, 0x23, 0x58, 0x14, 0xa7, 0xa4, 0x53, 0xe2, 0xbc, 0x6e, 0x27, 0x79, 0x89, 0xb5, 0xb5,
+	0x48, 0xc2, 0x5d, 0x0e, 0xd0, 0xe5, 0x26, 0x74, 0x1b, 0x5a, 0x1e, 0x9e, 0xd8, 0xd6, 0xc8, 0x18,
+	0xb8, 0x53, 0x67, 0x88, 0xbd, 0x4e, 0x79, 0x45, 0xb9, 0x57, 0xd6, 0x9b, 0x12, 0xba, 0xcd, 0x81,
+	0xda, 0xaf, 0x14, 0xe8, 0xe8, 0xd8, 0xc6, 0x06, 0xc5, 0xaf, 0x53, 0xd9, 0x65, 0x58, 0x74, 0x89,
+	0x89, 0xfb, 0x3d, 0xae, 0x6c, 0x51, 0x97, 0x7f, 0xda, 0xdf, 0xa5, 0x23, 0xde, 0xf0, 0xb8, 0x8e,
+	0x39, 0xab, 0x7c, 0x06, 0x67, 0x69, 0x7f, 0x8c, 0xbc, 0xf0, 0xa6, 0x6b, 0x1a, 0x79, 0xaa, 0x9c,
+	0xf0, 0xd4, 0x0f, 0xe1, 0xea, 0xa6, 0x87, 0x0d, 0x1f, 0x7f, 0x8f, 0x55, 0x83, 0xcd, 0x03, 0xc3,
+	0x75, 0xb1, 0x1d, 0xa8, 0x90, 0x66, 0xae, 0xe4, 0x30, 0xef, 0x40, 0x65, 0xe2, 0x91, 0x97, 0x47,
+	0xa1, 0xdc, 0xc1, 0xaf, 0xf6, 0x1b, 0x05, 0xba, 0x79, 0xb4, 0xcf, 0x93, 0x38, 0xee, 0x42, 0xdb,
+	0x13, 0xc2, 0x0d, 0x46, 0x82, 0x1e, 0xe7, 0x5a, 0xd3, 0x5b, 0x12, 0x2c, 0xb9, 0x88, 0x73, 0x44,
+	0xa7, 0x76, 0x84, 0x57, 0xe4, 0x78, 0x4d, 0x01, 0x95, 0x68, 0xda, 0xef, 0x15, 0xb8, 0xba, 0x85,
+	0xfd, 0xd0, 0x7b, 0x8c, 0x1d, 0x7e, 0x43, 0x93, 0xf0, 0xaf, 0x15, 0x68, 0xa7, 0x04, 0x45, 0x2b,
+	0x50, 0x8f, 0xe1, 0x48, 0x07, 0xc5, 0x41, 0xe8, 0x9b, 0x50, 0x66, 0xb6, 0xc3, 0x5c, 0xa4, 0xd6,
+	0x86, 0xb6, 0x96, 0xed, 0x01, 0xd6, 0x92, 0x54, 0x75, 0xb1, 0x01, 0xad, 0xc3, 0xe5, 0x9c, 0x04,
+	0x2c, 0xc5, 0x47, 0xd9, 0xfc, 0xab, 0xfd, 0x41, 0x81, 0x6e, 0x9e, 0x31, 0xcf, 0xe3, 0xf0, 0xa7,
+	0xb0, 0x1c, 0x6a, 0x33, 0x30, 0x31, 0x1d, 0x79, 0xd6, 0x84, 0x7d, 0x8b, 0x9a, 0x51, 0xdf, 0xb8,
+	0x75, 0xb2, 0x3e, 0x54, 0x5f, 0x0a, 0x49, 0xf4, 0x62, 0x14, 0x34, 0x0b, 0x96, 0xb6, 0xb0, 0xbf,
+	0x8b, 0xc7, 0x0e, 0x76, 0xfd, 0xbe, 0xbb, 0x4f, 0xce, 0xee, 0xf7, 0x1b, 0x00, 0x54, 0xd2, 0x09,
+	0xcb, 0x59, 0x0c, 0xa2, 0xfd, 0xa9, 0x08, 0xf5, 0x18, 0x23, 0x74, 0x0d, 0x6a, 0xe1, 0xaa, 0xf4,
+	0x5a, 0x04, 0xc8, 0x44, 0x4c, 0x21, 0x27, 0x62, 0x52, 0x9e, 0x2f, 0x66, 0x3d, 0x3f, 0x23, 0x39,
+	0xa3, 0xab, 0x50, 0x75, 0xb0, 0x33, 0xa0, 0xd6, 0x2b, 0x2c, 0x93, 0x41, 0xc5, 0xc1, 0xce, 0xae,
+	0xf5, 0x0a, 0xb3, 0x25, 0x77, 0xea, 0x0c, 0x3c, 0x72, 0x48, 0x3b, 0x8b, 0x62, 0xc9, 0x9d, 0x3a,
+	0x3a, 0x39, 0xa4, 0xe8, 0x3a, 0x80, 0xe5, 0x9a, 0xf8, 0xe5, 0xc0, 0x35, 0x1c, 0xdc, 0xa9, 0xf0,
+	0xc3, 0x54, 0xe3, 0x90, 0x6d, 0xc3, 0xc1, 0x2c, 0x0d, 0xf0, 0x9f, 0x7e, 0xaf, 0x53, 0x15, 0x1b,
+	0xe5, 0x2f, 0x53, 0x55, 0x1e, 0xc1, 0x7e, 0xaf, 0x53, 0x13, 0xfb, 0x42, 0x00, 0xfa, 0x04, 0x9a,
+	0x52, 0xef, 0x81, 0x08, 0x53, 0xe0, 0x61, 0xba, 0x92, 0xe7, 0x56, 0x69, 0x40, 0x11, 0xa4, 0x0d,
+	0x1a, 0xfb, 0x43, 0x37, 0xa1, 0x1e, 0x94, 0x4d, 0xcb, 0xa4, 0x9d, 0xba, 0x70, 0x80, 0x04, 0xf5,
+	0x4d, 0xca, 0x35, 0x23, 0x26, 0xe6, 0xab, 0x0d, 0xbe, 0x5a, 0xe1, 0xe6, 0x30, 0x29, 0xba, 0x03,
+	0xad, 0x11, 0x71, 0x26, 0x06, 0xb7, 0xec, 0x43, 0x8f, 0x38, 0x9d, 0x26, 0x47, 0x48, 0x41, 0x79,
+	0x77, 0x9b, 0x8e, 0x97, 0xf3, 0x84, 0xf6, 0x37, 0xa0, 0x6c, 0xb9, 0xfb, 0x24, 0x88, 0xe4, 0x9b,
+	0xc7, 0xa8, 0xcc, 0x99, 0x09, 0x6c, 0xed, 0xdf, 0x0a, 0x2c, 0x7f, 0x6c, 0x9a, 0x79, 0xf9, 0xfa,
+	0xf4, 0x71, 0x1b, 0xc5, 0x48, 0x21, 0x11, 0x23, 0xf3, 0xe4, 0xac, 0x77, 0xe0, 0x52, 0x2a, 0x17,
+	0xcb, 0x50, 0xab, 0xe9, 0x6a, 0x32, 0x1b, 0xf7, 0x7b, 0xe8, 0x6d, 0x50, 0x93, 0xf9, 0x58, 0x56,
+	0xa2, 0x9a, 0xde, 0x4e, 0x64, 0x64, 0x11, 0x30, 0x81, 0xe3, 0x7a, 0x32, 0x0a, 0x23, 0x80, 0xf6,
+	0x37, 0x05, 0xae, 0xea, 0xd8, 0x21, 0x2f, 0xf0, 0xff, 0xac, 0x05, 0xb4, 0x1f, 0x17, 0x61, 0xf9,
+	0x07, 0x86, 0x3f, 0x3a, 0xe8, 0x39, 0x12, 0x48, 0x5f, 0x8f, 0x82, 0xa9, 0x24, 0x53, 0xca, 0x26,
+	0x99, 0x30, 0x88, 0xcb, 0x79, 0x41, 0xcc, 0x6e, 0x88, 0x6b, 0x9f, 0x06, 0xfa, 0x46, 0x41, 0x1c,
+	0x6b, 0xbc, 0x16, 0xcf, 0xd2, 0x25, 0x6f, 0x42, 0x13, 0xbf, 0x1c, 0xd9, 0x53, 0x76, 0xa0, 0x39,
+	0xf7, 0x0a, 0xe7, 0x7e, 0x23, 0x87, 0x7b, 0xfc, 0x04, 0x35, 0xe4, 0xa6, 0x3e, 0x97, 0x21, 0x11,
+	0x67, 0xd5, 0x74, 0x9c, 0xfd, 0xab, 0x00, 0x6d, 0xb9, 0x97, 0x75, 0xb2, 0x73, 0x64, 0xed, 0x94,
+	0xb1, 0x0a, 0x59, 0x63, 0xcd, 0x63, 0xf2, 0xa0, 0x83, 0x28, 0xc5, 0x3a, 0x88, 0xeb, 0x00, 0xfb,
+	0xf6, 0x94, 0x1e, 0x0c, 0x7c, 0xcb, 0x09, 0x72, 0x76, 0x8d, 0x43, 0xf6, 0x2c, 0x07, 0xa3, 0x8f,
+	0xa1, 0x31, 0xb4, 0x5c, 0x9b, 0x8c, 0x07, 0x13, 0xc3, 0x3f, 0x60, 0x99, 0x7b, 0x96, 0x31, 0x1e,
+	0x5a, 0xd8, 0x36, 0x1f, 0x70, 0x5c, 0xbd, 0x2e, 0xf6, 0xec, 0xb0, 0x2d, 0xe8, 0x06, 0xd4, 0x59,
+	0xe2, 0x27, 0xfb, 0x22, 0xf7, 0x57, 0x04, 0x0b, 0x77, 0xea, 0x3c, 0xd9, 0xe7, 0xd9, 0xff, 0x23,
+	0xa8, 0x99, 0xd8, 0xf6, 0x0d, 0x9b, 0x8c, 0x69, 0xa7, 0x3a, 0xd3, 0xd5, 0x3d, 0x86, 0xf3, 0x88,
+	0x8c, 0xb9, 0xb5, 0xa3, 0x1d, 0x39, 0x29, 0xb6, 0x96, 0x9b, 0x62, 0xff, 0x59, 0x80, 0xcb, 0xcc,
+	0xda, 0xd2, 0xf0, 0x17, 0x10, 0xf5, 0x1f, 0x06, 0xf1, 0x5a, 0x9c, 0xdd, 0x3e, 0xa4, 0xdc, 0x9e,
+	0x8d, 0xd9, 0x33, 0xdd, 0xec, 0xbe, 0x0b, 0x2d, 0x9b, 0x18, 0xe6, 0x60, 0x44, 0x5c, 0x93, 0x07,
+	0x04, 0x77, 0x64, 0x6b, 0xe3, 0xad, 0x3c, 0x11, 0xf6, 0x3c, 0x6b, 0x3c, 0xc6, 0xde, 0x66, 0x80,
+	0xab, 0x37, 0x6d, 0x7e, 0xaf, 0x95, 0xbf, 0xe8, 0x16, 0x34, 0x29, 0x99, 0x7a, 0x23, 0x3c, 0x90,
+	0x5a, 0x8a, 0x3c, 0xd9, 0x10, 0xc0, 0x6d, 0xa1, 0x6b, 0x22, 0xc0, 0x2b, 0xe9, 0x00, 0xff, 0xab,
+	0x02, 0xcb, 0xf2, 0xf2, 0x72, 0x71, 0xe6, 0x0e, 0xa2, 0xb9, 0x78, 0x4c, 0x3f, 0x5c, 0x9a, 0xa3,
+	0x1f, 0x2e, 0xe7, 0x5c, 0x69, 0x92, 0x3d, 0xd7, 0x62, 0xa6, 0xe7, 0xda, 0x83, 0x66, 0x98, 0x3f,
+	0xf9, 0xf1, 0xbd, 0x05, 0x4d, 0x21, 0xd6, 0x80, 0x19, 0x13, 0x9b, 0xc1, 0x7d, 0x46, 0x00, 0x1f,
+	0x71, 0x18, 0xa3, 0x1a, 0xe6, 0x67, 0x51, 0x9a, 0x6b, 0x7a, 0x0c, 0xa2, 0xfd, 0x42, 0x01, 0x35,
+	0x5e, 0x79, 0x38, 0xe5, 0x79, 0x2e, 0x4a, 0x77, 0xa1, 0x2d, 0x27, 0x72, 0x61, 0xfa, 0x97, 0x57,
+	0x97, 0xe7, 0x71, 0x72, 0x3d, 0xf4, 0x01, 0x2c, 0x0b, 0xc4, 0x4c, 0xb9, 0x10, 0x57, 0x98, 0x2b,
+	0x7c, 0x55, 0x4f, 0xd5, 0x8c, 0xbf, 0x14, 0xa1, 0x15, 0xc5, 0xde, 0xdc, 0x52, 0xcd, 0x33, 0x89,
+	0xd9, 0x06, 0x35, 0xea, 0xc1, 0x79, 0x97, 0x76, 0xec, 0xf1, 0x49, 0x77, 0xdf, 0xed, 0x49, 0x12,
+	0x80, 0x1e, 0x42, 0x53, 0xea, 0x24, 0xb3, 0x77, 0x89, 0x13, 0xfb, 0x5a, 0x1e, 0xb1, 0x84, 0x07,
+	0xf5, 0x46, 0xac, 0x94, 0x50, 0xf4, 0x21, 0xd4, 0xf8, 0x89, 0xf2, 0x8f, 0x26, 0x58, 0x1e, 0xa6,
+	0x6b, 0x79, 0x34, 0x98, 0x67, 0xf7, 0x8e, 0x26, 0x58, 0xaf, 0xda, 0xf2, 0xeb, 0xbc, 0xf5, 0xe7,
+	0x3e, 0x2c, 0x79, 0xe2, 0xe8, 0x98, 0x83, 0x84, 0xf9, 0x2a, 0xdc, 0x7c, 0x57, 0x82, 0xc5, 0x9d,
+	0xb8, 0x19, 0x67, 0xdc, 0xa7, 0xaa, 0x33, 0xef, 0x53, 0x9f, 0x41, 0x5d, 0x97, 0xc7, 0x55, 0x56,
+	0x9f, 0xe8, 0x38, 0x2b, 0xa9, 0xe3, 0x3c, 0xd7, 0x9d, 0x21, 0xde, 0x04, 0x17, 0x13, 0x4d, 0xb0,
+	0xf6, 0x19, 0xa0, 0x2d, 0xec, 0x4b, 0x76, 0xe7, 0x48, 0x04, 0x73, 0x88, 0xa1, 0xfd, 0x54, 0x81,
+	0xcb, 0x09, 0x66, 0xe7, 0xe9, 0xa2, 0xbf, 0x05, 0x55, 0x69, 0x84, 0x63, 0x1b, 0xe9, 0x98, 0x21,
+	0xf5, 0x70, 0x83, 0xf6, 0x73, 0x05, 0x96, 0xbf, 0x6d, 0xb8, 0x26, 0xd9, 0xdf, 0x3f, 0x7f, 0x0e,
+	0xdc, 0x84, 0xe0, 0x4e, 0xd2, 0x3f, 0x4d, 0x5b, 0x9f, 0xd8, 0xa4, 0xfd, 0xb2, 0x00, 0xcb, 0x2c,
+	0x5e, 0x1f, 0x18, 0xb6, 0xe1, 0x8e, 0xf0, 0xfc, 0x77, 0xc6, 0xff, 0x4e, 0xf7, 0x91, 0xa9, 0x2b,
+	0xa5, 0x9c, 0xba, 0x72, 0x1d, 0xc0, 0xa4, 0xfe, 0x20, 0x31, 0x4f, 0xaa, 0x99, 0xd4, 0x97, 0xcb,
+	0x37, 0xa1, 0x2e, 0x69, 0x98, 0xc4, 0xc5, 0xfc, 0x80, 0x55, 0x75, 0x10, 0xa0, 0x1e, 0x71, 0xf9,
+	0x2d, 0x93, 0xed, 0xe7, 0xab, 0x15, 0xbe, 0x5a, 0x31, 0xa9, 0xcf, 0x97, 0xae, 0x03, 0xbc, 0x30,
+	0x6c, 0xcb, 0xe4, 0x89, 0x81, 0x1f, 0x8d, 0xaa, 0x5e, 0xe3, 0x10, 0x66, 0x02, 0xed, 0x67, 0x05,
+	0x40, 0x31, 0xeb, 0x9c, 0xdd, 0x57, 0xb7, 0xa1, 0x95, 0xd0, 0x33, 0x1c, 0xe9, 0xc7, 0x15, 0xa5,
+	0xac, 0x66, 0x0f, 0x05, 0xab, 0x81, 0x87, 0x0d, 0x4a, 0xdc, 0x4e, 0xf1, 0x34, 0x35, 0x7b, 0x18,
+	0x88, 0xc9, 0xb6, 0x32, 0xbb, 0x44, 0x66, 0x0b, 0x46, 0x3c, 0x10, 0xda, 0x8d, 0xb2, 0xeb, 0x04,
+	0xc5, 0x86, 0x8d, 0xcd, 0x41, 0xac, 0xae, 0x89, 0xca, 0xa7, 0x8a, 0x85, 0xdd, 0x10, 0xbe, 0xfa,
+	0x0a, 0x5a, 0xc9, 0x44, 0x8b, 0x1a, 0x50, 0xdd, 0x26, 0xfe, 0x27, 0x2f, 0x2d, 0xea, 0xab, 0x0b,
+	0xa8, 0x05, 0xb0, 0x4d, 0xfc, 0x1d, 0x0f, 0x53, 0xec, 0xfa, 0xaa, 0x82, 0x00, 0x16, 0x9f, 0xb8,
+	0x3d, 0x8b, 0x7e, 0xae, 0x16, 0xd0, 0x65, 0x39, 0x48, 0x32, 0xec, 0xbe, 0xcc, 0x3a, 0x6a, 0x91,
+	0x6d, 0x0f, 0xff, 0x4a, 0x48, 0x85, 0x46, 0x88, 0xb2, 0xb5, 0xf3, 0x7d, 0xb5, 0x8c, 0x6a, 0x50,
+	0x16, 0x9f, 0x8b, 0xab, 0x4f, 0x40, 0x4d, 0x2b, 0x8b, 0xea, 0x50, 0x39, 0x10, 0x27, 0x49, 0x5d,
+	0x40, 0x6d, 0xa8, 0xdb, 0x91, 0x9b, 0x54, 0x85, 0x01, 0xc6, 0xde, 0x64, 0x24, 0x1d, 0xa6, 0x16,
+	0x18, 0x37, 0x66, 0x88, 0x1e, 0x39, 0x74, 0xd5, 0xe2, 0xea, 0x77, 0xa0, 0x11, 0xbf, 0xdc, 0xa3,
+	0x2a, 0x94, 0xb6, 0x89, 0x8b, 0xd5, 0x05, 0x46, 0x76, 0xcb, 0x23, 0x87, 0x96, 0x3b, 0x16, 0x3a,
+	0x3c, 0xf4, 0xc8, 0x2b, 0xec, 0xaa, 0x05, 0xb6, 0xc0, 0x6c, 0xc2, 0x16, 0x8a, 0x6c, 0x41, 0x18,
+	0x48, 0x2d, 0xad, 0xbe, 0x0f, 0xd5, 0x20, 0xe1, 0xa3, 0x4b, 0xd0, 0x4c, 0x8c, 0xa1, 0xd5, 0x05,
+	0x84, 0x44, 0x1b, 0x16, 0xa5, 0x76, 0x55, 0xd9, 0xf8, 0xa2, 0x01, 0x20, 0x6a, 0x3a, 0x7b, 0xcc,
+	0x42, 0x13, 0x9e, 0x0b, 0x37, 0x89, 0x33, 0x21, 0x6e, 0x20, 0x12, 0x45, 0xef, 0x25, 0x7d, 0x1e,
+	0x3e, 0x8d, 0x65, 0x51, 0xa5, 0x96, 0xdd, 0x3b, 0x33, 0x76, 0xa4, 0xd0, 0xb5, 0x05, 0xe4, 0x70,
+	0x8e, 0xac, 0x99, 0xdf, 0xb3, 0x46, 0x9f, 0x07, 0x33, 0xcc, 0x63, 0x38, 0xa6, 0x50, 0x03, 0x8e,
+	0xa9, 0x7a, 0x2c, 0x7f, 0x76, 0x7d, 0xcf, 0x72, 0xc7, 0x41, 0x9a, 0xd5, 0x16, 0xd0, 0x73, 0xb8,
+	0xc2, 0x06, 0x19, 0xbe, 0xe1, 0x5b, 0xd4, 0xb7, 0x46, 0x34, 0x60, 0xb8, 0x31, 0x9b, 0x61, 0x06,
+	0xf9, 0x94, 0x2c, 0x6d, 0x68, 0xa7, 0x9e, 0xe4, 0xd0, 0x6a, 0x6e, 0x66, 0xcc, 0x7d, 0x3e, 0xec,
+	0xbe, 0x33, 0x17, 0x6e, 0xc8, 0xcd, 0x82, 0x56, 0xf2, 0xb9, 0x0a, 0xbd, 0x3d, 0x8b, 0x40, 0x66,
+	0x70, 0xdf, 0x5d, 0x9d, 0x07, 0x35, 0x64, 0xf5, 0x14, 0x5a, 0xc9, 0x97, 0x8e, 0x7c, 0x56, 0xb9,
+	0xaf, 0x21, 0xdd, 0xe3, 0x2a, 0x9c, 0xb6, 0x80, 0x7e, 0x04, 0x97, 0x32, 0xcf, 0x0b, 0xe8, 0xeb,
+	0xf9, 0xe5, 0x2d, 0xff, 0x15, 0xe2, 0x24, 0x0e, 0x52, 0xfa, 0xc8, 0x8a, 0xb3, 0xa5, 0xcf, 0xbc,
+	0x33, 0xcd, 0x2f, 0x7d, 0x8c, 0xfc, 0x71, 0xd2, 0x9f, 0x9a, 0xc3, 0x14, 0x50, 0xf6, 0x81, 0x01,
+	0xbd, 0x9b, 0xc7, 0x62, 0xe6, 0x23, 0x47, 0x77, 0x6d, 0x5e, 0xf4, 0xd0, 0xe5, 0x53, 0x7e, 0x5a,
+	0xd3, 0xa3, 0xf8, 0x5c, 0xb6, 0x33, 0xdf, 0x16, 0xba, 0x6b, 0xf3, 0xa2, 0xc7, 0x83, 0x3a, 0x39,
+	0x7e, 0xcc, 0xf7, 0x55, 0xee, 0x48, 0xbb, 0xbb, 0x3a, 0x0f, 0x6a, 0xc8, 0x6a, 0x0f, 0xea, 0xb1,
+	0x32, 0x8b, 0xee, 0xcc, 0x8a, 0x89, 0x64, 0x1d, 0x3e, 0x39, 0x20, 0xea, 0xb1, 0xb6, 0x2f, 0x9f,
+	0x6a, 0xb6, 0x09, 0xed, 0xde, 0x3d, 0x11, 0x2f, 0x94, 0x7b, 0x00, 0xb0, 0x85, 0xfd, 0xc7, 0xd8,
+	0xf7, 0xac, 0x51, 0x86, 0x81, 0xfc, 0x89, 0x10, 0x66, 0x30, 0xc8, 0xc1, 0x0b, 0x18, 0x6c, 0xfc,
+	0xb9, 0x06, 0x35, 0x1e, 0x15, 0xac, 0x66, 0xff, 0xbf, 0x50, 0x5c, 0x40, 0xa1, 0x78, 0x06, 0xed,
+	0xd4, 0x74, 0x3b, 0xbf, 0x50, 0xe4, 0x8f, 0xc0, 0x4f, 0x0a, 0xc1, 0x21, 0xa0, 0xec, 0xf0, 0x38,
+	0xff, 0xe8, 0xce, 0x1c, 0x32, 0x9f, 0xc4, 0xe3, 0x19, 0xb4, 0x53, 0xc3, 0xdb, 0x7c, 0x0d, 0xf2,
+	0x27, 0xbc, 0x27, 0x51, 0xff, 0x14, 0x1a, 0xf1, 0x09, 0x19, 0xba, 0x3b, 0xeb, 0x6c, 0xa6, 0x2e,
+	0x34, 0xaf, 0x3f, 0x5b, 0x5f, 0x7c, 0x35, 0x7b, 0x06, 0xed, 0xd4, 0x44, 0x2b, 0xdf, 0xf2, 0xf9,
+	0x63, 0xaf, 0x93, 0xa8, 0x7f, 0x89, 0xf9, 0xf7, 0xa2, 0xf3, 0xd8, 0x83, 0x0f, 0x9e, 0x6e, 0x8c,
+	0x2d, 0xff, 0x60, 0x3a, 0x64, 0x5a, 0xae, 0x0b, 0xcc, 0x77, 0x2d, 0x22, 0xbf, 0xd6, 0x83, 0x03,
+	0xbd, 0xce, 0x29, 0xad, 0x73, 0x69, 0x27, 0xc3, 0xe1, 0x22, 0xff, 0xbd, 0xff, 0x9f, 0x01, 0x00,
+	0xe2, 0xc2, 0x48, 0x23, 0x5b, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2182,6 +2448,8 @@ type QueryCoordClient interface {
 	CreateQueryChannel(ctx context.Context, in *CreateQueryChannelRequest, opts ...grpc.CallOption) (*CreateQueryChannelResponse, error)
 	GetPartitionStates(ctx context.Context, in *GetPartitionStatesRequest, opts ...grpc.CallOption) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetReplicas(ctx context.Context, in *GetReplicasRequest, opts ...grpc.CallOption) (*GetReplicasResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2302,6 +2570,24 @@ func (c *queryCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInf
 	return out, nil
 }
 
+func (c *queryCoordClient) LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/LoadBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetReplicas(ctx context.Context, in *GetReplicasRequest, opts ...grpc.CallOption) (*GetReplicasResponse, error) {
+	out := new(GetReplicasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetMetrics", in, out, opts...)
@@ -2325,6 +2611,8 @@ type QueryCoordServer interface {
 	CreateQueryChannel(context.Context, *CreateQueryChannelRequest) (*CreateQueryChannelResponse, error)
 	GetPartitionStates(context.Context, *GetPartitionStatesRequest) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	LoadBalance(context.Context, *LoadBalanceRequest) (*commonpb.Status, error)
+	GetReplicas(context.Context, *GetReplicasRequest) (*GetReplicasResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2369,6 +2657,12 @@ func (*UnimplementedQueryCoordServer) GetPartitionStates(ctx context.Context, re
 func (*UnimplementedQueryCoordServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
 }
+func (*UnimplementedQueryCoordServer) LoadBalance(ctx context.Context, req *LoadBalanceRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadBalance not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetReplicas(ctx context.Context, req *GetReplicasRequest) (*GetReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicas not implemented")
+}
 func (*UnimplementedQueryCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -2593,6 +2887,42 @@ func _QueryCoord_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_LoadBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).LoadBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/LoadBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).LoadBalance(ctx, req.(*LoadBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetReplicas(ctx, req.(*GetReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -2663,6 +2993,14 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSegmentInfo",
 			Handler:    _QueryCoord_GetSegmentInfo_Handler,
 		},
+		{
+			MethodName: "LoadBalance",
+			Handler:    _QueryCoord_LoadBalance_Handler,
+		},
+		{
+			MethodName: "GetReplicas",
+			Handler:    _QueryCoord_GetReplicas_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _QueryCoord_GetMetrics_Handler,
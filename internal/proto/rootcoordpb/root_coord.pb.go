@@ -233,69 +233,202 @@ func (m *AllocIDResponse) GetCount() uint32 {
 	return 0
 }
 
+type GetCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCredentialRequest) Reset()         { *m = GetCredentialRequest{} }
+func (m *GetCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialRequest) ProtoMessage()    {}
+func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{4}
+}
+
+func (m *GetCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialRequest.Unmarshal(m, b)
+}
+func (m *GetCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialRequest.Merge(m, src)
+}
+func (m *GetCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialRequest.Size(m)
+}
+func (m *GetCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialRequest proto.InternalMessageInfo
+
+func (m *GetCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type GetCredentialResponse struct {
+	Status   *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Username string           `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// encrypted by bcrypt
+	EncryptedPassword string `protobuf:"bytes,3,opt,name=encrypted_password,json=encryptedPassword,proto3" json:"encrypted_password,omitempty"`
+	// privileges granted to the user through its roles
+	Grants               []*milvuspb.GrantEntity `protobuf:"bytes,4,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetCredentialResponse) Reset()         { *m = GetCredentialResponse{} }
+func (m *GetCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialResponse) ProtoMessage()    {}
+func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{5}
+}
+
+func (m *GetCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialResponse.Unmarshal(m, b)
+}
+func (m *GetCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialResponse.Merge(m, src)
+}
+func (m *GetCredentialResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialResponse.Size(m)
+}
+func (m *GetCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialResponse proto.InternalMessageInfo
+
+func (m *GetCredentialResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCredentialResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetCredentialResponse) GetEncryptedPassword() string {
+	if m != nil {
+		return m.EncryptedPassword
+	}
+	return ""
+}
+
+func (m *GetCredentialResponse) GetGrants() []*milvuspb.GrantEntity {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
 	proto.RegisterType((*AllocIDResponse)(nil), "milvus.proto.rootcoord.AllocIDResponse")
+	proto.RegisterType((*GetCredentialRequest)(nil), "milvus.proto.rootcoord.GetCredentialRequest")
+	proto.RegisterType((*GetCredentialResponse)(nil), "milvus.proto.rootcoord.GetCredentialResponse")
 }
 
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdb, 0x4f, 0xe3, 0x46,
-	0x14, 0xc6, 0x49, 0xa0, 0x54, 0x1c, 0x72, 0x41, 0x23, 0xa0, 0x28, 0xe5, 0x81, 0xa6, 0x2a, 0x24,
-	0x5c, 0x1c, 0x04, 0x52, 0xd5, 0x57, 0x48, 0x54, 0x88, 0xd4, 0x48, 0xc5, 0x01, 0x69, 0x6f, 0x28,
-	0x9a, 0x38, 0x47, 0x89, 0x85, 0xed, 0x31, 0x9e, 0xc9, 0xc2, 0x3e, 0xee, 0x5f, 0xb6, 0xff, 0xda,
-	0xca, 0x97, 0x71, 0x6c, 0xc7, 0x0e, 0x8e, 0x76, 0xdf, 0xe2, 0xf8, 0x37, 0xdf, 0x37, 0xe7, 0x3b,
-	0x1e, 0xfb, 0xc0, 0x96, 0xc3, 0x98, 0x18, 0x68, 0x8c, 0x39, 0x23, 0xc5, 0x76, 0x98, 0x60, 0x64,
-	0xd7, 0xd4, 0x8d, 0xcf, 0x53, 0xee, 0x5f, 0x29, 0xee, 0x6d, 0xef, 0x6e, 0xad, 0xa4, 0x31, 0xd3,
-	0x64, 0x96, 0xff, 0x7f, 0xad, 0x14, 0xa5, 0x6a, 0x15, 0xdd, 0x12, 0xe8, 0x58, 0xd4, 0x08, 0xae,
-	0x37, 0x6d, 0x87, 0xbd, 0x7e, 0x09, 0x2e, 0xb6, 0x46, 0x54, 0xd0, 0xa8, 0x45, 0x7d, 0x00, 0x3b,
-	0x57, 0x86, 0xc1, 0xb4, 0x7b, 0xdd, 0x44, 0x2e, 0xa8, 0x69, 0xab, 0xf8, 0x3c, 0x45, 0x2e, 0xc8,
-	0x39, 0xac, 0x0d, 0x29, 0xc7, 0xbd, 0xc2, 0x41, 0xa1, 0xb1, 0x79, 0xb1, 0xaf, 0xc4, 0xb6, 0x12,
-	0xf8, 0xf7, 0xf8, 0xf8, 0x9a, 0x72, 0x54, 0x3d, 0x92, 0x6c, 0xc3, 0x2f, 0x1a, 0x9b, 0x5a, 0x62,
-	0x6f, 0xf5, 0xa0, 0xd0, 0x28, 0xab, 0xfe, 0x45, 0xfd, 0x6b, 0x01, 0x76, 0x93, 0x0e, 0xdc, 0x66,
-	0x16, 0x47, 0x72, 0x09, 0xeb, 0x5c, 0x50, 0x31, 0xe5, 0x81, 0xc9, 0xef, 0xa9, 0x26, 0x7d, 0x0f,
-	0x51, 0x03, 0x94, 0xec, 0xc3, 0x86, 0x90, 0x4a, 0x7b, 0xc5, 0x83, 0x42, 0x63, 0x4d, 0x9d, 0xfd,
-	0x91, 0xb1, 0x87, 0x77, 0x50, 0xf1, 0xb6, 0xd0, 0xed, 0xfc, 0x84, 0xea, 0x8a, 0x51, 0x65, 0x03,
-	0xaa, 0xa1, 0xf2, 0x8f, 0x54, 0x55, 0x81, 0x62, 0xb7, 0xe3, 0x49, 0xaf, 0xaa, 0xc5, 0x6e, 0x27,
-	0xbd, 0x8e, 0x8b, 0x6f, 0xdb, 0xb0, 0xa1, 0x32, 0x26, 0xda, 0x6e, 0x03, 0x89, 0x0d, 0xe4, 0x06,
-	0x45, 0x9b, 0x99, 0x36, 0xb3, 0xd0, 0x12, 0xae, 0x22, 0x72, 0x72, 0x1e, 0xb7, 0x0b, 0x9f, 0x86,
-	0x79, 0x34, 0xc8, 0xa2, 0x76, 0x98, 0xb1, 0x22, 0x81, 0xd7, 0x57, 0x88, 0xe9, 0x39, 0xba, 0x8d,
-	0xbc, 0xd7, 0xb5, 0xa7, 0xf6, 0x84, 0x5a, 0x16, 0x1a, 0x8b, 0x1c, 0x13, 0xa8, 0x74, 0xfc, 0x33,
-	0xbe, 0x22, 0xb8, 0xe8, 0x0b, 0x47, 0xb7, 0xc6, 0x32, 0xc7, 0xfa, 0x0a, 0x79, 0x86, 0xed, 0x1b,
-	0xf4, 0xdc, 0x75, 0x2e, 0x74, 0x8d, 0x4b, 0xc3, 0x8b, 0x6c, 0xc3, 0x39, 0x78, 0x49, 0xcb, 0x01,
-	0x6c, 0xb5, 0x1d, 0xa4, 0x02, 0xdb, 0xcc, 0x30, 0x50, 0x13, 0x3a, 0xb3, 0xc8, 0x69, 0xea, 0xd2,
-	0x24, 0x26, 0x8d, 0x16, 0xb5, 0xbb, 0xbe, 0x42, 0x3e, 0x42, 0xa5, 0xe3, 0x30, 0x3b, 0x22, 0x7f,
-	0x9c, 0x2a, 0x1f, 0x87, 0x72, 0x8a, 0x0f, 0xa0, 0x7c, 0x4b, 0x79, 0x44, 0xbb, 0x99, 0xaa, 0x1d,
-	0x63, 0xa4, 0xf4, 0x1f, 0xa9, 0xe8, 0x35, 0x63, 0x46, 0x24, 0x9e, 0x17, 0x20, 0x1d, 0xe4, 0x9a,
-	0xa3, 0x0f, 0xa3, 0x01, 0x29, 0xe9, 0x15, 0xcc, 0x81, 0xd2, 0xaa, 0x95, 0x9b, 0x0f, 0x8d, 0x1f,
-	0x60, 0xd3, 0x0f, 0xfc, 0xca, 0xd0, 0x29, 0x27, 0x47, 0x0b, 0x5a, 0xe2, 0x11, 0x39, 0x03, 0xbb,
-	0x83, 0x0d, 0x37, 0x68, 0x5f, 0xf4, 0xaf, 0xcc, 0x46, 0x2c, 0x23, 0xd9, 0x07, 0xb8, 0x32, 0x04,
-	0x3a, 0xbe, 0xe6, 0x61, 0xaa, 0xe6, 0x0c, 0xc8, 0x29, 0x6a, 0x41, 0xb5, 0x3f, 0x61, 0x2f, 0xb3,
-	0x68, 0x38, 0x39, 0x49, 0x7f, 0xa0, 0xe3, 0x94, 0x94, 0x3f, 0xcd, 0x07, 0x87, 0x71, 0x3f, 0x42,
-	0xd5, 0x0f, 0xf3, 0x7f, 0xea, 0x08, 0xdd, 0x6b, 0xf2, 0xc9, 0x82, 0xc8, 0x43, 0x2a, 0x67, 0x39,
-	0xef, 0xa1, 0xec, 0xc6, 0x3a, 0x13, 0x6f, 0x66, 0x46, 0xbf, 0xac, 0xf4, 0x23, 0x94, 0x6e, 0x29,
-	0x9f, 0x29, 0x37, 0xb2, 0x4e, 0xc0, 0x9c, 0x70, 0xae, 0x03, 0xf0, 0x04, 0x15, 0x37, 0xb5, 0x70,
-	0x31, 0xcf, 0x38, 0xbe, 0x71, 0x48, 0x5a, 0x9c, 0xe4, 0x62, 0x43, 0x33, 0x0b, 0xaa, 0xf2, 0x50,
-	0xf4, 0x71, 0x6c, 0xa2, 0x25, 0x32, 0xba, 0x90, 0xa0, 0x16, 0x77, 0x7d, 0x0e, 0x0e, 0xfd, 0x10,
-	0x4a, 0xee, 0x5e, 0x82, 0x1b, 0x3c, 0x23, 0xbb, 0x28, 0x22, 0x9d, 0x9a, 0x39, 0xc8, 0xf9, 0xb3,
-	0xdc, 0xb5, 0x46, 0xf8, 0xba, 0xf0, 0x2c, 0x7b, 0x44, 0xce, 0xce, 0x4f, 0xa0, 0x2c, 0x4b, 0xf3,
-	0x85, 0x9b, 0x0b, 0xcb, 0x8f, 0x49, 0x1f, 0xe7, 0x41, 0xc3, 0x02, 0x82, 0xb7, 0x86, 0xef, 0x92,
-	0xfd, 0xd6, 0x58, 0x66, 0xf3, 0xcf, 0xc1, 0x84, 0x12, 0x0e, 0x49, 0xe4, 0x4c, 0x49, 0x1f, 0xfe,
-	0x94, 0xd4, 0x71, 0xad, 0xa6, 0xe4, 0xc5, 0xc3, 0x2a, 0x3e, 0xc1, 0xaf, 0xc1, 0xe8, 0x92, 0x7c,
-	0x4b, 0x25, 0x16, 0x87, 0x53, 0x53, 0xed, 0xe8, 0x4d, 0x2e, 0x54, 0xa7, 0xb0, 0xf3, 0x60, 0x8f,
-	0xdc, 0x2f, 0xa4, 0xff, 0x1d, 0x96, 0x93, 0x40, 0xb2, 0x2b, 0xb3, 0x69, 0x23, 0xce, 0xf5, 0xf8,
-	0xf8, 0xad, 0xcc, 0x0c, 0xf8, 0x4d, 0x45, 0x03, 0x29, 0xc7, 0xce, 0xdd, 0x7f, 0x3d, 0xe4, 0x9c,
-	0x8e, 0xb1, 0x2f, 0x1c, 0xa4, 0x66, 0x72, 0x42, 0xf0, 0x47, 0xe0, 0x0c, 0x38, 0x67, 0x87, 0x34,
-	0xd8, 0x09, 0x9e, 0xe5, 0x7f, 0x8d, 0x29, 0x9f, 0xb8, 0xc3, 0x91, 0x81, 0x02, 0x47, 0xc9, 0x23,
-	0xe9, 0x4e, 0xd8, 0x4a, 0x2a, 0x99, 0xa3, 0xa4, 0x01, 0xc0, 0x0d, 0x8a, 0x1e, 0x0a, 0x47, 0xd7,
-	0xb2, 0x3e, 0x1e, 0x33, 0x20, 0xa3, 0x2d, 0x29, 0x9c, 0x6c, 0xcb, 0xf5, 0x3f, 0x1f, 0xfe, 0x1e,
-	0xeb, 0x62, 0x32, 0x1d, 0xba, 0xd6, 0x2d, 0x9f, 0x3c, 0xd3, 0x59, 0xf0, 0xab, 0x25, 0xbb, 0xd1,
-	0xf2, 0x94, 0x5a, 0x61, 0x83, 0xed, 0xe1, 0x70, 0xdd, 0xfb, 0xeb, 0xf2, 0x7b, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x27, 0xad, 0x32, 0xbe, 0xa6, 0x0c, 0x00, 0x00,
+	// 1136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xdb, 0x52, 0xdb, 0x46,
+	0x18, 0xc7, 0x31, 0x50, 0x1a, 0x3e, 0x8e, 0xdd, 0x09, 0x29, 0xe3, 0xe6, 0xc2, 0x75, 0x53, 0x62,
+	0x93, 0x60, 0x32, 0x64, 0xa6, 0x93, 0x5b, 0xc0, 0x85, 0x30, 0x13, 0xa6, 0x44, 0x86, 0xe9, 0x91,
+	0xf1, 0x2c, 0xd2, 0x37, 0x46, 0x83, 0xa4, 0x15, 0xda, 0x35, 0x84, 0xcb, 0xbe, 0x51, 0x5f, 0xa5,
+	0x6f, 0xd4, 0x59, 0x9d, 0x25, 0x6b, 0xc5, 0xba, 0xc9, 0x9d, 0x65, 0xfd, 0xf4, 0xff, 0xef, 0x77,
+	0xd8, 0x83, 0x04, 0xeb, 0x01, 0x63, 0x62, 0x68, 0x32, 0x16, 0x58, 0x3d, 0x3f, 0x60, 0x82, 0x91,
+	0x67, 0xae, 0xed, 0xdc, 0x8d, 0x79, 0x74, 0xd5, 0x93, 0xb7, 0xc3, 0xbb, 0xcd, 0x65, 0x93, 0xb9,
+	0x2e, 0xf3, 0xa2, 0xff, 0x9b, 0xcb, 0x79, 0xaa, 0xb9, 0x6a, 0x7b, 0x02, 0x03, 0x8f, 0x3a, 0xf1,
+	0xf5, 0x92, 0x1f, 0xb0, 0x4f, 0x0f, 0xf1, 0xc5, 0xba, 0x45, 0x05, 0xcd, 0x5b, 0xb4, 0x87, 0xb0,
+	0xb1, 0xef, 0x38, 0xcc, 0x3c, 0xb7, 0x5d, 0xe4, 0x82, 0xba, 0xbe, 0x81, 0xb7, 0x63, 0xe4, 0x82,
+	0xbc, 0x81, 0xf9, 0x2b, 0xca, 0x71, 0xb3, 0xd1, 0x6a, 0x74, 0x96, 0xf6, 0x9e, 0xf7, 0x0a, 0x43,
+	0x89, 0xfd, 0x4f, 0xf9, 0xe8, 0x80, 0x72, 0x34, 0x42, 0x92, 0x3c, 0x85, 0xaf, 0x4c, 0x36, 0xf6,
+	0xc4, 0xe6, 0x5c, 0xab, 0xd1, 0x59, 0x31, 0xa2, 0x8b, 0xf6, 0xdf, 0x0d, 0x78, 0x56, 0x76, 0xe0,
+	0x3e, 0xf3, 0x38, 0x92, 0xb7, 0xb0, 0xc0, 0x05, 0x15, 0x63, 0x1e, 0x9b, 0x7c, 0x57, 0x69, 0x32,
+	0x08, 0x11, 0x23, 0x46, 0xc9, 0x73, 0x58, 0x14, 0x89, 0xd2, 0xe6, 0x6c, 0xab, 0xd1, 0x99, 0x37,
+	0xb2, 0x3f, 0x14, 0x63, 0xf8, 0x0d, 0x56, 0xc3, 0x21, 0x9c, 0xf4, 0xbf, 0x40, 0x74, 0xb3, 0x79,
+	0x65, 0x07, 0xd6, 0x52, 0xe5, 0xcf, 0x89, 0x6a, 0x15, 0x66, 0x4f, 0xfa, 0xa1, 0xf4, 0x9c, 0x31,
+	0x7b, 0xd2, 0x57, 0xc4, 0x61, 0xc1, 0xd3, 0x63, 0x14, 0x87, 0x01, 0x5a, 0xe8, 0x09, 0x9b, 0x3a,
+	0xff, 0x3f, 0x9a, 0x26, 0x3c, 0x19, 0x73, 0xd9, 0x26, 0x2e, 0x86, 0xae, 0x8b, 0x46, 0x7a, 0xdd,
+	0xfe, 0xb7, 0x01, 0x1b, 0x25, 0x9b, 0xcf, 0x09, 0xad, 0xc6, 0x8a, 0xec, 0x00, 0x41, 0xcf, 0x0c,
+	0x1e, 0x7c, 0x81, 0xd6, 0xd0, 0xa7, 0x9c, 0xdf, 0xb3, 0xc0, 0x0a, 0x63, 0x5e, 0x34, 0xbe, 0x49,
+	0xef, 0x9c, 0xc5, 0x37, 0xc8, 0x3b, 0x58, 0x18, 0x05, 0xd4, 0x13, 0x7c, 0x73, 0xbe, 0x35, 0xd7,
+	0x59, 0xda, 0x6b, 0x15, 0xfd, 0xe3, 0x8b, 0x63, 0x89, 0xfc, 0xec, 0x09, 0x5b, 0x3c, 0x18, 0x31,
+	0xbf, 0xf7, 0x4f, 0x0b, 0x16, 0x0d, 0xc6, 0xc4, 0xa1, 0x6c, 0x7d, 0xe2, 0x03, 0x91, 0x01, 0x32,
+	0xd7, 0x67, 0x1e, 0x7a, 0x42, 0x0e, 0x18, 0x39, 0x79, 0x53, 0x54, 0x4b, 0xe7, 0xd1, 0x24, 0x1a,
+	0xe7, 0xbd, 0xb9, 0xa5, 0x78, 0xa2, 0x84, 0xb7, 0x67, 0x88, 0x1b, 0x3a, 0xca, 0x29, 0x70, 0x6e,
+	0x9b, 0x37, 0x87, 0xd7, 0xd4, 0xf3, 0xd0, 0xa9, 0x73, 0x2c, 0xa1, 0x89, 0xe3, 0x0f, 0x95, 0x11,
+	0x0f, 0x44, 0x60, 0x7b, 0xa3, 0xa4, 0x4c, 0xed, 0x19, 0x72, 0x1b, 0x36, 0x8a, 0x74, 0xb7, 0xb9,
+	0xb0, 0x4d, 0x9e, 0x18, 0xee, 0xa9, 0x0d, 0x27, 0xe0, 0x29, 0x2d, 0x87, 0xb0, 0x7e, 0x18, 0x20,
+	0x15, 0x78, 0xc8, 0x1c, 0x07, 0x4d, 0x61, 0x33, 0x8f, 0xbc, 0xae, 0x7c, 0xb4, 0x8c, 0x25, 0x46,
+	0x75, 0xdd, 0xd4, 0x9e, 0x21, 0x7f, 0xc2, 0x6a, 0x3f, 0x60, 0x7e, 0x4e, 0x7e, 0xbb, 0x52, 0xbe,
+	0x08, 0x69, 0x8a, 0x0f, 0x61, 0xe5, 0x3d, 0xe5, 0x39, 0xed, 0x6e, 0xa5, 0x76, 0x81, 0x49, 0xa4,
+	0xbf, 0xaf, 0x44, 0x0f, 0x18, 0x73, 0x72, 0xe9, 0xb9, 0x07, 0xd2, 0x47, 0x6e, 0x06, 0xf6, 0x55,
+	0x3e, 0x41, 0xbd, 0xea, 0x08, 0x26, 0xc0, 0xc4, 0x6a, 0x57, 0x9b, 0x4f, 0x8d, 0x2f, 0x60, 0x29,
+	0x4a, 0xf8, 0xbe, 0x63, 0x53, 0x4e, 0x5e, 0xd6, 0x94, 0x24, 0x24, 0x34, 0x13, 0xf6, 0x11, 0x16,
+	0x65, 0xa2, 0x23, 0xd1, 0x1f, 0x95, 0x85, 0x98, 0x46, 0x72, 0x00, 0xb0, 0xef, 0x08, 0x0c, 0x22,
+	0xcd, 0xad, 0x4a, 0xcd, 0x0c, 0xd0, 0x14, 0xfd, 0x05, 0x9e, 0xec, 0x5b, 0xd6, 0x91, 0x8d, 0x8e,
+	0x45, 0x5e, 0x54, 0x4b, 0xc6, 0xb7, 0xf5, 0xdb, 0x30, 0xca, 0x56, 0x9f, 0x0a, 0x1a, 0xae, 0xa5,
+	0xdb, 0x35, 0x29, 0x4d, 0x20, 0x4d, 0xf1, 0x5f, 0x61, 0x59, 0x66, 0x2d, 0x95, 0xee, 0x28, 0x13,
+	0x3b, 0xa5, 0xf0, 0x35, 0xac, 0x7c, 0xb0, 0xb9, 0x48, 0x9e, 0xe2, 0x8a, 0xfe, 0x2e, 0x30, 0x89,
+	0xf4, 0xb6, 0x0e, 0x5a, 0xb1, 0x0e, 0xa4, 0xfb, 0x47, 0xfd, 0x3a, 0x50, 0xde, 0xcd, 0x1e, 0x9f,
+	0xaa, 0xeb, 0x17, 0xbe, 0xa5, 0x63, 0x50, 0xc6, 0xf4, 0x0d, 0xfa, 0xe8, 0xa0, 0x86, 0x41, 0x19,
+	0x9b, 0xae, 0x18, 0xf2, 0xb9, 0x0b, 0x8e, 0x41, 0x5d, 0x31, 0x52, 0xe6, 0xf1, 0x62, 0xe4, 0xd0,
+	0xb4, 0x18, 0x1e, 0xac, 0x14, 0x76, 0xf2, 0x72, 0x1c, 0xe9, 0x91, 0xb2, 0x57, 0x75, 0xae, 0x68,
+	0xee, 0x68, 0xd2, 0xa9, 0xdf, 0x00, 0x20, 0xaa, 0xaa, 0xc1, 0x1c, 0x54, 0x4c, 0xe1, 0x0c, 0xd0,
+	0x9f, 0xc2, 0xb2, 0xe3, 0x43, 0xc9, 0x17, 0xca, 0x09, 0x31, 0x85, 0xe0, 0xef, 0xb0, 0xb2, 0x6f,
+	0x85, 0xb9, 0x3a, 0x67, 0xa1, 0x6a, 0x57, 0xb5, 0x30, 0x64, 0x8c, 0xa6, 0xb4, 0x09, 0xc4, 0x40,
+	0x97, 0xdd, 0xa1, 0x7c, 0xf2, 0x28, 0x60, 0x6e, 0xa8, 0x5f, 0xbd, 0xcc, 0x4f, 0x82, 0xfa, 0x4b,
+	0x50, 0x78, 0xc6, 0x39, 0x0b, 0xec, 0x3b, 0xdb, 0xc1, 0x91, 0x6a, 0x09, 0x2a, 0x42, 0x9a, 0xe2,
+	0x97, 0xb0, 0x66, 0xe0, 0x1d, 0xbb, 0xc1, 0x4c, 0xfd, 0x95, 0x62, 0xf8, 0x05, 0x4a, 0x7b, 0x72,
+	0x81, 0x6c, 0xd6, 0x70, 0x68, 0xaa, 0x45, 0x3e, 0x03, 0x12, 0xd1, 0x97, 0x8f, 0x72, 0xb9, 0x96,
+	0x5f, 0x1b, 0x5c, 0xb3, 0xfb, 0x6c, 0x2f, 0xe4, 0x8a, 0xf1, 0x97, 0xa8, 0xc4, 0xea, 0xb5, 0x1e,
+	0x9c, 0xfa, 0x5d, 0xc2, 0x5a, 0xd4, 0xd1, 0x67, 0x34, 0x10, 0xb6, 0xbc, 0xab, 0xf0, 0x2b, 0x51,
+	0xfa, 0xbd, 0x2a, 0xbb, 0x3b, 0x13, 0xef, 0x2a, 0x67, 0xc0, 0xb4, 0xd2, 0x97, 0xb0, 0xfc, 0x9e,
+	0xf2, 0x4c, 0xb9, 0xa3, 0x3a, 0xf2, 0x4c, 0x08, 0x6b, 0x9d, 0x78, 0x6e, 0x60, 0x55, 0x66, 0x2d,
+	0x7d, 0x98, 0x2b, 0xba, 0xb4, 0x08, 0x25, 0x16, 0xaf, 0xb4, 0xd8, 0x7c, 0xd5, 0x93, 0x53, 0xd0,
+	0x00, 0x47, 0x2e, 0x7a, 0x42, 0x51, 0x85, 0x12, 0x55, 0x5f, 0xf5, 0x09, 0x38, 0xf5, 0x43, 0x58,
+	0x96, 0x63, 0x89, 0x6f, 0x70, 0x45, 0xee, 0xf2, 0x48, 0xe2, 0xd4, 0xd5, 0x20, 0x27, 0x0f, 0x6f,
+	0x27, 0x9e, 0x85, 0x9f, 0x6a, 0x0f, 0x6f, 0x21, 0xa1, 0xbf, 0x01, 0x25, 0xa1, 0x45, 0xc2, 0xdd,
+	0xda, 0xf0, 0x0b, 0xd2, 0xdb, 0x3a, 0x68, 0x1a, 0x40, 0x7c, 0x4c, 0x8c, 0x5c, 0xd4, 0xc7, 0xc4,
+	0x69, 0x06, 0x7f, 0x1b, 0xbf, 0xcc, 0xa7, 0xdf, 0x13, 0x88, 0x72, 0x9b, 0xaa, 0xfc, 0xb2, 0xd1,
+	0xec, 0xe9, 0xe2, 0x69, 0x14, 0x7f, 0xc1, 0xd7, 0xf1, 0x5b, 0x3e, 0xd9, 0xaa, 0x7d, 0xf8, 0xa4,
+	0xaf, 0x58, 0xb1, 0x2a, 0xb8, 0x54, 0x9d, 0xc2, 0x46, 0x7c, 0x52, 0x89, 0x5e, 0xbc, 0x92, 0x57,
+	0x3f, 0xd2, 0x55, 0xbc, 0xad, 0x95, 0xb8, 0x53, 0x3e, 0x7a, 0x2c, 0x67, 0x0e, 0x7c, 0x6b, 0xa0,
+	0x83, 0x94, 0x63, 0xff, 0xe3, 0x87, 0x53, 0xe4, 0x9c, 0x8e, 0x70, 0x20, 0x02, 0xa4, 0x6e, 0xf9,
+	0x95, 0x30, 0xfa, 0x5a, 0xa4, 0x80, 0xb5, 0x37, 0xc1, 0x8d, 0xb8, 0x97, 0x8f, 0x9c, 0x31, 0xbf,
+	0x96, 0x6f, 0xc3, 0x0e, 0x0a, 0xb4, 0xca, 0x53, 0x52, 0x7e, 0x8c, 0xea, 0x55, 0x92, 0x1a, 0x21,
+	0x0d, 0x01, 0x8e, 0x51, 0x9c, 0xa2, 0x08, 0x6c, 0x53, 0xb5, 0x91, 0x64, 0x40, 0xfd, 0x46, 0x92,
+	0xe7, 0x92, 0xb2, 0x1c, 0xbc, 0xfb, 0xe3, 0xa7, 0x91, 0x2d, 0xae, 0xc7, 0x57, 0xd2, 0x7a, 0x37,
+	0x22, 0x77, 0x6c, 0x16, 0xff, 0xda, 0x4d, 0xaa, 0xb1, 0x1b, 0x2a, 0xed, 0xa6, 0x05, 0xf6, 0xaf,
+	0xae, 0x16, 0xc2, 0xbf, 0xde, 0xfe, 0x37, 0x00, 0x38, 0x06, 0x25, 0x08, 0xd1, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -344,6 +477,23 @@ type RootCoordClient interface {
 	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
+	CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *milvuspb.UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error)
+	// GetCredential is used by proxy to authenticate users
+	GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
+	CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddUserToRole(ctx context.Context, in *milvuspb.AddUserToRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RemoveUserFromRole(ctx context.Context, in *milvuspb.RemoveUserFromRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GrantPrivilege(ctx context.Context, in *milvuspb.GrantPrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RevokePrivilege(ctx context.Context, in *milvuspb.RevokePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest, opts ...grpc.CallOption) (*milvuspb.ListGrantsResponse, error)
 	//*
 	// @brief This method is used to list all collections.
 	//
@@ -483,6 +633,150 @@ func (c *rootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAlia
 	return out, nil
 }
 
+func (c *rootCoordClient) AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AddField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+	out := new(milvuspb.ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) UpdateCredential(ctx context.Context, in *milvuspb.UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/UpdateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DeleteCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
+	out := new(milvuspb.ListCredUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListCredUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error) {
+	out := new(GetCredentialResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AddUserToRole(ctx context.Context, in *milvuspb.AddUserToRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AddUserToRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RemoveUserFromRole(ctx context.Context, in *milvuspb.RemoveUserFromRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RemoveUserFromRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GrantPrivilege(ctx context.Context, in *milvuspb.GrantPrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GrantPrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RevokePrivilege(ctx context.Context, in *milvuspb.RevokePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RevokePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest, opts ...grpc.CallOption) (*milvuspb.ListGrantsResponse, error) {
+	out := new(milvuspb.ListGrantsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	out := new(milvuspb.ShowCollectionsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ShowCollections", in, out, opts...)
@@ -663,6 +957,23 @@ type RootCoordServer interface {
 	CreateAlias(context.Context, *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+	AddField(context.Context, *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+	CreateCredential(context.Context, *milvuspb.CreateCredentialRequest) (*commonpb.Status, error)
+	UpdateCredential(context.Context, *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+	ListCredUsers(context.Context, *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+	// GetCredential is used by proxy to authenticate users
+	GetCredential(context.Context, *GetCredentialRequest) (*GetCredentialResponse, error)
+	CreateRole(context.Context, *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+	AddUserToRole(context.Context, *milvuspb.AddUserToRoleRequest) (*commonpb.Status, error)
+	RemoveUserFromRole(context.Context, *milvuspb.RemoveUserFromRoleRequest) (*commonpb.Status, error)
+	GrantPrivilege(context.Context, *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error)
+	RevokePrivilege(context.Context, *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error)
+	ListGrants(context.Context, *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error)
 	//*
 	// @brief This method is used to list all collections.
 	//
@@ -738,6 +1049,54 @@ func (*UnimplementedRootCoordServer) DropAlias(ctx context.Context, req *milvusp
 func (*UnimplementedRootCoordServer) AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
 }
+func (*UnimplementedRootCoordServer) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddField not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredUsers not implemented")
+}
+func (*UnimplementedRootCoordServer) GetCredential(ctx context.Context, req *GetCredentialRequest) (*GetCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedRootCoordServer) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedRootCoordServer) AddUserToRole(ctx context.Context, req *milvuspb.AddUserToRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserToRole not implemented")
+}
+func (*UnimplementedRootCoordServer) RemoveUserFromRole(ctx context.Context, req *milvuspb.RemoveUserFromRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromRole not implemented")
+}
+func (*UnimplementedRootCoordServer) GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPrivilege not implemented")
+}
+func (*UnimplementedRootCoordServer) RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePrivilege not implemented")
+}
+func (*UnimplementedRootCoordServer) ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
 func (*UnimplementedRootCoordServer) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
@@ -971,6 +1330,294 @@ func _RootCoord_AlterAlias_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_AddField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AddFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AddField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AddField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AddField(ctx, req.(*milvuspb.AddFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateDatabase(ctx, req.(*milvuspb.CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropDatabase(ctx, req.(*milvuspb.DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDatabases(ctx, req.(*milvuspb.ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateCredential(ctx, req.(*milvuspb.CreateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.UpdateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).UpdateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/UpdateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).UpdateCredential(ctx, req.(*milvuspb.UpdateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DeleteCredential(ctx, req.(*milvuspb.DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListCredUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListCredUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListCredUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListCredUsers(ctx, req.(*milvuspb.ListCredUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetCredential(ctx, req.(*GetCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateRole(ctx, req.(*milvuspb.CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropRole(ctx, req.(*milvuspb.DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AddUserToRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AddUserToRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AddUserToRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AddUserToRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AddUserToRole(ctx, req.(*milvuspb.AddUserToRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RemoveUserFromRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RemoveUserFromRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RemoveUserFromRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RemoveUserFromRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RemoveUserFromRole(ctx, req.(*milvuspb.RemoveUserFromRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GrantPrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GrantPrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GrantPrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GrantPrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GrantPrivilege(ctx, req.(*milvuspb.GrantPrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RevokePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RevokePrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RevokePrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RevokePrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RevokePrivilege(ctx, req.(*milvuspb.RevokePrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListGrants(ctx, req.(*milvuspb.ListGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.ShowCollectionsRequest)
 	if err := dec(in); err != nil {
@@ -1303,6 +1950,70 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AlterAlias",
 			Handler:    _RootCoord_AlterAlias_Handler,
 		},
+		{
+			MethodName: "AddField",
+			Handler:    _RootCoord_AddField_Handler,
+		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _RootCoord_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _RootCoord_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _RootCoord_ListDatabases_Handler,
+		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _RootCoord_CreateCredential_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _RootCoord_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _RootCoord_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListCredUsers",
+			Handler:    _RootCoord_ListCredUsers_Handler,
+		},
+		{
+			MethodName: "GetCredential",
+			Handler:    _RootCoord_GetCredential_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _RootCoord_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _RootCoord_DropRole_Handler,
+		},
+		{
+			MethodName: "AddUserToRole",
+			Handler:    _RootCoord_AddUserToRole_Handler,
+		},
+		{
+			MethodName: "RemoveUserFromRole",
+			Handler:    _RootCoord_RemoveUserFromRole_Handler,
+		},
+		{
+			MethodName: "GrantPrivilege",
+			Handler:    _RootCoord_GrantPrivilege_Handler,
+		},
+		{
+			MethodName: "RevokePrivilege",
+			Handler:    _RootCoord_RevokePrivilege_Handler,
+		},
+		{
+			MethodName: "ListGrants",
+			Handler:    _RootCoord_ListGrants_Handler,
+		},
 		{
 			MethodName: "ShowCollections",
 			Handler:    _RootCoord_ShowCollections_Handler,
@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+// authorizationHeader is the metadata key of the credentials, the value is base64("username:password")
+const authorizationHeader = "authorization"
+
+// milvusServicePrefix is the prefix of the full method names of MilvusService, only the requests from
+// clients are authenticated, the requests from other components reach ProxyService on the internal port
+const milvusServicePrefix = "/milvus.proto.milvus.MilvusService/"
+
+type usernameCtxKey struct{}
+
+// GetCurUsernameFromContext returns the username authenticated by AuthenticationInterceptor
+func GetCurUsernameFromContext(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(usernameCtxKey{}).(string)
+	return username, ok
+}
+
+// parseAuthorization extracts the username and the raw password from the metadata of the request
+func parseAuthorization(ctx context.Context) (string, string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", "", errors.New("missing metadata in the request")
+	}
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return "", "", errors.New("missing authorization in the metadata")
+	}
+	rawCred, err := crypto.Base64Decode(values[0])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid authorization: %w", err)
+	}
+	pos := strings.Index(rawCred, ":")
+	if pos < 0 {
+		return "", "", errors.New("invalid authorization, should be base64 encoded username:password")
+	}
+	return rawCred[:pos], rawCred[pos+1:], nil
+}
+
+// passwordVerify checks the raw password against the encrypted one stored in root coord,
+// the sha256 of a verified password is cached to avoid running bcrypt for every request
+func passwordVerify(ctx context.Context, username string, rawPwd string, cache Cache) bool {
+	credInfo, err := cache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		log.Debug("get credential info failed", zap.String("username", username), zap.Error(err))
+		return false
+	}
+	sha256Pwd := crypto.SHA256(rawPwd, username)
+	if credInfo.verifiedPassword == sha256Pwd {
+		return true
+	}
+	if !crypto.PasswordVerify(rawPwd, credInfo.encryptedPassword) {
+		return false
+	}
+	cache.UpdateCredentialInfo(username, sha256Pwd)
+	return true
+}
+
+// AuthenticationInterceptor verifies the credentials carried by each request of MilvusService,
+// the authenticated username is passed to the following handlers through the context
+func AuthenticationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !Params.AuthorizationEnabled || !strings.HasPrefix(info.FullMethod, milvusServicePrefix) {
+		return handler(ctx, req)
+	}
+	username, rawPwd, err := parseAuthorization(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if globalMetaCache == nil || !passwordVerify(ctx, username, rawPwd, globalMetaCache) {
+		return nil, status.Error(codes.Unauthenticated, "auth check failure, please check username and password are correct")
+	}
+	return handler(context.WithValue(ctx, usernameCtxKey{}, username), req)
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+type mockCredentialRootCoord struct {
+	types.RootCoord
+	creds       map[string]*rootcoordpb.GetCredentialResponse
+	accessCount int
+}
+
+func newMockCredentialRootCoord(t *testing.T) *mockCredentialRootCoord {
+	rootPwd, err := crypto.PasswordEncrypt(common.DefaultRootPassword)
+	assert.Nil(t, err)
+	userPwd, err := crypto.PasswordEncrypt("password")
+	assert.Nil(t, err)
+	return &mockCredentialRootCoord{
+		creds: map[string]*rootcoordpb.GetCredentialResponse{
+			common.DefaultRootUser: {
+				Username:          common.DefaultRootUser,
+				EncryptedPassword: rootPwd,
+			},
+			"user": {
+				Username:          "user",
+				EncryptedPassword: userPwd,
+				Grants: []*milvuspb.GrantEntity{
+					{RoleName: "role", DbName: common.DefaultDatabaseName, ObjectName: "coll", Privilege: PrivilegeSearch},
+					{RoleName: "role", DbName: "db", ObjectName: allObjects, Privilege: PrivilegeAll},
+				},
+			},
+		},
+	}
+}
+
+func (m *mockCredentialRootCoord) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	m.accessCount++
+	cred, ok := m.creds[req.Username]
+	if !ok {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "user not exist"},
+		}, nil
+	}
+	cred.Status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	return cred, nil
+}
+
+func authContext(username, password string) context.Context {
+	md := metadata.Pairs(authorizationHeader, crypto.Base64Encode(username+":"+password))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestAuthenticationInterceptor(t *testing.T) {
+	Params.Init()
+	authEnabled := Params.AuthorizationEnabled
+	defer func() { Params.AuthorizationEnabled = authEnabled }()
+
+	rc := newMockCredentialRootCoord(t)
+	err := InitMetaCache(rc)
+	assert.Nil(t, err)
+
+	var handledUser string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handledUser, _ = GetCurUsernameFromContext(ctx)
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: milvusServicePrefix + "Search"}
+
+	t.Run("disabled", func(t *testing.T) {
+		Params.AuthorizationEnabled = false
+		_, err := AuthenticationInterceptor(context.Background(), nil, info, handler)
+		assert.Nil(t, err)
+	})
+
+	Params.AuthorizationEnabled = true
+	t.Run("internal service", func(t *testing.T) {
+		_, err := AuthenticationInterceptor(context.Background(), nil,
+			&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache"}, handler)
+		assert.Nil(t, err)
+	})
+
+	t.Run("missing credentials", func(t *testing.T) {
+		_, err := AuthenticationInterceptor(context.Background(), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		md := metadata.Pairs(authorizationHeader, "not base64")
+		_, err = AuthenticationInterceptor(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		md = metadata.Pairs(authorizationHeader, crypto.Base64Encode("user"))
+		_, err = AuthenticationInterceptor(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		_, err := AuthenticationInterceptor(authContext("user", "wrong"), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = AuthenticationInterceptor(authContext("notExist", "password"), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		resp, err := AuthenticationInterceptor(authContext("user", "password"), nil, info, handler)
+		assert.Nil(t, err)
+		assert.Equal(t, "ok", resp)
+		assert.Equal(t, "user", handledUser)
+
+		// the verified password is cached
+		credInfo, err := globalMetaCache.GetCredentialInfo(context.Background(), "user")
+		assert.Nil(t, err)
+		assert.Equal(t, crypto.SHA256("password", "user"), credInfo.verifiedPassword)
+		_, err = AuthenticationInterceptor(authContext("user", "password"), nil, info, handler)
+		assert.Nil(t, err)
+		_, err = AuthenticationInterceptor(authContext("user", "wrong"), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"google.golang.org/grpc/peer"
+)
+
+// eventuallyTs is the guarantee timestamp of the Eventually consistency level, query nodes serve
+// the request at once since their serviceable time is always later than it
+const eventuallyTs Timestamp = 1
+
+// sessionTsExpiration is how long the last write of a session is remembered, query nodes are expected
+// to have consumed the write long before
+const sessionTsExpiration = 10 * time.Minute
+
+// sessionTsCache remembers the timestamp of the last write of each session to each collection,
+// a session is a client connection identified by its peer address
+type sessionTsCache struct {
+	mu        sync.Mutex
+	ts        map[string]map[UniqueID]Timestamp // session -> collection id -> timestamp of the last write
+	lastClean time.Time
+}
+
+var globalSessionTs = newSessionTsCache()
+
+func newSessionTsCache() *sessionTsCache {
+	return &sessionTsCache{
+		ts:        make(map[string]map[UniqueID]Timestamp),
+		lastClean: time.Now(),
+	}
+}
+
+// getSessionID returns the peer address of the request, requests without peer share the empty session
+func getSessionID(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// update records a write to the collection by the session of ctx
+func (c *sessionTsCache) update(ctx context.Context, collectionID UniqueID, ts Timestamp) {
+	session := getSessionID(ctx)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.ts[session]; !ok {
+		c.ts[session] = make(map[UniqueID]Timestamp)
+	}
+	if ts > c.ts[session][collectionID] {
+		c.ts[session][collectionID] = ts
+	}
+	if now := time.Now(); now.Sub(c.lastClean) > sessionTsExpiration {
+		c.clean(now)
+		c.lastClean = now
+	}
+}
+
+// get returns the timestamp of the last write to the collection by the session of ctx
+func (c *sessionTsCache) get(ctx context.Context, collectionID UniqueID) (Timestamp, bool) {
+	session := getSessionID(ctx)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ts, ok := c.ts[session][collectionID]
+	return ts, ok
+}
+
+// clean removes the writes older than sessionTsExpiration, the caller must hold c.mu
+func (c *sessionTsCache) clean(now time.Time) {
+	expired := tsoutil.ComposeTSByTime(now.Add(-sessionTsExpiration), 0)
+	for session, collections := range c.ts {
+		for collectionID, ts := range collections {
+			if ts < expired {
+				delete(collections, collectionID)
+			}
+		}
+		if len(collections) == 0 {
+			delete(c.ts, session)
+		}
+	}
+}
+
+// getConsistencyLevel returns the consistency level of a search or query, which is the default one of
+// the collection if useDefault is set
+func getConsistencyLevel(ctx context.Context, dbName string, collectionName string, level commonpb.ConsistencyLevel, useDefault bool) (commonpb.ConsistencyLevel, error) {
+	if useDefault {
+		collInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName)
+		if err != nil {
+			return 0, err
+		}
+		return collInfo.consistencyLevel, nil
+	}
+	if _, ok := commonpb.ConsistencyLevel_name[int32(level)]; !ok {
+		return 0, fmt.Errorf("invalid consistency level %d", level)
+	}
+	return level, nil
+}
+
+// getGuaranteeTimestamp returns the timestamp query nodes wait for before serving a search or query,
+// an explicit guaranteeTs from the request takes precedence over the consistency level
+func getGuaranteeTimestamp(ctx context.Context, level commonpb.ConsistencyLevel, collectionID UniqueID, guaranteeTs, beginTs Timestamp) Timestamp {
+	if guaranteeTs != 0 {
+		return guaranteeTs
+	}
+	switch level {
+	case commonpb.ConsistencyLevel_Session:
+		if ts, ok := globalSessionTs.get(ctx, collectionID); ok {
+			return ts
+		}
+		// nothing was written by the session
+		return eventuallyTs
+	case commonpb.ConsistencyLevel_Bounded:
+		graceful := tsoutil.ComposeTS(Params.GracefulTime.Milliseconds(), 0)
+		if beginTs <= graceful {
+			return eventuallyTs
+		}
+		return beginTs - graceful
+	case commonpb.ConsistencyLevel_Eventually:
+		return eventuallyTs
+	default:
+		return beginTs
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/peer"
+)
+
+func peerContext(addr string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 19530},
+	})
+}
+
+func TestSessionTsCache(t *testing.T) {
+	cache := newSessionTsCache()
+	ctx1 := peerContext("10.0.0.1")
+	ctx2 := peerContext("10.0.0.2")
+	now := time.Now()
+
+	cache.update(ctx1, 1, tsoutil.ComposeTSByTime(now, 2))
+	cache.update(ctx1, 1, tsoutil.ComposeTSByTime(now, 1))
+	ts, ok := cache.get(ctx1, 1)
+	assert.True(t, ok)
+	assert.Equal(t, tsoutil.ComposeTSByTime(now, 2), ts)
+
+	// sessions and collections are tracked separately
+	_, ok = cache.get(ctx2, 1)
+	assert.False(t, ok)
+	_, ok = cache.get(ctx1, 2)
+	assert.False(t, ok)
+
+	cache.update(ctx2, 1, tsoutil.ComposeTSByTime(now.Add(-2*sessionTsExpiration), 0))
+	cache.clean(now)
+	_, ok = cache.get(ctx1, 1)
+	assert.True(t, ok)
+	_, ok = cache.get(ctx2, 1)
+	assert.False(t, ok)
+	assert.Equal(t, 1, len(cache.ts))
+}
+
+func TestGetConsistencyLevel(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	level, err := getConsistencyLevel(ctx, "", "collection2", commonpb.ConsistencyLevel_Strong, true)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Bounded, level)
+
+	level, err = getConsistencyLevel(ctx, "", "collection2", commonpb.ConsistencyLevel_Eventually, false)
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ConsistencyLevel_Eventually, level)
+
+	_, err = getConsistencyLevel(ctx, "", "collection2", commonpb.ConsistencyLevel(100), false)
+	assert.NotNil(t, err)
+
+	_, err = getConsistencyLevel(ctx, "", "collection3", commonpb.ConsistencyLevel_Strong, true)
+	assert.NotNil(t, err)
+}
+
+func TestGetGuaranteeTimestamp(t *testing.T) {
+	Params.Init()
+	now := time.Now()
+	beginTs := tsoutil.ComposeTSByTime(now, 5)
+	ctx := peerContext("10.0.0.3")
+
+	assert.Equal(t, beginTs, getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Strong, 1, 0, beginTs))
+	assert.Equal(t, eventuallyTs, getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Eventually, 1, 0, beginTs))
+	assert.Equal(t, tsoutil.ComposeTSByTime(now.Add(-Params.GracefulTime), 5),
+		getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Bounded, 1, 0, beginTs))
+	assert.Equal(t, eventuallyTs, getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Bounded, 1, 0, 100))
+
+	// an explicit guarantee timestamp takes precedence
+	assert.Equal(t, Timestamp(10), getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Strong, 1, 10, beginTs))
+
+	assert.Equal(t, eventuallyTs, getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Session, 1, 0, beginTs))
+	writeTs := tsoutil.ComposeTSByTime(now.Add(-time.Second), 0)
+	globalSessionTs.update(ctx, 1, writeTs)
+	assert.Equal(t, writeTs, getGuaranteeTimestamp(ctx, commonpb.ConsistencyLevel_Session, 1, 0, beginTs))
+	assert.Equal(t, eventuallyTs, getGuaranteeTimestamp(peerContext("10.0.0.4"), commonpb.ConsistencyLevel_Session, 1, 0, beginTs))
+}
@@ -0,0 +1,211 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	RankStrategyKey = "strategy"
+	RRFKKey         = "k"
+	WeightsKey      = "weights"
+
+	// RRFRankStrategy fuses hits by reciprocal rank, the score of a hit is sum(1 / (k + rank)) over the sub-requests
+	RRFRankStrategy = "rrf"
+	// WeightedRankStrategy fuses hits by the weighted sum of their scores normalized into [0, 1]
+	WeightedRankStrategy = "weighted"
+
+	defaultRRFK = 60
+)
+
+// rankParams is how the hits of the sub-requests of a hybrid search are fused
+type rankParams struct {
+	strategy string
+	k        float64
+	weights  []float64
+	topK     int64
+	offset   int64
+}
+
+// parseRankParams gets the rank params of a hybrid search with numRequests sub-requests
+func parseRankParams(params []*commonpb.KeyValuePair, numRequests int) (*rankParams, error) {
+	ret := &rankParams{k: defaultRRFK}
+
+	topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, params)
+	if err != nil {
+		return nil, errors.New(TopKKey + " not found in rank_params")
+	}
+	ret.topK, err = strconv.ParseInt(topKStr, 0, 64)
+	if err != nil {
+		return nil, errors.New(TopKKey + " " + topKStr + " is invalid")
+	}
+	offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, params)
+	if err == nil {
+		ret.offset, err = strconv.ParseInt(offsetStr, 0, 64)
+		if err != nil {
+			return nil, errors.New(OffsetKey + " " + offsetStr + " is invalid")
+		}
+	}
+	if ret.topK <= 0 {
+		return nil, fmt.Errorf("%s %d should be positive", TopKKey, ret.topK)
+	}
+	if err := validateResultWindow(ret.offset, ret.topK); err != nil {
+		return nil, err
+	}
+
+	ret.strategy, err = funcutil.GetAttrByKeyFromRepeatedKV(RankStrategyKey, params)
+	if err != nil {
+		ret.strategy = RRFRankStrategy
+	}
+	switch ret.strategy {
+	case RRFRankStrategy:
+		kStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RRFKKey, params)
+		if err == nil {
+			ret.k, err = strconv.ParseFloat(kStr, 64)
+			if err != nil || ret.k <= 0 {
+				return nil, errors.New(RRFKKey + " " + kStr + " is invalid, it should be positive")
+			}
+		}
+	case WeightedRankStrategy:
+		weightsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(WeightsKey, params)
+		if err != nil {
+			return nil, errors.New(WeightsKey + " not found in rank_params")
+		}
+		if err := json.Unmarshal([]byte(weightsStr), &ret.weights); err != nil {
+			return nil, errors.New(WeightsKey + " " + weightsStr + " is invalid, it should be an array of numbers")
+		}
+		if len(ret.weights) != numRequests {
+			return nil, fmt.Errorf("number of %s %d mis-match with the number of requests %d", WeightsKey, len(ret.weights), numRequests)
+		}
+		for _, w := range ret.weights {
+			if w < 0 {
+				return nil, errors.New(WeightsKey + " " + weightsStr + " is invalid, weights should not be negative")
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown %s %s, should be %s or %s", RankStrategyKey, ret.strategy, RRFRankStrategy, WeightedRankStrategy)
+	}
+	return ret, nil
+}
+
+// normalizeScore maps the distance of a hit into [0, 1], a closer hit gets a larger score
+func normalizeScore(metricType string, distance float32) float64 {
+	if metricType == "IP" {
+		return 0.5 + math.Atan(float64(distance))/math.Pi
+	}
+	return 1 - 2*math.Atan(float64(distance))/math.Pi
+}
+
+// fusedHit is a hit of a hybrid search, with the location of its output fields in the result of a sub-request
+type fusedHit struct {
+	id     int64
+	score  float64
+	result int
+	idx    int64
+}
+
+// fuseSearchResults ranks the hits of the sub-requests of a hybrid search into a single list for each query,
+// metricTypes are the metric types of the sub-requests
+func fuseSearchResults(results []*schemapb.SearchResultData, metricTypes []string, params *rankParams) (*schemapb.SearchResultData, error) {
+	var nq int64
+	for _, result := range results {
+		if nq != 0 && result.GetNumQueries() != nq {
+			return nil, fmt.Errorf("search result's nq(%d) mis-match with %d", result.GetNumQueries(), nq)
+		}
+		nq = result.GetNumQueries()
+	}
+
+	ret := &schemapb.SearchResultData{
+		NumQueries: nq,
+		Scores:     make([]float32, 0),
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: make([]int64, 0),
+				},
+			},
+		},
+		Topks: make([]int64, 0, nq),
+	}
+
+	// start of the hits of the current query in each result
+	starts := make([]int64, len(results))
+	for q := int64(0); q < nq; q++ {
+		hits := make(map[int64]*fusedHit)
+		for i, result := range results {
+			var topk int64
+			if q < int64(len(result.GetTopks())) {
+				topk = result.GetTopks()[q]
+			}
+			ids := result.GetIds().GetIntId().GetData()
+			for rank := int64(0); rank < topk; rank++ {
+				idx := starts[i] + rank
+				var score float64
+				if params.strategy == WeightedRankStrategy {
+					score = params.weights[i] * normalizeScore(metricTypes[i], result.GetScores()[idx])
+				} else {
+					score = 1 / (params.k + float64(rank+1))
+				}
+				if hit, ok := hits[ids[idx]]; ok {
+					hit.score += score
+				} else {
+					hits[ids[idx]] = &fusedHit{id: ids[idx], score: score, result: i, idx: idx}
+				}
+			}
+			starts[i] += topk
+		}
+
+		sorted := make([]*fusedHit, 0, len(hits))
+		for _, hit := range hits {
+			sorted = append(sorted, hit)
+		}
+		sort.Slice(sorted, func(i, j int) bool {
+			if sorted[i].score != sorted[j].score {
+				return sorted[i].score > sorted[j].score
+			}
+			return sorted[i].id < sorted[j].id
+		})
+
+		var kept int64
+		for i := params.offset; i < int64(len(sorted)) && kept < params.topK; i++ {
+			hit := sorted[i]
+			ret.Ids.GetIntId().Data = append(ret.Ids.GetIntId().Data, hit.id)
+			ret.Scores = append(ret.Scores, float32(hit.score))
+			if fieldsData := results[hit.result].GetFieldsData(); len(fieldsData) > 0 {
+				if ret.FieldsData == nil {
+					ret.FieldsData = make([]*schemapb.FieldData, len(fieldsData))
+				}
+				typeutil.AppendFieldData(ret.FieldsData, fieldsData, hit.idx)
+			}
+			kept++
+		}
+		ret.Topks = append(ret.Topks, kept)
+		if kept > ret.TopK {
+			ret.TopK = kept
+		}
+	}
+	if ret.FieldsData == nil {
+		ret.FieldsData = make([]*schemapb.FieldData, 0)
+	}
+	return ret, nil
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRankParams(t *testing.T) {
+	Params.Init()
+	kvs := func(pairs ...string) []*commonpb.KeyValuePair {
+		ret := make([]*commonpb.KeyValuePair, 0)
+		for i := 0; i < len(pairs); i += 2 {
+			ret = append(ret, &commonpb.KeyValuePair{Key: pairs[i], Value: pairs[i+1]})
+		}
+		return ret
+	}
+
+	params, err := parseRankParams(kvs(TopKKey, "10"), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, RRFRankStrategy, params.strategy)
+	assert.Equal(t, float64(defaultRRFK), params.k)
+	assert.Equal(t, int64(10), params.topK)
+	assert.Equal(t, int64(0), params.offset)
+
+	params, err = parseRankParams(kvs(TopKKey, "10", OffsetKey, "5", RankStrategyKey, RRFRankStrategy, RRFKKey, "20"), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(20), params.k)
+	assert.Equal(t, int64(5), params.offset)
+
+	params, err = parseRankParams(kvs(TopKKey, "10", RankStrategyKey, WeightedRankStrategy, WeightsKey, "[0.7, 0.3]"), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, WeightedRankStrategy, params.strategy)
+	assert.Equal(t, []float64{0.7, 0.3}, params.weights)
+
+	invalids := [][]*commonpb.KeyValuePair{
+		kvs(),
+		kvs(TopKKey, "ten"),
+		kvs(TopKKey, "0"),
+		kvs(TopKKey, "10", OffsetKey, "-1"),
+		kvs(TopKKey, "10", RankStrategyKey, "max"),
+		kvs(TopKKey, "10", RRFKKey, "0"),
+		kvs(TopKKey, "10", RankStrategyKey, WeightedRankStrategy),
+		kvs(TopKKey, "10", RankStrategyKey, WeightedRankStrategy, WeightsKey, "0.7"),
+		kvs(TopKKey, "10", RankStrategyKey, WeightedRankStrategy, WeightsKey, "[1]"),
+		kvs(TopKKey, "10", RankStrategyKey, WeightedRankStrategy, WeightsKey, "[1, -1]"),
+	}
+	for _, invalid := range invalids {
+		_, err = parseRankParams(invalid, 2)
+		assert.Error(t, err)
+	}
+}
+
+func TestNormalizeScore(t *testing.T) {
+	assert.Equal(t, 0.5, normalizeScore("IP", 0))
+	assert.Greater(t, normalizeScore("IP", 2), normalizeScore("IP", 1))
+	assert.Equal(t, float64(1), normalizeScore("L2", 0))
+	assert.Greater(t, normalizeScore("L2", 1), normalizeScore("L2", 2))
+	assert.Greater(t, normalizeScore("L2", 100), float64(0))
+}
+
+func TestFuseSearchResults(t *testing.T) {
+	newResult := func(ids []int64, scores []float32, topks []int64) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: int64(len(topks)),
+			Scores:     scores,
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: ids,
+					},
+				},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "int64",
+					FieldId:   101,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{
+								LongData: &schemapb.LongArray{
+									Data: ids,
+								},
+							},
+						},
+					},
+				},
+			},
+			Topks: topks,
+		}
+	}
+	// two queries, the second query of the text search hits nothing
+	text := newResult([]int64{1, 2, 3}, []float32{0.9, 0.8, 0.1}, []int64{3, 0})
+	image := newResult([]int64{3, 2, 4, 5}, []float32{0.1, 0.2, 0.3, 0.4}, []int64{3, 1})
+	results := []*schemapb.SearchResultData{text, image}
+	metricTypes := []string{"IP", "L2"}
+
+	fused, err := fuseSearchResults(results, metricTypes, &rankParams{strategy: RRFRankStrategy, k: 1, topK: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), fused.NumQueries)
+	assert.Equal(t, int64(3), fused.TopK)
+	assert.Equal(t, []int64{3, 1}, fused.Topks)
+	// 2: 1/3 + 1/3, 3: 1/4 + 1/2, 1: 1/2, 4: 1/4
+	assert.Equal(t, []int64{3, 2, 1, 5}, fused.Ids.GetIntId().Data)
+	assert.InDelta(t, 0.75, fused.Scores[0], 1e-6)
+	assert.Equal(t, []int64{3, 2, 1, 5}, fused.FieldsData[0].GetScalars().GetLongData().Data)
+
+	fused, err = fuseSearchResults(results, metricTypes, &rankParams{strategy: RRFRankStrategy, k: 1, topK: 3, offset: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 0}, fused.Topks)
+	assert.Equal(t, []int64{2, 1, 4}, fused.Ids.GetIntId().Data)
+
+	// the image search dominates
+	fused, err = fuseSearchResults(results, metricTypes, &rankParams{strategy: WeightedRankStrategy, weights: []float64{0.1, 0.9}, topK: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 1}, fused.Topks)
+	assert.Equal(t, []int64{3, 2, 4, 1, 5}, fused.Ids.GetIntId().Data)
+
+	// sub-requests without hits
+	empty := &schemapb.SearchResultData{NumQueries: 2, Topks: []int64{0, 0}}
+	fused, err = fuseSearchResults([]*schemapb.SearchResultData{empty, empty}, metricTypes, &rankParams{strategy: RRFRankStrategy, k: 1, topK: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 0}, fused.Topks)
+	assert.Equal(t, 0, len(fused.FieldsData))
+
+	_, err = fuseSearchResults([]*schemapb.SearchResultData{text, newResult([]int64{1}, []float32{1}, []int64{1})}, metricTypes,
+		&rankParams{strategy: RRFRankStrategy, k: 1, topK: 3})
+	assert.Error(t, err)
+}
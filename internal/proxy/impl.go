@@ -17,6 +17,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 
@@ -26,6 +28,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -35,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -85,7 +89,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 
 	collectionName := request.CollectionName
 	if globalMetaCache != nil {
-		globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+		globalMetaCache.RemoveCollection(ctx, request.DbName, collectionName) // no need to return error, though collection may be not cached
 	}
 	log.Debug("InvalidateCollectionMetaCache Done",
 		zap.String("role", Params.RoleName),
@@ -98,6 +102,29 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	}, nil
 }
 
+// InvalidateCredentialCache drops the cached credential of the user, empty username means all users
+func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	log.Debug("InvalidateCredentialCache",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+
+	if globalMetaCache != nil {
+		if request.Username == "" {
+			globalMetaCache.RemoveAllCredentials()
+		} else {
+			globalMetaCache.RemoveCredential(request.Username)
+		}
+	}
+	log.Debug("InvalidateCredentialCache Done",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (node *Proxy) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	log.Debug("ReleaseDQLMessageStream",
 		zap.Any("role", Params.RoleName),
@@ -1347,6 +1374,8 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 			errIndex[i] = i
 		}
 		it.result.ErrIndex = errIndex
+	} else {
+		globalSessionTs.update(ctx, it.CollectionID, it.EndTs())
 	}
 	it.result.InsertCnt = int64(it.req.NumRows)
 	return it.result, nil
@@ -1363,6 +1392,8 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		ctx:           ctx,
 		Condition:     NewTaskCondition(ctx),
 		DeleteRequest: request,
+		chMgr:         node.chMgr,
+		chTicker:      node.chTicker,
 	}
 
 	log.Debug("Delete enqueue",
@@ -1411,9 +1442,101 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		}, nil
 	}
 
+	globalSessionTs.update(ctx, dt.collectionID, dt.EndTs())
 	return dt.result, nil
 }
 
+func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Upsert")
+	defer sp.Finish()
+	ut := &upsertTask{
+		insertTask: &insertTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			req: &milvuspb.InsertRequest{
+				Base:           request.Base,
+				DbName:         request.DbName,
+				CollectionName: request.CollectionName,
+				PartitionName:  request.PartitionName,
+				FieldsData:     request.FieldsData,
+				NumRows:        request.NumRows,
+			},
+			BaseInsertTask: BaseInsertTask{
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType: commonpb.MsgType_Insert,
+						MsgID:   0,
+					},
+					CollectionName: request.CollectionName,
+					PartitionName:  request.PartitionName,
+				},
+			},
+			rowIDAllocator: node.idAllocator,
+			segIDAssigner:  node.segAssigner,
+			chMgr:          node.chMgr,
+			chTicker:       node.chTicker,
+		},
+		upsertReq:    request,
+		tsoAllocator: node.tsoAllocator,
+	}
+	var err error
+
+	if len(ut.PartitionName) <= 0 {
+		ut.PartitionName = Params.DefaultPartitionName
+	}
+
+	log.Debug("Upsert enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("NumRows", request.NumRows))
+
+	defer func() {
+		log.Debug("Upsert Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", ut.Base.MsgID),
+			zap.Uint64("timestamp", ut.BeginTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("partition", request.PartitionName),
+			zap.Int("len(primaryKeys)", len(ut.primaryKeys)))
+	}()
+
+	failedResult := func(err error) *milvuspb.MutationResult {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ErrIndex: errIndex,
+		}
+	}
+
+	err = node.sched.dmQueue.Enqueue(ut)
+	if err != nil {
+		return failedResult(err), nil
+	}
+
+	err = ut.WaitToFinish()
+	if err != nil {
+		return failedResult(err), nil
+	}
+	globalSessionTs.update(ctx, ut.CollectionID, ut.EndTs())
+	return ut.result, nil
+}
+
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.SearchResults{
@@ -1422,21 +1545,25 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	}
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Search")
 	defer sp.Finish()
-	qt := &searchTask{
-		ctx:       ctx,
-		Condition: NewTaskCondition(ctx),
-		SearchRequest: &internalpb.SearchRequest{
-			Base: &commonpb.MsgBase{
-				MsgType:  commonpb.MsgType_Search,
-				SourceID: Params.ProxyID,
+	newSearchTask := func(excludedReplicaIDs []UniqueID) *searchTask {
+		return &searchTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			SearchRequest: &internalpb.SearchRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Search,
+					SourceID: Params.ProxyID,
+				},
+				ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
 			},
-			ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
-		},
-		resultBuf: make(chan []*internalpb.SearchResults),
-		query:     request,
-		chMgr:     node.chMgr,
-		qc:        node.queryCoord,
+			resultBuf:          make(chan []*internalpb.SearchResults),
+			query:              request,
+			chMgr:              node.chMgr,
+			qc:                 node.queryCoord,
+			excludedReplicaIDs: excludedReplicaIDs,
+		}
 	}
+	qt := newSearchTask(nil)
 
 	log.Debug("Search enqueue",
 		zap.String("role", Params.RoleName),
@@ -1481,6 +1608,19 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	}()
 
 	err = qt.WaitToFinish()
+	// fail over to another replica of the collection
+	for err != nil && qt.canRetryOnOtherReplica() {
+		log.Warn("Search failed on replica, retry with another replica",
+			zap.Error(err),
+			zap.Int64("msgID", qt.Base.MsgID),
+			zap.Int64("replicaID", qt.ReplicaID))
+		excludedReplicaIDs := append(qt.excludedReplicaIDs, qt.ReplicaID)
+		qt = newSearchTask(excludedReplicaIDs)
+		err = node.sched.dqQueue.Enqueue(qt)
+		if err == nil {
+			err = qt.WaitToFinish()
+		}
+	}
 	log.Debug("Search Finished",
 		zap.Error(err),
 		zap.String("role", Params.RoleName),
@@ -1504,6 +1644,107 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return qt.result, nil
 }
 
+// HybridSearch searches several vector fields of a collection with the sub-requests, and fuses their hits
+// into a single ranked list, the sub-requests search the same snapshot of the collection
+func (node *Proxy) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.SearchResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-HybridSearch")
+	defer sp.Finish()
+
+	failed := func(err error) (*milvuspb.SearchResults, error) {
+		log.Debug("HybridSearch failed",
+			zap.Error(err),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("HybridSearch",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("partitions", request.PartitionNames),
+		zap.Int("len(Requests)", len(request.Requests)),
+		zap.Any("RankParams", request.RankParams),
+		zap.Any("OutputFields", request.OutputFields))
+
+	if len(request.Requests) == 0 {
+		return failed(errors.New("no search request in hybrid search"))
+	}
+	params, err := parseRankParams(request.RankParams, len(request.Requests))
+	if err != nil {
+		return failed(err)
+	}
+
+	travelTimestamp := request.TravelTimestamp
+	if travelTimestamp == 0 {
+		travelTimestamp, err = node.tsoAllocator.AllocOne()
+		if err != nil {
+			return failed(err)
+		}
+	}
+	metricTypes := make([]string, len(request.Requests))
+	for i, subRequest := range request.Requests {
+		metricTypes[i], err = funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, subRequest.SearchParams)
+		if err != nil {
+			return failed(errors.New(MetricTypeKey + " not found in search_params"))
+		}
+		subRequest.DbName = request.DbName
+		subRequest.CollectionName = request.CollectionName
+		subRequest.PartitionNames = request.PartitionNames
+		subRequest.OutputFields = request.OutputFields
+		subRequest.TravelTimestamp = travelTimestamp
+		subRequest.GuaranteeTimestamp = request.GuaranteeTimestamp
+		subRequest.ConsistencyLevel = request.ConsistencyLevel
+		subRequest.UseDefaultConsistency = request.UseDefaultConsistency
+	}
+
+	subResults := make([]*milvuspb.SearchResults, len(request.Requests))
+	var wg sync.WaitGroup
+	for i := range request.Requests {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			subResults[i], _ = node.Search(ctx, request.Requests[i])
+		}(i)
+	}
+	wg.Wait()
+
+	results := make([]*schemapb.SearchResultData, len(subResults))
+	for i, subResult := range subResults {
+		if subResult.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return failed(fmt.Errorf("search request %d failed: %s", i, subResult.GetStatus().GetReason()))
+		}
+		results[i] = subResult.Results
+	}
+	fused, err := fuseSearchResults(results, metricTypes, params)
+	if err != nil {
+		return failed(err)
+	}
+
+	log.Debug("HybridSearch Done",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Int64("nq", fused.NumQueries),
+		zap.Int64("topk", fused.TopK))
+	return &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Results: fused,
+	}, nil
+}
+
 func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	resp := &milvuspb.FlushResponse{
 		Status: &commonpb.Status{
@@ -1564,30 +1805,46 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	return node.query(ctx, request, false)
+}
 
+// query runs the query, an empty expression retrieves every entity if matchAll is set
+func (node *Proxy) query(ctx context.Context, request *milvuspb.QueryRequest, matchAll bool) (*milvuspb.QueryResults, error) {
 	queryRequest := &milvuspb.QueryRequest{
-		DbName:         request.DbName,
-		CollectionName: request.CollectionName,
-		PartitionNames: request.PartitionNames,
-		Expr:           request.Expr,
-		OutputFields:   request.OutputFields,
-	}
-
-	qt := &queryTask{
-		ctx:       ctx,
-		Condition: NewTaskCondition(ctx),
-		RetrieveRequest: &internalpb.RetrieveRequest{
-			Base: &commonpb.MsgBase{
-				MsgType:  commonpb.MsgType_Retrieve,
-				SourceID: Params.ProxyID,
+		DbName:                request.DbName,
+		CollectionName:        request.CollectionName,
+		PartitionNames:        request.PartitionNames,
+		Expr:                  request.Expr,
+		OutputFields:          request.OutputFields,
+		TravelTimestamp:       request.TravelTimestamp,
+		TravelTime:            request.TravelTime,
+		GuaranteeTimestamp:    request.GuaranteeTimestamp,
+		ConsistencyLevel:      request.ConsistencyLevel,
+		UseDefaultConsistency: request.UseDefaultConsistency,
+		Limit:                 request.Limit,
+		Offset:                request.Offset,
+	}
+
+	newQueryTask := func(excludedReplicaIDs []UniqueID) *queryTask {
+		return &queryTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			RetrieveRequest: &internalpb.RetrieveRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Retrieve,
+					SourceID: Params.ProxyID,
+				},
+				ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
 			},
-			ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
-		},
-		resultBuf: make(chan []*internalpb.RetrieveResults),
-		query:     queryRequest,
-		chMgr:     node.chMgr,
-		qc:        node.queryCoord,
+			resultBuf:          make(chan []*internalpb.RetrieveResults),
+			query:              queryRequest,
+			chMgr:              node.chMgr,
+			qc:                 node.queryCoord,
+			excludedReplicaIDs: excludedReplicaIDs,
+			matchAll:           matchAll,
+		}
 	}
+	qt := newQueryTask(nil)
 
 	log.Debug("Query enqueue",
 		zap.String("role", Params.RoleName),
@@ -1623,6 +1880,19 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	}()
 
 	err = qt.WaitToFinish()
+	// fail over to another replica of the collection
+	for err != nil && qt.canRetryOnOtherReplica() {
+		log.Warn("Query failed on replica, retry with another replica",
+			zap.Error(err),
+			zap.Int64("msgID", qt.Base.MsgID),
+			zap.Int64("replicaID", qt.ReplicaID))
+		excludedReplicaIDs := append(qt.excludedReplicaIDs, qt.ReplicaID)
+		qt = newQueryTask(excludedReplicaIDs)
+		err = node.sched.dqQueue.Enqueue(qt)
+		if err == nil {
+			err = qt.WaitToFinish()
+		}
+	}
 	if err != nil {
 		return &milvuspb.QueryResults{
 			Status: &commonpb.Status{
@@ -1638,6 +1908,131 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	}, nil
 }
 
+// QueryIterator returns a batch of the entities matching the expression in the order of primary key, with the
+// cursor of the next batch, all batches are read at the same timestamp so the iteration sees a single snapshot.
+// The snapshot is kept for common.retentionDuration only (5 days by default), the cursors older than that expire.
+func (node *Proxy) QueryIterator(ctx context.Context, request *milvuspb.QueryIteratorRequest) (*milvuspb.QueryIteratorResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.QueryIteratorResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-QueryIterator")
+	defer sp.Finish()
+
+	failed := func(err error) (*milvuspb.QueryIteratorResults, error) {
+		log.Debug("QueryIterator failed",
+			zap.Error(err),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+		return &milvuspb.QueryIteratorResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("QueryIterator",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("partitions", request.PartitionNames),
+		zap.Int64("batchSize", request.BatchSize),
+		zap.String("cursor", request.Cursor))
+
+	if request.BatchSize <= 0 {
+		return failed(fmt.Errorf("batch size %d should be positive", request.BatchSize))
+	}
+	if err := validateResultWindow(0, request.BatchSize); err != nil {
+		return failed(err)
+	}
+
+	// the iteration is ordered by primary key, so only int64 primary keys are supported
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.DbName, request.CollectionName)
+	if err != nil {
+		return failed(err)
+	}
+	pkField, err := getQueryIteratorPKField(schema)
+	if err != nil {
+		return failed(err)
+	}
+
+	var cursor *queryCursor
+	travelTimestamp := request.TravelTimestamp
+	if request.Cursor != "" {
+		cursor, err = decodeQueryCursor(request.Cursor)
+		if err != nil {
+			return failed(err)
+		}
+		if err = checkQueryCursorExpired(cursor, time.Now()); err != nil {
+			return failed(err)
+		}
+		travelTimestamp = cursor.TravelTimestamp
+	} else if travelTimestamp == 0 {
+		travelTimestamp, err = node.tsoAllocator.AllocOne()
+		if err != nil {
+			return failed(err)
+		}
+	}
+
+	// every segment retrieves at most batch size entities after the cursor in the order of primary key,
+	// an empty expression without cursor matches every entity
+	resp, err := node.query(ctx, &milvuspb.QueryRequest{
+		Base:               request.Base,
+		DbName:             request.DbName,
+		CollectionName:     request.CollectionName,
+		PartitionNames:     request.PartitionNames,
+		Expr:               queryIteratorExpr(pkField.Name, request.Expr, cursor),
+		OutputFields:       request.OutputFields,
+		TravelTimestamp:    travelTimestamp,
+		GuaranteeTimestamp: travelTimestamp,
+		Limit:              request.BatchSize,
+	}, true)
+	if err != nil {
+		return failed(err)
+	}
+	ret := &milvuspb.QueryIteratorResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		FieldsData: make([]*schemapb.FieldData, 0),
+	}
+	switch resp.GetStatus().GetErrorCode() {
+	case commonpb.ErrorCode_Success:
+		ret.FieldsData = resp.FieldsData
+	case commonpb.ErrorCode_EmptyCollection:
+		// no entity left
+	default:
+		return &milvuspb.QueryIteratorResults{Status: resp.Status}, nil
+	}
+
+	var pks []int64
+	for _, fieldData := range ret.FieldsData {
+		if fieldData.FieldName == pkField.Name {
+			pks = fieldData.GetScalars().GetLongData().GetData()
+		}
+	}
+	// a full batch may be followed by more entities
+	if int64(len(pks)) >= request.BatchSize {
+		ret.Cursor, err = encodeQueryCursor(&queryCursor{
+			TravelTimestamp: travelTimestamp,
+			LastPK:          pks[len(pks)-1],
+		})
+		if err != nil {
+			return failed(err)
+		}
+	}
+
+	log.Debug("QueryIterator Done",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Int("len(pks)", len(pks)),
+		zap.String("cursor", ret.Cursor))
+	return ret, nil
+}
+
 func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
@@ -1774,38 +2169,174 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	return aat.result, nil
 }
 
-func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
-	param, _ := funcutil.GetAttrByKeyFromRepeatedKV("metric", request.GetParams())
-	metric, err := distance.ValidateMetricType(param)
+func (node *Proxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	cdt := &CreateDatabaseTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: request,
+		rootCoord:             node.rootCoord,
+	}
+
+	err := node.sched.ddQueue.Enqueue(cdt)
 	if err != nil {
-		return &milvuspb.CalcDistanceResults{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    err.Error(),
-			},
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
 		}, nil
 	}
 
-	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
-		outputFields := []string{ids.FieldName}
+	log.Debug("CreateDatabase",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("CreateDatabase Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
 
-		queryRequest := &milvuspb.QueryRequest{
-			DbName:         "",
-			CollectionName: ids.CollectionName,
-			PartitionNames: ids.PartitionNames,
-			OutputFields:   outputFields,
-		}
+	err = cdt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
 
-		qt := &queryTask{
-			ctx:       ctx,
-			Condition: NewTaskCondition(ctx),
-			RetrieveRequest: &internalpb.RetrieveRequest{
-				Base: &commonpb.MsgBase{
-					MsgType:  commonpb.MsgType_Retrieve,
-					SourceID: Params.ProxyID,
-				},
-				ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
-			},
+	return cdt.result, nil
+}
+
+func (node *Proxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	ddt := &DropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	err := node.sched.ddQueue.Enqueue(ddt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("DropDatabase",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("DropDatabase Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
+
+	err = ddt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return ddt.result, nil
+}
+
+func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ListDatabasesResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	ldt := &ListDatabasesTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: request,
+		rootCoord:            node.rootCoord,
+	}
+
+	err := node.sched.ddQueue.Enqueue(ldt)
+	if err != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("ListDatabases",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp))
+	defer func() {
+		log.Debug("ListDatabases Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp))
+	}()
+
+	err = ldt.WaitToFinish()
+	if err != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	return ldt.result, nil
+}
+
+func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
+	param, _ := funcutil.GetAttrByKeyFromRepeatedKV("metric", request.GetParams())
+	metric, err := distance.ValidateMetricType(param)
+	if err != nil {
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
+		outputFields := []string{ids.FieldName}
+
+		queryRequest := &milvuspb.QueryRequest{
+			DbName:         "",
+			CollectionName: ids.CollectionName,
+			PartitionNames: ids.PartitionNames,
+			OutputFields:   outputFields,
+		}
+
+		qt := &queryTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			RetrieveRequest: &internalpb.RetrieveRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Retrieve,
+					SourceID: Params.ProxyID,
+				},
+				ResultChannelID: strconv.FormatInt(Params.ProxyID, 10),
+			},
 			resultBuf: make(chan []*internalpb.RetrieveResults),
 			query:     queryRequest,
 			chMgr:     node.chMgr,
@@ -2381,6 +2912,187 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 	}, nil
 }
 
+// ManualCompaction invokes compaction on specified collection
+func (node *Proxy) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	log.Debug("ManualCompaction",
+		zap.String("role", Params.RoleName),
+		zap.Int64("collectionID", req.GetCollectionID()))
+
+	resp := &milvuspb.ManualCompactionResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.dataCoord.ManualCompaction(ctx, req)
+	log.Debug("ManualCompaction received",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Any("resp", resp),
+		zap.Error(err))
+	return resp, err
+}
+
+// GetCompactionState gets the compaction state of specified compaction id
+func (node *Proxy) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	log.Debug("GetCompactionState",
+		zap.String("role", Params.RoleName),
+		zap.Int64("compactionID", req.GetCompactionID()))
+
+	resp := &milvuspb.GetCompactionStateResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.dataCoord.GetCompactionState(ctx, req)
+	log.Debug("GetCompactionState received",
+		zap.Int64("compactionID", req.GetCompactionID()),
+		zap.Any("resp", resp),
+		zap.Error(err))
+	return resp, err
+}
+
+// BulkLoad imports the files in object storage into the specified collection or partition,
+// the files are parsed and written to segments by data nodes, the task id returned can be used
+// to query the state of import via GetImportState
+func (node *Proxy) BulkLoad(ctx context.Context, req *milvuspb.BulkLoadRequest) (*milvuspb.BulkLoadResponse, error) {
+	log.Debug("BulkLoad",
+		zap.String("role", Params.RoleName),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("partition", req.GetPartitionName()),
+		zap.Strings("files", req.GetFiles()))
+
+	resp := &milvuspb.BulkLoadResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+	if len(req.GetFiles()) == 0 {
+		resp.Status.Reason = "no file to import"
+		return resp, nil
+	}
+
+	partitionName := req.GetPartitionName()
+	if partitionName == "" {
+		partitionName = Params.DefaultPartitionName
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, req.GetDbName(), req.GetCollectionName(), partitionName)
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	describeResp, err := node.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DescribeCollection,
+			SourceID: Params.ProxyID,
+		},
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+	})
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	if describeResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		resp.Status = describeResp.GetStatus()
+		return resp, nil
+	}
+
+	importResp, err := node.dataCoord.Import(ctx, &datapb.ImportTask{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+		ChannelNames: describeResp.GetVirtualChannelNames(),
+		Files:        req.GetFiles(),
+	})
+	log.Debug("BulkLoad received",
+		zap.Int64("collectionID", collectionID),
+		zap.Int64("partitionID", partitionID),
+		zap.Any("resp", importResp),
+		zap.Error(err))
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	resp.Status = importResp.GetStatus()
+	resp.TaskID = importResp.GetTaskID()
+	return resp, nil
+}
+
+// GetImportState gets the state of the import task with specified task id
+func (node *Proxy) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	log.Debug("GetImportState",
+		zap.String("role", Params.RoleName),
+		zap.Int64("taskID", req.GetTaskID()))
+
+	resp := &milvuspb.GetImportStateResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.dataCoord.GetImportState(ctx, req)
+	log.Debug("GetImportState received",
+		zap.Int64("taskID", req.GetTaskID()),
+		zap.Any("resp", resp),
+		zap.Error(err))
+	return resp, err
+}
+
+// LoadBalance moves the sealed segments of the source query node to the destination query nodes
+func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceRequest) (*commonpb.Status, error) {
+	log.Debug("LoadBalance",
+		zap.String("role", Params.RoleName),
+		zap.Int64("srcNodeID", req.SrcNodeID),
+		zap.Int64s("dstNodeIDs", req.DstNodeIDs),
+		zap.Int64s("sealedSegmentIDs", req.SealedSegmentIDs))
+
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	infoResp, err := node.queryCoord.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_LoadBalanceSegments,
+			MsgID:     0,
+			Timestamp: 0,
+			SourceID:  Params.ProxyID,
+		},
+		SourceNodeIDs:    []int64{req.SrcNodeID},
+		DstNodeIDs:       req.DstNodeIDs,
+		BalanceReason:    querypb.TriggerCondition_loadBalance,
+		SealedSegmentIDs: req.SealedSegmentIDs,
+	})
+	if err != nil {
+		log.Error("Failed to LoadBalance from Query Coordinator",
+			zap.Any("req", req), zap.Error(err))
+		status.Reason = err.Error()
+		return status, nil
+	}
+	if infoResp.ErrorCode != commonpb.ErrorCode_Success {
+		log.Error("LoadBalance failed to query coordinator", zap.String("errMsg", infoResp.Reason))
+		status.Reason = infoResp.Reason
+		return status, nil
+	}
+	log.Debug("LoadBalance Done", zap.Any("req", req), zap.Any("status", infoResp))
+	status.ErrorCode = commonpb.ErrorCode_Success
+	return status, nil
+}
+
 // checkHealthy checks proxy state is Healthy
 func (node *Proxy) checkHealthy() bool {
 	code := node.stateCode.Load().(internalpb.StateCode)
@@ -2393,3 +3105,382 @@ func unhealthyStatus() *commonpb.Status {
 		Reason:    "proxy not healthy",
 	}
 }
+
+// CreateCredential creates a user, the password is encrypted before it's sent to root coord
+func (node *Proxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("CreateCredential", zap.String("role", Params.RoleName), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := ValidatePassword(req.Password); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	encryptedPassword, err := crypto.PasswordEncrypt(req.Password)
+	if err != nil {
+		log.Error("encrypt password failed", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "encrypt password failed: " + err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_CreateCredential,
+			SourceID: Params.ProxyID,
+		},
+		Username: req.Username,
+		Password: encryptedPassword,
+	})
+	if err != nil {
+		log.Error("CreateCredential failed", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Debug("CreateCredential Done", zap.String("username", req.Username), zap.Any("status", result))
+	return result, nil
+}
+
+// UpdateCredential changes the password of a user, the old password must be provided
+func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("UpdateCredential", zap.String("role", Params.RoleName), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := ValidatePassword(req.NewPassword); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, req.Username)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if !crypto.PasswordVerify(req.OldPassword, credInfo.encryptedPassword) {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "old password is not correct",
+		}, nil
+	}
+	encryptedPassword, err := crypto.PasswordEncrypt(req.NewPassword)
+	if err != nil {
+		log.Error("encrypt password failed", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "encrypt password failed: " + err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.UpdateCredential(ctx, &milvuspb.UpdateCredentialRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_UpdateCredential,
+			SourceID: Params.ProxyID,
+		},
+		Username:    req.Username,
+		NewPassword: encryptedPassword,
+	})
+	if err != nil {
+		log.Error("UpdateCredential failed", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Debug("UpdateCredential Done", zap.String("username", req.Username), zap.Any("status", result))
+	return result, nil
+}
+
+// DeleteCredential deletes a user, the root user can't be deleted
+func (node *Proxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("DeleteCredential", zap.String("role", Params.RoleName), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if req.Username == common.DefaultRootUser {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    fmt.Sprintf("user %s can't be deleted", common.DefaultRootUser),
+		}, nil
+	}
+	result, err := node.rootCoord.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DeleteCredential,
+			SourceID: Params.ProxyID,
+		},
+		Username: req.Username,
+	})
+	if err != nil {
+		log.Error("DeleteCredential failed", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Debug("DeleteCredential Done", zap.String("username", req.Username), zap.Any("status", result))
+	return result, nil
+}
+
+// ListCredUsers lists the names of all users
+func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	log.Debug("ListCredUsers", zap.String("role", Params.RoleName))
+	if !node.checkHealthy() {
+		return &milvuspb.ListCredUsersResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	result, err := node.rootCoord.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ListCredUsernames,
+			SourceID: Params.ProxyID,
+		},
+	})
+	if err != nil {
+		log.Error("ListCredUsers failed", zap.Error(err))
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListCredUsers Done", zap.Strings("usernames", result.Usernames))
+	return result, nil
+}
+
+// CreateRole creates a role without any privilege
+func (node *Proxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	log.Debug("CreateRole", zap.String("role", Params.RoleName), zap.String("roleName", req.RoleName))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.CreateRole(ctx, &milvuspb.CreateRoleRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_CreateRole,
+			SourceID: Params.ProxyID,
+		},
+		RoleName: req.RoleName,
+	})
+	if err != nil {
+		log.Error("CreateRole failed", zap.String("roleName", req.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Debug("CreateRole Done", zap.String("roleName", req.RoleName), zap.Any("status", result))
+	return result, nil
+}
+
+// DropRole drops a role, the role is revoked from all its users
+func (node *Proxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	log.Debug("DropRole", zap.String("role", Params.RoleName), zap.String("roleName", req.RoleName))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	result, err := node.rootCoord.DropRole(ctx, &milvuspb.DropRoleRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DropRole,
+			SourceID: Params.ProxyID,
+		},
+		RoleName: req.RoleName,
+	})
+	if err != nil {
+		log.Error("DropRole failed", zap.String("roleName", req.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Debug("DropRole Done", zap.String("roleName", req.RoleName), zap.Any("status", result))
+	return result, nil
+}
+
+// AddUserToRole grants a role to a user
+func (node *Proxy) AddUserToRole(ctx context.Context, req *milvuspb.AddUserToRoleRequest) (*commonpb.Status, error) {
+	log.Debug("AddUserToRole", zap.String("role", Params.RoleName),
+		zap.String("username", req.Username), zap.String("roleName", req.RoleName))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	result, err := node.rootCoord.AddUserToRole(ctx, &milvuspb.AddUserToRoleRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_AddUserToRole,
+			SourceID: Params.ProxyID,
+		},
+		Username: req.Username,
+		RoleName: req.RoleName,
+	})
+	if err != nil {
+		log.Error("AddUserToRole failed", zap.String("username", req.Username),
+			zap.String("roleName", req.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Debug("AddUserToRole Done", zap.String("username", req.Username),
+		zap.String("roleName", req.RoleName), zap.Any("status", result))
+	return result, nil
+}
+
+// RemoveUserFromRole revokes a role from a user
+func (node *Proxy) RemoveUserFromRole(ctx context.Context, req *milvuspb.RemoveUserFromRoleRequest) (*commonpb.Status, error) {
+	log.Debug("RemoveUserFromRole", zap.String("role", Params.RoleName),
+		zap.String("username", req.Username), zap.String("roleName", req.RoleName))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	result, err := node.rootCoord.RemoveUserFromRole(ctx, &milvuspb.RemoveUserFromRoleRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_RemoveUserFromRole,
+			SourceID: Params.ProxyID,
+		},
+		Username: req.Username,
+		RoleName: req.RoleName,
+	})
+	if err != nil {
+		log.Error("RemoveUserFromRole failed", zap.String("username", req.Username),
+			zap.String("roleName", req.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Debug("RemoveUserFromRole Done", zap.String("username", req.Username),
+		zap.String("roleName", req.RoleName), zap.Any("status", result))
+	return result, nil
+}
+
+// validateGrantEntity checks the grant entity, an empty object name means all collections of the database
+func validateGrantEntity(entity *milvuspb.GrantEntity) error {
+	if entity == nil {
+		return errors.New("grant entity should not be empty")
+	}
+	if entity.DbName != "" {
+		if err := ValidateDatabaseName(entity.DbName); err != nil {
+			return err
+		}
+	}
+	if entity.ObjectName == "" {
+		entity.ObjectName = allObjects
+	}
+	if entity.ObjectName != allObjects {
+		if err := ValidateCollectionName(entity.ObjectName); err != nil {
+			return err
+		}
+	}
+	return ValidatePrivilege(entity.Privilege)
+}
+
+// GrantPrivilege grants a privilege on a collection to a role
+func (node *Proxy) GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	log.Debug("GrantPrivilege", zap.String("role", Params.RoleName), zap.Any("entity", req.Entity))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := validateGrantEntity(req.Entity); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.GrantPrivilege(ctx, &milvuspb.GrantPrivilegeRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GrantPrivilege,
+			SourceID: Params.ProxyID,
+		},
+		Entity: req.Entity,
+	})
+	if err != nil {
+		log.Error("GrantPrivilege failed", zap.Any("entity", req.Entity), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Debug("GrantPrivilege Done", zap.Any("entity", req.Entity), zap.Any("status", result))
+	return result, nil
+}
+
+// RevokePrivilege revokes a privilege on a collection from a role
+func (node *Proxy) RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	log.Debug("RevokePrivilege", zap.String("role", Params.RoleName), zap.Any("entity", req.Entity))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := validateGrantEntity(req.Entity); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	result, err := node.rootCoord.RevokePrivilege(ctx, &milvuspb.RevokePrivilegeRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_RevokePrivilege,
+			SourceID: Params.ProxyID,
+		},
+		Entity: req.Entity,
+	})
+	if err != nil {
+		log.Error("RevokePrivilege failed", zap.Any("entity", req.Entity), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Debug("RevokePrivilege Done", zap.Any("entity", req.Entity), zap.Any("status", result))
+	return result, nil
+}
+
+// ListGrants lists the privileges granted to a role, empty role name means all roles
+func (node *Proxy) ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	log.Debug("ListGrants", zap.String("role", Params.RoleName), zap.String("roleName", req.RoleName))
+	if !node.checkHealthy() {
+		return &milvuspb.ListGrantsResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	result, err := node.rootCoord.ListGrants(ctx, &milvuspb.ListGrantsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ListGrants,
+			SourceID: Params.ProxyID,
+		},
+		RoleName: req.RoleName,
+	})
+	if err != nil {
+		log.Error("ListGrants failed", zap.String("roleName", req.RoleName), zap.Error(err))
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListGrants Done", zap.String("roleName", req.RoleName), zap.Int("grants", len(result.Entities)))
+	return result, nil
+}
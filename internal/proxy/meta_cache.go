@@ -25,20 +25,31 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// Cache caches the collection meta of the databases, an empty database name means the default database
 type Cache interface {
-	GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
-	GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error)
-	GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
-	GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
-	GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error)
-	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
-	RemoveCollection(ctx context.Context, collectionName string)
-	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	GetCollectionID(ctx context.Context, dbName string, collectionName string) (typeutil.UniqueID, error)
+	GetCollectionInfo(ctx context.Context, dbName string, collectionName string) (*collectionInfo, error)
+	GetPartitionID(ctx context.Context, dbName string, collectionName string, partitionName string) (typeutil.UniqueID, error)
+	GetPartitions(ctx context.Context, dbName string, collectionName string) (map[string]typeutil.UniqueID, error)
+	GetPartitionInfo(ctx context.Context, dbName string, collectionName string, partitionName string) (*partitionInfo, error)
+	GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error)
+	// GetCollectionSchemaAt returns the schema of the collection at the timestamp, it is not cached
+	GetCollectionSchemaAt(ctx context.Context, dbName string, collectionID typeutil.UniqueID, ts typeutil.Timestamp) (*schemapb.CollectionSchema, error)
+	RemoveCollection(ctx context.Context, dbName string, collectionName string)
+	RemovePartition(ctx context.Context, dbName string, collectionName string, partitionName string)
+
+	// GetCredentialInfo returns the encrypted password and the grants of the user
+	GetCredentialInfo(ctx context.Context, username string) (*credentialInfo, error)
+	// UpdateCredentialInfo caches the password hash which has been verified against the encrypted password
+	UpdateCredentialInfo(username string, verifiedPassword string)
+	RemoveCredential(username string)
+	RemoveAllCredentials()
 }
 
 type collectionInfo struct {
@@ -47,6 +58,7 @@ type collectionInfo struct {
 	partInfo            map[string]*partitionInfo
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
+	consistencyLevel    commonpb.ConsistencyLevel
 }
 
 type partitionInfo struct {
@@ -55,11 +67,22 @@ type partitionInfo struct {
 	createdUtcTimestamp uint64
 }
 
+type credentialInfo struct {
+	username          string
+	encryptedPassword string
+	// sha256 of the raw password which has passed the bcrypt verification, bcrypt is too slow to run for every request
+	verifiedPassword string
+	grants           []*milvuspb.GrantEntity
+}
+
 type MetaCache struct {
 	client types.RootCoord
 
-	collInfo map[string]*collectionInfo
+	collInfo map[string]map[string]*collectionInfo // database name -> collection name -> collection info
 	mu       sync.RWMutex
+
+	credMap map[string]*credentialInfo // username -> credential info
+	credMut sync.RWMutex
 }
 
 var globalMetaCache Cache
@@ -76,24 +99,34 @@ func InitMetaCache(client types.RootCoord) error {
 func NewMetaCache(client types.RootCoord) (*MetaCache, error) {
 	return &MetaCache{
 		client:   client,
-		collInfo: map[string]*collectionInfo{},
+		collInfo: map[string]map[string]*collectionInfo{},
+		credMap:  map[string]*credentialInfo{},
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+// getDbName return the database name used in meta cache, empty name means the default database
+func getDbName(dbName string) string {
+	if dbName == "" {
+		return common.DefaultDatabaseName
+	}
+	return dbName
+}
+
+func (m *MetaCache) GetCollectionID(ctx context.Context, dbName string, collectionName string) (typeutil.UniqueID, error) {
+	dbName = getDbName(dbName)
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.collInfo[dbName][collectionName]
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			return 0, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		m.updateCollection(coll, dbName, collectionName)
+		collInfo = m.collInfo[dbName][collectionName]
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
@@ -101,21 +134,22 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string)
 	return collInfo.collID, nil
 }
 
-func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
+func (m *MetaCache) GetCollectionInfo(ctx context.Context, dbName string, collectionName string) (*collectionInfo, error) {
+	dbName = getDbName(dbName)
 	m.mu.RLock()
 	var collInfo *collectionInfo
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.collInfo[dbName][collectionName]
 	m.mu.RUnlock()
 
 	if !ok {
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		m.updateCollection(coll, dbName, collectionName)
+		collInfo = m.collInfo[dbName][collectionName]
 	}
 
 	return &collectionInfo{
@@ -124,17 +158,19 @@ func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string
 		partInfo:            collInfo.partInfo,
 		createdTimestamp:    collInfo.createdTimestamp,
 		createdUtcTimestamp: collInfo.createdUtcTimestamp,
+		consistencyLevel:    collInfo.consistencyLevel,
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *MetaCache) GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error) {
+	dbName = getDbName(dbName)
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.collInfo[dbName][collectionName]
 
 	if !ok {
 		t0 := time.Now()
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			log.Warn("Failed to load collection from rootcoord ",
 				zap.String("collection name ", collectionName),
@@ -143,8 +179,8 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		m.updateCollection(coll, dbName, collectionName)
+		collInfo = m.collInfo[dbName][collectionName]
 		log.Debug("Reload collection from rootcoord ",
 			zap.String("collection name ", collectionName),
 			zap.Any("time take ", time.Since(t0)))
@@ -155,34 +191,54 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) GetCollectionSchemaAt(ctx context.Context, dbName string, collectionID typeutil.UniqueID, ts typeutil.Timestamp) (*schemapb.CollectionSchema, error) {
+	coll, err := m.doDescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_DescribeCollection,
+		},
+		DbName:       getDbName(dbName),
+		CollectionID: collectionID,
+		TimeStamp:    ts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return coll.Schema, nil
+}
+
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, dbName string, collectionName string) {
+	if _, ok := m.collInfo[dbName]; !ok {
+		m.collInfo[dbName] = map[string]*collectionInfo{}
+	}
+	_, ok := m.collInfo[dbName][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{}
+		m.collInfo[dbName][collectionName] = &collectionInfo{}
 	}
-	m.collInfo[collectionName].schema = coll.Schema
-	m.collInfo[collectionName].collID = coll.CollectionID
-	m.collInfo[collectionName].createdTimestamp = coll.CreatedTimestamp
-	m.collInfo[collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	m.collInfo[dbName][collectionName].schema = coll.Schema
+	m.collInfo[dbName][collectionName].collID = coll.CollectionID
+	m.collInfo[dbName][collectionName].createdTimestamp = coll.CreatedTimestamp
+	m.collInfo[dbName][collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	m.collInfo[dbName][collectionName].consistencyLevel = coll.ConsistencyLevel
 }
 
-func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
-	partInfo, err := m.GetPartitionInfo(ctx, collectionName, partitionName)
+func (m *MetaCache) GetPartitionID(ctx context.Context, dbName string, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	partInfo, err := m.GetPartitionInfo(ctx, dbName, collectionName, partitionName)
 	if err != nil {
 		return 0, err
 	}
 	return partInfo.partitionID, nil
 }
 
-func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitions(ctx context.Context, dbName string, collectionName string) (map[string]typeutil.UniqueID, error) {
+	dbName = getDbName(dbName)
+	_, err := m.GetCollectionID(ctx, dbName, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.collInfo[dbName][collectionName]
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -191,7 +247,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	if collInfo.partInfo == nil || len(collInfo.partInfo) == 0 {
 		m.mu.RUnlock()
 
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -199,13 +255,13 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		err = m.updatePartitions(partitions, collectionName)
+		err = m.updatePartitions(partitions, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		log.Debug("proxy", zap.Any("GetPartitions:partitions after update", partitions), zap.Any("collectionName", collectionName))
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[collectionName].partInfo
+		partInfo := m.collInfo[dbName][collectionName].partInfo
 		for k, v := range partInfo {
 			ret[k] = v.partitionID
 		}
@@ -215,7 +271,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	defer m.mu.RUnlock()
 
 	ret := make(map[string]typeutil.UniqueID)
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := m.collInfo[dbName][collectionName].partInfo
 	for k, v := range partInfo {
 		ret[k] = v.partitionID
 	}
@@ -223,15 +279,16 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	return ret, nil
 }
 
-func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitionInfo(ctx context.Context, dbName string, collectionName string, partitionName string) (*partitionInfo, error) {
+	dbName = getDbName(dbName)
+	_, err := m.GetCollectionID(ctx, dbName, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.collInfo[dbName][collectionName]
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -242,20 +299,20 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	m.mu.RUnlock()
 
 	if !ok {
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		err = m.updatePartitions(partitions, collectionName)
+		err = m.updatePartitions(partitions, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.Any("collectionName", collectionName))
 
-		partInfo, ok = m.collInfo[collectionName].partInfo[partitionName]
+		partInfo, ok = m.collInfo[dbName][collectionName].partInfo[partitionName]
 		if !ok {
 			return nil, fmt.Errorf("partitionID of partitionName:%s can not be find", partitionName)
 		}
@@ -267,13 +324,18 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	}, nil
 }
 
-func (m *MetaCache) describeCollection(ctx context.Context, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+func (m *MetaCache) describeCollection(ctx context.Context, dbName string, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
 	req := &milvuspb.DescribeCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_DescribeCollection,
 		},
+		DbName:         dbName,
 		CollectionName: collectionName,
 	}
+	return m.doDescribeCollection(ctx, req)
+}
+
+func (m *MetaCache) doDescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
 	coll, err := m.client.DescribeCollection(ctx, req)
 	if err != nil {
 		return nil, err
@@ -294,6 +356,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 		PhysicalChannelNames: coll.PhysicalChannelNames,
 		CreatedTimestamp:     coll.CreatedTimestamp,
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
+		ConsistencyLevel:     coll.ConsistencyLevel,
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= common.StartOfUserFieldID {
@@ -303,11 +366,12 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 	return resp, nil
 }
 
-func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
+func (m *MetaCache) showPartitions(ctx context.Context, dbName string, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
 	req := &milvuspb.ShowPartitionsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_ShowPartitions,
 		},
+		DbName:         dbName,
 		CollectionName: collectionName,
 	}
 
@@ -327,14 +391,17 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string) error {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, dbName string, collectionName string) error {
+	if _, ok := m.collInfo[dbName]; !ok {
+		m.collInfo[dbName] = map[string]*collectionInfo{}
+	}
+	_, ok := m.collInfo[dbName][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{
+		m.collInfo[dbName][collectionName] = &collectionInfo{
 			partInfo: map[string]*partitionInfo{},
 		}
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := m.collInfo[dbName][collectionName].partInfo
 	if partInfo == nil {
 		partInfo = map[string]*partitionInfo{}
 	}
@@ -353,26 +420,85 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 			}
 		}
 	}
-	m.collInfo[collectionName].partInfo = partInfo
+	m.collInfo[dbName][collectionName].partInfo = partInfo
 	return nil
 }
 
-func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
+func (m *MetaCache) RemoveCollection(ctx context.Context, dbName string, collectionName string) {
+	dbName = getDbName(dbName)
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	delete(m.collInfo, collectionName)
+	delete(m.collInfo[dbName], collectionName)
 }
 
-func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
+func (m *MetaCache) RemovePartition(ctx context.Context, dbName string, collectionName, partitionName string) {
+	dbName = getDbName(dbName)
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	_, ok := m.collInfo[collectionName]
+	_, ok := m.collInfo[dbName][collectionName]
 	if !ok {
 		return
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := m.collInfo[dbName][collectionName].partInfo
 	if partInfo == nil {
 		return
 	}
 	delete(partInfo, partitionName)
 }
+
+func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*credentialInfo, error) {
+	m.credMut.RLock()
+	credInfo, ok := m.credMap[username]
+	m.credMut.RUnlock()
+
+	if !ok {
+		req := &rootcoordpb.GetCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GetCredential,
+			},
+			Username: username,
+		}
+		resp, err := m.client.GetCredential(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, errors.New(resp.Status.Reason)
+		}
+		credInfo = &credentialInfo{
+			username:          resp.Username,
+			encryptedPassword: resp.EncryptedPassword,
+			grants:            resp.Grants,
+		}
+		m.credMut.Lock()
+		m.credMap[username] = credInfo
+		m.credMut.Unlock()
+	}
+
+	return &credentialInfo{
+		username:          credInfo.username,
+		encryptedPassword: credInfo.encryptedPassword,
+		verifiedPassword:  credInfo.verifiedPassword,
+		grants:            credInfo.grants,
+	}, nil
+}
+
+func (m *MetaCache) UpdateCredentialInfo(username string, verifiedPassword string) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	if credInfo, ok := m.credMap[username]; ok {
+		credInfo.verifiedPassword = verifiedPassword
+	}
+}
+
+func (m *MetaCache) RemoveCredential(username string) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	delete(m.credMap, username)
+}
+
+func (m *MetaCache) RemoveAllCredentials() {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	m.credMap = map[string]*credentialInfo{}
+}
@@ -17,9 +17,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -85,6 +87,19 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 		return nil, errors.New("mocked error")
 	}
 	m.AccessCount++
+	// collection 1 had no description before it was altered
+	if in.CollectionName == "" && in.CollectionID == 1 && in.TimeStamp != 0 {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			CollectionID: typeutil.UniqueID(1),
+			Schema: &schemapb.CollectionSchema{
+				AutoID:      true,
+				Description: "old",
+			},
+		}, nil
+	}
 	if in.CollectionName == "collection1" {
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
@@ -105,6 +120,7 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 			Schema: &schemapb.CollectionSchema{
 				AutoID: true,
 			},
+			ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
 		}, nil
 	}
 	if in.CollectionName == "errorCollection" {
@@ -129,6 +145,31 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 	}, nil
 }
 
+func (m *MockRootCoordClientInterface) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	if m.Error {
+		return nil, errors.New("mocked error")
+	}
+	m.AccessCount++
+	if in.Username == "user1" {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			Username:          "user1",
+			EncryptedPassword: "encrypted",
+			Grants: []*milvuspb.GrantEntity{
+				{RoleName: "role1", DbName: common.DefaultDatabaseName, ObjectName: "collection1", Privilege: PrivilegeSearch},
+			},
+		}, nil
+	}
+	return &rootcoordpb.GetCredentialResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "user not exist",
+		},
+	}, nil
+}
+
 //Simulate the cache path and the
 func TestMetaCache_GetCollection(t *testing.T) {
 	ctx := context.Background()
@@ -136,24 +177,24 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
 	assert.Equal(t, client.AccessCount, 1)
 
 	// should'nt be accessed to remote root coord.
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 1)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
 		Fields: []*schemapb.FieldSchema{},
 	})
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection2")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection2")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection2")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection2")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -162,11 +203,11 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	})
 
 	// test to get from cache, this should trigger root request
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -176,6 +217,35 @@ func TestMetaCache_GetCollection(t *testing.T) {
 
 }
 
+func TestMetaCache_GetCollectionOfDatabase(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	_, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, client.AccessCount, 1)
+
+	// empty database name means the default database
+	_, err = globalMetaCache.GetCollectionID(ctx, common.DefaultDatabaseName, "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, client.AccessCount, 1)
+
+	// collections in different databases are cached separately
+	_, err = globalMetaCache.GetCollectionID(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, client.AccessCount, 2)
+
+	globalMetaCache.RemoveCollection(ctx, "db1", "collection1")
+	_, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, client.AccessCount, 2)
+	_, err = globalMetaCache.GetCollectionID(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, client.AccessCount, 3)
+}
+
 func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	ctx := context.Background()
 	client := &MockRootCoordClientInterface{}
@@ -183,13 +253,13 @@ func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	assert.Nil(t, err)
 	client.Error = true
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 
 	client.Error = false
 
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
@@ -211,30 +281,50 @@ func TestMetaCache_GetNonExistCollection(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection3")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, int64(0))
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection3")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection3")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 }
 
+func TestMetaCache_GetCollectionSchemaAt(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	schema, err := globalMetaCache.GetCollectionSchemaAt(ctx, "", 1, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, "old", schema.Description)
+	assert.Equal(t, client.AccessCount, 1)
+
+	// historical schemas are not cached
+	_, err = globalMetaCache.GetCollectionSchemaAt(ctx, "", 1, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, client.AccessCount, 2)
+
+	_, err = globalMetaCache.GetCollectionSchemaAt(ctx, "", 2, 100)
+	assert.NotNil(t, err)
+}
+
 func TestMetaCache_GetPartitionID(t *testing.T) {
 	ctx := context.Background()
 	client := &MockRootCoordClientInterface{}
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetPartitionID(ctx, "collection1", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "collection1", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(3))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(4))
 }
@@ -246,25 +336,64 @@ func TestMetaCache_GetPartitionError(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Test the case where ShowPartitionsResponse is not aligned
-	id, err := globalMetaCache.GetPartitionID(ctx, "errorCollection", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "errorCollection", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
-	partitions, err2 := globalMetaCache.GetPartitions(ctx, "errorCollection")
+	partitions, err2 := globalMetaCache.GetPartitions(ctx, "", "errorCollection")
 	assert.NotNil(t, err2)
 	log.Debug(err.Error())
 	assert.Equal(t, len(partitions), 0)
 
 	// Test non existed tables
-	id, err = globalMetaCache.GetPartitionID(ctx, "nonExisted", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "nonExisted", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
 	// Test non existed partition
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par3")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
+
+func TestMetaCache_GetCredentialInfo(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, client.AccessCount)
+	assert.Equal(t, "encrypted", credInfo.encryptedPassword)
+	assert.Equal(t, "", credInfo.verifiedPassword)
+	assert.Equal(t, 1, len(credInfo.grants))
+
+	globalMetaCache.UpdateCredentialInfo("user1", "verified")
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, client.AccessCount)
+	assert.Equal(t, "verified", credInfo.verifiedPassword)
+
+	globalMetaCache.RemoveCredential("user1")
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, client.AccessCount)
+	assert.Equal(t, "", credInfo.verifiedPassword)
+
+	globalMetaCache.RemoveAllCredentials()
+	_, err = globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, client.AccessCount)
+
+	_, err = globalMetaCache.GetCredentialInfo(ctx, "user2")
+	assert.NotNil(t, err)
+
+	client.Error = true
+	globalMetaCache.RemoveAllCredentials()
+	_, err = globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.NotNil(t, err)
+}
@@ -14,6 +14,7 @@ package proxy
 import (
 	"context"
 	"math/rand"
+	"strconv"
 	"sync"
 	"time"
 
@@ -72,6 +73,19 @@ func newMockTsoAllocator() tsoAllocator {
 	return &mockTsoAllocator{}
 }
 
+// mockIncTsoAllocator allocates increasing timestamps after lastTs
+type mockIncTsoAllocator struct {
+	lastTs Timestamp
+	mtx    sync.Mutex
+}
+
+func (tso *mockIncTsoAllocator) AllocOne() (Timestamp, error) {
+	tso.mtx.Lock()
+	defer tso.mtx.Unlock()
+	tso.lastTs++
+	return tso.lastTs, nil
+}
+
 type mockIDAllocatorInterface struct {
 }
 
@@ -437,6 +451,14 @@ func generateFloat64Array(numRows int) []float64 {
 	return ret
 }
 
+func generateStringArray(numRows int) []string {
+	ret := make([]string, 0, numRows)
+	for i := 0; i < numRows; i++ {
+		ret = append(ret, strconv.Itoa(rand.Int()))
+	}
+	return ret
+}
+
 func generateFloatVectors(numRows, dim int) []float32 {
 	total := numRows * dim
 	ret := make([]float32, 0, total)
@@ -534,6 +556,16 @@ func newScalarFieldData(dType schemapb.DataType, fieldName string, numRows int)
 				},
 			},
 		}
+	case schemapb.DataType_String:
+		ret.Field = &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{
+						Data: generateStringArray(numRows),
+					},
+				},
+			},
+		}
 	}
 
 	return ret
@@ -51,6 +51,8 @@ type ParamTable struct {
 	MaxFieldNum              int64
 	MaxShardNum              int32
 	MaxDimension             int64
+	MaxResultWindow          int64
+	RangeSearchMaxResults    int64
 	DefaultPartitionName     string
 	DefaultIndexName         string
 
@@ -65,6 +67,14 @@ type ParamTable struct {
 
 	MaxTaskNum int64
 
+	// AuthorizationEnabled requires every request to carry valid credentials and privileges
+	AuthorizationEnabled bool
+
+	// RetentionDuration is how far back in time a search or query may travel
+	RetentionDuration time.Duration
+	// GracefulTime is the staleness window of search and query in the Bounded consistency level
+	GracefulTime time.Duration
+
 	PulsarMaxMessageSize int
 	RoleName             string
 }
@@ -99,6 +109,8 @@ func (pt *ParamTable) Init() {
 	pt.initMaxFieldNum()
 	pt.initMaxShardNum()
 	pt.initMaxDimension()
+	pt.initMaxResultWindow()
+	pt.initRangeSearchMaxResults()
 	pt.initDefaultPartitionName()
 	pt.initDefaultIndexName()
 
@@ -106,6 +118,9 @@ func (pt *ParamTable) Init() {
 	pt.initRoleName()
 
 	pt.initMaxTaskNum()
+	pt.initAuthorizationEnabled()
+	pt.initRetentionDuration()
+	pt.initGracefulTime()
 
 	Params.initLogCfg()
 }
@@ -221,6 +236,17 @@ func (pt *ParamTable) initMaxDimension() {
 	pt.MaxDimension = maxDimension
 }
 
+func (pt *ParamTable) initMaxResultWindow() {
+	pt.MaxResultWindow = pt.ParseInt64("proxy.maxResultWindow")
+}
+
+func (pt *ParamTable) initRangeSearchMaxResults() {
+	pt.RangeSearchMaxResults = pt.ParseInt64("proxy.rangeSearchMaxResults")
+	if pt.RangeSearchMaxResults <= 0 || pt.RangeSearchMaxResults > pt.MaxResultWindow {
+		panic(fmt.Sprintf("proxy.rangeSearchMaxResults %d should be in range (0, %d]", pt.RangeSearchMaxResults, pt.MaxResultWindow))
+	}
+}
+
 func (pt *ParamTable) initDefaultPartitionName() {
 	name, err := pt.Load("common.defaultPartitionName")
 	if err != nil {
@@ -312,3 +338,28 @@ func (pt *ParamTable) initMaxTaskNum() {
 	}
 	pt.MaxTaskNum = maxTaskNum
 }
+
+func (pt *ParamTable) initAuthorizationEnabled() {
+	pt.AuthorizationEnabled = pt.ParseBool("proxy.authorizationEnabled", false)
+}
+
+func (pt *ParamTable) initRetentionDuration() {
+	pt.RetentionDuration = time.Duration(pt.ParseInt64("common.retentionDuration")) * time.Second
+}
+
+// initGracefulTime falls back to queryNode.gracefulTime, the key used by former versions
+func (pt *ParamTable) initGracefulTime() {
+	str, err := pt.LoadWithDefault("queryNode.gracefulTime", "1000")
+	if err != nil {
+		panic(err)
+	}
+	str, err = pt.LoadWithDefault("common.gracefulTime", str)
+	if err != nil {
+		panic(err)
+	}
+	gracefulTime, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	pt.GracefulTime = time.Duration(gracefulTime) * time.Millisecond
+}
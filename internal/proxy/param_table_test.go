@@ -13,6 +13,7 @@ package proxy
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -89,6 +90,54 @@ func TestParamTable_Normal(t *testing.T) {
 	t.Run("MaxTaskNum", func(t *testing.T) {
 		t.Logf("MaxTaskNum: %d", Params.MaxTaskNum)
 	})
+
+	t.Run("AuthorizationEnabled", func(t *testing.T) {
+		assert.False(t, Params.AuthorizationEnabled)
+	})
+
+	t.Run("RetentionDuration", func(t *testing.T) {
+		assert.Equal(t, 5*24*time.Hour, Params.RetentionDuration)
+	})
+
+	t.Run("GracefulTime", func(t *testing.T) {
+		assert.Equal(t, time.Second, Params.GracefulTime)
+
+		graceful, err := Params.Load("common.gracefulTime")
+		assert.NoError(t, err)
+		defer func() {
+			Params.Save("common.gracefulTime", graceful)
+			Params.initGracefulTime()
+		}()
+
+		// the key of former versions
+		Params.Remove("common.gracefulTime")
+		Params.Save("queryNode.gracefulTime", "2000")
+		Params.initGracefulTime()
+		assert.Equal(t, 2*time.Second, Params.GracefulTime)
+
+		Params.Remove("queryNode.gracefulTime")
+		Params.initGracefulTime()
+		assert.Equal(t, time.Second, Params.GracefulTime)
+	})
+
+	t.Run("MaxResultWindow", func(t *testing.T) {
+		assert.Equal(t, int64(16384), Params.MaxResultWindow)
+	})
+
+	t.Run("RangeSearchMaxResults", func(t *testing.T) {
+		assert.Equal(t, int64(16384), Params.RangeSearchMaxResults)
+
+		Params.Save("proxy.rangeSearchMaxResults", "0")
+		shouldPanic(t, "proxy.rangeSearchMaxResults", func() {
+			Params.initRangeSearchMaxResults()
+		})
+		Params.Save("proxy.rangeSearchMaxResults", "16385")
+		shouldPanic(t, "proxy.rangeSearchMaxResults", func() {
+			Params.initRangeSearchMaxResults()
+		})
+		Params.Save("proxy.rangeSearchMaxResults", "16384")
+		Params.initRangeSearchMaxResults()
+	})
 }
 
 func shouldPanic(t *testing.T, name string, f func()) {
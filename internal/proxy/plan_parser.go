@@ -14,10 +14,13 @@ package proxy
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 
 	ant_ast "github.com/antonmedv/expr/ast"
+	ant_file "github.com/antonmedv/expr/file"
 	ant_parser "github.com/antonmedv/expr/parser"
+	ant_lexer "github.com/antonmedv/expr/parser/lexer"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -67,6 +70,13 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 		floatNodeRight, rightFloat := node.Right.(*ant_ast.FloatNode)
 		integerNodeRight, rightInteger := node.Right.(*ant_ast.IntegerNode)
 
+		// arithmetic on a field is kept, it is parsed into BinaryArithOpEvalRangeExpr
+		_, leftIdentifier := node.Left.(*ant_ast.IdentifierNode)
+		_, rightIdentifier := node.Right.(*ant_ast.IdentifierNode)
+		if leftIdentifier || rightIdentifier {
+			return
+		}
+
 		switch node.Operator {
 		case "+":
 			if leftFloat && rightFloat {
@@ -164,10 +174,117 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 	}
 }
 
+// parseLikePattern translates the pattern of like operator into an equivalent operator and its operand,
+// `%` matches any sequence of characters and `\%` is a literal `%`, only prefix, suffix and infix patterns are supported
+func parseLikePattern(pattern string) (string, string, error) {
+	var parts []string
+	var builder strings.Builder
+	escaped := false
+	for _, c := range pattern {
+		switch {
+		case escaped:
+			builder.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '%':
+			parts = append(parts, builder.String())
+			builder.Reset()
+		case c == '_':
+			return "", "", fmt.Errorf("wildcard _ is not supported in like pattern %s", pattern)
+		default:
+			builder.WriteRune(c)
+		}
+	}
+	if escaped {
+		builder.WriteRune('\\')
+	}
+	parts = append(parts, builder.String())
+	if len(parts) == 1 {
+		return "==", parts[0], nil
+	}
+
+	prefix, suffix := parts[0], parts[len(parts)-1]
+	var infixes []string
+	for _, part := range parts[1 : len(parts)-1] {
+		if part != "" {
+			infixes = append(infixes, part)
+		}
+	}
+	switch {
+	case len(infixes) == 0 && suffix == "":
+		if prefix == "" {
+			return "contains", "", nil
+		}
+		return "startsWith", prefix, nil
+	case len(infixes) == 0 && prefix == "":
+		return "endsWith", suffix, nil
+	case len(infixes) == 1 && prefix == "" && suffix == "":
+		return "contains", infixes[0], nil
+	}
+	return "", "", fmt.Errorf("unsupported like pattern %s, only prefix%%, %%suffix and %%infix%% are supported", pattern)
+}
+
+// rewriteStringMatchOps rewrites the string match operators unknown to the expr parser, `like` and the
+// operators in lower case (i.e. `startswith`), into the operators `startsWith`, `endsWith`, `contains` and `==`
+func rewriteStringMatchOps(exprStr string) (string, error) {
+	tokens, err := ant_lexer.Lex(ant_file.NewSource(exprStr))
+	if err != nil {
+		return "", err
+	}
+	rewritten := false
+	for i := 0; i+1 < len(tokens); i++ {
+		// operators are always followed by a string operand
+		if tokens[i].Kind != ant_lexer.Identifier || tokens[i+1].Kind != ant_lexer.String {
+			continue
+		}
+		switch strings.ToLower(tokens[i].Value) {
+		case "startswith":
+			tokens[i].Value = "startsWith"
+		case "endswith":
+			tokens[i].Value = "endsWith"
+		case "contains":
+			tokens[i].Value = "contains"
+		case "like":
+			op, operand, err := parseLikePattern(tokens[i+1].Value)
+			if err != nil {
+				return "", err
+			}
+			tokens[i].Value = op
+			tokens[i+1].Value = operand
+		default:
+			continue
+		}
+		tokens[i].Kind = ant_lexer.Operator
+		rewritten = true
+	}
+	if !rewritten {
+		return exprStr, nil
+	}
+
+	var builder strings.Builder
+	for _, token := range tokens {
+		switch token.Kind {
+		case ant_lexer.EOF:
+			continue
+		case ant_lexer.String:
+			builder.WriteString(strconv.Quote(token.Value))
+		default:
+			builder.WriteString(token.Value)
+		}
+		builder.WriteString(" ")
+	}
+	return builder.String(), nil
+}
+
 func parseExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, error) {
 	if exprStr == "" {
 		return nil, nil
 	}
+	exprStr, err := rewriteStringMatchOps(exprStr)
+	if err != nil {
+		return nil, err
+	}
 	ast, err := ant_parser.Parse(exprStr)
 	if err != nil {
 		return nil, err
@@ -277,6 +394,12 @@ func (pc *ParserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	if boolNode := parseBoolNode(&right); boolNode != nil {
 		right = boolNode
 	}
+	if arithNode, ok := left.(*ant_ast.BinaryNode); ok && getArithOpType(arithNode.Operator) != planpb.ArithOpType_Unknown {
+		return pc.createBinaryArithOpEvalRangeExpr(arithNode, &right, operator, false)
+	}
+	if arithNode, ok := right.(*ant_ast.BinaryNode); ok && getArithOpType(arithNode.Operator) != planpb.ArithOpType_Unknown {
+		return pc.createBinaryArithOpEvalRangeExpr(arithNode, &left, operator, true)
+	}
 	idNodeLeft, okLeft := left.(*ant_ast.IdentifierNode)
 	idNodeRight, okRight := right.(*ant_ast.IdentifierNode)
 
@@ -347,6 +470,87 @@ func (pc *ParserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	return expr, nil
 }
 
+func getArithOpType(opStr string) planpb.ArithOpType {
+	switch opStr {
+	case "+":
+		return planpb.ArithOpType_Add
+	case "-":
+		return planpb.ArithOpType_Sub
+	case "*":
+		return planpb.ArithOpType_Mul
+	case "/":
+		return planpb.ArithOpType_Div
+	case "%":
+		return planpb.ArithOpType_Mod
+	default:
+		return planpb.ArithOpType_Unknown
+	}
+}
+
+// createBinaryArithOpEvalRangeExpr creates the expr comparing the arithmetic between a field and a constant with a value,
+// the field is allowed to be the right operand of the commutative operators only, i.e. `2 * FloatField > 1`
+func (pc *ParserContext) createBinaryArithOpEvalRangeExpr(arithNode *ant_ast.BinaryNode, valueNode *ant_ast.Node, operator string, reverse bool) (*planpb.Expr, error) {
+	arithOp := getArithOpType(arithNode.Operator)
+	idNode, ok := arithNode.Left.(*ant_ast.IdentifierNode)
+	operandNode := &arithNode.Right
+	if !ok {
+		idNode, ok = arithNode.Right.(*ant_ast.IdentifierNode)
+		if !ok {
+			return nil, fmt.Errorf("arithmetic expr has no identifier")
+		}
+		if arithOp != planpb.ArithOpType_Add && arithOp != planpb.ArithOpType_Mul {
+			return nil, fmt.Errorf("identifier should be the left operand of arithmetic operator %s", arithNode.Operator)
+		}
+		operandNode = &arithNode.Left
+	}
+	if _, ok := (*operandNode).(*ant_ast.IdentifierNode); ok {
+		return nil, fmt.Errorf("arithmetic between fields is not supported")
+	}
+
+	field, err := pc.handleIdentifier(idNode)
+	if err != nil {
+		return nil, err
+	}
+	if !typeutil.IsIntegerType(field.DataType) && !typeutil.IsFloatingType(field.DataType) {
+		return nil, fmt.Errorf("arithmetic is not supported on field %s of type %s", field.Name, field.DataType.String())
+	}
+	if arithOp == planpb.ArithOpType_Mod && !typeutil.IsIntegerType(field.DataType) {
+		return nil, fmt.Errorf("modulo is not supported on field %s of type %s", field.Name, field.DataType.String())
+	}
+
+	operand, err := pc.handleLeafValue(operandNode, field.DataType)
+	if err != nil {
+		return nil, err
+	}
+	if arithOp == planpb.ArithOpType_Div || arithOp == planpb.ArithOpType_Mod {
+		if operand.GetInt64Val() == 0 && operand.GetFloatVal() == 0 {
+			return nil, fmt.Errorf("divide by zero")
+		}
+	}
+	val, err := pc.handleLeafValue(valueNode, field.DataType)
+	if err != nil {
+		return nil, err
+	}
+
+	op := getCompareOpType(operator, reverse)
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("invalid binary operator(%s)", operator)
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_BinaryArithOpEvalRangeExpr{
+			BinaryArithOpEvalRangeExpr: &planpb.BinaryArithOpEvalRangeExpr{
+				ColumnInfo:   createColumnInfo(field),
+				ArithOp:      arithOp,
+				RightOperand: operand,
+				Op:           op,
+				Value:        val,
+			},
+		},
+	}
+	return expr, nil
+}
+
 func (pc *ParserContext) handleCmpExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
 	return pc.createCmpExpr(node.Left, node.Right, node.Operator)
 }
@@ -429,6 +633,52 @@ func (pc *ParserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Expr, e
 	return expr, nil
 }
 
+func getStringMatchOpType(opStr string) planpb.StringMatchExpr_MatchOp {
+	switch opStr {
+	case "startsWith":
+		return planpb.StringMatchExpr_Prefix
+	case "endsWith":
+		return planpb.StringMatchExpr_Suffix
+	case "contains":
+		return planpb.StringMatchExpr_Contains
+	default:
+		return planpb.StringMatchExpr_Invalid
+	}
+}
+
+func (pc *ParserContext) handleStringMatchExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
+	op := getStringMatchOpType(node.Operator)
+	if op == planpb.StringMatchExpr_Invalid {
+		return nil, fmt.Errorf("invalid operator(%s)", node.Operator)
+	}
+	idNode, ok := node.Left.(*ant_ast.IdentifierNode)
+	if !ok {
+		return nil, fmt.Errorf("left operand of the %s operator must be identifier", node.Operator)
+	}
+	field, err := pc.handleIdentifier(idNode)
+	if err != nil {
+		return nil, err
+	}
+	if !typeutil.IsStringType(field.DataType) {
+		return nil, fmt.Errorf("%s operator is only supported on string field, field = %s", node.Operator, field.Name)
+	}
+	strNode, ok := node.Right.(*ant_ast.StringNode)
+	if !ok {
+		return nil, fmt.Errorf("right operand of the %s operator must be string", node.Operator)
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_StringMatchExpr{
+			StringMatchExpr: &planpb.StringMatchExpr{
+				ColumnInfo: createColumnInfo(field),
+				Op:         op,
+				Pattern:    strNode.Value,
+			},
+		},
+	}
+	return expr, nil
+}
+
 func (pc *ParserContext) combineUnaryRangeExpr(a, b *planpb.UnaryRangeExpr) *planpb.Expr {
 	if a.Op == planpb.OpType_LessEqual || a.Op == planpb.OpType_LessThan {
 		a, b = b, a
@@ -458,7 +708,7 @@ func (pc *ParserContext) handleMultiCmpExpr(node *ant_ast.BinaryNode) (*planpb.E
 	// handle multiple relational operator
 	for {
 		binNodeLeft, LeftOk := curNode.Left.(*ant_ast.BinaryNode)
-		if !LeftOk {
+		if !LeftOk || getArithOpType(binNodeLeft.Operator) != planpb.ArithOpType_Unknown {
 			expr, err := pc.handleCmpExpr(curNode)
 			if err != nil {
 				return nil, err
@@ -521,6 +771,8 @@ func (pc *ParserContext) handleBinaryExpr(node *ant_ast.BinaryNode) (*planpb.Exp
 		return pc.handleLogicalExpr(node)
 	case "in", "not in":
 		return pc.handleInExpr(node)
+	case "startsWith", "endsWith", "contains":
+		return pc.handleStringMatchExpr(node)
 	}
 	return nil, fmt.Errorf("unsupported binary operator %s", node.Operator)
 }
@@ -584,6 +836,16 @@ func (pc *ParserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 		} else {
 			return nil, fmt.Errorf("type mismatch")
 		}
+	case *ant_ast.StringNode:
+		if typeutil.IsStringType(dataType) {
+			gv = &planpb.GenericValue{
+				Val: &planpb.GenericValue_StringVal{
+					StringVal: node.Value,
+				},
+			}
+		} else {
+			return nil, fmt.Errorf("type mismatch")
+		}
 	default:
 		return nil, fmt.Errorf("unsupported leaf node")
 	}
@@ -615,7 +877,8 @@ func (pc *ParserContext) handleExpr(nodeRaw *ant_ast.Node) (*planpb.Expr, error)
 	case *ant_ast.IdentifierNode,
 		*ant_ast.FloatNode,
 		*ant_ast.IntegerNode,
-		*ant_ast.BoolNode:
+		*ant_ast.BoolNode,
+		*ant_ast.StringNode:
 		return nil, fmt.Errorf("scalar expr is not supported yet")
 	case *ant_ast.UnaryNode:
 		expr, err := pc.handleUnaryExpr(node)
@@ -625,6 +888,8 @@ func (pc *ParserContext) handleExpr(nodeRaw *ant_ast.Node) (*planpb.Expr, error)
 		return expr, nil
 	case *ant_ast.BinaryNode:
 		return pc.handleBinaryExpr(node)
+	case *ant_ast.MatchesNode:
+		return nil, fmt.Errorf("regular expression match is not supported, use like instead")
 	default:
 		return nil, fmt.Errorf("unsupported node (%s)", node.Type().String())
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -33,12 +34,16 @@ func newTestSchema() *schemapb.CollectionSchema {
 
 	for name, value := range schemapb.DataType_value {
 		dataType := schemapb.DataType(value)
-		if !typeutil.IsIntegerType(dataType) && !typeutil.IsFloatingType(dataType) && !typeutil.IsVectorType(dataType) {
+		if !typeutil.IsIntegerType(dataType) && !typeutil.IsFloatingType(dataType) && !typeutil.IsVectorType(dataType) &&
+			!typeutil.IsStringType(dataType) {
 			continue
 		}
 		newField := &schemapb.FieldSchema{
 			FieldID: int64(100 + value), Name: name + "Field", IsPrimaryKey: false, Description: "", DataType: dataType,
 		}
+		if typeutil.IsStringType(dataType) {
+			newField.TypeParams = []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}
+		}
 		fields = append(fields, newField)
 	}
 
@@ -154,6 +159,201 @@ func TestParseExpr_Naive(t *testing.T) {
 	})
 }
 
+func TestParseExpr_String(t *testing.T) {
+	schemaPb := newTestSchema()
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+
+	t.Run("test string value", func(t *testing.T) {
+		exprProto, err := parseExpr(schema, `StringField == "abc"`)
+		assert.Nil(t, err)
+		unaryRangeExpr := exprProto.GetUnaryRangeExpr()
+		assert.NotNil(t, unaryRangeExpr)
+		assert.Equal(t, planpb.OpType_Equal, unaryRangeExpr.GetOp())
+		assert.Equal(t, "abc", unaryRangeExpr.GetValue().GetStringVal())
+
+		exprProto, err = parseExpr(schema, `StringField in ["a", "b"]`)
+		assert.Nil(t, err)
+		values := exprProto.GetTermExpr().GetValues()
+		assert.Equal(t, 2, len(values))
+		assert.Equal(t, "a", values[0].GetStringVal())
+		assert.Equal(t, "b", values[1].GetStringVal())
+
+		exprProto, err = parseExpr(schema, `"a" <= StringField < "b"`)
+		assert.Nil(t, err)
+		binaryRangeExpr := exprProto.GetBinaryRangeExpr()
+		assert.NotNil(t, binaryRangeExpr)
+		assert.True(t, binaryRangeExpr.GetLowerInclusive())
+		assert.False(t, binaryRangeExpr.GetUpperInclusive())
+		assert.Equal(t, "a", binaryRangeExpr.GetLowerValue().GetStringVal())
+		assert.Equal(t, "b", binaryRangeExpr.GetUpperValue().GetStringVal())
+
+		exprStrs := []string{
+			`StringField != "abc"`,
+			`StringField > "abc"`,
+			`"abc" >= StringField`,
+			`StringField not in ["a", "b"]`,
+		}
+		for _, exprStr := range exprStrs {
+			_, err := parseExpr(schema, exprStr)
+			assert.Nil(t, err)
+		}
+	})
+
+	t.Run("test string value invalid", func(t *testing.T) {
+		exprStrs := []string{
+			`StringField == 1`,
+			`StringField in [1, 2]`,
+			`Int64Field == "abc"`,
+			`FloatField in ["a"]`,
+			`"abc"`,
+		}
+		for _, exprStr := range exprStrs {
+			exprProto, err := parseExpr(schema, exprStr)
+			assert.Error(t, err)
+			assert.Nil(t, exprProto)
+		}
+	})
+}
+
+func TestParseExpr_StringMatch(t *testing.T) {
+	schemaPb := newTestSchema()
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+
+	t.Run("test string match", func(t *testing.T) {
+		cases := []struct {
+			exprStr string
+			op      planpb.StringMatchExpr_MatchOp
+			pattern string
+		}{
+			{`StringField startsWith "abc"`, planpb.StringMatchExpr_Prefix, "abc"},
+			{`StringField startswith "abc"`, planpb.StringMatchExpr_Prefix, "abc"},
+			{`StringField endsWith "abc"`, planpb.StringMatchExpr_Suffix, "abc"},
+			{`StringField ENDSWITH "abc"`, planpb.StringMatchExpr_Suffix, "abc"},
+			{`StringField contains "abc"`, planpb.StringMatchExpr_Contains, "abc"},
+			{`StringField like "abc%"`, planpb.StringMatchExpr_Prefix, "abc"},
+			{`StringField LIKE '%abc'`, planpb.StringMatchExpr_Suffix, "abc"},
+			{`StringField like "%abc%"`, planpb.StringMatchExpr_Contains, "abc"},
+			{`StringField like "%%abc%%"`, planpb.StringMatchExpr_Contains, "abc"},
+			{`StringField like "%"`, planpb.StringMatchExpr_Contains, ""},
+			{`StringField like "100\\%%"`, planpb.StringMatchExpr_Prefix, "100%"},
+		}
+		for _, c := range cases {
+			exprProto, err := parseExpr(schema, c.exprStr)
+			assert.Nil(t, err, c.exprStr)
+			matchExpr := exprProto.GetStringMatchExpr()
+			assert.NotNil(t, matchExpr, c.exprStr)
+			assert.Equal(t, c.op, matchExpr.GetOp(), c.exprStr)
+			assert.Equal(t, c.pattern, matchExpr.GetPattern(), c.exprStr)
+		}
+
+		// like without wildcard is equal
+		exprProto, err := parseExpr(schema, `StringField like "abc"`)
+		assert.Nil(t, err)
+		assert.Equal(t, planpb.OpType_Equal, exprProto.GetUnaryRangeExpr().GetOp())
+		assert.Equal(t, "abc", exprProto.GetUnaryRangeExpr().GetValue().GetStringVal())
+
+		exprStrs := []string{
+			`not (StringField like "abc%")`,
+			`StringField like "a%" && Int64Field > 1`,
+			`StringField startsWith "a" or StringField in ["b", "c"]`,
+		}
+		for _, exprStr := range exprStrs {
+			_, err := parseExpr(schema, exprStr)
+			assert.Nil(t, err, exprStr)
+		}
+	})
+
+	t.Run("test string match invalid", func(t *testing.T) {
+		exprStrs := []string{
+			`StringField like "a%b"`,
+			`StringField like "a_c"`,
+			`StringField like "%a%b%"`,
+			`Int64Field like "abc%"`,
+			`Int64Field startsWith "abc"`,
+			`StringField startsWith 1`,
+			`"abc" contains StringField`,
+			`StringField matches "a.*"`,
+		}
+		for _, exprStr := range exprStrs {
+			exprProto, err := parseExpr(schema, exprStr)
+			assert.Error(t, err, exprStr)
+			assert.Nil(t, exprProto)
+		}
+	})
+}
+
+func TestParseExpr_BinaryArith(t *testing.T) {
+	schemaPb := newTestSchema()
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	assert.Nil(t, err)
+
+	t.Run("test binary arith", func(t *testing.T) {
+		exprProto, err := parseExpr(schema, "FloatField * 0.9 < 100")
+		assert.Nil(t, err)
+		arithExpr := exprProto.GetBinaryArithOpEvalRangeExpr()
+		assert.NotNil(t, arithExpr)
+		assert.Equal(t, planpb.ArithOpType_Mul, arithExpr.GetArithOp())
+		assert.Equal(t, 0.9, arithExpr.GetRightOperand().GetFloatVal())
+		assert.Equal(t, planpb.OpType_LessThan, arithExpr.GetOp())
+		assert.Equal(t, float64(100), arithExpr.GetValue().GetFloatVal())
+
+		// constants are folded and the comparison is reversed
+		exprProto, err = parseExpr(schema, "1 + 2 == Int64Field % (5 * 2)")
+		assert.Nil(t, err)
+		arithExpr = exprProto.GetBinaryArithOpEvalRangeExpr()
+		assert.NotNil(t, arithExpr)
+		assert.Equal(t, planpb.ArithOpType_Mod, arithExpr.GetArithOp())
+		assert.Equal(t, int64(10), arithExpr.GetRightOperand().GetInt64Val())
+		assert.Equal(t, planpb.OpType_Equal, arithExpr.GetOp())
+		assert.Equal(t, int64(3), arithExpr.GetValue().GetInt64Val())
+
+		exprProto, err = parseExpr(schema, "10 > 2 * Int32Field")
+		assert.Nil(t, err)
+		arithExpr = exprProto.GetBinaryArithOpEvalRangeExpr()
+		assert.NotNil(t, arithExpr)
+		assert.Equal(t, planpb.ArithOpType_Mul, arithExpr.GetArithOp())
+		assert.Equal(t, planpb.OpType_LessThan, arithExpr.GetOp())
+
+		exprStrs := []string{
+			"Int64Field + 1 > 2",
+			"Int64Field - 1 >= 2",
+			"Int64Field / 2 != 2",
+			"DoubleField - 1.5 <= -2",
+			"1 < Int8Field + 1 < 5",
+			"Int64Field % 10 == 3 && FloatField * 0.9 < 100",
+			"not (Int16Field * 2 > 3)",
+		}
+		for _, exprStr := range exprStrs {
+			_, err := parseExpr(schema, exprStr)
+			assert.Nil(t, err, exprStr)
+		}
+	})
+
+	t.Run("test binary arith invalid", func(t *testing.T) {
+		exprStrs := []string{
+			"Int64Field + Int32Field > 1",
+			"2 - Int64Field > 1",
+			"2 / Int64Field > 1",
+			"FloatField % 2 == 1",
+			"Int64Field / 0 > 1",
+			"Int64Field % 0 == 1",
+			"Int64Field * 0.5 > 1",
+			"Int64Field + 1 > FloatField",
+			`StringField + "a" == "ab"`,
+			"Int64Field + 1",
+			"Int64Field + 1 + 2 > 3",
+			"Int64Field ** 2 > 3",
+		}
+		for _, exprStr := range exprStrs {
+			exprProto, err := parseExpr(schema, exprStr)
+			assert.Error(t, err, exprStr)
+			assert.Nil(t, exprProto)
+		}
+	})
+}
+
 func TestParsePlanNode_Naive(t *testing.T) {
 	exprStrs := []string{
 		"not (Int64Field > 3)",
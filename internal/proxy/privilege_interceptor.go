@@ -0,0 +1,223 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+// the privileges which can be granted to roles, PrivilegeAll implies all the others
+const (
+	PrivilegeAll                = "All"
+	PrivilegeCreateCollection   = "CreateCollection"
+	PrivilegeDropCollection     = "DropCollection"
+	PrivilegeDescribeCollection = "DescribeCollection"
+	PrivilegeShowCollections    = "ShowCollections"
+	PrivilegeLoad               = "Load"
+	PrivilegeRelease            = "Release"
+	PrivilegeCreatePartition    = "CreatePartition"
+	PrivilegeDropPartition      = "DropPartition"
+	PrivilegeManageAlias        = "ManageAlias"
+	PrivilegeCreateIndex        = "CreateIndex"
+	PrivilegeDropIndex          = "DropIndex"
+	PrivilegeInsert             = "Insert"
+	PrivilegeDelete             = "Delete"
+	PrivilegeUpsert             = "Upsert"
+	PrivilegeSearch             = "Search"
+	PrivilegeQuery              = "Query"
+	PrivilegeFlush              = "Flush"
+)
+
+var allPrivileges = []string{
+	PrivilegeAll,
+	PrivilegeCreateCollection,
+	PrivilegeDropCollection,
+	PrivilegeDescribeCollection,
+	PrivilegeShowCollections,
+	PrivilegeLoad,
+	PrivilegeRelease,
+	PrivilegeCreatePartition,
+	PrivilegeDropPartition,
+	PrivilegeManageAlias,
+	PrivilegeCreateIndex,
+	PrivilegeDropIndex,
+	PrivilegeInsert,
+	PrivilegeDelete,
+	PrivilegeUpsert,
+	PrivilegeSearch,
+	PrivilegeQuery,
+	PrivilegeFlush,
+}
+
+// allObjects is the object name of a grant which covers all collections of the database
+const allObjects = "*"
+
+// method2Privilege maps the methods of MilvusService to the privileges they require,
+// the methods listed neither here nor in publicMethods, rootOnlyMethods are denied for users other than root
+var method2Privilege = map[string]string{
+	"CreateCollection":         PrivilegeCreateCollection,
+	"DropCollection":           PrivilegeDropCollection,
+	"HasCollection":            PrivilegeDescribeCollection,
+	"DescribeCollection":       PrivilegeDescribeCollection,
+	"GetCollectionStatistics":  PrivilegeDescribeCollection,
+	"HasPartition":             PrivilegeDescribeCollection,
+	"ShowPartitions":           PrivilegeDescribeCollection,
+	"GetPartitionStatistics":   PrivilegeDescribeCollection,
+	"DescribeIndex":            PrivilegeDescribeCollection,
+	"GetIndexState":            PrivilegeDescribeCollection,
+	"GetIndexBuildProgress":    PrivilegeDescribeCollection,
+	"GetPersistentSegmentInfo": PrivilegeDescribeCollection,
+	"GetQuerySegmentInfo":      PrivilegeDescribeCollection,
+	"ShowCollections":          PrivilegeShowCollections,
+	"LoadCollection":           PrivilegeLoad,
+	"LoadPartitions":           PrivilegeLoad,
+	"ReleaseCollection":        PrivilegeRelease,
+	"ReleasePartitions":        PrivilegeRelease,
+	"CreatePartition":          PrivilegeCreatePartition,
+	"DropPartition":            PrivilegeDropPartition,
+	"CreateAlias":              PrivilegeManageAlias,
+	"DropAlias":                PrivilegeManageAlias,
+	"AlterAlias":               PrivilegeManageAlias,
+	"CreateIndex":              PrivilegeCreateIndex,
+	"DropIndex":                PrivilegeDropIndex,
+	"Insert":                   PrivilegeInsert,
+	"BulkLoad":                 PrivilegeInsert,
+	"Delete":                   PrivilegeDelete,
+	"Upsert":                   PrivilegeUpsert,
+	"Search":                   PrivilegeSearch,
+	"HybridSearch":             PrivilegeSearch,
+	"Query":                    PrivilegeQuery,
+	"QueryIterator":            PrivilegeQuery,
+	"CalcDistance":             PrivilegeQuery,
+	"Flush":                    PrivilegeFlush,
+	"GetImportState":           PrivilegeInsert,
+}
+
+// publicMethods are the methods of MilvusService allowed for all authenticated users
+var publicMethods = map[string]struct{}{
+	"Dummy":         {},
+	"RegisterLink":  {},
+	"ListDatabases": {},
+}
+
+// rootOnlyMethods are the methods of MilvusService managing the cluster, databases, users and roles
+var rootOnlyMethods = map[string]struct{}{
+	"GetMetrics":         {},
+	"ManualCompaction":   {},
+	"GetCompactionState": {},
+	"LoadBalance":        {},
+	"CreateDatabase":     {},
+	"DropDatabase":       {},
+	"CreateCredential":   {},
+	"DeleteCredential":   {},
+	"ListCredUsers":      {},
+	"CreateRole":         {},
+	"DropRole":           {},
+	"AddUserToRole":      {},
+	"RemoveUserFromRole": {},
+	"GrantPrivilege":     {},
+	"RevokePrivilege":    {},
+	"ListGrants":         {},
+}
+
+// getPrivilegeObjects returns the database and the collections the request operates on,
+// the requests without a collection name are checked against the grants on all collections
+func getPrivilegeObjects(req interface{}) (string, []string) {
+	var dbName string
+	if r, ok := req.(interface{ GetDbName() string }); ok {
+		dbName = r.GetDbName()
+	}
+	if r, ok := req.(interface{ GetCollectionName() string }); ok && r.GetCollectionName() != "" {
+		return getDbName(dbName), []string{r.GetCollectionName()}
+	}
+	if r, ok := req.(interface{ GetCollectionNames() []string }); ok && len(r.GetCollectionNames()) > 0 {
+		return getDbName(dbName), r.GetCollectionNames()
+	}
+	return getDbName(dbName), []string{allObjects}
+}
+
+// hasPrivilege checks whether any of the grants covers the privilege on the collection
+func hasPrivilege(grants []*milvuspb.GrantEntity, dbName string, objectName string, privilege string) bool {
+	for _, g := range grants {
+		if getDbName(g.DbName) != dbName {
+			continue
+		}
+		if g.ObjectName != allObjects && g.ObjectName != objectName {
+			continue
+		}
+		if g.Privilege == PrivilegeAll || g.Privilege == privilege {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPrivilege returns nil if the user is allowed to call the method of MilvusService with the request
+func checkPrivilege(ctx context.Context, username string, method string, req interface{}, cache Cache) error {
+	if username == common.DefaultRootUser {
+		return nil
+	}
+	if _, ok := publicMethods[method]; ok {
+		return nil
+	}
+	if _, ok := rootOnlyMethods[method]; ok {
+		return fmt.Errorf("%s is only allowed for user %s", method, common.DefaultRootUser)
+	}
+	// users can change their own password only
+	if r, ok := req.(*milvuspb.UpdateCredentialRequest); ok && method == "UpdateCredential" {
+		if r.GetUsername() != username {
+			return fmt.Errorf("%s of other users is only allowed for user %s", method, common.DefaultRootUser)
+		}
+		return nil
+	}
+	privilege, ok := method2Privilege[method]
+	if !ok {
+		return fmt.Errorf("%s is not allowed for user %s", method, username)
+	}
+	credInfo, err := cache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		return err
+	}
+	dbName, objectNames := getPrivilegeObjects(req)
+	for _, objectName := range objectNames {
+		if !hasPrivilege(credInfo.grants, dbName, objectName, privilege) {
+			return fmt.Errorf("user %s doesn't have privilege %s on %s.%s", username, privilege, dbName, objectName)
+		}
+	}
+	return nil
+}
+
+// PrivilegeInterceptor checks whether the user authenticated by AuthenticationInterceptor
+// has been granted the privilege required by the request, it must be chained after AuthenticationInterceptor
+func PrivilegeInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !Params.AuthorizationEnabled || !strings.HasPrefix(info.FullMethod, milvusServicePrefix) {
+		return handler(ctx, req)
+	}
+	username, ok := GetCurUsernameFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "the request is not authenticated")
+	}
+	method := strings.TrimPrefix(info.FullMethod, milvusServicePrefix)
+	if err := checkPrivilege(ctx, username, method, req, globalMetaCache); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func TestHasPrivilege(t *testing.T) {
+	grants := []*milvuspb.GrantEntity{
+		{DbName: "", ObjectName: "coll", Privilege: PrivilegeSearch},
+		{DbName: "db", ObjectName: allObjects, Privilege: PrivilegeAll},
+	}
+	assert.True(t, hasPrivilege(grants, common.DefaultDatabaseName, "coll", PrivilegeSearch))
+	assert.False(t, hasPrivilege(grants, common.DefaultDatabaseName, "coll", PrivilegeInsert))
+	assert.False(t, hasPrivilege(grants, common.DefaultDatabaseName, "coll1", PrivilegeSearch))
+	assert.False(t, hasPrivilege(grants, "db1", "coll", PrivilegeSearch))
+	assert.True(t, hasPrivilege(grants, "db", "coll", PrivilegeInsert))
+	assert.True(t, hasPrivilege(grants, "db", allObjects, PrivilegeShowCollections))
+}
+
+func TestGetPrivilegeObjects(t *testing.T) {
+	dbName, objects := getPrivilegeObjects(&milvuspb.SearchRequest{CollectionName: "coll"})
+	assert.Equal(t, common.DefaultDatabaseName, dbName)
+	assert.Equal(t, []string{"coll"}, objects)
+
+	dbName, objects = getPrivilegeObjects(&milvuspb.FlushRequest{DbName: "db", CollectionNames: []string{"c1", "c2"}})
+	assert.Equal(t, "db", dbName)
+	assert.Equal(t, []string{"c1", "c2"}, objects)
+
+	dbName, objects = getPrivilegeObjects(&milvuspb.ShowCollectionsRequest{DbName: "db"})
+	assert.Equal(t, "db", dbName)
+	assert.Equal(t, []string{allObjects}, objects)
+}
+
+func TestPrivilegeInterceptor(t *testing.T) {
+	Params.Init()
+	authEnabled := Params.AuthorizationEnabled
+	defer func() { Params.AuthorizationEnabled = authEnabled }()
+	Params.AuthorizationEnabled = true
+
+	rc := newMockCredentialRootCoord(t)
+	err := InitMetaCache(rc)
+	assert.Nil(t, err)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(username string, method string, req interface{}) error {
+		ctx := context.Background()
+		if username != "" {
+			ctx = context.WithValue(ctx, usernameCtxKey{}, username)
+		}
+		_, err := PrivilegeInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: milvusServicePrefix + method}, handler)
+		return err
+	}
+
+	// not authenticated
+	err = call("", "Search", &milvuspb.SearchRequest{CollectionName: "coll"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// root bypasses all checks
+	assert.Nil(t, call(common.DefaultRootUser, "CreateRole", &milvuspb.CreateRoleRequest{RoleName: "r"}))
+	assert.Nil(t, call(common.DefaultRootUser, "Insert", &milvuspb.InsertRequest{CollectionName: "coll"}))
+
+	// management methods are only allowed for root
+	err = call("user", "CreateCredential", &milvuspb.CreateCredentialRequest{Username: "u"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = call("user", "GrantPrivilege", &milvuspb.GrantPrivilegeRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = call("user", "GetMetrics", &milvuspb.GetMetricsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = call("user", "GetCompactionState", &milvuspb.GetCompactionStateRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// users can only update their own credential
+	assert.Nil(t, call("user", "UpdateCredential", &milvuspb.UpdateCredentialRequest{Username: "user"}))
+	err = call("user", "UpdateCredential", &milvuspb.UpdateCredentialRequest{Username: common.DefaultRootUser})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// methods without privilege requirement
+	assert.Nil(t, call("user", "ListDatabases", &milvuspb.ListDatabasesRequest{}))
+
+	// unknown methods are denied
+	err = call("user", "NotExist", &milvuspb.ListDatabasesRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// granted privileges
+	assert.Nil(t, call("user", "Search", &milvuspb.SearchRequest{CollectionName: "coll"}))
+	assert.Nil(t, call("user", "Insert", &milvuspb.InsertRequest{DbName: "db", CollectionName: "coll"}))
+	assert.Nil(t, call("user", "ShowCollections", &milvuspb.ShowCollectionsRequest{DbName: "db"}))
+
+	err = call("user", "Insert", &milvuspb.InsertRequest{CollectionName: "coll"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = call("user", "Search", &milvuspb.SearchRequest{CollectionName: "coll1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = call("user", "ShowCollections", &milvuspb.ShowCollectionsRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = call("user", "GetImportState", &milvuspb.GetImportStateRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = call("notExist", "Search", &milvuspb.SearchRequest{CollectionName: "coll"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// internal services are not checked
+	_, err = PrivilegeInterceptor(context.Background(), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCredentialCache"}, handler)
+	assert.Nil(t, err)
+}
+
+func TestPrivilegeMethods(t *testing.T) {
+	// every method of MilvusService is listed in exactly one of the method sets
+	service := reflect.TypeOf((*milvuspb.MilvusServiceServer)(nil)).Elem()
+	for i := 0; i < service.NumMethod(); i++ {
+		method := service.Method(i).Name
+		listed := 0
+		if _, ok := method2Privilege[method]; ok {
+			listed++
+		}
+		if _, ok := publicMethods[method]; ok {
+			listed++
+		}
+		if _, ok := rootOnlyMethods[method]; ok {
+			listed++
+		}
+		if method == "UpdateCredential" {
+			listed++
+		}
+		assert.Equal(t, 1, listed, method)
+	}
+}
@@ -55,6 +55,7 @@ import (
 
 	grpcrootcoord "github.com/milvus-io/milvus/internal/distributed/rootcoord"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/datacoord"
 	"github.com/milvus-io/milvus/internal/datanode"
 	"github.com/milvus-io/milvus/internal/indexcoord"
@@ -795,7 +796,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
@@ -964,7 +965,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1006,7 +1007,20 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, int64(rowNum), resp.InsertCnt)
 	})
 
-	// TODO(dragondriver): proxy.Delete()
+	wg.Add(1)
+	t.Run("delete", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Delete(ctx, &milvuspb.DeleteRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			PartitionName:  partitionName,
+			Expr:           fmt.Sprintf("%s in [1, 2, 3]", int64Field),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, int64(3), resp.DeleteCnt)
+	})
 
 	flushed := true // fortunately, no task depends on this state, maybe CreateIndex?
 	wg.Add(1)
@@ -1369,7 +1383,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("release collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ReleaseCollection(ctx, &milvuspb.ReleaseCollectionRequest{
@@ -1441,7 +1455,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1498,7 +1512,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions after release partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1594,7 +1608,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions after drop partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1641,7 +1655,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("drop collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DropCollection(ctx, &milvuspb.DropCollectionRequest{
@@ -1699,6 +1713,81 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, 0, len(resp.CollectionNames))
 	})
 
+	wg.Add(1)
+	t.Run("credential and rbac", func(t *testing.T) {
+		defer wg.Done()
+		const username = "proxyTestUser"
+		const roleName = "proxyTestRole"
+		resp, err := proxy.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{Username: username, Password: "short"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{Username: username, Password: "password"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.UpdateCredential(ctx, &milvuspb.UpdateCredentialRequest{Username: username, OldPassword: "wrong", NewPassword: "password1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.UpdateCredential(ctx, &milvuspb.UpdateCredentialRequest{Username: username, OldPassword: "password", NewPassword: "password1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		users, err := proxy.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, users.Status.ErrorCode)
+		assert.Contains(t, users.Usernames, username)
+
+		resp, err = proxy.CreateRole(ctx, &milvuspb.CreateRoleRequest{RoleName: roleName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.AddUserToRole(ctx, &milvuspb.AddUserToRoleRequest{Username: username, RoleName: roleName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.GrantPrivilege(ctx, &milvuspb.GrantPrivilegeRequest{
+			Entity: &milvuspb.GrantEntity{RoleName: roleName, ObjectName: collectionName, Privilege: "NotExist"},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		entity := &milvuspb.GrantEntity{RoleName: roleName, ObjectName: collectionName, Privilege: PrivilegeSearch}
+		resp, err = proxy.GrantPrivilege(ctx, &milvuspb.GrantPrivilegeRequest{Entity: entity})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		grants, err := proxy.ListGrants(ctx, &milvuspb.ListGrantsRequest{RoleName: roleName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, grants.Status.ErrorCode)
+		assert.Equal(t, 1, len(grants.Entities))
+
+		resp, err = proxy.RevokePrivilege(ctx, &milvuspb.RevokePrivilegeRequest{Entity: entity})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.RemoveUserFromRole(ctx, &milvuspb.RemoveUserFromRoleRequest{Username: username, RoleName: roleName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: roleName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: common.DefaultRootUser})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: username})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.InvalidateCredentialCache(ctx, &proxypb.InvalidateCredCacheRequest{Username: username})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	// proxy unhealthy
 	//
 	//notStateCode := "not state code"
@@ -1935,6 +2024,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("LoadBalance fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.LoadBalance(ctx, &milvuspb.LoadBalanceRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("RegisterLink fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
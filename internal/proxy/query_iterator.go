@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// queryCursor is where a query iterator resumes, batches are read at the same travel timestamp
+// and each batch starts after the last primary key of the previous one
+type queryCursor struct {
+	TravelTimestamp Timestamp `json:"travel_timestamp"`
+	LastPK          int64     `json:"last_pk"`
+}
+
+// encodeQueryCursor turns the cursor into the opaque token returned to the client
+func encodeQueryCursor(cursor *queryCursor) (string, error) {
+	bs, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+// decodeQueryCursor parses the token returned with the previous batch
+func decodeQueryCursor(token string) (*queryCursor, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("invalid query cursor " + token)
+	}
+	cursor := &queryCursor{}
+	if err := json.Unmarshal(bs, cursor); err != nil || cursor.TravelTimestamp == 0 {
+		return nil, errors.New("invalid query cursor " + token)
+	}
+	return cursor, nil
+}
+
+// checkQueryCursorExpired fails the cursors older than the retention duration, the data at their travel
+// timestamp may have been compacted or collected, so the iteration has to start over from a new snapshot
+func checkQueryCursorExpired(cursor *queryCursor, now time.Time) error {
+	if cursor.TravelTimestamp < tsoutil.ComposeTSByTime(now.Add(-Params.RetentionDuration), 0) {
+		return fmt.Errorf("query cursor expired, an iteration must finish within the retention duration %v, "+
+			"start it again without cursor", Params.RetentionDuration)
+	}
+	return nil
+}
+
+// queryIteratorExpr restricts the expression to the entities after the cursor, the first batch of an
+// empty expression stays empty and is served by a plan matching every entity. Segments seek the
+// primary key bound in their primary key order, so a batch doesn't sort the entities before the cursor
+func queryIteratorExpr(pkFieldName string, expr string, cursor *queryCursor) string {
+	if cursor == nil {
+		return expr
+	}
+	pkExpr := fmt.Sprintf("%s > %d", pkFieldName, cursor.LastPK)
+	if expr == "" {
+		return pkExpr
+	}
+	return "(" + expr + ") && " + pkExpr
+}
+
+// getQueryIteratorPKField gets the primary key field the iterator is ordered by
+func getQueryIteratorPKField(schema *schemapb.CollectionSchema) (*schemapb.FieldSchema, error) {
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey {
+			if field.DataType != schemapb.DataType_Int64 {
+				return nil, fmt.Errorf("query iterator doesn't support primary key %s of type %s, only int64 primary keys are supported",
+					field.Name, field.DataType.String())
+			}
+			return field, nil
+		}
+	}
+	return nil, errors.New("primary key not found in the schema of collection " + schema.Name)
+}
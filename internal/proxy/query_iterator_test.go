@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryCursor(t *testing.T) {
+	token, err := encodeQueryCursor(&queryCursor{TravelTimestamp: 100, LastPK: -5})
+	assert.NoError(t, err)
+	cursor, err := decodeQueryCursor(token)
+	assert.NoError(t, err)
+	assert.Equal(t, Timestamp(100), cursor.TravelTimestamp)
+	assert.Equal(t, int64(-5), cursor.LastPK)
+
+	invalids := []string{"not a cursor", "e30", "bnVsbA"}
+	for _, invalid := range invalids {
+		_, err = decodeQueryCursor(invalid)
+		assert.Error(t, err)
+	}
+}
+
+func TestCheckQueryCursorExpired(t *testing.T) {
+	Params.Init()
+	now := time.Now()
+	cursor := &queryCursor{TravelTimestamp: tsoutil.ComposeTSByTime(now.Add(-Params.RetentionDuration/2), 0)}
+	assert.NoError(t, checkQueryCursorExpired(cursor, now))
+
+	cursor.TravelTimestamp = tsoutil.ComposeTSByTime(now.Add(-Params.RetentionDuration-time.Second), 0)
+	assert.Error(t, checkQueryCursorExpired(cursor, now))
+}
+
+func TestQueryIteratorExpr(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "collection",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	cursor := &queryCursor{TravelTimestamp: 100, LastPK: 10}
+
+	// no predicate is built for an empty expression
+	assert.Equal(t, "", queryIteratorExpr("pk", "", nil))
+
+	exprs := map[string]string{
+		queryIteratorExpr("pk", "age > 1", nil):                "age > 1",
+		queryIteratorExpr("pk", "", cursor):                    "pk > 10",
+		queryIteratorExpr("pk", "age > 1 || age < -1", cursor): "(age > 1 || age < -1) && pk > 10",
+	}
+	for expr, expected := range exprs {
+		assert.Equal(t, expected, expr)
+		_, err := CreateExprPlan(schema, expr)
+		assert.NoError(t, err)
+	}
+}
+
+func TestGetQueryIteratorPKField(t *testing.T) {
+	field, err := getQueryIteratorPKField(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "pk", field.Name)
+
+	_, err = getQueryIteratorPKField(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_String, IsPrimaryKey: true},
+		},
+	})
+	assert.EqualError(t, err, "query iterator doesn't support primary key pk of type String, only int64 primary keys are supported")
+
+	_, err = getQueryIteratorPKField(&schemapb.CollectionSchema{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// replicaRoundRobin spreads the search and query requests over the replicas of collections
+var replicaRoundRobin uint64
+
+// selectReplica chooses one in-memory replica of the collection to serve a search or query request,
+// the replicas in excludedReplicaIDs are skipped. 0 is returned if the collection is loaded without
+// replicas, then all query nodes serve the request. The number of replicas is returned as well.
+func selectReplica(ctx context.Context, qc types.QueryCoord, collectionID UniqueID, excludedReplicaIDs []UniqueID) (UniqueID, int, error) {
+	resp, err := qc.GetReplicas(ctx, &querypb.GetReplicasRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_Undefined,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return 0, 0, errors.New(resp.Status.Reason)
+	}
+	if len(resp.Replicas) == 0 {
+		return 0, 0, nil
+	}
+
+	excluded := make(map[UniqueID]struct{}, len(excludedReplicaIDs))
+	for _, replicaID := range excludedReplicaIDs {
+		excluded[replicaID] = struct{}{}
+	}
+	candidates := make([]UniqueID, 0, len(resp.Replicas))
+	for _, replica := range resp.Replicas {
+		if _, ok := excluded[replica.ReplicaID]; !ok && len(replica.NodeIds) > 0 {
+			candidates = append(candidates, replica.ReplicaID)
+		}
+	}
+	if len(candidates) == 0 {
+		return 0, len(resp.Replicas), fmt.Errorf("no available replica of collection %d", collectionID)
+	}
+
+	index := atomic.AddUint64(&replicaRoundRobin, 1) % uint64(len(candidates))
+	return candidates[index], len(resp.Replicas), nil
+}
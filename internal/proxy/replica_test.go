@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type replicaQueryCoordMock struct {
+	types.QueryCoord
+	replicas []*querypb.ReplicaInfo
+}
+
+func (coord *replicaQueryCoordMock) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	return &querypb.GetReplicasResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Replicas: coord.replicas,
+	}, nil
+}
+
+func TestSelectReplica(t *testing.T) {
+	ctx := context.Background()
+	collectionID := UniqueID(1)
+
+	t.Run("without replicas", func(t *testing.T) {
+		qc := &replicaQueryCoordMock{}
+		replicaID, numReplicas, err := selectReplica(ctx, qc, collectionID, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, UniqueID(0), replicaID)
+		assert.Equal(t, 0, numReplicas)
+	})
+
+	qc := &replicaQueryCoordMock{
+		replicas: []*querypb.ReplicaInfo{
+			{ReplicaID: 1, CollectionID: collectionID, NodeIds: []int64{1}},
+			{ReplicaID: 2, CollectionID: collectionID, NodeIds: []int64{2}},
+		},
+	}
+
+	t.Run("round robin", func(t *testing.T) {
+		selected := make(map[UniqueID]bool)
+		for i := 0; i < 4; i++ {
+			replicaID, numReplicas, err := selectReplica(ctx, qc, collectionID, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, 2, numReplicas)
+			selected[replicaID] = true
+		}
+		assert.Equal(t, 2, len(selected))
+	})
+
+	t.Run("exclude failed replicas", func(t *testing.T) {
+		replicaID, _, err := selectReplica(ctx, qc, collectionID, []UniqueID{1})
+		assert.Nil(t, err)
+		assert.Equal(t, UniqueID(2), replicaID)
+
+		_, _, err = selectReplica(ctx, qc, collectionID, []UniqueID{1, 2})
+		assert.NotNil(t, err)
+	})
+}
@@ -83,8 +83,15 @@ type RootCoordMock struct {
 	collName2ID  map[string]typeutil.UniqueID
 	collID2Meta  map[typeutil.UniqueID]collectionMeta
 	collAlias2ID map[string]typeutil.UniqueID
+	dbNames      map[string]struct{}
 	collMtx      sync.RWMutex
 
+	// username -> credential, role name -> grants
+	credentials map[string]*rootcoordpb.GetCredentialResponse
+	userRoles   map[string][]string
+	roleGrants  map[string][]*milvuspb.GrantEntity
+	credMtx     sync.RWMutex
+
 	// TODO(dragondriver): need default partition?
 	collID2Partitions map[typeutil.UniqueID]partitionMap
 	partitionMtx      sync.RWMutex
@@ -195,6 +202,323 @@ func (coord *RootCoordMock) AlterAlias(ctx context.Context, req *milvuspb.AlterA
 	}, nil
 }
 
+func (coord *RootCoordMock) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	collID, exist := coord.collName2ID[req.CollectionName]
+	if !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    fmt.Sprintf("collection does not exist, name = %s", req.CollectionName),
+		}, nil
+	}
+	meta := coord.collID2Meta[collID]
+	meta.schema.Fields = append(meta.schema.Fields, req.Field)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	if _, exist := coord.dbNames[req.DbName]; exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("database %s exist", req.DbName),
+		}, nil
+	}
+	coord.dbNames[req.DbName] = struct{}{}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	if _, exist := coord.dbNames[req.DbName]; !exist || req.DbName == common.DefaultDatabaseName {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("can't drop database %s", req.DbName),
+		}, nil
+	}
+	delete(coord.dbNames, req.DbName)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	coord.collMtx.RLock()
+	defer coord.collMtx.RUnlock()
+
+	dbNames := make([]string, 0, len(coord.dbNames))
+	createdTimestamps := make([]uint64, 0, len(coord.dbNames))
+	for dbName := range coord.dbNames {
+		dbNames = append(dbNames, dbName)
+		createdTimestamps = append(createdTimestamps, 0)
+	}
+	return &milvuspb.ListDatabasesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		DbNames:           dbNames,
+		CreatedTimestamps: createdTimestamps,
+	}, nil
+}
+
+func removeString(strs []string, str string) []string {
+	ret := make([]string, 0, len(strs))
+	for _, s := range strs {
+		if s != str {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+func unhealthyMockStatus() *commonpb.Status {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		Reason:    "unhealthy",
+	}
+}
+
+func failedMockStatus(format string, args ...interface{}) *commonpb.Status {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		Reason:    fmt.Sprintf(format, args...),
+	}
+}
+
+func successMockStatus() *commonpb.Status {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+}
+
+func (coord *RootCoordMock) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return unhealthyMockStatus(), nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	if _, ok := coord.credentials[req.Username]; ok {
+		return failedMockStatus("user %s exist", req.Username), nil
+	}
+	coord.credentials[req.Username] = &rootcoordpb.GetCredentialResponse{
+		Username:          req.Username,
+		EncryptedPassword: req.Password,
+	}
+	return successMockStatus(), nil
+}
+
+func (coord *RootCoordMock) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return unhealthyMockStatus(), nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	cred, ok := coord.credentials[req.Username]
+	if !ok {
+		return failedMockStatus("user %s not exist", req.Username), nil
+	}
+	cred.EncryptedPassword = req.NewPassword
+	return successMockStatus(), nil
+}
+
+func (coord *RootCoordMock) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return unhealthyMockStatus(), nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	if _, ok := coord.credentials[req.Username]; !ok {
+		return failedMockStatus("user %s not exist", req.Username), nil
+	}
+	delete(coord.credentials, req.Username)
+	delete(coord.userRoles, req.Username)
+	return successMockStatus(), nil
+}
+
+func (coord *RootCoordMock) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.ListCredUsersResponse{Status: unhealthyMockStatus()}, nil
+	}
+	coord.credMtx.RLock()
+	defer coord.credMtx.RUnlock()
+	usernames := make([]string, 0, len(coord.credentials))
+	for username := range coord.credentials {
+		usernames = append(usernames, username)
+	}
+	return &milvuspb.ListCredUsersResponse{
+		Status:    successMockStatus(),
+		Usernames: usernames,
+	}, nil
+}
+
+func (coord *RootCoordMock) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	if !coord.healthy() {
+		return &rootcoordpb.GetCredentialResponse{Status: unhealthyMockStatus()}, nil
+	}
+	coord.credMtx.RLock()
+	defer coord.credMtx.RUnlock()
+	cred, ok := coord.credentials[req.Username]
+	if !ok {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: failedMockStatus("user %s not exist", req.Username),
+		}, nil
+	}
+	resp := &rootcoordpb.GetCredentialResponse{
+		Status:            successMockStatus(),
+		Username:          cred.Username,
+		EncryptedPassword: cred.EncryptedPassword,
+	}
+	for _, roleName := range coord.userRoles[req.Username] {
+		resp.Grants = append(resp.Grants, coord.roleGrants[roleName]...)
+	}
+	return resp, nil
+}
+
+func (coord *RootCoordMock) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return unhealthyMockStatus(), nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	if _, ok := coord.roleGrants[req.RoleName]; ok {
+		return failedMockStatus("role %s exist", req.RoleName), nil
+	}
+	coord.roleGrants[req.RoleName] = []*milvuspb.GrantEntity{}
+	return successMockStatus(), nil
+}
+
+func (coord *RootCoordMock) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return unhealthyMockStatus(), nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	if _, ok := coord.roleGrants[req.RoleName]; !ok {
+		return failedMockStatus("role %s not exist", req.RoleName), nil
+	}
+	delete(coord.roleGrants, req.RoleName)
+	for username, roles := range coord.userRoles {
+		coord.userRoles[username] = removeString(roles, req.RoleName)
+	}
+	return successMockStatus(), nil
+}
+
+func (coord *RootCoordMock) AddUserToRole(ctx context.Context, req *milvuspb.AddUserToRoleRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return unhealthyMockStatus(), nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	if _, ok := coord.credentials[req.Username]; !ok {
+		return failedMockStatus("user %s not exist", req.Username), nil
+	}
+	if _, ok := coord.roleGrants[req.RoleName]; !ok {
+		return failedMockStatus("role %s not exist", req.RoleName), nil
+	}
+	coord.userRoles[req.Username] = append(coord.userRoles[req.Username], req.RoleName)
+	return successMockStatus(), nil
+}
+
+func (coord *RootCoordMock) RemoveUserFromRole(ctx context.Context, req *milvuspb.RemoveUserFromRoleRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return unhealthyMockStatus(), nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	coord.userRoles[req.Username] = removeString(coord.userRoles[req.Username], req.RoleName)
+	return successMockStatus(), nil
+}
+
+func (coord *RootCoordMock) GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return unhealthyMockStatus(), nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	grants, ok := coord.roleGrants[req.Entity.RoleName]
+	if !ok {
+		return failedMockStatus("role %s not exist", req.Entity.RoleName), nil
+	}
+	coord.roleGrants[req.Entity.RoleName] = append(grants, req.Entity)
+	return successMockStatus(), nil
+}
+
+func (coord *RootCoordMock) RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return unhealthyMockStatus(), nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+	grants, ok := coord.roleGrants[req.Entity.RoleName]
+	if !ok {
+		return failedMockStatus("role %s not exist", req.Entity.RoleName), nil
+	}
+	remains := make([]*milvuspb.GrantEntity, 0, len(grants))
+	for _, g := range grants {
+		if !proto.Equal(g, req.Entity) {
+			remains = append(remains, g)
+		}
+	}
+	coord.roleGrants[req.Entity.RoleName] = remains
+	return successMockStatus(), nil
+}
+
+func (coord *RootCoordMock) ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	if !coord.healthy() {
+		return &milvuspb.ListGrantsResponse{Status: unhealthyMockStatus()}, nil
+	}
+	coord.credMtx.RLock()
+	defer coord.credMtx.RUnlock()
+	return &milvuspb.ListGrantsResponse{
+		Status:   successMockStatus(),
+		Entities: coord.roleGrants[req.RoleName],
+	}, nil
+}
+
 func (coord *RootCoordMock) updateState(state internalpb.StateCode) {
 	coord.state.Store(state)
 }
@@ -930,6 +1254,10 @@ func NewRootCoordMock(opts ...RootCoordMockOption) *RootCoordMock {
 		collName2ID:       make(map[string]typeutil.UniqueID),
 		collID2Meta:       make(map[typeutil.UniqueID]collectionMeta),
 		collID2Partitions: make(map[typeutil.UniqueID]partitionMap),
+		dbNames:           map[string]struct{}{common.DefaultDatabaseName: {}},
+		credentials:       make(map[string]*rootcoordpb.GetCredentialResponse),
+		userRoles:         make(map[string][]string),
+		roleGrants:        make(map[string][]*milvuspb.GrantEntity),
 		lastTs:            typeutil.Timestamp(time.Now().UnixNano()),
 	}
 
@@ -59,6 +59,11 @@ const (
 	QueryTaskName                   = "QueryTask"
 	AnnsFieldKey                    = "anns_field"
 	TopKKey                         = "topk"
+	OffsetKey                       = "offset"
+	RadiusKey                       = "radius"
+	RangeFilterKey                  = "range_filter"
+	GroupByFieldKey                 = "group_by_field"
+	GroupSizeKey                    = "group_size"
 	MetricTypeKey                   = "metric_type"
 	SearchParamsKey                 = "params"
 	HasCollectionTaskName           = "HasCollectionTask"
@@ -81,9 +86,13 @@ const (
 	LoadPartitionTaskName           = "LoadPartitionsTask"
 	ReleasePartitionTaskName        = "ReleasePartitionsTask"
 	deleteTaskName                  = "DeleteTask"
+	upsertTaskName                  = "UpsertTask"
 	CreateAliasTaskName             = "CreateAliasTask"
 	DropAliasTaskName               = "DropAliasTask"
 	AlterAliasTaskName              = "AlterAliasTask"
+	CreateDatabaseTaskName          = "CreateDatabaseTask"
+	DropDatabaseTaskName            = "DropDatabaseTask"
+	ListDatabasesTaskName           = "ListDatabasesTask"
 
 	minFloat32 = -1 * float32(math.MaxFloat32)
 )
@@ -183,7 +192,7 @@ func (it *insertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *insertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.DbName, it.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -301,10 +310,13 @@ func (it *insertTask) checkRowNums() error {
 				if fieldNumRows != rowNums {
 					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
 				}
+			case *schemapb.ScalarField_StringData:
+				fieldNumRows := getNumRowsOfScalarField(scalarField.GetStringData().Data)
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
 			case *schemapb.ScalarField_BytesData:
 				return errUnsupportedDType("bytes")
-			case *schemapb.ScalarField_StringData:
-				return errUnsupportedDType("string")
 			case nil:
 				continue
 			default:
@@ -346,6 +358,8 @@ func (it *insertTask) checkRowNums() error {
 func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 	dTypes := make([]schemapb.DataType, 0, len(it.req.FieldsData))
 	datas := make([][]interface{}, 0, len(it.req.FieldsData))
+	// max lengths of the string fields, a string takes a fixed size in row based data
+	maxLengths := make([]int, 0, len(it.req.FieldsData))
 	rowNum := 0
 
 	appendScalarField := func(getDataFunc func() interface{}) error {
@@ -415,6 +429,7 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 	}
 
 	for _, field := range it.req.FieldsData {
+		maxLength := 0
 		switch field.Field.(type) {
 		case *schemapb.FieldData_Scalars:
 			scalarField := field.GetScalars()
@@ -454,10 +469,25 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 				if err != nil {
 					return err
 				}
+			case *schemapb.ScalarField_StringData:
+				var err error
+				maxLength, err = it.getMaxLengthOfField(field.FieldName)
+				if err != nil {
+					return err
+				}
+				for _, str := range scalarField.GetStringData().Data {
+					if len(str) > maxLength {
+						return fmt.Errorf("the length (%d) of string exceeds the max length (%d) of field %s", len(str), maxLength, field.FieldName)
+					}
+				}
+				err = appendScalarField(func() interface{} {
+					return scalarField.GetStringData().Data
+				})
+				if err != nil {
+					return err
+				}
 			case *schemapb.ScalarField_BytesData:
 				return errors.New("bytes field is not supported now")
-			case *schemapb.ScalarField_StringData:
-				return errors.New("string field is not supported now")
 			case nil:
 				continue
 			default:
@@ -492,6 +522,7 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 		}
 
 		dTypes = append(dTypes, field.Type)
+		maxLengths = append(maxLengths, maxLength)
 	}
 
 	it.RowData = make([]*commonpb.Blob, 0, rowNum)
@@ -556,6 +587,16 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 					log.Warn("ConvertData", zap.Error(err))
 				}
 				blob.Value = append(blob.Value, buffer.Bytes()...)
+			case schemapb.DataType_String:
+				// 4 bytes length followed by the string padded with zeros to the max length
+				d := datas[j][i].(string)
+				err := binary.Write(&buffer, endian, uint32(len(d)))
+				if err != nil {
+					log.Warn("ConvertData", zap.Error(err))
+				}
+				buffer.WriteString(d)
+				buffer.Write(make([]byte, maxLengths[j]-len(d)))
+				blob.Value = append(blob.Value, buffer.Bytes()...)
 			case schemapb.DataType_FloatVector:
 				d := datas[j][i].([]float32)
 				err := binary.Write(&buffer, endian, d)
@@ -584,6 +625,16 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 	return nil
 }
 
+// getMaxLengthOfField returns the max length of the string field in collection schema
+func (it *insertTask) getMaxLengthOfField(fieldName string) (int, error) {
+	for _, field := range it.schema.Fields {
+		if field.Name == fieldName {
+			return typeutil.GetMaxLength(field)
+		}
+	}
+	return 0, fmt.Errorf("field %s not found in collection schema", fieldName)
+}
+
 func (it *insertTask) checkFieldAutoID() error {
 	// TODO(dragondriver): in fact, NumRows is not trustable, we should check all input fields
 	if it.req.NumRows <= 0 {
@@ -623,6 +674,7 @@ func (it *insertTask) checkFieldAutoID() error {
 
 	var primaryField *schemapb.FieldData
 	var primaryData []int64
+	var primaryStrData []string
 	for _, field := range it.req.FieldsData {
 		if field.FieldName == autoIDFieldName {
 			return fmt.Errorf("autoID field (%v) does not require data", autoIDFieldName)
@@ -633,8 +685,8 @@ func (it *insertTask) checkFieldAutoID() error {
 	}
 
 	if primaryField != nil {
-		if primaryField.Type != schemapb.DataType_Int64 {
-			return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
+		if primaryField.Type != schemapb.DataType_Int64 && primaryField.Type != schemapb.DataType_String {
+			return fmt.Errorf("currently only support DataType Int64 or String as PrimaryField")
 		}
 		switch primaryField.Field.(type) {
 		case *schemapb.FieldData_Scalars:
@@ -642,16 +694,29 @@ func (it *insertTask) checkFieldAutoID() error {
 			switch scalarField.Data.(type) {
 			case *schemapb.ScalarField_LongData:
 				primaryData = scalarField.GetLongData().Data
+				it.result.IDs.IdField = &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: primaryData,
+					},
+				}
+			case *schemapb.ScalarField_StringData:
+				primaryStrData = scalarField.GetStringData().Data
+				// an empty string marks the missing hits of search results
+				for _, pk := range primaryStrData {
+					if pk == "" {
+						return fmt.Errorf("empty string is not allowed as primary key")
+					}
+				}
+				it.result.IDs.IdField = &schemapb.IDs_StrId{
+					StrId: &schemapb.StringArray{
+						Data: primaryStrData,
+					},
+				}
 			default:
-				return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
+				return fmt.Errorf("currently only support DataType Int64 or String as PrimaryField")
 			}
 		default:
-			return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
-		}
-		it.result.IDs.IdField = &schemapb.IDs_IntId{
-			IntId: &schemapb.LongArray{
-				Data: primaryData,
-			},
+			return fmt.Errorf("currently only support DataType Int64 or String as PrimaryField")
 		}
 	}
 
@@ -713,6 +778,13 @@ func (it *insertTask) checkFieldAutoID() error {
 		if uint32(len(it.HashValues)) != 0 && uint32(len(it.HashValues)) != rowNums {
 			return fmt.Errorf("invalid length of input hash values")
 		}
+		if (it.HashValues == nil || len(it.HashValues) <= 0) && primaryStrData != nil {
+			it.HashValues = make([]uint32, 0, len(primaryStrData))
+			for _, pk := range primaryStrData {
+				hash, _ := typeutil.Hash32String(pk)
+				it.HashValues = append(it.HashValues, uint32(hash))
+			}
+		}
 		if it.HashValues == nil || len(it.HashValues) <= 0 {
 			it.HashValues = make([]uint32, 0, len(primaryData))
 			for _, pk := range primaryData {
@@ -757,7 +829,7 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.DbName, collectionName)
 	log.Debug("Proxy Insert PreExecute", zap.Any("collSchema", collSchema))
 	if err != nil {
 		return err
@@ -1005,19 +1077,19 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-Execute")
 	defer sp.Finish()
 	collectionName := it.BaseInsertTask.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.DbName, collectionName)
 	if err != nil {
 		return err
 	}
 	it.CollectionID = collID
 	var partitionID UniqueID
 	if len(it.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.DbName, collectionName, it.PartitionName)
 		if err != nil {
 			return err
 		}
 	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.DefaultPartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.DbName, collectionName, Params.DefaultPartitionName)
 		if err != nil {
 			return err
 		}
@@ -1149,6 +1221,10 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("maximum shards's number should be limited to %d", Params.MaxShardNum)
 	}
 
+	if _, ok := commonpb.ConsistencyLevel_name[int32(cct.ConsistencyLevel)]; !ok {
+		return fmt.Errorf("invalid consistency level %d", cct.ConsistencyLevel)
+	}
+
 	if int64(len(cct.schema.Fields)) > Params.MaxFieldNum {
 		return fmt.Errorf("maximum field's number should be limited to %d", Params.MaxFieldNum)
 	}
@@ -1175,6 +1251,9 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		if err := ValidateFieldName(field.Name); err != nil {
 			return err
 		}
+		if err := ValidateMaxLength(field); err != nil {
+			return err
+		}
 		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
 			exist := false
 			var dim int64 = 0
@@ -1275,7 +1354,7 @@ func (dct *dropCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (dct *dropCollectionTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, dct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dct.DbName, dct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1297,7 +1376,7 @@ func (dct *dropCollectionTask) Execute(ctx context.Context) error {
 }
 
 func (dct *dropCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.RemoveCollection(ctx, dct.CollectionName)
+	globalMetaCache.RemoveCollection(ctx, dct.DbName, dct.CollectionName)
 	return nil
 }
 
@@ -1362,6 +1441,26 @@ type searchTask struct {
 	query     *milvuspb.SearchRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+
+	// replicas which failed to serve the request before
+	excludedReplicaIDs []UniqueID
+	numReplicas        int
+
+	// number of hits of each query skipped after reduce
+	offset int64
+	// nil unless it's a range search
+	rangeSearchInfo *planpb.RangeSearchInfo
+	// index of the group by field in the output fields and the max number of hits of each group,
+	// groupSize is 0 unless the hits are grouped
+	groupByFieldIdx int
+	groupSize       int64
+	// the group by field is output only to group the hits
+	groupByFieldAdded bool
+}
+
+// canRetryOnOtherReplica checks whether the failed search could be served by another replica
+func (st *searchTask) canRetryOnOtherReplica() bool {
+	return st.ReplicaID != 0 && len(st.excludedReplicaIDs)+1 < st.numReplicas
 }
 
 func (st *searchTask) TraceCtx() context.Context {
@@ -1404,7 +1503,7 @@ func (st *searchTask) OnEnqueue() error {
 }
 
 func (st *searchTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1421,7 +1520,7 @@ func (st *searchTask) getChannels() ([]pChan, error) {
 }
 
 func (st *searchTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1444,7 +1543,7 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	st.Base.SourceID = Params.ProxyID
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1491,11 +1590,24 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
 	}
 
+	st.SearchRequest.ReplicaID, st.numReplicas, err = selectReplica(ctx, st.qc, collID, st.excludedReplicaIDs)
+	if err != nil {
+		return err
+	}
+	log.Debug("search with replica", zap.Int64("collID", collID), zap.Int64("replicaID", st.SearchRequest.ReplicaID))
+
 	// TODO(dragondriver): necessary to check if partition was loaded into query node?
 
 	st.Base.MsgType = commonpb.MsgType_Search
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	travelTimestamp, err := getTravelTimestamp(st.query.TravelTimestamp, st.query.TravelTime, st.BeginTs())
+	if err != nil {
+		return err
+	}
+	schema, err := getCollectionSchemaAt(ctx, st.query.DbName, collectionName, travelTimestamp, st.BeginTs())
+	if err != nil {
+		return err
+	}
 
 	outputFields, err := translateOutputFields(st.query.OutputFields, schema, false)
 	if err != nil {
@@ -1519,6 +1631,18 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(TopKKey + " " + topKStr + " is not invalid")
 		}
 
+		// query nodes search offset + topk hits, the first offset hits are skipped after reduce
+		offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, st.query.SearchParams)
+		if err == nil {
+			st.offset, err = strconv.ParseInt(offsetStr, 0, 64)
+			if err != nil {
+				return errors.New(OffsetKey + " " + offsetStr + " is not invalid")
+			}
+		}
+		if err := validateResultWindow(st.offset, int64(topK)); err != nil {
+			return err
+		}
+
 		metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, st.query.SearchParams)
 		if err != nil {
 			return errors.New(MetricTypeKey + " not found in search_params")
@@ -1529,10 +1653,47 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(SearchParamsKey + " not found in search_params")
 		}
 
+		st.rangeSearchInfo, err = parseRangeSearchInfo(st.query.SearchParams, metricType)
+		if err != nil {
+			return err
+		}
+		// a range search returns all the hits in range rather than topk, each segment searches up to the
+		// configured max results, and the search fails if more hits are in range
+		searchTopK := st.offset + int64(topK)
+		if st.rangeSearchInfo != nil {
+			if st.offset >= Params.RangeSearchMaxResults {
+				return fmt.Errorf("offset %d of range search should be less than %d", st.offset, Params.RangeSearchMaxResults)
+			}
+			searchTopK = Params.RangeSearchMaxResults
+		}
+
 		queryInfo := &planpb.QueryInfo{
-			Topk:         int64(topK),
-			MetricType:   metricType,
-			SearchParams: searchParams,
+			Topk:            searchTopK,
+			MetricType:      metricType,
+			SearchParams:    searchParams,
+			RangeSearchInfo: st.rangeSearchInfo,
+		}
+
+		// query nodes return the values of the group by field, so that hits are grouped again in reduce
+		groupByField, groupSize, err := parseGroupByField(st.query.SearchParams, schema)
+		if err != nil {
+			return err
+		}
+		if groupByField != nil {
+			queryInfo.GroupByFieldId = groupByField.FieldID
+			queryInfo.GroupSize = groupSize
+			st.groupSize = groupSize
+			st.groupByFieldIdx = -1
+			for i, name := range st.query.OutputFields {
+				if name == groupByField.Name {
+					st.groupByFieldIdx = i
+				}
+			}
+			if st.groupByFieldIdx == -1 {
+				st.groupByFieldIdx = len(st.query.OutputFields)
+				st.query.OutputFields = append(st.query.OutputFields, groupByField.Name)
+				st.groupByFieldAdded = true
+			}
 		}
 
 		log.Debug("create query plan",
@@ -1580,23 +1741,19 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 		log.Debug("Proxy::searchTask::PreExecute", zap.Any("plan.OutputFieldIds", plan.OutputFieldIds),
 			zap.Any("plan", plan.String()))
 	}
-	travelTimestamp := st.query.TravelTimestamp
-	if travelTimestamp == 0 {
-		travelTimestamp = st.BeginTs()
-	}
-	guaranteeTimestamp := st.query.GuaranteeTimestamp
-	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = st.BeginTs()
+	consistencyLevel, err := getConsistencyLevel(ctx, st.query.DbName, collectionName, st.query.ConsistencyLevel, st.query.UseDefaultConsistency)
+	if err != nil {
+		return err
 	}
 	st.SearchRequest.TravelTimestamp = travelTimestamp
-	st.SearchRequest.GuaranteeTimestamp = guaranteeTimestamp
+	st.SearchRequest.GuaranteeTimestamp = getGuaranteeTimestamp(ctx, consistencyLevel, collID, st.query.GuaranteeTimestamp, st.BeginTs())
 
 	st.SearchRequest.ResultChannelID = Params.SearchResultChannelNames[0]
 	st.SearchRequest.DbID = 0 // todo
 	st.SearchRequest.CollectionID = collID
 	st.SearchRequest.PartitionIDs = make([]UniqueID, 0)
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, st.query.DbName, collectionName)
 	if err != nil {
 		return err
 	}
@@ -1650,7 +1807,7 @@ func (st *searchTask) Execute(ctx context.Context) error {
 	msgPack.Msgs[0] = tsMsg
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1724,14 +1881,101 @@ func decodeSearchResults(searchResults []*internalpb.SearchResults) ([]*schemapb
 	// return decodeSearchResultsParallelByCPU(searchResults)
 }
 
+// parseRangeSearchInfo gets radius and the optional range_filter of a range search from search params,
+// it returns nil if radius is not set. A range search ignores topk and returns all the hits in range,
+// it fails rather than cutting the hits if more than proxy.rangeSearchMaxResults of them are in range
+func parseRangeSearchInfo(searchParams []*commonpb.KeyValuePair, metricType string) (*planpb.RangeSearchInfo, error) {
+	radiusStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RadiusKey, searchParams)
+	if err != nil {
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(RangeFilterKey, searchParams); err == nil {
+			return nil, errors.New(RangeFilterKey + " is set without " + RadiusKey)
+		}
+		return nil, nil
+	}
+	radius, err := strconv.ParseFloat(radiusStr, 32)
+	if err != nil {
+		return nil, errors.New(RadiusKey + " " + radiusStr + " is invalid")
+	}
+
+	// without range_filter, no hit is too close
+	rangeFilter := math.Inf(-1)
+	if metricType == "IP" {
+		rangeFilter = math.Inf(1)
+	}
+	rangeFilterStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RangeFilterKey, searchParams)
+	if err == nil {
+		rangeFilter, err = strconv.ParseFloat(rangeFilterStr, 32)
+		if err != nil {
+			return nil, errors.New(RangeFilterKey + " " + rangeFilterStr + " is invalid")
+		}
+	}
+
+	if err := validateRangeSearchParams(metricType, float32(radius), float32(rangeFilter)); err != nil {
+		return nil, err
+	}
+	return &planpb.RangeSearchInfo{
+		Radius:      float32(radius),
+		RangeFilter: float32(rangeFilter),
+	}, nil
+}
+
+// parseGroupByField gets the int64 field to group the hits by and the optional group_size from search params,
+// it returns nil if group_by_field is not set
+func parseGroupByField(searchParams []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) (*schemapb.FieldSchema, int64, error) {
+	fieldName, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParams)
+	if err != nil {
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupSizeKey, searchParams); err == nil {
+			return nil, 0, errors.New(GroupSizeKey + " is set without " + GroupByFieldKey)
+		}
+		return nil, 0, nil
+	}
+
+	var groupByField *schemapb.FieldSchema
+	for _, field := range schema.Fields {
+		if field.Name == fieldName {
+			groupByField = field
+			break
+		}
+	}
+	if groupByField == nil {
+		return nil, 0, errors.New("Field " + fieldName + " not exist")
+	}
+	if groupByField.DataType != schemapb.DataType_Int64 {
+		return nil, 0, fmt.Errorf("field %s of type %s can't be used to group search results, only int64 fields are supported",
+			fieldName, groupByField.DataType.String())
+	}
+
+	groupSize := int64(1)
+	groupSizeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupSizeKey, searchParams)
+	if err == nil {
+		groupSize, err = strconv.ParseInt(groupSizeStr, 0, 64)
+		if err != nil || groupSize <= 0 {
+			return nil, 0, errors.New(GroupSizeKey + " " + groupSizeStr + " is invalid, it should be positive")
+		}
+	}
+	return groupByField, groupSize, nil
+}
+
+// inSearchRange checks the score of a hit before it's turned back into distance,
+// scores of metrics other than IP are negated distances, so a larger score is always closer
+func inSearchRange(rangeSearchInfo *planpb.RangeSearchInfo, metricType string, score float32) bool {
+	if metricType == "IP" {
+		return score > rangeSearchInfo.Radius && score <= rangeSearchInfo.RangeFilter
+	}
+	return -score < rangeSearchInfo.Radius && -score >= rangeSearchInfo.RangeFilter
+}
+
+// reduceSearchResultDataParallel merges the hits of query nodes, if groupSize is positive,
+// at most groupSize hits are kept for each value of the int64 field at groupByFieldIdx of FieldsData
 func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultData, availableQueryNodeNum int64,
-	nq int64, topk int64, metricType string, maxParallel int) (*milvuspb.SearchResults, error) {
+	nq int64, topk int64, metricType string, rangeSearchInfo *planpb.RangeSearchInfo, groupByFieldIdx int, groupSize int64,
+	maxParallel int) (*milvuspb.SearchResults, error) {
 
 	log.Debug("reduceSearchResultDataParallel",
 		zap.Int("len(searchResultData)", len(searchResultData)),
 		zap.Int64("availableQueryNodeNum", availableQueryNodeNum),
 		zap.Int64("nq", nq), zap.Int64("topk", topk), zap.String("metricType", metricType),
-		zap.Int("maxParallel", maxParallel))
+		zap.Int64("groupSize", groupSize), zap.Int("maxParallel", maxParallel))
 
 	ret := &milvuspb.SearchResults{
 		Status: &commonpb.Status{
@@ -1765,31 +2009,52 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 		if sData.TopK != topk {
 			return ret, fmt.Errorf("search result's topk(%d) mis-match with %d", sData.TopK, topk)
 		}
-		if len(sData.Ids.GetIntId().Data) != (int)(nq*topk) {
-			return ret, fmt.Errorf("search result's id length %d invalid", len(sData.Ids.GetIntId().Data))
+		if typeutil.GetSizeOfIDs(sData.Ids) != (int)(nq*topk) {
+			return ret, fmt.Errorf("search result's id length %d invalid", typeutil.GetSizeOfIDs(sData.Ids))
 		}
 		if len(sData.Scores) != (int)(nq*topk) {
 			return ret, fmt.Errorf("search result's score length %d invalid", len(sData.Scores))
 		}
+		if groupSize > 0 && (groupByFieldIdx >= len(sData.FieldsData) ||
+			len(sData.FieldsData[groupByFieldIdx].GetScalars().GetLongData().GetData()) != (int)(nq*topk)) {
+			return ret, errors.New("search result's group by field data invalid")
+		}
 	}
 
+	limit := topk
+
 	// TODO(yukun): Use parallel function
 	var realTopK int64 = -1
 	var idx int64
 	var j int64
 	for idx = 0; idx < nq; idx++ {
 		locs := make([]int64, availableQueryNodeNum)
+		// number of hits kept of each group
+		groupCounts := make(map[int64]int64)
+		groupValue := func(q int, curIdx int64) int64 {
+			return searchResultData[q].FieldsData[groupByFieldIdx].GetScalars().GetLongData().Data[curIdx]
+		}
+		skipHit := func(q int, curIdx int64) bool {
+			if rangeSearchInfo != nil && !inSearchRange(rangeSearchInfo, metricType, searchResultData[q].Scores[curIdx]) {
+				return true
+			}
+			return groupSize > 0 && groupCounts[groupValue(q, curIdx)] >= groupSize
+		}
 
 		j = 0
-		for ; j < topk; j++ {
+		for ; j < limit; j++ {
 			choice, maxDistance := -1, minFloat32
 			for q, loc := range locs { // query num, the number of ways to merge
+				// skip the hits out of the search range or of the full groups
+				for loc < topk && skipHit(q, idx*topk+loc) {
+					loc++
+				}
+				locs[q] = loc
 				if loc >= topk {
 					continue
 				}
 				curIdx := idx*topk + loc
-				id := searchResultData[q].Ids.GetIntId().Data[curIdx]
-				if id != -1 {
+				if typeutil.IsValidID(searchResultData[q].Ids, curIdx) {
 					distance := searchResultData[q].Scores[curIdx]
 					if distance > maxDistance {
 						choice = q
@@ -1804,11 +2069,13 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 			curIdx := idx*topk + choiceOffset
 
 			// ignore invalid search result
-			id := searchResultData[choice].Ids.GetIntId().Data[curIdx]
-			if id == -1 {
+			if !typeutil.IsValidID(searchResultData[choice].Ids, curIdx) {
 				continue
 			}
-			ret.Results.Ids.GetIntId().Data = append(ret.Results.Ids.GetIntId().Data, id)
+			typeutil.AppendIDs(ret.Results.Ids, searchResultData[choice].Ids, curIdx)
+			if groupSize > 0 {
+				groupCounts[groupValue(choice, curIdx)]++
+			}
 			// TODO(yukun): Process searchResultData.FieldsData
 			for k, fieldData := range searchResultData[choice].FieldsData {
 				switch fieldType := fieldData.Field.(type) {
@@ -1883,6 +2150,18 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 						} else {
 							ret.Results.FieldsData[k].GetScalars().GetDoubleData().Data = append(ret.Results.FieldsData[k].GetScalars().GetDoubleData().Data, scalarType.DoubleData.Data[curIdx])
 						}
+					case *schemapb.ScalarField_StringData:
+						if ret.Results.FieldsData[k].GetScalars().GetStringData() == nil {
+							ret.Results.FieldsData[k].Field.(*schemapb.FieldData_Scalars).Scalars = &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_StringData{
+									StringData: &schemapb.StringArray{
+										Data: []string{scalarType.StringData.Data[curIdx]},
+									},
+								},
+							}
+						} else {
+							ret.Results.FieldsData[k].GetScalars().GetStringData().Data = append(ret.Results.FieldsData[k].GetScalars().GetStringData().Data, scalarType.StringData.Data[curIdx])
+						}
 					default:
 						log.Debug("Not supported field type")
 						return nil, fmt.Errorf("not supported field type: %s", fieldData.Type.String())
@@ -1927,6 +2206,25 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 			ret.Results.Scores = append(ret.Results.Scores, searchResultData[choice].Scores[idx*topk+choiceOffset])
 			locs[choice]++
 		}
+		if rangeSearchInfo != nil && j == limit {
+			// topk of a range search is the max results, the hits beyond it are unknown to the query nodes
+			for q, loc := range locs {
+				for loc < topk && skipHit(q, idx*topk+loc) {
+					loc++
+				}
+				if loc < topk && typeutil.IsValidID(searchResultData[q].Ids, idx*topk+loc) {
+					return ret, fmt.Errorf("range search hits more than %d results, reduce radius or increase proxy.rangeSearchMaxResults", topk)
+				}
+			}
+		}
+		if rangeSearchInfo != nil || groupSize > 0 {
+			// queries of a range search or a grouped search hit different numbers of entities
+			if j > realTopK {
+				realTopK = j
+			}
+			ret.Results.Topks = append(ret.Results.Topks, j)
+			continue
+		}
 		if realTopK != -1 && realTopK != j {
 			log.Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
 			// return nil, errors.New("the length (topk) between all result of query is different")
@@ -1946,13 +2244,101 @@ func reduceSearchResultDataParallel(searchResultData []*schemapb.SearchResultDat
 	return ret, nil
 }
 
+// skipSearchResultData drops the first offset hits of each query in the reduced search result
+func skipSearchResultData(data *schemapb.SearchResultData, offset int64) *schemapb.SearchResultData {
+	if offset <= 0 {
+		return data
+	}
+	ret := &schemapb.SearchResultData{
+		NumQueries: data.NumQueries,
+		TopK:       data.TopK - offset,
+		FieldsData: make([]*schemapb.FieldData, len(data.FieldsData)),
+		Scores:     make([]float32, 0),
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: make([]int64, 0),
+				},
+			},
+		},
+		Topks: make([]int64, 0, len(data.Topks)),
+	}
+	if ret.TopK < 0 {
+		ret.TopK = 0
+	}
+
+	var start int64
+	for _, topk := range data.Topks {
+		var kept int64
+		for i := start + offset; i < start+topk; i++ {
+			typeutil.AppendIDs(ret.Ids, data.Ids, i)
+			ret.Scores = append(ret.Scores, data.Scores[i])
+			typeutil.AppendFieldData(ret.FieldsData, data.FieldsData, i)
+			kept++
+		}
+		ret.Topks = append(ret.Topks, kept)
+		start += topk
+	}
+	if typeutil.GetSizeOfIDs(ret.Ids) == 0 {
+		ret.FieldsData = make([]*schemapb.FieldData, 0)
+	}
+	return ret
+}
+
+// reduceRetrieveResultsByPK merges the retrieve results of query nodes, each sorted by primary key,
+// drops the duplicated entities returned by several nodes or replicas,
+// skips the first offset entities and keeps at most limit entities
+func reduceRetrieveResultsByPK(retrieveResults []*internalpb.RetrieveResults, offset, limit int64) []*schemapb.FieldData {
+	var ret []*schemapb.FieldData
+	cursors := make([]int, len(retrieveResults))
+	var skipped, kept int64
+	var lastPK interface{}
+	hasLast := false
+	for kept < limit {
+		sel := -1
+		var minPK interface{}
+		for i, result := range retrieveResults {
+			if cursors[i] >= typeutil.GetSizeOfIDs(result.GetIds()) {
+				continue
+			}
+			pk := typeutil.GetPK(result.GetIds(), int64(cursors[i]))
+			if sel == -1 || typeutil.LessPK(pk, minPK) {
+				sel = i
+				minPK = pk
+			}
+		}
+		if sel == -1 {
+			break
+		}
+		if hasLast && minPK == lastPK {
+			cursors[sel]++
+			continue
+		}
+		lastPK, hasLast = minPK, true
+		if skipped < offset {
+			skipped++
+		} else {
+			if ret == nil {
+				ret = make([]*schemapb.FieldData, len(retrieveResults[sel].FieldsData))
+			}
+			typeutil.AppendFieldData(ret, retrieveResults[sel].FieldsData, int64(cursors[sel]))
+			kept++
+		}
+		cursors[sel]++
+	}
+	if ret == nil {
+		ret = make([]*schemapb.FieldData, 0)
+	}
+	return ret
+}
+
 func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, availableQueryNodeNum int64,
-	nq int64, topk int64, metricType string) (*milvuspb.SearchResults, error) {
+	nq int64, topk int64, metricType string, rangeSearchInfo *planpb.RangeSearchInfo, groupByFieldIdx int, groupSize int64) (*milvuspb.SearchResults, error) {
 	t := time.Now()
 	defer func() {
 		log.Debug("reduceSearchResults", zap.Any("time cost", time.Since(t)))
 	}()
-	return reduceSearchResultDataParallel(searchResultData, availableQueryNodeNum, nq, topk, metricType, runtime.NumCPU())
+	return reduceSearchResultDataParallel(searchResultData, availableQueryNodeNum, nq, topk, metricType, rangeSearchInfo, groupByFieldIdx, groupSize, runtime.NumCPU())
 }
 
 //func printSearchResult(partialSearchResult *internalpb.SearchResults) {
@@ -2039,12 +2425,20 @@ func (st *searchTask) PostExecute(ctx context.Context) error {
 			}
 
 			st.result, err = reduceSearchResultData(results, int64(availableQueryNodeNum),
-				searchResults[0].NumQueries, searchResults[0].TopK, searchResults[0].MetricType)
+				searchResults[0].NumQueries, searchResults[0].TopK, searchResults[0].MetricType, st.rangeSearchInfo,
+				st.groupByFieldIdx, st.groupSize)
 			if err != nil {
 				return err
 			}
+			st.result.Results = skipSearchResultData(st.result.Results, st.offset)
+			if st.groupByFieldAdded {
+				st.query.OutputFields = st.query.OutputFields[:st.groupByFieldIdx]
+				if len(st.result.Results.FieldsData) > st.groupByFieldIdx {
+					st.result.Results.FieldsData = st.result.Results.FieldsData[:st.groupByFieldIdx]
+				}
+			}
 
-			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
+			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, st.query.CollectionName)
 			if err != nil {
 				return err
 			}
@@ -2074,6 +2468,17 @@ type queryTask struct {
 	chMgr     channelsMgr
 	qc        types.QueryCoord
 	ids       *schemapb.IDs
+	// an empty expression matches every entity instead of failing the query
+	matchAll bool
+
+	// replicas which failed to serve the request before
+	excludedReplicaIDs []UniqueID
+	numReplicas        int
+}
+
+// canRetryOnOtherReplica checks whether the failed query could be served by another replica
+func (qt *queryTask) canRetryOnOtherReplica() bool {
+	return qt.ReplicaID != 0 && len(qt.excludedReplicaIDs)+1 < qt.numReplicas
 }
 
 func (qt *queryTask) TraceCtx() context.Context {
@@ -2114,7 +2519,7 @@ func (qt *queryTask) OnEnqueue() error {
 }
 
 func (qt *queryTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2131,7 +2536,7 @@ func (qt *queryTask) getChannels() ([]pChan, error) {
 }
 
 func (qt *queryTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2166,7 +2571,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	log.Info("Validate collection name.", zap.Any("collectionName", collectionName),
 		zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2216,7 +2621,20 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
 	}
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+	qt.RetrieveRequest.ReplicaID, qt.numReplicas, err = selectReplica(ctx, qt.qc, collectionID, qt.excludedReplicaIDs)
+	if err != nil {
+		return err
+	}
+	log.Debug("query with replica", zap.Int64("collID", collectionID), zap.Int64("replicaID", qt.RetrieveRequest.ReplicaID))
+
+	travelTimestamp, err := getTravelTimestamp(qt.query.TravelTimestamp, qt.query.TravelTime, qt.BeginTs())
+	if err != nil {
+		return err
+	}
+	schema, err := getCollectionSchemaAt(ctx, qt.query.DbName, qt.query.CollectionName, travelTimestamp, qt.BeginTs())
+	if err != nil {
+		return err
+	}
 
 	if qt.ids != nil {
 		pkField := ""
@@ -2228,14 +2646,18 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 		qt.query.Expr = IDs2Expr(pkField, qt.ids.GetIntId().Data)
 	}
 
-	if qt.query.Expr == "" {
+	if qt.query.Expr == "" && !qt.matchAll {
 		errMsg := "Query expression is empty"
 		return fmt.Errorf(errMsg)
 	}
 
-	plan, err := CreateExprPlan(schema, qt.query.Expr)
-	if err != nil {
-		return err
+	// a plan without predicates retrieves every entity
+	plan := &planpb.PlanNode{}
+	if qt.query.Expr != "" {
+		plan, err = CreateExprPlan(schema, qt.query.Expr)
+		if err != nil {
+			return err
+		}
 	}
 	qt.query.OutputFields, err = translateOutputFields(qt.query.OutputFields, schema, true)
 	if err != nil {
@@ -2276,21 +2698,27 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	}
 	log.Debug("translate output fields to field ids", zap.Any("OutputFieldsID", qt.OutputFieldsId))
 
+	if err := validateResultWindow(qt.query.GetOffset(), qt.query.GetLimit()); err != nil {
+		return err
+	}
+	if qt.query.GetLimit() > 0 {
+		// each segment and query node returns its first offset + limit entities by primary key,
+		// the proxy skips offset of them after merge
+		qt.RetrieveRequest.Limit = qt.query.GetOffset() + qt.query.GetLimit()
+		plan.Limit = qt.RetrieveRequest.Limit
+	}
+
 	qt.RetrieveRequest.SerializedExprPlan, err = proto.Marshal(plan)
 	if err != nil {
 		return err
 	}
 
-	travelTimestamp := qt.query.TravelTimestamp
-	if travelTimestamp == 0 {
-		travelTimestamp = qt.BeginTs()
-	}
-	guaranteeTimestamp := qt.query.GuaranteeTimestamp
-	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = qt.BeginTs()
+	consistencyLevel, err := getConsistencyLevel(ctx, qt.query.DbName, collectionName, qt.query.ConsistencyLevel, qt.query.UseDefaultConsistency)
+	if err != nil {
+		return err
 	}
 	qt.TravelTimestamp = travelTimestamp
-	qt.GuaranteeTimestamp = guaranteeTimestamp
+	qt.GuaranteeTimestamp = getGuaranteeTimestamp(ctx, consistencyLevel, collectionID, qt.query.GuaranteeTimestamp, qt.BeginTs())
 
 	qt.ResultChannelID = Params.RetrieveResultChannelNames[0]
 	qt.DbID = 0 // todo(yukun)
@@ -2298,7 +2726,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	qt.CollectionID = collectionID
 	qt.PartitionIDs = make([]UniqueID, 0)
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		log.Debug("Failed to get partitions in collection.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2421,41 +2849,46 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 			},
 			FieldsData: make([]*schemapb.FieldData, 0),
 		}
-		for _, partialRetrieveResult := range retrieveResult {
-			availableQueryNodeNum++
-			if partialRetrieveResult.Ids == nil {
-				reason += "ids is nil\n"
-				continue
-			} else {
-				// handles initialization, cannot use idx==0 since first result may be empty
-				if len(qt.result.FieldsData) == 0 {
-					qt.result.FieldsData = append(qt.result.FieldsData, partialRetrieveResult.FieldsData...)
+		if qt.query.GetLimit() > 0 {
+			availableQueryNodeNum = len(retrieveResult)
+			qt.result.FieldsData = reduceRetrieveResultsByPK(retrieveResult, qt.query.GetOffset(), qt.query.GetLimit())
+		} else {
+			for _, partialRetrieveResult := range retrieveResult {
+				availableQueryNodeNum++
+				if partialRetrieveResult.Ids == nil {
+					reason += "ids is nil\n"
+					continue
 				} else {
-					for k, fieldData := range partialRetrieveResult.FieldsData {
-						switch fieldType := fieldData.Field.(type) {
-						case *schemapb.FieldData_Scalars:
-							switch scalarType := fieldType.Scalars.Data.(type) {
-							case *schemapb.ScalarField_BoolData:
-								qt.result.FieldsData[k].GetScalars().GetBoolData().Data = append(qt.result.FieldsData[k].GetScalars().GetBoolData().Data, scalarType.BoolData.Data...)
-							case *schemapb.ScalarField_IntData:
-								qt.result.FieldsData[k].GetScalars().GetIntData().Data = append(qt.result.FieldsData[k].GetScalars().GetIntData().Data, scalarType.IntData.Data...)
-							case *schemapb.ScalarField_LongData:
-								qt.result.FieldsData[k].GetScalars().GetLongData().Data = append(qt.result.FieldsData[k].GetScalars().GetLongData().Data, scalarType.LongData.Data...)
-							case *schemapb.ScalarField_FloatData:
-								qt.result.FieldsData[k].GetScalars().GetFloatData().Data = append(qt.result.FieldsData[k].GetScalars().GetFloatData().Data, scalarType.FloatData.Data...)
-							case *schemapb.ScalarField_DoubleData:
-								qt.result.FieldsData[k].GetScalars().GetDoubleData().Data = append(qt.result.FieldsData[k].GetScalars().GetDoubleData().Data, scalarType.DoubleData.Data...)
+					// handles initialization, cannot use idx==0 since first result may be empty
+					if len(qt.result.FieldsData) == 0 {
+						qt.result.FieldsData = append(qt.result.FieldsData, partialRetrieveResult.FieldsData...)
+					} else {
+						for k, fieldData := range partialRetrieveResult.FieldsData {
+							switch fieldType := fieldData.Field.(type) {
+							case *schemapb.FieldData_Scalars:
+								switch scalarType := fieldType.Scalars.Data.(type) {
+								case *schemapb.ScalarField_BoolData:
+									qt.result.FieldsData[k].GetScalars().GetBoolData().Data = append(qt.result.FieldsData[k].GetScalars().GetBoolData().Data, scalarType.BoolData.Data...)
+								case *schemapb.ScalarField_IntData:
+									qt.result.FieldsData[k].GetScalars().GetIntData().Data = append(qt.result.FieldsData[k].GetScalars().GetIntData().Data, scalarType.IntData.Data...)
+								case *schemapb.ScalarField_LongData:
+									qt.result.FieldsData[k].GetScalars().GetLongData().Data = append(qt.result.FieldsData[k].GetScalars().GetLongData().Data, scalarType.LongData.Data...)
+								case *schemapb.ScalarField_FloatData:
+									qt.result.FieldsData[k].GetScalars().GetFloatData().Data = append(qt.result.FieldsData[k].GetScalars().GetFloatData().Data, scalarType.FloatData.Data...)
+								case *schemapb.ScalarField_DoubleData:
+									qt.result.FieldsData[k].GetScalars().GetDoubleData().Data = append(qt.result.FieldsData[k].GetScalars().GetDoubleData().Data, scalarType.DoubleData.Data...)
+								default:
+									log.Debug("Query received not supported data type")
+								}
+							case *schemapb.FieldData_Vectors:
+								switch vectorType := fieldType.Vectors.Data.(type) {
+								case *schemapb.VectorField_BinaryVector:
+									qt.result.FieldsData[k].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector = append(qt.result.FieldsData[k].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector, vectorType.BinaryVector...)
+								case *schemapb.VectorField_FloatVector:
+									qt.result.FieldsData[k].GetVectors().GetFloatVector().Data = append(qt.result.FieldsData[k].GetVectors().GetFloatVector().Data, vectorType.FloatVector.Data...)
+								}
 							default:
-								log.Debug("Query received not supported data type")
 							}
-						case *schemapb.FieldData_Vectors:
-							switch vectorType := fieldType.Vectors.Data.(type) {
-							case *schemapb.VectorField_BinaryVector:
-								qt.result.FieldsData[k].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector = append(qt.result.FieldsData[k].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector, vectorType.BinaryVector...)
-							case *schemapb.VectorField_FloatVector:
-								qt.result.FieldsData[k].GetVectors().GetFloatVector().Data = append(qt.result.FieldsData[k].GetVectors().GetFloatVector().Data, vectorType.FloatVector.Data...)
-							}
-						default:
 						}
 					}
 				}
@@ -2486,7 +2919,7 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 			return nil
 		}
 
-		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.DbName, qt.query.CollectionName)
 		if err != nil {
 			return err
 		}
@@ -2667,6 +3100,7 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.CreatedTimestamp = result.CreatedTimestamp
 		dct.result.CreatedUtcTimestamp = result.CreatedUtcTimestamp
 		dct.result.ShardsNum = result.ShardsNum
+		dct.result.ConsistencyLevel = result.ConsistencyLevel
 		for _, field := range result.Schema.Fields {
 			if field.FieldID >= common.StartOfUserFieldID {
 				dct.result.Schema.Fields = append(dct.result.Schema.Fields, &schemapb.FieldSchema{
@@ -2741,7 +3175,7 @@ func (g *getCollectionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getCollectionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -2828,11 +3262,11 @@ func (g *getPartitionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getPartitionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
-	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.CollectionName, g.PartitionName)
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.DbName, g.CollectionName, g.PartitionName)
 	if err != nil {
 		return err
 	}
@@ -2951,7 +3385,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 		}
 		collectionIDs := make([]UniqueID, 0)
 		for _, collectionName := range sct.CollectionNames {
-			collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, sct.DbName, collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -3000,7 +3434,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
 				return errors.New("failed to show collections")
 			}
-			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.DbName, collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -3334,7 +3768,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.DbName, collectionName)
 		if err != nil {
 			log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 				zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3347,7 +3781,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 		}
 		partitionIDs := make([]UniqueID, 0)
 		for _, partitionName := range spt.PartitionNames {
-			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, spt.DbName, collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3395,7 +3829,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
 				return errors.New("failed to show partitions")
 			}
-			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, collectionName, partitionName)
+			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, spt.DbName, collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3751,7 +4185,7 @@ func (gibpt *getIndexBuildProgressTask) PreExecute(ctx context.Context) error {
 
 func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 	collectionName := gibpt.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gibpt.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -3972,7 +4406,7 @@ func (gist *getIndexStateTask) PreExecute(ctx context.Context) error {
 
 func (gist *getIndexStateTask) Execute(ctx context.Context) error {
 	collectionName := gist.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -4174,7 +4608,7 @@ func (ft *flushTask) PreExecute(ctx context.Context) error {
 func (ft *flushTask) Execute(ctx context.Context) error {
 	coll2Segments := make(map[string]*schemapb.LongArray)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collID, err := globalMetaCache.GetCollectionID(ctx, ft.DbName, collName)
 		if err != nil {
 			return err
 		}
@@ -4268,16 +4702,20 @@ func (lct *loadCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if lct.ReplicaNumber < 0 {
+		return fmt.Errorf("invalid replica number %d, replica number should not be negative", lct.ReplicaNumber)
+	}
+
 	return nil
 }
 
 func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	log.Debug("loadCollectionTask Execute", zap.String("role", Params.RoleName), zap.Int64("msgID", lct.Base.MsgID))
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.DbName, lct.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.DbName, lct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -4289,12 +4727,13 @@ func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 			Timestamp: lct.Base.Timestamp,
 			SourceID:  lct.Base.SourceID,
 		},
-		DbID:         0,
-		CollectionID: collID,
-		Schema:       collSchema,
+		DbID:          0,
+		CollectionID:  collID,
+		Schema:        collSchema,
+		ReplicaNumber: lct.ReplicaNumber,
 	}
 	log.Debug("send LoadCollectionRequest to query coordinator", zap.String("role", Params.RoleName), zap.Int64("msgID", request.Base.MsgID), zap.Int64("collectionID", request.CollectionID),
-		zap.Any("schema", request.Schema))
+		zap.Any("schema", request.Schema), zap.Int32("replicaNumber", request.ReplicaNumber))
 	lct.result, err = lct.queryCoord.LoadCollection(ctx, request)
 	if err != nil {
 		return fmt.Errorf("call query coordinator LoadCollection: %s", err)
@@ -4367,7 +4806,7 @@ func (rct *releaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rct.DbName, rct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -4453,16 +4892,16 @@ func (lpt *loadPartitionsTask) PreExecute(ctx context.Context) error {
 
 func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.DbName, lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4548,12 +4987,12 @@ func (rpt *releasePartitionsTask) PreExecute(ctx context.Context) error {
 
 func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.DbName, rpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.DbName, rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4581,8 +5020,14 @@ func (rpt *releasePartitionsTask) PostExecute(ctx context.Context) error {
 type deleteTask struct {
 	Condition
 	*milvuspb.DeleteRequest
-	ctx    context.Context
-	result *milvuspb.MutationResult
+	ctx      context.Context
+	result   *milvuspb.MutationResult
+	chMgr    channelsMgr
+	chTicker channelsTimeTicker
+
+	collectionID UniqueID
+	partitionID  UniqueID
+	primaryKeys  *schemapb.IDs
 }
 
 func (dt *deleteTask) TraceCtx() context.Context {
@@ -4622,49 +5067,522 @@ func (dt *deleteTask) OnEnqueue() error {
 	return nil
 }
 
-func (dt *deleteTask) PreExecute(ctx context.Context) error {
-	dt.Base.MsgType = commonpb.MsgType_Delete
-	dt.Base.SourceID = Params.ProxyID
+func (dt *deleteTask) getPChanStats() (map[pChan]pChanStatistics, error) {
+	ret := make(map[pChan]pChanStatistics)
 
-	collName := dt.CollectionName
-	if err := ValidateCollectionName(collName); err != nil {
-		return err
+	channels, err := dt.getChannels()
+	if err != nil {
+		return ret, err
 	}
 
-	partitionTag := dt.PartitionName
-	if err := ValidatePartitionTag(partitionTag, true); err != nil {
-		return err
-	}
+	beginTs := dt.BeginTs()
+	endTs := dt.EndTs()
 
-	return nil
+	for _, channel := range channels {
+		ret[channel] = pChanStatistics{
+			minTs: beginTs,
+			maxTs: endTs,
+		}
+	}
+	return ret, nil
 }
 
-func (dt *deleteTask) Execute(ctx context.Context) (err error) {
-	return nil
+func (dt *deleteTask) getChannels() ([]pChan, error) {
+	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.DbName, dt.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	var channels []pChan
+	channels, err = dt.chMgr.getChannels(collID)
+	if err != nil {
+		err = dt.chMgr.createDMLMsgStream(collID)
+		if err != nil {
+			return nil, err
+		}
+		channels, err = dt.chMgr.getChannels(collID)
+		if err == nil {
+			for _, pchan := range channels {
+				err := dt.chTicker.addPChan(pchan)
+				if err != nil {
+					log.Warn("failed to add pchan to channels time ticker",
+						zap.Error(err),
+						zap.Int64("collection id", collID),
+						zap.String("pchan", pchan))
+				}
+			}
+		}
+	}
+	return channels, err
 }
 
-func (dt *deleteTask) PostExecute(ctx context.Context) error {
-	return nil
-}
+// getPrimaryKeysFromExpr extracts the primary keys to delete from the expression,
+// only `pk in [...]` and `pk == x` are supported for now.
+func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (*schemapb.IDs, error) {
+	if len(expr) == 0 {
+		return nil, errors.New("empty expression is not allowed in delete")
+	}
 
-type CreateAliasTask struct {
-	Condition
-	*milvuspb.CreateAliasRequest
-	ctx       context.Context
-	rootCoord types.RootCoord
-	result    *commonpb.Status
-}
+	plan, err := CreateExprPlan(schema, expr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expr plan, expr = %s, err = %s", expr, err)
+	}
 
-func (c *CreateAliasTask) TraceCtx() context.Context {
-	return c.ctx
-}
+	var columnInfo *planpb.ColumnInfo
+	var values []*planpb.GenericValue
+	switch realExpr := plan.GetPredicates().GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		columnInfo = realExpr.TermExpr.GetColumnInfo()
+		values = realExpr.TermExpr.GetValues()
+	case *planpb.Expr_UnaryRangeExpr:
+		if realExpr.UnaryRangeExpr.GetOp() != planpb.OpType_Equal {
+			return nil, fmt.Errorf("invalid expression for delete, only `in` and `==` on primary field are supported, expr = %s", expr)
+		}
+		columnInfo = realExpr.UnaryRangeExpr.GetColumnInfo()
+		values = []*planpb.GenericValue{realExpr.UnaryRangeExpr.GetValue()}
+	default:
+		return nil, fmt.Errorf("invalid expression for delete, only `in` and `==` on primary field are supported, expr = %s", expr)
+	}
 
-func (c *CreateAliasTask) ID() UniqueID {
-	return c.Base.MsgID
-}
+	if !columnInfo.GetIsPrimaryKey() {
+		return nil, fmt.Errorf("delete expression must be on the primary field, expr = %s", expr)
+	}
 
-func (c *CreateAliasTask) SetID(uid UniqueID) {
-	c.Base.MsgID = uid
+	switch columnInfo.GetDataType() {
+	case schemapb.DataType_Int64:
+		pks := make([]int64, 0, len(values))
+		for _, v := range values {
+			pks = append(pks, v.GetInt64Val())
+		}
+		return &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: pks,
+				},
+			},
+		}, nil
+	case schemapb.DataType_String:
+		pks := make([]string, 0, len(values))
+		for _, v := range values {
+			pks = append(pks, v.GetStringVal())
+		}
+		return &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{
+					Data: pks,
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("currently only support DataType Int64 or String as PrimaryField in delete, expr = %s", expr)
+	}
+}
+
+// repackDeleteMsgByHash splits the primary keys into delete messages by the dml channel they are hashed onto,
+// the same way insert does. Each message is a copy of req with its own shard name and primary keys.
+func repackDeleteMsgByHash(ctx context.Context, stream msgstream.MsgStream, channelNames []vChan,
+	primaryKeys *schemapb.IDs, req *internalpb.DeleteRequest) ([]msgstream.TsMsg, error) {
+	numPKs := typeutil.GetSizeOfIDs(primaryKeys)
+	hashValues := make([]uint32, 0, numPKs)
+	for _, pk := range primaryKeys.GetIntId().GetData() {
+		hash, _ := typeutil.Hash32Int64(pk)
+		hashValues = append(hashValues, hash)
+	}
+	for _, pk := range primaryKeys.GetStrId().GetData() {
+		hash, _ := typeutil.Hash32String(pk)
+		hashValues = append(hashValues, uint32(hash))
+	}
+
+	msg := &msgstream.DeleteMsg{
+		BaseMsg: msgstream.BaseMsg{
+			HashValues: hashValues,
+		},
+	}
+	channelIDs := stream.ComputeProduceChannelIndexes([]msgstream.TsMsg{msg})
+	if len(channelIDs) != 1 || len(channelIDs[0]) != numPKs {
+		return nil, fmt.Errorf("failed to compute dml channels for delete, collection = %s", req.CollectionName)
+	}
+
+	result := make(map[int32]*msgstream.DeleteMsg)
+	var msgs []msgstream.TsMsg
+	for index, channelID := range channelIDs[0] {
+		curMsg, ok := result[channelID]
+		if !ok {
+			if int(channelID) >= len(channelNames) {
+				return nil, fmt.Errorf("Proxy, delete, can not found channelName")
+			}
+			curMsg = &msgstream.DeleteMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx:            ctx,
+					BeginTimestamp: req.Timestamp,
+					EndTimestamp:   req.Timestamp,
+				},
+				DeleteRequest: internalpb.DeleteRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:   req.Base.MsgType,
+						MsgID:     req.Base.MsgID,
+						Timestamp: req.Base.Timestamp,
+						SourceID:  req.Base.SourceID,
+					},
+					ShardName:      channelNames[channelID],
+					DbName:         req.DbName,
+					CollectionName: req.CollectionName,
+					PartitionName:  req.PartitionName,
+					CollectionID:   req.CollectionID,
+					PartitionID:    req.PartitionID,
+					Timestamp:      req.Timestamp,
+				},
+			}
+			result[channelID] = curMsg
+			msgs = append(msgs, curMsg)
+		}
+		curMsg.HashValues = append(curMsg.HashValues, hashValues[index])
+		switch pk := typeutil.GetPK(primaryKeys, int64(index)).(type) {
+		case int64:
+			curMsg.PrimaryKeys = append(curMsg.PrimaryKeys, pk)
+		case string:
+			curMsg.StringPrimaryKeys = append(curMsg.StringPrimaryKeys, pk)
+		}
+	}
+	return msgs, nil
+}
+
+func (dt *deleteTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(dt.ctx, "Proxy-Delete-PreExecute")
+	defer sp.Finish()
+	dt.Base.MsgType = commonpb.MsgType_Delete
+	dt.Base.SourceID = Params.ProxyID
+
+	dt.result = &milvuspb.MutationResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IDs: &schemapb.IDs{
+			IdField: nil,
+		},
+		Timestamp: dt.BeginTs(),
+	}
+
+	collName := dt.CollectionName
+	if err := ValidateCollectionName(collName); err != nil {
+		return err
+	}
+	collID, err := globalMetaCache.GetCollectionID(ctx, dt.DbName, collName)
+	if err != nil {
+		return err
+	}
+	dt.collectionID = collID
+
+	// an empty partition name means deleting from all partitions of the collection
+	partitionTag := dt.PartitionName
+	if len(partitionTag) > 0 {
+		if err := ValidatePartitionTag(partitionTag, true); err != nil {
+			return err
+		}
+		partID, err := globalMetaCache.GetPartitionID(ctx, dt.DbName, collName, partitionTag)
+		if err != nil {
+			return err
+		}
+		dt.partitionID = partID
+	} else {
+		dt.partitionID = 0
+	}
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dt.DbName, collName)
+	if err != nil {
+		return err
+	}
+
+	dt.primaryKeys, err = getPrimaryKeysFromExpr(schema, dt.Expr)
+	if err != nil {
+		return err
+	}
+
+	dt.result.IDs = dt.primaryKeys
+	dt.result.DeleteCnt = int64(typeutil.GetSizeOfIDs(dt.primaryKeys))
+
+	return nil
+}
+
+func (dt *deleteTask) Execute(ctx context.Context) (err error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(dt.ctx, "Proxy-Delete-Execute")
+	defer sp.Finish()
+
+	collID := dt.collectionID
+	stream, err := dt.chMgr.getDMLStream(collID)
+	if err != nil {
+		err = dt.chMgr.createDMLMsgStream(collID)
+		if err != nil {
+			dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			dt.result.Status.Reason = err.Error()
+			return err
+		}
+		channels, err := dt.chMgr.getChannels(collID)
+		if err == nil {
+			for _, pchan := range channels {
+				err := dt.chTicker.addPChan(pchan)
+				if err != nil {
+					log.Warn("failed to add pchan to channels time ticker",
+						zap.Error(err),
+						zap.String("pchan", pchan))
+				}
+			}
+		}
+		stream, err = dt.chMgr.getDMLStream(collID)
+		if err != nil {
+			dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			dt.result.Status.Reason = err.Error()
+			return err
+		}
+	}
+
+	channelNames, err := dt.chMgr.getVChannels(collID)
+	if err != nil {
+		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		dt.result.Status.Reason = err.Error()
+		return err
+	}
+
+	ts := dt.BeginTs()
+	msgs, err := repackDeleteMsgByHash(ctx, stream, channelNames, dt.primaryKeys, &internalpb.DeleteRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_Delete,
+			MsgID:     dt.Base.MsgID,
+			Timestamp: ts,
+			SourceID:  dt.Base.SourceID,
+		},
+		DbName:         dt.DbName,
+		CollectionName: dt.CollectionName,
+		PartitionName:  dt.PartitionName,
+		CollectionID:   collID,
+		PartitionID:    dt.partitionID,
+		Timestamp:      ts,
+	})
+	if err != nil {
+		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		dt.result.Status.Reason = err.Error()
+		return err
+	}
+
+	msgPack := &msgstream.MsgPack{
+		BeginTs: ts,
+		EndTs:   ts,
+		Msgs:    msgs,
+	}
+
+	log.Debug("Proxy delete send to dml channels",
+		zap.Int64("collection id", collID),
+		zap.Int64("partition id", dt.partitionID),
+		zap.Int("num of primary keys", typeutil.GetSizeOfIDs(dt.primaryKeys)),
+		zap.Int("num of msgs", len(msgPack.Msgs)))
+
+	err = stream.Produce(msgPack)
+	if err != nil {
+		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		dt.result.Status.Reason = err.Error()
+		return err
+	}
+
+	return nil
+}
+
+func (dt *deleteTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// upsertTask deletes the rows of the incoming primary keys at the task timestamp and inserts the new rows
+// at a later one, it reuses insertTask to check the fields, repack the rows and assign the segment IDs.
+type upsertTask struct {
+	*insertTask
+	upsertReq    *milvuspb.UpsertRequest
+	tsoAllocator tsoAllocator
+
+	primaryKeys []int64
+}
+
+func (ut *upsertTask) Name() string {
+	return upsertTaskName
+}
+
+func (ut *upsertTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-PreExecute")
+	defer sp.Finish()
+
+	collectionName := ut.CollectionName
+	if err := ValidateCollectionName(collectionName); err != nil {
+		return err
+	}
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, ut.DbName, collectionName)
+	if err != nil {
+		return err
+	}
+	// the primary keys of autoID collections are allocated by proxy, there is nothing to replace
+	for _, field := range collSchema.Fields {
+		if field.IsPrimaryKey && field.AutoID {
+			return fmt.Errorf("upsert is not supported on collection with autoID primary field, collection = %s", collectionName)
+		}
+		// deletes only carry int64 primary keys
+		if field.IsPrimaryKey && field.DataType != schemapb.DataType_Int64 {
+			return fmt.Errorf("upsert is only supported on collection with int64 primary field, collection = %s", collectionName)
+		}
+	}
+
+	if err := ut.insertTask.PreExecute(ctx); err != nil {
+		return err
+	}
+
+	ut.primaryKeys = ut.result.GetIDs().GetIntId().GetData()
+	if len(ut.primaryKeys) == 0 {
+		return fmt.Errorf("primary field data is required in upsert, collection = %s", collectionName)
+	}
+	ut.result.UpsertCnt = int64(ut.req.NumRows)
+
+	return nil
+}
+
+func (ut *upsertTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-Execute")
+	defer sp.Finish()
+	collectionName := ut.CollectionName
+	collID, err := globalMetaCache.GetCollectionID(ctx, ut.DbName, collectionName)
+	if err != nil {
+		return err
+	}
+	ut.CollectionID = collID
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, ut.DbName, collectionName, ut.PartitionName)
+	if err != nil {
+		return err
+	}
+	ut.PartitionID = partitionID
+
+	stream, err := ut.chMgr.getDMLStream(collID)
+	if err != nil {
+		err = ut.chMgr.createDMLMsgStream(collID)
+		if err != nil {
+			ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			ut.result.Status.Reason = err.Error()
+			return err
+		}
+		channels, err := ut.chMgr.getChannels(collID)
+		if err == nil {
+			for _, pchan := range channels {
+				err := ut.chTicker.addPChan(pchan)
+				if err != nil {
+					log.Warn("failed to add pchan to channels time ticker",
+						zap.Error(err),
+						zap.String("pchan", pchan))
+				}
+			}
+		}
+		stream, err = ut.chMgr.getDMLStream(collID)
+		if err != nil {
+			ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			ut.result.Status.Reason = err.Error()
+			return err
+		}
+	}
+
+	channelNames, err := ut.chMgr.getVChannels(collID)
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+
+	// the old rows are deleted from the partition specified by user, or from all the partitions if not specified
+	var deletePartitionName string
+	var deletePartitionID UniqueID
+	if len(ut.upsertReq.GetPartitionName()) > 0 {
+		deletePartitionName = ut.PartitionName
+		deletePartitionID = partitionID
+	}
+
+	// the rows are only deleted by the deletes after them, so the delete takes the task timestamp
+	// and the new rows take a later one to survive the delete
+	deleteTs := ut.BeginTs()
+	insertTs, err := ut.tsoAllocator.AllocOne()
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+	ut.EndTimestamp = insertTs
+	for i := range ut.Timestamps {
+		ut.Timestamps[i] = insertTs
+	}
+
+	deleteMsgs, err := repackDeleteMsgByHash(ctx, stream, channelNames, ut.result.GetIDs(), &internalpb.DeleteRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_Delete,
+			MsgID:     ut.Base.MsgID,
+			Timestamp: deleteTs,
+			SourceID:  ut.Base.SourceID,
+		},
+		DbName:         ut.upsertReq.GetDbName(),
+		CollectionName: collectionName,
+		PartitionName:  deletePartitionName,
+		CollectionID:   collID,
+		PartitionID:    deletePartitionID,
+		Timestamp:      deleteTs,
+	})
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+
+	ut.BaseMsg.Ctx = ctx
+	insertPack, err := ut._assignSegmentID(stream, &msgstream.MsgPack{
+		BeginTs: insertTs,
+		EndTs:   insertTs,
+		Msgs:    []msgstream.TsMsg{&ut.BaseInsertTask},
+	})
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+
+	msgPack := &msgstream.MsgPack{
+		BeginTs: deleteTs,
+		EndTs:   insertTs,
+		Msgs:    append(deleteMsgs, insertPack.Msgs...),
+	}
+
+	log.Debug("Proxy upsert send to dml channels",
+		zap.Int64("collection id", collID),
+		zap.Int64("partition id", partitionID),
+		zap.Int("num of primary keys", len(ut.primaryKeys)),
+		zap.Int("num of delete msgs", len(deleteMsgs)),
+		zap.Int("num of insert msgs", len(insertPack.Msgs)))
+
+	err = stream.Produce(msgPack)
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+
+	return nil
+}
+
+type CreateAliasTask struct {
+	Condition
+	*milvuspb.CreateAliasRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (c *CreateAliasTask) TraceCtx() context.Context {
+	return c.ctx
+}
+
+func (c *CreateAliasTask) ID() UniqueID {
+	return c.Base.MsgID
+}
+
+func (c *CreateAliasTask) SetID(uid UniqueID) {
+	c.Base.MsgID = uid
 }
 
 func (c *CreateAliasTask) Name() string {
@@ -4856,3 +5774,196 @@ func (a *AlterAliasTask) Execute(ctx context.Context) error {
 func (a *AlterAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+type CreateDatabaseTask struct {
+	Condition
+	*milvuspb.CreateDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (c *CreateDatabaseTask) TraceCtx() context.Context {
+	return c.ctx
+}
+
+func (c *CreateDatabaseTask) ID() UniqueID {
+	return c.Base.MsgID
+}
+
+func (c *CreateDatabaseTask) SetID(uid UniqueID) {
+	c.Base.MsgID = uid
+}
+
+func (c *CreateDatabaseTask) Name() string {
+	return CreateDatabaseTaskName
+}
+
+func (c *CreateDatabaseTask) Type() commonpb.MsgType {
+	return c.Base.MsgType
+}
+
+func (c *CreateDatabaseTask) BeginTs() Timestamp {
+	return c.Base.Timestamp
+}
+
+func (c *CreateDatabaseTask) EndTs() Timestamp {
+	return c.Base.Timestamp
+}
+
+func (c *CreateDatabaseTask) SetTs(ts Timestamp) {
+	c.Base.Timestamp = ts
+}
+
+func (c *CreateDatabaseTask) OnEnqueue() error {
+	c.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (c *CreateDatabaseTask) PreExecute(ctx context.Context) error {
+	c.Base.MsgType = commonpb.MsgType_CreateDatabase
+	c.Base.SourceID = Params.ProxyID
+
+	if err := ValidateDatabaseName(c.DbName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *CreateDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	c.result, err = c.rootCoord.CreateDatabase(ctx, c.CreateDatabaseRequest)
+	return err
+}
+
+func (c *CreateDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type DropDatabaseTask struct {
+	Condition
+	*milvuspb.DropDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (d *DropDatabaseTask) TraceCtx() context.Context {
+	return d.ctx
+}
+
+func (d *DropDatabaseTask) ID() UniqueID {
+	return d.Base.MsgID
+}
+
+func (d *DropDatabaseTask) SetID(uid UniqueID) {
+	d.Base.MsgID = uid
+}
+
+func (d *DropDatabaseTask) Name() string {
+	return DropDatabaseTaskName
+}
+
+func (d *DropDatabaseTask) Type() commonpb.MsgType {
+	return d.Base.MsgType
+}
+
+func (d *DropDatabaseTask) BeginTs() Timestamp {
+	return d.Base.Timestamp
+}
+
+func (d *DropDatabaseTask) EndTs() Timestamp {
+	return d.Base.Timestamp
+}
+
+func (d *DropDatabaseTask) SetTs(ts Timestamp) {
+	d.Base.Timestamp = ts
+}
+
+func (d *DropDatabaseTask) OnEnqueue() error {
+	d.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (d *DropDatabaseTask) PreExecute(ctx context.Context) error {
+	d.Base.MsgType = commonpb.MsgType_DropDatabase
+	d.Base.SourceID = Params.ProxyID
+
+	if err := ValidateDatabaseName(d.DbName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *DropDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	d.result, err = d.rootCoord.DropDatabase(ctx, d.DropDatabaseRequest)
+	return err
+}
+
+func (d *DropDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type ListDatabasesTask struct {
+	Condition
+	*milvuspb.ListDatabasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.ListDatabasesResponse
+}
+
+func (l *ListDatabasesTask) TraceCtx() context.Context {
+	return l.ctx
+}
+
+func (l *ListDatabasesTask) ID() UniqueID {
+	return l.Base.MsgID
+}
+
+func (l *ListDatabasesTask) SetID(uid UniqueID) {
+	l.Base.MsgID = uid
+}
+
+func (l *ListDatabasesTask) Name() string {
+	return ListDatabasesTaskName
+}
+
+func (l *ListDatabasesTask) Type() commonpb.MsgType {
+	return l.Base.MsgType
+}
+
+func (l *ListDatabasesTask) BeginTs() Timestamp {
+	return l.Base.Timestamp
+}
+
+func (l *ListDatabasesTask) EndTs() Timestamp {
+	return l.Base.Timestamp
+}
+
+func (l *ListDatabasesTask) SetTs(ts Timestamp) {
+	l.Base.Timestamp = ts
+}
+
+func (l *ListDatabasesTask) OnEnqueue() error {
+	l.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (l *ListDatabasesTask) PreExecute(ctx context.Context) error {
+	l.Base.MsgType = commonpb.MsgType_ListDatabases
+	l.Base.SourceID = Params.ProxyID
+	return nil
+}
+
+func (l *ListDatabasesTask) Execute(ctx context.Context) error {
+	var err error
+	l.result, err = l.rootCoord.ListDatabases(ctx, l.ListDatabasesRequest)
+	return err
+}
+
+func (l *ListDatabasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
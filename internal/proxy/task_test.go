@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"strconv"
 	"sync"
@@ -22,10 +23,12 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 	"github.com/stretchr/testify/assert"
 )
@@ -634,6 +637,92 @@ func TestInsertTask_checkRowNums(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestInsertTask_stringField(t *testing.T) {
+	ctx := context.Background()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	idAllocator, err := allocator.NewIDAllocator(ctx, rc, Params.ProxyID)
+	assert.NoError(t, err)
+	_ = idAllocator.Start()
+	defer idAllocator.Close()
+
+	numRows := 2
+	task := insertTask{
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{
+				Base: &commonpb.MsgBase{},
+			},
+		},
+		schema: &schemapb.CollectionSchema{
+			Name: "TestInsertTask_stringField",
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:      100,
+					Name:         "pk",
+					IsPrimaryKey: true,
+					DataType:     schemapb.DataType_String,
+					TypeParams:   []*commonpb.KeyValuePair{{Key: "max_length", Value: "4"}},
+				},
+				{
+					FieldID:  101,
+					Name:     "Int64",
+					DataType: schemapb.DataType_Int64,
+				},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: uint32(numRows),
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_String,
+					FieldName: "pk",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_StringData{
+								StringData: &schemapb.StringArray{Data: []string{"a", "abcd"}},
+							},
+						},
+					},
+				},
+				newScalarFieldData(schemapb.DataType_Int64, "Int64", numRows),
+			},
+		},
+		result: &milvuspb.MutationResult{
+			IDs: &schemapb.IDs{},
+		},
+		rowIDAllocator: idAllocator,
+	}
+
+	assert.NoError(t, task.checkRowNums())
+	assert.NoError(t, task.checkFieldAutoID())
+	assert.Equal(t, []string{"a", "abcd"}, task.result.IDs.GetStrId().GetData())
+	// rows are hashed by the string primary keys
+	for i, pk := range []string{"a", "abcd"} {
+		hash, _ := typeutil.Hash32String(pk)
+		assert.Equal(t, uint32(hash), task.HashValues[i])
+	}
+
+	assert.NoError(t, task.transferColumnBasedRequestToRowBasedData())
+	assert.Equal(t, numRows, len(task.RowData))
+	for _, blob := range task.RowData {
+		// 4 bytes length, 4 bytes string and 8 bytes int64
+		assert.Equal(t, 16, len(blob.Value))
+	}
+	assert.Equal(t, uint32(1), binary.LittleEndian.Uint32(task.RowData[0].Value[0:4]))
+	assert.Equal(t, []byte{'a', 0, 0, 0}, task.RowData[0].Value[4:8])
+	assert.Equal(t, uint32(4), binary.LittleEndian.Uint32(task.RowData[1].Value[0:4]))
+	assert.Equal(t, []byte("abcd"), task.RowData[1].Value[4:8])
+
+	// string exceeds the max length
+	task.req.FieldsData[0].GetScalars().GetStringData().Data[0] = "abcde"
+	assert.Error(t, task.transferColumnBasedRequestToRowBasedData())
+
+	// less string data
+	task.req.FieldsData[0] = newScalarFieldData(schemapb.DataType_String, "pk", numRows/2)
+	assert.Error(t, task.checkRowNums())
+}
+
 func TestTranslateOutputFields(t *testing.T) {
 	const (
 		idFieldName           = "id"
@@ -1020,6 +1109,30 @@ func TestCreateCollectionTask(t *testing.T) {
 		err = task.PreExecute(ctx)
 		assert.Error(t, err)
 
+		// string fields are accepted, primary key or not
+		for _, isPrimaryKey := range []bool{true, false} {
+			schema = proto.Clone(schemaBackup).(*schemapb.CollectionSchema)
+			for idx := range schema.Fields {
+				schema.Fields[idx].IsPrimaryKey = false
+				schema.Fields[idx].AutoID = false
+			}
+			schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+				FieldID:      200,
+				Name:         "str",
+				IsPrimaryKey: isPrimaryKey,
+				DataType:     schemapb.DataType_String,
+				TypeParams:   []*commonpb.KeyValuePair{{Key: "max_length", Value: "8"}},
+			})
+			if !isPrimaryKey {
+				schema.Fields[0].IsPrimaryKey = true
+			}
+			stringFieldSchema, err := proto.Marshal(schema)
+			assert.NoError(t, err)
+			task.CreateCollectionRequest.Schema = stringFieldSchema
+			err = task.PreExecute(ctx)
+			assert.NoError(t, err)
+		}
+
 		// ValidateFieldName
 		schema = proto.Clone(schemaBackup).(*schemapb.CollectionSchema)
 		for idx := range schema.Fields {
@@ -1165,7 +1278,7 @@ func TestDropCollectionTask(t *testing.T) {
 	assert.NotNil(t, err)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1243,7 +1356,7 @@ func TestHasCollectionTask(t *testing.T) {
 	assert.Equal(t, false, task.result.Value)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1349,7 +1462,7 @@ func TestDescribeCollectionTask_ShardsNum1(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1408,7 +1521,7 @@ func TestDescribeCollectionTask_ShardsNum2(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1702,7 +1815,7 @@ func TestSearchTask_all(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2043,7 +2156,7 @@ func TestSearchTask_7803_reduce(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2225,6 +2338,341 @@ func TestSearchTask_7803_reduce(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSkipSearchResultData(t *testing.T) {
+	data := &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       3,
+		Scores:     []float32{1, 2, 3, 4, 5},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: []int64{11, 12, 13, 21, 22},
+				},
+			},
+		},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "int64",
+				FieldId:   100,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{
+							LongData: &schemapb.LongArray{
+								Data: []int64{110, 120, 130, 210, 220},
+							},
+						},
+					},
+				},
+			},
+		},
+		Topks: []int64{3, 2},
+	}
+
+	assert.Equal(t, data, skipSearchResultData(data, 0))
+
+	ret := skipSearchResultData(data, 1)
+	assert.Equal(t, int64(2), ret.TopK)
+	assert.Equal(t, []int64{2, 1}, ret.Topks)
+	assert.Equal(t, []int64{12, 13, 22}, ret.Ids.GetIntId().Data)
+	assert.Equal(t, []float32{2, 3, 5}, ret.Scores)
+	assert.Equal(t, []int64{120, 130, 220}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+
+	ret = skipSearchResultData(data, 3)
+	assert.Equal(t, int64(0), ret.TopK)
+	assert.Equal(t, []int64{0, 0}, ret.Topks)
+	assert.Equal(t, 0, len(ret.Ids.GetIntId().Data))
+	assert.Equal(t, 0, len(ret.FieldsData))
+}
+
+func TestReduceRetrieveResultsByPK(t *testing.T) {
+	newResult := func(pks []int64) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: pks,
+					},
+				},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "pk",
+					FieldId:   100,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{
+								LongData: &schemapb.LongArray{
+									Data: pks,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	results := []*internalpb.RetrieveResults{
+		newResult([]int64{1, 4, 5}),
+		newResult([]int64{2, 3, 6}),
+		newResult([]int64{}),
+	}
+
+	fieldsData := reduceRetrieveResultsByPK(results, 0, 4)
+	assert.Equal(t, []int64{1, 2, 3, 4}, fieldsData[0].GetScalars().GetLongData().Data)
+
+	fieldsData = reduceRetrieveResultsByPK(results, 2, 3)
+	assert.Equal(t, []int64{3, 4, 5}, fieldsData[0].GetScalars().GetLongData().Data)
+
+	fieldsData = reduceRetrieveResultsByPK(results, 4, 10)
+	assert.Equal(t, []int64{5, 6}, fieldsData[0].GetScalars().GetLongData().Data)
+
+	fieldsData = reduceRetrieveResultsByPK(results, 6, 10)
+	assert.Equal(t, 0, len(fieldsData))
+
+	// replicas and query nodes serving the same segment return the same entities
+	duplicated := []*internalpb.RetrieveResults{
+		newResult([]int64{1, 2, 4}),
+		newResult([]int64{1, 2, 3}),
+		newResult([]int64{2, 3, 5}),
+	}
+	fieldsData = reduceRetrieveResultsByPK(duplicated, 0, 10)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, fieldsData[0].GetScalars().GetLongData().Data)
+
+	fieldsData = reduceRetrieveResultsByPK(duplicated, 2, 2)
+	assert.Equal(t, []int64{3, 4}, fieldsData[0].GetScalars().GetLongData().Data)
+
+	// string primary keys are merged in lexical order
+	newStrResult := func(pks []string) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_StrId{
+					StrId: &schemapb.StringArray{
+						Data: pks,
+					},
+				},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_String,
+					FieldName: "pk",
+					FieldId:   100,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_StringData{
+								StringData: &schemapb.StringArray{
+									Data: pks,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	strResults := []*internalpb.RetrieveResults{
+		newStrResult([]string{"a", "ab", "c"}),
+		newStrResult([]string{"ab", "b"}),
+	}
+	fieldsData = reduceRetrieveResultsByPK(strResults, 1, 10)
+	assert.Equal(t, []string{"ab", "b", "c"}, fieldsData[0].GetScalars().GetStringData().Data)
+}
+
+func TestParseRangeSearchInfo(t *testing.T) {
+	info, err := parseRangeSearchInfo(nil, "L2")
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+
+	info, err = parseRangeSearchInfo([]*commonpb.KeyValuePair{{Key: RadiusKey, Value: "10"}}, "L2")
+	assert.NoError(t, err)
+	assert.Equal(t, float32(10), info.Radius)
+	assert.Equal(t, float32(math.Inf(-1)), info.RangeFilter)
+
+	info, err = parseRangeSearchInfo([]*commonpb.KeyValuePair{{Key: RadiusKey, Value: "0.5"}}, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, float32(0.5), info.Radius)
+	assert.Equal(t, float32(math.Inf(1)), info.RangeFilter)
+
+	info, err = parseRangeSearchInfo([]*commonpb.KeyValuePair{
+		{Key: RadiusKey, Value: "10"},
+		{Key: RangeFilterKey, Value: "1"},
+	}, "L2")
+	assert.NoError(t, err)
+	assert.Equal(t, float32(1), info.RangeFilter)
+
+	_, err = parseRangeSearchInfo([]*commonpb.KeyValuePair{{Key: RangeFilterKey, Value: "1"}}, "L2")
+	assert.Error(t, err)
+
+	_, err = parseRangeSearchInfo([]*commonpb.KeyValuePair{{Key: RadiusKey, Value: "far"}}, "L2")
+	assert.Error(t, err)
+
+	_, err = parseRangeSearchInfo([]*commonpb.KeyValuePair{
+		{Key: RadiusKey, Value: "10"},
+		{Key: RangeFilterKey, Value: "near"},
+	}, "L2")
+	assert.Error(t, err)
+
+	_, err = parseRangeSearchInfo([]*commonpb.KeyValuePair{
+		{Key: RadiusKey, Value: "1"},
+		{Key: RangeFilterKey, Value: "10"},
+	}, "L2")
+	assert.Error(t, err)
+}
+
+func TestReduceSearchResultData_RangeSearch(t *testing.T) {
+	// scores of L2 are negated distances, sorted in descending order, padded with invalid hits
+	newResult := func(ids []int64, scores []float32) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Scores:     scores,
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: ids,
+					},
+				},
+			},
+		}
+	}
+	results := []*schemapb.SearchResultData{
+		newResult([]int64{1, 2, 3}, []float32{-1, -2, -30}),
+		newResult([]int64{4, 5, -1}, []float32{-0.5, -3, 0}),
+	}
+
+	ret, err := reduceSearchResultData(results, 2, 1, 3, "L2", nil, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 1, 2}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{3}, ret.Results.Topks)
+
+	// hits within radius are kept
+	ret, err = reduceSearchResultData(results, 2, 1, 3, "L2", &planpb.RangeSearchInfo{
+		Radius:      2.5,
+		RangeFilter: float32(math.Inf(-1)),
+	}, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 1, 2}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []float32{0.5, 1, 2}, ret.Results.Scores)
+	assert.Equal(t, []int64{3}, ret.Results.Topks)
+
+	// more hits within radius than the max results fail
+	_, err = reduceSearchResultData(results, 2, 1, 3, "L2", &planpb.RangeSearchInfo{
+		Radius:      10,
+		RangeFilter: float32(math.Inf(-1)),
+	}, 0, 0)
+	assert.Error(t, err)
+
+	// hits closer than range_filter are dropped
+	ret, err = reduceSearchResultData(results, 2, 1, 3, "L2", &planpb.RangeSearchInfo{
+		Radius:      10,
+		RangeFilter: 1,
+	}, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 5}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{3}, ret.Results.Topks)
+}
+
+func TestParseGroupByField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Float},
+		},
+	}
+
+	field, groupSize, err := parseGroupByField(nil, schema)
+	assert.NoError(t, err)
+	assert.Nil(t, field)
+	assert.Equal(t, int64(0), groupSize)
+
+	field, groupSize, err = parseGroupByField([]*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "category"}}, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), field.FieldID)
+	assert.Equal(t, int64(1), groupSize)
+
+	field, groupSize, err = parseGroupByField([]*commonpb.KeyValuePair{
+		{Key: GroupByFieldKey, Value: "category"},
+		{Key: GroupSizeKey, Value: "3"},
+	}, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), field.FieldID)
+	assert.Equal(t, int64(3), groupSize)
+
+	invalids := [][]*commonpb.KeyValuePair{
+		{{Key: GroupSizeKey, Value: "3"}},
+		{{Key: GroupByFieldKey, Value: "unknown"}},
+		{{Key: GroupByFieldKey, Value: "score"}},
+		{{Key: GroupByFieldKey, Value: "category"}, {Key: GroupSizeKey, Value: "0"}},
+		{{Key: GroupByFieldKey, Value: "category"}, {Key: GroupSizeKey, Value: "three"}},
+	}
+	for _, invalid := range invalids {
+		_, _, err = parseGroupByField(invalid, schema)
+		assert.Error(t, err)
+	}
+}
+
+func TestReduceSearchResultData_GroupBy(t *testing.T) {
+	newResult := func(ids []int64, scores []float32, groups []int64) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Scores:     scores,
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: ids,
+					},
+				},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type: schemapb.DataType_Int64,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{
+								LongData: &schemapb.LongArray{
+									Data: groups,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	results := []*schemapb.SearchResultData{
+		newResult([]int64{1, 2, 3}, []float32{-1, -2, -3}, []int64{10, 10, 20}),
+		newResult([]int64{4, 5, 6}, []float32{-1.5, -2.5, -4}, []int64{10, 30, 20}),
+	}
+
+	// the best hit of each group
+	ret, err := reduceSearchResultData(results, 2, 1, 3, "L2", nil, 0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 5, 3}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []float32{1, 2.5, 3}, ret.Results.Scores)
+	assert.Equal(t, []int64{10, 30, 20}, ret.Results.FieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []int64{3}, ret.Results.Topks)
+
+	ret, err = reduceSearchResultData(results, 2, 1, 3, "L2", nil, 0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 4, 5}, ret.Results.Ids.GetIntId().Data)
+
+	// all hits fall into a single group
+	results = []*schemapb.SearchResultData{
+		newResult([]int64{1, 2, 3}, []float32{-1, -2, -3}, []int64{10, 10, 10}),
+		newResult([]int64{4, 5, 6}, []float32{-1.5, -2.5, -4}, []int64{10, 10, 10}),
+	}
+	ret, err = reduceSearchResultData(results, 2, 1, 3, "L2", nil, 0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, ret.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{1}, ret.Results.Topks)
+
+	_, err = reduceSearchResultData(results, 2, 1, 3, "L2", nil, 1, 1)
+	assert.Error(t, err)
+}
+
 func TestSearchTask_Type(t *testing.T) {
 	Params.Init()
 
@@ -2407,7 +2855,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	assert.NoError(t, createColT.Execute(ctx))
 	assert.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, _ := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, _ := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// ValidateCollectionName
 	task.query.CollectionName = "$"
@@ -2719,7 +3167,7 @@ func TestQueryTask_all(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -3026,7 +3474,7 @@ func TestInsertTask_all(t *testing.T) {
 		PartitionName:  partitionName,
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -3225,14 +3673,92 @@ func TestInsertTask_all(t *testing.T) {
 }
 
 func TestDeleteTask_all(t *testing.T) {
+	var err error
+
 	Params.Init()
 
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+
 	ctx := context.Background()
 
+	err = InitMetaCache(rc)
+	assert.NoError(t, err)
+
+	shardsNum := int32(2)
 	prefix := "TestDeleteTask_all"
 	dbName := ""
 	collectionName := prefix + funcutil.GenRandomStr()
 	partitionName := prefix + funcutil.GenRandomStr()
+	boolField := "bool"
+	int32Field := "int32"
+	int64Field := "int64"
+	floatField := "float"
+	doubleField := "double"
+	floatVecField := "fvec"
+	binaryVecField := "bvec"
+	dim := 128
+
+	schema := constructCollectionSchemaWithAllType(
+		boolField, int32Field, int64Field, floatField, doubleField,
+		floatVecField, binaryVecField, dim, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+
+	createColT := &createCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			Schema:         marshaledSchema,
+			ShardsNum:      shardsNum,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+		result:    nil,
+		schema:    nil,
+	}
+
+	assert.NoError(t, createColT.OnEnqueue())
+	assert.NoError(t, createColT.PreExecute(ctx))
+	assert.NoError(t, createColT.Execute(ctx))
+	assert.NoError(t, createColT.PostExecute(ctx))
+
+	_, _ = rc.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_CreatePartition,
+			MsgID:     0,
+			Timestamp: 0,
+			SourceID:  Params.ProxyID,
+		},
+		DbName:         dbName,
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+	})
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	assert.NoError(t, err)
+
+	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
+	query := newMockGetChannelsService()
+	factory := newSimpleMockMsgStreamFactory()
+	chMgr := newChannelsMgrImpl(dmlChannelsFunc, nil, query.GetChannels, nil, factory)
+	defer chMgr.removeAllDMLStream()
+	defer chMgr.removeAllDQLStream()
+
+	err = chMgr.createDMLMsgStream(collectionID)
+	assert.NoError(t, err)
+	pchans, err := chMgr.getChannels(collectionID)
+	assert.NoError(t, err)
+
+	interval := time.Millisecond * 10
+	tso := newMockTsoAllocator()
+
+	ticker := newChannelsTimeTicker(ctx, interval, []string{}, newGetStatisticsFunc(pchans), tso)
+	_ = ticker.start()
+	defer ticker.close()
 
 	task := &deleteTask{
 		Condition: NewTaskCondition(ctx),
@@ -3246,23 +3772,11 @@ func TestDeleteTask_all(t *testing.T) {
 			DbName:         dbName,
 			CollectionName: collectionName,
 			PartitionName:  partitionName,
-			Expr:           "",
-		},
-		ctx: ctx,
-		result: &milvuspb.MutationResult{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_Success,
-				Reason:    "",
-			},
-			IDs:          nil,
-			SuccIndex:    nil,
-			ErrIndex:     nil,
-			Acknowledged: false,
-			InsertCnt:    0,
-			DeleteCnt:    0,
-			UpsertCnt:    0,
-			Timestamp:    0,
+			Expr:           int64Field + " in [1, 2, 3]",
 		},
+		ctx:      ctx,
+		chMgr:    chMgr,
+		chTicker: ticker,
 	}
 
 	assert.NoError(t, task.OnEnqueue())
@@ -3281,20 +3795,87 @@ func TestDeleteTask_all(t *testing.T) {
 	assert.Equal(t, ts, task.BeginTs())
 	assert.Equal(t, ts, task.EndTs())
 
+	channels, err := task.getChannels()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, pchans, channels)
+
+	stats, err := task.getPChanStats()
+	assert.NoError(t, err)
+	assert.Equal(t, len(pchans), len(stats))
+
 	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, []int64{1, 2, 3}, task.primaryKeys.GetIntId().GetData())
+	assert.Equal(t, int64(3), task.result.DeleteCnt)
+	assert.Equal(t, []int64{1, 2, 3}, task.result.IDs.GetIntId().GetData())
 	assert.NoError(t, task.Execute(ctx))
 	assert.NoError(t, task.PostExecute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.Status.ErrorCode)
 }
 
 func TestDeleteTask_PreExecute(t *testing.T) {
+	var err error
+
 	Params.Init()
 
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+
 	ctx := context.Background()
 
-	prefix := "TestDeleteTask_all"
+	err = InitMetaCache(rc)
+	assert.NoError(t, err)
+
+	prefix := "TestDeleteTask_PreExecute"
 	dbName := ""
 	collectionName := prefix + funcutil.GenRandomStr()
 	partitionName := prefix + funcutil.GenRandomStr()
+	boolField := "bool"
+	int32Field := "int32"
+	int64Field := "int64"
+	floatField := "float"
+	doubleField := "double"
+	floatVecField := "fvec"
+	binaryVecField := "bvec"
+	dim := 128
+
+	schema := constructCollectionSchemaWithAllType(
+		boolField, int32Field, int64Field, floatField, doubleField,
+		floatVecField, binaryVecField, dim, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+
+	createColT := &createCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			Schema:         marshaledSchema,
+			ShardsNum:      common.DefaultShardsNum,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+		result:    nil,
+		schema:    nil,
+	}
+
+	assert.NoError(t, createColT.OnEnqueue())
+	assert.NoError(t, createColT.PreExecute(ctx))
+	assert.NoError(t, createColT.Execute(ctx))
+	assert.NoError(t, createColT.PostExecute(ctx))
+
+	_, _ = rc.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_CreatePartition,
+			MsgID:     0,
+			Timestamp: 0,
+			SourceID:  Params.ProxyID,
+		},
+		DbName:         dbName,
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+	})
 
 	task := &deleteTask{
 		DeleteRequest: &milvuspb.DeleteRequest{
@@ -3307,19 +3888,326 @@ func TestDeleteTask_PreExecute(t *testing.T) {
 			DbName:         dbName,
 			CollectionName: collectionName,
 			PartitionName:  partitionName,
-			Expr:           "",
+			Expr:           int64Field + " in [1, 2]",
 		},
+		ctx: ctx,
 	}
 
 	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, []int64{1, 2}, task.primaryKeys.GetIntId().GetData())
 
 	task.DeleteRequest.CollectionName = "" // empty
 	assert.Error(t, task.PreExecute(ctx))
 	task.DeleteRequest.CollectionName = collectionName
 
-	task.DeleteRequest.PartitionName = "" // empty
+	task.DeleteRequest.PartitionName = "" // empty means all partitions
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, UniqueID(0), task.partitionID)
+	task.DeleteRequest.PartitionName = "#" + partitionName // invalid
 	assert.Error(t, task.PreExecute(ctx))
 	task.DeleteRequest.PartitionName = partitionName
+
+	task.DeleteRequest.Expr = int64Field + " == 10"
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, []int64{10}, task.primaryKeys.GetIntId().GetData())
+
+	task.DeleteRequest.Expr = "" // empty
+	assert.Error(t, task.PreExecute(ctx))
+
+	task.DeleteRequest.Expr = int64Field + " > 10" // range
+	assert.Error(t, task.PreExecute(ctx))
+
+	task.DeleteRequest.Expr = int32Field + " in [1, 2]" // not primary field
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestDeleteTask_StringPrimaryKey(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "TestDeleteTask_StringPrimaryKey",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_String,
+				TypeParams:   []*commonpb.KeyValuePair{{Key: "max_length", Value: "8"}},
+			},
+			{
+				FieldID:    101,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}},
+			},
+		},
+	}
+
+	pks, err := getPrimaryKeysFromExpr(schema, `pk in ["a", "b"]`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, pks.GetStrId().GetData())
+
+	pks, err = getPrimaryKeysFromExpr(schema, `pk == "c"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c"}, pks.GetStrId().GetData())
+
+	_, err = getPrimaryKeysFromExpr(schema, `pk > "c"`)
+	assert.Error(t, err)
+
+	// string primary keys are hashed the same way as insert
+	msgs, err := repackDeleteMsgByHash(context.Background(), newSimpleMockMsgStream(), []vChan{"ch"}, pks, &internalpb.DeleteRequest{
+		Base: &commonpb.MsgBase{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(msgs))
+	deleteMsg := msgs[0].(*msgstream.DeleteMsg)
+	assert.Equal(t, []string{"c"}, deleteMsg.StringPrimaryKeys)
+	assert.Equal(t, 0, len(deleteMsg.PrimaryKeys))
+	hash, _ := typeutil.Hash32String("c")
+	assert.Equal(t, []uint32{uint32(hash)}, deleteMsg.HashValues)
+}
+
+func TestUpsertTask_all(t *testing.T) {
+	var err error
+
+	Params.Init()
+
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+
+	ctx := context.Background()
+
+	err = InitMetaCache(rc)
+	assert.NoError(t, err)
+
+	shardsNum := int32(2)
+	prefix := "TestUpsertTask_all"
+	dbName := ""
+	collectionName := prefix + funcutil.GenRandomStr()
+	partitionName := prefix + funcutil.GenRandomStr()
+	boolField := "bool"
+	int32Field := "int32"
+	int64Field := "int64"
+	floatField := "float"
+	doubleField := "double"
+	floatVecField := "fvec"
+	binaryVecField := "bvec"
+	dim := 128
+	nb := 10
+
+	createCollection := func(collectionName string, schema *schemapb.CollectionSchema) {
+		marshaledSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+
+		createColT := &createCollectionTask{
+			Condition: NewTaskCondition(ctx),
+			CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+				Base:           nil,
+				DbName:         dbName,
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+				ShardsNum:      shardsNum,
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+			result:    nil,
+			schema:    nil,
+		}
+
+		assert.NoError(t, createColT.OnEnqueue())
+		assert.NoError(t, createColT.PreExecute(ctx))
+		assert.NoError(t, createColT.Execute(ctx))
+		assert.NoError(t, createColT.PostExecute(ctx))
+	}
+
+	createCollection(collectionName, constructCollectionSchemaWithAllType(
+		boolField, int32Field, int64Field, floatField, doubleField,
+		floatVecField, binaryVecField, dim, collectionName))
+
+	_, _ = rc.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_CreatePartition,
+			MsgID:     0,
+			Timestamp: 0,
+			SourceID:  Params.ProxyID,
+		},
+		DbName:         dbName,
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+	})
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	assert.NoError(t, err)
+
+	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
+	query := newMockGetChannelsService()
+	factory := newSimpleMockMsgStreamFactory()
+	chMgr := newChannelsMgrImpl(dmlChannelsFunc, nil, query.GetChannels, nil, factory)
+	defer chMgr.removeAllDMLStream()
+	defer chMgr.removeAllDQLStream()
+
+	err = chMgr.createDMLMsgStream(collectionID)
+	assert.NoError(t, err)
+	pchans, err := chMgr.getChannels(collectionID)
+	assert.NoError(t, err)
+
+	interval := time.Millisecond * 10
+	tso := newMockTsoAllocator()
+
+	ticker := newChannelsTimeTicker(ctx, interval, []string{}, newGetStatisticsFunc(pchans), tso)
+	_ = ticker.start()
+	defer ticker.close()
+
+	idAllocator, err := allocator.NewIDAllocator(ctx, rc, Params.ProxyID)
+	assert.NoError(t, err)
+	_ = idAllocator.Start()
+	defer idAllocator.Close()
+
+	segAllocator, err := newSegIDAssigner(ctx, &mockDataCoord{expireTime: Timestamp(2500)}, getLastTick1)
+	assert.NoError(t, err)
+	segAllocator.Init()
+	_ = segAllocator.Start()
+	defer segAllocator.Close()
+
+	newScalarFieldData := func(fieldName string, fieldID int64, dataType schemapb.DataType, data *schemapb.ScalarField) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      dataType,
+			FieldName: fieldName,
+			Field:     &schemapb.FieldData_Scalars{Scalars: data},
+			FieldId:   fieldID,
+		}
+	}
+	pks := generateInt64Array(nb)
+	fieldsData := []*schemapb.FieldData{
+		newScalarFieldData(boolField, common.StartOfUserFieldID+0, schemapb.DataType_Bool, &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: generateBoolArray(nb)}},
+		}),
+		newScalarFieldData(int32Field, common.StartOfUserFieldID+1, schemapb.DataType_Int32, &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: generateInt32Array(nb)}},
+		}),
+		newScalarFieldData(int64Field, common.StartOfUserFieldID+2, schemapb.DataType_Int64, &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+		}),
+		newScalarFieldData(floatField, common.StartOfUserFieldID+3, schemapb.DataType_Float, &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: generateFloat32Array(nb)}},
+		}),
+		newScalarFieldData(doubleField, common.StartOfUserFieldID+4, schemapb.DataType_Double, &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: generateFloat64Array(nb)}},
+		}),
+		{
+			Type:      schemapb.DataType_FloatVector,
+			FieldName: floatVecField,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: int64(dim),
+					Data: &schemapb.VectorField_FloatVector{
+						FloatVector: &schemapb.FloatArray{
+							Data: generateFloatVectors(nb, dim),
+						},
+					},
+				},
+			},
+			FieldId: common.StartOfUserFieldID + 5,
+		},
+		{
+			Type:      schemapb.DataType_BinaryVector,
+			FieldName: binaryVecField,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: int64(dim),
+					Data: &schemapb.VectorField_BinaryVector{
+						BinaryVector: generateBinaryVectors(nb, dim),
+					},
+				},
+			},
+			FieldId: common.StartOfUserFieldID + 6,
+		},
+	}
+
+	newUpsertTask := func(collectionName string, fieldsData []*schemapb.FieldData) *upsertTask {
+		req := &milvuspb.UpsertRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_Insert,
+				MsgID:     0,
+				Timestamp: 0,
+				SourceID:  Params.ProxyID,
+			},
+			DbName:         dbName,
+			CollectionName: collectionName,
+			PartitionName:  partitionName,
+			FieldsData:     fieldsData,
+			NumRows:        uint32(nb),
+		}
+		return &upsertTask{
+			insertTask: &insertTask{
+				BaseInsertTask: BaseInsertTask{
+					InsertRequest: internalpb.InsertRequest{
+						Base: &commonpb.MsgBase{
+							MsgType: commonpb.MsgType_Insert,
+							MsgID:   0,
+						},
+						CollectionName: collectionName,
+						PartitionName:  partitionName,
+					},
+				},
+				req: &milvuspb.InsertRequest{
+					Base:           req.Base,
+					DbName:         req.DbName,
+					CollectionName: req.CollectionName,
+					PartitionName:  req.PartitionName,
+					FieldsData:     req.FieldsData,
+					NumRows:        req.NumRows,
+				},
+				Condition:      NewTaskCondition(ctx),
+				ctx:            ctx,
+				rowIDAllocator: idAllocator,
+				segIDAssigner:  segAllocator,
+				chMgr:          chMgr,
+				chTicker:       ticker,
+			},
+			upsertReq:    req,
+			tsoAllocator: &mockIncTsoAllocator{lastTs: 1000},
+		}
+	}
+
+	t.Run("upsert", func(t *testing.T) {
+		task := newUpsertTask(collectionName, fieldsData)
+
+		assert.NoError(t, task.OnEnqueue())
+		assert.Equal(t, upsertTaskName, task.Name())
+		task.SetTs(Timestamp(1000))
+
+		channels, err := task.getChannels()
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, pchans, channels)
+
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Equal(t, pks, task.primaryKeys)
+		assert.Equal(t, int64(nb), task.result.UpsertCnt)
+		assert.Equal(t, pks, task.result.IDs.GetIntId().GetData())
+		assert.NoError(t, task.Execute(ctx))
+		assert.NoError(t, task.PostExecute(ctx))
+		assert.Equal(t, commonpb.ErrorCode_Success, task.result.Status.ErrorCode)
+		// the new rows are inserted after the delete of the old rows
+		assert.Equal(t, nb, len(task.Timestamps))
+		for _, ts := range task.Timestamps {
+			assert.Greater(t, ts, task.BeginTs())
+		}
+	})
+
+	t.Run("fields data missing", func(t *testing.T) {
+		task := newUpsertTask(collectionName, append([]*schemapb.FieldData{}, fieldsData[0], fieldsData[1], fieldsData[3]))
+		assert.NoError(t, task.OnEnqueue())
+		assert.Error(t, task.PreExecute(ctx))
+	})
+
+	t.Run("autoID collection", func(t *testing.T) {
+		autoIDCollectionName := prefix + funcutil.GenRandomStr()
+		createCollection(autoIDCollectionName, constructCollectionSchema(int64Field, floatVecField, dim, autoIDCollectionName))
+
+		task := newUpsertTask(autoIDCollectionName, []*schemapb.FieldData{fieldsData[2], fieldsData[5]})
+		assert.NoError(t, task.OnEnqueue())
+		assert.Error(t, task.PreExecute(ctx))
+	})
 }
 
 func TestCreateAlias_all(t *testing.T) {
@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// getTravelTimestamp returns the timestamp a search or query travels to. travelTs takes precedence over
+// the RFC3339 travelTime, and beginTs of the request is used when neither is set. The timestamp must
+// lie within the retention duration before beginTs, older data may have been compacted or collected.
+func getTravelTimestamp(travelTs Timestamp, travelTime string, beginTs Timestamp) (Timestamp, error) {
+	if travelTs == 0 && travelTime != "" {
+		t, err := time.Parse(time.RFC3339, travelTime)
+		if err != nil {
+			return 0, fmt.Errorf("invalid travel time %s, it should be in RFC3339 format, error = %w", travelTime, err)
+		}
+		travelTs = tsoutil.ComposeTSByTime(t, 0)
+	}
+	if travelTs == 0 {
+		return beginTs, nil
+	}
+	if travelTs > beginTs {
+		return 0, fmt.Errorf("travel timestamp %d is later than the request timestamp %d", travelTs, beginTs)
+	}
+	if travelTs < tsoutil.AddPhysicalDurationOnTs(beginTs, -Params.RetentionDuration) {
+		return 0, fmt.Errorf("travel timestamp %d is out of the retention duration %v", travelTs, Params.RetentionDuration)
+	}
+	return travelTs, nil
+}
+
+// getCollectionSchemaAt returns the schema of the collection at the travel timestamp. The collection is
+// looked up by id, so a collection dropped and created again under the same name since then is rejected.
+func getCollectionSchemaAt(ctx context.Context, dbName string, collectionName string, travelTs, beginTs Timestamp) (*schemapb.CollectionSchema, error) {
+	if travelTs >= beginTs {
+		return globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+	}
+	collID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := globalMetaCache.GetCollectionSchemaAt(ctx, dbName, collID, travelTs)
+	if err != nil {
+		return nil, fmt.Errorf("collection %s does not exist at travel timestamp %d, error = %w", collectionName, travelTs, err)
+	}
+	return schema, nil
+}
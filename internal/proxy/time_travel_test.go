@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTravelTimestamp(t *testing.T) {
+	Params.Init()
+	now := time.Now()
+	beginTs := tsoutil.ComposeTSByTime(now, 10)
+	hourAgo := tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0)
+
+	ts, err := getTravelTimestamp(0, "", beginTs)
+	assert.Nil(t, err)
+	assert.Equal(t, beginTs, ts)
+
+	ts, err = getTravelTimestamp(hourAgo, "", beginTs)
+	assert.Nil(t, err)
+	assert.Equal(t, hourAgo, ts)
+
+	// travel timestamp takes precedence over travel time
+	ts, err = getTravelTimestamp(hourAgo, "not a time", beginTs)
+	assert.Nil(t, err)
+	assert.Equal(t, hourAgo, ts)
+
+	ts, err = getTravelTimestamp(0, now.Add(-time.Hour).Format(time.RFC3339), beginTs)
+	assert.Nil(t, err)
+	expected := tsoutil.ComposeTSByTime(now.Add(-time.Hour).Truncate(time.Second), 0)
+	assert.Equal(t, expected, ts)
+
+	_, err = getTravelTimestamp(0, "not a time", beginTs)
+	assert.NotNil(t, err)
+
+	_, err = getTravelTimestamp(beginTs+1, "", beginTs)
+	assert.NotNil(t, err)
+
+	outOfRetention := tsoutil.ComposeTSByTime(now.Add(-Params.RetentionDuration-time.Minute), 0)
+	_, err = getTravelTimestamp(outOfRetention, "", beginTs)
+	assert.NotNil(t, err)
+}
+
+func TestGetCollectionSchemaAt(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	schema, err := getCollectionSchemaAt(ctx, "", "collection1", 100, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, "", schema.Description)
+
+	schema, err = getCollectionSchemaAt(ctx, "", "collection1", 99, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, "old", schema.Description)
+
+	_, err = getCollectionSchemaAt(ctx, "", "collection2", 99, 100)
+	assert.NotNil(t, err)
+}
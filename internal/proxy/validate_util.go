@@ -14,11 +14,23 @@ package proxy
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// maxVarCharLength is the upper limit of the max length declared by string field
+const maxVarCharLength = 65535
+
+// the length limits of the raw password, the upper limit is below the 72 bytes accepted by bcrypt
+const (
+	minPasswordLength = 6
+	maxPasswordLength = 64
 )
 
 func isAlpha(c uint8) bool {
@@ -73,6 +85,91 @@ func ValidateCollectionName(collName string) error {
 	return validateCollectionNameOrAlias(collName, "name")
 }
 
+func ValidateDatabaseName(dbName string) error {
+	dbName = strings.TrimSpace(dbName)
+
+	if dbName == "" {
+		return errors.New("Database name should not be empty")
+	}
+
+	invalidMsg := "Invalid database name: " + dbName + ". "
+	if int64(len(dbName)) > Params.MaxNameLength {
+		msg := invalidMsg + "The length of a database name must be less than " +
+			strconv.FormatInt(Params.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := dbName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a database name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(dbName); i++ {
+		c := dbName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Database name can only contain numbers, letters and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+// validateIdentifier checks the name of users and roles, which follows the same rule as database names
+func validateIdentifier(entity, entityType string) error {
+	if entity == "" {
+		return fmt.Errorf("%s should not be empty", entityType)
+	}
+
+	invalidMsg := fmt.Sprintf("Invalid %s: %s. ", strings.ToLower(entityType), entity)
+	if int64(len(entity)) > Params.MaxNameLength {
+		msg := invalidMsg + fmt.Sprintf("The length of a %s must be less than ", strings.ToLower(entityType)) +
+			strconv.FormatInt(Params.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := entity[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + fmt.Sprintf("The first character of a %s must be an underscore or letter.", strings.ToLower(entityType))
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(entity); i++ {
+		c := entity[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + fmt.Sprintf("%s can only contain numbers, letters and underscores.", entityType)
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+func ValidateUsername(username string) error {
+	return validateIdentifier(username, "Username")
+}
+
+func ValidateRoleName(roleName string) error {
+	return validateIdentifier(roleName, "Role name")
+}
+
+// ValidatePassword checks the length of the raw password
+func ValidatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return fmt.Errorf("The length of password must be between %d and %d characters", minPasswordLength, maxPasswordLength)
+	}
+	return nil
+}
+
+// ValidatePrivilege checks whether the privilege is supported
+func ValidatePrivilege(privilege string) error {
+	for _, p := range allPrivileges {
+		if p == privilege {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid privilege: %s. The supported privileges are %v", privilege, allPrivileges)
+}
+
 func ValidatePartitionTag(partitionTag string, strictCheck bool) error {
 	partitionTag = strings.TrimSpace(partitionTag)
 
@@ -149,6 +246,41 @@ func ValidateDimension(dim int64, isBinary bool) error {
 	return nil
 }
 
+// validateRangeSearchParams checks radius and range_filter of a range search, hits closer than radius and
+// not closer than range_filter are kept, and the distance of IP gets larger as hits get closer
+func validateRangeSearchParams(metricType string, radius, rangeFilter float32) error {
+	if math.IsNaN(float64(radius)) || math.IsNaN(float64(rangeFilter)) {
+		return fmt.Errorf("invalid radius %v and range_filter %v", radius, rangeFilter)
+	}
+	if metricType == "IP" {
+		if rangeFilter <= radius {
+			return fmt.Errorf("range_filter %v should be larger than radius %v for metric type %s", rangeFilter, radius, metricType)
+		}
+		return nil
+	}
+	if rangeFilter >= radius {
+		return fmt.Errorf("range_filter %v should be less than radius %v for metric type %s", rangeFilter, radius, metricType)
+	}
+	return nil
+}
+
+// validateResultWindow checks the offset and limit of a query, or the offset and topk of a search
+func validateResultWindow(offset, limit int64) error {
+	if offset < 0 {
+		return fmt.Errorf("invalid offset: %d. should not be negative", offset)
+	}
+	if limit < 0 {
+		return fmt.Errorf("invalid limit: %d. should not be negative", limit)
+	}
+	if offset > 0 && limit == 0 {
+		return fmt.Errorf("invalid offset: %d. limit is required when offset is set", offset)
+	}
+	if offset+limit > Params.MaxResultWindow {
+		return fmt.Errorf("invalid offset and limit: offset + limit (%d) should not exceed %d", offset+limit, Params.MaxResultWindow)
+	}
+	return nil
+}
+
 func ValidateVectorFieldMetricType(field *schemapb.FieldSchema) error {
 	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) {
 		return nil
@@ -185,6 +317,9 @@ func ValidateFieldAutoID(coll *schemapb.CollectionSchema) error {
 			if !field.IsPrimaryKey {
 				return fmt.Errorf("only primary field can speficy AutoID with true, field name = %s", field.Name)
 			}
+			if field.DataType != schemapb.DataType_Int64 {
+				return fmt.Errorf("only int64 primary field can speficy AutoID with true, field name = %s", field.Name)
+			}
 		}
 	}
 	return nil
@@ -197,8 +332,8 @@ func ValidatePrimaryKey(coll *schemapb.CollectionSchema) error {
 			if idx != -1 {
 				return fmt.Errorf("there are more than one primary key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
 			}
-			if field.DataType != schemapb.DataType_Int64 {
-				return errors.New("the data type of primary key should be int64")
+			if field.DataType != schemapb.DataType_Int64 && field.DataType != schemapb.DataType_String {
+				return errors.New("the data type of primary key should be int64 or string")
 			}
 			idx = i
 		}
@@ -209,6 +344,31 @@ func ValidatePrimaryKey(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// ValidateMaxLength checks the max length declared in the type params of string field
+func ValidateMaxLength(field *schemapb.FieldSchema) error {
+	if !typeutil.IsStringType(field.DataType) {
+		return nil
+	}
+	exist := false
+	for _, param := range field.TypeParams {
+		if param.Key == common.MaxLengthKey {
+			exist = true
+			break
+		}
+	}
+	if !exist {
+		return fmt.Errorf("max length is not defined in field type params, check type param `%s` for string field %s", common.MaxLengthKey, field.Name)
+	}
+	maxLength, err := typeutil.GetMaxLength(field)
+	if err != nil {
+		return err
+	}
+	if maxLength <= 0 || maxLength > maxVarCharLength {
+		return fmt.Errorf("invalid max length: %d. should be in range 1 ~ %d", maxLength, maxVarCharLength)
+	}
+	return nil
+}
+
 func RepeatedKeyValToMap(kvPairs []*commonpb.KeyValuePair) (map[string]string, error) {
 	resMap := make(map[string]string)
 	for _, kv := range kvPairs {
@@ -226,7 +386,8 @@ func isVector(dataType schemapb.DataType) (bool, error) {
 	case schemapb.DataType_Bool, schemapb.DataType_Int8,
 		schemapb.DataType_Int16, schemapb.DataType_Int32,
 		schemapb.DataType_Int64,
-		schemapb.DataType_Float, schemapb.DataType_Double:
+		schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_String:
 		return false, nil
 
 	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
@@ -270,8 +431,8 @@ func ValidateSchema(coll *schemapb.CollectionSchema) error {
 			} else if primaryIdx != -1 {
 				return fmt.Errorf("there are more than one primary key, field name = %s, %s", coll.Fields[primaryIdx].Name, field.Name)
 			}
-			if field.DataType != schemapb.DataType_Int64 {
-				return fmt.Errorf("type of primary key shoule be int64")
+			if field.DataType != schemapb.DataType_Int64 && field.DataType != schemapb.DataType_String {
+				return fmt.Errorf("type of primary key shoule be int64 or string")
 			}
 			primaryIdx = idx
 		}
@@ -324,7 +485,11 @@ func ValidateSchema(coll *schemapb.CollectionSchema) error {
 			if len(field.IndexParams) != 0 {
 				return fmt.Errorf("index params is not empty for scalar field: %s(%d)", field.Name, field.FieldID)
 			}
-			if len(field.TypeParams) != 0 {
+			if typeutil.IsStringType(field.DataType) {
+				if err := ValidateMaxLength(field); err != nil {
+					return err
+				}
+			} else if len(field.TypeParams) != 0 {
 				return fmt.Errorf("type params is not empty for scalar field: %s(%d)", field.Name, field.FieldID)
 			}
 		}
@@ -12,6 +12,7 @@
 package proxy
 
 import (
+	"math"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -45,6 +46,68 @@ func TestValidateCollectionName(t *testing.T) {
 	}
 }
 
+func TestValidateDatabaseName(t *testing.T) {
+	assert.Nil(t, ValidateDatabaseName("abc"))
+	assert.Nil(t, ValidateDatabaseName("_123abc"))
+	assert.Nil(t, ValidateDatabaseName("abc123_"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"123abc",
+		"abc$",
+		"_12 ac",
+		" ",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, ValidateDatabaseName(name))
+	}
+}
+
+func TestValidateUsernameAndRoleName(t *testing.T) {
+	assert.Nil(t, ValidateUsername("abc"))
+	assert.Nil(t, ValidateUsername("_123abc"))
+	assert.Nil(t, ValidateRoleName("abc123_"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"123abc",
+		"abc$",
+		"_12 ac",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, ValidateUsername(name))
+		assert.NotNil(t, ValidateRoleName(name))
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	assert.Nil(t, ValidatePassword("123456"))
+	assert.NotNil(t, ValidatePassword("12345"))
+	assert.NotNil(t, ValidatePassword(string(make([]byte, maxPasswordLength+1))))
+}
+
+func TestValidatePrivilege(t *testing.T) {
+	for _, p := range allPrivileges {
+		assert.Nil(t, ValidatePrivilege(p))
+	}
+	assert.NotNil(t, ValidatePrivilege(""))
+	assert.NotNil(t, ValidatePrivilege("search"))
+}
+
 func TestValidatePartitionTag(t *testing.T) {
 	assert.Nil(t, ValidatePartitionTag("abc", true))
 	assert.Nil(t, ValidatePartitionTag("123abc", true))
@@ -107,6 +170,32 @@ func TestValidateDimension(t *testing.T) {
 	assert.NotNil(t, ValidateDimension(9, true))
 }
 
+func TestValidateRangeSearchParams(t *testing.T) {
+	assert.Nil(t, validateRangeSearchParams("L2", 10, 1))
+	assert.Nil(t, validateRangeSearchParams("L2", 10, float32(math.Inf(-1))))
+	assert.Nil(t, validateRangeSearchParams("IP", 0.5, 1))
+	assert.Nil(t, validateRangeSearchParams("IP", 0.5, float32(math.Inf(1))))
+
+	assert.NotNil(t, validateRangeSearchParams("L2", 1, 10))
+	assert.NotNil(t, validateRangeSearchParams("L2", 1, 1))
+	assert.NotNil(t, validateRangeSearchParams("IP", 1, 0.5))
+	assert.NotNil(t, validateRangeSearchParams("IP", float32(math.NaN()), 1))
+}
+
+func TestValidateResultWindow(t *testing.T) {
+	Params.Init()
+	assert.Nil(t, validateResultWindow(0, 0))
+	assert.Nil(t, validateResultWindow(0, 10))
+	assert.Nil(t, validateResultWindow(10, 10))
+	assert.Nil(t, validateResultWindow(10, Params.MaxResultWindow-10))
+
+	// invalid offset or limit
+	assert.NotNil(t, validateResultWindow(-1, 10))
+	assert.NotNil(t, validateResultWindow(0, -1))
+	assert.NotNil(t, validateResultWindow(10, 0))
+	assert.NotNil(t, validateResultWindow(10, Params.MaxResultWindow))
+}
+
 func TestValidateVectorFieldMetricType(t *testing.T) {
 	field1 := &schemapb.FieldSchema{
 		Name:         "",
@@ -179,6 +268,14 @@ func TestValidatePrimaryKey(t *testing.T) {
 	assert.NotNil(t, ValidateSchema(&coll))
 	pf.DataType = schemapb.DataType_Int64
 	assert.Nil(t, ValidateSchema(&coll))
+	pf.DataType = schemapb.DataType_String
+	assert.NotNil(t, ValidateSchema(&coll))
+	pf.TypeParams = []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}
+	assert.Nil(t, ValidateSchema(&coll))
+	assert.Nil(t, ValidatePrimaryKey(&coll))
+	pf.AutoID = true
+	assert.NotNil(t, ValidateFieldAutoID(&coll))
+	pf.AutoID = false
 	coll.Fields = append(coll.Fields, &schemapb.FieldSchema{
 		Name:         "",
 		FieldID:      102,
@@ -191,6 +288,30 @@ func TestValidatePrimaryKey(t *testing.T) {
 	assert.NotNil(t, ValidateSchema(&coll))
 }
 
+func TestValidateMaxLength(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:     "f1",
+		FieldID:  100,
+		DataType: schemapb.DataType_Int64,
+	}
+	assert.Nil(t, ValidateMaxLength(field))
+
+	field.DataType = schemapb.DataType_String
+	assert.NotNil(t, ValidateMaxLength(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: "max_length", Value: "abc"}}
+	assert.NotNil(t, ValidateMaxLength(field))
+
+	field.TypeParams[0].Value = "0"
+	assert.NotNil(t, ValidateMaxLength(field))
+
+	field.TypeParams[0].Value = "65536"
+	assert.NotNil(t, ValidateMaxLength(field))
+
+	field.TypeParams[0].Value = "256"
+	assert.Nil(t, ValidateMaxLength(field))
+}
+
 func TestValidateSchema(t *testing.T) {
 	coll := &schemapb.CollectionSchema{
 		Name:        "coll1",
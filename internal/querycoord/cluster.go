@@ -222,16 +222,16 @@ func (c *queryNodeCluster) loadSegments(ctx context.Context, nodeID int64, in *q
 				segmentInfos[segmentID] = proto.Clone(segmentInfo).(*querypb.SegmentInfo)
 				if in.LoadCondition != querypb.TriggerCondition_loadBalance {
 					segmentInfo.SegmentState = querypb.SegmentState_sealing
-					segmentInfo.NodeID = nodeID
+					setSegmentNode(segmentInfo, in.ReplicaID, nodeID)
 				}
 			} else {
 				segmentInfo = &querypb.SegmentInfo{
 					SegmentID:    segmentID,
 					CollectionID: info.CollectionID,
 					PartitionID:  info.PartitionID,
-					NodeID:       nodeID,
 					SegmentState: querypb.SegmentState_sealing,
 				}
+				setSegmentNode(segmentInfo, in.ReplicaID, nodeID)
 			}
 			c.clusterMeta.setSegmentInfo(segmentID, segmentInfo)
 		}
@@ -269,6 +269,15 @@ func (c *queryNodeCluster) releaseSegments(ctx context.Context, nodeID int64, in
 		}
 
 		for _, segmentID := range in.SegmentIDs {
+			segmentInfo, err := c.clusterMeta.getSegmentInfoByID(segmentID)
+			if err != nil {
+				continue
+			}
+			// other replicas may still hold the segment
+			if removeSegmentNode(segmentInfo, nodeID) {
+				c.clusterMeta.setSegmentInfo(segmentID, segmentInfo)
+				continue
+			}
 			c.clusterMeta.deleteSegmentInfoByID(segmentID)
 		}
 		return nil
@@ -407,8 +416,9 @@ func (c *queryNodeCluster) getSegmentInfo(ctx context.Context, in *querypb.GetSe
 }
 
 type queryNodeGetMetricsResponse struct {
-	resp *milvuspb.GetMetricsResponse
-	err  error
+	nodeID int64
+	resp   *milvuspb.GetMetricsResponse
+	err    error
 }
 
 func (c *queryNodeCluster) getMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) []queryNodeGetMetricsResponse {
@@ -416,11 +426,12 @@ func (c *queryNodeCluster) getMetrics(ctx context.Context, in *milvuspb.GetMetri
 	defer c.RUnlock()
 
 	ret := make([]queryNodeGetMetricsResponse, 0, len(c.nodes))
-	for _, node := range c.nodes {
+	for nodeID, node := range c.nodes {
 		resp, err := node.getMetrics(ctx, in)
 		ret = append(ret, queryNodeGetMetricsResponse{
-			resp: resp,
-			err:  err,
+			nodeID: nodeID,
+			resp:   resp,
+			err:    err,
 		})
 	}
 
@@ -463,7 +474,7 @@ func (c *queryNodeCluster) getNumSegments(nodeID int64) (int, error) {
 		segmentInfos = append(segmentInfos, res...)
 	}
 	for _, info := range segmentInfos {
-		if info.NodeID == nodeID {
+		if isSegmentOnNode(info, nodeID) {
 			numSegment++
 		}
 	}
@@ -128,7 +128,7 @@ func (qc *QueryCoord) LoadCollection(ctx context.Context, req *querypb.LoadColle
 	collectionID := req.CollectionID
 	//schema := req.Schema
 	log.Debug("LoadCollectionRequest received", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID), zap.Int64("collectionID", collectionID),
-		zap.Stringer("schema", req.Schema))
+		zap.Stringer("schema", req.Schema), zap.Int32("replicaNumber", req.ReplicaNumber))
 	status := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
 	}
@@ -525,6 +525,71 @@ func (qc *QueryCoord) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmen
 	}, nil
 }
 
+// LoadBalance moves the sealed segments on the source query node to the destination query nodes
+func (qc *QueryCoord) LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error) {
+	log.Debug("LoadBalanceRequest received", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID),
+		zap.Int64s("sourceNodeIDs", req.SourceNodeIDs), zap.Int64s("dstNodeIDs", req.DstNodeIDs), zap.Int64s("sealedSegmentIDs", req.SealedSegmentIDs))
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("load balance end with query coordinator not healthy")
+		return status, err
+	}
+
+	req.BalanceReason = querypb.TriggerCondition_loadBalance
+	loadBalanceTask := &LoadBalanceTask{
+		BaseTask: BaseTask{
+			ctx:              qc.loopCtx,
+			Condition:        NewTaskCondition(qc.loopCtx),
+			triggerCondition: querypb.TriggerCondition_loadBalance,
+		},
+		LoadBalanceRequest: req,
+		rootCoord:          qc.rootCoordClient,
+		dataCoord:          qc.dataCoordClient,
+		cluster:            qc.cluster,
+		meta:               qc.meta,
+	}
+	qc.scheduler.Enqueue([]task{loadBalanceTask})
+
+	err := loadBalanceTask.WaitToFinish()
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		return status, err
+	}
+	log.Debug("LoadBalanceRequest completed", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID),
+		zap.Int64s("sourceNodeIDs", req.SourceNodeIDs), zap.Int64s("dstNodeIDs", req.DstNodeIDs))
+	return status, nil
+}
+
+// GetReplicas returns the in-memory replicas of the collection,
+// the result is empty if the collection is loaded without replica groups
+func (qc *QueryCoord) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("getReplicas end with query coordinator not healthy")
+		return &querypb.GetReplicasResponse{
+			Status: status,
+		}, err
+	}
+
+	replicas := qc.meta.getReplicasByCollectionID(req.CollectionID)
+	log.Debug("getReplicas", zap.Int64("collectionID", req.CollectionID), zap.Int("numReplicas", len(replicas)))
+	return &querypb.GetReplicasResponse{
+		Status:   status,
+		Replicas: replicas,
+	}, nil
+}
+
 func (qc *QueryCoord) isHealthy() bool {
 	code := qc.stateCode.Load().(internalpb.StateCode)
 	return code == internalpb.StateCode_Healthy
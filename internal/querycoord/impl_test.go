@@ -399,6 +399,164 @@ func TestLoadBalanceTask(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestManualLoadBalance(t *testing.T) {
+	refreshParams()
+	baseCtx := context.Background()
+
+	queryCoord, err := startQueryCoord(baseCtx)
+	assert.Nil(t, err)
+
+	queryNode1, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode1.queryNodeID)
+
+	res, err := queryCoord.LoadCollection(baseCtx, &querypb.LoadCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadCollection,
+		},
+		CollectionID: defaultCollectionID,
+		Schema:       genCollectionSchema(defaultCollectionID, false),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, res.ErrorCode)
+	for {
+		collectionInfo := queryCoord.meta.showCollections()
+		if collectionInfo[0].InMemoryPercentage == 100 {
+			break
+		}
+	}
+
+	queryNode2, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode2.queryNodeID)
+
+	t.Run("Test LoadBalance with invalid destination", func(t *testing.T) {
+		status, err := queryCoord.LoadBalance(baseCtx, &querypb.LoadBalanceRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadBalanceSegments,
+			},
+			SourceNodeIDs: []int64{queryNode1.queryNodeID},
+			DstNodeIDs:    []int64{queryNode1.queryNodeID},
+		})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+
+	t.Run("Test LoadBalance", func(t *testing.T) {
+		status, err := queryCoord.LoadBalance(baseCtx, &querypb.LoadBalanceRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadBalanceSegments,
+			},
+			SourceNodeIDs: []int64{queryNode1.queryNodeID},
+			DstNodeIDs:    []int64{queryNode2.queryNodeID},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		for _, info := range queryCoord.meta.showSegmentInfos(defaultCollectionID, nil) {
+			assert.Equal(t, queryNode2.queryNodeID, info.NodeID)
+		}
+	})
+
+	queryNode1.stop()
+	queryNode2.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
+
+func TestLoadCollectionWithReplicas(t *testing.T) {
+	refreshParams()
+	baseCtx := context.Background()
+
+	queryCoord, err := startQueryCoord(baseCtx)
+	assert.Nil(t, err)
+
+	queryNode1, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode1.queryNodeID)
+
+	t.Run("Test LoadCollection without enough nodes", func(t *testing.T) {
+		status, err := queryCoord.LoadCollection(baseCtx, &querypb.LoadCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadCollection,
+			},
+			CollectionID:  defaultCollectionID,
+			Schema:        genCollectionSchema(defaultCollectionID, false),
+			ReplicaNumber: 2,
+		})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+
+	queryNode2, err := startQueryNodeServer(baseCtx)
+	assert.Nil(t, err)
+	waitQueryNodeOnline(queryCoord.cluster, queryNode2.queryNodeID)
+
+	t.Run("Test LoadCollection with replicas", func(t *testing.T) {
+		status, err := queryCoord.LoadCollection(baseCtx, &querypb.LoadCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadCollection,
+			},
+			CollectionID:  defaultCollectionID,
+			Schema:        genCollectionSchema(defaultCollectionID, false),
+			ReplicaNumber: 2,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
+	t.Run("Test GetReplicas", func(t *testing.T) {
+		res, err := queryCoord.GetReplicas(baseCtx, &querypb.GetReplicasRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_Undefined,
+			},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, res.Status.ErrorCode)
+		assert.Equal(t, 2, len(res.Replicas))
+		for _, replica := range res.Replicas {
+			assert.Equal(t, 1, len(replica.NodeIds))
+		}
+
+		for _, info := range queryCoord.meta.showSegmentInfos(defaultCollectionID, nil) {
+			assert.Equal(t, 2, len(info.NodeIds))
+		}
+	})
+
+	t.Run("Test LoadCollection with different replica number", func(t *testing.T) {
+		status, err := queryCoord.LoadCollection(baseCtx, &querypb.LoadCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadCollection,
+			},
+			CollectionID:  defaultCollectionID,
+			Schema:        genCollectionSchema(defaultCollectionID, false),
+			ReplicaNumber: 1,
+		})
+		assert.NotNil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+	})
+
+	t.Run("Test ReleaseCollection", func(t *testing.T) {
+		status, err := queryCoord.ReleaseCollection(baseCtx, &querypb.ReleaseCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ReleaseCollection,
+			},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		assert.Equal(t, 0, len(queryCoord.meta.getReplicasByCollectionID(defaultCollectionID)))
+	})
+
+	queryNode1.stop()
+	queryNode2.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
+
 func TestGrpcTaskBeforeHealthy(t *testing.T) {
 	ctx := context.Background()
 	unHealthyCoord, err := startUnHealthyQueryCoord(ctx)
@@ -520,6 +678,28 @@ func TestGrpcTaskBeforeHealthy(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 
+	t.Run("Test LoadBalance", func(t *testing.T) {
+		status, err := unHealthyCoord.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadBalanceSegments,
+			},
+			SourceNodeIDs: []int64{defaultQueryNodeID},
+		})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Test GetReplicas", func(t *testing.T) {
+		res, err := unHealthyCoord.GetReplicas(ctx, &querypb.GetReplicasRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_Undefined,
+			},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, res.Status.ErrorCode)
+		assert.NotNil(t, err)
+	})
+
 	t.Run("Test GetComponentStates", func(t *testing.T) {
 		states, err := unHealthyCoord.GetComponentStates(ctx)
 		assert.Equal(t, commonpb.ErrorCode_Success, states.Status.ErrorCode)
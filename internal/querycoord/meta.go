@@ -15,6 +15,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -31,6 +32,7 @@ const (
 	collectionMetaPrefix   = "queryCoord-collectionMeta"
 	segmentMetaPrefix      = "queryCoord-segmentMeta"
 	queryChannelMetaPrefix = "queryCoord-queryChannel"
+	replicaMetaPrefix      = "queryCoord-replicaMeta"
 )
 
 // Meta contains information about all loaded collections and partitions, including segment information and vchannel information
@@ -68,6 +70,12 @@ type Meta interface {
 	setLoadType(collectionID UniqueID, loadType querypb.LoadType) error
 	getLoadType(collectionID UniqueID) (querypb.LoadType, error)
 	setLoadPercentage(collectionID UniqueID, partitionID UniqueID, percentage int64, loadType querypb.LoadType) error
+
+	setReplicaInfo(info *querypb.ReplicaInfo) error
+	getReplicaByID(replicaID UniqueID) (*querypb.ReplicaInfo, error)
+	getReplicasByCollectionID(collectionID UniqueID) []*querypb.ReplicaInfo
+	getReplicaByNodeID(collectionID UniqueID, nodeID int64) (*querypb.ReplicaInfo, error)
+	removeNodeFromReplicas(nodeID int64) ([]*querypb.ReplicaInfo, error)
 	//printMeta()
 }
 
@@ -78,6 +86,7 @@ type MetaReplica struct {
 	collectionInfos   map[UniqueID]*querypb.CollectionInfo
 	segmentInfos      map[UniqueID]*querypb.SegmentInfo
 	queryChannelInfos map[UniqueID]*querypb.QueryChannelInfo
+	replicas          map[UniqueID]*querypb.ReplicaInfo
 
 	//partitionStates map[UniqueID]*querypb.PartitionStates
 }
@@ -86,12 +95,14 @@ func newMeta(kv kv.MetaKv) (Meta, error) {
 	collectionInfos := make(map[UniqueID]*querypb.CollectionInfo)
 	segmentInfos := make(map[UniqueID]*querypb.SegmentInfo)
 	queryChannelInfos := make(map[UniqueID]*querypb.QueryChannelInfo)
+	replicas := make(map[UniqueID]*querypb.ReplicaInfo)
 
 	m := &MetaReplica{
 		client:            kv,
 		collectionInfos:   collectionInfos,
 		segmentInfos:      segmentInfos,
 		queryChannelInfos: queryChannelInfos,
+		replicas:          replicas,
 	}
 
 	err := m.reloadFromKV()
@@ -153,6 +164,23 @@ func (m *MetaReplica) reloadFromKV() error {
 		}
 		m.queryChannelInfos[collectionID] = queryChannelInfo
 	}
+
+	replicaKeys, replicaValues, err := m.client.LoadWithPrefix(replicaMetaPrefix)
+	if err != nil {
+		return err
+	}
+	for index := range replicaKeys {
+		replicaID, err := strconv.ParseInt(filepath.Base(replicaKeys[index]), 10, 64)
+		if err != nil {
+			return err
+		}
+		replicaInfo := &querypb.ReplicaInfo{}
+		err = proto.Unmarshal([]byte(replicaValues[index]), replicaInfo)
+		if err != nil {
+			return err
+		}
+		m.replicas[replicaID] = replicaInfo
+	}
 	//TODO::update partition states
 
 	return nil
@@ -307,14 +335,25 @@ func (m *MetaReplica) deleteSegmentInfoByNodeID(nodeID UniqueID) error {
 	defer m.Unlock()
 
 	for segmentID, info := range m.segmentInfos {
-		if info.NodeID == nodeID {
-			err := removeSegmentInfo(segmentID, m.client)
+		if !isSegmentOnNode(info, nodeID) {
+			continue
+		}
+		newInfo := proto.Clone(info).(*querypb.SegmentInfo)
+		if removeSegmentNode(newInfo, nodeID) {
+			err := saveSegmentInfo(segmentID, newInfo, m.client)
 			if err != nil {
-				log.Error("remove segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
+				log.Error("save segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
 				return err
 			}
-			delete(m.segmentInfos, segmentID)
+			m.segmentInfos[segmentID] = newInfo
+			continue
 		}
+		err := removeSegmentInfo(segmentID, m.client)
+		if err != nil {
+			log.Error("remove segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
+			return err
+		}
+		delete(m.segmentInfos, segmentID)
 	}
 
 	return nil
@@ -433,6 +472,17 @@ func (m *MetaReplica) releaseCollection(collectionID UniqueID) error {
 		}
 	}
 
+	for id, info := range m.replicas {
+		if info.CollectionID == collectionID {
+			err := removeReplicaInfo(id, m.client)
+			if err != nil {
+				log.Error("remove replicaInfo error", zap.Any("error", err.Error()), zap.Int64("replicaID", id))
+				return err
+			}
+			delete(m.replicas, id)
+		}
+	}
+
 	delete(m.queryChannelInfos, collectionID)
 	err := removeGlobalCollectionInfo(collectionID, m.client)
 	if err != nil {
@@ -701,6 +751,160 @@ func (m *MetaReplica) setLoadPercentage(collectionID UniqueID, partitionID Uniqu
 //	}
 //}
 
+func (m *MetaReplica) setReplicaInfo(info *querypb.ReplicaInfo) error {
+	m.Lock()
+	defer m.Unlock()
+
+	err := saveReplicaInfo(info, m.client)
+	if err != nil {
+		log.Error("save replicaInfo error", zap.Any("error", err.Error()), zap.Int64("replicaID", info.ReplicaID))
+		return err
+	}
+	m.replicas[info.ReplicaID] = proto.Clone(info).(*querypb.ReplicaInfo)
+	return nil
+}
+
+func (m *MetaReplica) getReplicaByID(replicaID UniqueID) (*querypb.ReplicaInfo, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	if info, ok := m.replicas[replicaID]; ok {
+		return proto.Clone(info).(*querypb.ReplicaInfo), nil
+	}
+
+	return nil, fmt.Errorf("getReplicaByID: can't find replicaID %d in replicas", replicaID)
+}
+
+func (m *MetaReplica) getReplicasByCollectionID(collectionID UniqueID) []*querypb.ReplicaInfo {
+	m.RLock()
+	defer m.RUnlock()
+
+	replicas := make([]*querypb.ReplicaInfo, 0)
+	for _, info := range m.replicas {
+		if info.CollectionID == collectionID {
+			replicas = append(replicas, proto.Clone(info).(*querypb.ReplicaInfo))
+		}
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].ReplicaID < replicas[j].ReplicaID
+	})
+	return replicas
+}
+
+func (m *MetaReplica) getReplicaByNodeID(collectionID UniqueID, nodeID int64) (*querypb.ReplicaInfo, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, info := range m.replicas {
+		if info.CollectionID != collectionID {
+			continue
+		}
+		for _, id := range info.NodeIds {
+			if id == nodeID {
+				return proto.Clone(info).(*querypb.ReplicaInfo), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("getReplicaByNodeID: node %d doesn't belong to any replica of collection %d", nodeID, collectionID)
+}
+
+// removeNodeFromReplicas removes the offline query node from all replicas, the updated replicas are returned
+func (m *MetaReplica) removeNodeFromReplicas(nodeID int64) ([]*querypb.ReplicaInfo, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	updated := make([]*querypb.ReplicaInfo, 0)
+	for _, info := range m.replicas {
+		nodeIDs := make([]int64, 0, len(info.NodeIds))
+		for _, id := range info.NodeIds {
+			if id != nodeID {
+				nodeIDs = append(nodeIDs, id)
+			}
+		}
+		if len(nodeIDs) == len(info.NodeIds) {
+			continue
+		}
+		newInfo := proto.Clone(info).(*querypb.ReplicaInfo)
+		newInfo.NodeIds = nodeIDs
+		err := saveReplicaInfo(newInfo, m.client)
+		if err != nil {
+			log.Error("save replicaInfo error", zap.Any("error", err.Error()), zap.Int64("replicaID", info.ReplicaID))
+			return nil, err
+		}
+		m.replicas[info.ReplicaID] = newInfo
+		updated = append(updated, proto.Clone(newInfo).(*querypb.ReplicaInfo))
+	}
+
+	return updated, nil
+}
+
+// getSegmentNodes returns the replicas holding the segment and the query nodes serving them,
+// segments loaded before replicas were introduced are served by NodeID only
+func getSegmentNodes(info *querypb.SegmentInfo) ([]UniqueID, []int64) {
+	if len(info.NodeIds) == 0 {
+		return []UniqueID{0}, []int64{info.NodeID}
+	}
+	return info.ReplicaIds, info.NodeIds
+}
+
+func isSegmentOnNode(info *querypb.SegmentInfo, nodeID int64) bool {
+	_, nodeIDs := getSegmentNodes(info)
+	for _, id := range nodeIDs {
+		if id == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
+func isSegmentOnReplica(info *querypb.SegmentInfo, replicaID UniqueID) bool {
+	replicaIDs, _ := getSegmentNodes(info)
+	for _, id := range replicaIDs {
+		if id == replicaID {
+			return true
+		}
+	}
+	return false
+}
+
+// setSegmentNode records that the segment copy of the replica is served by the query node
+func setSegmentNode(info *querypb.SegmentInfo, replicaID UniqueID, nodeID int64) {
+	for index, id := range info.ReplicaIds {
+		if id == replicaID {
+			info.NodeIds[index] = nodeID
+			info.NodeID = info.NodeIds[0]
+			return
+		}
+	}
+	info.ReplicaIds = append(info.ReplicaIds, replicaID)
+	info.NodeIds = append(info.NodeIds, nodeID)
+	info.NodeID = info.NodeIds[0]
+}
+
+// removeSegmentNode removes the query node from the nodes serving the segment,
+// false is returned if the segment is not served by any query node any more
+func removeSegmentNode(info *querypb.SegmentInfo, nodeID int64) bool {
+	if len(info.NodeIds) == 0 {
+		return info.NodeID != nodeID
+	}
+	replicaIDs := make([]UniqueID, 0, len(info.ReplicaIds))
+	nodeIDs := make([]int64, 0, len(info.NodeIds))
+	for index, id := range info.NodeIds {
+		if id != nodeID {
+			replicaIDs = append(replicaIDs, info.ReplicaIds[index])
+			nodeIDs = append(nodeIDs, id)
+		}
+	}
+	if len(nodeIDs) == 0 {
+		return false
+	}
+	info.ReplicaIds = replicaIDs
+	info.NodeIds = nodeIDs
+	info.NodeID = nodeIDs[0]
+	return true
+}
+
 func saveGlobalCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, kv kv.MetaKv) error {
 	infoBytes, err := proto.Marshal(info)
 	if err != nil {
@@ -740,3 +944,18 @@ func saveQueryChannelInfo(collectionID UniqueID, info *querypb.QueryChannelInfo,
 	key := fmt.Sprintf("%s/%d", queryChannelMetaPrefix, collectionID)
 	return kv.Save(key, string(infoBytes))
 }
+
+func saveReplicaInfo(info *querypb.ReplicaInfo, kv kv.MetaKv) error {
+	infoBytes, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("%s/%d", replicaMetaPrefix, info.ReplicaID)
+	return kv.Save(key, string(infoBytes))
+}
+
+func removeReplicaInfo(replicaID UniqueID, kv kv.MetaKv) error {
+	key := fmt.Sprintf("%s/%d", replicaMetaPrefix, replicaID)
+	return kv.Remove(key)
+}
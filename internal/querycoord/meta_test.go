@@ -84,6 +84,7 @@ func TestMetaFunc(t *testing.T) {
 		collectionInfos:   map[UniqueID]*querypb.CollectionInfo{},
 		segmentInfos:      map[UniqueID]*querypb.SegmentInfo{},
 		queryChannelInfos: map[UniqueID]*querypb.QueryChannelInfo{},
+		replicas:          map[UniqueID]*querypb.ReplicaInfo{},
 	}
 
 	nodeID := int64(100)
@@ -289,6 +290,98 @@ func TestMetaFunc(t *testing.T) {
 	})
 }
 
+func TestMetaReplicaInfo(t *testing.T) {
+	refreshParams()
+	kv, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	meta := &MetaReplica{
+		client:            kv,
+		collectionInfos:   map[UniqueID]*querypb.CollectionInfo{},
+		segmentInfos:      map[UniqueID]*querypb.SegmentInfo{},
+		queryChannelInfos: map[UniqueID]*querypb.QueryChannelInfo{},
+		replicas:          map[UniqueID]*querypb.ReplicaInfo{},
+	}
+
+	replica1 := &querypb.ReplicaInfo{
+		ReplicaID:    1,
+		CollectionID: defaultCollectionID,
+		NodeIds:      []int64{100, 101},
+	}
+	replica2 := &querypb.ReplicaInfo{
+		ReplicaID:    2,
+		CollectionID: defaultCollectionID,
+		NodeIds:      []int64{102},
+	}
+
+	t.Run("Test SetReplicaInfo", func(t *testing.T) {
+		err := meta.setReplicaInfo(replica2)
+		assert.Nil(t, err)
+		err = meta.setReplicaInfo(replica1)
+		assert.Nil(t, err)
+
+		replicas := meta.getReplicasByCollectionID(defaultCollectionID)
+		assert.Equal(t, 2, len(replicas))
+		assert.Equal(t, int64(1), replicas[0].ReplicaID)
+		assert.Equal(t, int64(2), replicas[1].ReplicaID)
+	})
+
+	t.Run("Test GetReplica", func(t *testing.T) {
+		replica, err := meta.getReplicaByID(2)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{102}, replica.NodeIds)
+
+		replica, err = meta.getReplicaByNodeID(defaultCollectionID, 101)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), replica.ReplicaID)
+
+		_, err = meta.getReplicaByNodeID(defaultCollectionID, 103)
+		assert.NotNil(t, err)
+
+		_, err = meta.getReplicaByID(3)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Test SegmentNodes", func(t *testing.T) {
+		info := &querypb.SegmentInfo{
+			SegmentID: defaultSegmentID,
+			NodeID:    100,
+		}
+		replicaIDs, nodeIDs := getSegmentNodes(info)
+		assert.Equal(t, []UniqueID{0}, replicaIDs)
+		assert.Equal(t, []int64{100}, nodeIDs)
+
+		setSegmentNode(info, 1, 100)
+		setSegmentNode(info, 2, 102)
+		assert.True(t, isSegmentOnReplica(info, 1))
+		assert.True(t, isSegmentOnNode(info, 102))
+		assert.Equal(t, int64(100), info.NodeID)
+
+		assert.True(t, removeSegmentNode(info, 100))
+		assert.False(t, isSegmentOnReplica(info, 1))
+		assert.Equal(t, int64(102), info.NodeID)
+		assert.False(t, removeSegmentNode(info, 102))
+	})
+
+	t.Run("Test RemoveNodeFromReplicas", func(t *testing.T) {
+		replicas, err := meta.removeNodeFromReplicas(100)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(replicas))
+		assert.Equal(t, []int64{101}, replicas[0].NodeIds)
+
+		replica, err := meta.getReplicaByID(1)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{101}, replica.NodeIds)
+	})
+
+	t.Run("Test ReleaseCollection", func(t *testing.T) {
+		meta.addCollection(defaultCollectionID, genCollectionSchema(defaultCollectionID, false))
+		err := meta.releaseCollection(defaultCollectionID)
+		assert.Nil(t, err)
+		replicas := meta.getReplicasByCollectionID(defaultCollectionID)
+		assert.Equal(t, 0, len(replicas))
+	})
+}
+
 func TestReloadMetaFromKV(t *testing.T) {
 	refreshParams()
 	kv, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
@@ -298,6 +391,7 @@ func TestReloadMetaFromKV(t *testing.T) {
 		collectionInfos:   map[UniqueID]*querypb.CollectionInfo{},
 		segmentInfos:      map[UniqueID]*querypb.SegmentInfo{},
 		queryChannelInfos: map[UniqueID]*querypb.QueryChannelInfo{},
+		replicas:          map[UniqueID]*querypb.ReplicaInfo{},
 	}
 
 	kvs := make(map[string]string)
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
@@ -195,6 +196,7 @@ type rootCoordMock struct {
 	types.RootCoord
 	CollectionIDs []UniqueID
 	Col2partition map[UniqueID][]UniqueID
+	allocatedID   UniqueID
 	sync.RWMutex
 }
 
@@ -258,6 +260,22 @@ func (rc *rootCoordMock) ReleaseDQLMessageStream(ctx context.Context, in *proxyp
 	}, nil
 }
 
+func (rc *rootCoordMock) AllocID(ctx context.Context, req *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
+	rc.Lock()
+	defer rc.Unlock()
+
+	rc.allocatedID++
+	id := rc.allocatedID
+	rc.allocatedID += UniqueID(req.Count)
+	return &rootcoordpb.AllocIDResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		ID:    id,
+		Count: req.Count,
+	}, nil
+}
+
 func (rc *rootCoordMock) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
 	return nil, errors.New("describeSegment fail")
 }
@@ -58,6 +58,11 @@ type ParamTable struct {
 	MinioSecretAccessKey string
 	MinioUseSSLStr       bool
 	MinioBucketName      string
+
+	// --- Balance ---
+	AutoBalance                        bool
+	BalanceIntervalSeconds             int64
+	MemoryUsageMaxDifferencePercentage float64
 }
 
 var Params ParamTable
@@ -103,6 +108,11 @@ func (p *ParamTable) Init() {
 	p.initMinioSecretAccessKey()
 	p.initMinioUseSSLStr()
 	p.initMinioBucketName()
+
+	//--- Balance ---
+	p.initAutoBalance()
+	p.initBalanceIntervalSeconds()
+	p.initMemoryUsageMaxDifferencePercentage()
 }
 
 func (p *ParamTable) initQueryCoordAddress() {
@@ -238,6 +248,18 @@ func (p *ParamTable) initMinioBucketName() {
 	p.MinioBucketName = bucketName
 }
 
+func (p *ParamTable) initAutoBalance() {
+	p.AutoBalance = p.ParseBool("queryCoord.autoBalance", false)
+}
+
+func (p *ParamTable) initBalanceIntervalSeconds() {
+	p.BalanceIntervalSeconds = p.ParseInt64("queryCoord.balanceIntervalSeconds")
+}
+
+func (p *ParamTable) initMemoryUsageMaxDifferencePercentage() {
+	p.MemoryUsageMaxDifferencePercentage = p.ParseFloat("queryCoord.memoryUsageMaxDifferencePercentage") / 100
+}
+
 func (p *ParamTable) initLogCfg() {
 	p.InitLogCfg("querycoord", 0)
 }
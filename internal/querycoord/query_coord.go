@@ -13,6 +13,7 @@ package querycoord
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"path/filepath"
 	"strconv"
@@ -135,6 +136,14 @@ func (qc *QueryCoord) Start() error {
 	qc.loopWg.Add(1)
 	go qc.watchMetaLoop()
 
+	qc.loopWg.Add(1)
+	go qc.watchHandoffSegmentLoop()
+
+	if Params.AutoBalance {
+		qc.loopWg.Add(1)
+		go qc.loadBalanceSegmentLoop()
+	}
+
 	go qc.session.LivenessCheck(qc.loopCtx, qc.liveCh, func() {
 		qc.Stop()
 	})
@@ -309,6 +318,12 @@ func (qc *QueryCoord) watchMetaLoop() {
 				switch event.Type {
 				case mvccpb.PUT:
 					//TODO::
+					// keep the replica placements recorded by query coord
+					if oldInfo, err := qc.meta.getSegmentInfoByID(segmentID); err == nil && len(oldInfo.NodeIds) > 0 {
+						segmentInfo.NodeID = oldInfo.NodeID
+						segmentInfo.ReplicaIds = oldInfo.ReplicaIds
+						segmentInfo.NodeIds = oldInfo.NodeIds
+					}
 					qc.meta.setSegmentInfo(segmentID, segmentInfo)
 				case mvccpb.DELETE:
 					//TODO::
@@ -318,3 +333,215 @@ func (qc *QueryCoord) watchMetaLoop() {
 	}
 
 }
+
+func (qc *QueryCoord) watchHandoffSegmentLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Debug("query coordinator start watch handoff segment loop")
+
+	watchChan := qc.kvClient.WatchWithPrefix(handoffSegmentPrefix)
+
+	// segments flushed while query coordinator was offline
+	_, values, err := qc.kvClient.LoadWithPrefix(handoffSegmentPrefix)
+	if err != nil {
+		log.Error("watch handoff segment loop error when load handoff segments", zap.Error(err))
+	}
+	for _, value := range values {
+		qc.enqueueHandoffSegment([]byte(value))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case resp := <-watchChan:
+			for _, event := range resp.Events {
+				if event.Type == mvccpb.PUT {
+					qc.enqueueHandoffSegment(event.Kv.Value)
+				}
+			}
+		}
+	}
+}
+
+// enqueueHandoffSegment triggers a HandoffTask for a segment published by root coordinator,
+// the handoff event is removed once the task has been persisted by the scheduler
+func (qc *QueryCoord) enqueueHandoffSegment(value []byte) {
+	segmentInfo := &querypb.SegmentInfo{}
+	err := proto.Unmarshal(value, segmentInfo)
+	if err != nil {
+		log.Error("watch handoff segment loop error when unmarshal", zap.Error(err))
+		return
+	}
+
+	handoffReq := &querypb.HandoffSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_HandoffSegments,
+			SourceID: qc.session.ServerID,
+		},
+		SegmentInfos: []*querypb.SegmentInfo{segmentInfo},
+	}
+	handoffTask := &HandoffTask{
+		BaseTask: BaseTask{
+			ctx:              qc.loopCtx,
+			Condition:        NewTaskCondition(qc.loopCtx),
+			triggerCondition: querypb.TriggerCondition_handoff,
+		},
+		HandoffSegmentsRequest: handoffReq,
+		dataCoord:              qc.dataCoordClient,
+		cluster:                qc.cluster,
+		meta:                   qc.meta,
+	}
+	qc.scheduler.Enqueue([]task{handoffTask})
+	log.Debug("start a handoff task", zap.Int64("segmentID", segmentInfo.SegmentID))
+
+	key := fmt.Sprintf("%s/%d/%d/%d", handoffSegmentPrefix, segmentInfo.CollectionID, segmentInfo.PartitionID, segmentInfo.SegmentID)
+	err = qc.kvClient.Remove(key)
+	if err != nil {
+		log.Error("watch handoff segment loop error when remove handoff event", zap.String("key", key), zap.Error(err))
+	}
+}
+
+func (qc *QueryCoord) loadBalanceSegmentLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Debug("query coordinator start load balance segment loop")
+
+	timer := time.NewTicker(time.Duration(Params.BalanceIntervalSeconds) * time.Second)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			qc.balanceSegments(ctx)
+		}
+	}
+}
+
+// balanceSegments moves sealed segments from the query node with the highest memory usage rate
+// to the one with the lowest, when the difference exceeds MemoryUsageMaxDifferencePercentage
+func (qc *QueryCoord) balanceSegments(ctx context.Context) {
+	onlineNodes, err := qc.cluster.onlineNodes()
+	if err != nil || len(onlineNodes) < 2 {
+		return
+	}
+
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		log.Warn("loadBalanceSegmentLoop: construct metrics request failed", zap.Error(err))
+		return
+	}
+	memoryUsageRate := make(map[int64]float64)
+	for _, nodeMetrics := range qc.cluster.getMetrics(ctx, req) {
+		if _, ok := onlineNodes[nodeMetrics.nodeID]; !ok {
+			continue
+		}
+		if nodeMetrics.err != nil || nodeMetrics.resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			continue
+		}
+		infos := metricsinfo.QueryNodeInfos{}
+		err = metricsinfo.UnmarshalComponentInfos(nodeMetrics.resp.Response, &infos)
+		if err != nil || infos.HardwareInfos.Memory == 0 {
+			continue
+		}
+		memoryUsageRate[nodeMetrics.nodeID] = float64(infos.HardwareInfos.MemoryUsage) / float64(infos.HardwareInfos.Memory)
+	}
+	if len(memoryUsageRate) < 2 {
+		return
+	}
+
+	var sourceNodeID, dstNodeID int64
+	first := true
+	for nodeID, rate := range memoryUsageRate {
+		if first {
+			sourceNodeID, dstNodeID = nodeID, nodeID
+			first = false
+			continue
+		}
+		if rate > memoryUsageRate[sourceNodeID] {
+			sourceNodeID = nodeID
+		}
+		if rate < memoryUsageRate[dstNodeID] {
+			dstNodeID = nodeID
+		}
+	}
+	if memoryUsageRate[sourceNodeID]-memoryUsageRate[dstNodeID] <= Params.MemoryUsageMaxDifferencePercentage {
+		return
+	}
+
+	numSourceSegments, err := qc.cluster.getNumSegments(sourceNodeID)
+	if err != nil {
+		return
+	}
+	numDstSegments, err := qc.cluster.getNumSegments(dstNodeID)
+	if err != nil {
+		return
+	}
+	numSegmentsToMove := (numSourceSegments - numDstSegments) / 2
+	if numSegmentsToMove <= 0 {
+		return
+	}
+
+	sealedSegmentIDs := make([]UniqueID, 0, numSegmentsToMove)
+	for _, collectionInfo := range qc.meta.showCollections() {
+		for _, segmentInfo := range qc.meta.showSegmentInfos(collectionInfo.CollectionID, nil) {
+			if len(sealedSegmentIDs) >= numSegmentsToMove {
+				break
+			}
+			if segmentInfo.SegmentState != querypb.SegmentState_sealed {
+				continue
+			}
+			replicaIDs, nodeIDs := getSegmentNodes(segmentInfo)
+			for index, nodeID := range nodeIDs {
+				if nodeID == sourceNodeID && canServeReplica(qc.meta, segmentInfo.CollectionID, replicaIDs[index], dstNodeID) {
+					sealedSegmentIDs = append(sealedSegmentIDs, segmentInfo.SegmentID)
+					break
+				}
+			}
+		}
+	}
+	if len(sealedSegmentIDs) == 0 {
+		return
+	}
+
+	loadBalanceSegment := &querypb.LoadBalanceRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_LoadBalanceSegments,
+			SourceID: qc.session.ServerID,
+		},
+		SourceNodeIDs:    []int64{sourceNodeID},
+		DstNodeIDs:       []int64{dstNodeID},
+		BalanceReason:    querypb.TriggerCondition_loadBalance,
+		SealedSegmentIDs: sealedSegmentIDs,
+	}
+	loadBalanceTask := &LoadBalanceTask{
+		BaseTask: BaseTask{
+			ctx:              qc.loopCtx,
+			Condition:        NewTaskCondition(qc.loopCtx),
+			triggerCondition: querypb.TriggerCondition_loadBalance,
+		},
+		LoadBalanceRequest: loadBalanceSegment,
+		rootCoord:          qc.rootCoordClient,
+		dataCoord:          qc.dataCoordClient,
+		cluster:            qc.cluster,
+		meta:               qc.meta,
+	}
+	qc.scheduler.Enqueue([]task{loadBalanceTask})
+	log.Debug("start a loadBalance task",
+		zap.Int64("sourceNodeID", sourceNodeID),
+		zap.Int64("dstNodeID", dstNodeID),
+		zap.Int64s("segmentIDs", sealedSegmentIDs))
+
+	err = loadBalanceTask.WaitToFinish()
+	if err != nil {
+		log.Warn("loadBalanceSegmentLoop: load balance task failed", zap.Error(err))
+		return
+	}
+	qc.metricsCacheManager.InvalidateSystemInfoMetrics()
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -27,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
@@ -37,6 +39,7 @@ const (
 	activeTaskPrefix      = "queryCoord-activeTask"
 	taskInfoPrefix        = "queryCoord-taskInfo"
 	loadBalanceInfoPrefix = "queryCoord-loadBalanceInfo"
+	handoffSegmentPrefix  = "queryCoord-handoff"
 )
 
 type taskState int
@@ -128,7 +131,7 @@ func (bt *BaseTask) SetState(state taskState) {
 	bt.state = state
 }
 
-//************************grpcTask***************************//
+// ************************grpcTask***************************//
 type LoadCollectionTask struct {
 	BaseTask
 	*querypb.LoadCollectionRequest
@@ -215,6 +218,18 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 	}
 
 	log.Debug("loadCollectionTask: toLoadPartitionIDs", zap.Int64s("partitionIDs", toLoadPartitionIDs))
+	var replicas []*querypb.ReplicaInfo
+	if hasCollection {
+		replicas = lct.meta.getReplicasByCollectionID(collectionID)
+		err = checkReplicaNumber(collectionID, lct.ReplicaNumber, replicas)
+	} else {
+		replicas, err = createReplicas(ctx, collectionID, lct.ReplicaNumber, lct.meta, lct.cluster, lct.rootCoord)
+	}
+	if err != nil {
+		status.Reason = err.Error()
+		lct.result = status
+		return err
+	}
 	lct.meta.addCollection(collectionID, lct.Schema)
 	lct.meta.setLoadType(collectionID, querypb.LoadType_loadCollection)
 	for _, id := range toLoadPartitionIDs {
@@ -246,6 +261,7 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 				CollectionID: collectionID,
 				BinlogPaths:  segmentBingLog.FieldBinlogs,
 				NumOfRows:    segmentBingLog.NumOfRows,
+				Deltalogs:    segmentBingLog.Deltalogs,
 			}
 
 			msgBase := proto.Clone(lct.Base).(*commonpb.MsgBase)
@@ -302,7 +318,7 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 		}
 	}
 
-	err = assignInternalTask(ctx, collectionID, lct, lct.meta, lct.cluster, loadSegmentReqs, watchDmChannelReqs)
+	err = assignReplicaTasks(ctx, collectionID, lct, lct.meta, lct.cluster, replicas, loadSegmentReqs, watchDmChannelReqs)
 	if err != nil {
 		status.Reason = err.Error()
 		lct.result = status
@@ -556,6 +572,7 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 				CollectionID: collectionID,
 				BinlogPaths:  segmentBingLog.FieldBinlogs,
 				NumOfRows:    segmentBingLog.NumOfRows,
+				Deltalogs:    segmentBingLog.Deltalogs,
 			}
 
 			msgBase := proto.Clone(lpt.Base).(*commonpb.MsgBase)
@@ -586,7 +603,8 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 			log.Debug("LoadPartitionTask: set watchDmChannelsRequests", zap.Any("request", watchDmRequest), zap.Int64("collectionID", collectionID))
 		}
 	}
-	err := assignInternalTask(ctx, collectionID, lpt, lpt.meta, lpt.cluster, loadSegmentReqs, watchDmReqs)
+	replicas := lpt.meta.getReplicasByCollectionID(collectionID)
+	err := assignReplicaTasks(ctx, collectionID, lpt, lpt.meta, lpt.cluster, replicas, loadSegmentReqs, watchDmReqs)
 	if err != nil {
 		status.Reason = err.Error()
 		lpt.result = status
@@ -778,7 +796,7 @@ func (rpt *ReleasePartitionTask) PostExecute(context.Context) error {
 	return nil
 }
 
-//****************************internal task*******************************//
+// ****************************internal task*******************************//
 type LoadSegmentTask struct {
 	BaseTask
 	*querypb.LoadSegmentsRequest
@@ -839,6 +857,13 @@ func (lst *LoadSegmentTask) Execute(ctx context.Context) error {
 		return err
 	}
 
+	if lst.LoadCondition == querypb.TriggerCondition_loadBalance && lst.SourceNodeID != 0 {
+		lst.releaseSourceSegments(ctx)
+	}
+	if lst.LoadCondition == querypb.TriggerCondition_handoff {
+		lst.releaseCompactedSegments(ctx)
+	}
+
 	log.Debug("loadSegmentTask Execute done",
 		zap.Int64("taskID", lst.ID()))
 	return nil
@@ -849,6 +874,100 @@ func (lst *LoadSegmentTask) PostExecute(context.Context) error {
 	return nil
 }
 
+// releaseSourceSegments releases the balanced segments from the source node after they
+// have been loaded on the target node, so that there is no gap of serving these segments
+func (lst *LoadSegmentTask) releaseSourceSegments(ctx context.Context) {
+	segmentIDs := make([]UniqueID, 0)
+	segmentInfos := make([]*querypb.SegmentInfo, 0)
+	for _, info := range lst.Infos {
+		segmentIDs = append(segmentIDs, info.SegmentID)
+		segmentInfo, err := lst.meta.getSegmentInfoByID(info.SegmentID)
+		if err != nil {
+			segmentInfo = &querypb.SegmentInfo{
+				SegmentID:    info.SegmentID,
+				CollectionID: info.CollectionID,
+				PartitionID:  info.PartitionID,
+			}
+		}
+		setSegmentNode(segmentInfo, lst.ReplicaID, lst.NodeID)
+		segmentInfo.SegmentState = querypb.SegmentState_sealed
+		segmentInfos = append(segmentInfos, segmentInfo)
+	}
+
+	msgBase := proto.Clone(lst.Base).(*commonpb.MsgBase)
+	msgBase.MsgType = commonpb.MsgType_ReleaseSegments
+	releaseSegmentsReq := &querypb.ReleaseSegmentsRequest{
+		Base:         msgBase,
+		NodeID:       lst.SourceNodeID,
+		CollectionID: lst.Infos[0].CollectionID,
+		SegmentIDs:   segmentIDs,
+	}
+	err := lst.cluster.releaseSegments(ctx, lst.SourceNodeID, releaseSegmentsReq)
+	if err != nil {
+		log.Warn("LoadSegmentTask: release segments from source node failed",
+			zap.Int64("sourceNodeID", lst.SourceNodeID),
+			zap.Int64s("segmentIDs", segmentIDs),
+			zap.Error(err))
+	}
+
+	for _, info := range segmentInfos {
+		err = lst.meta.setSegmentInfo(info.SegmentID, info)
+		if err != nil {
+			log.Warn("LoadSegmentTask: update segment info failed", zap.Int64("segmentID", info.SegmentID), zap.Error(err))
+		}
+	}
+}
+
+// releaseCompactedSegments releases the segments merged into the handed off segments from the
+// replica, the loading node has dropped its copies together with the handoff
+func (lst *LoadSegmentTask) releaseCompactedSegments(ctx context.Context) {
+	for _, info := range lst.Infos {
+		for _, segmentID := range info.CompactionFrom {
+			segmentInfo, err := lst.meta.getSegmentInfoByID(segmentID)
+			if err != nil {
+				continue
+			}
+			replicaIDs, nodeIDs := getSegmentNodes(segmentInfo)
+			for index, nodeID := range nodeIDs {
+				if replicaIDs[index] != lst.ReplicaID {
+					continue
+				}
+				if nodeID != lst.NodeID {
+					msgBase := proto.Clone(lst.Base).(*commonpb.MsgBase)
+					msgBase.MsgType = commonpb.MsgType_ReleaseSegments
+					releaseSegmentsReq := &querypb.ReleaseSegmentsRequest{
+						Base:         msgBase,
+						NodeID:       nodeID,
+						CollectionID: info.CollectionID,
+						SegmentIDs:   []UniqueID{segmentID},
+					}
+					err = lst.cluster.releaseSegments(ctx, nodeID, releaseSegmentsReq)
+					if err != nil {
+						log.Warn("LoadSegmentTask: release compacted segment failed",
+							zap.Int64("nodeID", nodeID),
+							zap.Int64("segmentID", segmentID),
+							zap.Error(err))
+					}
+					continue
+				}
+				latestInfo, err := lst.meta.getSegmentInfoByID(segmentID)
+				if err != nil {
+					continue
+				}
+				latestInfo = proto.Clone(latestInfo).(*querypb.SegmentInfo)
+				if removeSegmentNode(latestInfo, nodeID) {
+					err = lst.meta.setSegmentInfo(segmentID, latestInfo)
+				} else {
+					err = lst.meta.deleteSegmentInfoByID(segmentID)
+				}
+				if err != nil {
+					log.Warn("LoadSegmentTask: update compacted segment info failed", zap.Int64("segmentID", segmentID), zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
 func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 	segmentIDs := make([]UniqueID, 0)
 	collectionID := lst.Infos[0].CollectionID
@@ -856,7 +975,14 @@ func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 	for _, info := range lst.Infos {
 		segmentIDs = append(segmentIDs, info.SegmentID)
 	}
-	segment2Nodes := shuffleSegmentsToQueryNode(segmentIDs, lst.cluster)
+	replicaNodeIDs, err := getReplicaNodeIDs(lst.meta, lst.ReplicaID)
+	if err != nil {
+		return nil, err
+	}
+	segment2Nodes, err := shuffleSegmentsToQueryNode(segmentIDs, lst.cluster, replicaNodeIDs)
+	if err != nil {
+		return nil, err
+	}
 	node2segmentInfos := make(map[int64][]*querypb.SegmentLoadInfo)
 	for index, info := range lst.Infos {
 		nodeID := segment2Nodes[index]
@@ -879,6 +1005,8 @@ func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 				Infos:         infos,
 				Schema:        lst.Schema,
 				LoadCondition: lst.LoadCondition,
+				SourceNodeID:  lst.SourceNodeID,
+				ReplicaID:     lst.ReplicaID,
 			},
 			meta:    lst.meta,
 			cluster: lst.cluster,
@@ -901,6 +1029,7 @@ func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 				CollectionID:     collectionID,
 				RequestChannelID: queryChannel,
 				ResultChannelID:  queryResultChannel,
+				ReplicaID:        lst.ReplicaID,
 			}
 			watchQueryChannelTask := &WatchQueryChannelTask{
 				BaseTask: BaseTask{
@@ -1068,7 +1197,14 @@ func (wdt *WatchDmChannelTask) Reschedule() ([]task, error) {
 		channelIDs = append(channelIDs, info.ChannelName)
 	}
 
-	channel2Nodes := shuffleChannelsToQueryNode(channelIDs, wdt.cluster)
+	replicaNodeIDs, err := getReplicaNodeIDs(wdt.meta, wdt.ReplicaID)
+	if err != nil {
+		return nil, err
+	}
+	channel2Nodes, err := shuffleChannelsToQueryNode(channelIDs, wdt.cluster, replicaNodeIDs)
+	if err != nil {
+		return nil, err
+	}
 	node2channelInfos := make(map[int64][]*datapb.VchannelInfo)
 	for index, info := range wdt.Infos {
 		nodeID := channel2Nodes[index]
@@ -1093,6 +1229,7 @@ func (wdt *WatchDmChannelTask) Reschedule() ([]task, error) {
 				Infos:        infos,
 				Schema:       wdt.Schema,
 				ExcludeInfos: wdt.ExcludeInfos,
+				ReplicaID:    wdt.ReplicaID,
 			},
 			meta:    wdt.meta,
 			cluster: wdt.cluster,
@@ -1115,6 +1252,7 @@ func (wdt *WatchDmChannelTask) Reschedule() ([]task, error) {
 				CollectionID:     collectionID,
 				RequestChannelID: queryChannel,
 				ResultChannelID:  queryResultChannel,
+				ReplicaID:        wdt.ReplicaID,
 			}
 			watchQueryChannelTask := &WatchQueryChannelTask{
 				BaseTask: BaseTask{
@@ -1208,11 +1346,174 @@ func (wqt *WatchQueryChannelTask) PostExecute(context.Context) error {
 	return nil
 }
 
-//****************************handoff task********************************//
+// ****************************handoff task********************************//
 type HandoffTask struct {
+	BaseTask
+	*querypb.HandoffSegmentsRequest
+	dataCoord types.DataCoord
+	cluster   Cluster
+	meta      Meta
+}
+
+func (ht *HandoffTask) MsgBase() *commonpb.MsgBase {
+	return ht.Base
+}
+
+func (ht *HandoffTask) Marshal() ([]byte, error) {
+	return proto.Marshal(ht.HandoffSegmentsRequest)
+}
+
+func (ht *HandoffTask) Type() commonpb.MsgType {
+	return ht.Base.MsgType
+}
+
+func (ht *HandoffTask) Timestamp() Timestamp {
+	return ht.Base.Timestamp
+}
+
+func (ht *HandoffTask) PreExecute(context.Context) error {
+	ht.result = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	segmentIDs := make([]UniqueID, 0)
+	for _, info := range ht.SegmentInfos {
+		segmentIDs = append(segmentIDs, info.SegmentID)
+	}
+	log.Debug("start do handoff segments task",
+		zap.Int64s("segmentIDs", segmentIDs))
+	return nil
+}
+
+func (ht *HandoffTask) Execute(ctx context.Context) error {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	for _, segmentInfo := range ht.SegmentInfos {
+		collectionID := segmentInfo.CollectionID
+		partitionID := segmentInfo.PartitionID
+		segmentID := segmentInfo.SegmentID
+
+		collectionInfo, err := ht.meta.getCollectionInfoByID(collectionID)
+		if err != nil {
+			log.Debug("handoffTask: collection has not been loaded into memory", zap.Int64("collectionID", collectionID), zap.Int64("segmentID", segmentID))
+			continue
+		}
+		if collectionInfo.LoadType == querypb.LoadType_LoadPartition && !ht.meta.hasPartition(collectionID, partitionID) {
+			log.Debug("handoffTask: partition has not been loaded into memory", zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID), zap.Int64("segmentID", segmentID))
+			continue
+		}
+		if collectionInfo.LoadType == querypb.LoadType_loadCollection && ht.meta.hasReleasePartition(collectionID, partitionID) {
+			log.Debug("handoffTask: partition has been released", zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID), zap.Int64("segmentID", segmentID))
+			continue
+		}
+		if ht.meta.hasSegmentInfo(segmentID) {
+			log.Debug("handoffTask: sealed segment has already been loaded", zap.Int64("segmentID", segmentID))
+			continue
+		}
+
+		// the growing segment lives on the query nodes which watch its dm channel, one per replica
+		nodeIDs := getDmChannelOwners(collectionInfo, segmentInfo.ChannelID)
+		if len(nodeIDs) == 0 {
+			log.Debug("handoffTask: dm channel has not been watched by any query node", zap.String("channel", segmentInfo.ChannelID), zap.Int64("segmentID", segmentID))
+			continue
+		}
+
+		getRecoveryInfo := &datapb.GetRecoveryInfoRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_HandoffSegments,
+			},
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+		}
+		recoveryInfo, err := ht.dataCoord.GetRecoveryInfo(ctx, getRecoveryInfo)
+		if err != nil {
+			status.Reason = err.Error()
+			ht.result = status
+			return err
+		}
+		if recoveryInfo.Status.ErrorCode != commonpb.ErrorCode_Success {
+			err = errors.New(recoveryInfo.Status.Reason)
+			status.Reason = err.Error()
+			ht.result = status
+			return err
+		}
+
+		var segmentLoadInfo *querypb.SegmentLoadInfo
+		for _, segmentBinlogs := range recoveryInfo.Binlogs {
+			if segmentBinlogs.SegmentID == segmentID {
+				segmentLoadInfo = &querypb.SegmentLoadInfo{
+					SegmentID:      segmentID,
+					PartitionID:    partitionID,
+					CollectionID:   collectionID,
+					BinlogPaths:    segmentBinlogs.FieldBinlogs,
+					NumOfRows:      segmentBinlogs.NumOfRows,
+					Deltalogs:      segmentBinlogs.Deltalogs,
+					CompactionFrom: segmentInfo.CompactionFrom,
+				}
+				break
+			}
+		}
+		if segmentLoadInfo == nil {
+			log.Warn("handoffTask: segment has not been flushed in data coord", zap.Int64("segmentID", segmentID))
+			continue
+		}
+
+		for _, nodeID := range nodeIDs {
+			var replicaID UniqueID
+			if replica, err := ht.meta.getReplicaByNodeID(collectionID, nodeID); err == nil {
+				replicaID = replica.ReplicaID
+			}
+			msgBase := proto.Clone(ht.Base).(*commonpb.MsgBase)
+			msgBase.MsgType = commonpb.MsgType_LoadSegments
+			loadSegmentTask := &LoadSegmentTask{
+				BaseTask: BaseTask{
+					ctx:              ht.ctx,
+					Condition:        NewTaskCondition(ht.ctx),
+					triggerCondition: querypb.TriggerCondition_handoff,
+				},
+				LoadSegmentsRequest: &querypb.LoadSegmentsRequest{
+					Base:          msgBase,
+					NodeID:        nodeID,
+					Infos:         []*querypb.SegmentLoadInfo{proto.Clone(segmentLoadInfo).(*querypb.SegmentLoadInfo)},
+					Schema:        collectionInfo.Schema,
+					LoadCondition: querypb.TriggerCondition_handoff,
+					ReplicaID:     replicaID,
+				},
+				meta:    ht.meta,
+				cluster: ht.cluster,
+			}
+			ht.AddChildTask(loadSegmentTask)
+			log.Debug("handoffTask: add a loadSegmentTask childTask", zap.Int64("segmentID", segmentID), zap.Int64("nodeID", nodeID), zap.Int64("replicaID", replicaID))
+		}
+	}
+
+	log.Debug("handoffTask Execute done",
+		zap.Int64("taskID", ht.ID()))
+	return nil
 }
 
-//*********************** ***load balance task*** ************************//
+func (ht *HandoffTask) PostExecute(context.Context) error {
+	log.Debug("handoffTask postExecute done",
+		zap.Int64("taskID", ht.ID()))
+	return nil
+}
+
+// getDmChannelOwners returns the query nodes which have watched the dm channel
+func getDmChannelOwners(collectionInfo *querypb.CollectionInfo, channel string) []int64 {
+	nodeIDs := make([]int64, 0)
+	for _, channelInfo := range collectionInfo.ChannelInfos {
+		for _, channelID := range channelInfo.ChannelIDs {
+			if channelID == channel {
+				nodeIDs = append(nodeIDs, channelInfo.NodeIDLoaded)
+				break
+			}
+		}
+	}
+	return nodeIDs
+}
+
+// *********************** ***load balance task*** ************************//
 type LoadBalanceTask struct {
 	BaseTask
 	*querypb.LoadBalanceRequest
@@ -1258,6 +1559,16 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 	if lbt.triggerCondition == querypb.TriggerCondition_nodeDown {
 		for _, nodeID := range lbt.SourceNodeIDs {
 			lbt.meta.deleteSegmentInfoByNodeID(nodeID)
+			updatedReplicas, err := lbt.meta.removeNodeFromReplicas(nodeID)
+			if err != nil {
+				status.Reason = err.Error()
+				lbt.result = status
+				return err
+			}
+			collection2Replica := make(map[UniqueID]*querypb.ReplicaInfo)
+			for _, replica := range updatedReplicas {
+				collection2Replica[replica.CollectionID] = replica
+			}
 			collectionInfos := lbt.cluster.getCollectionInfosByID(lbt.ctx, nodeID)
 			for _, info := range collectionInfos {
 				collectionID := info.CollectionID
@@ -1269,6 +1580,14 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 				loadType := metaInfo.LoadType
 				schema := metaInfo.Schema
 				partitionIDs := info.PartitionIDs
+				replica, hasReplica := collection2Replica[collectionID]
+				if hasReplica {
+					replica, err = lbt.prepareRecoveryReplica(replica)
+					if err != nil {
+						log.Warn("loadBalanceTask: no query node available for the replica", zap.Int64("collectionID", collectionID), zap.Error(err))
+						continue
+					}
+				}
 
 				segmentsToLoad := make([]UniqueID, 0)
 				loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0)
@@ -1299,12 +1618,19 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 
 					for _, segmentBingLog := range recoveryInfo.Binlogs {
 						segmentID := segmentBingLog.SegmentID
+						// only reload the segments lost by the down node, the others are still served
+						if segmentInfo, err := lbt.meta.getSegmentInfoByID(segmentID); err == nil {
+							if !hasReplica || isSegmentOnReplica(segmentInfo, replica.ReplicaID) {
+								continue
+							}
+						}
 						segmentLoadInfo := &querypb.SegmentLoadInfo{
 							SegmentID:    segmentID,
 							PartitionID:  partitionID,
 							CollectionID: collectionID,
 							BinlogPaths:  segmentBingLog.FieldBinlogs,
 							NumOfRows:    segmentBingLog.NumOfRows,
+							Deltalogs:    segmentBingLog.Deltalogs,
 						}
 
 						msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
@@ -1364,7 +1690,7 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 						}
 					}
 				}
-				err = assignInternalTask(ctx, collectionID, lbt, lbt.meta, lbt.cluster, loadSegmentReqs, watchDmChannelReqs)
+				err = assignInternalTask(ctx, collectionID, lbt, lbt.meta, lbt.cluster, loadSegmentReqs, watchDmChannelReqs, replica)
 				if err != nil {
 					status.Reason = err.Error()
 					lbt.result = status
@@ -1375,10 +1701,14 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 		}
 	}
 
-	//TODO::
-	//if lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
-	//	return nil
-	//}
+	if lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
+		err := lbt.balanceSegments(ctx)
+		if err != nil {
+			status.Reason = err.Error()
+			lbt.result = status
+			return err
+		}
+	}
 
 	log.Debug("LoadBalanceTask Execute done",
 		zap.Int64s("sourceNodeIDs", lbt.SourceNodeIDs),
@@ -1387,11 +1717,264 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 	return nil
 }
 
-func (lbt *LoadBalanceTask) PostExecute(context.Context) error {
-	for _, id := range lbt.SourceNodeIDs {
-		err := lbt.cluster.removeNodeInfo(id)
+// prepareRecoveryReplica makes sure the replica which lost a query node still has online query nodes,
+// a query node which doesn't belong to any replica of the collection is added to the replica if not
+func (lbt *LoadBalanceTask) prepareRecoveryReplica(replica *querypb.ReplicaInfo) (*querypb.ReplicaInfo, error) {
+	onlineNodes, err := lbt.cluster.onlineNodes()
+	if err != nil {
+		return nil, err
+	}
+	for _, nodeID := range replica.NodeIds {
+		if _, ok := onlineNodes[nodeID]; ok {
+			return replica, nil
+		}
+	}
+
+	freeNodeIDs := make([]int64, 0)
+	for nodeID := range onlineNodes {
+		if _, err := lbt.meta.getReplicaByNodeID(replica.CollectionID, nodeID); err != nil {
+			freeNodeIDs = append(freeNodeIDs, nodeID)
+		}
+	}
+	if len(freeNodeIDs) == 0 {
+		return nil, fmt.Errorf("replica %d has no online query node", replica.ReplicaID)
+	}
+	sort.Slice(freeNodeIDs, func(i, j int) bool {
+		return freeNodeIDs[i] < freeNodeIDs[j]
+	})
+	replica.NodeIds = append(replica.NodeIds, freeNodeIDs[0])
+	err = lbt.meta.setReplicaInfo(replica)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("loadBalanceTask: add query node to replica", zap.Int64("replicaID", replica.ReplicaID), zap.Int64("nodeID", freeNodeIDs[0]))
+	return replica, nil
+}
+
+// segmentPlacement is a copy of a sealed segment served by a query node
+type segmentPlacement struct {
+	segmentID UniqueID
+	replicaID UniqueID
+	nodeID    int64
+}
+
+// balanceSegments moves the sealed segments on the source nodes to the destination nodes,
+// each segment is loaded on the destination node first and then released from the source node
+func (lbt *LoadBalanceTask) balanceSegments(ctx context.Context) error {
+	if len(lbt.SourceNodeIDs) == 0 {
+		return errors.New("loadBalanceTask: source nodes are empty")
+	}
+	isSourceNode := make(map[int64]bool)
+	for _, nodeID := range lbt.SourceNodeIDs {
+		isSourceNode[nodeID] = true
+	}
+
+	dstNodeIDs := lbt.DstNodeIDs
+	if len(dstNodeIDs) == 0 {
+		onlineNodes, err := lbt.cluster.onlineNodes()
 		if err != nil {
-			log.Error("LoadBalanceTask: remove mode info error", zap.Int64("nodeID", id))
+			return err
+		}
+		for nodeID := range onlineNodes {
+			if !isSourceNode[nodeID] {
+				dstNodeIDs = append(dstNodeIDs, nodeID)
+			}
+		}
+	}
+	numSegments := make(map[int64]int)
+	for _, nodeID := range dstNodeIDs {
+		if isSourceNode[nodeID] {
+			return fmt.Errorf("loadBalanceTask: node %d is both source and destination", nodeID)
+		}
+		online, err := lbt.cluster.isOnline(nodeID)
+		if err != nil || !online {
+			return fmt.Errorf("loadBalanceTask: destination node %d is not online", nodeID)
+		}
+		numSegments[nodeID], _ = lbt.cluster.getNumSegments(nodeID)
+	}
+	if len(dstNodeIDs) == 0 {
+		return errors.New("loadBalanceTask: no available destination node")
+	}
+
+	// the sealed segments on the source nodes, grouped by collection and partition
+	segmentsToBalance := make(map[UniqueID]map[UniqueID][]*segmentPlacement)
+	balanced := make(map[UniqueID]bool)
+	for _, segmentID := range lbt.SealedSegmentIDs {
+		balanced[segmentID] = false
+	}
+	for _, collectionInfo := range lbt.meta.showCollections() {
+		for _, segmentInfo := range lbt.meta.showSegmentInfos(collectionInfo.CollectionID, nil) {
+			if segmentInfo.SegmentState != querypb.SegmentState_sealed {
+				continue
+			}
+			if len(lbt.SealedSegmentIDs) > 0 {
+				if _, ok := balanced[segmentInfo.SegmentID]; !ok {
+					continue
+				}
+			}
+			replicaIDs, nodeIDs := getSegmentNodes(segmentInfo)
+			for index, nodeID := range nodeIDs {
+				if !isSourceNode[nodeID] {
+					continue
+				}
+				if len(lbt.SealedSegmentIDs) > 0 {
+					balanced[segmentInfo.SegmentID] = true
+				}
+				collectionID := segmentInfo.CollectionID
+				if _, ok := segmentsToBalance[collectionID]; !ok {
+					segmentsToBalance[collectionID] = make(map[UniqueID][]*segmentPlacement)
+				}
+				segmentsToBalance[collectionID][segmentInfo.PartitionID] = append(segmentsToBalance[collectionID][segmentInfo.PartitionID], &segmentPlacement{
+					segmentID: segmentInfo.SegmentID,
+					replicaID: replicaIDs[index],
+					nodeID:    nodeID,
+				})
+			}
+		}
+	}
+	for segmentID, ok := range balanced {
+		if !ok {
+			return fmt.Errorf("loadBalanceTask: sealed segment %d is not found on source nodes", segmentID)
+		}
+	}
+
+	for collectionID, partitionSegments := range segmentsToBalance {
+		collectionInfo, err := lbt.meta.getCollectionInfoByID(collectionID)
+		if err != nil {
+			log.Warn("loadBalanceTask: getCollectionInfoByID occur error", zap.Int64("collectionID", collectionID), zap.Error(err))
+			continue
+		}
+		// destination node -> replica the node serves
+		dstNodes := make(map[int64]UniqueID)
+		for partitionID, placements := range partitionSegments {
+			getRecoveryInfo := &datapb.GetRecoveryInfoRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_LoadBalanceSegments,
+				},
+				CollectionID: collectionID,
+				PartitionID:  partitionID,
+			}
+			recoveryInfo, err := lbt.dataCoord.GetRecoveryInfo(ctx, getRecoveryInfo)
+			if err != nil {
+				return err
+			}
+			if recoveryInfo.Status.ErrorCode != commonpb.ErrorCode_Success {
+				return errors.New(recoveryInfo.Status.Reason)
+			}
+			segmentBinlogs := make(map[UniqueID]*datapb.SegmentBinlogs)
+			for _, binlogs := range recoveryInfo.Binlogs {
+				segmentBinlogs[binlogs.SegmentID] = binlogs
+			}
+
+			for _, placement := range placements {
+				binlogs, ok := segmentBinlogs[placement.segmentID]
+				if !ok {
+					log.Warn("loadBalanceTask: segment binlogs not found in data coord", zap.Int64("segmentID", placement.segmentID))
+					continue
+				}
+				// choose the destination node with the least segments which is able to serve the replica
+				dstNodeID := int64(-1)
+				for _, nodeID := range dstNodeIDs {
+					if !canServeReplica(lbt.meta, collectionID, placement.replicaID, nodeID) {
+						continue
+					}
+					if dstNodeID == -1 || numSegments[nodeID] < numSegments[dstNodeID] {
+						dstNodeID = nodeID
+					}
+				}
+				if dstNodeID == -1 {
+					log.Warn("loadBalanceTask: no destination node available for the replica",
+						zap.Int64("segmentID", placement.segmentID),
+						zap.Int64("replicaID", placement.replicaID))
+					continue
+				}
+				err = addNodeToReplica(lbt.meta, placement.replicaID, dstNodeID)
+				if err != nil {
+					return err
+				}
+				numSegments[dstNodeID]++
+				dstNodes[dstNodeID] = placement.replicaID
+
+				msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
+				msgBase.MsgType = commonpb.MsgType_LoadSegments
+				loadSegmentTask := &LoadSegmentTask{
+					BaseTask: BaseTask{
+						ctx:              lbt.ctx,
+						Condition:        NewTaskCondition(lbt.ctx),
+						triggerCondition: querypb.TriggerCondition_loadBalance,
+					},
+					LoadSegmentsRequest: &querypb.LoadSegmentsRequest{
+						Base:   msgBase,
+						NodeID: dstNodeID,
+						Infos: []*querypb.SegmentLoadInfo{
+							{
+								SegmentID:    placement.segmentID,
+								PartitionID:  partitionID,
+								CollectionID: collectionID,
+								BinlogPaths:  binlogs.FieldBinlogs,
+								NumOfRows:    binlogs.NumOfRows,
+								Deltalogs:    binlogs.Deltalogs,
+							},
+						},
+						Schema:        collectionInfo.Schema,
+						LoadCondition: querypb.TriggerCondition_loadBalance,
+						SourceNodeID:  placement.nodeID,
+						ReplicaID:     placement.replicaID,
+					},
+					meta:    lbt.meta,
+					cluster: lbt.cluster,
+				}
+				lbt.AddChildTask(loadSegmentTask)
+				log.Debug("loadBalanceTask: add a loadSegmentTask childTask",
+					zap.Int64("segmentID", placement.segmentID),
+					zap.Int64("replicaID", placement.replicaID),
+					zap.Int64("sourceNodeID", placement.nodeID),
+					zap.Int64("dstNodeID", dstNodeID))
+			}
+		}
+
+		// destination nodes must watch the query channel before serving the collection
+		for nodeID, replicaID := range dstNodes {
+			if lbt.cluster.hasWatchedQueryChannel(lbt.ctx, nodeID, collectionID) {
+				continue
+			}
+			queryChannel, queryResultChannel, err := lbt.meta.GetQueryChannel(collectionID)
+			if err != nil {
+				return err
+			}
+			msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
+			msgBase.MsgType = commonpb.MsgType_WatchQueryChannels
+			watchQueryChannelTask := &WatchQueryChannelTask{
+				BaseTask: BaseTask{
+					ctx:              lbt.ctx,
+					Condition:        NewTaskCondition(lbt.ctx),
+					triggerCondition: querypb.TriggerCondition_loadBalance,
+				},
+				AddQueryChannelRequest: &querypb.AddQueryChannelRequest{
+					Base:             msgBase,
+					NodeID:           nodeID,
+					CollectionID:     collectionID,
+					RequestChannelID: queryChannel,
+					ResultChannelID:  queryResultChannel,
+					ReplicaID:        replicaID,
+				},
+				cluster: lbt.cluster,
+			}
+			lbt.AddChildTask(watchQueryChannelTask)
+			log.Debug("loadBalanceTask: add a watchQueryChannelTask childTask", zap.Int64("collectionID", collectionID), zap.Int64("nodeID", nodeID))
+		}
+	}
+
+	return nil
+}
+
+func (lbt *LoadBalanceTask) PostExecute(context.Context) error {
+	if lbt.triggerCondition == querypb.TriggerCondition_nodeDown {
+		for _, id := range lbt.SourceNodeIDs {
+			err := lbt.cluster.removeNodeInfo(id)
+			if err != nil {
+				log.Error("LoadBalanceTask: remove mode info error", zap.Int64("nodeID", id))
+			}
 		}
 	}
 	log.Debug("LoadBalanceTask postExecute done",
@@ -1401,7 +1984,9 @@ func (lbt *LoadBalanceTask) PostExecute(context.Context) error {
 	return nil
 }
 
-func shuffleChannelsToQueryNode(dmChannels []string, cluster Cluster) []int64 {
+// shuffleChannelsToQueryNode assigns query nodes to the dm channels, only the nodes in includeNodeIDs
+// are chosen if it's not empty
+func shuffleChannelsToQueryNode(dmChannels []string, cluster Cluster, includeNodeIDs []int64) ([]int64, error) {
 	maxNumChannels := 0
 	nodes := make(map[int64]Node)
 	var err error
@@ -1414,6 +1999,10 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster Cluster) []int64 {
 		}
 		break
 	}
+	nodes, err = filterQueryNodes(nodes, includeNodeIDs)
+	if err != nil {
+		return nil, err
+	}
 
 	for nodeID := range nodes {
 		numChannels, _ := cluster.getNumDmChannels(nodeID)
@@ -1423,7 +2012,7 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster Cluster) []int64 {
 	}
 	res := make([]int64, 0)
 	if len(dmChannels) == 0 {
-		return res
+		return res, nil
 	}
 
 	offset := 0
@@ -1439,7 +2028,7 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster Cluster) []int64 {
 				res = append(res, nodeID)
 				offset++
 				if offset == len(dmChannels) {
-					return res
+					return res, nil
 				}
 			}
 		} else {
@@ -1447,7 +2036,7 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster Cluster) []int64 {
 				res = append(res, nodeID)
 				offset++
 				if offset == len(dmChannels) {
-					return res
+					return res, nil
 				}
 			}
 		}
@@ -1457,7 +2046,9 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster Cluster) []int64 {
 	}
 }
 
-func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster Cluster) []int64 {
+// shuffleSegmentsToQueryNode assigns query nodes to the segments, only the nodes in includeNodeIDs
+// are chosen if it's not empty
+func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster Cluster, includeNodeIDs []int64) ([]int64, error) {
 	maxNumSegments := 0
 	nodes := make(map[int64]Node)
 	var err error
@@ -1470,6 +2061,11 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster Cluster) []int64
 		}
 		break
 	}
+	nodes, err = filterQueryNodes(nodes, includeNodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	for nodeID := range nodes {
 		numSegments, _ := cluster.getNumSegments(nodeID)
 		if numSegments > maxNumSegments {
@@ -1479,7 +2075,7 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster Cluster) []int64
 	res := make([]int64, 0)
 
 	if len(segmentIDs) == 0 {
-		return res
+		return res, nil
 	}
 
 	offset := 0
@@ -1495,7 +2091,7 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster Cluster) []int64
 				res = append(res, nodeID)
 				offset++
 				if offset == len(segmentIDs) {
-					return res
+					return res, nil
 				}
 			}
 		} else {
@@ -1503,7 +2099,7 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster Cluster) []int64
 				res = append(res, nodeID)
 				offset++
 				if offset == len(segmentIDs) {
-					return res
+					return res, nil
 				}
 			}
 		}
@@ -1513,6 +2109,170 @@ func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster Cluster) []int64
 	}
 }
 
+func filterQueryNodes(nodes map[int64]Node, includeNodeIDs []int64) (map[int64]Node, error) {
+	if len(includeNodeIDs) == 0 {
+		return nodes, nil
+	}
+	filtered := make(map[int64]Node)
+	for _, nodeID := range includeNodeIDs {
+		if node, ok := nodes[nodeID]; ok {
+			filtered[nodeID] = node
+		}
+	}
+	if len(filtered) == 0 {
+		return nil, fmt.Errorf("no online query node in %v", includeNodeIDs)
+	}
+	return filtered, nil
+}
+
+// getReplicaNodeIDs returns the query nodes of the replica, nil means all query nodes are available
+func getReplicaNodeIDs(meta Meta, replicaID UniqueID) ([]int64, error) {
+	if replicaID == 0 {
+		return nil, nil
+	}
+	replica, err := meta.getReplicaByID(replicaID)
+	if err != nil {
+		return nil, err
+	}
+	return replica.NodeIds, nil
+}
+
+// canServeReplica checks whether the query node is able to hold segments of the replica,
+// a node may join a replica if it doesn't belong to any other replica of the collection
+func canServeReplica(meta Meta, collectionID UniqueID, replicaID UniqueID, nodeID int64) bool {
+	if replicaID == 0 {
+		return true
+	}
+	replica, err := meta.getReplicaByNodeID(collectionID, nodeID)
+	if err != nil {
+		return true
+	}
+	return replica.ReplicaID == replicaID
+}
+
+// addNodeToReplica adds the query node to the replica if it's not a member yet
+func addNodeToReplica(meta Meta, replicaID UniqueID, nodeID int64) error {
+	if replicaID == 0 {
+		return nil
+	}
+	replica, err := meta.getReplicaByID(replicaID)
+	if err != nil {
+		return err
+	}
+	for _, id := range replica.NodeIds {
+		if id == nodeID {
+			return nil
+		}
+	}
+	replica.NodeIds = append(replica.NodeIds, nodeID)
+	return meta.setReplicaInfo(replica)
+}
+
+// createReplicas divides the online query nodes into replicaNumber groups, each group holds a full copy
+// of the collection. No replica is created if replicaNumber is less than 2, all query nodes are used then
+func createReplicas(ctx context.Context, collectionID UniqueID, replicaNumber int32, meta Meta, cluster Cluster, rootCoord types.RootCoord) ([]*querypb.ReplicaInfo, error) {
+	if replicaNumber <= 1 {
+		return nil, nil
+	}
+	onlineNodes, err := cluster.onlineNodes()
+	if err != nil {
+		return nil, err
+	}
+	if len(onlineNodes) < int(replicaNumber) {
+		return nil, fmt.Errorf("no enough query nodes to load %d replicas, only %d query nodes are online", replicaNumber, len(onlineNodes))
+	}
+	nodeIDs := make([]int64, 0, len(onlineNodes))
+	for nodeID := range onlineNodes {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool {
+		return nodeIDs[i] < nodeIDs[j]
+	})
+
+	resp, err := rootCoord.AllocID(ctx, &rootcoordpb.AllocIDRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_RequestID,
+		},
+		Count: uint32(replicaNumber),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+
+	replicas := make([]*querypb.ReplicaInfo, 0, replicaNumber)
+	for i := int32(0); i < replicaNumber; i++ {
+		replicas = append(replicas, &querypb.ReplicaInfo{
+			ReplicaID:    resp.ID + int64(i),
+			CollectionID: collectionID,
+		})
+	}
+	for index, nodeID := range nodeIDs {
+		replica := replicas[index%int(replicaNumber)]
+		replica.NodeIds = append(replica.NodeIds, nodeID)
+	}
+	for _, replica := range replicas {
+		err = meta.setReplicaInfo(replica)
+		if err != nil {
+			return nil, err
+		}
+		log.Debug("create a replica", zap.Int64("collectionID", collectionID), zap.Int64("replicaID", replica.ReplicaID), zap.Int64s("nodeIDs", replica.NodeIds))
+	}
+	return replicas, nil
+}
+
+// checkReplicaNumber checks whether the requested replica number matches the replicas of a loaded collection
+func checkReplicaNumber(collectionID UniqueID, replicaNumber int32, replicas []*querypb.ReplicaInfo) error {
+	if replicaNumber <= 0 {
+		return nil
+	}
+	expected := int(replicaNumber)
+	if expected == 1 {
+		expected = 0
+	}
+	if len(replicas) != expected {
+		loadedNumber := len(replicas)
+		if loadedNumber == 0 {
+			loadedNumber = 1
+		}
+		return fmt.Errorf("collection %d has been loaded with %d replicas, request replica number %d", collectionID, loadedNumber, replicaNumber)
+	}
+	return nil
+}
+
+// assignReplicaTasks assigns the load requests to every replica of the collection,
+// the requests are assigned to all query nodes if the collection has no replica
+func assignReplicaTasks(ctx context.Context,
+	collectionID UniqueID,
+	parentTask task,
+	meta Meta,
+	cluster Cluster,
+	replicas []*querypb.ReplicaInfo,
+	loadSegmentRequests []*querypb.LoadSegmentsRequest,
+	watchDmChannelRequests []*querypb.WatchDmChannelsRequest) error {
+	if len(replicas) == 0 {
+		return assignInternalTask(ctx, collectionID, parentTask, meta, cluster, loadSegmentRequests, watchDmChannelRequests, nil)
+	}
+
+	for _, replica := range replicas {
+		replicaLoadSegmentRequests := make([]*querypb.LoadSegmentsRequest, 0, len(loadSegmentRequests))
+		for _, req := range loadSegmentRequests {
+			replicaLoadSegmentRequests = append(replicaLoadSegmentRequests, proto.Clone(req).(*querypb.LoadSegmentsRequest))
+		}
+		replicaWatchDmChannelRequests := make([]*querypb.WatchDmChannelsRequest, 0, len(watchDmChannelRequests))
+		for _, req := range watchDmChannelRequests {
+			replicaWatchDmChannelRequests = append(replicaWatchDmChannelRequests, proto.Clone(req).(*querypb.WatchDmChannelsRequest))
+		}
+		err := assignInternalTask(ctx, collectionID, parentTask, meta, cluster, replicaLoadSegmentRequests, replicaWatchDmChannelRequests, replica)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func mergeVChannelInfo(info1 *datapb.VchannelInfo, info2 *datapb.VchannelInfo) *datapb.VchannelInfo {
 	collectionID := info1.CollectionID
 	channelName := info1.ChannelName
@@ -1550,21 +2310,36 @@ func assignInternalTask(ctx context.Context,
 	meta Meta,
 	cluster Cluster,
 	loadSegmentRequests []*querypb.LoadSegmentsRequest,
-	watchDmChannelRequests []*querypb.WatchDmChannelsRequest) error {
+	watchDmChannelRequests []*querypb.WatchDmChannelsRequest,
+	replica *querypb.ReplicaInfo) error {
 
 	sp, _ := trace.StartSpanFromContext(ctx)
 	defer sp.Finish()
+	var replicaID UniqueID
+	var replicaNodeIDs []int64
+	if replica != nil {
+		replicaID = replica.ReplicaID
+		replicaNodeIDs = replica.NodeIds
+	}
 	segmentsToLoad := make([]UniqueID, 0)
 	for _, req := range loadSegmentRequests {
+		req.ReplicaID = replicaID
 		segmentsToLoad = append(segmentsToLoad, req.Infos[0].SegmentID)
 	}
 	channelsToWatch := make([]string, 0)
 	for _, req := range watchDmChannelRequests {
+		req.ReplicaID = replicaID
 		channelsToWatch = append(channelsToWatch, req.Infos[0].ChannelName)
 	}
-	segment2Nodes := shuffleSegmentsToQueryNode(segmentsToLoad, cluster)
-	watchRequest2Nodes := shuffleChannelsToQueryNode(channelsToWatch, cluster)
-	log.Debug("assignInternalTask: segment to node", zap.Any("segments map", segment2Nodes), zap.Int64("collectionID", collectionID))
+	segment2Nodes, err := shuffleSegmentsToQueryNode(segmentsToLoad, cluster, replicaNodeIDs)
+	if err != nil {
+		return err
+	}
+	watchRequest2Nodes, err := shuffleChannelsToQueryNode(channelsToWatch, cluster, replicaNodeIDs)
+	if err != nil {
+		return err
+	}
+	log.Debug("assignInternalTask: segment to node", zap.Any("segments map", segment2Nodes), zap.Int64("collectionID", collectionID), zap.Int64("replicaID", replicaID))
 	log.Debug("assignInternalTask: watch request to node", zap.Any("request map", watchRequest2Nodes), zap.Int64("collectionID", collectionID))
 
 	watchQueryChannelInfo := make(map[int64]bool)
@@ -1641,6 +2416,7 @@ func assignInternalTask(ctx context.Context,
 				CollectionID:     collectionID,
 				RequestChannelID: queryChannel,
 				ResultChannelID:  queryResultChannel,
+				ReplicaID:        replicaID,
 			}
 			watchQueryChannelTask := &WatchQueryChannelTask{
 				BaseTask: BaseTask{
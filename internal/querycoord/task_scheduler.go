@@ -335,7 +335,7 @@ func (scheduler *TaskScheduler) unmarshalTask(t string) (task, error) {
 			BaseTask: BaseTask{
 				ctx:              scheduler.ctx,
 				Condition:        NewTaskCondition(scheduler.ctx),
-				triggerCondition: querypb.TriggerCondition_grpcRequest,
+				triggerCondition: loadReq.LoadCondition,
 			},
 			LoadSegmentsRequest: &loadReq,
 			cluster:             scheduler.cluster,
@@ -410,6 +410,24 @@ func (scheduler *TaskScheduler) unmarshalTask(t string) (task, error) {
 			meta:               scheduler.meta,
 		}
 		newTask = loadBalanceTask
+	case commonpb.MsgType_HandoffSegments:
+		handoffReq := querypb.HandoffSegmentsRequest{}
+		err = proto.Unmarshal([]byte(t), &handoffReq)
+		if err != nil {
+			log.Error(err.Error())
+		}
+		handoffTask := &HandoffTask{
+			BaseTask: BaseTask{
+				ctx:              scheduler.ctx,
+				Condition:        NewTaskCondition(scheduler.ctx),
+				triggerCondition: querypb.TriggerCondition_handoff,
+			},
+			HandoffSegmentsRequest: &handoffReq,
+			dataCoord:              scheduler.dataCoord,
+			cluster:                scheduler.cluster,
+			meta:                   scheduler.meta,
+		}
+		newTask = handoffTask
 	default:
 		err = errors.New("inValid msg type when unMarshal task")
 		log.Error(err.Error())
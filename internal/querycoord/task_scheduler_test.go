@@ -360,6 +360,28 @@ func TestUnMarshalTask(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, task.Type(), commonpb.MsgType_LoadBalanceSegments)
 	})
+
+	t.Run("Test HandoffTask", func(t *testing.T) {
+		handoffTask := &HandoffTask{
+			HandoffSegmentsRequest: &querypb.HandoffSegmentsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_HandoffSegments,
+				},
+			},
+		}
+
+		blobs, err := handoffTask.Marshal()
+		assert.Nil(t, err)
+		err = kv.Save("testMarshalHandoffTask", string(blobs))
+		assert.Nil(t, err)
+		defer kv.RemoveWithPrefix("testMarshalHandoffTask")
+		value, err := kv.Load("testMarshalHandoffTask")
+		assert.Nil(t, err)
+
+		task, err := taskScheduler.unmarshalTask(value)
+		assert.Nil(t, err)
+		assert.Equal(t, task.Type(), commonpb.MsgType_HandoffSegments)
+	})
 }
 
 func TestReloadTaskFromKV(t *testing.T) {
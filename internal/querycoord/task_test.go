@@ -54,6 +54,55 @@ func TestTriggerTask(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test Handoff", func(t *testing.T) {
+		dataCoord := queryCoord.dataCoordClient.(*dataCoordMock)
+		segmentID := dataCoord.partitionID2Segment[defaultPartitionID]
+		channel := "handoff-test-channel"
+		err = queryCoord.meta.addDmChannel(defaultCollectionID, node.queryNodeID, []string{channel})
+		assert.Nil(t, err)
+
+		req := &querypb.HandoffSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_HandoffSegments,
+			},
+			SegmentInfos: []*querypb.SegmentInfo{
+				{
+					SegmentID:    segmentID,
+					CollectionID: defaultCollectionID,
+					PartitionID:  defaultPartitionID,
+					ChannelID:    channel,
+					SegmentState: querypb.SegmentState_sealed,
+				},
+				{
+					SegmentID:    segmentID + 1,
+					CollectionID: defaultCollectionID + 1,
+					PartitionID:  defaultPartitionID,
+					ChannelID:    channel,
+					SegmentState: querypb.SegmentState_sealed,
+				},
+			},
+		}
+		handoffTask := &HandoffTask{
+			BaseTask: BaseTask{
+				ctx:              ctx,
+				Condition:        NewTaskCondition(ctx),
+				triggerCondition: querypb.TriggerCondition_handoff,
+			},
+			HandoffSegmentsRequest: req,
+			dataCoord:              queryCoord.dataCoordClient,
+			cluster:                queryCoord.cluster,
+			meta:                   queryCoord.meta,
+		}
+
+		err = handoffTask.Execute(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(handoffTask.GetChildTask()))
+		loadSegmentTask := handoffTask.GetChildTask()[0].(*LoadSegmentTask)
+		assert.Equal(t, querypb.TriggerCondition_handoff, loadSegmentTask.LoadCondition)
+		assert.Equal(t, node.queryNodeID, loadSegmentTask.NodeID)
+		assert.Equal(t, segmentID, loadSegmentTask.Infos[0].SegmentID)
+	})
+
 	t.Run("Test ReleaseCollection", func(t *testing.T) {
 		req := &querypb.ReleaseCollectionRequest{
 			Base: &commonpb.MsgBase{
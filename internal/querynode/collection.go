@@ -40,6 +40,7 @@ type Collection struct {
 	collectionPtr C.CCollection
 	id            UniqueID
 	partitionIDs  []UniqueID
+	schemaMu      sync.RWMutex // guards schema
 	schema        *schemapb.CollectionSchema
 	vChannels     []Channel
 	pChannels     []Channel
@@ -57,9 +58,38 @@ func (c *Collection) ID() UniqueID {
 }
 
 func (c *Collection) Schema() *schemapb.CollectionSchema {
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
 	return c.schema
 }
 
+// addField appends the field to the schema of collection and segcore, it returns false if the
+// schema already has the field. The segments have to be switched to the new schema by updateSchema.
+func (c *Collection) addField(field *schemapb.FieldSchema) (bool, error) {
+	c.schemaMu.Lock()
+	defer c.schemaMu.Unlock()
+	for _, f := range c.schema.Fields {
+		if f.FieldID == field.FieldID {
+			return false, nil
+		}
+	}
+
+	schema := proto.Clone(c.schema).(*schemapb.CollectionSchema)
+	schema.Fields = append(schema.Fields, field)
+	/*
+		CStatus
+		UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob);
+	*/
+	cSchemaBlob := C.CString(proto.MarshalTextString(schema))
+	defer C.free(unsafe.Pointer(cSchemaBlob))
+	status := C.UpdateCollectionSchema(c.collectionPtr, cSchemaBlob)
+	if err := HandleCStatus(&status, "UpdateCollectionSchema failed"); err != nil {
+		return false, err
+	}
+	c.schema = schema
+	return true, nil
+}
+
 func (c *Collection) addPartitionID(partitionID UniqueID) {
 	c.releaseMu.Lock()
 	defer c.releaseMu.Unlock()
@@ -55,6 +55,7 @@ type ReplicaInterface interface {
 	getCollectionNum() int
 	getPartitionIDs(collectionID UniqueID) ([]UniqueID, error)
 	getVecFieldIDsByCollectionID(collectionID UniqueID) ([]FieldID, error)
+	addField(collectionID UniqueID, field *schemapb.FieldSchema) error
 
 	// partition
 	addPartition(collectionID UniqueID, partitionID UniqueID) error
@@ -243,6 +244,31 @@ func (colReplica *collectionReplica) getFieldsByCollectionIDPrivate(collectionID
 	return collection.Schema().Fields, nil
 }
 
+// addField appends the field to the schema of collection, and switches the loaded segments to
+// the new schema, it does nothing if the collection already has the field
+func (colReplica *collectionReplica) addField(collectionID UniqueID, field *schemapb.FieldSchema) error {
+	colReplica.mu.Lock()
+	defer colReplica.mu.Unlock()
+	collection, err := colReplica.getCollectionByIDPrivate(collectionID)
+	if err != nil {
+		return err
+	}
+	added, err := collection.addField(field)
+	if err != nil || !added {
+		return err
+	}
+	for _, segment := range colReplica.segments {
+		if segment.collectionID != collectionID {
+			continue
+		}
+		if err := segment.updateSchema(collection); err != nil {
+			return err
+		}
+	}
+	log.Debug("add field to collection", zap.Int64("collectionID", collectionID), zap.String("field", field.Name))
+	return nil
+}
+
 //----------------------------------------------------------------------------------------------------- partition
 func (colReplica *collectionReplica) addPartition(collectionID UniqueID, partitionID UniqueID) error {
 	colReplica.mu.Lock()
@@ -388,10 +414,14 @@ func (colReplica *collectionReplica) addSegmentPrivate(segmentID UniqueID, parti
 func (colReplica *collectionReplica) setSegment(segment *Segment) error {
 	colReplica.mu.Lock()
 	defer colReplica.mu.Unlock()
-	_, err := colReplica.getCollectionByIDPrivate(segment.collectionID)
+	collection, err := colReplica.getCollectionByIDPrivate(segment.collectionID)
 	if err != nil {
 		return err
 	}
+	// the segment may be loaded before a field is added to the collection
+	if err = segment.updateSchema(collection); err != nil {
+		return err
+	}
 	return colReplica.addSegmentPrivate(segment.segmentID, segment.partitionID, segment)
 }
 
@@ -38,9 +38,10 @@ type dataSyncService struct {
 	collectionFlowGraphs map[UniqueID]map[Channel]*queryNodeFlowGraph // map[collectionID]flowGraphs
 	partitionFlowGraphs  map[UniqueID]map[Channel]*queryNodeFlowGraph // map[partitionID]flowGraphs
 
-	streamingReplica ReplicaInterface
-	tSafeReplica     TSafeReplicaInterface
-	msFactory        msgstream.Factory
+	streamingReplica  ReplicaInterface
+	historicalReplica ReplicaInterface
+	tSafeReplica      TSafeReplicaInterface
+	msFactory         msgstream.Factory
 }
 
 // collection flow graph
@@ -60,6 +61,7 @@ func (dsService *dataSyncService) addCollectionFlowGraph(collectionID UniqueID,
 			collectionID,
 			partitionID,
 			dsService.streamingReplica,
+			dsService.historicalReplica,
 			dsService.tSafeReplica,
 			vChannel,
 			dsService.msFactory)
@@ -133,6 +135,7 @@ func (dsService *dataSyncService) addPartitionFlowGraph(collectionID UniqueID, p
 			collectionID,
 			partitionID,
 			dsService.streamingReplica,
+			dsService.historicalReplica,
 			dsService.tSafeReplica,
 			vChannel,
 			dsService.msFactory)
@@ -197,6 +200,7 @@ func (dsService *dataSyncService) removePartitionFlowGraph(partitionID UniqueID)
 
 func newDataSyncService(ctx context.Context,
 	streamingReplica ReplicaInterface,
+	historicalReplica ReplicaInterface,
 	tSafeReplica TSafeReplicaInterface,
 	factory msgstream.Factory) *dataSyncService {
 
@@ -205,6 +209,7 @@ func newDataSyncService(ctx context.Context,
 		collectionFlowGraphs: make(map[UniqueID]map[Channel]*queryNodeFlowGraph),
 		partitionFlowGraphs:  make(map[UniqueID]map[Channel]*queryNodeFlowGraph),
 		streamingReplica:     streamingReplica,
+		historicalReplica:    historicalReplica,
 		tSafeReplica:         tSafeReplica,
 		msFactory:            factory,
 	}
@@ -26,10 +26,11 @@ import (
 
 type filterDmNode struct {
 	baseNode
-	loadType     loadType // load collection or load partition
-	collectionID UniqueID
-	partitionID  UniqueID
-	replica      ReplicaInterface
+	loadType          loadType // load collection or load partition
+	collectionID      UniqueID
+	partitionID       UniqueID
+	replica           ReplicaInterface
+	historicalReplica ReplicaInterface
 }
 
 func (fdmNode *filterDmNode) Name() string {
@@ -63,6 +64,7 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	var iMsg = insertMsg{
 		insertMessages: make([]*msgstream.InsertMsg, 0),
+		deleteMessages: make([]*msgstream.DeleteMsg, 0),
 		timeRange: TimeRange{
 			timestampMin: msgStreamMsg.TimestampMin(),
 			timestampMax: msgStreamMsg.TimestampMax(),
@@ -75,6 +77,13 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			if resMsg != nil {
 				iMsg.insertMessages = append(iMsg.insertMessages, resMsg)
 			}
+		case commonpb.MsgType_Delete:
+			resMsg := fdmNode.filterInvalidDeleteMessage(msg.(*msgstream.DeleteMsg))
+			if resMsg != nil {
+				iMsg.deleteMessages = append(iMsg.deleteMessages, resMsg)
+			}
+		case commonpb.MsgType_AddField:
+			fdmNode.handleAddFieldMessage(msg.(*msgstream.AddFieldMsg))
 		default:
 			log.Warn("Non supporting", zap.Int32("message type", int32(msg.Type())))
 		}
@@ -87,6 +96,25 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	return []Msg{res}
 }
 
+// handleAddFieldMessage appends the added field to the loaded schema of both replicas,
+// the existing rows read the default value of the field
+func (fdmNode *filterDmNode) handleAddFieldMessage(msg *msgstream.AddFieldMsg) {
+	if msg.CollectionID != fdmNode.collectionID {
+		return
+	}
+	for _, replica := range []ReplicaInterface{fdmNode.replica, fdmNode.historicalReplica} {
+		if replica == nil || !replica.hasCollection(msg.CollectionID) {
+			continue
+		}
+		if err := replica.addField(msg.CollectionID, msg.GetField()); err != nil {
+			log.Warn("failed to add field to the loaded collection",
+				zap.Int64("collectionID", msg.CollectionID),
+				zap.String("field", msg.GetField().GetName()),
+				zap.Error(err))
+		}
+	}
+}
+
 func (fdmNode *filterDmNode) filterInvalidInsertMessage(msg *msgstream.InsertMsg) *msgstream.InsertMsg {
 	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	msg.SetTraceCtx(ctx)
@@ -170,7 +198,45 @@ func (fdmNode *filterDmNode) filterInvalidInsertMessage(msg *msgstream.InsertMsg
 	return msg
 }
 
-func newFilteredDmNode(replica ReplicaInterface,
+func (fdmNode *filterDmNode) filterInvalidDeleteMessage(msg *msgstream.DeleteMsg) *msgstream.DeleteMsg {
+	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+	msg.SetTraceCtx(ctx)
+	defer sp.Finish()
+
+	// check if the collection from message is target collection
+	if msg.CollectionID != fdmNode.collectionID {
+		return nil
+	}
+
+	// check if collection exists
+	if !fdmNode.replica.hasCollection(msg.CollectionID) {
+		log.Debug("filter invalid delete message, collection dose not exist",
+			zap.Any("collectionID", msg.CollectionID),
+			zap.Any("partitionID", msg.PartitionID))
+		return nil
+	}
+
+	// partitionID 0 means the delete applies to all partitions of the collection,
+	// otherwise, the flow graph of partition only accepts deletes of the target partition
+	if fdmNode.loadType == loadTypePartition && msg.PartitionID != 0 && msg.PartitionID != fdmNode.partitionID {
+		log.Debug("filter invalid delete message, partition is not the target partition",
+			zap.Any("collectionID", msg.CollectionID),
+			zap.Any("partitionID", msg.PartitionID))
+		return nil
+	}
+
+	if len(msg.PrimaryKeys) <= 0 && len(msg.StringPrimaryKeys) <= 0 {
+		log.Debug("filter invalid delete message, no primary keys",
+			zap.Any("collectionID", msg.CollectionID),
+			zap.Any("partitionID", msg.PartitionID))
+		return nil
+	}
+
+	return msg
+}
+
+func newFilteredDmNode(streamingReplica ReplicaInterface,
+	historicalReplica ReplicaInterface,
 	loadType loadType,
 	collectionID UniqueID,
 	partitionID UniqueID) *filterDmNode {
@@ -189,10 +255,11 @@ func newFilteredDmNode(replica ReplicaInterface,
 	}
 
 	return &filterDmNode{
-		baseNode:     baseNode,
-		loadType:     loadType,
-		collectionID: collectionID,
-		partitionID:  partitionID,
-		replica:      replica,
+		baseNode:          baseNode,
+		loadType:          loadType,
+		collectionID:      collectionID,
+		partitionID:       partitionID,
+		replica:           streamingReplica,
+		historicalReplica: historicalReplica,
 	}
 }
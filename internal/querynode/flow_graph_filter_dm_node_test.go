@@ -21,6 +21,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
@@ -30,8 +31,12 @@ func getFilterDMNode(ctx context.Context) (*filterDmNode, error) {
 		return nil, err
 	}
 	streaming.replica.initExcludedSegments(defaultCollectionID)
+	historical, err := genSimpleHistorical(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	return newFilteredDmNode(streaming.replica, loadTypeCollection, defaultCollectionID, defaultPartitionID), nil
+	return newFilteredDmNode(streaming.replica, historical.replica, loadTypeCollection, defaultCollectionID, defaultPartitionID), nil
 }
 
 func TestFlowGraphFilterDmNode_filterDmNode(t *testing.T) {
@@ -44,7 +49,7 @@ func TestFlowGraphFilterDmNode_filterDmNode(t *testing.T) {
 
 func TestFlowGraphFilterDmNode_invalidLoadType(t *testing.T) {
 	const invalidLoadType = -1
-	fg := newFilteredDmNode(nil, invalidLoadType, defaultCollectionID, defaultPartitionID)
+	fg := newFilteredDmNode(nil, nil, invalidLoadType, defaultCollectionID, defaultPartitionID)
 	assert.Nil(t, fg)
 }
 
@@ -168,6 +173,67 @@ func TestFlowGraphFilterDmNode_filterInvalidInsertMessage(t *testing.T) {
 	})
 }
 
+func TestFlowGraphFilterDmNode_filterInvalidDeleteMessage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("valid test", func(t *testing.T) {
+		msg := genSimpleDeleteMsg()
+		fg, err := getFilterDMNode(ctx)
+		assert.NoError(t, err)
+		res := fg.filterInvalidDeleteMessage(msg)
+		assert.NotNil(t, res)
+	})
+
+	t.Run("test delete all partitions", func(t *testing.T) {
+		msg := genSimpleDeleteMsg()
+		msg.PartitionID = 0
+		fg, err := getFilterDMNode(ctx)
+		assert.NoError(t, err)
+		fg.loadType = loadTypePartition
+		res := fg.filterInvalidDeleteMessage(msg)
+		assert.NotNil(t, res)
+	})
+
+	t.Run("test no collection", func(t *testing.T) {
+		msg := genSimpleDeleteMsg()
+		msg.CollectionID = UniqueID(1000)
+		fg, err := getFilterDMNode(ctx)
+		assert.NoError(t, err)
+		fg.collectionID = UniqueID(1000)
+		res := fg.filterInvalidDeleteMessage(msg)
+		assert.Nil(t, res)
+	})
+
+	t.Run("test not target collection", func(t *testing.T) {
+		msg := genSimpleDeleteMsg()
+		fg, err := getFilterDMNode(ctx)
+		assert.NoError(t, err)
+		fg.collectionID = UniqueID(1000)
+		res := fg.filterInvalidDeleteMessage(msg)
+		assert.Nil(t, res)
+	})
+
+	t.Run("test not target partition", func(t *testing.T) {
+		msg := genSimpleDeleteMsg()
+		fg, err := getFilterDMNode(ctx)
+		assert.NoError(t, err)
+		fg.loadType = loadTypePartition
+		fg.partitionID = UniqueID(1000)
+		res := fg.filterInvalidDeleteMessage(msg)
+		assert.Nil(t, res)
+	})
+
+	t.Run("test no primary keys", func(t *testing.T) {
+		msg := genSimpleDeleteMsg()
+		msg.PrimaryKeys = []int64{}
+		fg, err := getFilterDMNode(ctx)
+		assert.NoError(t, err)
+		res := fg.filterInvalidDeleteMessage(msg)
+		assert.Nil(t, res)
+	})
+}
+
 func TestFlowGraphFilterDmNode_Operate(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -175,7 +241,8 @@ func TestFlowGraphFilterDmNode_Operate(t *testing.T) {
 	genFilterDMMsg := func() []flowgraph.Msg {
 		iMsg, err := genSimpleInsertMsg()
 		assert.NoError(t, err)
-		msg := flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{iMsg}, 0, 1000, nil, nil)
+		dMsg := genSimpleDeleteMsg()
+		msg := flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{iMsg, dMsg}, 0, 1000, nil, nil)
 		return []flowgraph.Msg{msg}
 	}
 
@@ -185,6 +252,57 @@ func TestFlowGraphFilterDmNode_Operate(t *testing.T) {
 		assert.NoError(t, err)
 		res := fg.Operate(msg)
 		assert.NotNil(t, res)
+		iMsg, ok := res[0].(*insertMsg)
+		assert.True(t, ok)
+		assert.Equal(t, 1, len(iMsg.insertMessages))
+		assert.Equal(t, 1, len(iMsg.deleteMessages))
+	})
+
+	t.Run("test add field", func(t *testing.T) {
+		fg, err := getFilterDMNode(ctx)
+		assert.NoError(t, err)
+		col, err := fg.replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+
+		genAddFieldMsg := func(field *schemapb.FieldSchema) *msgstream.AddFieldMsg {
+			return &msgstream.AddFieldMsg{
+				BaseMsg: genMsgStreamBaseMsg(),
+				AddFieldRequest: internalpb.AddFieldRequest{
+					Base:         genCommonMsgBase(commonpb.MsgType_AddField),
+					CollectionID: defaultCollectionID,
+					Field:        field,
+				},
+			}
+		}
+
+		// a field known to the loaded schema changes nothing
+		numFields := len(col.Schema().Fields)
+		fg.Operate([]flowgraph.Msg{flowgraph.GenerateMsgStreamMsg(
+			[]msgstream.TsMsg{genAddFieldMsg(col.Schema().Fields[0])}, 0, 1000, nil, nil)})
+		assert.Equal(t, numFields, len(col.Schema().Fields))
+
+		// the new field is added to both replicas, inserts before and after it pass
+		iMsg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		afterMsg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		iMsg.Base.MsgType = commonpb.MsgType_Insert
+		afterMsg.Base.MsgType = commonpb.MsgType_Insert
+		addFieldMsg := genAddFieldMsg(&schemapb.FieldSchema{
+			FieldID:  1000,
+			Name:     "added",
+			DataType: schemapb.DataType_Int64,
+		})
+		res := fg.Operate([]flowgraph.Msg{flowgraph.GenerateMsgStreamMsg(
+			[]msgstream.TsMsg{iMsg, addFieldMsg, afterMsg}, 0, 1000, nil, nil)})
+		resMsg, ok := res[0].(*insertMsg)
+		assert.True(t, ok)
+		assert.Equal(t, 2, len(resMsg.insertMessages))
+		assert.Equal(t, numFields+1, len(col.Schema().Fields))
+		assert.Equal(t, int64(1000), col.Schema().Fields[numFields].FieldID)
+		hisCol, err := fg.historicalReplica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		assert.Equal(t, numFields+1, len(hisCol.Schema().Fields))
 	})
 
 	t.Run("invalid input length", func(t *testing.T) {
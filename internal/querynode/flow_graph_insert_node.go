@@ -12,20 +12,29 @@
 package querynode
 
 import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
 	"sync"
 
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type insertNode struct {
 	baseNode
-	replica ReplicaInterface
+	streamingReplica  ReplicaInterface
+	historicalReplica ReplicaInterface
 }
 
 type InsertData struct {
@@ -33,6 +42,14 @@ type InsertData struct {
 	insertTimestamps map[UniqueID][]Timestamp
 	insertRecords    map[UniqueID][]*commonpb.Blob
 	insertOffset     map[UniqueID]int64
+	insertPKs        map[UniqueID][]int64
+	insertStrPKs     map[UniqueID][]string
+}
+
+type DeleteData struct {
+	deleteIDs        map[UniqueID][]int64
+	deleteStrIDs     map[UniqueID][]string
+	deleteTimestamps map[UniqueID][]Timestamp
 }
 
 func (iNode *insertNode) Name() string {
@@ -58,6 +75,8 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		insertTimestamps: make(map[UniqueID][]Timestamp),
 		insertRecords:    make(map[UniqueID][]*commonpb.Blob),
 		insertOffset:     make(map[UniqueID]int64),
+		insertPKs:        make(map[UniqueID][]int64),
+		insertStrPKs:     make(map[UniqueID][]string),
 	}
 
 	if iMsg == nil {
@@ -70,12 +89,17 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		spans = append(spans, sp)
 		msg.SetTraceCtx(ctx)
 	}
+	for _, msg := range iMsg.deleteMessages {
+		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+		spans = append(spans, sp)
+		msg.SetTraceCtx(ctx)
+	}
 
 	// 1. hash insertMessages to insertData
 	for _, task := range iMsg.insertMessages {
 		// check if partition exists, if not, create partition
-		if hasPartition := iNode.replica.hasPartition(task.PartitionID); !hasPartition {
-			err := iNode.replica.addPartition(task.CollectionID, task.PartitionID)
+		if hasPartition := iNode.streamingReplica.hasPartition(task.PartitionID); !hasPartition {
+			err := iNode.streamingReplica.addPartition(task.CollectionID, task.PartitionID)
 			if err != nil {
 				log.Warn(err.Error())
 				continue
@@ -83,12 +107,39 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		}
 
 		// check if segment exists, if not, create this segment
-		if !iNode.replica.hasSegment(task.SegmentID) {
-			err := iNode.replica.addSegment(task.SegmentID, task.PartitionID, task.CollectionID, task.ShardName, segmentTypeGrowing, true)
+		if !iNode.streamingReplica.hasSegment(task.SegmentID) {
+			err := iNode.streamingReplica.addSegment(task.SegmentID, task.PartitionID, task.CollectionID, task.ShardName, segmentTypeGrowing, true)
+			if err != nil {
+				log.Warn(err.Error())
+				continue
+			}
+		}
+
+		// rows written before a field was added are shorter than the loaded schema
+		if err := fillMissingFields(task, iNode.streamingReplica); err != nil {
+			log.Warn(err.Error())
+			continue
+		}
+
+		stringPK, err := hasStringPrimaryKey(task.CollectionID, iNode.streamingReplica)
+		if err != nil {
+			log.Warn(err.Error())
+			continue
+		}
+		if stringPK {
+			pks, err := getStringPrimaryKeys(task, iNode.streamingReplica)
+			if err != nil {
+				log.Warn(err.Error())
+				continue
+			}
+			insertData.insertStrPKs[task.SegmentID] = append(insertData.insertStrPKs[task.SegmentID], pks...)
+		} else {
+			pks, err := getPrimaryKeys(task, iNode.streamingReplica)
 			if err != nil {
 				log.Warn(err.Error())
 				continue
 			}
+			insertData.insertPKs[task.SegmentID] = append(insertData.insertPKs[task.SegmentID], pks...)
 		}
 
 		insertData.insertIDs[task.SegmentID] = append(insertData.insertIDs[task.SegmentID], task.RowIDs...)
@@ -98,7 +149,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	// 2. do preInsert
 	for segmentID := range insertData.insertRecords {
-		var targetSegment, err = iNode.replica.getSegmentByID(segmentID)
+		var targetSegment, err = iNode.streamingReplica.getSegmentByID(segmentID)
 		if err != nil {
 			log.Warn(err.Error())
 		}
@@ -122,6 +173,26 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	}
 	wg.Wait()
 
+	// 4. do delete, delete messages are applied to both growing segments and sealed segments
+	for _, replica := range []ReplicaInterface{iNode.streamingReplica, iNode.historicalReplica} {
+		deleteData := DeleteData{
+			deleteIDs:        make(map[UniqueID][]int64),
+			deleteStrIDs:     make(map[UniqueID][]string),
+			deleteTimestamps: make(map[UniqueID][]Timestamp),
+		}
+		for _, delMsg := range iMsg.deleteMessages {
+			err := filterSegmentsByPKs(delMsg, replica, &deleteData)
+			if err != nil {
+				log.Warn(err.Error())
+			}
+		}
+		for segmentID := range deleteData.deleteTimestamps {
+			wg.Add(1)
+			go iNode.delete(replica, &deleteData, segmentID, &wg)
+		}
+		wg.Wait()
+	}
+
 	var res Msg = &serviceTimeMsg{
 		timeRange: iMsg.timeRange,
 	}
@@ -134,7 +205,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 func (iNode *insertNode) insert(insertData *InsertData, segmentID UniqueID, wg *sync.WaitGroup) {
 	log.Debug("QueryNode::iNode::insert", zap.Any("SegmentID", segmentID))
-	var targetSegment, err = iNode.replica.getSegmentByID(segmentID)
+	var targetSegment, err = iNode.streamingReplica.getSegmentByID(segmentID)
 	if err != nil {
 		log.Warn("cannot find segment:", zap.Int64("segmentID", segmentID))
 		// TODO: add error handling
@@ -160,12 +231,285 @@ func (iNode *insertNode) insert(insertData *InsertData, segmentID UniqueID, wg *
 		return
 	}
 
+	targetSegment.updateBloomFilter(insertData.insertPKs[segmentID])
+	targetSegment.updateStringBloomFilter(insertData.insertStrPKs[segmentID])
+
 	log.Debug("Do insert done", zap.Int("len", len(insertData.insertIDs[segmentID])),
 		zap.Int64("segmentID", segmentID))
 	wg.Done()
 }
 
-func newInsertNode(replica ReplicaInterface) *insertNode {
+func (iNode *insertNode) delete(replica ReplicaInterface, deleteData *DeleteData, segmentID UniqueID, wg *sync.WaitGroup) {
+	defer wg.Done()
+	log.Debug("QueryNode::iNode::delete", zap.Any("SegmentID", segmentID))
+	targetSegment, err := replica.getSegmentByID(segmentID)
+	if err != nil {
+		log.Warn("cannot find segment:", zap.Int64("segmentID", segmentID))
+		return
+	}
+
+	timestamps := deleteData.deleteTimestamps[segmentID]
+	offset := targetSegment.segmentPreDelete(len(timestamps))
+	if strIDs, ok := deleteData.deleteStrIDs[segmentID]; ok {
+		ids := &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{Data: strIDs},
+			},
+		}
+		err = targetSegment.segmentDeleteIDs(offset, ids, &timestamps)
+	} else {
+		ids := deleteData.deleteIDs[segmentID]
+		err = targetSegment.segmentDelete(offset, &ids, &timestamps)
+	}
+	if err != nil {
+		log.Warn("QueryNode: targetSegmentDelete failed", zap.Error(err))
+		return
+	}
+
+	log.Debug("Do delete done", zap.Int("len", len(timestamps)), zap.Int64("segmentID", segmentID))
+}
+
+// filterSegmentsByPKs routes the primary keys of delete message to the segments which may contain them
+func filterSegmentsByPKs(msg *msgstream.DeleteMsg, replica ReplicaInterface, deleteData *DeleteData) error {
+	if !replica.hasCollection(msg.CollectionID) {
+		return nil
+	}
+
+	var partitionIDs []UniqueID
+	if msg.PartitionID != 0 {
+		if !replica.hasPartition(msg.PartitionID) {
+			return nil
+		}
+		partitionIDs = []UniqueID{msg.PartitionID}
+	} else {
+		var err error
+		partitionIDs, err = replica.getPartitionIDs(msg.CollectionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	segments := make([]*Segment, 0)
+	for _, partitionID := range partitionIDs {
+		segmentIDs, err := replica.getSegmentIDs(partitionID)
+		if err != nil {
+			return err
+		}
+		for _, segmentID := range segmentIDs {
+			segment, err := replica.getSegmentByID(segmentID)
+			if err != nil {
+				return err
+			}
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		return nil
+	}
+
+	if len(msg.StringPrimaryKeys) > 0 {
+		segmentPKs, err := getSegmentsByStringPKs(msg.StringPrimaryKeys, segments)
+		if err != nil {
+			return err
+		}
+		for segmentID, pks := range segmentPKs {
+			deleteData.deleteStrIDs[segmentID] = append(deleteData.deleteStrIDs[segmentID], pks...)
+			for range pks {
+				deleteData.deleteTimestamps[segmentID] = append(deleteData.deleteTimestamps[segmentID], msg.Timestamp)
+			}
+		}
+		return nil
+	}
+
+	segmentPKs, err := getSegmentsByPKs(msg.PrimaryKeys, segments)
+	if err != nil {
+		return err
+	}
+	for segmentID, pks := range segmentPKs {
+		deleteData.deleteIDs[segmentID] = append(deleteData.deleteIDs[segmentID], pks...)
+		for range pks {
+			deleteData.deleteTimestamps[segmentID] = append(deleteData.deleteTimestamps[segmentID], msg.Timestamp)
+		}
+	}
+	return nil
+}
+
+// getPrimaryKeys would get primary keys by insert messages
+func getPrimaryKeys(msg *msgstream.InsertMsg, replica ReplicaInterface) ([]int64, error) {
+	pkField, offset, err := getPrimaryKeyOffset(msg, replica)
+	if err != nil {
+		return nil, err
+	}
+	if pkField == nil {
+		return msg.RowIDs, nil
+	}
+	if pkField.DataType != schemapb.DataType_Int64 {
+		return nil, fmt.Errorf("unsupported primary key data type %s", pkField.DataType.String())
+	}
+
+	pks := make([]int64, len(msg.RowData))
+	for i, blob := range msg.RowData {
+		if len(blob.Value) < offset+8 {
+			return nil, fmt.Errorf("invalid row data length %d of insert message", len(blob.Value))
+		}
+		pks[i] = int64(binary.LittleEndian.Uint64(blob.Value[offset : offset+8]))
+	}
+	return pks, nil
+}
+
+// getStringPrimaryKeys would get string primary keys by insert messages
+func getStringPrimaryKeys(msg *msgstream.InsertMsg, replica ReplicaInterface) ([]string, error) {
+	pkField, offset, err := getPrimaryKeyOffset(msg, replica)
+	if err != nil {
+		return nil, err
+	}
+	if pkField == nil || pkField.DataType != schemapb.DataType_String {
+		return nil, errors.New("primary key is not a string field")
+	}
+	maxLength, err := typeutil.GetMaxLength(pkField)
+	if err != nil {
+		return nil, err
+	}
+
+	pks := make([]string, len(msg.RowData))
+	for i, blob := range msg.RowData {
+		pk, err := getStringInRow(blob.Value, offset, maxLength)
+		if err != nil {
+			return nil, err
+		}
+		pks[i] = pk
+	}
+	return pks, nil
+}
+
+// getPrimaryKeyOffset returns the primary key field and its offset in row based insert data,
+// the field is nil if the row id is used as primary key
+func getPrimaryKeyOffset(msg *msgstream.InsertMsg, replica ReplicaInterface) (*schemapb.FieldSchema, int, error) {
+	if len(msg.RowIDs) != len(msg.Timestamps) || len(msg.RowIDs) != len(msg.RowData) {
+		return nil, 0, errors.New("misaligned messages detected")
+	}
+
+	collection, err := replica.getCollectionByID(msg.CollectionID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := 0
+	for _, field := range collection.Schema().Fields {
+		if field.FieldID < common.StartOfUserFieldID {
+			continue
+		}
+		if field.IsPrimaryKey {
+			return field, offset, nil
+		}
+		size, err := getFieldSizeInRow(field)
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += size
+	}
+	return nil, 0, nil
+}
+
+// hasStringPrimaryKey tells whether the primary key field of collection is a string field
+func hasStringPrimaryKey(collectionID UniqueID, replica ReplicaInterface) (bool, error) {
+	collection, err := replica.getCollectionByID(collectionID)
+	if err != nil {
+		return false, err
+	}
+	for _, field := range collection.Schema().Fields {
+		if field.IsPrimaryKey {
+			return field.DataType == schemapb.DataType_String, nil
+		}
+	}
+	return false, nil
+}
+
+// getStringInRow decodes the string slot at offset of row based insert data,
+// which is a 4 bytes length followed by max length bytes
+func getStringInRow(row []byte, offset int, maxLength int) (string, error) {
+	if len(row) < offset+4+maxLength {
+		return "", fmt.Errorf("invalid row data length %d of insert message", len(row))
+	}
+	length := int(binary.LittleEndian.Uint32(row[offset : offset+4]))
+	if length > maxLength {
+		return "", fmt.Errorf("invalid string length %d, max length = %d", length, maxLength)
+	}
+	return string(row[offset+4 : offset+4+length]), nil
+}
+
+// fillMissingFields pads the rows of insert message with zero values of the fields
+// which were added to the collection schema after the rows were written
+func fillMissingFields(msg *msgstream.InsertMsg, replica ReplicaInterface) error {
+	collection, err := replica.getCollectionByID(msg.CollectionID)
+	if err != nil {
+		return err
+	}
+
+	rowSize := 0
+	for _, field := range collection.Schema().Fields {
+		if field.FieldID < common.StartOfUserFieldID {
+			continue
+		}
+		size, err := getFieldSizeInRow(field)
+		if err != nil {
+			return err
+		}
+		rowSize += size
+	}
+
+	for i, blob := range msg.RowData {
+		if len(blob.Value) > rowSize {
+			return fmt.Errorf("invalid row data length %d of insert message, row size = %d", len(blob.Value), rowSize)
+		}
+		if len(blob.Value) < rowSize {
+			value := make([]byte, rowSize)
+			copy(value, blob.Value)
+			msg.RowData[i] = &commonpb.Blob{Value: value}
+		}
+	}
+	return nil
+}
+
+// getFieldSizeInRow returns the size in bytes of the field in row based insert data
+func getFieldSizeInRow(field *schemapb.FieldSchema) (int, error) {
+	switch field.DataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8:
+		return 1, nil
+	case schemapb.DataType_Int16:
+		return 2, nil
+	case schemapb.DataType_Int32, schemapb.DataType_Float:
+		return 4, nil
+	case schemapb.DataType_Int64, schemapb.DataType_Double:
+		return 8, nil
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+		dim := 0
+		for _, kv := range field.TypeParams {
+			if kv.Key == "dim" {
+				var err error
+				dim, err = strconv.Atoi(kv.Value)
+				if err != nil {
+					return 0, err
+				}
+			}
+		}
+		if field.DataType == schemapb.DataType_FloatVector {
+			return dim * 4, nil
+		}
+		return dim / 8, nil
+	case schemapb.DataType_String:
+		// 4 bytes length followed by max length bytes
+		maxLength, err := typeutil.GetMaxLength(field)
+		if err != nil {
+			return 0, err
+		}
+		return 4 + maxLength, nil
+	default:
+		return 0, fmt.Errorf("unsupported data type %s in row based insert data", field.DataType.String())
+	}
+}
+
+func newInsertNode(streamingReplica ReplicaInterface, historicalReplica ReplicaInterface) *insertNode {
 	maxQueueLength := Params.FlowGraphMaxQueueLength
 	maxParallelism := Params.FlowGraphMaxParallelism
 
@@ -174,7 +518,8 @@ func newInsertNode(replica ReplicaInterface) *insertNode {
 	baseNode.SetMaxParallelism(maxParallelism)
 
 	return &insertNode{
-		baseNode: baseNode,
-		replica:  replica,
+		baseNode:          baseNode,
+		streamingReplica:  streamingReplica,
+		historicalReplica: historicalReplica,
 	}
 }
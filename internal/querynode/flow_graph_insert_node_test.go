@@ -12,6 +12,8 @@
 package querynode
 
 import (
+	"encoding/binary"
+	"fmt"
 	"sync"
 	"testing"
 
@@ -19,6 +21,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
@@ -49,7 +52,9 @@ func TestFlowGraphInsertNode_insert(t *testing.T) {
 	t.Run("test insert", func(t *testing.T) {
 		replica, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(replica)
+		historicalReplica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		insertNode := newInsertNode(replica, historicalReplica)
 
 		err = replica.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -70,7 +75,9 @@ func TestFlowGraphInsertNode_insert(t *testing.T) {
 	t.Run("test segment insert error", func(t *testing.T) {
 		replica, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(replica)
+		historicalReplica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		insertNode := newInsertNode(replica, historicalReplica)
 
 		err = replica.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -92,7 +99,9 @@ func TestFlowGraphInsertNode_insert(t *testing.T) {
 	t.Run("test no target segment", func(t *testing.T) {
 		replica, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(replica)
+		historicalReplica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		insertNode := newInsertNode(replica, historicalReplica)
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
 		insertNode.insert(nil, defaultSegmentID, wg)
@@ -101,7 +110,9 @@ func TestFlowGraphInsertNode_insert(t *testing.T) {
 	t.Run("test invalid segmentType", func(t *testing.T) {
 		replica, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(replica)
+		historicalReplica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		insertNode := newInsertNode(replica, historicalReplica)
 
 		err = replica.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -121,7 +132,9 @@ func TestFlowGraphInsertNode_operate(t *testing.T) {
 	t.Run("test operate", func(t *testing.T) {
 		replica, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(replica)
+		historicalReplica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		insertNode := newInsertNode(replica, historicalReplica)
 
 		err = replica.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -145,7 +158,9 @@ func TestFlowGraphInsertNode_operate(t *testing.T) {
 	t.Run("test invalid input length", func(t *testing.T) {
 		replica, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(replica)
+		historicalReplica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		insertNode := newInsertNode(replica, historicalReplica)
 
 		err = replica.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -165,4 +180,244 @@ func TestFlowGraphInsertNode_operate(t *testing.T) {
 		msg := []flowgraph.Msg{&iMsg, &iMsg}
 		insertNode.Operate(msg)
 	})
+	t.Run("test operate with delete", func(t *testing.T) {
+		replica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		historicalReplica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		insertNode := newInsertNode(replica, historicalReplica)
+
+		err = replica.addSegment(defaultSegmentID,
+			defaultPartitionID,
+			defaultCollectionID,
+			defaultVChannel,
+			segmentTypeGrowing,
+			true)
+		assert.NoError(t, err)
+
+		sealedSegment, err := genSimpleSealedSegment()
+		assert.NoError(t, err)
+		err = historicalReplica.setSegment(sealedSegment)
+		assert.NoError(t, err)
+		sealedSegment.updateBloomFilter(genSimpleRowIDField())
+
+		msgInsertMsg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		msgDeleteMsg := genSimpleDeleteMsg()
+		iMsg := insertMsg{
+			insertMessages: []*msgstream.InsertMsg{
+				msgInsertMsg,
+			},
+			deleteMessages: []*msgstream.DeleteMsg{
+				msgDeleteMsg,
+			},
+		}
+		msg := []flowgraph.Msg{&iMsg}
+		insertNode.Operate(msg)
+
+		growingSegment, err := replica.getSegmentByID(defaultSegmentID)
+		assert.NoError(t, err)
+		for _, segment := range []*Segment{growingSegment, sealedSegment} {
+			assert.Equal(t, int64(len(msgDeleteMsg.PrimaryKeys)), segment.getDeletedCount())
+		}
+	})
+
+	t.Run("test operate with upsert", func(t *testing.T) {
+		replica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		historicalReplica, err := genSimpleReplica()
+		assert.NoError(t, err)
+		insertNode := newInsertNode(replica, historicalReplica)
+
+		err = replica.addSegment(defaultSegmentID,
+			defaultPartitionID,
+			defaultCollectionID,
+			defaultVChannel,
+			segmentTypeGrowing,
+			true)
+		assert.NoError(t, err)
+
+		msgInsertMsg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		insertNode.Operate([]flowgraph.Msg{&insertMsg{
+			insertMessages: []*msgstream.InsertMsg{msgInsertMsg},
+		}})
+
+		// upsert pk 1, 2, 3 the way proxy does: the delete takes the task ts,
+		// the new rows take a later one and arrive in the same batch
+		msgDeleteMsg := genSimpleDeleteMsg()
+		msgUpsertMsg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		msgUpsertMsg.RowIDs = msgUpsertMsg.RowIDs[1:4]
+		msgUpsertMsg.RowData = msgUpsertMsg.RowData[1:4]
+		msgUpsertMsg.Timestamps = []Timestamp{defaultMsgLength + 1, defaultMsgLength + 1, defaultMsgLength + 1}
+		insertNode.Operate([]flowgraph.Msg{&insertMsg{
+			insertMessages: []*msgstream.InsertMsg{msgUpsertMsg},
+			deleteMessages: []*msgstream.DeleteMsg{msgDeleteMsg},
+		}})
+
+		segment, err := replica.getSegmentByID(defaultSegmentID)
+		assert.NoError(t, err)
+		plan, err := genSimpleRetrievePlan()
+		assert.NoError(t, err)
+		defer plan.delete()
+		res, err := segment.getEntityByIds(plan)
+		assert.NoError(t, err)
+		// only the upserted rows are visible, the old ones are deleted
+		assert.Equal(t, []int64{defaultMsgLength, defaultMsgLength + 1, defaultMsgLength + 2}, res.Offset)
+	})
+}
+
+func TestFlowGraphInsertNode_getPrimaryKeys(t *testing.T) {
+	replica, err := genSimpleReplica()
+	assert.NoError(t, err)
+
+	t.Run("test row id as primary key", func(t *testing.T) {
+		msg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		pks, err := getPrimaryKeys(msg, replica)
+		assert.NoError(t, err)
+		assert.Equal(t, msg.RowIDs, pks)
+	})
+
+	t.Run("test primary key field", func(t *testing.T) {
+		col, err := replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		pkField := &schemapb.FieldSchema{
+			FieldID:      200,
+			Name:         "pk",
+			IsPrimaryKey: true,
+			DataType:     schemapb.DataType_Int64,
+		}
+		col.schema.Fields = append(col.schema.Fields, pkField)
+		defer func() {
+			col.schema.Fields = col.schema.Fields[:len(col.schema.Fields)-1]
+		}()
+
+		msg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		for i := range msg.RowData {
+			buf := make([]byte, 8)
+			binary.LittleEndian.PutUint64(buf, uint64(i+1000))
+			msg.RowData[i].Value = append(msg.RowData[i].Value, buf...)
+		}
+		pks, err := getPrimaryKeys(msg, replica)
+		assert.NoError(t, err)
+		assert.Equal(t, len(msg.RowData), len(pks))
+		for i, pk := range pks {
+			assert.Equal(t, int64(i+1000), pk)
+		}
+	})
+
+	t.Run("test string primary key field", func(t *testing.T) {
+		col, err := replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		pkField := &schemapb.FieldSchema{
+			FieldID:      200,
+			Name:         "pk",
+			IsPrimaryKey: true,
+			DataType:     schemapb.DataType_String,
+			TypeParams: []*commonpb.KeyValuePair{
+				{Key: "max_length", Value: "8"},
+			},
+		}
+		col.schema.Fields = append(col.schema.Fields, pkField)
+		defer func() {
+			col.schema.Fields = col.schema.Fields[:len(col.schema.Fields)-1]
+		}()
+
+		stringPK, err := hasStringPrimaryKey(defaultCollectionID, replica)
+		assert.NoError(t, err)
+		assert.True(t, stringPK)
+
+		msg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		for i := range msg.RowData {
+			buf := make([]byte, 4+8)
+			pk := fmt.Sprintf("pk%d", i)
+			binary.LittleEndian.PutUint32(buf, uint32(len(pk)))
+			copy(buf[4:], pk)
+			msg.RowData[i].Value = append(msg.RowData[i].Value, buf...)
+		}
+		_, err = getPrimaryKeys(msg, replica)
+		assert.Error(t, err)
+		pks, err := getStringPrimaryKeys(msg, replica)
+		assert.NoError(t, err)
+		assert.Equal(t, len(msg.RowData), len(pks))
+		for i, pk := range pks {
+			assert.Equal(t, fmt.Sprintf("pk%d", i), pk)
+		}
+	})
+
+	t.Run("test misaligned messages", func(t *testing.T) {
+		msg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		msg.RowData = msg.RowData[1:]
+		_, err = getPrimaryKeys(msg, replica)
+		assert.Error(t, err)
+	})
+}
+
+func TestFlowGraphInsertNode_fillMissingFields(t *testing.T) {
+	replica, err := genSimpleReplica()
+	assert.NoError(t, err)
+
+	msg, err := genSimpleInsertMsg()
+	assert.NoError(t, err)
+	rowSize := len(msg.RowData[0].Value)
+
+	t.Run("test complete rows", func(t *testing.T) {
+		err := fillMissingFields(msg, replica)
+		assert.NoError(t, err)
+		assert.Equal(t, rowSize, len(msg.RowData[0].Value))
+	})
+
+	t.Run("test rows written before a field was added", func(t *testing.T) {
+		col, err := replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		col.schema.Fields = append(col.schema.Fields, &schemapb.FieldSchema{
+			FieldID:  1000,
+			Name:     "added",
+			DataType: schemapb.DataType_Int64,
+		})
+		defer func() {
+			col.schema.Fields = col.schema.Fields[:len(col.schema.Fields)-1]
+		}()
+
+		original := msg.RowData[0].Value
+		err = fillMissingFields(msg, replica)
+		assert.NoError(t, err)
+		for _, blob := range msg.RowData {
+			assert.Equal(t, rowSize+8, len(blob.Value))
+			assert.Equal(t, make([]byte, 8), blob.Value[rowSize:])
+		}
+		assert.Equal(t, original, msg.RowData[0].Value[:rowSize])
+	})
+
+	t.Run("test rows longer than schema", func(t *testing.T) {
+		msg, err := genSimpleInsertMsg()
+		assert.NoError(t, err)
+		msg.RowData[0].Value = append(msg.RowData[0].Value, 0)
+		err = fillMissingFields(msg, replica)
+		assert.Error(t, err)
+	})
+}
+
+func TestFlowGraphInsertNode_getFieldSizeInRow(t *testing.T) {
+	size, err := getFieldSizeInRow(&schemapb.FieldSchema{DataType: schemapb.DataType_Int32})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, size)
+
+	field := &schemapb.FieldSchema{
+		Name:       "str",
+		DataType:   schemapb.DataType_String,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "16"}},
+	}
+	size, err = getFieldSizeInRow(field)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, size)
+
+	field.TypeParams = nil
+	_, err = getFieldSizeInRow(field)
+	assert.Error(t, err)
 }
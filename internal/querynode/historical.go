@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"path/filepath"
 	"strconv"
 	"sync"
@@ -26,6 +27,8 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -47,6 +50,8 @@ type historical struct {
 	mu                   sync.Mutex // guards globalSealedSegments
 	globalSealedSegments map[UniqueID]*querypb.SegmentInfo
 
+	handoffMu sync.RWMutex // makes the handoff from growing to sealed segments atomic to search and retrieve
+
 	etcdKV *etcdkv.EtcdKV
 }
 
@@ -180,6 +185,87 @@ func (h *historical) removeGlobalSegmentIDsByPartitionIds(partitionIDs []UniqueI
 	}
 }
 
+// handoffSegments sets the loaded sealed segments into historical and releases
+// the growing segments with the same id from streaming, and the sealed segments
+// compacted into them from historical, in one step
+func (h *historical) handoffSegments(segments []*Segment, compactionFrom []UniqueID, streamingReplica ReplicaInterface) error {
+	h.handoffMu.Lock()
+	defer h.handoffMu.Unlock()
+
+	for index, segment := range segments {
+		err := h.replica.setSegment(segment)
+		if err != nil {
+			for _, s := range segments[index:] {
+				deleteSegment(s)
+			}
+			return err
+		}
+
+		// the flushed segment would never receive new insert data,
+		// exclude it so that the flow graph won't create the growing segment again
+		excludedSegment := &datapb.SegmentInfo{
+			ID:           segment.segmentID,
+			CollectionID: segment.collectionID,
+			PartitionID:  segment.partitionID,
+			DmlPosition: &internalpb.MsgPosition{
+				Timestamp: math.MaxUint64,
+			},
+		}
+		err = streamingReplica.addExcludedSegments(segment.collectionID, []*datapb.SegmentInfo{excludedSegment})
+		if err != nil {
+			log.Warn("handoff: add excluded segment failed", zap.Int64("segmentID", segment.segmentID), zap.Error(err))
+		}
+		if streamingReplica.hasSegment(segment.segmentID) {
+			// deletes consumed after the delta logs of the sealed segment were written
+			// only live in the growing segment, keep them before releasing it
+			err = copyDeletes(streamingReplica, segment)
+			if err != nil {
+				log.Warn("handoff: copy deletes of growing segment failed", zap.Int64("segmentID", segment.segmentID), zap.Error(err))
+			}
+			err = streamingReplica.removeSegment(segment.segmentID)
+			if err != nil {
+				log.Warn("handoff: release growing segment failed", zap.Int64("segmentID", segment.segmentID), zap.Error(err))
+			}
+		}
+		log.Debug("handoff segment done", zap.Int64("segmentID", segment.segmentID))
+	}
+
+	for _, segmentID := range compactionFrom {
+		if !h.replica.hasSegment(segmentID) {
+			continue
+		}
+		err := h.replica.removeSegment(segmentID)
+		if err != nil {
+			log.Warn("handoff: release compacted segment failed", zap.Int64("segmentID", segmentID), zap.Error(err))
+		}
+	}
+
+	for _, segment := range segments {
+		err := h.loader.updateSegmentInfo(segment.segmentID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyDeletes applies the deletes of the growing segment with the same id to the sealed segment
+func copyDeletes(streamingReplica ReplicaInterface, sealedSegment *Segment) error {
+	growingSegment, err := streamingReplica.getSegmentByID(sealedSegment.segmentID)
+	if err != nil {
+		return err
+	}
+	pks, timestamps, err := growingSegment.getDeletedIDs()
+	if err != nil {
+		return err
+	}
+	if len(timestamps) == 0 {
+		return nil
+	}
+	offset := sealedSegment.segmentPreDelete(len(timestamps))
+	return sealedSegment.segmentDeleteIDs(offset, pks, &timestamps)
+}
+
 func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.ChunkManager,
 	plan *RetrievePlan) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
 
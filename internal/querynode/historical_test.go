@@ -187,3 +187,97 @@ func TestHistorical_Search(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestHistorical_handoffSegments(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	his, err := genSimpleHistorical(ctx)
+	assert.NoError(t, err)
+	err = his.replica.removeSegment(defaultSegmentID)
+	assert.NoError(t, err)
+
+	strm, err := genSimpleStreaming(ctx)
+	assert.NoError(t, err)
+	strm.replica.initExcludedSegments(defaultCollectionID)
+	assert.True(t, strm.replica.hasSegment(defaultSegmentID))
+
+	segmentInfo := &querypb.SegmentInfo{
+		SegmentID:    defaultSegmentID,
+		CollectionID: defaultCollectionID,
+		PartitionID:  defaultPartitionID,
+		SegmentState: querypb.SegmentState_sealing,
+	}
+	segmentInfoBytes, err := proto.Marshal(segmentInfo)
+	assert.NoError(t, err)
+	err = his.etcdKV.Save(queryCoordSegmentMetaPrefix+"/"+strconv.FormatInt(defaultSegmentID, 10), string(segmentInfoBytes))
+	assert.NoError(t, err)
+
+	// deletes only applied to the growing segment
+	growingSegment, err := strm.replica.getSegmentByID(defaultSegmentID)
+	assert.NoError(t, err)
+	pks := []IntPrimaryKey{1, 2, 3}
+	timestamps := []Timestamp{defaultMsgLength, defaultMsgLength, defaultMsgLength}
+	offset := growingSegment.segmentPreDelete(len(pks))
+	err = growingSegment.segmentDelete(offset, &pks, &timestamps)
+	assert.NoError(t, err)
+
+	seg, err := genSimpleSealedSegment()
+	assert.NoError(t, err)
+	err = his.handoffSegments([]*Segment{seg}, nil, strm.replica)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(pks)), seg.getDeletedCount())
+
+	assert.True(t, his.replica.hasSegment(defaultSegmentID))
+	assert.False(t, strm.replica.hasSegment(defaultSegmentID))
+	excludedSegments, err := strm.replica.getExcludedSegments(defaultCollectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(excludedSegments))
+	assert.Equal(t, defaultSegmentID, excludedSegments[0].ID)
+
+	value, err := his.etcdKV.Load(queryNodeSegmentMetaPrefix + "/" + strconv.FormatInt(defaultSegmentID, 10))
+	assert.NoError(t, err)
+	err = proto.Unmarshal([]byte(value), segmentInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, querypb.SegmentState_sealed, segmentInfo.SegmentState)
+}
+
+func TestHistorical_handoffCompactedSegments(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	his, err := genSimpleHistorical(ctx)
+	assert.NoError(t, err)
+	assert.True(t, his.replica.hasSegment(defaultSegmentID))
+
+	strm, err := genSimpleStreaming(ctx)
+	assert.NoError(t, err)
+	strm.replica.initExcludedSegments(defaultCollectionID)
+
+	compactedSegmentID := defaultSegmentID + 1
+	segmentInfo := &querypb.SegmentInfo{
+		SegmentID:      compactedSegmentID,
+		CollectionID:   defaultCollectionID,
+		PartitionID:    defaultPartitionID,
+		SegmentState:   querypb.SegmentState_sealing,
+		CompactionFrom: []UniqueID{defaultSegmentID},
+	}
+	segmentInfoBytes, err := proto.Marshal(segmentInfo)
+	assert.NoError(t, err)
+	err = his.etcdKV.Save(queryCoordSegmentMetaPrefix+"/"+strconv.FormatInt(compactedSegmentID, 10), string(segmentInfoBytes))
+	assert.NoError(t, err)
+
+	seg, err := genSealedSegment(genSimpleSegCoreSchema(),
+		genSimpleInsertDataSchema(),
+		defaultCollectionID,
+		defaultPartitionID,
+		compactedSegmentID,
+		defaultVChannel,
+		defaultMsgLength)
+	assert.NoError(t, err)
+	err = his.handoffSegments([]*Segment{seg}, segmentInfo.CompactionFrom, strm.replica)
+	assert.NoError(t, err)
+
+	assert.True(t, his.replica.hasSegment(compactedSegmentID))
+	assert.False(t, his.replica.hasSegment(defaultSegmentID))
+}
@@ -126,6 +126,7 @@ func (node *QueryNode) AddQueryChannel(ctx context.Context, in *queryPb.AddQuery
 		}
 		return status, err
 	}
+	sc.setReplicaID(in.ReplicaID)
 	consumeChannels := []string{in.RequestChannelID}
 	//consumeSubName := Params.MsgChannelSubName
 	consumeSubName := Params.MsgChannelSubName + "-" + strconv.FormatInt(collectionID, 10) + "-" + strconv.Itoa(rand.Int())
@@ -724,6 +724,22 @@ func genSimpleInsertMsg() (*msgstream.InsertMsg, error) {
 	}, nil
 }
 
+func genSimpleDeleteMsg() *msgstream.DeleteMsg {
+	return &msgstream.DeleteMsg{
+		BaseMsg: genMsgStreamBaseMsg(),
+		DeleteRequest: internalpb.DeleteRequest{
+			Base:           genCommonMsgBase(commonpb.MsgType_Delete),
+			CollectionName: defaultCollectionName,
+			PartitionName:  defaultPartitionName,
+			CollectionID:   defaultCollectionID,
+			PartitionID:    defaultPartitionID,
+			ShardName:      defaultVChannel,
+			Timestamp:      Timestamp(defaultMsgLength),
+			PrimaryKeys:    []IntPrimaryKey{1, 2, 3},
+		},
+	}
+}
+
 // ---------- unittest util functions ----------
 // functions of replica
 func genSealedSegment(schemaForCreate *schemapb.CollectionSchema,
@@ -864,7 +880,7 @@ func genSimpleStreaming(ctx context.Context) (*streaming, error) {
 	if err != nil {
 		return nil, err
 	}
-	s := newStreaming(ctx, fac, kv)
+	s := newStreaming(ctx, fac, kv, newCollectionReplica(kv))
 	r, err := genSimpleReplica()
 	if err != nil {
 		return nil, err
@@ -70,8 +70,7 @@ type ParamTable struct {
 	// stats
 	StatsPublishInterval int
 
-	GracefulTime int64
-	SliceIndex   int
+	SliceIndex int
 
 	// segcore
 	ChunkRows int64
@@ -113,8 +112,6 @@ func (p *ParamTable) Init() {
 	p.initEtcdEndpoints()
 	p.initMetaRootPath()
 
-	p.initGracefulTime()
-
 	p.initFlowGraphMaxQueueLength()
 	p.initFlowGraphMaxParallelism()
 
@@ -303,10 +300,6 @@ func (p *ParamTable) initMetaRootPath() {
 	p.MetaRootPath = rootPath + "/" + subPath
 }
 
-func (p *ParamTable) initGracefulTime() {
-	p.GracefulTime = p.ParseInt64("queryNode.gracefulTime")
-}
-
 func (p *ParamTable) initSegcoreChunkRows() {
 	p.ChunkRows = p.ParseInt64("queryNode.segcore.chunkRows")
 }
@@ -17,7 +17,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"unsafe"
 
 	oplog "github.com/opentracing/opentracing-go/log"
@@ -53,6 +55,9 @@ type queryCollection struct {
 	historical   *historical
 	streaming    *streaming
 
+	// replicaID is the in-memory replica the query node serves, 0 means all search requests are served
+	replicaID int64
+
 	unsolvedMsgMu sync.Mutex // guards unsolvedMsg
 	unsolvedMsg   []queryMsg
 
@@ -75,6 +80,14 @@ type queryCollection struct {
 
 type ResultEntityIds []UniqueID
 
+func (q *queryCollection) setReplicaID(replicaID UniqueID) {
+	atomic.StoreInt64(&q.replicaID, replicaID)
+}
+
+func (q *queryCollection) getReplicaID() UniqueID {
+	return atomic.LoadInt64(&q.replicaID)
+}
+
 func newQueryCollection(releaseCtx context.Context,
 	cancel context.CancelFunc,
 	collectionID UniqueID,
@@ -246,13 +259,7 @@ func (q *queryCollection) setServiceableTime(t Timestamp) {
 		return
 	}
 
-	gracefulTimeInMilliSecond := Params.GracefulTime
-	if gracefulTimeInMilliSecond > 0 {
-		gracefulTime := tsoutil.ComposeTS(gracefulTimeInMilliSecond, 0)
-		q.serviceableTime = t + gracefulTime
-	} else {
-		q.serviceableTime = t
-	}
+	q.serviceableTime = t
 }
 
 func (q *queryCollection) consumeQuery() {
@@ -302,11 +309,13 @@ func (q *queryCollection) loadBalance(msg *msgstream.LoadBalanceSegmentsMsg) {
 func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 	msgType := msg.Type()
 	var collectionID UniqueID
+	var replicaID UniqueID
 	var msgTypeStr string
 
 	switch msgType {
 	case commonpb.MsgType_Retrieve:
 		collectionID = msg.(*msgstream.RetrieveMsg).CollectionID
+		replicaID = msg.(*msgstream.RetrieveMsg).ReplicaID
 		msgTypeStr = "retrieve"
 		//log.Debug("consume retrieve message",
 		//	zap.Any("collectionID", collectionID),
@@ -314,6 +323,7 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 		//)
 	case commonpb.MsgType_Search:
 		collectionID = msg.(*msgstream.SearchMsg).CollectionID
+		replicaID = msg.(*msgstream.SearchMsg).ReplicaID
 		msgTypeStr = "search"
 		//log.Debug("consume search message",
 		//	zap.Any("collectionID", collectionID),
@@ -332,6 +342,10 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 		//err := fmt.Errorf("not target collection query request, collectionID = %d, targetCollectionID = %d, msgID = %d", q.collectionID, collectionID, msg.ID())
 		return nil
 	}
+	if replicaID != 0 && replicaID != q.getReplicaID() {
+		// the request is served by another replica
+		return nil
+	}
 
 	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	msg.SetTraceCtx(ctx)
@@ -552,27 +566,57 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 	if err != nil {
 		return nil, err
 	}
-	topK := len(pbHits.IDs)
+	topK := len(pbHits.Scores)
 
-	blobOffset += 8
-	var ids []int64
 	var scores []float32
 	for _, hit := range hits {
-		ids = append(ids, hit.IDs...)
 		scores = append(scores, hit.Scores...)
 	}
 
 	finalResult := &schemapb.SearchResultData{
-		Ids: &schemapb.IDs{
+		Scores:     scores,
+		TopK:       int64(topK),
+		NumQueries: int64(numQueries),
+	}
+
+	// a string primary key is left in the row data by segcore instead of the int64 ids
+	pkField, err := schema.GetPrimaryKeyField()
+	if err == nil && pkField.DataType == schemapb.DataType_String {
+		maxLength, err := typeutil.GetMaxLength(pkField)
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for _, hit := range hits {
+			for _, row := range hit.RowData {
+				id, err := getStringInRow(row, blobOffset, maxLength)
+				if err != nil {
+					return nil, err
+				}
+				ids = append(ids, id)
+			}
+		}
+		finalResult.Ids = &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{
+					Data: ids,
+				},
+			},
+		}
+		blobOffset += 4 + maxLength
+	} else {
+		var ids []int64
+		for _, hit := range hits {
+			ids = append(ids, hit.IDs...)
+		}
+		finalResult.Ids = &schemapb.IDs{
 			IdField: &schemapb.IDs_IntId{
 				IntId: &schemapb.LongArray{
 					Data: ids,
 				},
 			},
-		},
-		Scores:     scores,
-		TopK:       int64(topK),
-		NumQueries: int64(numQueries),
+		}
+		blobOffset += 8
 	}
 
 	for _, fieldID := range fieldIDs {
@@ -742,6 +786,34 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
 			blobOffset += blobLen
+		case schemapb.DataType_String:
+			maxLength, err := typeutil.GetMaxLength(fieldMeta)
+			if err != nil {
+				return nil, err
+			}
+			var colData []string
+			for _, hit := range hits {
+				for _, row := range hit.RowData {
+					data, err := getStringInRow(row, blobOffset, maxLength)
+					if err != nil {
+						return nil, err
+					}
+					colData = append(colData, data)
+				}
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{
+							StringData: &schemapb.StringArray{
+								Data: colData,
+							},
+						},
+					},
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
+			blobOffset += 4 + maxLength
 		case schemapb.DataType_FloatVector:
 			dim, err := schema.GetVectorDimFromID(fieldID)
 			if err != nil {
@@ -821,7 +893,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 		return err
 	}
 
-	schema, err := typeutil.CreateSchemaHelper(collection.schema)
+	schema, err := typeutil.CreateSchemaHelper(collection.Schema())
 	if err != nil {
 		return err
 	}
@@ -878,9 +950,12 @@ func (q *queryCollection) search(msg queryMsg) error {
 
 	searchResults := make([]*SearchResult, 0)
 
+	// hold handoffMu so that a segment in handoff is searched exactly once
+	q.historical.handoffMu.RLock()
 	// historical search
 	hisSearchResults, sealedSegmentSearched, err1 := q.historical.search(searchRequests, collection.id, searchMsg.PartitionIDs, plan, travelTimestamp)
 	if err1 != nil {
+		q.historical.handoffMu.RUnlock()
 		log.Warn(err1.Error())
 		return err1
 	}
@@ -893,11 +968,13 @@ func (q *queryCollection) search(msg queryMsg) error {
 		var strSearchResults []*SearchResult
 		strSearchResults, err2 = q.streaming.search(searchRequests, collection.id, searchMsg.PartitionIDs, channel, plan, travelTimestamp)
 		if err2 != nil {
+			q.historical.handoffMu.RUnlock()
 			log.Warn(err2.Error())
 			return err2
 		}
 		searchResults = append(searchResults, strSearchResults...)
 	}
+	q.historical.handoffMu.RUnlock()
 	tr.Record("streaming search done")
 
 	sp.LogFields(oplog.String("statistical time", "segment search end"))
@@ -1100,12 +1177,15 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		q.vectorChunkManager = storage.NewVectorChunkManager(q.localChunkManager, q.remoteChunkManager,
 			&etcdpb.CollectionMeta{
 				ID:     collection.id,
-				Schema: collection.schema,
+				Schema: collection.Schema(),
 			}, q.localCacheEnabled)
 	}
+	// hold handoffMu so that a segment in handoff is retrieved exactly once
+	q.historical.handoffMu.RLock()
 	// historical retrieve
 	hisRetrieveResults, sealedSegmentRetrieved, err1 := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, q.vectorChunkManager, plan)
 	if err1 != nil {
+		q.historical.handoffMu.RUnlock()
 		log.Warn(err1.Error())
 		return err1
 	}
@@ -1114,6 +1194,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 
 	// streaming retrieve
 	strRetrieveResults, _, err2 := q.streaming.retrieve(collectionID, retrieveMsg.PartitionIDs, plan)
+	q.historical.handoffMu.RUnlock()
 	if err2 != nil {
 		log.Warn(err2.Error())
 		return err2
@@ -1127,6 +1208,10 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	}
 	tr.Record("merge result done")
 
+	if retrieveMsg.Limit > 0 {
+		result = limitRetrieveResults(result, retrieveMsg.Limit)
+	}
+
 	resultChannelInt := 0
 	retrieveResultMsg := &msgstream.RetrieveResultMsg{
 		BaseMsg: msgstream.BaseMsg{Ctx: retrieveMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
@@ -1159,6 +1244,40 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	return nil
 }
 
+// limitRetrieveResults sorts the merged retrieve result by primary key and keeps the first limit entities,
+// segcore already keeps at most limit entities of each segment
+func limitRetrieveResults(result *segcorepb.RetrieveResults, limit int64) *segcorepb.RetrieveResults {
+	ids := result.GetIds()
+	size := typeutil.GetSizeOfIDs(ids)
+	if size == 0 {
+		return result
+	}
+
+	idxs := make([]int, size)
+	for i := range idxs {
+		idxs[i] = i
+	}
+	sort.SliceStable(idxs, func(i, j int) bool {
+		return typeutil.LessPK(typeutil.GetPK(ids, int64(idxs[i])), typeutil.GetPK(ids, int64(idxs[j])))
+	})
+	if int64(len(idxs)) > limit {
+		idxs = idxs[:limit]
+	}
+
+	limited := &segcorepb.RetrieveResults{
+		Ids:        &schemapb.IDs{},
+		FieldsData: make([]*schemapb.FieldData, len(result.FieldsData)),
+	}
+	for _, i := range idxs {
+		typeutil.AppendIDs(limited.Ids, ids, int64(i))
+		if i < len(result.Offset) {
+			limited.Offset = append(limited.Offset, result.Offset[i])
+		}
+		typeutil.AppendFieldData(limited.FieldsData, result.FieldsData, int64(i))
+	}
+	return limited
+}
+
 func getSegmentsByPKs(pks []int64, segments []*Segment) (map[int64][]int64, error) {
 	if pks == nil {
 		return nil, fmt.Errorf("pks is nil when getSegmentsByPKs")
@@ -1167,11 +1286,28 @@ func getSegmentsByPKs(pks []int64, segments []*Segment) (map[int64][]int64, erro
 		return nil, fmt.Errorf("segments is nil when getSegmentsByPKs")
 	}
 	results := make(map[int64][]int64)
-	buf := make([]byte, 8)
 	for _, segment := range segments {
 		for _, pk := range pks {
-			binary.BigEndian.PutUint64(buf, uint64(pk))
-			exist := segment.pkFilter.Test(buf)
+			exist := segment.pkFilter.test(pk)
+			if exist {
+				results[segment.segmentID] = append(results[segment.segmentID], pk)
+			}
+		}
+	}
+	return results, nil
+}
+
+func getSegmentsByStringPKs(pks []string, segments []*Segment) (map[int64][]string, error) {
+	if pks == nil {
+		return nil, fmt.Errorf("pks is nil when getSegmentsByStringPKs")
+	}
+	if segments == nil {
+		return nil, fmt.Errorf("segments is nil when getSegmentsByStringPKs")
+	}
+	results := make(map[int64][]string)
+	for _, segment := range segments {
+		for _, pk := range pks {
+			exist := segment.pkFilter.testString(pk)
 			if exist {
 				results[segment.segmentID] = append(results[segment.segmentID], pk)
 			}
@@ -9,7 +9,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
@@ -20,7 +19,6 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
-	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -109,7 +107,7 @@ func TestQueryCollection_withoutVChannel(t *testing.T) {
 	assert.Nil(t, err)
 
 	//create a streaming
-	streaming := newStreaming(context.Background(), factory, etcdKV)
+	streaming := newStreaming(context.Background(), factory, etcdKV, historical.replica)
 	err = streaming.replica.addCollection(0, schema)
 	assert.Nil(t, err)
 	err = streaming.replica.addPartition(0, 1)
@@ -185,17 +183,10 @@ func TestQueryCollection_withoutVChannel(t *testing.T) {
 }
 
 func TestGetSegmentsByPKs(t *testing.T) {
-	buf := make([]byte, 8)
-	filter1 := bloom.NewWithEstimates(1000000, 0.01)
-	for i := 0; i < 3; i++ {
-		binary.BigEndian.PutUint64(buf, uint64(i))
-		filter1.Add(buf)
-	}
-	filter2 := bloom.NewWithEstimates(1000000, 0.01)
-	for i := 3; i < 5; i++ {
-		binary.BigEndian.PutUint64(buf, uint64(i))
-		filter2.Add(buf)
-	}
+	filter1 := newPKFilter(1000000)
+	filter1.add([]int64{0, 1, 2})
+	filter2 := newPKFilter(1000000)
+	filter2.add([]int64{3, 4})
 	segment1 := &Segment{
 		segmentID: 1,
 		pkFilter:  filter1,
@@ -470,10 +461,8 @@ func TestQueryCollection_serviceableTime(t *testing.T) {
 	st := Timestamp(1000)
 	queryCollection.setServiceableTime(st)
 
-	gracefulTimeInMilliSecond := Params.GracefulTime
-	gracefulTime := tsoutil.ComposeTS(gracefulTimeInMilliSecond, 0)
 	resST := queryCollection.getServiceableTime()
-	assert.Equal(t, st+gracefulTime, resST)
+	assert.Equal(t, st, resST)
 }
 
 func TestQueryCollection_addTSafeWatcher(t *testing.T) {
@@ -530,6 +519,55 @@ func TestQueryCollection_mergeRetrieveResults(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestQueryCollection_limitRetrieveResults(t *testing.T) {
+	result := &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: []int64{3, 1, 2},
+				},
+			},
+		},
+		Offset: []int64{0, 1, 2},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int32,
+				FieldName: defaultConstFieldName,
+				FieldId:   simpleConstField.id,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_IntData{
+							IntData: &schemapb.IntArray{
+								Data: []int32{30, 10, 20},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	res := limitRetrieveResults(result, 2)
+	assert.Equal(t, []int64{1, 2}, res.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{1, 2}, res.Offset)
+	assert.Equal(t, []int32{10, 20}, res.FieldsData[0].GetScalars().GetIntData().Data)
+
+	res = limitRetrieveResults(result, 10)
+	assert.Equal(t, []int64{1, 2, 3}, res.Ids.GetIntId().Data)
+	assert.Equal(t, []int32{10, 20, 30}, res.FieldsData[0].GetScalars().GetIntData().Data)
+
+	result.Ids = &schemapb.IDs{
+		IdField: &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: []string{"c", "a", "b"},
+			},
+		},
+	}
+	res = limitRetrieveResults(result, 2)
+	assert.Equal(t, []string{"a", "b"}, res.Ids.GetStrId().Data)
+	assert.Equal(t, []int32{10, 20}, res.FieldsData[0].GetScalars().GetIntData().Data)
+}
+
 func TestQueryCollection_doUnsolvedQueryMsg(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -30,6 +30,7 @@ import (
 	"unsafe"
 
 	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
@@ -50,6 +51,12 @@ const (
 	segmentTypeIndexing
 )
 
+const (
+	// initial capacity of the pk filter of growing segments, sealed segments are sized by row count
+	bloomFilterSize       uint    = 100000
+	maxBloomFalsePositive float64 = 0.005
+)
+
 type VectorFieldInfo struct {
 	fieldBinlog *datapb.FieldBinlog
 }
@@ -92,7 +99,7 @@ type Segment struct {
 	vectorFieldMutex sync.RWMutex // guards vectorFieldInfos
 	vectorFieldInfos map[UniqueID]*VectorFieldInfo
 
-	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
+	pkFilter *pkFilter //  bloom filter of pk inside a segment
 }
 
 //-------------------------------------------------------------------------------------- common interfaces
@@ -198,6 +205,7 @@ func newSegment(collection *Collection, segmentID UniqueID, partitionID UniqueID
 		onService:        onService,
 		indexInfos:       make(map[int64]*indexInfo),
 		vectorFieldInfos: make(map[UniqueID]*VectorFieldInfo),
+		pkFilter:         newPKFilter(bloomFilterSize),
 	}
 
 	return segment
@@ -670,6 +678,185 @@ func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps
 	return nil
 }
 
+// segmentDeleteIDs applies the deletes of int64 or string primary keys to the segment
+func (s *Segment) segmentDeleteIDs(offset int64, ids *schemapb.IDs, timestamps *[]Timestamp) error {
+	/*
+		CStatus
+		DeleteByIds(CSegmentInterface c_segment,
+		            int64_t reserved_offset,
+		            const void* ids_blob,
+		            int64_t ids_blob_size,
+		            const uint64_t* timestamps);
+	*/
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+	blob, err := proto.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	if len(blob) == 0 {
+		return nil
+	}
+
+	var status = C.DeleteByIds(s.segmentPtr, C.int64_t(offset), unsafe.Pointer(&blob[0]), C.int64_t(len(blob)), (*C.uint64_t)(&(*timestamps)[0]))
+	return HandleCStatus(&status, "DeleteByIds failed")
+}
+
+// updateSchema switches the segment to the current schema of collection, the rows in the segment
+// get zero values of the fields added after they were inserted
+func (s *Segment) updateSchema(collection *Collection) error {
+	/*
+		CStatus
+		UpdateSegmentSchema(CSegmentInterface c_segment, CCollection c_collection);
+	*/
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock() // segCore doesn't allow the other operations while the schema changes
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+	var status = C.UpdateSegmentSchema(s.segmentPtr, collection.collectionPtr)
+	return HandleCStatus(&status, "UpdateSegmentSchema failed")
+}
+
+// getDeletedRecords returns the primary keys and timestamps of the deletes applied to the growing segment
+func (s *Segment) getDeletedRecords() ([]IntPrimaryKey, []Timestamp, error) {
+	/*
+		CStatus
+		GetDeletedRecords(CSegmentInterface c_segment, long size, long* row_ids, unsigned long* timestamps);
+	*/
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock()
+	if s.segmentPtr == nil {
+		return nil, nil, errors.New("null seg core pointer")
+	}
+	if s.segmentType != segmentTypeGrowing {
+		return nil, nil, fmt.Errorf("getDeletedRecords failed, illegal segment type %v, segmentID = %d", s.segmentType, s.ID())
+	}
+
+	count := int64(C.GetDeletedCount(s.segmentPtr))
+	pks := make([]IntPrimaryKey, count)
+	timestamps := make([]Timestamp, count)
+	if count == 0 {
+		return pks, timestamps, nil
+	}
+	status := C.GetDeletedRecords(s.segmentPtr, C.long(count), (*C.long)(&pks[0]), (*C.ulong)(&timestamps[0]))
+	if err := HandleCStatus(&status, "GetDeletedRecords failed"); err != nil {
+		return nil, nil, err
+	}
+	return pks, timestamps, nil
+}
+
+// getDeletedIDs returns the primary keys and timestamps of the deletes applied to the growing segment,
+// the primary keys are int64 or string as the primary key field
+func (s *Segment) getDeletedIDs() (*schemapb.IDs, []Timestamp, error) {
+	/*
+		CProtoResult
+		GetDeletedIds(CSegmentInterface c_segment, int64_t size, uint64_t* timestamps);
+	*/
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock()
+	if s.segmentPtr == nil {
+		return nil, nil, errors.New("null seg core pointer")
+	}
+	if s.segmentType != segmentTypeGrowing {
+		return nil, nil, fmt.Errorf("getDeletedIDs failed, illegal segment type %v, segmentID = %d", s.segmentType, s.ID())
+	}
+
+	count := int64(C.GetDeletedCount(s.segmentPtr))
+	ids := &schemapb.IDs{}
+	timestamps := make([]Timestamp, count)
+	if count == 0 {
+		return ids, timestamps, nil
+	}
+	resProto := C.GetDeletedIds(s.segmentPtr, C.int64_t(count), (*C.uint64_t)(&timestamps[0]))
+	if err := HandleCProtoResult(&resProto, ids); err != nil {
+		return nil, nil, err
+	}
+	return ids, timestamps, nil
+}
+
+// updateBloomFilter adds primary keys into the bloom filter of segment
+func (s *Segment) updateBloomFilter(pks []int64) {
+	s.pkFilter.add(pks)
+}
+
+// updateStringBloomFilter adds string primary keys into the bloom filter of segment
+func (s *Segment) updateStringBloomFilter(pks []string) {
+	s.pkFilter.addStrings(pks)
+}
+
+// pkFilter is a set of bloom filters of the primary keys inside a segment. Once the last filter
+// is full, a new one with doubled capacity is added, so the false positive rate holds as the segment grows.
+type pkFilter struct {
+	mu       sync.RWMutex
+	filters  []*bloom.BloomFilter
+	capacity uint // number of pks the last filter is sized for
+	count    uint // number of pks added into the last filter
+}
+
+func newPKFilter(capacity uint) *pkFilter {
+	if capacity == 0 {
+		capacity = 1
+	}
+	return &pkFilter{
+		filters:  []*bloom.BloomFilter{bloom.NewWithEstimates(capacity, maxBloomFalsePositive)},
+		capacity: capacity,
+	}
+}
+
+func (f *pkFilter) add(pks []int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	buf := make([]byte, 8)
+	for _, pk := range pks {
+		binary.BigEndian.PutUint64(buf, uint64(pk))
+		f.addBytes(buf)
+	}
+}
+
+func (f *pkFilter) addStrings(pks []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, pk := range pks {
+		f.addBytes([]byte(pk))
+	}
+}
+
+// addBytes adds a key into the last filter, the caller must hold the lock
+func (f *pkFilter) addBytes(key []byte) {
+	if f.count >= f.capacity {
+		f.capacity *= 2
+		f.count = 0
+		f.filters = append(f.filters, bloom.NewWithEstimates(f.capacity, maxBloomFalsePositive))
+	}
+	f.filters[len(f.filters)-1].Add(key)
+	f.count++
+}
+
+func (f *pkFilter) test(pk int64) bool {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(pk))
+	return f.testBytes(buf)
+}
+
+func (f *pkFilter) testString(pk string) bool {
+	return f.testBytes([]byte(pk))
+}
+
+func (f *pkFilter) testBytes(key []byte) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for _, filter := range f.filters {
+		if filter.Test(key) {
+			return true
+		}
+	}
+	return false
+}
+
 //-------------------------------------------------------------------------------------- interfaces for sealed segment
 func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interface{}) error {
 	/*
@@ -731,8 +918,22 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 		}
 		dataPointer = unsafe.Pointer(&d[0])
 	case []string:
-		// TODO: support string type
-		return errors.New("we cannot support string type now")
+		if len(d) <= 0 {
+			return emptyErr
+		}
+		// strings are loaded as consecutive 4 bytes length followed by the bytes
+		size := 0
+		for _, str := range d {
+			size += 4 + len(str)
+		}
+		blob := make([]byte, 0, size)
+		buf := make([]byte, 4)
+		for _, str := range d {
+			binary.LittleEndian.PutUint32(buf, uint32(len(str)))
+			blob = append(blob, buf...)
+			blob = append(blob, str...)
+		}
+		dataPointer = unsafe.Pointer(&blob[0])
 	default:
 		return errors.New("illegal field data type")
 	}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
@@ -25,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
@@ -49,8 +51,20 @@ type segmentLoader struct {
 	indexLoader *indexLoader
 }
 
-func (loader *segmentLoader) loadSegmentOfConditionHandOff(req *querypb.LoadSegmentsRequest) error {
-	return errors.New("TODO: implement hand off")
+// loadSegmentOfConditionHandOff loads the sealed segments without setting them into historical,
+// the caller is responsible to replace the growing segments with them
+func (loader *segmentLoader) loadSegmentOfConditionHandOff(req *querypb.LoadSegmentsRequest) ([]*Segment, error) {
+	if req == nil {
+		return nil, errors.New("null load segments request when hand off")
+	}
+	segments, err := loader.loadSealedSegments(req, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range segments {
+		s.setOnService(true)
+	}
+	return segments, nil
 }
 
 func (loader *segmentLoader) loadSegmentOfConditionLoadBalance(req *querypb.LoadSegmentsRequest) error {
@@ -66,14 +80,32 @@ func (loader *segmentLoader) loadSegmentOfConditionNodeDown(req *querypb.LoadSeg
 }
 
 func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onService bool) error {
+	newSegments, err := loader.loadSealedSegments(req, onService)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range newSegments {
+		err := loader.historicalReplica.setSegment(s)
+		if err != nil {
+			for _, s := range newSegments {
+				deleteSegment(s)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (loader *segmentLoader) loadSealedSegments(req *querypb.LoadSegmentsRequest, onService bool) ([]*Segment, error) {
 	// no segment needs to load, return
 	if len(req.Infos) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	err := loader.checkSegmentMemory(req.Infos)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	newSegments := make([]*Segment, 0)
@@ -82,16 +114,6 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 			deleteSegment(s)
 		}
 	}
-	setSegments := func() error {
-		for _, s := range newSegments {
-			err := loader.historicalReplica.setSegment(s)
-			if err != nil {
-				segmentGC()
-				return err
-			}
-		}
-		return nil
-	}
 
 	// start to load
 	for _, info := range req.Infos {
@@ -103,7 +125,7 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 		if err != nil {
 			log.Warn(err.Error())
 			segmentGC()
-			return err
+			return nil, err
 		}
 		segment := newSegment(collection, segmentID, partitionID, collectionID, "", segmentTypeSealed, onService)
 		err = loader.loadSegmentInternal(collectionID, segment, info)
@@ -111,46 +133,49 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 			deleteSegment(segment)
 			log.Warn(err.Error())
 			segmentGC()
-			return err
+			return nil, err
 		}
 		if onService {
-			key := fmt.Sprintf("%s/%d", queryCoordSegmentMetaPrefix, segmentID)
-			value, err := loader.etcdKV.Load(key)
+			err = loader.updateSegmentInfo(segmentID)
 			if err != nil {
 				deleteSegment(segment)
-				log.Warn("error when load segment info from etcd", zap.Any("error", err.Error()))
 				segmentGC()
-				return err
-			}
-			segmentInfo := &querypb.SegmentInfo{}
-			err = proto.Unmarshal([]byte(value), segmentInfo)
-			if err != nil {
-				deleteSegment(segment)
-				log.Warn("error when unmarshal segment info from etcd", zap.Any("error", err.Error()))
-				segmentGC()
-				return err
-			}
-			segmentInfo.SegmentState = querypb.SegmentState_sealed
-			newKey := fmt.Sprintf("%s/%d", queryNodeSegmentMetaPrefix, segmentID)
-			newValue, err := proto.Marshal(segmentInfo)
-			if err != nil {
-				deleteSegment(segment)
-				log.Warn("error when marshal segment info", zap.Error(err))
-				segmentGC()
-				return err
-			}
-			err = loader.etcdKV.Save(newKey, string(newValue))
-			if err != nil {
-				deleteSegment(segment)
-				log.Warn("error when update segment info to etcd", zap.Any("error", err.Error()))
-				segmentGC()
-				return err
+				return nil, err
 			}
 		}
 		newSegments = append(newSegments, segment)
 	}
 
-	return setSegments()
+	return newSegments, nil
+}
+
+// updateSegmentInfo marks the segment as sealed in etcd, query coord watches the change
+func (loader *segmentLoader) updateSegmentInfo(segmentID UniqueID) error {
+	key := fmt.Sprintf("%s/%d", queryCoordSegmentMetaPrefix, segmentID)
+	value, err := loader.etcdKV.Load(key)
+	if err != nil {
+		log.Warn("error when load segment info from etcd", zap.Any("error", err.Error()))
+		return err
+	}
+	segmentInfo := &querypb.SegmentInfo{}
+	err = proto.Unmarshal([]byte(value), segmentInfo)
+	if err != nil {
+		log.Warn("error when unmarshal segment info from etcd", zap.Any("error", err.Error()))
+		return err
+	}
+	segmentInfo.SegmentState = querypb.SegmentState_sealed
+	newKey := fmt.Sprintf("%s/%d", queryNodeSegmentMetaPrefix, segmentID)
+	newValue, err := proto.Marshal(segmentInfo)
+	if err != nil {
+		log.Warn("error when marshal segment info", zap.Error(err))
+		return err
+	}
+	err = loader.etcdKV.Save(newKey, string(newValue))
+	if err != nil {
+		log.Warn("error when update segment info to etcd", zap.Any("error", err.Error()))
+		return err
+	}
+	return nil
 }
 
 func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment *Segment, segmentLoadInfo *querypb.SegmentLoadInfo) error {
@@ -183,11 +208,18 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment
 	// we don't need to load raw data for indexed vector field
 	fieldBinlogs := loader.filterFieldBinlogs(segmentLoadInfo.BinlogPaths, indexedFieldIDs)
 
+	// the number of rows of a sealed segment is known, size the pk filter by it
+	segment.pkFilter = newPKFilter(uint(segmentLoadInfo.NumOfRows))
 	log.Debug("loading insert...")
 	err = loader.loadSegmentFieldsData(segment, fieldBinlogs)
 	if err != nil {
 		return err
 	}
+	log.Debug("loading delta...")
+	err = loader.loadSegmentDeltaLogs(segment, segmentLoadInfo.Deltalogs)
+	if err != nil {
+		return err
+	}
 	for _, id := range indexedFieldIDs {
 		log.Debug("loading index...")
 		err = loader.indexLoader.loadIndex(segment, id)
@@ -213,7 +245,7 @@ func (loader *segmentLoader) checkSegmentMemory(segmentLoadInfos []*querypb.Segm
 			return err
 		}
 
-		sizePerRecord, err := typeutil.EstimateSizePerRecord(col.schema)
+		sizePerRecord, err := typeutil.EstimateSizePerRecord(col.Schema())
 		if err != nil {
 			return err
 		}
@@ -270,6 +302,73 @@ func (loader *segmentLoader) filterFieldBinlogs(fieldBinlogs []*datapb.FieldBinl
 	return result
 }
 
+// loadSegmentDeltaLogs loads the delete records persisted in delta logs and applies them to the segment
+func (loader *segmentLoader) loadSegmentDeltaLogs(segment *Segment, deltaLogs []*datapb.DeltaLogInfo) error {
+	dCodec := storage.DeleteCodec{}
+	defer func() {
+		err := dCodec.Close()
+		if err != nil {
+			log.Warn(err.Error())
+		}
+	}()
+	stringPK, err := hasStringPrimaryKey(segment.collectionID, loader.historicalReplica)
+	if err != nil {
+		return err
+	}
+	for _, deltaLog := range deltaLogs {
+		log.Debug("load segment delta logs",
+			zap.Int64("segmentID", segment.segmentID),
+			zap.String("path", deltaLog.GetDeltaLogPath()),
+		)
+		value, err := loader.minioKV.Load(deltaLog.GetDeltaLogPath())
+		if err != nil {
+			return err
+		}
+		_, _, deleteData, err := dCodec.Deserialize(&storage.Blob{
+			Key:   deltaLog.GetDeltaLogPath(),
+			Value: []byte(value),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(deleteData.Data) == 0 {
+			continue
+		}
+		timestamps := make([]Timestamp, 0, len(deleteData.Data))
+		if stringPK {
+			pks := make([]string, 0, len(deleteData.Data))
+			for key, ts := range deleteData.Data {
+				pks = append(pks, key)
+				timestamps = append(timestamps, Timestamp(ts))
+			}
+			ids := &schemapb.IDs{
+				IdField: &schemapb.IDs_StrId{
+					StrId: &schemapb.StringArray{Data: pks},
+				},
+			}
+			offset := segment.segmentPreDelete(len(pks))
+			err = segment.segmentDeleteIDs(offset, ids, &timestamps)
+		} else {
+			pks := make([]int64, 0, len(deleteData.Data))
+			for key, ts := range deleteData.Data {
+				pk, err := strconv.ParseInt(key, 10, 64)
+				if err != nil {
+					return err
+				}
+				pks = append(pks, pk)
+				timestamps = append(timestamps, Timestamp(ts))
+			}
+			offset := segment.segmentPreDelete(len(pks))
+			err = segment.segmentDelete(offset, &pks, &timestamps)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlogs []*datapb.FieldBinlog) error {
 	iCodec := storage.InsertCodec{}
 	defer func() {
@@ -306,6 +405,26 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		return err
 	}
 
+	// the row id is used as primary key if there is no primary key field in schema
+	pkFieldID := int64(rootcoord.RowIDField)
+	collection, err := loader.historicalReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+	for _, field := range collection.Schema().Fields {
+		if field.IsPrimaryKey {
+			pkFieldID = field.FieldID
+			break
+		}
+	}
+
+	// segments flushed before a field was added to the collection have no binlog of that field,
+	// fill it with default values
+	err = storage.FillMissingFields(collection.Schema(), insertData)
+	if err != nil {
+		return err
+	}
+
 	for fieldID, value := range insertData.Data {
 		var numRows []int64
 		var data interface{}
@@ -325,15 +444,21 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 		case *storage.Int64FieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
+			if fieldID == pkFieldID {
+				segment.updateBloomFilter(fieldData.Data)
+			}
 		case *storage.FloatFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
 		case *storage.DoubleFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
-		case storage.StringFieldData:
+		case *storage.StringFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
+			if fieldID == pkFieldID {
+				segment.updateStringBloomFilter(fieldData.Data)
+			}
 		case *storage.FloatVectorFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
@@ -21,8 +21,11 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -327,6 +330,52 @@ func TestSegmentLoader_loadSegmentFieldsData(t *testing.T) {
 	})
 }
 
+func TestSegmentLoader_loadSegmentDeltaLogs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	historical, err := genSimpleHistorical(ctx)
+	assert.NoError(t, err)
+
+	segment, err := genSimpleSealedSegment()
+	assert.NoError(t, err)
+
+	dCodec := storage.NewDeleteCodec(&etcdpb.CollectionMeta{
+		ID: defaultCollectionID,
+	})
+	blob, err := dCodec.Serialize(defaultPartitionID, defaultSegmentID, &storage.DeleteData{
+		Data: map[string]int64{
+			"1": 100,
+			"2": 200,
+		},
+	})
+	assert.NoError(t, err)
+
+	kv, err := genMinioKV(ctx)
+	assert.NoError(t, err)
+	key := fmt.Sprintf("%s/%d", "delta-log-test", rand.Int())
+	err = kv.Save(key, string(blob.Value))
+	assert.NoError(t, err)
+
+	err = historical.loader.loadSegmentDeltaLogs(segment, []*datapb.DeltaLogInfo{
+		{
+			RecordEntries: 2,
+			TimestampFrom: 100,
+			TimestampTo:   200,
+			DeltaLogPath:  key,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), segment.getDeletedCount())
+
+	err = historical.loader.loadSegmentDeltaLogs(segment, []*datapb.DeltaLogInfo{
+		{
+			DeltaLogPath: "invalid-delta-log-path",
+		},
+	})
+	assert.Error(t, err)
+}
+
 func TestSegmentLoader_invalid(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -335,7 +384,7 @@ func TestSegmentLoader_invalid(t *testing.T) {
 		historical, err := genSimpleHistorical(ctx)
 		assert.NoError(t, err)
 
-		err = historical.loader.loadSegmentOfConditionHandOff(nil)
+		_, err = historical.loader.loadSegmentOfConditionHandOff(nil)
 		assert.Error(t, err)
 	})
 
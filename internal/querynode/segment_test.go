@@ -279,8 +279,7 @@ func TestSegment_getDeletedCount(t *testing.T) {
 	assert.NoError(t, err)
 
 	var deletedCount = segment.getDeletedCount()
-	// TODO: assert.Equal(t, deletedCount, len(ids))
-	assert.Equal(t, deletedCount, int64(0))
+	assert.Equal(t, int64(len(ids)), deletedCount)
 
 	deleteCollection(collection)
 
@@ -448,6 +447,48 @@ func TestSegment_segmentDelete(t *testing.T) {
 	err = segment.segmentDelete(offsetDelete, &ids, &timestamps)
 	assert.NoError(t, err)
 
+	pks, deletedTimestamps, err := segment.getDeletedRecords()
+	assert.NoError(t, err)
+	assert.Equal(t, ids, pks)
+	assert.Equal(t, timestamps, deletedTimestamps)
+
+	deleteCollection(collection)
+}
+
+func TestSegment_pkFilter(t *testing.T) {
+	collectionID := UniqueID(0)
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+
+	collection := newCollection(collectionMeta.ID, collectionMeta.Schema)
+	assert.Equal(t, collection.ID(), collectionID)
+
+	segmentID := UniqueID(0)
+	segment := newSegment(collection, segmentID, defaultPartitionID, collectionID, "", segmentTypeGrowing, true)
+	assert.Equal(t, segmentID, segment.segmentID)
+
+	segment.updateBloomFilter([]int64{1, 2, 3})
+	results, err := getSegmentsByPKs([]int64{1, 2, 3}, []*Segment{segment})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, results[segmentID])
+
+	// the filter grows once its capacity is reached
+	segment.pkFilter = newPKFilter(4)
+	pks := make([]int64, 0)
+	for pk := int64(0); pk < 100; pk++ {
+		pks = append(pks, pk)
+	}
+	segment.updateBloomFilter(pks)
+	assert.Greater(t, len(segment.pkFilter.filters), 1)
+	for _, pk := range pks {
+		assert.True(t, segment.pkFilter.test(pk))
+	}
+
+	segment.updateStringBloomFilter([]string{"a", "b,c"})
+	strResults, err := getSegmentsByStringPKs([]string{"a", "b,c"}, []*Segment{segment})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b,c"}, strResults[segmentID])
+
+	deleteSegment(segment)
 	deleteCollection(collection)
 }
 
@@ -35,10 +35,10 @@ type streaming struct {
 	msFactory       msgstream.Factory
 }
 
-func newStreaming(ctx context.Context, factory msgstream.Factory, etcdKV *etcdkv.EtcdKV) *streaming {
+func newStreaming(ctx context.Context, factory msgstream.Factory, etcdKV *etcdkv.EtcdKV, historicalReplica ReplicaInterface) *streaming {
 	replica := newCollectionReplica(etcdKV)
 	tReplica := newTSafeReplica()
-	newDS := newDataSyncService(ctx, replica, tReplica, factory)
+	newDS := newDataSyncService(ctx, replica, historicalReplica, tReplica, factory)
 
 	return &streaming{
 		replica:         replica,
@@ -383,7 +383,15 @@ func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 
 	switch l.req.LoadCondition {
 	case queryPb.TriggerCondition_handoff:
-		err = l.node.historical.loader.loadSegmentOfConditionHandOff(l.req)
+		var segments []*Segment
+		segments, err = l.node.historical.loader.loadSegmentOfConditionHandOff(l.req)
+		if err == nil {
+			var compactionFrom []UniqueID
+			for _, info := range l.req.Infos {
+				compactionFrom = append(compactionFrom, info.CompactionFrom...)
+			}
+			err = l.node.historical.handoffSegments(segments, compactionFrom, l.node.streaming.replica)
+		}
 	case queryPb.TriggerCondition_loadBalance:
 		err = l.node.historical.loader.loadSegmentOfConditionLoadBalance(l.req)
 	case queryPb.TriggerCondition_grpcRequest:
@@ -282,7 +282,7 @@ func TestTask_loadSegmentsTask(t *testing.T) {
 		}
 		task.req.LoadCondition = querypb.TriggerCondition_handoff
 		err = task.Execute(ctx)
-		assert.Error(t, err)
+		assert.NoError(t, err)
 	})
 }
 
@@ -14,12 +14,14 @@ package rootcoord
 import (
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -50,6 +52,15 @@ const (
 	// CollectionAliasMetaPrefix prefix for collection alias meta
 	CollectionAliasMetaPrefix = ComponentPrefix + "/collection-alias"
 
+	// DatabaseMetaPrefix prefix for database meta
+	DatabaseMetaPrefix = ComponentPrefix + "/database"
+
+	// CredentialMetaPrefix prefix for user credential meta
+	CredentialMetaPrefix = ComponentPrefix + "/credential"
+
+	// RoleMetaPrefix prefix for role meta
+	RoleMetaPrefix = ComponentPrefix + "/role"
+
 	// TimestampPrefix prefix for timestamp
 	TimestampPrefix = ComponentPrefix + "/timestamp"
 
@@ -79,6 +90,9 @@ const (
 
 	// AlterAliasDDType name of DD type for alter collection alias
 	AlterAliasDDType = "AlterAlias"
+
+	// AddFieldDDType name of DD type for add field
+	AddFieldDDType = "AddField"
 )
 
 // MetaTable store all rootcoord meta info
@@ -86,16 +100,20 @@ type MetaTable struct {
 	client          kv.SnapShotKV                                                   // client of a reliable kv service, i.e. etcd client
 	tenantID2Meta   map[typeutil.UniqueID]pb.TenantMeta                             // tenant id to tenant meta
 	proxyID2Meta    map[typeutil.UniqueID]pb.ProxyMeta                              // proxy id to proxy meta
+	dbName2Meta     map[string]pb.DatabaseInfo                                      // database name -> meta
 	collID2Meta     map[typeutil.UniqueID]pb.CollectionInfo                         // collection_id -> meta
-	collName2ID     map[string]typeutil.UniqueID                                    // collection name to collection id
-	collAlias2ID    map[string]typeutil.UniqueID                                    // collection alias to collection id
+	collName2ID     map[string]map[string]typeutil.UniqueID                         // database name -> collection name -> collection id
+	collAlias2ID    map[string]map[string]typeutil.UniqueID                         // database name -> collection alias -> collection id
 	partID2SegID    map[typeutil.UniqueID]map[typeutil.UniqueID]bool                // partition_id -> segment_id -> bool
 	segID2IndexMeta map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo // collection_id/index_id/partition_id/segment_id -> meta
 	indexID2Meta    map[typeutil.UniqueID]pb.IndexInfo                              // collection_id/index_id -> meta
+	username2Cred   map[string]pb.CredentialInfo                                    // username -> credential
+	roleName2Meta   map[string]pb.RoleInfo                                          // role name -> meta
 
 	tenantLock sync.RWMutex
 	proxyLock  sync.RWMutex
 	ddLock     sync.RWMutex
+	credLock   sync.RWMutex
 }
 
 // NewMetaTable create meta table for rootcoord, which stores all in-memory information
@@ -106,6 +124,7 @@ func NewMetaTable(kv kv.SnapShotKV) (*MetaTable, error) {
 		tenantLock: sync.RWMutex{},
 		proxyLock:  sync.RWMutex{},
 		ddLock:     sync.RWMutex{},
+		credLock:   sync.RWMutex{},
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -117,12 +136,15 @@ func NewMetaTable(kv kv.SnapShotKV) (*MetaTable, error) {
 func (mt *MetaTable) reloadFromKV() error {
 	mt.tenantID2Meta = make(map[typeutil.UniqueID]pb.TenantMeta)
 	mt.proxyID2Meta = make(map[typeutil.UniqueID]pb.ProxyMeta)
+	mt.dbName2Meta = make(map[string]pb.DatabaseInfo)
 	mt.collID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
-	mt.collName2ID = make(map[string]typeutil.UniqueID)
-	mt.collAlias2ID = make(map[string]typeutil.UniqueID)
+	mt.collName2ID = make(map[string]map[string]typeutil.UniqueID)
+	mt.collAlias2ID = make(map[string]map[string]typeutil.UniqueID)
 	mt.partID2SegID = make(map[typeutil.UniqueID]map[typeutil.UniqueID]bool)
 	mt.segID2IndexMeta = make(map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo)
 	mt.indexID2Meta = make(map[typeutil.UniqueID]pb.IndexInfo)
+	mt.username2Cred = make(map[string]pb.CredentialInfo)
+	mt.roleName2Meta = make(map[string]pb.RoleInfo)
 
 	_, values, err := mt.client.LoadWithPrefix(TenantMetaPrefix, 0)
 	if err != nil {
@@ -152,6 +174,23 @@ func (mt *MetaTable) reloadFromKV() error {
 		mt.proxyID2Meta[proxyMeta.ID] = proxyMeta
 	}
 
+	// the default database is never persisted, collections created before databases are supported belong to it
+	mt.unlockAddDatabase(pb.DatabaseInfo{Name: common.DefaultDatabaseName})
+
+	_, values, err = mt.client.LoadWithPrefix(DatabaseMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		dbInfo := pb.DatabaseInfo{}
+		err = proto.Unmarshal([]byte(value), &dbInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord Unmarshal pb.DatabaseInfo err:%w", err)
+		}
+		mt.unlockAddDatabase(dbInfo)
+	}
+
 	_, values, err = mt.client.LoadWithPrefix(CollectionMetaPrefix, 0)
 	if err != nil {
 		return err
@@ -163,8 +202,12 @@ func (mt *MetaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("RootCoord Unmarshal pb.CollectionInfo err:%w", err)
 		}
+		dbName := getDbName(collInfo.DbName)
+		if _, ok := mt.dbName2Meta[dbName]; !ok {
+			mt.unlockAddDatabase(pb.DatabaseInfo{Name: dbName})
+		}
 		mt.collID2Meta[collInfo.ID] = collInfo
-		mt.collName2ID[collInfo.Schema.Name] = collInfo.ID
+		mt.collName2ID[dbName][collInfo.Schema.Name] = collInfo.ID
 	}
 
 	_, values, err = mt.client.LoadWithPrefix(SegmentIndexMetaPrefix, 0)
@@ -222,13 +265,77 @@ func (mt *MetaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("RootCoord Unmarshal pb.AliasInfo err:%w", err)
 		}
-		mt.collAlias2ID[aliasInfo.Schema.Name] = aliasInfo.ID
+		dbName := getDbName(aliasInfo.DbName)
+		if _, ok := mt.dbName2Meta[dbName]; !ok {
+			mt.unlockAddDatabase(pb.DatabaseInfo{Name: dbName})
+		}
+		mt.collAlias2ID[dbName][aliasInfo.Schema.Name] = aliasInfo.ID
+	}
+
+	_, values, err = mt.client.LoadWithPrefix(CredentialMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		credInfo := pb.CredentialInfo{}
+		err = proto.Unmarshal([]byte(value), &credInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord Unmarshal pb.CredentialInfo err:%w", err)
+		}
+		mt.username2Cred[credInfo.Username] = credInfo
+	}
+
+	_, values, err = mt.client.LoadWithPrefix(RoleMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		roleInfo := pb.RoleInfo{}
+		err = proto.Unmarshal([]byte(value), &roleInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord Unmarshal pb.RoleInfo err:%w", err)
+		}
+		mt.roleName2Meta[roleInfo.Name] = roleInfo
 	}
 
 	log.Debug("reload meta table from KV successfully")
 	return nil
 }
 
+// getDbName return the database name used in meta table, empty name means the default database
+func getDbName(dbName string) string {
+	if dbName == "" {
+		return common.DefaultDatabaseName
+	}
+	return dbName
+}
+
+// aliasMetaKey return the kv key of a collection alias, aliases in the default database keep the key
+// used before databases are supported. Other aliases are keyed by the database id rather than the name,
+// since meta keys are removed by prefix and an alias never starts with a digit, caller should hold ddLock
+func (mt *MetaTable) aliasMetaKey(dbName, alias string) string {
+	if dbName == common.DefaultDatabaseName {
+		return fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias)
+	}
+	return fmt.Sprintf("%s/%d/%s", CollectionAliasMetaPrefix, mt.dbName2Meta[dbName].ID, alias)
+}
+
+func (mt *MetaTable) unlockAddDatabase(db pb.DatabaseInfo) {
+	mt.dbName2Meta[db.Name] = db
+	mt.collName2ID[db.Name] = make(map[string]typeutil.UniqueID)
+	mt.collAlias2ID[db.Name] = make(map[string]typeutil.UniqueID)
+}
+
+// getCollectionIDByName return collection id by collection name or alias in the database, caller should hold ddLock
+func (mt *MetaTable) getCollectionIDByName(dbName, collName string) (typeutil.UniqueID, bool) {
+	dbName = getDbName(dbName)
+	if collID, ok := mt.collName2ID[dbName][collName]; ok {
+		return collID, true
+	}
+	collID, ok := mt.collAlias2ID[dbName][collName]
+	return collID, ok
+}
+
 func (mt *MetaTable) getAdditionKV(op func(ts typeutil.Timestamp) (string, error), meta map[string]string) func(ts typeutil.Timestamp) (string, string, error) {
 	if op == nil {
 		return nil
@@ -295,7 +402,11 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 		(len(coll.PartitionIDs) != 1 && len(coll.PartitionIDs) != 0) {
 		return fmt.Errorf("PartitionIDs, PartitionNames and PartitionCreatedTimestmaps' length mis-match when creating collection")
 	}
-	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
+	coll.DbName = getDbName(coll.DbName)
+	if _, ok := mt.dbName2Meta[coll.DbName]; !ok {
+		return fmt.Errorf("database %s not exist", coll.DbName)
+	}
+	if _, ok := mt.collName2ID[coll.DbName][coll.Schema.Name]; ok {
 		return fmt.Errorf("collection %s exist", coll.Schema.Name)
 	}
 	if len(coll.FieldIndexes) != len(idx) {
@@ -327,7 +438,7 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 			coll.PartitionCreatedTimestamps[0] = ts
 		}
 		mt.collID2Meta[coll.ID] = *coll
-		mt.collName2ID[coll.Schema.Name] = coll.ID
+		mt.collName2ID[coll.DbName][coll.Schema.Name] = coll.ID
 		k1 := fmt.Sprintf("%s/%d", CollectionMetaPrefix, coll.ID)
 		v1, err := proto.Marshal(coll)
 		if err != nil {
@@ -358,8 +469,9 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 		return fmt.Errorf("can't find collection. id = %d", collID)
 	}
 
+	dbName := getDbName(collMeta.DbName)
 	delete(mt.collID2Meta, collID)
-	delete(mt.collName2ID, collMeta.Schema.Name)
+	delete(mt.collName2ID[dbName], collMeta.Schema.Name)
 
 	// update segID2IndexMeta
 	for partID := range collMeta.PartitionIDs {
@@ -385,7 +497,7 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 	var aliases []string
 	// delete collection aliases
-	for alias, cid := range mt.collAlias2ID {
+	for alias, cid := range mt.collAlias2ID[dbName] {
 		if cid == collID {
 			aliases = append(aliases, alias)
 		}
@@ -398,10 +510,8 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 
 	for _, alias := range aliases {
-		delete(mt.collAlias2ID, alias)
-		delMetakeys = append(delMetakeys,
-			fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias),
-		)
+		delete(mt.collAlias2ID[dbName], alias)
+		delMetakeys = append(delMetakeys, mt.aliasMetaKey(dbName, alias))
 	}
 
 	// save ddOpStr into etcd
@@ -455,17 +565,15 @@ func (mt *MetaTable) GetCollectionByID(collectionID typeutil.UniqueID, ts typeut
 	return &colMeta, nil
 }
 
-// GetCollectionByName return collection meta by collection name
-func (mt *MetaTable) GetCollectionByName(collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
+// GetCollectionByName return collection meta by collection name in the database
+func (mt *MetaTable) GetCollectionByName(dbName string, collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
 	if ts == 0 {
-		vid, ok := mt.collName2ID[collectionName]
+		vid, ok := mt.getCollectionIDByName(dbName, collectionName)
 		if !ok {
-			if vid, ok = mt.collAlias2ID[collectionName]; !ok {
-				return nil, fmt.Errorf("can't find collection: " + collectionName)
-			}
+			return nil, fmt.Errorf("can't find collection: " + collectionName)
 		}
 		col, ok := mt.collID2Meta[vid]
 		if !ok {
@@ -485,21 +593,26 @@ func (mt *MetaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 			continue
 		}
-		if collMeta.Schema.Name == collectionName {
+		if collMeta.Schema.Name == collectionName && getDbName(collMeta.DbName) == getDbName(dbName) {
 			return &collMeta, nil
 		}
 	}
 	return nil, fmt.Errorf("can't find collection: %s, at timestamp = %d", collectionName, ts)
 }
 
-// ListCollections list all collection names
-func (mt *MetaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
+// ListCollections list all collection names in the database
+func (mt *MetaTable) ListCollections(dbName string, ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	colls := make(map[string]*pb.CollectionInfo)
+	dbName = getDbName(dbName)
 
 	if ts == 0 {
-		for collName, collID := range mt.collName2ID {
+		collName2ID, ok := mt.collName2ID[dbName]
+		if !ok {
+			return nil, fmt.Errorf("database %s not exist", dbName)
+		}
+		for collName, collID := range collName2ID {
 			coll := mt.collID2Meta[collID]
 			colCopy := proto.Clone(&coll)
 			colls[collName] = colCopy.(*pb.CollectionInfo)
@@ -516,6 +629,10 @@ func (mt *MetaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.Coll
 		err := proto.Unmarshal([]byte(val), &collMeta)
 		if err != nil {
 			log.Debug("unmarshal collection info failed", zap.Error(err))
+			continue
+		}
+		if getDbName(collMeta.DbName) != dbName {
+			continue
 		}
 		colls[collMeta.Schema.Name] = &collMeta
 	}
@@ -527,7 +644,11 @@ func (mt *MetaTable) ListAliases(collID typeutil.UniqueID) []string {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	var aliases []string
-	for alias, cid := range mt.collAlias2ID {
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return aliases
+	}
+	for alias, cid := range mt.collAlias2ID[getDbName(collMeta.DbName)] {
 		if cid == collID {
 			aliases = append(aliases, alias)
 		}
@@ -625,6 +746,58 @@ func (mt *MetaTable) AddPartition(collID typeutil.UniqueID, partitionName string
 	return nil
 }
 
+// AddField append a field to the collection schema and bump the schema version
+func (mt *MetaTable) AddField(collID typeutil.UniqueID, field *schemapb.FieldSchema, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+	if coll.Schema == nil {
+		return fmt.Errorf("collection %d has no schema", collID)
+	}
+	for _, f := range coll.Schema.Fields {
+		if f.FieldID == field.FieldID {
+			return fmt.Errorf("field id = %d already exists", field.FieldID)
+		}
+		if f.Name == field.Name {
+			return fmt.Errorf("field name = %s already exists", field.Name)
+		}
+	}
+	meta := make(map[string]string)
+
+	// save ddOpStr into etcd
+	addition := mt.getAdditionKV(ddOpStr, meta)
+
+	saveColl := func(ts typeutil.Timestamp) (string, string, error) {
+		// don't modify the schema in place, it may be shared with the caller of AddCollection
+		schema := proto.Clone(coll.Schema).(*schemapb.CollectionSchema)
+		schema.Fields = append(schema.Fields, field)
+		coll.Schema = schema
+		coll.SchemaVersion++
+		mt.collID2Meta[collID] = coll
+
+		k1 := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+		v1, err := proto.Marshal(&coll)
+		if err != nil {
+			log.Error("MetaTable AddField saveColl Marshal fail",
+				zap.String("key", k1), zap.Error(err))
+			return "", "", fmt.Errorf("MetaTable AddField saveColl Marshal fail, k1:%s, err:%w", k1, err)
+		}
+		meta[k1] = string(v1)
+
+		return k1, string(v1), nil
+	}
+
+	err := mt.client.MultiSave(meta, ts, addition, saveColl)
+	if err != nil {
+		log.Error("SnapShotKV MultiSave fail", zap.Error(err))
+		panic("SnapShotKV MultiSave fail")
+	}
+	return nil
+}
+
 // GetPartitionNameByID return partition name by partition id
 func (mt *MetaTable) GetPartitionNameByID(collID, partitionID typeutil.UniqueID, ts typeutil.Timestamp) (string, error) {
 	if ts == 0 {
@@ -838,22 +1011,19 @@ func (mt *MetaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo, ts typeutil.Times
 }
 
 // DropIndex drop index
-func (mt *MetaTable) DropIndex(collName, fieldName, indexName string, ts typeutil.Timestamp) (typeutil.UniqueID, bool, error) {
+func (mt *MetaTable) DropIndex(dbName, collName, fieldName, indexName string, ts typeutil.Timestamp) (typeutil.UniqueID, bool, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.getCollectionIDByName(dbName, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return 0, false, fmt.Errorf("collection name = %s not exist", collName)
-		}
+		return 0, false, fmt.Errorf("collection name = %s not exist", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
 		return 0, false, fmt.Errorf("collection name  = %s not has meta", collName)
 	}
-	fieldSch, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSch, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return 0, false, err
 	}
@@ -964,20 +1134,17 @@ func (mt *MetaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, filedID in
 }
 
 // GetFieldSchema return field schema
-func (mt *MetaTable) GetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
+func (mt *MetaTable) GetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	return mt.unlockGetFieldSchema(collName, fieldName)
+	return mt.unlockGetFieldSchema(dbName, collName, fieldName)
 }
 
-func (mt *MetaTable) unlockGetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
-	collID, ok := mt.collName2ID[collName]
+func (mt *MetaTable) unlockGetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
+	collID, ok := mt.getCollectionIDByName(dbName, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
-		}
+		return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1022,25 +1189,22 @@ func (mt *MetaTable) unlockIsSegmentIndexed(segID typeutil.UniqueID, fieldSchema
 }
 
 // GetNotIndexedSegments return segment ids which have no index
-func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID, ts typeutil.Timestamp) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
+func (mt *MetaTable) GetNotIndexedSegments(dbName string, collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID, ts typeutil.Timestamp) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	if idxInfo.IndexParams == nil {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("index param is nil")
 	}
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.getCollectionIDByName(dbName, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
-		}
+		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
-	fieldSchema, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSchema, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return nil, fieldSchema, err
 	}
@@ -1157,16 +1321,13 @@ func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, id
 }
 
 // GetIndexByName return index info by index name
-func (mt *MetaTable) GetIndexByName(collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
+func (mt *MetaTable) GetIndexByName(dbName, collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.getCollectionIDByName(dbName, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
-		}
+		return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1225,29 +1386,33 @@ func (mt *MetaTable) dupMeta() (
 }
 
 // AddAlias add collection alias
-func (mt *MetaTable) AddAlias(collectionAlias string, collectionName string,
+func (mt *MetaTable) AddAlias(dbName string, collectionAlias string, collectionName string,
 	ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; ok {
+	dbName = getDbName(dbName)
+	if _, ok := mt.dbName2Meta[dbName]; !ok {
+		return fmt.Errorf("database %s not exist", dbName)
+	}
+	if _, ok := mt.collAlias2ID[dbName][collectionAlias]; ok {
 		return fmt.Errorf("duplicate collection alias, alias = %s", collectionAlias)
 	}
 
-	if _, ok := mt.collName2ID[collectionAlias]; ok {
+	if _, ok := mt.collName2ID[dbName][collectionAlias]; ok {
 		return fmt.Errorf("collection alias collides with existing collection name. collection = %s, alias = %s", collectionAlias, collectionAlias)
 	}
 
-	id, ok := mt.collName2ID[collectionName]
+	id, ok := mt.collName2ID[dbName][collectionName]
 	if !ok {
 		return fmt.Errorf("aliased collection name does not exist, name = %s", collectionName)
 	}
-	mt.collAlias2ID[collectionAlias] = id
+	mt.collAlias2ID[dbName][collectionAlias] = id
 
 	meta := make(map[string]string)
 	addition := mt.getAdditionKV(ddOpStr, meta)
 	saveAlias := func(ts typeutil.Timestamp) (string, string, error) {
-		k1 := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias)
-		v1, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}})
+		k1 := mt.aliasMetaKey(dbName, collectionAlias)
+		v1, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}, DbName: dbName})
 		if err != nil {
 			log.Error("MetaTable AddAlias saveAlias Marshal CollectionInfo fail",
 				zap.String("key", k1), zap.Error(err))
@@ -1266,16 +1431,17 @@ func (mt *MetaTable) AddAlias(collectionAlias string, collectionName string,
 }
 
 // DeleteAlias delete collection alias
-func (mt *MetaTable) DeleteAlias(collectionAlias string, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+func (mt *MetaTable) DeleteAlias(dbName string, collectionAlias string, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; !ok {
+	dbName = getDbName(dbName)
+	if _, ok := mt.collAlias2ID[dbName][collectionAlias]; !ok {
 		return fmt.Errorf("alias does not exist, alias = %s", collectionAlias)
 	}
-	delete(mt.collAlias2ID, collectionAlias)
+	delete(mt.collAlias2ID[dbName], collectionAlias)
 
 	delMetakeys := []string{
-		fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias),
+		mt.aliasMetaKey(dbName, collectionAlias),
 	}
 	meta := make(map[string]string)
 	addition := mt.getAdditionKV(ddOpStr, meta)
@@ -1288,23 +1454,24 @@ func (mt *MetaTable) DeleteAlias(collectionAlias string, ts typeutil.Timestamp,
 }
 
 // AlterAlias alter collection alias
-func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
+func (mt *MetaTable) AlterAlias(dbName string, collectionAlias string, collectionName string, ts typeutil.Timestamp, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; !ok {
+	dbName = getDbName(dbName)
+	if _, ok := mt.collAlias2ID[dbName][collectionAlias]; !ok {
 		return fmt.Errorf("alias does not exist, alias = %s", collectionAlias)
 	}
 
-	id, ok := mt.collName2ID[collectionName]
+	id, ok := mt.collName2ID[dbName][collectionName]
 	if !ok {
 		return fmt.Errorf("aliased collection name does not exist, name = %s", collectionName)
 	}
-	mt.collAlias2ID[collectionAlias] = id
+	mt.collAlias2ID[dbName][collectionAlias] = id
 	meta := make(map[string]string)
 	addition := mt.getAdditionKV(ddOpStr, meta)
 	alterAlias := func(ts typeutil.Timestamp) (string, string, error) {
-		k1 := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias)
-		v1, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}})
+		k1 := mt.aliasMetaKey(dbName, collectionAlias)
+		v1, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}, DbName: dbName})
 		if err != nil {
 			log.Error("MetaTable AlterAlias alterAlias Marshal CollectionInfo fail",
 				zap.String("key", k1), zap.Error(err))
@@ -1321,3 +1488,351 @@ func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, t
 	}
 	return nil
 }
+
+// AddDatabase add database
+func (mt *MetaTable) AddDatabase(db *pb.DatabaseInfo, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	if _, ok := mt.dbName2Meta[db.Name]; ok {
+		return fmt.Errorf("database %s exist", db.Name)
+	}
+
+	db.CreateTime = ts
+	k := fmt.Sprintf("%s/%d", DatabaseMetaPrefix, db.ID)
+	v, err := proto.Marshal(db)
+	if err != nil {
+		log.Error("MetaTable AddDatabase Marshal fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("MetaTable AddDatabase Marshal fail key:%s, err:%w", k, err)
+	}
+
+	err = mt.client.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.unlockAddDatabase(*db)
+	return nil
+}
+
+// DeleteDatabase delete database, the database should be empty and can't be the default database
+func (mt *MetaTable) DeleteDatabase(dbName string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	if dbName == common.DefaultDatabaseName {
+		return fmt.Errorf("can't drop the default database")
+	}
+	if _, ok := mt.dbName2Meta[dbName]; !ok {
+		return fmt.Errorf("database %s not exist", dbName)
+	}
+	if len(mt.collName2ID[dbName]) != 0 {
+		return fmt.Errorf("database %s is not empty, has %d collections", dbName, len(mt.collName2ID[dbName]))
+	}
+
+	delMetakeys := []string{
+		fmt.Sprintf("%s/%d", DatabaseMetaPrefix, mt.dbName2Meta[dbName].ID),
+	}
+	delete(mt.dbName2Meta, dbName)
+	delete(mt.collName2ID, dbName)
+	delete(mt.collAlias2ID, dbName)
+
+	err := mt.client.MultiSaveAndRemoveWithPrefix(map[string]string{}, delMetakeys, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	return nil
+}
+
+// ListDatabases list all databases, including the default database
+func (mt *MetaTable) ListDatabases() []*pb.DatabaseInfo {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	dbs := make([]*pb.DatabaseInfo, 0, len(mt.dbName2Meta))
+	for _, db := range mt.dbName2Meta {
+		dbCopy := proto.Clone(&db)
+		dbs = append(dbs, dbCopy.(*pb.DatabaseInfo))
+	}
+	sort.Slice(dbs, func(i, j int) bool { return dbs[i].Name < dbs[j].Name })
+	return dbs
+}
+
+func (mt *MetaTable) marshalCredential(cred *pb.CredentialInfo) (string, string, error) {
+	k := fmt.Sprintf("%s/%d", CredentialMetaPrefix, cred.ID)
+	v, err := proto.Marshal(cred)
+	if err != nil {
+		log.Error("MetaTable marshal CredentialInfo fail", zap.String("key", k), zap.Error(err))
+		return "", "", fmt.Errorf("MetaTable marshal CredentialInfo fail key:%s, err:%w", k, err)
+	}
+	return k, string(v), nil
+}
+
+func (mt *MetaTable) marshalRole(role *pb.RoleInfo) (string, string, error) {
+	k := fmt.Sprintf("%s/%d", RoleMetaPrefix, role.ID)
+	v, err := proto.Marshal(role)
+	if err != nil {
+		log.Error("MetaTable marshal RoleInfo fail", zap.String("key", k), zap.Error(err))
+		return "", "", fmt.Errorf("MetaTable marshal RoleInfo fail key:%s, err:%w", k, err)
+	}
+	return k, string(v), nil
+}
+
+// unlockSaveCredential persist the credential and update the in-memory meta, caller should hold credLock
+func (mt *MetaTable) unlockSaveCredential(cred *pb.CredentialInfo, ts typeutil.Timestamp) error {
+	k, v, err := mt.marshalCredential(cred)
+	if err != nil {
+		return err
+	}
+	err = mt.client.Save(k, v, ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.username2Cred[cred.Username] = *cred
+	return nil
+}
+
+// unlockSaveRole persist the role and update the in-memory meta, caller should hold credLock
+func (mt *MetaTable) unlockSaveRole(role *pb.RoleInfo, ts typeutil.Timestamp) error {
+	k, v, err := mt.marshalRole(role)
+	if err != nil {
+		return err
+	}
+	err = mt.client.Save(k, v, ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.roleName2Meta[role.Name] = *role
+	return nil
+}
+
+// AddCredential add a user, the password should have been encrypted
+func (mt *MetaTable) AddCredential(cred *pb.CredentialInfo, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+	if _, ok := mt.username2Cred[cred.Username]; ok {
+		return fmt.Errorf("user %s exist", cred.Username)
+	}
+	cred.CreateTime = ts
+	return mt.unlockSaveCredential(cred, ts)
+}
+
+// UpdateCredential update the encrypted password of a user
+func (mt *MetaTable) UpdateCredential(username string, encryptedPassword string, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+	cred, ok := mt.username2Cred[username]
+	if !ok {
+		return fmt.Errorf("user %s not exist", username)
+	}
+	cred.EncryptedPassword = encryptedPassword
+	return mt.unlockSaveCredential(&cred, ts)
+}
+
+// DeleteCredential delete a user
+func (mt *MetaTable) DeleteCredential(username string, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+	cred, ok := mt.username2Cred[username]
+	if !ok {
+		return fmt.Errorf("user %s not exist", username)
+	}
+
+	delMetakeys := []string{
+		fmt.Sprintf("%s/%d", CredentialMetaPrefix, cred.ID),
+	}
+	err := mt.client.MultiSaveAndRemoveWithPrefix(map[string]string{}, delMetakeys, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	delete(mt.username2Cred, username)
+	return nil
+}
+
+// GetCredential return the credential of a user
+func (mt *MetaTable) GetCredential(username string) (*pb.CredentialInfo, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+	cred, ok := mt.username2Cred[username]
+	if !ok {
+		return nil, fmt.Errorf("user %s not exist", username)
+	}
+	return proto.Clone(&cred).(*pb.CredentialInfo), nil
+}
+
+// ListCredUsernames list the names of all users
+func (mt *MetaTable) ListCredUsernames() []string {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+	usernames := make([]string, 0, len(mt.username2Cred))
+	for username := range mt.username2Cred {
+		usernames = append(usernames, username)
+	}
+	sort.Strings(usernames)
+	return usernames
+}
+
+// AddRole add a role without any privilege
+func (mt *MetaTable) AddRole(role *pb.RoleInfo, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+	if _, ok := mt.roleName2Meta[role.Name]; ok {
+		return fmt.Errorf("role %s exist", role.Name)
+	}
+	role.CreateTime = ts
+	return mt.unlockSaveRole(role, ts)
+}
+
+// DeleteRole delete a role, the role is also removed from the users it's granted to
+func (mt *MetaTable) DeleteRole(roleName string, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+	role, ok := mt.roleName2Meta[roleName]
+	if !ok {
+		return fmt.Errorf("role %s not exist", roleName)
+	}
+
+	saveMeta := make(map[string]string)
+	updatedCreds := make([]pb.CredentialInfo, 0)
+	for _, cred := range mt.username2Cred {
+		roles := make([]string, 0, len(cred.Roles))
+		for _, r := range cred.Roles {
+			if r != roleName {
+				roles = append(roles, r)
+			}
+		}
+		if len(roles) == len(cred.Roles) {
+			continue
+		}
+		cred.Roles = roles
+		k, v, err := mt.marshalCredential(&cred)
+		if err != nil {
+			return err
+		}
+		saveMeta[k] = v
+		updatedCreds = append(updatedCreds, cred)
+	}
+
+	delMetakeys := []string{
+		fmt.Sprintf("%s/%d", RoleMetaPrefix, role.ID),
+	}
+	err := mt.client.MultiSaveAndRemoveWithPrefix(saveMeta, delMetakeys, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	for _, cred := range updatedCreds {
+		mt.username2Cred[cred.Username] = cred
+	}
+	delete(mt.roleName2Meta, roleName)
+	return nil
+}
+
+// ListRoles list all roles
+func (mt *MetaTable) ListRoles() []*pb.RoleInfo {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+	roles := make([]*pb.RoleInfo, 0, len(mt.roleName2Meta))
+	for _, role := range mt.roleName2Meta {
+		roleCopy := proto.Clone(&role)
+		roles = append(roles, roleCopy.(*pb.RoleInfo))
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+	return roles
+}
+
+// AddUserToRole grant the role to a user
+func (mt *MetaTable) AddUserToRole(username string, roleName string, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+	cred, ok := mt.username2Cred[username]
+	if !ok {
+		return fmt.Errorf("user %s not exist", username)
+	}
+	if _, ok := mt.roleName2Meta[roleName]; !ok {
+		return fmt.Errorf("role %s not exist", roleName)
+	}
+	for _, r := range cred.Roles {
+		if r == roleName {
+			return fmt.Errorf("user %s already has role %s", username, roleName)
+		}
+	}
+	cred.Roles = append(append([]string{}, cred.Roles...), roleName)
+	return mt.unlockSaveCredential(&cred, ts)
+}
+
+// RemoveUserFromRole revoke the role from a user
+func (mt *MetaTable) RemoveUserFromRole(username string, roleName string, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+	cred, ok := mt.username2Cred[username]
+	if !ok {
+		return fmt.Errorf("user %s not exist", username)
+	}
+	roles := make([]string, 0, len(cred.Roles))
+	for _, r := range cred.Roles {
+		if r != roleName {
+			roles = append(roles, r)
+		}
+	}
+	if len(roles) == len(cred.Roles) {
+		return fmt.Errorf("user %s doesn't have role %s", username, roleName)
+	}
+	cred.Roles = roles
+	return mt.unlockSaveCredential(&cred, ts)
+}
+
+func isSameGrant(g1, g2 *pb.GrantInfo) bool {
+	return getDbName(g1.DbName) == getDbName(g2.DbName) && g1.ObjectName == g2.ObjectName && g1.Privilege == g2.Privilege
+}
+
+// GrantPrivilege grant a privilege on an object to the role
+func (mt *MetaTable) GrantPrivilege(roleName string, grant *pb.GrantInfo, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+	role, ok := mt.roleName2Meta[roleName]
+	if !ok {
+		return fmt.Errorf("role %s not exist", roleName)
+	}
+	for _, g := range role.Grants {
+		if isSameGrant(g, grant) {
+			return fmt.Errorf("role %s already has privilege %s on %s", roleName, grant.Privilege, grant.ObjectName)
+		}
+	}
+	grant.DbName = getDbName(grant.DbName)
+	role.Grants = append(append([]*pb.GrantInfo{}, role.Grants...), grant)
+	return mt.unlockSaveRole(&role, ts)
+}
+
+// RevokePrivilege revoke a privilege on an object from the role
+func (mt *MetaTable) RevokePrivilege(roleName string, grant *pb.GrantInfo, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+	role, ok := mt.roleName2Meta[roleName]
+	if !ok {
+		return fmt.Errorf("role %s not exist", roleName)
+	}
+	grants := make([]*pb.GrantInfo, 0, len(role.Grants))
+	for _, g := range role.Grants {
+		if !isSameGrant(g, grant) {
+			grants = append(grants, g)
+		}
+	}
+	if len(grants) == len(role.Grants) {
+		return fmt.Errorf("role %s doesn't have privilege %s on %s", roleName, grant.Privilege, grant.ObjectName)
+	}
+	role.Grants = grants
+	return mt.unlockSaveRole(&role, ts)
+}
+
+// GetRole return the role with its grants
+func (mt *MetaTable) GetRole(roleName string) (*pb.RoleInfo, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+	role, ok := mt.roleName2Meta[roleName]
+	if !ok {
+		return nil, fmt.Errorf("role %s not exist", roleName)
+	}
+	return proto.Clone(&role).(*pb.RoleInfo), nil
+}
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -262,7 +263,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, uint64(1), ts)
 
-		collMeta, err := mt.GetCollectionByName("testColl", 0)
+		collMeta, err := mt.GetCollectionByName("", "testColl", 0)
 		assert.Nil(t, err)
 		assert.Equal(t, collMeta.CreateTime, ts)
 		assert.Equal(t, collMeta.PartitionCreatedTimestamps[0], ts)
@@ -271,7 +272,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		assert.True(t, mt.HasCollection(collInfo.ID, 0))
 
-		field, err := mt.GetFieldSchema("testColl", "field110")
+		field, err := mt.GetFieldSchema("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, collInfo.Schema.Fields[0].FieldID, field.FieldID)
 
@@ -283,7 +284,7 @@ func TestMetaTable(t *testing.T) {
 
 	t.Run("add alias", func(t *testing.T) {
 		ts := ftso()
-		err = mt.AddAlias("alias1", "testColl", ts, ddOp)
+		err = mt.AddAlias("", "alias1", "testColl", ts, ddOp)
 		assert.Nil(t, err)
 		aliases := mt.ListAliases(collID)
 		assert.Equal(t, aliases, []string{"alias1"})
@@ -291,13 +292,13 @@ func TestMetaTable(t *testing.T) {
 
 	t.Run("alter alias", func(t *testing.T) {
 		ts := ftso()
-		err = mt.AlterAlias("alias1", "testColl", ts, ddOp)
+		err = mt.AlterAlias("", "alias1", "testColl", ts, ddOp)
 		assert.Nil(t, err)
 	})
 
 	t.Run("delete alias", func(t *testing.T) {
 		ts := ftso()
-		err = mt.DeleteAlias("alias1", ts, ddOp)
+		err = mt.DeleteAlias("", "alias1", ts, ddOp)
 		assert.Nil(t, err)
 	})
 
@@ -319,6 +320,39 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, "false", flag)
 	})
 
+	t.Run("add field", func(t *testing.T) {
+		ts := ftso()
+		field := &schemapb.FieldSchema{
+			FieldID:  fieldID2,
+			Name:     "field111",
+			DataType: schemapb.DataType_Int64,
+		}
+		err = mt.AddField(collID, field, ts, ddOp)
+		assert.Nil(t, err)
+
+		collMeta, err := mt.GetCollectionByID(collID, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), collMeta.SchemaVersion)
+		assert.Equal(t, 2, len(collMeta.Schema.Fields))
+		assert.Equal(t, "field111", collMeta.Schema.Fields[1].Name)
+
+		// schema before the field is added
+		collMeta, err = mt.GetCollectionByID(collID, ts-1)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), collMeta.SchemaVersion)
+		assert.Equal(t, 1, len(collMeta.Schema.Fields))
+
+		err = mt.AddField(collID, field, ftso(), ddOp)
+		assert.NotNil(t, err)
+		err = mt.AddField(collIDInvalid, field, ftso(), ddOp)
+		assert.NotNil(t, err)
+
+		// check DD operation flag
+		flag, err := mt.client.Load(DDMsgSendPrefix, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, "false", flag)
+	})
+
 	t.Run("add segment index", func(t *testing.T) {
 		segIdxInfo := pb.SegmentIndexInfo{
 			CollectionID: collID,
@@ -369,9 +403,9 @@ func TestMetaTable(t *testing.T) {
 			IndexParams: params,
 		}
 
-		_, _, err := mt.GetNotIndexedSegments("collTest", "field110", idxInfo, nil, 0)
+		_, _, err := mt.GetNotIndexedSegments("", "collTest", "field110", idxInfo, nil, 0)
 		assert.NotNil(t, err)
-		seg, field, err := mt.GetNotIndexedSegments("testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2}, 0)
+		seg, field, err := mt.GetNotIndexedSegments("", "testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2}, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(seg))
 		assert.Equal(t, segID2, seg[0])
@@ -387,7 +421,7 @@ func TestMetaTable(t *testing.T) {
 		idxInfo.IndexID = 2001
 		idxInfo.IndexName = "field110-1"
 
-		seg, field, err = mt.GetNotIndexedSegments("testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2}, 0)
+		seg, field, err = mt.GetNotIndexedSegments("", "testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2}, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(seg))
 		assert.Equal(t, segID, seg[0])
@@ -397,7 +431,7 @@ func TestMetaTable(t *testing.T) {
 	})
 
 	t.Run("get index by name", func(t *testing.T) {
-		_, idx, err := mt.GetIndexByName("testColl", "field110")
+		_, idx, err := mt.GetIndexByName("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 		assert.Equal(t, indexID, idx[0].IndexID)
@@ -413,7 +447,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		assert.True(t, EqualKeyPairArray(idx[0].IndexParams, params))
 
-		_, idx, err = mt.GetIndexByName("testColl", "idx201")
+		_, idx, err = mt.GetIndexByName("", "testColl", "idx201")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idx))
 	})
@@ -435,20 +469,20 @@ func TestMetaTable(t *testing.T) {
 	})
 
 	t.Run("drop index", func(t *testing.T) {
-		idx, ok, err := mt.DropIndex("testColl", "field110", "field110", 0)
+		idx, ok, err := mt.DropIndex("", "testColl", "field110", "field110", 0)
 		assert.Nil(t, err)
 		assert.True(t, ok)
 		assert.Equal(t, indexID, idx)
 
-		_, ok, err = mt.DropIndex("testColl", "field110", "field110-error", 0)
+		_, ok, err = mt.DropIndex("", "testColl", "field110", "field110-error", 0)
 		assert.Nil(t, err)
 		assert.False(t, ok)
 
-		_, idxs, err := mt.GetIndexByName("testColl", "field110")
+		_, idxs, err := mt.GetIndexByName("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idxs))
 
-		_, idxs, err = mt.GetIndexByName("testColl", "field110-1")
+		_, idxs, err = mt.GetIndexByName("", "testColl", "field110-1")
 		assert.Nil(t, err)
 		assert.Equal(t, len(idxs), 1)
 		assert.Equal(t, idxs[0].IndexID, int64(2001))
@@ -474,12 +508,12 @@ func TestMetaTable(t *testing.T) {
 		err = mt.DeleteCollection(collIDInvalid, ts, nil)
 		assert.NotNil(t, err)
 		ts2 := ftso()
-		err = mt.AddAlias("alias1", "testColl", ts2, ddOp)
+		err = mt.AddAlias("", "alias1", "testColl", ts2, ddOp)
 		assert.Nil(t, err)
 		err = mt.DeleteCollection(collID, ts, nil)
 		assert.Nil(t, err)
 		ts3 := ftso()
-		err = mt.DeleteAlias("alias1", ts3, ddOp)
+		err = mt.DeleteAlias("", "alias1", ts3, ddOp)
 		assert.NotNil(t, err)
 
 		// check DD operation flag
@@ -488,6 +522,165 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, "false", flag)
 	})
 
+	t.Run("database", func(t *testing.T) {
+		const dbName = "testDb"
+		err = mt.AddDatabase(&pb.DatabaseInfo{ID: 1000, Name: dbName}, ftso())
+		assert.Nil(t, err)
+		err = mt.AddDatabase(&pb.DatabaseInfo{ID: 1001, Name: dbName}, ftso())
+		assert.NotNil(t, err)
+
+		dbs := mt.ListDatabases()
+		assert.Equal(t, 2, len(dbs))
+		assert.Equal(t, common.DefaultDatabaseName, dbs[0].Name)
+		assert.Equal(t, dbName, dbs[1].Name)
+
+		coll := proto.Clone(collInfo).(*pb.CollectionInfo)
+		coll.ID = collIDInvalid
+		coll.DbName = dbName
+		coll.FieldIndexes = nil
+		err = mt.AddCollection(coll, ftso(), nil, ddOp)
+		assert.Nil(t, err)
+		err = mt.AddAlias(dbName, "alias1", collName, ftso(), ddOp)
+		assert.Nil(t, err)
+
+		_, err = mt.GetCollectionByName("", collName, 0)
+		assert.NotNil(t, err)
+		_, err = mt.GetCollectionByName("", "alias1", 0)
+		assert.NotNil(t, err)
+		collMeta, err := mt.GetCollectionByName(dbName, "alias1", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collIDInvalid, collMeta.ID)
+		colls, err := mt.ListCollections(dbName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(colls))
+		colls, err = mt.ListCollections("", 0)
+		assert.Nil(t, err)
+		assert.Zero(t, len(colls))
+
+		mt2, err := NewMetaTable(skv)
+		assert.Nil(t, err)
+		collMeta, err = mt2.GetCollectionByName(dbName, "alias1", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collIDInvalid, collMeta.ID)
+
+		err = mt.DeleteDatabase(common.DefaultDatabaseName, ftso())
+		assert.NotNil(t, err)
+		err = mt.DeleteDatabase(dbName, ftso())
+		assert.NotNil(t, err)
+		err = mt.DeleteCollection(collIDInvalid, ftso(), nil)
+		assert.Nil(t, err)
+		err = mt.DeleteDatabase(dbName, ftso())
+		assert.Nil(t, err)
+		err = mt.DeleteDatabase(dbName, ftso())
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, len(mt.ListDatabases()))
+
+		// meta keys are removed by prefix, dropping a database shouldn't affect the database with a longer name
+		err = mt.AddDatabase(&pb.DatabaseInfo{ID: 1002, Name: dbName}, ftso())
+		assert.Nil(t, err)
+		err = mt.AddDatabase(&pb.DatabaseInfo{ID: 1003, Name: dbName + "1"}, ftso())
+		assert.Nil(t, err)
+		coll.DbName = dbName + "1"
+		err = mt.AddCollection(coll, ftso(), nil, ddOp)
+		assert.Nil(t, err)
+		err = mt.AddAlias(dbName+"1", "alias1", collName, ftso(), ddOp)
+		assert.Nil(t, err)
+		err = mt.DeleteDatabase(dbName, ftso())
+		assert.Nil(t, err)
+
+		mt2, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(mt2.ListDatabases()))
+		collMeta, err = mt2.GetCollectionByName(dbName+"1", "alias1", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collIDInvalid, collMeta.ID)
+		err = mt.DeleteCollection(collIDInvalid, ftso(), nil)
+		assert.Nil(t, err)
+		err = mt.DeleteDatabase(dbName+"1", ftso())
+		assert.Nil(t, err)
+	})
+
+	t.Run("credential and role", func(t *testing.T) {
+		const username = "testUser"
+		const roleName = "testRole"
+		err = mt.AddCredential(&pb.CredentialInfo{ID: 2000, Username: username, EncryptedPassword: "pwd"}, ftso())
+		assert.Nil(t, err)
+		err = mt.AddCredential(&pb.CredentialInfo{ID: 2001, Username: username, EncryptedPassword: "pwd"}, ftso())
+		assert.NotNil(t, err)
+		err = mt.UpdateCredential(username, "pwd1", ftso())
+		assert.Nil(t, err)
+		err = mt.UpdateCredential("notExist", "pwd1", ftso())
+		assert.NotNil(t, err)
+		cred, err := mt.GetCredential(username)
+		assert.Nil(t, err)
+		assert.Equal(t, "pwd1", cred.EncryptedPassword)
+		_, err = mt.GetCredential("notExist")
+		assert.NotNil(t, err)
+		assert.Equal(t, []string{username}, mt.ListCredUsernames())
+
+		err = mt.AddUserToRole(username, roleName, ftso())
+		assert.NotNil(t, err)
+		err = mt.AddRole(&pb.RoleInfo{ID: 2002, Name: roleName}, ftso())
+		assert.Nil(t, err)
+		err = mt.AddRole(&pb.RoleInfo{ID: 2003, Name: roleName}, ftso())
+		assert.NotNil(t, err)
+		err = mt.AddUserToRole(username, roleName, ftso())
+		assert.Nil(t, err)
+		err = mt.AddUserToRole(username, roleName, ftso())
+		assert.NotNil(t, err)
+
+		grant := &pb.GrantInfo{ObjectName: collName, Privilege: "Search"}
+		err = mt.GrantPrivilege(roleName, grant, ftso())
+		assert.Nil(t, err)
+		err = mt.GrantPrivilege(roleName, &pb.GrantInfo{DbName: common.DefaultDatabaseName, ObjectName: collName, Privilege: "Search"}, ftso())
+		assert.NotNil(t, err)
+		err = mt.GrantPrivilege("notExist", grant, ftso())
+		assert.NotNil(t, err)
+		role, err := mt.GetRole(roleName)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(role.Grants))
+		assert.Equal(t, common.DefaultDatabaseName, role.Grants[0].DbName)
+		assert.Equal(t, 1, len(mt.ListRoles()))
+
+		mt2, err := NewMetaTable(skv)
+		assert.Nil(t, err)
+		cred, err = mt2.GetCredential(username)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{roleName}, cred.Roles)
+		role, err = mt2.GetRole(roleName)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(role.Grants))
+
+		err = mt.RevokePrivilege(roleName, grant, ftso())
+		assert.Nil(t, err)
+		err = mt.RevokePrivilege(roleName, grant, ftso())
+		assert.NotNil(t, err)
+		err = mt.RemoveUserFromRole(username, roleName, ftso())
+		assert.Nil(t, err)
+		err = mt.RemoveUserFromRole(username, roleName, ftso())
+		assert.NotNil(t, err)
+
+		err = mt.AddUserToRole(username, roleName, ftso())
+		assert.Nil(t, err)
+		err = mt.DeleteRole(roleName, ftso())
+		assert.Nil(t, err)
+		err = mt.DeleteRole(roleName, ftso())
+		assert.NotNil(t, err)
+		cred, err = mt.GetCredential(username)
+		assert.Nil(t, err)
+		assert.Zero(t, len(cred.Roles))
+
+		err = mt.DeleteCredential(username, ftso())
+		assert.Nil(t, err)
+		err = mt.DeleteCredential(username, ftso())
+		assert.NotNil(t, err)
+
+		mt2, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		assert.Zero(t, len(mt2.ListCredUsernames()))
+		assert.Zero(t, len(mt2.ListRoles()))
+	})
+
 	/////////////////////////// these tests should run at last, it only used to hit the error lines ////////////////////////
 	mockKV := &mockTestKV{}
 	mt.client = mockKV
@@ -547,7 +740,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.GetCollectionByName(collInfo.Schema.Name, 0)
+		_, err = mt.GetCollectionByName("", collInfo.Schema.Name, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find collection %s with id %d", collInfo.Schema.Name, collInfo.ID))
 
@@ -777,16 +970,16 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		ts = ftso()
-		_, _, err = mt.DropIndex("abc", "abc", "abc", ts)
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc", ts)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name = abc not exist")
 
-		mt.collName2ID["abc"] = 2
-		_, _, err = mt.DropIndex("abc", "abc", "abc", ts)
+		mt.collName2ID[common.DefaultDatabaseName]["abc"] = 2
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc", ts)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name  = abc not has meta")
 
-		_, _, err = mt.DropIndex(collInfo.Schema.Name, "abc", "abc", ts)
+		_, _, err = mt.DropIndex("", collInfo.Schema.Name, "abc", "abc", ts)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed abc", collInfo.Schema.Name))
 
@@ -804,7 +997,7 @@ func TestMetaTable(t *testing.T) {
 		mt.collID2Meta[coll.ID] = coll
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
 		ts = ftso()
-		idxID, isDroped, err := mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
+		idxID, isDroped, err := mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
 		assert.Zero(t, idxID)
 		assert.False(t, isDroped)
 		assert.Nil(t, err)
@@ -821,8 +1014,8 @@ func TestMetaTable(t *testing.T) {
 			return fmt.Errorf("multi save and remove with prefix error")
 		}
 		ts = ftso()
-		assert.Panics(t, func() { mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts) })
-		//_, _, err = mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
+		assert.Panics(t, func() { mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts) })
+		//_, _, err = mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save and remove with prefix error")
 	})
@@ -903,12 +1096,12 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 
-		mt.collName2ID = make(map[string]int64)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		mt.collName2ID = map[string]map[string]int64{common.DefaultDatabaseName: {}}
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 	})
@@ -953,8 +1146,8 @@ func TestMetaTable(t *testing.T) {
 			},
 		}
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetNotIndexedSegments("abc", "no-field", idx, nil, 0)
+		mt.collName2ID[common.DefaultDatabaseName]["abc"] = 123
+		_, _, err = mt.GetNotIndexedSegments("", "abc", "no-field", idx, nil, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -979,13 +1172,13 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, nil)
 		assert.Nil(t, err)
 
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, "no-field", idx, nil, 0)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, "no-field", idx, nil, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed no-field", collInfo.Schema.Name))
 
 		bakMeta := mt.indexID2Meta
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 		mt.indexID2Meta = bakMeta
@@ -993,8 +1186,8 @@ func TestMetaTable(t *testing.T) {
 		mockKV.multiSave = func(kvs map[string]string, ts typeutil.Timestamp, addition ...func(ts typeutil.Timestamp) (string, string, error)) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 
@@ -1032,8 +1225,8 @@ func TestMetaTable(t *testing.T) {
 		mockKV.multiSave = func(kvs map[string]string, ts typeutil.Timestamp, addition ...func(ts typeutil.Timestamp) (string, string, error)) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 	})
@@ -1045,8 +1238,8 @@ func TestMetaTable(t *testing.T) {
 		err := mt.reloadFromKV()
 		assert.Nil(t, err)
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetIndexByName("abc", "hij")
+		mt.collName2ID[common.DefaultDatabaseName]["abc"] = 123
+		_, _, err = mt.GetIndexByName("", "abc", "hij")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -1071,7 +1264,7 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, nil)
 		assert.Nil(t, err)
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetIndexByName(collInfo.Schema.Name, idxInfo[0].IndexName)
+		_, _, err = mt.GetIndexByName("", collInfo.Schema.Name, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 
@@ -1175,29 +1368,29 @@ func TestMetaWithTimestamp(t *testing.T) {
 	c2, err = mt.GetCollectionByID(collID2, tsoStart)
 	assert.NotNil(t, err)
 
-	c1, err = mt.GetCollectionByName(collName1, 0)
+	c1, err = mt.GetCollectionByName("", collName1, 0)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, 0)
+	c2, err = mt.GetCollectionByName("", collName2, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t2)
+	c1, err = mt.GetCollectionByName("", collName1, t2)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t2)
+	c2, err = mt.GetCollectionByName("", collName2, t2)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t1)
+	c1, err = mt.GetCollectionByName("", collName1, t1)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t1)
+	c2, err = mt.GetCollectionByName("", collName2, t1)
 	assert.NotNil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, tsoStart)
+	c1, err = mt.GetCollectionByName("", collName1, tsoStart)
 	assert.NotNil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, tsoStart)
+	c2, err = mt.GetCollectionByName("", collName2, tsoStart)
 	assert.NotNil(t, err)
 
 	getKeys := func(m map[string]*pb.CollectionInfo) []string {
@@ -1208,22 +1401,22 @@ func TestMetaWithTimestamp(t *testing.T) {
 		return keys
 	}
 
-	s1, err := mt.ListCollections(0)
+	s1, err := mt.ListCollections("", 0)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t2)
+	s1, err = mt.ListCollections("", t2)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t1)
+	s1, err = mt.ListCollections("", t1)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1})
 
-	s1, err = mt.ListCollections(tsoStart)
+	s1, err = mt.ListCollections("", tsoStart)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(s1))
 
@@ -103,6 +103,36 @@ func TestProxyClientManager_InvalidateCollectionMetaCache(t *testing.T) {
 	pcm.InvalidateCollectionMetaCache(ctx, nil)
 }
 
+func TestProxyClientManager_InvalidateCredentialCache(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+
+	core, err := NewCore(ctx, nil)
+	assert.Nil(t, err)
+	cli, err := clientv3.New(clientv3.Config{Endpoints: Params.EtcdEndpoints})
+	assert.Nil(t, err)
+	core.etcdCli = cli
+
+	pcm := newProxyClientManager(core)
+
+	pcm.InvalidateCredentialCache(ctx, nil)
+
+	core.SetNewProxyClient(
+		func(se *sessionutil.Session) (types.Proxy, error) {
+			return nil, nil
+		},
+	)
+
+	session := &sessionutil.Session{
+		ServerID: 100,
+		Address:  "localhost",
+	}
+
+	pcm.AddProxyClient(session)
+
+	pcm.InvalidateCredentialCache(ctx, nil)
+}
+
 func TestProxyClientManager_ReleaseDQLMessageStream(t *testing.T) {
 	Params.Init()
 	ctx := context.Background()
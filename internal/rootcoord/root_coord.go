@@ -27,6 +27,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -43,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -66,8 +68,14 @@ const (
 
 	// MetricRequestsSuccess used to count the num of successful requests
 	MetricRequestsSuccess = "success"
+
+	// HandoffSegmentPrefix is the etcd prefix under which flushed segments are published for query coord to hand off
+	HandoffSegmentPrefix = "queryCoord-handoff"
 )
 
+// handoffIndexCheckInterval is the interval to check whether the index builds of a flushed segment have finished
+var handoffIndexCheckInterval = time.Second
+
 func metricProxy(v int64) string {
 	return fmt.Sprintf("client_%d", v)
 }
@@ -89,6 +97,7 @@ type Core struct {
 	wg      sync.WaitGroup
 	etcdCli *clientv3.Client
 	kvBase  kv.TxnKV //*etcdkv.EtcdKV
+	metaKV  kv.TxnKV //*etcdkv.EtcdKV, rooted at MetaRootPath
 
 	//DDL lock
 	ddlLock sync.Mutex
@@ -111,14 +120,18 @@ type Core struct {
 	//setMsgStreams, send drop partition into dd channel
 	SendDdDropPartitionReq func(ctx context.Context, req *internalpb.DropPartitionRequest, channelNames []string) error
 
+	//setMsgStreams, send add field into dd channel
+	SendDdAddFieldReq func(ctx context.Context, req *internalpb.AddFieldRequest, channelNames []string) error
+
 	//get binlog file path from data service,
 	CallGetBinlogFilePathsService func(ctx context.Context, segID typeutil.UniqueID, fieldID typeutil.UniqueID) ([]string, error)
 	CallGetNumRowsService         func(ctx context.Context, segID typeutil.UniqueID, isFromFlushedChan bool) (int64, error)
 	CallGetFlushedSegmentsService func(ctx context.Context, collID, partID typeutil.UniqueID) ([]typeutil.UniqueID, error)
 
 	//call index builder's client to build index, return build id
-	CallBuildIndexService func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (typeutil.UniqueID, error)
-	CallDropIndexService  func(ctx context.Context, indexID typeutil.UniqueID) error
+	CallBuildIndexService     func(ctx context.Context, binlog []string, field *schemapb.FieldSchema, idxInfo *etcdpb.IndexInfo) (typeutil.UniqueID, error)
+	CallDropIndexService      func(ctx context.Context, indexID typeutil.UniqueID) error
+	CallGetIndexStatesService func(ctx context.Context, buildIDs []typeutil.UniqueID) ([]*indexpb.IndexInfo, error)
 
 	NewProxyClient func(sess *sessionutil.Session) (types.Proxy, error)
 
@@ -218,6 +231,9 @@ func (c *Core) checkInit() error {
 	if c.SendDdDropPartitionReq == nil {
 		return fmt.Errorf("SendDdDropPartitionReq is nil")
 	}
+	if c.SendDdAddFieldReq == nil {
+		return fmt.Errorf("SendDdAddFieldReq is nil")
+	}
 	if c.CallGetBinlogFilePathsService == nil {
 		return fmt.Errorf("CallGetBinlogFilePathsService is nil")
 	}
@@ -230,6 +246,9 @@ func (c *Core) checkInit() error {
 	if c.CallDropIndexService == nil {
 		return fmt.Errorf("CallDropIndexService is nil")
 	}
+	if c.CallGetIndexStatesService == nil {
+		return fmt.Errorf("CallGetIndexStatesService is nil")
+	}
 	if c.CallGetFlushedSegmentsService == nil {
 		return fmt.Errorf("CallGetFlushedSegments is nil")
 	}
@@ -568,6 +587,22 @@ func (c *Core) setMsgStreams() error {
 		return c.dmlChannels.Broadcast(channelNames, &msgPack)
 	}
 
+	c.SendDdAddFieldReq = func(ctx context.Context, req *internalpb.AddFieldRequest, channelNames []string) error {
+		msgPack := ms.MsgPack{}
+		baseMsg := ms.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: req.Base.Timestamp,
+			EndTimestamp:   req.Base.Timestamp,
+			HashValues:     []uint32{0},
+		}
+		msg := &ms.AddFieldMsg{
+			BaseMsg:         baseMsg,
+			AddFieldRequest: *req,
+		}
+		msgPack.Msgs = append(msgPack.Msgs, msg)
+		return c.dmlChannels.Broadcast(channelNames, &msgPack)
+	}
+
 	return nil
 }
 
@@ -754,6 +789,25 @@ func (c *Core) SetIndexCoord(s types.IndexCoord) error {
 		return nil
 	}
 
+	c.CallGetIndexStatesService = func(ctx context.Context, buildIDs []typeutil.UniqueID) (retStates []*indexpb.IndexInfo, retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("get index states from index service panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		rsp, err := s.GetIndexStates(ctx, &indexpb.GetIndexStatesRequest{
+			IndexBuildIDs: buildIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if rsp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("GetIndexStates from index service failed, error = %s", rsp.Status.Reason)
+		}
+		return rsp.States, nil
+	}
+
 	return nil
 }
 
@@ -891,6 +945,7 @@ func (c *Core) Init() error {
 				log.Error("RootCoord, Failed to new EtcdKV", zap.Any("reason", initError))
 				return initError
 			}
+			c.metaKV = metaKV
 			var ss *suffixSnapshot
 			if ss, initError = newSuffixSnapshot(metaKV, "_ts", Params.MetaRootPath, "snapshots"); initError != nil {
 				log.Error("RootCoord, Failed to new suffixSnapshot", zap.Error(initError))
@@ -974,6 +1029,10 @@ func (c *Core) Init() error {
 
 		c.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 
+		if initError = c.initRootCredential(); initError != nil {
+			return
+		}
+
 		initError = c.setMsgStreams()
 		if initError != nil {
 			return
@@ -987,6 +1046,44 @@ func (c *Core) Init() error {
 	return initError
 }
 
+// initRootCredential create the root user with the default password if it doesn't exist
+func (c *Core) initRootCredential() error {
+	if _, err := c.MetaTable.GetCredential(common.DefaultRootUser); err == nil {
+		return nil
+	}
+	encryptedPassword, err := crypto.PasswordEncrypt(common.DefaultRootPassword)
+	if err != nil {
+		return err
+	}
+	userID, _, err := c.IDAllocator(1)
+	if err != nil {
+		return err
+	}
+	ts, err := c.TSOAllocator(1)
+	if err != nil {
+		return err
+	}
+	log.Debug("RootCoord, create root user", zap.String("username", common.DefaultRootUser))
+	return c.MetaTable.AddCredential(&etcdpb.CredentialInfo{
+		ID:                userID,
+		Username:          common.DefaultRootUser,
+		EncryptedPassword: encryptedPassword,
+	}, ts)
+}
+
+// invalidateCredentialCache notify all proxies to drop the cached credential, empty username means all users
+func (c *Core) invalidateCredentialCache(ctx context.Context, username string, ts typeutil.Timestamp) {
+	c.proxyClientManager.InvalidateCredentialCache(ctx, &proxypb.InvalidateCredCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  c.session.ServerID,
+		},
+		Username: username,
+	})
+}
+
 func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 	if !force {
 		flag, err := c.MetaTable.client.Load(DDMsgSendPrefix, 0)
@@ -1018,7 +1115,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		if err = proto.Unmarshal(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1033,7 +1130,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		}
 		ts = ddReq.Base.Timestamp
 		dbName, collName = ddReq.DbName, ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1048,7 +1145,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		}
 		ts = ddReq.Base.Timestamp
 		dbName, collName = ddReq.DbName, ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1066,7 +1163,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		}
 		ts = ddReq.Base.Timestamp
 		dbName, collName = ddReq.DbName, ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1077,6 +1174,21 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 			return err
 		}
 		invalidateCache = true
+	case AddFieldDDType:
+		var ddReq = internalpb.AddFieldRequest{}
+		if err = proto.Unmarshal(ddOp.Body, &ddReq); err != nil {
+			return err
+		}
+		ts = ddReq.Base.Timestamp
+		dbName, collName = ddReq.DbName, ddReq.CollectionName
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
+		if err != nil {
+			return err
+		}
+		if err = c.SendDdAddFieldReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
+			return err
+		}
+		invalidateCache = true
 	default:
 		return fmt.Errorf("Invalid DdOperation %s", ddOp.Type)
 	}
@@ -1892,6 +2004,64 @@ func (c *Core) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseD
 	return c.proxyClientManager.ReleaseDQLMessageStream(ctx, in)
 }
 
+// publishHandoffSegment saves a flushed segment under HandoffSegmentPrefix,
+// query coord watches this prefix to replace the growing segment with the sealed one
+func (c *Core) publishHandoffSegment(segment *datapb.SegmentInfo) error {
+	info := &querypb.SegmentInfo{
+		SegmentID:      segment.ID,
+		CollectionID:   segment.CollectionID,
+		PartitionID:    segment.PartitionID,
+		NumRows:        segment.NumOfRows,
+		ChannelID:      segment.InsertChannel,
+		SegmentState:   querypb.SegmentState_sealed,
+		CompactionFrom: segment.CompactionFrom,
+	}
+	value, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("%s/%d/%d/%d", HandoffSegmentPrefix, segment.CollectionID, segment.PartitionID, segment.ID)
+	return c.metaKV.Save(key, string(value))
+}
+
+// publishHandoffSegmentOnIndexBuilt waits for the index builds of a flushed segment to finish, then publishes
+// the segment for handoff, so that query nodes load the sealed segment together with its index.
+// The growing segment keeps serving until then, a failed build doesn't block the handoff.
+func (c *Core) publishHandoffSegmentOnIndexBuilt(segment *datapb.SegmentInfo, buildIDs []typeutil.UniqueID) {
+	defer c.wg.Done()
+	ticker := time.NewTicker(handoffIndexCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("rootcoord context closed before the index of segment is built", zap.Int64("segment id", segment.ID))
+			return
+		case <-ticker.C:
+			states, err := c.CallGetIndexStatesService(c.ctx, buildIDs)
+			if err != nil {
+				log.Warn("get index states failed", zap.Int64("segment id", segment.ID), zap.Error(err))
+				continue
+			}
+			done := len(states) == len(buildIDs)
+			for _, state := range states {
+				if state.State != commonpb.IndexState_Finished && state.State != commonpb.IndexState_Failed {
+					done = false
+					break
+				}
+			}
+			if !done {
+				continue
+			}
+			if err := c.publishHandoffSegment(segment); err != nil {
+				log.Warn("publish handoff segment failed", zap.Int64("segment id", segment.ID), zap.Error(err))
+				continue
+			}
+			log.Debug("publish handoff segment after index built", zap.Int64("segment id", segment.ID), zap.Int64s("build ids", buildIDs))
+			return
+		}
+	}
+}
+
 // SegmentFlushCompleted check whether segment flush has completed
 func (c *Core) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error) {
 	code := c.stateCode.Load().(internalpb.StateCode)
@@ -1923,6 +2093,7 @@ func (c *Core) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlus
 		log.Debug("no index params on collection", zap.String("collection_name", coll.Schema.Name))
 	}
 
+	var buildIDs []typeutil.UniqueID
 	for _, f := range coll.FieldIndexes {
 		fieldSch, err := GetFieldSchemaByID(coll, f.FiledID)
 		if err != nil {
@@ -1956,6 +2127,18 @@ func (c *Core) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlus
 		if err != nil {
 			log.Error("AddIndex fail", zap.String("err", err.Error()))
 		}
+		buildIDs = append(buildIDs, info.BuildID)
+	}
+
+	if len(buildIDs) > 0 {
+		c.wg.Add(1)
+		go c.publishHandoffSegmentOnIndexBuilt(in.Segment, buildIDs)
+	} else if err := c.publishHandoffSegment(in.Segment); err != nil {
+		log.Warn("publish handoff segment failed", zap.Int64("segment id", segID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("publish handoff segment error = %v", err),
+		}, nil
 	}
 
 	return &commonpb.Status{
@@ -2135,3 +2318,539 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 		Reason:    "",
 	}, nil
 }
+
+// AddField add a field to an existing collection
+func (c *Core) AddField(ctx context.Context, in *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("AddField ", zap.String("collection name", in.CollectionName), zap.String("field name", in.GetField().GetName()))
+	t := &AddFieldReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("AddField failed", zap.String("collection name", in.CollectionName), zap.String("field name", in.GetField().GetName()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Add field failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("AddField Success", zap.String("collection name", in.CollectionName), zap.String("field name", in.GetField().GetName()))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// CreateDatabase create database
+func (c *Core) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateDatabase ", zap.String("dbname", in.DbName))
+	t := &CreateDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateDatabase failed", zap.String("dbname", in.DbName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Create database failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateDatabase Success", zap.String("dbname", in.DbName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// DropDatabase drop an empty database
+func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropDatabase ", zap.String("dbname", in.DbName))
+	t := &DropDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DropDatabase failed", zap.String("dbname", in.DbName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Drop database failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropDatabase Success", zap.String("dbname", in.DbName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// ListDatabases list all databases
+func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListDatabases", zap.Int64("msgID", in.Base.MsgID))
+	t := &ListDatabasesReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListDatabasesResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListDatabases failed", zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "List databases failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListDatabases Success", zap.Strings("database names", t.Rsp.DbNames), zap.Int64("msgID", in.Base.MsgID))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+// CreateCredential create a user with the encrypted password
+func (c *Core) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateCredential ", zap.String("username", in.Username))
+	t := &CreateCredentialReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateCredential failed", zap.String("username", in.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Create credential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateCredential Success", zap.String("username", in.Username))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// UpdateCredential update the encrypted password of a user
+func (c *Core) UpdateCredential(ctx context.Context, in *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("UpdateCredential ", zap.String("username", in.Username))
+	t := &UpdateCredentialReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("UpdateCredential failed", zap.String("username", in.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Update credential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("UpdateCredential Success", zap.String("username", in.Username))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// DeleteCredential delete a user
+func (c *Core) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DeleteCredential ", zap.String("username", in.Username))
+	t := &DeleteCredentialReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DeleteCredential failed", zap.String("username", in.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Delete credential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DeleteCredential Success", zap.String("username", in.Username))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// ListCredUsers list the names of all users
+func (c *Core) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListCredUsers", zap.Int64("msgID", in.Base.MsgID))
+	t := &ListCredUsersReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListCredUsersResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListCredUsers failed", zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "List credential users failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListCredUsers Success", zap.Int64("msgID", in.Base.MsgID))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+// GetCredential return the encrypted password and the grants of a user
+func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("GetCredential", zap.String("username", in.Username))
+	t := &GetCredentialReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &rootcoordpb.GetCredentialResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("GetCredential failed", zap.String("username", in.Username), zap.Error(err))
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "Get credential failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("GetCredential Success", zap.String("username", in.Username))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+// CreateRole create a role without any privilege
+func (c *Core) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateRole ", zap.String("role", in.RoleName))
+	t := &CreateRoleReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateRole failed", zap.String("role", in.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Create role failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateRole Success", zap.String("role", in.RoleName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// DropRole drop a role and remove it from all users
+func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropRole ", zap.String("role", in.RoleName))
+	t := &DropRoleReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DropRole failed", zap.String("role", in.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Drop role failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropRole Success", zap.String("role", in.RoleName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// AddUserToRole grant a role to a user
+func (c *Core) AddUserToRole(ctx context.Context, in *milvuspb.AddUserToRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("AddUserToRole ", zap.String("username", in.Username), zap.String("role", in.RoleName))
+	t := &AddUserToRoleReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("AddUserToRole failed", zap.String("username", in.Username), zap.String("role", in.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Add user to role failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("AddUserToRole Success", zap.String("username", in.Username), zap.String("role", in.RoleName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// RemoveUserFromRole revoke a role from a user
+func (c *Core) RemoveUserFromRole(ctx context.Context, in *milvuspb.RemoveUserFromRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("RemoveUserFromRole ", zap.String("username", in.Username), zap.String("role", in.RoleName))
+	t := &RemoveUserFromRoleReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("RemoveUserFromRole failed", zap.String("username", in.Username), zap.String("role", in.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Remove user from role failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("RemoveUserFromRole Success", zap.String("username", in.Username), zap.String("role", in.RoleName))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// GrantPrivilege grant a privilege on an object to a role
+func (c *Core) GrantPrivilege(ctx context.Context, in *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("GrantPrivilege ", zap.String("role", in.GetEntity().GetRoleName()), zap.String("object", in.GetEntity().GetObjectName()), zap.String("privilege", in.GetEntity().GetPrivilege()))
+	t := &GrantPrivilegeReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("GrantPrivilege failed", zap.String("role", in.GetEntity().GetRoleName()), zap.String("object", in.GetEntity().GetObjectName()), zap.String("privilege", in.GetEntity().GetPrivilege()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Grant privilege failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("GrantPrivilege Success", zap.String("role", in.GetEntity().GetRoleName()), zap.String("object", in.GetEntity().GetObjectName()), zap.String("privilege", in.GetEntity().GetPrivilege()))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// RevokePrivilege revoke a privilege on an object from a role
+func (c *Core) RevokePrivilege(ctx context.Context, in *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("RevokePrivilege ", zap.String("role", in.GetEntity().GetRoleName()), zap.String("object", in.GetEntity().GetObjectName()), zap.String("privilege", in.GetEntity().GetPrivilege()))
+	t := &RevokePrivilegeReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("RevokePrivilege failed", zap.String("role", in.GetEntity().GetRoleName()), zap.String("object", in.GetEntity().GetObjectName()), zap.String("privilege", in.GetEntity().GetPrivilege()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "Revoke privilege failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("RevokePrivilege Success", zap.String("role", in.GetEntity().GetRoleName()), zap.String("object", in.GetEntity().GetObjectName()), zap.String("privilege", in.GetEntity().GetPrivilege()))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// ListGrants list the grants of a role, empty role name means all roles
+func (c *Core) ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListGrants", zap.String("role", in.RoleName))
+	t := &ListGrantsReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListGrantsResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListGrants failed", zap.String("role", in.RoleName), zap.Error(err))
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "List grants failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListGrants Success", zap.String("role", in.RoleName))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/retry"
 
@@ -66,6 +67,13 @@ func (p *proxyMock) InvalidateCollectionMetaCache(ctx context.Context, request *
 		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
+
+func (p *proxyMock) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (p *proxyMock) GetCollArray() []string {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -221,6 +229,23 @@ func (idx *indexMock) DropIndex(ctx context.Context, req *indexpb.DropIndexReque
 	}, nil
 }
 
+func (idx *indexMock) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
+	states := make([]*indexpb.IndexInfo, 0, len(req.IndexBuildIDs))
+	for _, buildID := range req.IndexBuildIDs {
+		states = append(states, &indexpb.IndexInfo{
+			State:        commonpb.IndexState_Finished,
+			IndexBuildID: buildID,
+		})
+	}
+	return &indexpb.GetIndexStatesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		States: states,
+	}, nil
+}
+
 func (idx *indexMock) getFileArray() []string {
 	idx.mutex.Lock()
 	defer idx.mutex.Unlock()
@@ -505,7 +530,7 @@ func TestRootCoordInit(t *testing.T) {
 
 func TestRootCoord(t *testing.T) {
 	const (
-		dbName    = "testDb"
+		dbName    = common.DefaultDatabaseName
 		collName  = "testColl"
 		collName2 = "testColl2"
 		aliasName = "alias1"
@@ -662,10 +687,11 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 100,
 				SourceID:  100,
 			},
-			DbName:         dbName,
-			CollectionName: collName,
-			Schema:         sbf,
-			ShardsNum:      shardsNum,
+			DbName:           dbName,
+			CollectionName:   collName,
+			Schema:           sbf,
+			ShardsNum:        shardsNum,
+			ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
 		}
 		status, err := core.CreateCollection(ctx, req)
 		assert.Nil(t, err)
@@ -682,7 +708,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, 1, len(msgs))
 		createMsg, ok := (msgs[0]).(*msgstream.CreateCollectionMsg)
 		assert.True(t, ok)
-		createMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		createMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, createMeta.ID, createMsg.CollectionID)
 		assert.Equal(t, 1, len(createMeta.PartitionIDs))
@@ -692,6 +718,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, shardsNum, int32(len(createMeta.VirtualChannelNames)))
 		assert.Equal(t, shardsNum, int32(len(createMeta.PhysicalChannelNames)))
 		assert.Equal(t, shardsNum, createMeta.ShardsNum)
+		assert.Equal(t, commonpb.ConsistencyLevel_Bounded, createMeta.ConsistencyLevel)
 
 		vChanName := createMeta.VirtualChannelNames[0]
 		assert.Equal(t, createMeta.PhysicalChannelNames[0], ToPhysicalChannel(vChanName))
@@ -814,7 +841,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -834,6 +861,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, shardsNum, int32(len(rsp.VirtualChannelNames)))
 		assert.Equal(t, shardsNum, int32(len(rsp.PhysicalChannelNames)))
 		assert.Equal(t, shardsNum, rsp.ShardsNum)
+		assert.Equal(t, commonpb.ConsistencyLevel_Bounded, rsp.ConsistencyLevel)
 	})
 
 	t.Run("show collection", func(t *testing.T) {
@@ -870,7 +898,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		t.Log(status.Reason)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partNameIdx1, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -927,7 +955,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -948,7 +976,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		dm.mu.Lock()
@@ -990,7 +1018,7 @@ func TestRootCoord(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(collMeta.FieldIndexes))
 
@@ -1001,7 +1029,7 @@ func TestRootCoord(t *testing.T) {
 		files := im.getFileArray()
 		assert.Equal(t, 3, len(files))
 		assert.ElementsMatch(t, files, []string{"file0-100", "file1-100", "file2-100"})
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		idxMeta, err := core.MetaTable.GetIndexByID(collMeta.FieldIndexes[0].IndexID)
@@ -1015,7 +1043,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -1075,7 +1103,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 
@@ -1093,6 +1121,19 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, st.ErrorCode, commonpb.ErrorCode_Success)
 
+		handoffKey := fmt.Sprintf("%s/%d/%d/%d", HandoffSegmentPrefix, coll.ID, partID, segID)
+		var handoffValue string
+		assert.Eventually(t, func() bool {
+			handoffValue, err = core.metaKV.Load(handoffKey)
+			return err == nil
+		}, 10*time.Second, 100*time.Millisecond)
+		handoffInfo := &querypb.SegmentInfo{}
+		err = proto.Unmarshal([]byte(handoffValue), handoffInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, segID, handoffInfo.SegmentID)
+		assert.Equal(t, partID, handoffInfo.PartitionID)
+		assert.Equal(t, querypb.SegmentState_sealed, handoffInfo.SegmentState)
+
 		req := &milvuspb.DescribeIndexRequest{
 			Base: &commonpb.MsgBase{
 				MsgType:   commonpb.MsgType_DescribeIndex,
@@ -1131,7 +1172,7 @@ func TestRootCoord(t *testing.T) {
 			},
 		}
 
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		oldIdx := collMeta.FieldIndexes[0].IndexID
@@ -1141,7 +1182,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 		time.Sleep(100 * time.Millisecond)
 
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.FieldIndexes))
 		assert.Equal(t, oldIdx, collMeta.FieldIndexes[0].IndexID)
@@ -1169,7 +1210,7 @@ func TestRootCoord(t *testing.T) {
 			FieldName:      "vector",
 			IndexName:      Params.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, Params.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName("", collName, Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 
@@ -1182,7 +1223,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, idx[0].IndexID, im.idxDropID[0])
 		im.mutex.Unlock()
 
-		_, idx, err = core.MetaTable.GetIndexByName(collName, Params.DefaultIndexName)
+		_, idx, err = core.MetaTable.GetIndexByName("", collName, Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(idx))
 	})
@@ -1199,13 +1240,13 @@ func TestRootCoord(t *testing.T) {
 			CollectionName: collName,
 			PartitionName:  partName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		dropPartID := collMeta.PartitionIDs[1]
 		status, err := core.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -1244,7 +1285,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("remove DQL msgstream", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 
 		req := &proxypb.ReleaseDQLMessageStreamRequest{
@@ -1270,7 +1311,7 @@ func TestRootCoord(t *testing.T) {
 			DbName:         dbName,
 			CollectionName: collName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		status, err := core.DropCollection(ctx, req)
 		assert.Nil(t, err)
@@ -1881,6 +1922,169 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 	})
 
+	t.Run("add field", func(t *testing.T) {
+		req := &milvuspb.AddFieldRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AddField,
+				MsgID:     3017,
+				Timestamp: 3017,
+				SourceID:  3017,
+			},
+			CollectionName: collName,
+			Field: &schemapb.FieldSchema{
+				Name:     "added_field",
+				DataType: schemapb.DataType_Int64,
+			},
+		}
+		rsp, err := core.AddField(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), collMeta.SchemaVersion)
+		field, err := core.MetaTable.GetFieldSchema("", collName, "added_field")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(StartOfUserFieldID), field.FieldID)
+
+		ddOpStr, err := core.MetaTable.client.Load(DDOperationPrefix, 0)
+		assert.Nil(t, err)
+		var ddOp DdOperation
+		err = DecodeDdOperation(ddOpStr, &ddOp)
+		assert.Nil(t, err)
+		assert.Equal(t, AddFieldDDType, ddOp.Type)
+
+		// field name already exists
+		rsp, err = core.AddField(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		req.Field = &schemapb.FieldSchema{
+			Name:     "added_vector",
+			DataType: schemapb.DataType_FloatVector,
+		}
+		rsp, err = core.AddField(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+	})
+
+	t.Run("credential and rbac", func(t *testing.T) {
+		const username = "testUser"
+		const roleName = "testRole"
+		cred, err := core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_GetCredential},
+			Username: common.DefaultRootUser,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, cred.Status.ErrorCode)
+		assert.True(t, crypto.PasswordVerify(common.DefaultRootPassword, cred.EncryptedPassword))
+
+		status, err := core.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCredential},
+			Username: username,
+			Password: "encrypted",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.UpdateCredential(ctx, &milvuspb.UpdateCredentialRequest{
+			Base:        &commonpb.MsgBase{MsgType: commonpb.MsgType_UpdateCredential},
+			Username:    username,
+			NewPassword: "encrypted1",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		users, err := core.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{
+			Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_ListCredUsernames},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, users.Status.ErrorCode)
+		assert.ElementsMatch(t, []string{common.DefaultRootUser, username}, users.Usernames)
+
+		status, err = core.CreateRole(ctx, &milvuspb.CreateRoleRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateRole},
+			RoleName: roleName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.AddUserToRole(ctx, &milvuspb.AddUserToRoleRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_AddUserToRole},
+			Username: username,
+			RoleName: roleName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		entity := &milvuspb.GrantEntity{
+			RoleName:   roleName,
+			ObjectName: collName,
+			Privilege:  "Search",
+		}
+		status, err = core.GrantPrivilege(ctx, &milvuspb.GrantPrivilegeRequest{
+			Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_GrantPrivilege},
+			Entity: entity,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		grants, err := core.ListGrants(ctx, &milvuspb.ListGrantsRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_ListGrants},
+			RoleName: roleName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, grants.Status.ErrorCode)
+		assert.Equal(t, 1, len(grants.Entities))
+		assert.Equal(t, common.DefaultDatabaseName, grants.Entities[0].DbName)
+
+		cred, err = core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_GetCredential},
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, cred.Status.ErrorCode)
+		assert.Equal(t, "encrypted1", cred.EncryptedPassword)
+		assert.Equal(t, 1, len(cred.Grants))
+		assert.Equal(t, collName, cred.Grants[0].ObjectName)
+
+		status, err = core.RevokePrivilege(ctx, &milvuspb.RevokePrivilegeRequest{
+			Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_RevokePrivilege},
+			Entity: entity,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.RemoveUserFromRole(ctx, &milvuspb.RemoveUserFromRoleRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_RemoveUserFromRole},
+			Username: username,
+			RoleName: roleName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.DropRole(ctx, &milvuspb.DropRoleRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_DropRole},
+			RoleName: roleName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_DeleteCredential},
+			Username: common.DefaultRootUser,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_DeleteCredential},
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	status, err = core.DropCollection(ctx, &milvuspb.DropCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType:   commonpb.MsgType_DropCollection,
@@ -2158,7 +2362,7 @@ func TestRootCoord(t *testing.T) {
 
 func TestRootCoord2(t *testing.T) {
 	const (
-		dbName   = "testDb"
+		dbName   = common.DefaultDatabaseName
 		collName = "testColl"
 		partName = "testPartition"
 	)
@@ -2270,7 +2474,7 @@ func TestRootCoord2(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -2365,6 +2569,12 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
+	c.SendDdAddFieldReq = func(context.Context, *internalpb.AddFieldRequest, []string) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
 	c.CallGetBinlogFilePathsService = func(ctx context.Context, segID, fieldID typeutil.UniqueID) ([]string, error) {
 		return []string{}, nil
 	}
@@ -2395,6 +2605,12 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.NotNil(t, err)
 
+	c.CallGetIndexStatesService = func(ctx context.Context, buildIDs []typeutil.UniqueID) ([]*indexpb.IndexInfo, error) {
+		return nil, nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
 	c.NewProxyClient = func(*sessionutil.Session) (types.Proxy, error) {
 		return nil, nil
 	}
@@ -2418,7 +2634,7 @@ func TestCheckInit(t *testing.T) {
 
 func TestCheckFlushedSegments(t *testing.T) {
 	const (
-		dbName   = "testDb"
+		dbName   = common.DefaultDatabaseName
 		collName = "testColl"
 		partName = "testPartition"
 	)
@@ -2574,7 +2790,7 @@ func TestCheckFlushedSegments(t *testing.T) {
 
 func TestRootCoord_CheckZeroShardsNum(t *testing.T) {
 	const (
-		dbName   = "testDb"
+		dbName   = common.DefaultDatabaseName
 		collName = "testColl"
 	)
 
@@ -2650,7 +2866,7 @@ func TestRootCoord_CheckZeroShardsNum(t *testing.T) {
 	createCollectionInMeta(dbName, collName, core, shardsNum, modifyFunc)
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
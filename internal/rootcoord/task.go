@@ -23,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -150,6 +151,8 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		PhysicalChannelNames:       chanNames,
 		ShardsNum:                  t.Req.ShardsNum,
 		PartitionCreatedTimestamps: []uint64{0},
+		DbName:                     t.Req.DbName,
+		ConsistencyLevel:           t.Req.ConsistencyLevel,
 	}
 
 	idxInfo := make([]*etcdpb.IndexInfo, 0, 16)
@@ -248,7 +251,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		return fmt.Errorf("drop collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
 
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -366,7 +369,7 @@ func (t *HasCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasCollection {
 		return fmt.Errorf("has collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+	_, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 	if err == nil {
 		t.HasCollection = true
 	} else {
@@ -396,7 +399,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	var err error
 
 	if t.Req.CollectionName != "" {
-		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 		if err != nil {
 			return err
 		}
@@ -415,6 +418,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 		collInfo.ShardsNum = int32(len(collInfo.VirtualChannelNames))
 	}
 	t.Rsp.ShardsNum = collInfo.ShardsNum
+	t.Rsp.ConsistencyLevel = collInfo.ConsistencyLevel
 
 	t.Rsp.CreatedTimestamp = collInfo.CreateTime
 	createdPhysicalTime, _ := tsoutil.ParseHybridTs(collInfo.CreateTime)
@@ -441,7 +445,7 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_ShowCollections {
 		return fmt.Errorf("show collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.ListCollections(t.Req.TimeStamp)
+	coll, err := t.core.MetaTable.ListCollections(t.Req.DbName, t.Req.TimeStamp)
 	if err != nil {
 		return err
 	}
@@ -471,7 +475,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_CreatePartition {
 		return fmt.Errorf("create partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -566,7 +570,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropPartition {
 		return fmt.Errorf("drop partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -668,7 +672,7 @@ func (t *HasPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasPartition {
 		return fmt.Errorf("has partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -696,9 +700,9 @@ func (t *ShowPartitionReqTask) Execute(ctx context.Context) error {
 	var coll *etcdpb.CollectionInfo
 	var err error
 	if t.Req.CollectionName == "" {
-		coll, err = t.core.MetaTable.GetCollectionByID(t.Req.CollectionID, 0)
+		coll, err = t.core.MetaTable.GetCollectionByID(t.Req.CollectionID, t.Req.TimeStamp)
 	} else {
-		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 	}
 	if err != nil {
 		return err
@@ -834,7 +838,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		IndexID:     indexID,
 		IndexParams: t.Req.ExtraParams,
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -848,7 +852,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs, t.Req.Base.GetTimestamp())
+	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs, t.Req.Base.GetTimestamp())
 	if err != nil {
 		log.Debug("RootCoord CreateIndexReqTask metaTable.GetNotIndexedSegments", zap.Error(err))
 		return err
@@ -899,7 +903,7 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DescribeIndex {
 		return fmt.Errorf("describe index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		return err
 	}
@@ -936,7 +940,7 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropIndex {
 		return fmt.Errorf("drop index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, info, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	_, info, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		log.Warn("GetIndexByName failed,", zap.String("collection name", t.Req.CollectionName), zap.String("field name", t.Req.FieldName), zap.String("index name", t.Req.IndexName), zap.Error(err))
 		return err
@@ -952,7 +956,7 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 	ts, _ := t.core.TSOAllocator(1)
-	_, _, err = t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName, ts)
+	_, _, err = t.core.MetaTable.DropIndex(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName, ts)
 	return err
 }
 
@@ -1001,7 +1005,7 @@ func (t *CreateAliasReqTask) Execute(ctx context.Context) error {
 		t.core.chanTimeTick.AddDdlTimeTick(ts, reason)
 		// clear ddl timetick in all conditions
 		defer t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
-		err = t.core.MetaTable.AddAlias(t.Req.Alias, t.Req.CollectionName, ts, ddOp)
+		err = t.core.MetaTable.AddAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName, ts, ddOp)
 		if err != nil {
 			return err
 		}
@@ -1061,7 +1065,7 @@ func (t *DropAliasReqTask) Execute(ctx context.Context) error {
 		t.core.chanTimeTick.AddDdlTimeTick(ts, reason)
 		// clear ddl timetick in all conditions
 		defer t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
-		err = t.core.MetaTable.DeleteAlias(t.Req.Alias, ts, ddOp)
+		err = t.core.MetaTable.DeleteAlias(t.Req.DbName, t.Req.Alias, ts, ddOp)
 		if err != nil {
 			return err
 		}
@@ -1081,6 +1085,7 @@ func (t *DropAliasReqTask) Execute(ctx context.Context) error {
 			Timestamp: ts,
 			SourceID:  t.core.session.ServerID,
 		},
+		DbName:         t.Req.DbName,
 		CollectionName: t.Req.Alias,
 	}
 	// error doesn't matter here
@@ -1134,7 +1139,7 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 		t.core.chanTimeTick.AddDdlTimeTick(ts, reason)
 		// clear ddl timetick in all conditions
 		defer t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
-		err = t.core.MetaTable.AlterAlias(t.Req.Alias, t.Req.CollectionName, ts, ddOp)
+		err = t.core.MetaTable.AlterAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName, ts, ddOp)
 		if err != nil {
 			return err
 		}
@@ -1154,6 +1159,7 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 			Timestamp: ts,
 			SourceID:  t.core.session.ServerID,
 		},
+		DbName:         t.Req.DbName,
 		CollectionName: t.Req.Alias,
 	}
 	// error doesn't matter here
@@ -1162,3 +1168,581 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 	// Update DDOperation in etcd
 	return t.core.setDdMsgSendFlag(true)
 }
+
+// AddFieldReqTask add field request task
+type AddFieldReqTask struct {
+	baseReqTask
+	Req *milvuspb.AddFieldRequest
+}
+
+// Type return msg type
+func (t *AddFieldReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *AddFieldReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AddField {
+		return fmt.Errorf("add field, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.Field == nil || t.Req.Field.Name == "" {
+		return fmt.Errorf("add field, field name is empty")
+	}
+	// segments flushed before this field was added have no binlog for it,
+	// only scalar fields could be filled with default values when loading
+	if typeutil.IsVectorType(t.Req.Field.DataType) {
+		return fmt.Errorf("add field, vector field %s is not supported", t.Req.Field.Name)
+	}
+	if t.Req.Field.IsPrimaryKey || t.Req.Field.AutoID {
+		return fmt.Errorf("add field, field %s can't be primary key or auto id", t.Req.Field.Name)
+	}
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+
+	field := proto.Clone(t.Req.Field).(*schemapb.FieldSchema)
+	field.FieldID = int64(StartOfUserFieldID)
+	for _, f := range collMeta.Schema.Fields {
+		if f.FieldID >= field.FieldID {
+			field.FieldID = f.FieldID + 1
+		}
+	}
+
+	ddReq := internalpb.AddFieldRequest{
+		Base:           t.Req.Base,
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+		DbID:           0, // todo, not used
+		CollectionID:   collMeta.ID,
+		Field:          field,
+		SchemaVersion:  collMeta.SchemaVersion + 1,
+	}
+
+	// build DdOperation and save it into etcd, when ddmsg send fail,
+	// system can restore ddmsg from etcd and re-send
+	ddOp := func(ts typeutil.Timestamp) (string, error) {
+		ddReq.Base.Timestamp = ts
+		return EncodeDdOperation(&ddReq, AddFieldDDType)
+	}
+
+	reason := fmt.Sprintf("add field %s", field.Name)
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// use lambda function here to guarantee all resources to be released
+	addFieldFn := func() error {
+		// lock for ddl operation
+		t.core.ddlLock.Lock()
+		defer t.core.ddlLock.Unlock()
+
+		t.core.chanTimeTick.AddDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+
+		err = t.core.MetaTable.AddField(collMeta.ID, field, ts, ddOp)
+		if err != nil {
+			return err
+		}
+
+		err = t.core.SendDdAddFieldReq(ctx, &ddReq, collMeta.PhysicalChannelNames)
+		if err != nil {
+			return err
+		}
+
+		t.core.chanTimeTick.RemoveDdlTimeTick(ts, reason)
+		t.core.SendTimeTick(ts, reason)
+		return nil
+	}
+
+	err = addFieldFn()
+	if err != nil {
+		return err
+	}
+
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   0, //TODO, msg type
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+	}
+	// error doesn't matter here
+	t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+
+	// Update DDOperation in etcd
+	return t.core.setDdMsgSendFlag(true)
+}
+
+// CreateDatabaseReqTask create database request task
+type CreateDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateDatabaseRequest
+}
+
+// Type return msg type
+func (t *CreateDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *CreateDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateDatabase {
+		return fmt.Errorf("create database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.DbName == "" {
+		return fmt.Errorf("database name is empty")
+	}
+
+	dbID, _, err := t.core.IDAllocator(1)
+	if err != nil {
+		return fmt.Errorf("alloc database id error = %w", err)
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// lock for ddl operation
+	t.core.ddlLock.Lock()
+	defer t.core.ddlLock.Unlock()
+
+	return t.core.MetaTable.AddDatabase(&etcdpb.DatabaseInfo{ID: dbID, Name: t.Req.DbName}, ts)
+}
+
+// DropDatabaseReqTask drop database request task
+type DropDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropDatabaseRequest
+}
+
+// Type return msg type
+func (t *DropDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *DropDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropDatabase {
+		return fmt.Errorf("drop database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	// lock for ddl operation
+	t.core.ddlLock.Lock()
+	defer t.core.ddlLock.Unlock()
+
+	return t.core.MetaTable.DeleteDatabase(t.Req.DbName, ts)
+}
+
+// ListDatabasesReqTask list databases request task
+type ListDatabasesReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListDatabasesRequest
+	Rsp *milvuspb.ListDatabasesResponse
+}
+
+// Type return msg type
+func (t *ListDatabasesReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *ListDatabasesReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListDatabases {
+		return fmt.Errorf("list databases, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	for _, db := range t.core.MetaTable.ListDatabases() {
+		t.Rsp.DbNames = append(t.Rsp.DbNames, db.Name)
+		t.Rsp.CreatedTimestamps = append(t.Rsp.CreatedTimestamps, db.CreateTime)
+	}
+	return nil
+}
+
+// CreateCredentialReqTask create credential request task
+type CreateCredentialReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateCredentialRequest
+}
+
+// Type return msg type
+func (t *CreateCredentialReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *CreateCredentialReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateCredential {
+		return fmt.Errorf("create credential, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.Username == "" {
+		return fmt.Errorf("username is empty")
+	}
+
+	userID, _, err := t.core.IDAllocator(1)
+	if err != nil {
+		return fmt.Errorf("alloc user id error = %w", err)
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	return t.core.MetaTable.AddCredential(&etcdpb.CredentialInfo{
+		ID:                userID,
+		Username:          t.Req.Username,
+		EncryptedPassword: t.Req.Password,
+	}, ts)
+}
+
+// UpdateCredentialReqTask update credential request task
+type UpdateCredentialReqTask struct {
+	baseReqTask
+	Req *milvuspb.UpdateCredentialRequest
+}
+
+// Type return msg type
+func (t *UpdateCredentialReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *UpdateCredentialReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_UpdateCredential {
+		return fmt.Errorf("update credential, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.UpdateCredential(t.Req.Username, t.Req.NewPassword, ts); err != nil {
+		return err
+	}
+	t.core.invalidateCredentialCache(ctx, t.Req.Username, ts)
+	return nil
+}
+
+// DeleteCredentialReqTask delete credential request task
+type DeleteCredentialReqTask struct {
+	baseReqTask
+	Req *milvuspb.DeleteCredentialRequest
+}
+
+// Type return msg type
+func (t *DeleteCredentialReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *DeleteCredentialReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DeleteCredential {
+		return fmt.Errorf("delete credential, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.Username == common.DefaultRootUser {
+		return fmt.Errorf("user %s can't be deleted", common.DefaultRootUser)
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.DeleteCredential(t.Req.Username, ts); err != nil {
+		return err
+	}
+	t.core.invalidateCredentialCache(ctx, t.Req.Username, ts)
+	return nil
+}
+
+// ListCredUsersReqTask list credential usernames request task
+type ListCredUsersReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListCredUsersRequest
+	Rsp *milvuspb.ListCredUsersResponse
+}
+
+// Type return msg type
+func (t *ListCredUsersReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *ListCredUsersReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListCredUsernames {
+		return fmt.Errorf("list credential users, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	t.Rsp.Usernames = t.core.MetaTable.ListCredUsernames()
+	return nil
+}
+
+// GetCredentialReqTask get credential request task
+type GetCredentialReqTask struct {
+	baseReqTask
+	Req *rootcoordpb.GetCredentialRequest
+	Rsp *rootcoordpb.GetCredentialResponse
+}
+
+// Type return msg type
+func (t *GetCredentialReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *GetCredentialReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_GetCredential {
+		return fmt.Errorf("get credential, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	cred, err := t.core.MetaTable.GetCredential(t.Req.Username)
+	if err != nil {
+		return err
+	}
+	t.Rsp.Username = cred.Username
+	t.Rsp.EncryptedPassword = cred.EncryptedPassword
+	for _, roleName := range cred.Roles {
+		role, err := t.core.MetaTable.GetRole(roleName)
+		if err != nil {
+			// the role is dropped concurrently
+			continue
+		}
+		t.Rsp.Grants = append(t.Rsp.Grants, grantInfos2Entities(role.Name, role.Grants)...)
+	}
+	return nil
+}
+
+// CreateRoleReqTask create role request task
+type CreateRoleReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateRoleRequest
+}
+
+// Type return msg type
+func (t *CreateRoleReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *CreateRoleReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateRole {
+		return fmt.Errorf("create role, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.RoleName == "" {
+		return fmt.Errorf("role name is empty")
+	}
+
+	roleID, _, err := t.core.IDAllocator(1)
+	if err != nil {
+		return fmt.Errorf("alloc role id error = %w", err)
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	return t.core.MetaTable.AddRole(&etcdpb.RoleInfo{ID: roleID, Name: t.Req.RoleName}, ts)
+}
+
+// DropRoleReqTask drop role request task
+type DropRoleReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropRoleRequest
+}
+
+// Type return msg type
+func (t *DropRoleReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *DropRoleReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropRole {
+		return fmt.Errorf("drop role, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.DeleteRole(t.Req.RoleName, ts); err != nil {
+		return err
+	}
+	t.core.invalidateCredentialCache(ctx, "", ts)
+	return nil
+}
+
+// AddUserToRoleReqTask add user to role request task
+type AddUserToRoleReqTask struct {
+	baseReqTask
+	Req *milvuspb.AddUserToRoleRequest
+}
+
+// Type return msg type
+func (t *AddUserToRoleReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *AddUserToRoleReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AddUserToRole {
+		return fmt.Errorf("add user to role, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.AddUserToRole(t.Req.Username, t.Req.RoleName, ts); err != nil {
+		return err
+	}
+	t.core.invalidateCredentialCache(ctx, t.Req.Username, ts)
+	return nil
+}
+
+// RemoveUserFromRoleReqTask remove user from role request task
+type RemoveUserFromRoleReqTask struct {
+	baseReqTask
+	Req *milvuspb.RemoveUserFromRoleRequest
+}
+
+// Type return msg type
+func (t *RemoveUserFromRoleReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *RemoveUserFromRoleReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_RemoveUserFromRole {
+		return fmt.Errorf("remove user from role, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.RemoveUserFromRole(t.Req.Username, t.Req.RoleName, ts); err != nil {
+		return err
+	}
+	t.core.invalidateCredentialCache(ctx, t.Req.Username, ts)
+	return nil
+}
+
+// GrantPrivilegeReqTask grant privilege request task
+type GrantPrivilegeReqTask struct {
+	baseReqTask
+	Req *milvuspb.GrantPrivilegeRequest
+}
+
+// Type return msg type
+func (t *GrantPrivilegeReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *GrantPrivilegeReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_GrantPrivilege {
+		return fmt.Errorf("grant privilege, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.Entity == nil {
+		return fmt.Errorf("grant entity is empty")
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.GrantPrivilege(t.Req.Entity.RoleName, grantEntity2Info(t.Req.Entity), ts); err != nil {
+		return err
+	}
+	t.core.invalidateCredentialCache(ctx, "", ts)
+	return nil
+}
+
+// RevokePrivilegeReqTask revoke privilege request task
+type RevokePrivilegeReqTask struct {
+	baseReqTask
+	Req *milvuspb.RevokePrivilegeRequest
+}
+
+// Type return msg type
+func (t *RevokePrivilegeReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *RevokePrivilegeReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_RevokePrivilege {
+		return fmt.Errorf("revoke privilege, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.Entity == nil {
+		return fmt.Errorf("grant entity is empty")
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.RevokePrivilege(t.Req.Entity.RoleName, grantEntity2Info(t.Req.Entity), ts); err != nil {
+		return err
+	}
+	t.core.invalidateCredentialCache(ctx, "", ts)
+	return nil
+}
+
+// ListGrantsReqTask list grants request task
+type ListGrantsReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListGrantsRequest
+	Rsp *milvuspb.ListGrantsResponse
+}
+
+// Type return msg type
+func (t *ListGrantsReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *ListGrantsReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListGrants {
+		return fmt.Errorf("list grants, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	// empty role name means all roles
+	if t.Req.RoleName == "" {
+		for _, role := range t.core.MetaTable.ListRoles() {
+			t.Rsp.Entities = append(t.Rsp.Entities, grantInfos2Entities(role.Name, role.Grants)...)
+		}
+		return nil
+	}
+	role, err := t.core.MetaTable.GetRole(t.Req.RoleName)
+	if err != nil {
+		return err
+	}
+	t.Rsp.Entities = grantInfos2Entities(role.Name, role.Grants)
+	return nil
+}
+
+func grantEntity2Info(entity *milvuspb.GrantEntity) *etcdpb.GrantInfo {
+	return &etcdpb.GrantInfo{
+		DbName:     entity.DbName,
+		ObjectName: entity.ObjectName,
+		Privilege:  entity.Privilege,
+	}
+}
+
+func grantInfos2Entities(roleName string, grants []*etcdpb.GrantInfo) []*milvuspb.GrantEntity {
+	entities := make([]*milvuspb.GrantEntity, 0, len(grants))
+	for _, g := range grants {
+		entities = append(entities, &milvuspb.GrantEntity{
+			RoleName:   roleName,
+			DbName:     g.DbName,
+			ObjectName: g.ObjectName,
+			Privilege:  g.Privilege,
+		})
+	}
+	return entities
+}
@@ -140,6 +140,48 @@ type InsertData struct {
 	Infos []BlobInfo
 }
 
+// FillMissingFields fills zero values for the scalar fields defined in schema but absent in data.
+// Segments written before a field was added to the collection have no data for that field.
+func FillMissingFields(schema *schemapb.CollectionSchema, data *InsertData) error {
+	tsData, ok := data.Data[rootcoord.TimeStampField].(*Int64FieldData)
+	if !ok || len(tsData.Data) == 0 {
+		// nothing to fill
+		return nil
+	}
+	rowNum := len(tsData.Data)
+	for _, field := range schema.Fields {
+		if _, ok := data.Data[field.FieldID]; ok {
+			continue
+		}
+		numRows := []int64{int64(rowNum)}
+		switch field.DataType {
+		case schemapb.DataType_Bool:
+			data.Data[field.FieldID] = &BoolFieldData{NumRows: numRows, Data: make([]bool, rowNum)}
+		case schemapb.DataType_Int8:
+			data.Data[field.FieldID] = &Int8FieldData{NumRows: numRows, Data: make([]int8, rowNum)}
+		case schemapb.DataType_Int16:
+			data.Data[field.FieldID] = &Int16FieldData{NumRows: numRows, Data: make([]int16, rowNum)}
+		case schemapb.DataType_Int32:
+			data.Data[field.FieldID] = &Int32FieldData{NumRows: numRows, Data: make([]int32, rowNum)}
+		case schemapb.DataType_Int64:
+			data.Data[field.FieldID] = &Int64FieldData{NumRows: numRows, Data: make([]int64, rowNum)}
+		case schemapb.DataType_Float:
+			data.Data[field.FieldID] = &FloatFieldData{NumRows: numRows, Data: make([]float32, rowNum)}
+		case schemapb.DataType_Double:
+			data.Data[field.FieldID] = &DoubleFieldData{NumRows: numRows, Data: make([]float64, rowNum)}
+		case schemapb.DataType_String:
+			data.Data[field.FieldID] = &StringFieldData{NumRows: numRows, Data: make([]string, rowNum)}
+		case schemapb.DataType_BinaryVector, schemapb.DataType_FloatVector:
+			// vector fields can't be added to an existing collection,
+			// the raw data of indexed vector fields may be skipped on purpose
+			continue
+		default:
+			return fmt.Errorf("can't fill missing field %d of data type %s", field.FieldID, field.DataType.String())
+		}
+	}
+	return nil
+}
+
 // InsertCodec serializes and deserializes the insert data
 // Blob key example:
 // ${tenant}/insert_log/${collection_id}/${partition_id}/${segment_id}/${field_id}/${log_idx}
@@ -583,21 +625,32 @@ func (deleteCodec *DeleteCodec) Deserialize(blob *Blob) (partitionID UniqueID, s
 		if err != nil {
 			return InvalidUniqueID, InvalidUniqueID, nil, err
 		}
-		splits := strings.Split(singleString, ",")
-		if len(splits) != 2 {
+		// a string primary key may contain commas, the timestamp follows the last one
+		sep := strings.LastIndex(singleString, ",")
+		if sep < 0 {
 			return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("the format of delta log is incorrect")
 		}
-		ts, err := strconv.ParseInt(splits[1], 10, 64)
+		ts, err := strconv.ParseInt(singleString[sep+1:], 10, 64)
 		if err != nil {
 			return -1, -1, nil, err
 		}
-		result.Data[splits[0]] = ts
+		result.Data[singleString[:sep]] = ts
 	}
 	deleteCodec.readerCloseFunc = append(deleteCodec.readerCloseFunc, readerClose(binlogReader))
 	return pid, sid, result, nil
 
 }
 
+func (deleteCodec *DeleteCodec) Close() error {
+	for _, closeFunc := range deleteCodec.readerCloseFunc {
+		err := closeFunc()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Blob key example:
 // ${tenant}/data_definition_log/${collection_id}/ts/${log_idx}
 // ${tenant}/data_definition_log/${collection_id}/ddl/${log_idx}
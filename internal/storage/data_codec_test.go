@@ -310,7 +310,7 @@ func TestDeleteCodec(t *testing.T) {
 	}
 	deleteCodec := NewDeleteCodec(schema)
 	deleteData := &DeleteData{
-		Data: map[string]int64{"1": 43757345, "2": 23578294723},
+		Data: map[string]int64{"1": 43757345, "2": 23578294723, "a,b": 43757346},
 	}
 	blob, err := deleteCodec.Serialize(1, 1, deleteData)
 	assert.Nil(t, err)
@@ -544,3 +544,62 @@ func TestSchemaError(t *testing.T) {
 	assert.Nil(t, blobs)
 	assert.NotNil(t, err)
 }
+
+func TestFillMissingFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+			{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: BoolField, Name: "field_bool", DataType: schemapb.DataType_Bool},
+			{FieldID: Int32Field, Name: "field_int32", DataType: schemapb.DataType_Int32},
+			{FieldID: StringField, Name: "field_string", DataType: schemapb.DataType_String},
+		},
+	}
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField: &Int64FieldData{
+				NumRows: []int64{2},
+				Data:    []int64{3, 4},
+			},
+			TimestampField: &Int64FieldData{
+				NumRows: []int64{2},
+				Data:    []int64{3, 4},
+			},
+			BoolField: &BoolFieldData{
+				NumRows: []int64{2},
+				Data:    []bool{true, false},
+			},
+		},
+	}
+	err := FillMissingFields(schema, insertData)
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{true, false}, insertData.Data[BoolField].(*BoolFieldData).Data)
+	assert.Equal(t, []int64{2}, insertData.Data[Int32Field].(*Int32FieldData).NumRows)
+	assert.Equal(t, []int32{0, 0}, insertData.Data[Int32Field].(*Int32FieldData).Data)
+	assert.Equal(t, []string{"", ""}, insertData.Data[StringField].(*StringFieldData).Data)
+
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:  FloatVectorField,
+		Name:     "field_float_vector",
+		DataType: schemapb.DataType_FloatVector,
+	})
+	err = FillMissingFields(schema, insertData)
+	assert.Nil(t, err)
+	_, ok := insertData.Data[FloatVectorField]
+	assert.False(t, ok)
+
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:  Int64Field,
+		Name:     "field_invalid",
+		DataType: 999,
+	})
+	err = FillMissingFields(schema, insertData)
+	assert.NotNil(t, err)
+
+	// empty data has nothing to fill
+	emptyData := &InsertData{Data: make(map[int64]FieldData)}
+	err = FillMissingFields(schema, emptyData)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(emptyData.Data))
+}
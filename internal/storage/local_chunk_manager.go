@@ -84,6 +84,16 @@ func (lcm *LocalChunkManager) Read(key string) ([]byte, error) {
 	return content, file.Close()
 }
 
+// Remove deletes the local storage data if exist.
+func (lcm *LocalChunkManager) Remove(key string) error {
+	path := path.Join(lcm.localPath, key)
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
 // ReadAt reads specific position data of local storage if exist.
 func (lcm *LocalChunkManager) ReadAt(key string, p []byte, off int64) (n int, err error) {
 	path := path.Join(lcm.localPath, key)
@@ -50,3 +50,16 @@ func TestLocalChunkManager_Read(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, len(res), len(bin))
 }
+
+func TestLocalChunkManager_Remove(t *testing.T) {
+	lcm := NewLocalChunkManager(localPath)
+	err := lcm.Remove("invalid")
+	assert.Nil(t, err)
+
+	err = lcm.Write("2", []byte{1, 2, 3})
+	assert.Nil(t, err)
+	assert.True(t, lcm.Exist("2"))
+	err = lcm.Remove("2")
+	assert.Nil(t, err)
+	assert.False(t, lcm.Exist("2"))
+}
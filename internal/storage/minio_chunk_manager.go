@@ -54,6 +54,11 @@ func (mcm *MinioChunkManager) Read(key string) ([]byte, error) {
 	return []byte(results), err
 }
 
+// Remove deletes the chunk from minio storage.
+func (mcm *MinioChunkManager) Remove(key string) error {
+	return mcm.minio.Remove(key)
+}
+
 // ReadAt reads specific position data of minio storage if exist.
 func (mcm *MinioChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
 	results, err := mcm.minio.Load(key)
@@ -69,3 +69,20 @@ func TestMinioChunkManager_ReadAt(t *testing.T) {
 		assert.Equal(t, content[i-offset], bin[i])
 	}
 }
+
+func TestMinioChunkManager_Remove(t *testing.T) {
+	bucketName := "minio-chunk-manager"
+	kv, err := newMinIOKVClient(context.TODO(), bucketName)
+	assert.Nil(t, err)
+
+	minioMgr := NewMinioChunkManager(kv)
+
+	key := "2"
+	err = minioMgr.Write(key, []byte{1, 2, 3})
+	assert.Nil(t, err)
+	assert.True(t, minioMgr.Exist(key))
+
+	err = minioMgr.Remove(key)
+	assert.Nil(t, err)
+	assert.False(t, minioMgr.Exist(key))
+}
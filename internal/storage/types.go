@@ -19,4 +19,5 @@ type ChunkManager interface {
 	Exist(key string) bool
 	Read(key string) ([]byte, error)
 	ReadAt(key string, p []byte, off int64) (n int, err error)
+	Remove(key string) error
 }
@@ -124,6 +124,11 @@ func (vcm *VectorChunkManager) Read(key string) ([]byte, error) {
 	return vcm.downloadVectorFile(key)
 }
 
+// Remove deletes the vector data from local cache.
+func (vcm *VectorChunkManager) Remove(key string) error {
+	return vcm.localChunkManager.Remove(key)
+}
+
 // ReadAt reads specific position data of vector. If cached, it reads from local.
 func (vcm *VectorChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
 	if vcm.localCacheEnable {
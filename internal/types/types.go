@@ -57,6 +57,21 @@ type DataNode interface {
 	FlushSegments(ctx context.Context, req *datapb.FlushSegmentsRequest) (*commonpb.Status, error)
 
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+
+	// Compaction notifies DataNode to execute the compaction plan req provides. The compaction is async to this
+	//  rpc, DataNode reports the result to DataCoord via `CompleteCompaction` when the plan is done.
+	//
+	// Return UnexpectedError code in status:
+	//     If DataNode isn't in HEALTHY: states not HEALTHY or dynamic checks not HEALTHY
+	//     If DataNode doesn't watch the channel of the plan
+	Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error)
+
+	// Import notifies DataNode to execute the bulk import task req provides. The import is async to this
+	//  rpc, DataNode reports the result to DataCoord via `ReportImport` when the task is done or failed.
+	//
+	// Return UnexpectedError code in status:
+	//     If DataNode isn't in HEALTHY: states not HEALTHY or dynamic checks not HEALTHY
+	Import(ctx context.Context, req *datapb.ImportTaskInfo) (*commonpb.Status, error)
 }
 
 // DataCoord is the interface `datacoord` package implements
@@ -93,6 +108,27 @@ type DataCoord interface {
 	GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)
 
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+
+	// CompleteCompaction is called by DataNode when a compaction plan is done,
+	// the compacted segment replaces the segments listed in the plan atomically
+	CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error)
+
+	// ManualCompaction triggers compaction of all the segments of the specified collection
+	// the compaction id returned can be used to query the state via `GetCompactionState`
+	ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
+
+	// GetCompactionState returns the state of the compaction with provided compaction id
+	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
+
+	// Import creates a bulk import task of the files in object storage, the task is persisted and dispatched
+	// to a DataNode, the task id returned can be used to query the state via `GetImportState`
+	Import(ctx context.Context, req *datapb.ImportTask) (*datapb.ImportTaskResponse, error)
+
+	// GetImportState returns the state of the import task with provided task id
+	GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
+
+	// ReportImport is called by DataNode when an import task is done or failed
+	ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error)
 }
 
 // IndexNode is the interface `indexnode` package implements
@@ -270,6 +306,105 @@ type RootCoord interface {
 	DropAlias(ctx context.Context, req *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 
+	// AddField notifies RootCoord to append a field to the schema of an existing collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(not used), collection name and field schema
+	//
+	// The `ErrorCode` of `Status` is `Success` if add field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+
+	// CreateDatabase notifies RootCoord to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies RootCoord to drop an empty database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases notifies RootCoord to list all the databases, including the default database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// database names are filled in `DbNames`
+	// error is always nil
+	ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+
+	// CreateCredential notifies RootCoord to create a user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username and the password encrypted by proxy
+	//
+	// The `ErrorCode` of `Status` is `Success` if create user successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error)
+
+	// UpdateCredential notifies RootCoord to update the password of a user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username and the new password encrypted by proxy
+	//
+	// The `ErrorCode` of `Status` is `Success` if update password successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error)
+
+	// DeleteCredential notifies RootCoord to delete a user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username
+	//
+	// The `ErrorCode` of `Status` is `Success` if delete user successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+
+	// ListCredUsers notifies RootCoord to list all the users
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListCredUsersResponse` indicates if this operation is processed successfully or fail cause;
+	// usernames are filled in `Usernames`
+	// error is always nil
+	ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+
+	// GetCredential notifies RootCoord to get the credential of a user, it's used by proxy to authenticate users
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username
+	//
+	// The `Status` in response struct `GetCredentialResponse` indicates if this operation is processed successfully or fail cause;
+	// the encrypted password and the privileges granted to the user through its roles are filled in the response
+	// error is always nil
+	GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error)
+
+	// role based access control
+	CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+	AddUserToRole(ctx context.Context, req *milvuspb.AddUserToRoleRequest) (*commonpb.Status, error)
+	RemoveUserFromRole(ctx context.Context, req *milvuspb.RemoveUserFromRoleRequest) (*commonpb.Status, error)
+	GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error)
+	RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error)
+	ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error)
+
 	//global timestamp allocator
 	AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error)
 	AllocID(ctx context.Context, req *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error)
@@ -307,7 +442,7 @@ type Proxy interface {
 	// Such as `DropCollection`, `CreatePartition`, `DropPartition`, etc.
 	//
 	// ctx is the request to control request deadline and cancellation.
-	// request contains the request params, which are database name(not used now) and collection name.
+	// request contains the request params, which are database name and collection name.
 	//
 	// InvalidateCollectionMetaCache should always succeed even though the specific collection doesn't exist in Proxy.
 	// So the code of response `Status` should be always `Success`.
@@ -315,6 +450,19 @@ type Proxy interface {
 	// error is returned only when some communication issue occurs.
 	InvalidateCollectionMetaCache(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error)
 
+	// InvalidateCredentialCache notifies Proxy to clear the cached credential of specific user.
+	//
+	// InvalidateCredentialCache should be called when the password or the privileges of a user are changed.
+	//
+	// ctx is the request to control request deadline and cancellation.
+	// request contains the request params, which is the username, an empty username means all users.
+	//
+	// InvalidateCredentialCache should always succeed even though the specific user isn't cached in Proxy.
+	// So the code of response `Status` should be always `Success`.
+	//
+	// error is returned only when some communication issue occurs.
+	InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error)
+
 	// ReleaseDQLMessageStream notifies Proxy to release and close the search message stream of specific collection.
 	//
 	// ReleaseDQLMessageStream should be called when the specific collection was released.
@@ -368,6 +516,8 @@ type QueryCoord interface {
 	CreateQueryChannel(ctx context.Context, req *querypb.CreateQueryChannelRequest) (*querypb.CreateQueryChannelResponse, error)
 	GetPartitionStates(ctx context.Context, req *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
+	LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error)
+	GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error)
 
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordEncrypt encrypts the raw password by bcrypt, the result is safe to be persisted
+func PasswordEncrypt(rawPwd string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(rawPwd), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// PasswordVerify checks whether the raw password matches the password encrypted by PasswordEncrypt
+func PasswordVerify(rawPwd, encryptedPwd string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(encryptedPwd), []byte(rawPwd)) == nil
+}
+
+// SHA256 returns the hex encoded sha256 digest of src salted by salt, it's much cheaper than bcrypt
+// and is used to remember a verified password in memory
+func SHA256(src string, salt string) string {
+	h := sha256.New()
+	h.Write([]byte(src + salt))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Base64Encode encodes the string with the standard base64 encoding
+func Base64Encode(src string) string {
+	return base64.StdEncoding.EncodeToString([]byte(src))
+}
+
+// Base64Decode decodes the string encoded by Base64Encode
+func Base64Decode(src string) (string, error) {
+	bytes, err := base64.StdEncoding.DecodeString(src)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
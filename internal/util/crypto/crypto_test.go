@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordVerify(t *testing.T) {
+	encryptedPwd, err := PasswordEncrypt("Milvus")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "Milvus", encryptedPwd)
+	assert.True(t, PasswordVerify("Milvus", encryptedPwd))
+	assert.False(t, PasswordVerify("milvus", encryptedPwd))
+	assert.False(t, PasswordVerify("Milvus", "Milvus"))
+
+	another, err := PasswordEncrypt("Milvus")
+	assert.Nil(t, err)
+	assert.NotEqual(t, encryptedPwd, another)
+	assert.True(t, PasswordVerify("Milvus", another))
+}
+
+func TestSHA256(t *testing.T) {
+	assert.Equal(t, SHA256("Milvus", "root"), SHA256("Milvus", "root"))
+	assert.NotEqual(t, SHA256("Milvus", "root"), SHA256("Milvus", "user"))
+	assert.NotEqual(t, SHA256("Milvus", "root"), SHA256("milvus", "root"))
+}
+
+func TestBase64(t *testing.T) {
+	encoded := Base64Encode("root:Milvus")
+	assert.Equal(t, "cm9vdDpNaWx2dXM=", encoded)
+	decoded, err := Base64Decode(encoded)
+	assert.Nil(t, err)
+	assert.Equal(t, "root:Milvus", decoded)
+
+	_, err = Base64Decode("not base64!")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+// Package importutil parses the files of bulk import into the column based field data of storage.
+//
+// Supported formats are:
+//   - JSON (.json): an object of columns, i.e. {"field_a": [1, 2], "vec": [[0.1, 0.2], [0.3, 0.4]]}
+//   - NumPy (.npy): one column per file, the file name (without extension) is the field name
+//   - Parquet (.parquet): one column per file written by the payload writer, named like the NumPy files
+package importutil
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// extensions of the supported files
+const (
+	JSONFileExt    = ".json"
+	NumpyFileExt   = ".npy"
+	ParquetFileExt = ".parquet"
+)
+
+// Columns is the field data of columns parsed from files, keyed by field id
+type Columns = map[storage.FieldID]storage.FieldData
+
+// Parse parses the content of a file according to its extension
+func Parse(filePath string, content []byte, schema *schemapb.CollectionSchema) (Columns, error) {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	ext := strings.ToLower(path.Ext(filePath))
+	switch ext {
+	case JSONFileExt:
+		return parseJSON(content, helper)
+	case NumpyFileExt, ParquetFileExt:
+		fieldName := strings.TrimSuffix(path.Base(filePath), path.Ext(filePath))
+		field, err := helper.GetFieldFromName(fieldName)
+		if err != nil {
+			return nil, fmt.Errorf("file %s does not match any field: %w", filePath, err)
+		}
+		if err := checkImportField(field); err != nil {
+			return nil, err
+		}
+		var data storage.FieldData
+		if ext == NumpyFileExt {
+			data, err = parseNumpy(content, field, helper)
+		} else {
+			data, err = parseParquet(content, field, helper)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse file %s: %w", filePath, err)
+		}
+		return Columns{field.GetFieldID(): data}, nil
+	default:
+		return nil, fmt.Errorf("unsupported file type of %s", filePath)
+	}
+}
+
+// Merge adds the columns of src to dst, a field is not allowed to be provided by multiple files
+func Merge(dst Columns, src Columns) error {
+	for fieldID, data := range src {
+		if _, ok := dst[fieldID]; ok {
+			return fmt.Errorf("field %d is provided more than once", fieldID)
+		}
+		dst[fieldID] = data
+	}
+	return nil
+}
+
+// Validate checks that all the fields except the system fields and the auto id primary key are provided
+// with the same number of rows, returns the number of rows.
+func Validate(columns Columns, schema *schemapb.CollectionSchema) (int, error) {
+	rowCount := -1
+	for _, field := range schema.GetFields() {
+		if isSystemField(field) {
+			continue
+		}
+		data, ok := columns[field.GetFieldID()]
+		if field.GetIsPrimaryKey() && field.GetAutoID() {
+			if ok {
+				return 0, fmt.Errorf("primary key %s is auto generated, should not be provided", field.GetName())
+			}
+			continue
+		}
+		if !ok {
+			return 0, fmt.Errorf("field %s is not provided", field.GetName())
+		}
+		n := RowCount(data)
+		if rowCount >= 0 && n != rowCount {
+			return 0, fmt.Errorf("field %s has %d rows, but other fields have %d rows", field.GetName(), n, rowCount)
+		}
+		rowCount = n
+	}
+	if rowCount <= 0 {
+		return 0, fmt.Errorf("no row to import")
+	}
+	return rowCount, nil
+}
+
+// RowCount returns the number of rows of the field data
+func RowCount(data storage.FieldData) int {
+	switch d := data.(type) {
+	case *storage.BoolFieldData:
+		return len(d.Data)
+	case *storage.Int8FieldData:
+		return len(d.Data)
+	case *storage.Int16FieldData:
+		return len(d.Data)
+	case *storage.Int32FieldData:
+		return len(d.Data)
+	case *storage.Int64FieldData:
+		return len(d.Data)
+	case *storage.FloatFieldData:
+		return len(d.Data)
+	case *storage.DoubleFieldData:
+		return len(d.Data)
+	case *storage.StringFieldData:
+		return len(d.Data)
+	case *storage.BinaryVectorFieldData:
+		if d.Dim <= 0 {
+			return 0
+		}
+		return len(d.Data) * 8 / d.Dim
+	case *storage.FloatVectorFieldData:
+		if d.Dim <= 0 {
+			return 0
+		}
+		return len(d.Data) / d.Dim
+	default:
+		return 0
+	}
+}
+
+func isSystemField(field *schemapb.FieldSchema) bool {
+	return field.GetFieldID() == common.RowIDField || field.GetFieldID() == common.TimeStampField
+}
+
+// checkImportField rejects the fields which are filled by the data node
+func checkImportField(field *schemapb.FieldSchema) error {
+	if isSystemField(field) {
+		return fmt.Errorf("system field %s should not be provided", field.GetName())
+	}
+	if field.GetIsPrimaryKey() && field.GetAutoID() {
+		return fmt.Errorf("primary key %s is auto generated, should not be provided", field.GetName())
+	}
+	return nil
+}
+
+func getDim(field *schemapb.FieldSchema, helper *typeutil.SchemaHelper) (int, error) {
+	dim, err := helper.GetVectorDimFromID(field.GetFieldID())
+	if err != nil {
+		return 0, err
+	}
+	if dim <= 0 {
+		return 0, fmt.Errorf("invalid dim %d of field %s", dim, field.GetName())
+	}
+	if field.GetDataType() == schemapb.DataType_BinaryVector && dim%8 != 0 {
+		return 0, fmt.Errorf("dim %d of binary vector field %s is not a multiple of 8", dim, field.GetName())
+	}
+	return dim, nil
+}
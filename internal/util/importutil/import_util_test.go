@@ -0,0 +1,260 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSchema(autoID bool) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name:   "import",
+		AutoID: autoID,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: autoID, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Double},
+			{FieldID: 103, Name: "name", DataType: schemapb.DataType_String},
+			{FieldID: 104, Name: "flag", DataType: schemapb.DataType_Bool},
+			{FieldID: 105, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}}},
+			{FieldID: 106, Name: "bvec", DataType: schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}}},
+		},
+	}
+}
+
+// newNumpy builds a .npy file of version 1.0 with the little endian values
+func newNumpy(t *testing.T, descr string, shape string, values interface{}) []byte {
+	dict := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", descr, shape)
+	// the header is padded to align the data by 64 bytes
+	for (len(numpyMagic)+4+len(dict)+1)%64 != 0 {
+		dict += " "
+	}
+	dict += "\n"
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(numpyMagic)
+	buf.Write([]byte{1, 0})
+	assert.Nil(t, binary.Write(buf, binary.LittleEndian, uint16(len(dict))))
+	buf.WriteString(dict)
+	assert.Nil(t, binary.Write(buf, binary.LittleEndian, values))
+	return buf.Bytes()
+}
+
+func TestParse_JSON(t *testing.T) {
+	schema := newTestSchema(false)
+
+	t.Run("all fields", func(t *testing.T) {
+		content := []byte(`{
+			"pk": [1, 2],
+			"age": [10, 20],
+			"score": [0.5, 1.5],
+			"name": ["a", "b"],
+			"flag": [true, false],
+			"vec": [[0.1, 0.2, 0.3, 0.4], [0.5, 0.6, 0.7, 0.8]],
+			"bvec": [[1, 2], [254, 255]]
+		}`)
+		columns, err := Parse("files/a.json", content, schema)
+		assert.Nil(t, err)
+		assert.Equal(t, 7, len(columns))
+		assert.Equal(t, []int64{1, 2}, columns[100].(*storage.Int64FieldData).Data)
+		assert.Equal(t, []int32{10, 20}, columns[101].(*storage.Int32FieldData).Data)
+		assert.Equal(t, []float64{0.5, 1.5}, columns[102].(*storage.DoubleFieldData).Data)
+		assert.Equal(t, []string{"a", "b"}, columns[103].(*storage.StringFieldData).Data)
+		assert.Equal(t, []bool{true, false}, columns[104].(*storage.BoolFieldData).Data)
+		vec := columns[105].(*storage.FloatVectorFieldData)
+		assert.Equal(t, 4, vec.Dim)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8}, vec.Data)
+		bvec := columns[106].(*storage.BinaryVectorFieldData)
+		assert.Equal(t, 16, bvec.Dim)
+		assert.Equal(t, []byte{1, 2, 254, 255}, bvec.Data)
+
+		rows, err := Validate(columns, schema)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, rows)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		contents := []string{
+			`[1, 2]`,
+			`{"unknown": [1]}`,
+			`{"RowID": [1]}`,
+			`{"age": [1.5]}`,
+			`{"age": ["1"]}`,
+			`{"vec": [[0.1, 0.2, 0.3]]}`,
+			`{"bvec": [[1, 2, 3]]}`,
+			`{"bvec": [[1, 256]]}`,
+		}
+		for _, content := range contents {
+			_, err := Parse("a.json", []byte(content), schema)
+			assert.NotNil(t, err, content)
+		}
+	})
+
+	t.Run("auto id", func(t *testing.T) {
+		_, err := Parse("a.json", []byte(`{"pk": [1]}`), newTestSchema(true))
+		assert.NotNil(t, err)
+	})
+}
+
+func TestParse_Numpy(t *testing.T) {
+	schema := newTestSchema(false)
+
+	t.Run("all fields", func(t *testing.T) {
+		files := map[string][]byte{
+			"pk.npy":    newNumpy(t, "<i8", "2,", []int64{1, 2}),
+			"age.npy":   newNumpy(t, "<i4", "2,", []int32{10, 20}),
+			"score.npy": newNumpy(t, "<f8", "2,", []float64{0.5, 1.5}),
+			"flag.npy":  newNumpy(t, "|b1", "2,", []bool{true, false}),
+			"vec.npy":   newNumpy(t, "<f4", "2, 4", []float32{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8}),
+			"bvec.npy":  newNumpy(t, "|u1", "2, 2", []uint8{1, 2, 254, 255}),
+		}
+		columns := make(Columns)
+		for name, content := range files {
+			parsed, err := Parse("files/"+name, content, schema)
+			assert.Nil(t, err, name)
+			assert.Nil(t, Merge(columns, parsed))
+		}
+		assert.Equal(t, []int64{1, 2}, columns[100].(*storage.Int64FieldData).Data)
+		assert.Equal(t, []int32{10, 20}, columns[101].(*storage.Int32FieldData).Data)
+		assert.Equal(t, []float64{0.5, 1.5}, columns[102].(*storage.DoubleFieldData).Data)
+		assert.Equal(t, []bool{true, false}, columns[104].(*storage.BoolFieldData).Data)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8}, columns[105].(*storage.FloatVectorFieldData).Data)
+		assert.Equal(t, []byte{1, 2, 254, 255}, columns[106].(*storage.BinaryVectorFieldData).Data)
+
+		// name is not provided
+		_, err := Validate(columns, schema)
+		assert.NotNil(t, err)
+
+		// provided twice
+		parsed, err := Parse("pk.npy", files["pk.npy"], schema)
+		assert.Nil(t, err)
+		assert.NotNil(t, Merge(columns, parsed))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cases := []struct {
+			name    string
+			content []byte
+		}{
+			{"unknown.npy", newNumpy(t, "<i8", "1,", []int64{1})},
+			{"name.npy", newNumpy(t, "<i8", "1,", []int64{1})},
+			{"pk.npy", []byte("not a numpy file")},
+			{"pk.npy", newNumpy(t, ">i8", "1,", []int64{1})},
+			{"pk.npy", newNumpy(t, "<i4", "1,", []int32{1})},
+			{"pk.npy", newNumpy(t, "<i8", "2,", []int64{1})},
+			{"pk.npy", newNumpy(t, "<i8", "1, 1", []int64{1})},
+			{"vec.npy", newNumpy(t, "<f4", "1, 3", []float32{1, 2, 3})},
+			{"vec.npy", newNumpy(t, "<f4", "4,", []float32{1, 2, 3, 4})},
+			{"pk.csv", newNumpy(t, "<i8", "1,", []int64{1})},
+		}
+		for _, c := range cases {
+			_, err := Parse(c.name, c.content, schema)
+			assert.NotNil(t, err, c.name)
+		}
+	})
+
+	t.Run("fortran order", func(t *testing.T) {
+		content := newNumpy(t, "<i8", "1,", []int64{1})
+		content = bytes.Replace(content, []byte("False"), []byte("True "), 1)
+		_, err := Parse("pk.npy", content, schema)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestParse_Parquet(t *testing.T) {
+	schema := newTestSchema(false)
+
+	newParquet := func(dataType schemapb.DataType, add func(w *storage.PayloadWriter) error) []byte {
+		w, err := storage.NewPayloadWriter(dataType)
+		assert.Nil(t, err)
+		defer w.Close()
+		assert.Nil(t, add(w))
+		assert.Nil(t, w.FinishPayloadWriter())
+		buf, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+		return append([]byte(nil), buf...)
+	}
+
+	pk := newParquet(schemapb.DataType_Int64, func(w *storage.PayloadWriter) error {
+		return w.AddInt64ToPayload([]int64{1, 2})
+	})
+	columns, err := Parse("pk.parquet", pk, schema)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2}, columns[100].(*storage.Int64FieldData).Data)
+
+	name := newParquet(schemapb.DataType_String, func(w *storage.PayloadWriter) error {
+		if err := w.AddOneStringToPayload("a"); err != nil {
+			return err
+		}
+		return w.AddOneStringToPayload("b")
+	})
+	columns, err = Parse("name.parquet", name, schema)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, columns[103].(*storage.StringFieldData).Data)
+
+	vec := newParquet(schemapb.DataType_FloatVector, func(w *storage.PayloadWriter) error {
+		return w.AddFloatVectorToPayload([]float32{1, 2, 3, 4, 5, 6, 7, 8}, 4)
+	})
+	columns, err = Parse("vec.parquet", vec, schema)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, RowCount(columns[105]))
+
+	// dim mismatch
+	vec = newParquet(schemapb.DataType_FloatVector, func(w *storage.PayloadWriter) error {
+		return w.AddFloatVectorToPayload([]float32{1, 2}, 2)
+	})
+	_, err = Parse("vec.parquet", vec, schema)
+	assert.NotNil(t, err)
+
+	_, err = Parse("pk.parquet", []byte{}, schema)
+	assert.NotNil(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	columns := Columns{
+		101: &storage.Int32FieldData{Data: []int32{1, 2}},
+		102: &storage.DoubleFieldData{Data: []float64{1, 2}},
+		103: &storage.StringFieldData{Data: []string{"a", "b"}},
+		104: &storage.BoolFieldData{Data: []bool{true, false}},
+		105: &storage.FloatVectorFieldData{Data: make([]float32, 8), Dim: 4},
+		106: &storage.BinaryVectorFieldData{Data: make([]byte, 4), Dim: 16},
+	}
+	rows, err := Validate(columns, newTestSchema(true))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, rows)
+
+	// primary key is required without auto id
+	_, err = Validate(columns, newTestSchema(false))
+	assert.NotNil(t, err)
+
+	columns[100] = &storage.Int64FieldData{Data: []int64{1}}
+	_, err = Validate(columns, newTestSchema(false))
+	assert.NotNil(t, err)
+	_, err = Validate(columns, newTestSchema(true))
+	assert.NotNil(t, err)
+
+	_, err = Validate(Columns{}, &schemapb.CollectionSchema{})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package importutil
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// parseJSON parses a column based json object, each key is a field name and each value is the array of rows
+func parseJSON(content []byte, helper *typeutil.SchemaHelper) (Columns, error) {
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+
+	columns := make(Columns, len(raw))
+	for name, value := range raw {
+		field, err := helper.GetFieldFromName(name)
+		if err != nil {
+			return nil, fmt.Errorf("json key %s does not match any field: %w", name, err)
+		}
+		if err := checkImportField(field); err != nil {
+			return nil, err
+		}
+		data, err := parseJSONColumn(value, field, helper)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse field %s: %w", name, err)
+		}
+		columns[field.GetFieldID()] = data
+	}
+	return columns, nil
+}
+
+func parseJSONColumn(value json.RawMessage, field *schemapb.FieldSchema, helper *typeutil.SchemaHelper) (storage.FieldData, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := &storage.BoolFieldData{}
+		err := json.Unmarshal(value, &data.Data)
+		return data, err
+	case schemapb.DataType_Int8:
+		data := &storage.Int8FieldData{}
+		err := json.Unmarshal(value, &data.Data)
+		return data, err
+	case schemapb.DataType_Int16:
+		data := &storage.Int16FieldData{}
+		err := json.Unmarshal(value, &data.Data)
+		return data, err
+	case schemapb.DataType_Int32:
+		data := &storage.Int32FieldData{}
+		err := json.Unmarshal(value, &data.Data)
+		return data, err
+	case schemapb.DataType_Int64:
+		data := &storage.Int64FieldData{}
+		err := json.Unmarshal(value, &data.Data)
+		return data, err
+	case schemapb.DataType_Float:
+		data := &storage.FloatFieldData{}
+		err := json.Unmarshal(value, &data.Data)
+		return data, err
+	case schemapb.DataType_Double:
+		data := &storage.DoubleFieldData{}
+		err := json.Unmarshal(value, &data.Data)
+		return data, err
+	case schemapb.DataType_String:
+		data := &storage.StringFieldData{}
+		err := json.Unmarshal(value, &data.Data)
+		return data, err
+	case schemapb.DataType_FloatVector:
+		dim, err := getDim(field, helper)
+		if err != nil {
+			return nil, err
+		}
+		var rows [][]float32
+		if err := json.Unmarshal(value, &rows); err != nil {
+			return nil, err
+		}
+		data := &storage.FloatVectorFieldData{Dim: dim, Data: make([]float32, 0, len(rows)*dim)}
+		for i, row := range rows {
+			if len(row) != dim {
+				return nil, fmt.Errorf("row %d has %d dimensions, expect %d", i, len(row), dim)
+			}
+			data.Data = append(data.Data, row...)
+		}
+		return data, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getDim(field, helper)
+		if err != nil {
+			return nil, err
+		}
+		// rows are arrays of bytes rather than base64 strings
+		var rows [][]uint16
+		if err := json.Unmarshal(value, &rows); err != nil {
+			return nil, err
+		}
+		data := &storage.BinaryVectorFieldData{Dim: dim, Data: make([]byte, 0, len(rows)*dim/8)}
+		for i, row := range rows {
+			if len(row) != dim/8 {
+				return nil, fmt.Errorf("row %d has %d bytes, expect %d", i, len(row), dim/8)
+			}
+			for _, b := range row {
+				if b > 0xff {
+					return nil, fmt.Errorf("row %d has invalid byte %d", i, b)
+				}
+				data.Data = append(data.Data, byte(b))
+			}
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s", field.GetDataType().String())
+	}
+}
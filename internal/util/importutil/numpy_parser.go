@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// numpyMagic is the leading bytes of a .npy file
+const numpyMagic = "\x93NUMPY"
+
+var (
+	numpyDescrRegex   = regexp.MustCompile(`'descr'\s*:\s*'([^']*)'`)
+	numpyFortranRegex = regexp.MustCompile(`'fortran_order'\s*:\s*(True|False)`)
+	numpyShapeRegex   = regexp.MustCompile(`'shape'\s*:\s*\(([^)]*)\)`)
+)
+
+// numpyHeader is the array description in the header of a .npy file
+type numpyHeader struct {
+	descr        string
+	fortranOrder bool
+	shape        []int
+}
+
+// parseNumpy parses a .npy file into the field data, the element type must match the field:
+// bool for Bool, little endian integers and floats of the same width for the numeric fields,
+// 2-d float32 arrays of (rows, dim) for FloatVector and 2-d uint8 arrays of (rows, dim/8) for BinaryVector
+func parseNumpy(content []byte, field *schemapb.FieldSchema, helper *typeutil.SchemaHelper) (storage.FieldData, error) {
+	header, body, err := parseNumpyHeader(content)
+	if err != nil {
+		return nil, err
+	}
+	if header.fortranOrder {
+		return nil, errors.New("fortran order array is not supported")
+	}
+
+	dataType := field.GetDataType()
+	expectDescr, ok := numpyDescr[dataType]
+	if !ok {
+		return nil, fmt.Errorf("data type %s is not supported by numpy file", dataType.String())
+	}
+	// single byte types have no byte order
+	descr := strings.Replace(header.descr, "|", "<", 1)
+	if descr != expectDescr {
+		return nil, fmt.Errorf("numpy dtype %s does not match data type %s", header.descr, dataType.String())
+	}
+
+	rows, width := 0, 1
+	if typeutil.IsVectorType(dataType) {
+		dim, err := getDim(field, helper)
+		if err != nil {
+			return nil, err
+		}
+		width = dim
+		if dataType == schemapb.DataType_BinaryVector {
+			width = dim / 8
+		}
+		if len(header.shape) != 2 || header.shape[1] != width {
+			return nil, fmt.Errorf("numpy shape %v does not match (rows, %d)", header.shape, width)
+		}
+		rows = header.shape[0]
+	} else {
+		if len(header.shape) != 1 {
+			return nil, fmt.Errorf("numpy shape %v is not one dimensional", header.shape)
+		}
+		rows = header.shape[0]
+	}
+
+	// the element size is the digit of descr
+	elemSize := int(expectDescr[2] - '0')
+	count := rows * width
+	if len(body) != count*elemSize {
+		return nil, fmt.Errorf("numpy data has %d bytes, expect %d bytes of shape %v", len(body), count*elemSize, header.shape)
+	}
+
+	var data storage.FieldData
+	var values interface{}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		d := &storage.BoolFieldData{Data: make([]bool, count)}
+		data, values = d, d.Data
+	case schemapb.DataType_Int8:
+		d := &storage.Int8FieldData{Data: make([]int8, count)}
+		data, values = d, d.Data
+	case schemapb.DataType_Int16:
+		d := &storage.Int16FieldData{Data: make([]int16, count)}
+		data, values = d, d.Data
+	case schemapb.DataType_Int32:
+		d := &storage.Int32FieldData{Data: make([]int32, count)}
+		data, values = d, d.Data
+	case schemapb.DataType_Int64:
+		d := &storage.Int64FieldData{Data: make([]int64, count)}
+		data, values = d, d.Data
+	case schemapb.DataType_Float:
+		d := &storage.FloatFieldData{Data: make([]float32, count)}
+		data, values = d, d.Data
+	case schemapb.DataType_Double:
+		d := &storage.DoubleFieldData{Data: make([]float64, count)}
+		data, values = d, d.Data
+	case schemapb.DataType_FloatVector:
+		d := &storage.FloatVectorFieldData{Data: make([]float32, count), Dim: width}
+		data, values = d, d.Data
+	case schemapb.DataType_BinaryVector:
+		d := &storage.BinaryVectorFieldData{Data: make([]byte, count), Dim: width * 8}
+		data, values = d, d.Data
+	}
+	if err := binary.Read(bytes.NewReader(body), binary.LittleEndian, values); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// numpyDescr is the little endian numpy dtype of each data type
+var numpyDescr = map[schemapb.DataType]string{
+	schemapb.DataType_Bool:         "<b1",
+	schemapb.DataType_Int8:         "<i1",
+	schemapb.DataType_Int16:        "<i2",
+	schemapb.DataType_Int32:        "<i4",
+	schemapb.DataType_Int64:        "<i8",
+	schemapb.DataType_Float:        "<f4",
+	schemapb.DataType_Double:       "<f8",
+	schemapb.DataType_FloatVector:  "<f4",
+	schemapb.DataType_BinaryVector: "<u1",
+}
+
+// parseNumpyHeader parses the header of a .npy file of version 1.0, 2.0 or 3.0, returns the header and the array data
+func parseNumpyHeader(content []byte) (*numpyHeader, []byte, error) {
+	if len(content) < len(numpyMagic)+2 || string(content[:len(numpyMagic)]) != numpyMagic {
+		return nil, nil, errors.New("not a numpy file")
+	}
+	major := content[len(numpyMagic)]
+	offset := len(numpyMagic) + 2
+
+	var headerLen int
+	switch major {
+	case 1:
+		if len(content) < offset+2 {
+			return nil, nil, errors.New("numpy header is truncated")
+		}
+		headerLen = int(binary.LittleEndian.Uint16(content[offset:]))
+		offset += 2
+	case 2, 3:
+		if len(content) < offset+4 {
+			return nil, nil, errors.New("numpy header is truncated")
+		}
+		headerLen = int(binary.LittleEndian.Uint32(content[offset:]))
+		offset += 4
+	default:
+		return nil, nil, fmt.Errorf("unsupported numpy format version %d", major)
+	}
+	if len(content) < offset+headerLen {
+		return nil, nil, errors.New("numpy header is truncated")
+	}
+	dict := string(content[offset : offset+headerLen])
+
+	header := &numpyHeader{}
+	matches := numpyDescrRegex.FindStringSubmatch(dict)
+	if matches == nil {
+		return nil, nil, fmt.Errorf("descr not found in numpy header %s", dict)
+	}
+	header.descr = matches[1]
+
+	matches = numpyFortranRegex.FindStringSubmatch(dict)
+	if matches == nil {
+		return nil, nil, fmt.Errorf("fortran_order not found in numpy header %s", dict)
+	}
+	header.fortranOrder = matches[1] == "True"
+
+	matches = numpyShapeRegex.FindStringSubmatch(dict)
+	if matches == nil {
+		return nil, nil, fmt.Errorf("shape not found in numpy header %s", dict)
+	}
+	for _, s := range strings.Split(matches[1], ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return nil, nil, fmt.Errorf("invalid shape in numpy header %s", dict)
+		}
+		header.shape = append(header.shape, n)
+	}
+	return header, content[offset+headerLen:], nil
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package importutil
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// parseParquet parses a single column parquet file through the payload reader of storage,
+// the values are copied since the memory of reader is released on close
+func parseParquet(content []byte, field *schemapb.FieldSchema, helper *typeutil.SchemaHelper) (storage.FieldData, error) {
+	reader, err := storage.NewPayloadReader(field.GetDataType(), content)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		values, err := reader.GetBoolFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		return &storage.BoolFieldData{Data: append([]bool(nil), values...)}, nil
+	case schemapb.DataType_Int8:
+		values, err := reader.GetInt8FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		return &storage.Int8FieldData{Data: append([]int8(nil), values...)}, nil
+	case schemapb.DataType_Int16:
+		values, err := reader.GetInt16FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		return &storage.Int16FieldData{Data: append([]int16(nil), values...)}, nil
+	case schemapb.DataType_Int32:
+		values, err := reader.GetInt32FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		return &storage.Int32FieldData{Data: append([]int32(nil), values...)}, nil
+	case schemapb.DataType_Int64:
+		values, err := reader.GetInt64FromPayload()
+		if err != nil {
+			return nil, err
+		}
+		return &storage.Int64FieldData{Data: append([]int64(nil), values...)}, nil
+	case schemapb.DataType_Float:
+		values, err := reader.GetFloatFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		return &storage.FloatFieldData{Data: append([]float32(nil), values...)}, nil
+	case schemapb.DataType_Double:
+		values, err := reader.GetDoubleFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		return &storage.DoubleFieldData{Data: append([]float64(nil), values...)}, nil
+	case schemapb.DataType_String:
+		length, err := reader.GetPayloadLengthFromReader()
+		if err != nil {
+			return nil, err
+		}
+		data := &storage.StringFieldData{Data: make([]string, 0, length)}
+		for i := 0; i < length; i++ {
+			value, err := reader.GetOneStringFromPayload(i)
+			if err != nil {
+				return nil, err
+			}
+			data.Data = append(data.Data, value)
+		}
+		return data, nil
+	case schemapb.DataType_FloatVector:
+		values, dim, err := reader.GetFloatVectorFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkParquetDim(field, helper, dim); err != nil {
+			return nil, err
+		}
+		return &storage.FloatVectorFieldData{Data: append([]float32(nil), values...), Dim: dim}, nil
+	case schemapb.DataType_BinaryVector:
+		values, dim, err := reader.GetBinaryVectorFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkParquetDim(field, helper, dim); err != nil {
+			return nil, err
+		}
+		return &storage.BinaryVectorFieldData{Data: append([]byte(nil), values...), Dim: dim}, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s", field.GetDataType().String())
+	}
+}
+
+func checkParquetDim(field *schemapb.FieldSchema, helper *typeutil.SchemaHelper, dim int) error {
+	expect, err := getDim(field, helper)
+	if err != nil {
+		return err
+	}
+	if dim != expect {
+		return fmt.Errorf("parquet dim %d does not match dim %d of field %s", dim, expect, field.GetName())
+	}
+	return nil
+}
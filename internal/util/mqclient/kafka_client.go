@@ -0,0 +1,113 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// kafkaPartition is the only partition of a topic, the channels of msgstream are not partitioned in kafka
+const kafkaPartition int32 = 0
+
+type kafkaClient struct {
+	client sarama.Client
+}
+
+var kafkaClientInstance *kafkaClient
+var kafkaClientMu sync.Mutex
+
+// NewKafkaConfig returns the sarama config of kafka clients
+func NewKafkaConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	// message properties are carried by record headers, which require kafka 0.11
+	config.Version = sarama.V0_11_0_0
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Producer.Partitioner = sarama.NewManualPartitioner
+	return config
+}
+
+// NewKafkaClient creates a kafka client connected to the brokers
+func NewKafkaClient(brokers []string, config *sarama.Config) (*kafkaClient, error) {
+	c, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		log.Error("Set kafka client failed, error", zap.Error(err))
+		return nil, err
+	}
+	return &kafkaClient{client: c}, nil
+}
+
+// GetKafkaClientInstance returns the kafka client shared by msgstreams, it is created at the first successful call
+func GetKafkaClientInstance(brokers []string, config *sarama.Config) (*kafkaClient, error) {
+	kafkaClientMu.Lock()
+	defer kafkaClientMu.Unlock()
+	if kafkaClientInstance != nil {
+		return kafkaClientInstance, nil
+	}
+	c, err := NewKafkaClient(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+	kafkaClientInstance = c
+	return c, nil
+}
+
+func (kc *kafkaClient) CreateProducer(options ProducerOptions) (Producer, error) {
+	p, err := sarama.NewSyncProducerFromClient(kc.client)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaProducer{p: p, topic: options.Topic}, nil
+}
+
+// Subscribe creates a consumer which reads the whole partition and commits its offsets to the group named
+// after the subscription. Consumers of the same subscription are not coordinated, so only the subscription
+// types which hold with a single consumer are supported, i.e. Exclusive and KeyShared as used by msgstream.
+func (kc *kafkaClient) Subscribe(options ConsumerOptions) (Consumer, error) {
+	if options.Type != Exclusive && options.Type != KeyShared {
+		return nil, fmt.Errorf("subscription type %d is not supported by kafka, only Exclusive and KeyShared are supported", options.Type)
+	}
+	return newKafkaConsumer(kc.client, options)
+}
+
+func (kc *kafkaClient) EarliestMessageID() MessageID {
+	return &kafkaID{messageID: sarama.OffsetOldest}
+}
+
+func (kc *kafkaClient) StringToMsgID(id string) (MessageID, error) {
+	offset, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{messageID: offset}, nil
+}
+
+func (kc *kafkaClient) BytesToMsgID(id []byte) (MessageID, error) {
+	offset, err := DeserializeKafkaID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{messageID: offset}, nil
+}
+
+func (kc *kafkaClient) Close() {
+	if err := kc.client.Close(); err != nil {
+		log.Warn("Close kafka client failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockKafkaBroker starts an in-process broker serving the topic with the payloads from offset 0,
+// committedOffset is the offset of the subscription in the consumer group, -1 if nothing was acked
+func newMockKafkaBroker(t *testing.T, topic string, subName string, committedOffset int64, payloads ...string) *sarama.MockBroker {
+	broker := sarama.NewMockBroker(t, 1)
+	fetchResponse := sarama.NewMockFetchResponse(t, 1).SetVersion(4).SetHighWaterMark(topic, kafkaPartition, int64(len(payloads)))
+	for i, payload := range payloads {
+		fetchResponse.SetMessage(topic, kafkaPartition, int64(i), sarama.StringEncoder(payload))
+	}
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(topic, kafkaPartition, broker.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).SetVersion(1).
+			SetOffset(topic, kafkaPartition, sarama.OffsetOldest, 0).
+			SetOffset(topic, kafkaPartition, sarama.OffsetNewest, int64(len(payloads))),
+		"FetchRequest":   fetchResponse,
+		"ProduceRequest": sarama.NewMockProduceResponse(t).SetVersion(3),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, subName, broker),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset(subName, topic, kafkaPartition, committedOffset, "", sarama.ErrNoError),
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t),
+	})
+	return broker
+}
+
+func receiveKafkaMessage(t *testing.T, consumer Consumer) ConsumerMessage {
+	select {
+	case msg, ok := <-consumer.Chan():
+		assert.True(t, ok)
+		return msg
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "receive kafka message timeout")
+	}
+	return nil
+}
+
+func TestKafkaClient(t *testing.T) {
+	topic := "TestKafkaClient"
+	broker := newMockKafkaBroker(t, topic, "sub", -1)
+	defer broker.Close()
+
+	client, err := NewKafkaClient([]string{broker.Addr()}, NewKafkaConfig())
+	assert.Nil(t, err)
+	defer client.Close()
+
+	earliest := client.EarliestMessageID()
+	assert.Equal(t, sarama.OffsetOldest, earliest.(*kafkaID).messageID)
+
+	id, err := client.StringToMsgID("10")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), id.(*kafkaID).messageID)
+	_, err = client.StringToMsgID("offset")
+	assert.Error(t, err)
+
+	id, err = client.BytesToMsgID(SerializeKafkaID(20))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(20), id.(*kafkaID).messageID)
+	_, err = client.BytesToMsgID([]byte{1})
+	assert.Error(t, err)
+
+	producer, err := client.CreateProducer(ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+	assert.Equal(t, topic, producer.(*kafkaProducer).Topic())
+
+	_, err = producer.Send(context.TODO(), &ProducerMessage{
+		Payload:    []byte("payload"),
+		Properties: map[string]string{"key": "value"},
+	})
+	assert.Nil(t, err)
+
+	_, err = NewKafkaClient([]string{}, NewKafkaConfig())
+	assert.Error(t, err)
+}
+
+func TestKafkaConsumer(t *testing.T) {
+	topic := "TestKafkaConsumer"
+	subName := "sub"
+	broker := newMockKafkaBroker(t, topic, subName, -1, "a", "b", "c")
+	defer broker.Close()
+
+	client, err := NewKafkaClient([]string{broker.Addr()}, NewKafkaConfig())
+	assert.Nil(t, err)
+	defer client.Close()
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            subName,
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+		BufSize:                     1024,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, subName, consumer.Subscription())
+
+	for i, payload := range []string{"a", "b", "c"} {
+		msg := receiveKafkaMessage(t, consumer)
+		assert.Equal(t, topic, msg.Topic())
+		assert.Equal(t, payload, string(msg.Payload()))
+		assert.Equal(t, int64(i), msg.ID().(*kafkaID).messageID)
+		assert.NotNil(t, msg.Properties())
+		consumer.Ack(msg)
+	}
+	offset, _ := consumer.(*kafkaConsumer).pom.NextOffset()
+	assert.Equal(t, int64(3), offset)
+
+	err = consumer.Seek(&kafkaID{messageID: 1})
+	assert.Nil(t, err)
+	msg := receiveKafkaMessage(t, consumer)
+	assert.Equal(t, "b", string(msg.Payload()))
+
+	err = consumer.Seek(&kafkaID{messageID: 10})
+	assert.Error(t, err)
+	err = consumer.Seek(client.EarliestMessageID())
+	assert.Nil(t, err)
+	msg = receiveKafkaMessage(t, consumer)
+	assert.Equal(t, "a", string(msg.Payload()))
+
+	consumer.Close()
+	_, ok := <-consumer.Chan()
+	assert.False(t, ok)
+}
+
+func TestKafkaConsumer_CommittedOffset(t *testing.T) {
+	topic := "TestKafkaConsumer_CommittedOffset"
+	subName := "sub"
+	broker := newMockKafkaBroker(t, topic, subName, 2, "a", "b", "c")
+	defer broker.Close()
+
+	client, err := NewKafkaClient([]string{broker.Addr()}, NewKafkaConfig())
+	assert.Nil(t, err)
+	defer client.Close()
+
+	// consumers of the same subscription are not coordinated
+	for _, subType := range []SubscriptionType{Shared, Failover} {
+		_, err = client.Subscribe(ConsumerOptions{
+			Topic:            topic,
+			SubscriptionName: subName,
+			Type:             subType,
+		})
+		assert.Error(t, err)
+	}
+
+	// the subscription resumes from the committed offset whatever the initial position is
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            subName,
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+	})
+	assert.Nil(t, err)
+	defer consumer.Close()
+
+	msg := receiveKafkaMessage(t, consumer)
+	assert.Equal(t, "c", string(msg.Payload()))
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+type kafkaConsumer struct {
+	consumer      sarama.Consumer
+	offsetManager sarama.OffsetManager
+	pom           sarama.PartitionOffsetManager
+	topic         string
+	subName       string
+	msgChannel    chan ConsumerMessage
+
+	mu     sync.Mutex // guards pc and stopCh against concurrent Seek and Close
+	pc     sarama.PartitionConsumer
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// newKafkaConsumer starts consuming the topic from the committed offset of the subscription, or from
+// the initial position if nothing has been acked, like a durable pulsar subscription does
+func newKafkaConsumer(client sarama.Client, options ConsumerOptions) (*kafkaConsumer, error) {
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, err
+	}
+	offsetManager, err := sarama.NewOffsetManagerFromClient(options.SubscriptionName, client)
+	if err != nil {
+		consumer.Close()
+		return nil, err
+	}
+	pom, err := offsetManager.ManagePartition(options.Topic, kafkaPartition)
+	if err != nil {
+		offsetManager.Close()
+		consumer.Close()
+		return nil, err
+	}
+
+	kc := &kafkaConsumer{
+		consumer:      consumer,
+		offsetManager: offsetManager,
+		pom:           pom,
+		topic:         options.Topic,
+		subName:       options.SubscriptionName,
+		msgChannel:    make(chan ConsumerMessage, options.BufSize),
+	}
+	offset, _ := pom.NextOffset()
+	if offset < 0 {
+		offset = sarama.OffsetNewest
+		if options.SubscriptionInitialPosition == SubscriptionPositionEarliest {
+			offset = sarama.OffsetOldest
+		}
+	}
+	if err := kc.start(offset); err != nil {
+		kc.closeManagers()
+		return nil, err
+	}
+	return kc, nil
+}
+
+func (kc *kafkaConsumer) Subscription() string {
+	return kc.subName
+}
+
+func (kc *kafkaConsumer) Chan() <-chan ConsumerMessage {
+	return kc.msgChannel
+}
+
+// Seek restarts consuming at the offset, the message at the offset is the next one received
+func (kc *kafkaConsumer) Seek(id MessageID) error {
+	kc.mu.Lock()
+	defer kc.mu.Unlock()
+	kc.stop()
+	kc.drain()
+	return kc.start(id.(*kafkaID).messageID)
+}
+
+// Ack commits the offset after the message to the consumer group of the subscription
+func (kc *kafkaConsumer) Ack(message ConsumerMessage) {
+	km := message.(*kafkaMessage)
+	kc.pom.MarkOffset(km.msg.Offset+1, "")
+}
+
+func (kc *kafkaConsumer) Close() {
+	kc.mu.Lock()
+	defer kc.mu.Unlock()
+	kc.stop()
+	kc.closeManagers()
+	close(kc.msgChannel)
+}
+
+// start consumes the partition from the offset and forwards the messages to msgChannel, the caller must hold kc.mu
+func (kc *kafkaConsumer) start(offset int64) error {
+	pc, err := kc.consumer.ConsumePartition(kc.topic, kafkaPartition, offset)
+	if err != nil {
+		return err
+	}
+	stopCh := make(chan struct{})
+	kc.pc = pc
+	kc.stopCh = stopCh
+
+	kc.wg.Add(1)
+	go func() {
+		defer kc.wg.Done()
+		for {
+			select {
+			case msg, ok := <-pc.Messages():
+				if !ok {
+					return
+				}
+				select {
+				case kc.msgChannel <- &kafkaMessage{msg: msg}:
+				case <-stopCh:
+					return
+				}
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// stop stops consuming the partition, the caller must hold kc.mu
+func (kc *kafkaConsumer) stop() {
+	if kc.pc == nil {
+		return
+	}
+	close(kc.stopCh)
+	kc.wg.Wait()
+	if err := kc.pc.Close(); err != nil {
+		log.Warn("Close kafka partition consumer failed", zap.String("topic", kc.topic), zap.Error(err))
+	}
+	kc.pc = nil
+}
+
+// drain drops the messages received but not consumed yet
+func (kc *kafkaConsumer) drain() {
+	for {
+		select {
+		case <-kc.msgChannel:
+		default:
+			return
+		}
+	}
+}
+
+// closeManagers flushes the committed offset and releases the consumer
+func (kc *kafkaConsumer) closeManagers() {
+	if err := kc.pom.Close(); err != nil {
+		log.Warn("Close kafka partition offset manager failed", zap.String("topic", kc.topic), zap.Error(err))
+	}
+	if err := kc.offsetManager.Close(); err != nil {
+		log.Warn("Close kafka offset manager failed", zap.String("subscription", kc.subName), zap.Error(err))
+	}
+	if err := kc.consumer.Close(); err != nil {
+		log.Warn("Close kafka consumer failed", zap.String("topic", kc.topic), zap.Error(err))
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaID_Serialize(t *testing.T) {
+	kid := &kafkaID{
+		messageID: 8,
+	}
+
+	bin := kid.Serialize()
+	assert.Equal(t, 8, len(bin))
+
+	assert.Zero(t, kid.LedgerID())
+	assert.Zero(t, kid.EntryID())
+	assert.Zero(t, kid.BatchIdx())
+	assert.Equal(t, kafkaPartition, kid.PartitionIdx())
+}
+
+func Test_DeserializeKafkaID(t *testing.T) {
+	bin := SerializeKafkaID(5)
+	id, err := DeserializeKafkaID(bin)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), id)
+
+	_, err = DeserializeKafkaID([]byte{5})
+	assert.Error(t, err)
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package mqclient
+
+import (
+	"github.com/Shopify/sarama"
+)
+
+var _ ConsumerMessage = (*kafkaMessage)(nil)
+
+type kafkaMessage struct {
+	msg *sarama.ConsumerMessage
+}
+
+func (km *kafkaMessage) Topic() string {
+	return km.msg.Topic
+}
+
+func (km *kafkaMessage) Properties() map[string]string {
+	properties := make(map[string]string, len(km.msg.Headers))
+	for _, header := range km.msg.Headers {
+		properties[string(header.Key)] = string(header.Value)
+	}
+	return properties
+}
+
+func (km *kafkaMessage) Payload() []byte {
+	return km.msg.Value
+}
+
+func (km *kafkaMessage) ID() MessageID {
+	return &kafkaID{messageID: km.msg.Offset}
+}
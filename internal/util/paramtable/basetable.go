@@ -158,6 +158,18 @@ func (gp *BaseTable) tryloadFromEnv() {
 		panic(err)
 	}
 
+	kafkaBrokerList := os.Getenv("KAFKA_BROKER_LIST")
+	if kafkaBrokerList == "" {
+		kafkaBrokerList, err = gp.Load("kafka.brokerList")
+		if err != nil {
+			panic(err)
+		}
+	}
+	err = gp.Save("_KafkaBrokerList", kafkaBrokerList)
+	if err != nil {
+		panic(err)
+	}
+
 	rocksmqPath := os.Getenv("ROCKSMQ_PATH")
 	if rocksmqPath == "" {
 		path, err := gp.Load("rocksmq.path")
@@ -37,6 +37,10 @@ type BaseParamTable struct {
 	EtcdConfigPath string
 	EtcdDataDir    string
 
+	// --- MQ ---
+	MqType          string
+	KafkaBrokerList []string
+
 	initOnce sync.Once
 
 	LogConfig *log.Config
@@ -53,6 +57,7 @@ func (p *BaseParamTable) LoadCfgToMemory() {
 	p.initEtcdConf()
 	p.initMetaRootPath()
 	p.initKvRootPath()
+	p.initMqConf()
 	p.initLogCfg()
 }
 
@@ -121,6 +126,23 @@ func (p *BaseParamTable) initKvRootPath() {
 	p.KvRootPath = rootPath + "/" + subPath
 }
 
+func (p *BaseParamTable) initMqConf() {
+	mqType, err := p.LoadWithDefault("mq.type", "pulsar")
+	if err != nil {
+		panic(err)
+	}
+	if mqType != "pulsar" && mqType != "kafka" {
+		panic("mq.type should be pulsar or kafka, got " + mqType)
+	}
+	p.MqType = mqType
+
+	brokerList, err := p.Load("_KafkaBrokerList")
+	if err != nil {
+		panic(err)
+	}
+	p.KafkaBrokerList = strings.Split(brokerList, ",")
+}
+
 func (p *BaseParamTable) initLogCfg() {
 	p.LogConfig = &log.Config{}
 	format, err := p.Load("log.format")
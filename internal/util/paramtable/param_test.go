@@ -32,6 +32,17 @@ func TestBaseParamTable(t *testing.T) {
 	assert.NotEqual(t, Params.KvRootPath, "")
 	t.Logf("kv root path = %s", Params.KvRootPath)
 
+	assert.Equal(t, "pulsar", Params.MqType)
+	assert.NotZero(t, len(Params.KafkaBrokerList))
+	t.Logf("kafka broker list = %s", Params.KafkaBrokerList)
+
+	// test MqType
+	Params.Save("mq.type", "nsq")
+	assert.Panics(t, func() { Params.initMqConf() })
+	Params.Save("mq.type", "kafka")
+	Params.initMqConf()
+	assert.Equal(t, "kafka", Params.MqType)
+
 	// test UseEmbedEtcd
 	Params.Save("etcd.use.embed", "true")
 	assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode))
@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package tlsutil
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// defaultReloadInterval is used when tls.reloadInterval is not set.
+const defaultReloadInterval = 60 * time.Second
+
+// ParamTable stores the TLS settings shared by all grpc servers and clients
+type ParamTable struct {
+	paramtable.BaseTable
+
+	// ReloadInterval is how often the certificate files are checked for changes
+	ReloadInterval time.Duration
+
+	// --- Proxy endpoint ---
+	ProxyTLSEnabled    bool
+	ProxyServerPemPath string
+	ProxyServerKeyPath string
+	ProxyCaPemPath     string
+	ProxyServerName    string
+	ProxyClientAuth    bool
+
+	// --- Inter-component mutual TLS ---
+	InternalTLSEnabled bool
+	InternalPemPath    string
+	InternalKeyPath    string
+	InternalCaPemPath  string
+	InternalServerName string
+}
+
+// Params is the tls parameter table
+var Params ParamTable
+var once sync.Once
+
+// Init initializes the tls parameter table, it panics on inconsistent settings
+func (pt *ParamTable) Init() {
+	once.Do(func() {
+		pt.BaseTable.Init()
+		pt.initParams()
+	})
+}
+
+func (pt *ParamTable) initParams() {
+	pt.initReloadInterval()
+	pt.initProxyTLS()
+	pt.initInternalTLS()
+	pt.checkParams()
+}
+
+func (pt *ParamTable) initReloadInterval() {
+	valueStr, err := pt.LoadWithDefault("tls.reloadInterval", "")
+	if err != nil {
+		panic(err)
+	}
+	if valueStr == "" {
+		pt.ReloadInterval = defaultReloadInterval
+		return
+	}
+	seconds, err := strconv.ParseInt(valueStr, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	pt.ReloadInterval = time.Duration(seconds) * time.Second
+}
+
+func (pt *ParamTable) initProxyTLS() {
+	pt.ProxyTLSEnabled = pt.ParseBool("tls.proxy.enabled", false)
+	if !pt.ProxyTLSEnabled {
+		return
+	}
+	pt.ProxyServerPemPath = pt.loadRequired("tls.proxy.serverPemPath")
+	pt.ProxyServerKeyPath = pt.loadRequired("tls.proxy.serverKeyPath")
+	pt.ProxyCaPemPath = pt.loadOptional("tls.proxy.caPemPath")
+	pt.ProxyServerName = pt.loadOptional("tls.proxy.serverName")
+	pt.ProxyClientAuth = pt.ParseBool("tls.proxy.clientAuth", false)
+}
+
+func (pt *ParamTable) initInternalTLS() {
+	pt.InternalTLSEnabled = pt.ParseBool("tls.internal.enabled", false)
+	if !pt.InternalTLSEnabled {
+		return
+	}
+	pt.InternalPemPath = pt.loadRequired("tls.internal.pemPath")
+	pt.InternalKeyPath = pt.loadRequired("tls.internal.keyPath")
+	pt.InternalCaPemPath = pt.loadRequired("tls.internal.caPemPath")
+	pt.InternalServerName = pt.loadRequired("tls.internal.serverName")
+}
+
+// checkParams rejects client authentication on the proxy port without a CA to verify the clients
+func (pt *ParamTable) checkParams() {
+	if pt.ProxyClientAuth && pt.ProxyCaPemPath == "" {
+		panic("tls.proxy.caPemPath must be set when tls.proxy.clientAuth is true")
+	}
+}
+
+func (pt *ParamTable) loadRequired(key string) string {
+	value, err := pt.Load(key)
+	if err != nil {
+		panic(err)
+	}
+	if value == "" {
+		panic(key + " is empty")
+	}
+	return value
+}
+
+func (pt *ParamTable) loadOptional(key string) string {
+	value, err := pt.LoadWithDefault(key, "")
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package tlsutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamTable(t *testing.T) {
+	var pt ParamTable
+	pt.BaseTable.Init()
+	pt.initParams()
+
+	assert.Equal(t, defaultReloadInterval, pt.ReloadInterval)
+	assert.False(t, pt.ProxyTLSEnabled)
+	assert.False(t, pt.InternalTLSEnabled)
+
+	pt.Save("tls.reloadInterval", "5")
+	pt.initReloadInterval()
+	assert.Equal(t, 5*time.Second, pt.ReloadInterval)
+
+	t.Run("proxy tls", func(t *testing.T) {
+		pt.Save("tls.proxy.enabled", "true")
+		pt.Save("tls.proxy.serverPemPath", "")
+		assert.Panics(t, pt.initProxyTLS)
+
+		pt.Save("tls.proxy.serverPemPath", "/certs/proxy.pem")
+		pt.Save("tls.proxy.serverKeyPath", "/certs/proxy.key")
+		pt.Save("tls.proxy.caPemPath", "")
+		pt.Save("tls.proxy.clientAuth", "true")
+		pt.initProxyTLS()
+		assert.True(t, pt.ProxyTLSEnabled)
+		assert.Equal(t, "/certs/proxy.pem", pt.ProxyServerPemPath)
+		assert.Equal(t, "/certs/proxy.key", pt.ProxyServerKeyPath)
+		assert.True(t, pt.ProxyClientAuth)
+		assert.Panics(t, pt.checkParams)
+
+		pt.Save("tls.proxy.caPemPath", "/certs/proxy-ca.pem")
+		pt.initProxyTLS()
+		pt.checkParams()
+	})
+
+	t.Run("internal tls", func(t *testing.T) {
+		pt.Save("tls.internal.enabled", "true")
+		pt.Save("tls.internal.pemPath", "/certs/node.pem")
+		pt.Save("tls.internal.keyPath", "/certs/node.key")
+		pt.Save("tls.internal.caPemPath", "/certs/ca.pem")
+		pt.Save("tls.internal.serverName", "")
+		assert.Panics(t, pt.initInternalTLS)
+
+		pt.Save("tls.internal.serverName", "milvus.internal")
+		pt.initInternalTLS()
+		assert.True(t, pt.InternalTLSEnabled)
+		assert.Equal(t, "milvus.internal", pt.InternalServerName)
+		pt.checkParams()
+
+		// the internal port of the proxy doesn't depend on the public proxy tls
+		pt.Save("tls.proxy.enabled", "false")
+		pt.initProxyTLS()
+		pt.checkParams()
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
+)
+
+// certReloader keeps an optional key pair and an optional CA bundle in memory and reloads
+// them when the files on disk change. Files are checked at most once per interval, lazily,
+// when a handshake or a dial asks for the current material.
+type certReloader struct {
+	certPath string
+	keyPath  string
+	caPath   string
+	interval time.Duration
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	caPool    *x509.CertPool
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+func newCertReloader(certPath, keyPath, caPath string, interval time.Duration) (*certReloader, error) {
+	r := &certReloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+		caPath:   caPath,
+		interval: interval,
+	}
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	r.modTime = modTime
+	r.lastCheck = time.Now()
+	return r, nil
+}
+
+func (r *certReloader) paths() []string {
+	var paths []string
+	if r.certPath != "" {
+		paths = append(paths, r.certPath, r.keyPath)
+	}
+	if r.caPath != "" {
+		paths = append(paths, r.caPath)
+	}
+	return paths
+}
+
+func (r *certReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, path := range r.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// load reads the files and swaps the in-memory material, the caller must hold r.mu
+// unless r is not shared yet.
+func (r *certReloader) load() error {
+	var cert *tls.Certificate
+	if r.certPath != "" {
+		pair, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+		if err != nil {
+			return fmt.Errorf("load key pair %s, %s failed, error = %w", r.certPath, r.keyPath, err)
+		}
+		cert = &pair
+	}
+	var caPool *x509.CertPool
+	if r.caPath != "" {
+		pem, err := ioutil.ReadFile(r.caPath)
+		if err != nil {
+			return fmt.Errorf("read ca bundle %s failed, error = %w", r.caPath, err)
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificate found in ca bundle %s", r.caPath)
+		}
+	}
+	r.cert = cert
+	r.caPool = caPool
+	return nil
+}
+
+// get returns the current key pair and CA pool, reloading them first if the files changed.
+// A failed reload keeps the previous material so a half-written file never breaks serving.
+func (r *certReloader) get() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.lastCheck) >= r.interval {
+		r.lastCheck = time.Now()
+		modTime, err := r.latestModTime()
+		if err != nil {
+			log.Warn("stat tls files failed, keep the loaded certificates", zap.Strings("paths", r.paths()), zap.Error(err))
+		} else if modTime.After(r.modTime) {
+			if err := r.load(); err != nil {
+				log.Warn("reload tls files failed, keep the loaded certificates", zap.Error(err))
+			} else {
+				r.modTime = modTime
+				log.Info("tls certificates reloaded", zap.Strings("paths", r.paths()))
+			}
+		}
+	}
+	return r.cert, r.caPool
+}
+
+// serverConfig builds the config for one server handshake. With clientAuth the peer
+// must present a certificate signed by the CA bundle.
+func (r *certReloader) serverConfig(clientAuth bool) *tls.Config {
+	cert, caPool := r.get()
+	config := &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		NextProtos:   []string{"h2"},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientAuth {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = caPool
+	}
+	return config
+}
+
+// clientConfig builds the config for a dial. A nil CA pool falls back to the system roots.
+// With withCert the key pair is presented to servers that ask for a client certificate.
+func (r *certReloader) clientConfig(serverName string, withCert bool) *tls.Config {
+	_, caPool := r.get()
+	config := &tls.Config{
+		ServerName: serverName,
+		RootCAs:    caPool,
+		MinVersion: tls.VersionTLS12,
+	}
+	if withCert {
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.get()
+			return cert, nil
+		}
+	}
+	return config
+}
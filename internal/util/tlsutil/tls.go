@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// lazyReloader creates a certReloader on first use and shares it afterwards
+type lazyReloader struct {
+	once     sync.Once
+	reloader *certReloader
+	err      error
+}
+
+func (l *lazyReloader) get(certPath, keyPath, caPath string) (*certReloader, error) {
+	l.once.Do(func() {
+		l.reloader, l.err = newCertReloader(certPath, keyPath, caPath, Params.ReloadInterval)
+	})
+	return l.reloader, l.err
+}
+
+var (
+	internalReloader    lazyReloader
+	proxyServerReloader lazyReloader
+	proxyCAReloader     lazyReloader
+)
+
+func getInternalReloader() (*certReloader, error) {
+	return internalReloader.get(Params.InternalPemPath, Params.InternalKeyPath, Params.InternalCaPemPath)
+}
+
+// ServerOption returns the transport credentials for the grpc server of the given role,
+// or an empty option when TLS is disabled for it.
+//
+// For the proxy role it covers the public port serving MilvusService, the Proxy service on
+// the internal port uses InternalServerOption like every other component.
+func ServerOption(role string) (grpc.ServerOption, error) {
+	Params.Init()
+	if role == typeutil.ProxyRole {
+		if !Params.ProxyTLSEnabled {
+			return grpc.EmptyServerOption{}, nil
+		}
+		proxy, err := proxyServerReloader.get(Params.ProxyServerPemPath, Params.ProxyServerKeyPath, Params.ProxyCaPemPath)
+		if err != nil {
+			return nil, err
+		}
+		return grpc.Creds(credentials.NewTLS(&tls.Config{
+			GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+				return proxy.serverConfig(Params.ProxyClientAuth), nil
+			},
+		})), nil
+	}
+	return InternalServerOption()
+}
+
+// InternalServerOption returns the internal mutual TLS credentials for the grpc servers
+// called by other components, or an empty option when tls.internal is disabled.
+func InternalServerOption() (grpc.ServerOption, error) {
+	Params.Init()
+	if !Params.InternalTLSEnabled {
+		return grpc.EmptyServerOption{}, nil
+	}
+	internal, err := getInternalReloader()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Creds(credentials.NewTLS(&tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return internal.serverConfig(true), nil
+		},
+	})), nil
+}
+
+// DialOption returns the transport credentials used to dial a component of the given role.
+// Dialing the public proxy port verifies it against tls.proxy.caPemPath or the system roots,
+// all other dials, including the internal proxy port, go through InternalDialOption.
+func DialOption(role string) (grpc.DialOption, error) {
+	Params.Init()
+	if role == typeutil.ProxyRole {
+		if !Params.ProxyTLSEnabled {
+			return grpc.WithInsecure(), nil
+		}
+		proxyCA, err := proxyCAReloader.get("", "", Params.ProxyCaPemPath)
+		if err != nil {
+			return nil, err
+		}
+		return grpc.WithTransportCredentials(newReloadingCredentials(func() *tls.Config {
+			return proxyCA.clientConfig(Params.ProxyServerName, false)
+		})), nil
+	}
+	return InternalDialOption()
+}
+
+// InternalDialOption returns the credentials to dial the internal grpc servers, presenting the
+// internal certificate when tls.internal is enabled.
+func InternalDialOption() (grpc.DialOption, error) {
+	Params.Init()
+	if !Params.InternalTLSEnabled {
+		return grpc.WithInsecure(), nil
+	}
+	internal, err := getInternalReloader()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(newReloadingCredentials(func() *tls.Config {
+		return internal.clientConfig(Params.InternalServerName, true)
+	})), nil
+}
+
+// reloadingCredentials builds a fresh tls config for every client handshake, so connections
+// re-established by grpc pick up a renewed CA bundle without dialing again.
+type reloadingCredentials struct {
+	credentials.TransportCredentials
+	newConfig func() *tls.Config
+}
+
+func newReloadingCredentials(newConfig func() *tls.Config) credentials.TransportCredentials {
+	return &reloadingCredentials{
+		TransportCredentials: credentials.NewTLS(newConfig()),
+		newConfig:            newConfig,
+	}
+}
+
+func (c *reloadingCredentials) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return credentials.NewTLS(c.newConfig()).ClientHandshake(ctx, authority, rawConn)
+}
+
+func (c *reloadingCredentials) Clone() credentials.TransportCredentials {
+	return newReloadingCredentials(c.newConfig)
+}
@@ -0,0 +1,287 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package tlsutil
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns a PEM encoded key pair for serverName, usable on both sides of a handshake
+func (ca *testCA) issue(t *testing.T, serverName string, serial int64) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: serverName},
+		DNSNames:     []string{serverName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func writeFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	assert.Nil(t, ioutil.WriteFile(path, data, 0600))
+	assert.Nil(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	caPath := filepath.Join(dir, "ca.pem")
+
+	ca := newTestCA(t, "ca")
+	now := time.Now()
+	certPem, keyPem := ca.issue(t, "node", 1)
+	writeFile(t, certPath, certPem, now)
+	writeFile(t, keyPath, keyPem, now)
+	writeFile(t, caPath, ca.pem, now)
+
+	_, err := newCertReloader(certPath, filepath.Join(dir, "not-exist"), caPath, 0)
+	assert.NotNil(t, err)
+
+	r, err := newCertReloader(certPath, keyPath, caPath, 0)
+	assert.Nil(t, err)
+	cert, caPool := r.get()
+	assert.NotNil(t, caPool)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), leaf.SerialNumber.Int64())
+
+	t.Run("reload changed files", func(t *testing.T) {
+		certPem, keyPem := ca.issue(t, "node", 2)
+		writeFile(t, certPath, certPem, now.Add(time.Minute))
+		writeFile(t, keyPath, keyPem, now.Add(time.Minute))
+		cert, _ := r.get()
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), leaf.SerialNumber.Int64())
+	})
+
+	t.Run("keep certificates on broken files", func(t *testing.T) {
+		writeFile(t, certPath, []byte("broken"), now.Add(2*time.Minute))
+		cert, _ := r.get()
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), leaf.SerialNumber.Int64())
+
+		assert.Nil(t, os.Remove(caPath))
+		_, caPool := r.get()
+		assert.NotNil(t, caPool)
+	})
+
+	t.Run("ca only", func(t *testing.T) {
+		writeFile(t, caPath, ca.pem, now)
+		r, err := newCertReloader("", "", caPath, time.Hour)
+		assert.Nil(t, err)
+		cert, caPool := r.get()
+		assert.Nil(t, cert)
+		assert.NotNil(t, caPool)
+
+		writeFile(t, caPath, []byte("broken"), now)
+		_, err = newCertReloader("", "", caPath, time.Hour)
+		assert.NotNil(t, err)
+	})
+}
+
+func startHealthServer(t *testing.T, role string) (string, func()) {
+	opt, err := ServerOption(role)
+	assert.Nil(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := grpc.NewServer(opt)
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	return lis.Addr().String(), server.Stop
+}
+
+func checkHealth(addr string, opt grpc.DialOption) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, opt, grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func TestServerAndDialOption(t *testing.T) {
+	Params.Init()
+	defer func() {
+		Params.ProxyTLSEnabled = false
+		Params.InternalTLSEnabled = false
+	}()
+
+	dir := t.TempDir()
+	now := time.Now()
+	internalCA := newTestCA(t, "internal-ca")
+	internalCert, internalKey := internalCA.issue(t, "milvus.internal", 1)
+	writeFile(t, filepath.Join(dir, "internal-ca.pem"), internalCA.pem, now)
+	writeFile(t, filepath.Join(dir, "internal.pem"), internalCert, now)
+	writeFile(t, filepath.Join(dir, "internal.key"), internalKey, now)
+
+	proxyCA := newTestCA(t, "proxy-ca")
+	proxyCert, proxyKey := proxyCA.issue(t, "milvus.example.com", 1)
+	writeFile(t, filepath.Join(dir, "proxy-ca.pem"), proxyCA.pem, now)
+	writeFile(t, filepath.Join(dir, "proxy.pem"), proxyCert, now)
+	writeFile(t, filepath.Join(dir, "proxy.key"), proxyKey, now)
+
+	t.Run("insecure", func(t *testing.T) {
+		opt, err := ServerOption(typeutil.RootCoordRole)
+		assert.Nil(t, err)
+		assert.Equal(t, grpc.EmptyServerOption{}, opt)
+
+		addr, stop := startHealthServer(t, typeutil.RootCoordRole)
+		defer stop()
+		dialOpt, err := DialOption(typeutil.RootCoordRole)
+		assert.Nil(t, err)
+		assert.Nil(t, checkHealth(addr, dialOpt))
+	})
+
+	Params.ProxyTLSEnabled = true
+	Params.ProxyServerPemPath = filepath.Join(dir, "proxy.pem")
+	Params.ProxyServerKeyPath = filepath.Join(dir, "proxy.key")
+	Params.ProxyCaPemPath = filepath.Join(dir, "proxy-ca.pem")
+	Params.ProxyServerName = "milvus.example.com"
+	Params.InternalTLSEnabled = true
+	Params.InternalPemPath = filepath.Join(dir, "internal.pem")
+	Params.InternalKeyPath = filepath.Join(dir, "internal.key")
+	Params.InternalCaPemPath = filepath.Join(dir, "internal-ca.pem")
+	Params.InternalServerName = "milvus.internal"
+
+	t.Run("internal mutual tls", func(t *testing.T) {
+		addr, stop := startHealthServer(t, typeutil.DataNodeRole)
+		defer stop()
+
+		dialOpt, err := DialOption(typeutil.DataNodeRole)
+		assert.Nil(t, err)
+		assert.Nil(t, checkHealth(addr, dialOpt))
+
+		// a client without the internal certificate is rejected
+		publicOpt, err := proxyDialOption()
+		assert.Nil(t, err)
+		assert.NotNil(t, checkHealth(addr, publicOpt))
+	})
+
+	t.Run("proxy public port", func(t *testing.T) {
+		addr, stop := startHealthServer(t, typeutil.ProxyRole)
+		defer stop()
+
+		dialOpt, err := DialOption(typeutil.ProxyRole)
+		assert.Nil(t, err)
+		assert.Nil(t, checkHealth(addr, dialOpt))
+
+		publicOpt, err := proxyDialOption()
+		assert.Nil(t, err)
+		assert.Nil(t, checkHealth(addr, publicOpt))
+
+		// the internal certificate isn't accepted in place of the public one
+		internalOpt, err := InternalDialOption()
+		assert.Nil(t, err)
+		assert.NotNil(t, checkHealth(addr, internalOpt))
+
+		insecureCtx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		_, err = grpc.DialContext(insecureCtx, addr, grpc.WithInsecure(), grpc.WithBlock())
+		assert.NotNil(t, err)
+	})
+
+	t.Run("proxy internal port", func(t *testing.T) {
+		opt, err := InternalServerOption()
+		assert.Nil(t, err)
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.Nil(t, err)
+		server := grpc.NewServer(opt)
+		grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+		go server.Serve(lis)
+		defer server.Stop()
+
+		internalOpt, err := InternalDialOption()
+		assert.Nil(t, err)
+		assert.Nil(t, checkHealth(lis.Addr().String(), internalOpt))
+
+		// SDK clients can't reach the internal port
+		publicOpt, err := proxyDialOption()
+		assert.Nil(t, err)
+		assert.NotNil(t, checkHealth(lis.Addr().String(), publicOpt))
+	})
+}
+
+// proxyDialOption dials like an SDK client, trusting only the public proxy CA
+func proxyDialOption() (grpc.DialOption, error) {
+	r, err := newCertReloader("", "", Params.ProxyCaPemPath, Params.ReloadInterval)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(newReloadingCredentials(func() *tls.Config {
+		return r.clientConfig(Params.ProxyServerName, false)
+	})), nil
+}
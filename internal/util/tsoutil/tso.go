@@ -27,6 +27,17 @@ func ComposeTS(physical, logical int64) uint64 {
 	return uint64((physical << logicalBits) + logical)
 }
 
+// ComposeTSByTime returns the hybrid timestamp of the physical time and the logical part.
+func ComposeTSByTime(physical time.Time, logical int64) uint64 {
+	return ComposeTS(physical.UnixNano()/int64(time.Millisecond), logical)
+}
+
+// AddPhysicalDurationOnTs adds the duration to the physical part of ts, the duration may be negative.
+func AddPhysicalDurationOnTs(ts uint64, duration time.Duration) uint64 {
+	physical, logical := ParseTS(ts)
+	return ComposeTSByTime(physical.Add(duration), int64(logical))
+}
+
 // ParseTS parses the ts to (physical,logical).
 func ParseTS(ts uint64) (time.Time, uint64) {
 	logical := ts & logicalBitsMask
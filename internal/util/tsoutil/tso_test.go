@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
 
@@ -28,3 +29,21 @@ func TestParseHybridTs(t *testing.T) {
 		zap.Uint64("logical", logical),
 		zap.Any("physical time", physicalTime))
 }
+
+func TestComposeTSByTime(t *testing.T) {
+	physical := time.Date(2021, 10, 1, 8, 0, 0, int(123*time.Millisecond), time.UTC)
+	ts := ComposeTSByTime(physical, 7)
+	parsed, logical := ParseTS(ts)
+	assert.True(t, physical.Equal(parsed))
+	assert.Equal(t, uint64(7), logical)
+}
+
+func TestAddPhysicalDurationOnTs(t *testing.T) {
+	ts := ComposeTSByTime(time.Now(), 3)
+	earlier := AddPhysicalDurationOnTs(ts, -time.Hour)
+	physical, _ := ParseHybridTs(ts)
+	earlierPhysical, logical := ParseHybridTs(earlier)
+	assert.Equal(t, uint64(time.Hour/time.Millisecond), physical-earlierPhysical)
+	assert.Equal(t, uint64(3), logical)
+	assert.Equal(t, ts, AddPhysicalDurationOnTs(earlier, time.Hour))
+}
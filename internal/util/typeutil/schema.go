@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
@@ -32,7 +33,12 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 		case schemapb.DataType_Int64, schemapb.DataType_Double:
 			res += 8
 		case schemapb.DataType_String:
-			res += 125 // todo find a better way to estimate string type
+			maxLength, err := GetMaxLength(fs)
+			if err != nil {
+				res += 125 // todo find a better way to estimate string type
+				break
+			}
+			res += maxLength
 		case schemapb.DataType_BinaryVector:
 			for _, kv := range fs.TypeParams {
 				if kv.Key == "dim" {
@@ -134,6 +140,23 @@ func (helper *SchemaHelper) GetVectorDimFromID(filedID int64) (int, error) {
 	return 0, fmt.Errorf("fieldID(%d) not has dim", filedID)
 }
 
+// GetMaxLength returns the max length declared in the type params of string field
+func GetMaxLength(field *schemapb.FieldSchema) (int, error) {
+	if !IsStringType(field.DataType) {
+		return 0, fmt.Errorf("field type = %s not has max length", schemapb.DataType_name[int32(field.DataType)])
+	}
+	for _, kv := range field.TypeParams {
+		if kv.Key == common.MaxLengthKey {
+			maxLength, err := strconv.Atoi(kv.Value)
+			if err != nil {
+				return 0, err
+			}
+			return maxLength, nil
+		}
+	}
+	return 0, fmt.Errorf("field %s not has max length", field.Name)
+}
+
 func IsVectorType(dataType schemapb.DataType) bool {
 	switch dataType {
 	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
@@ -170,3 +193,208 @@ func IsBoolType(dataType schemapb.DataType) bool {
 		return false
 	}
 }
+
+func IsStringType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_String:
+		return true
+	default:
+		return false
+	}
+}
+
+// AppendFieldData appends the idx-th row of fields data in src to dst
+func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
+	for i, fieldData := range src {
+		switch fieldType := fieldData.Field.(type) {
+		case *schemapb.FieldData_Scalars:
+			if dst[i] == nil || dst[i].GetScalars() == nil {
+				dst[i] = &schemapb.FieldData{
+					Type:      fieldData.Type,
+					FieldName: fieldData.FieldName,
+					FieldId:   fieldData.FieldId,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{},
+					},
+				}
+			}
+			dstScalar := dst[i].GetScalars()
+			switch srcScalar := fieldType.Scalars.Data.(type) {
+			case *schemapb.ScalarField_BoolData:
+				if dstScalar.GetBoolData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BoolData{
+						BoolData: &schemapb.BoolArray{
+							Data: []bool{srcScalar.BoolData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetBoolData().Data = append(dstScalar.GetBoolData().Data, srcScalar.BoolData.Data[idx])
+				}
+			case *schemapb.ScalarField_IntData:
+				if dstScalar.GetIntData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_IntData{
+						IntData: &schemapb.IntArray{
+							Data: []int32{srcScalar.IntData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetIntData().Data = append(dstScalar.GetIntData().Data, srcScalar.IntData.Data[idx])
+				}
+			case *schemapb.ScalarField_LongData:
+				if dstScalar.GetLongData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{
+							Data: []int64{srcScalar.LongData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetLongData().Data = append(dstScalar.GetLongData().Data, srcScalar.LongData.Data[idx])
+				}
+			case *schemapb.ScalarField_FloatData:
+				if dstScalar.GetFloatData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_FloatData{
+						FloatData: &schemapb.FloatArray{
+							Data: []float32{srcScalar.FloatData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetFloatData().Data = append(dstScalar.GetFloatData().Data, srcScalar.FloatData.Data[idx])
+				}
+			case *schemapb.ScalarField_DoubleData:
+				if dstScalar.GetDoubleData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_DoubleData{
+						DoubleData: &schemapb.DoubleArray{
+							Data: []float64{srcScalar.DoubleData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetDoubleData().Data = append(dstScalar.GetDoubleData().Data, srcScalar.DoubleData.Data[idx])
+				}
+			case *schemapb.ScalarField_StringData:
+				if dstScalar.GetStringData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: []string{srcScalar.StringData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
+				}
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: [][]byte{srcScalar.BytesData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data[idx])
+				}
+			}
+		case *schemapb.FieldData_Vectors:
+			dim := fieldType.Vectors.Dim
+			if dst[i] == nil || dst[i].GetVectors() == nil {
+				dst[i] = &schemapb.FieldData{
+					Type:      fieldData.Type,
+					FieldName: fieldData.FieldName,
+					FieldId:   fieldData.FieldId,
+					Field: &schemapb.FieldData_Vectors{
+						Vectors: &schemapb.VectorField{
+							Dim: dim,
+						},
+					},
+				}
+			}
+			dstVector := dst[i].GetVectors()
+			switch srcVector := fieldType.Vectors.Data.(type) {
+			case *schemapb.VectorField_BinaryVector:
+				rowBytes := dim / 8
+				if dstVector.GetBinaryVector() == nil {
+					dstVector.Data = &schemapb.VectorField_BinaryVector{
+						BinaryVector: make([]byte, 0, rowBytes),
+					}
+				}
+				dstBinaryVector := dstVector.Data.(*schemapb.VectorField_BinaryVector)
+				dstBinaryVector.BinaryVector = append(dstBinaryVector.BinaryVector, srcVector.BinaryVector[idx*rowBytes:(idx+1)*rowBytes]...)
+			case *schemapb.VectorField_FloatVector:
+				if dstVector.GetFloatVector() == nil {
+					dstVector.Data = &schemapb.VectorField_FloatVector{
+						FloatVector: &schemapb.FloatArray{
+							Data: make([]float32, 0, dim),
+						},
+					}
+				}
+				dstVector.GetFloatVector().Data = append(dstVector.GetFloatVector().Data, srcVector.FloatVector.Data[idx*dim:(idx+1)*dim]...)
+			}
+		}
+	}
+}
+
+// GetSizeOfIDs returns the number of primary keys in ids
+func GetSizeOfIDs(ids *schemapb.IDs) int {
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return len(ids.GetIntId().GetData())
+	case *schemapb.IDs_StrId:
+		return len(ids.GetStrId().GetData())
+	default:
+		return 0
+	}
+}
+
+// GetPK returns the idx-th primary key in ids, an int64 or a string
+func GetPK(ids *schemapb.IDs, idx int64) interface{} {
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return ids.GetIntId().GetData()[idx]
+	case *schemapb.IDs_StrId:
+		return ids.GetStrId().GetData()[idx]
+	default:
+		return nil
+	}
+}
+
+// LessPK tells whether primary key a is less than b, a and b are both int64 or both string
+func LessPK(a, b interface{}) bool {
+	switch pk := a.(type) {
+	case int64:
+		return pk < b.(int64)
+	case string:
+		return pk < b.(string)
+	default:
+		return false
+	}
+}
+
+// IsValidID tells whether the idx-th primary key in ids of search hits is a real hit,
+// the missing hits are filled with -1, or with an empty string for string primary keys
+func IsValidID(ids *schemapb.IDs, idx int64) bool {
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return ids.GetIntId().GetData()[idx] != -1
+	case *schemapb.IDs_StrId:
+		return ids.GetStrId().GetData()[idx] != ""
+	default:
+		return false
+	}
+}
+
+// AppendIDs appends the idx-th primary key in src to dst, dst takes the type of src if it holds another type
+func AppendIDs(dst *schemapb.IDs, src *schemapb.IDs, idx int64) {
+	switch src.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		if dst.GetIntId() == nil {
+			dst.IdField = &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{},
+			}
+		}
+		dst.GetIntId().Data = append(dst.GetIntId().Data, src.GetIntId().GetData()[idx])
+	case *schemapb.IDs_StrId:
+		if dst.GetStrId() == nil {
+			dst.IdField = &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{},
+			}
+		}
+		dst.GetStrId().Data = append(dst.GetStrId().Data, src.GetStrId().GetData()[idx])
+	}
+}
@@ -177,6 +177,44 @@ func TestSchema(t *testing.T) {
 		assert.False(t, IsFloatingType(schemapb.DataType_String))
 		assert.False(t, IsFloatingType(schemapb.DataType_BinaryVector))
 		assert.False(t, IsFloatingType(schemapb.DataType_FloatVector))
+
+		assert.False(t, IsStringType(schemapb.DataType_Bool))
+		assert.False(t, IsStringType(schemapb.DataType_Int64))
+		assert.False(t, IsStringType(schemapb.DataType_Double))
+		assert.True(t, IsStringType(schemapb.DataType_String))
+		assert.False(t, IsStringType(schemapb.DataType_FloatVector))
+	})
+
+	t.Run("MaxLength", func(t *testing.T) {
+		field := &schemapb.FieldSchema{
+			FieldID:  109,
+			Name:     "field_varchar",
+			DataType: schemapb.DataType_String,
+			TypeParams: []*commonpb.KeyValuePair{
+				{
+					Key:   "max_length",
+					Value: "64",
+				},
+			},
+		}
+		maxLength, err := GetMaxLength(field)
+		assert.Nil(t, err)
+		assert.Equal(t, 64, maxLength)
+
+		size, err := EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+		assert.Nil(t, err)
+		assert.Equal(t, 64, size)
+
+		// string field without max length
+		_, err = GetMaxLength(schema.Fields[6])
+		assert.NotNil(t, err)
+		// not a string field
+		_, err = GetMaxLength(schema.Fields[0])
+		assert.NotNil(t, err)
+
+		field.TypeParams[0].Value = "abc"
+		_, err = GetMaxLength(field)
+		assert.NotNil(t, err)
 	})
 }
 
@@ -324,3 +362,123 @@ func TestSchema_invalid(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestAppendFieldData(t *testing.T) {
+	src := []*schemapb.FieldData{
+		{
+			Type:      schemapb.DataType_Int64,
+			FieldName: "field_int64",
+			FieldId:   100,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{
+							Data: []int64{1, 2, 3},
+						},
+					},
+				},
+			},
+		},
+		{
+			Type:      schemapb.DataType_Bool,
+			FieldName: "field_bool",
+			FieldId:   101,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_BoolData{
+						BoolData: &schemapb.BoolArray{
+							Data: []bool{true, false, true},
+						},
+					},
+				},
+			},
+		},
+		{
+			Type:      schemapb.DataType_FloatVector,
+			FieldName: "field_float_vector",
+			FieldId:   102,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: 2,
+					Data: &schemapb.VectorField_FloatVector{
+						FloatVector: &schemapb.FloatArray{
+							Data: []float32{1.0, 1.1, 2.0, 2.1, 3.0, 3.1},
+						},
+					},
+				},
+			},
+		},
+		{
+			Type:      schemapb.DataType_BinaryVector,
+			FieldName: "field_binary_vector",
+			FieldId:   103,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: 16,
+					Data: &schemapb.VectorField_BinaryVector{
+						BinaryVector: []byte{1, 1, 2, 2, 3, 3},
+					},
+				},
+			},
+		},
+	}
+
+	dst := make([]*schemapb.FieldData, len(src))
+	AppendFieldData(dst, src, 0)
+	AppendFieldData(dst, src, 2)
+
+	assert.Equal(t, int64(100), dst[0].FieldId)
+	assert.Equal(t, schemapb.DataType_Int64, dst[0].Type)
+	assert.Equal(t, []int64{1, 3}, dst[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []bool{true, true}, dst[1].GetScalars().GetBoolData().Data)
+	assert.Equal(t, int64(2), dst[2].GetVectors().Dim)
+	assert.Equal(t, []float32{1.0, 1.1, 3.0, 3.1}, dst[2].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, []byte{1, 1, 3, 3}, dst[3].GetVectors().GetBinaryVector())
+}
+
+func TestIDs(t *testing.T) {
+	intIDs := &schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: []int64{3, -1, 1},
+			},
+		},
+	}
+	strIDs := &schemapb.IDs{
+		IdField: &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: []string{"c", "", "a"},
+			},
+		},
+	}
+
+	assert.Equal(t, 3, GetSizeOfIDs(intIDs))
+	assert.Equal(t, 3, GetSizeOfIDs(strIDs))
+	assert.Equal(t, 0, GetSizeOfIDs(&schemapb.IDs{}))
+
+	assert.True(t, IsValidID(intIDs, 0))
+	assert.False(t, IsValidID(intIDs, 1))
+	assert.True(t, IsValidID(strIDs, 0))
+	assert.False(t, IsValidID(strIDs, 1))
+
+	assert.Equal(t, int64(3), GetPK(intIDs, 0))
+	assert.Equal(t, "c", GetPK(strIDs, 0))
+	assert.True(t, LessPK(GetPK(intIDs, 2), GetPK(intIDs, 0)))
+	assert.True(t, LessPK(GetPK(strIDs, 2), GetPK(strIDs, 0)))
+	assert.False(t, LessPK(GetPK(strIDs, 0), GetPK(strIDs, 2)))
+
+	// the empty int ids take the type of the string ids
+	dst := &schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{},
+		},
+	}
+	AppendIDs(dst, strIDs, 2)
+	AppendIDs(dst, strIDs, 0)
+	assert.Nil(t, dst.GetIntId())
+	assert.Equal(t, []string{"a", "c"}, dst.GetStrId().GetData())
+
+	dst = &schemapb.IDs{}
+	AppendIDs(dst, intIDs, 0)
+	assert.Equal(t, []int64{3}, dst.GetIntId().GetData())
+}